@@ -5,6 +5,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/benbjohnson/clock"
 	"github.com/common-fate/common-fate/pkg/cachesync"
 	"github.com/common-fate/common-fate/pkg/handler"
 	"github.com/common-fate/common-fate/pkg/service/cachesvc"
@@ -49,6 +50,7 @@ var syncCommand = cli.Command{
 				RequestRouter: &requestroutersvc.Service{
 					DB: db,
 				},
+				Clock: clock.New(),
 			},
 		}
 
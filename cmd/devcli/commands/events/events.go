@@ -4,6 +4,6 @@ import "github.com/urfave/cli/v2"
 
 var EventsCommand = cli.Command{
 	Name:        "event",
-	Subcommands: []*cli.Command{&requestCommand},
+	Subcommands: []*cli.Command{&requestCommand, &replayCommand},
 	Action:      cli.ShowSubcommandHelp,
 }
@@ -0,0 +1,64 @@
+package events
+
+import (
+	"github.com/common-fate/clio"
+	"github.com/common-fate/common-fate/pkg/deploy"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/service/deadlettersvc"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+	"github.com/urfave/cli/v2"
+)
+
+var replayCommand = cli.Command{
+	Name:      "replay",
+	Usage:     "replay a dead letter event, or all of the dead letter events for a request",
+	ArgsUsage: "[event id]",
+	Flags: []cli.Flag{
+		&cli.StringFlag{Name: "request", Aliases: []string{"r"}, Usage: "replay the dead letter events for this request ID"},
+	},
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+		do, err := deploy.LoadConfig(deploy.DefaultFilename)
+		if err != nil {
+			return err
+		}
+		o, err := do.LoadOutput(ctx)
+		if err != nil {
+			return err
+		}
+		ddbClient, err := ddb.New(ctx, o.DynamoDBTable)
+		if err != nil {
+			return err
+		}
+		// status changes to requests, groups and targets are written with conditional writes
+		db := storage.NewStatusGuard(ddbClient)
+		eb, err := gevent.NewSender(ctx, gevent.SenderOpts{
+			EventBusARN: o.EventBusArn,
+		})
+		if err != nil {
+			return err
+		}
+		svc := deadlettersvc.Service{DB: db, Eventbus: eb}
+
+		if requestID := c.String("request"); requestID != "" {
+			events, err := svc.ReplayRequest(ctx, requestID)
+			if err != nil {
+				return err
+			}
+			clio.Successf("replayed %d events for request %s", len(events), requestID)
+			return nil
+		}
+
+		id := c.Args().First()
+		if id == "" {
+			return cli.ShowSubcommandHelp(c)
+		}
+		err = svc.Replay(ctx, id)
+		if err != nil {
+			return err
+		}
+		clio.Successf("replayed event %s", id)
+		return nil
+	},
+}
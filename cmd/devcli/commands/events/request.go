@@ -29,10 +29,12 @@ var requestCommand = cli.Command{
 
 		// reason := "Deploying Terraform for CF-123"
 
-		db, err := ddb.New(ctx, o.DynamoDBTable)
+		ddbClient, err := ddb.New(ctx, o.DynamoDBTable)
 		if err != nil {
 			return err
 		}
+		// status changes to requests, groups and targets are written with conditional writes
+		db := storage.NewStatusGuard(ddbClient)
 
 		q := storage.GetAccessRule{ID: c.String("rule")}
 
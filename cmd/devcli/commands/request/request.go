@@ -34,10 +34,12 @@ var submitCommand = cli.Command{
 
 		clk := clock.New()
 		_ = godotenv.Load()
-		db, err := ddb.New(ctx, os.Getenv("COMMONFATE_TABLE_NAME"))
+		ddbClient, err := ddb.New(ctx, os.Getenv("COMMONFATE_TABLE_NAME"))
 		if err != nil {
 			return err
 		}
+		// status changes to requests, groups and targets are written with conditional writes
+		db := storage.NewStatusGuard(ddbClient)
 		eh := eventhandler.NewLocalDevEventHandler(ctx, db, clk)
 		accsvc := &accesssvc.Service{
 			Clock:       clk,
@@ -0,0 +1,73 @@
+// Command event-schemas generates the JSON schemas for the events sent on the Common Fate event bus.
+//
+// Usage:
+//
+//	go run ./cmd/event-schemas -out pkg/gevent/schemas
+//
+// A file is written for the current schema version of each event. Files for earlier versions are left in place,
+// so that the schemas which external consumers depend on remain published.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/common-fate/common-fate/pkg/gevent"
+)
+
+func main() {
+	out := flag.String("out", "schemas", "the directory to write the schemas to")
+	flag.Parse()
+
+	err := run(*out)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(out string) error {
+	err := os.MkdirAll(out, 0755)
+	if err != nil {
+		return err
+	}
+	for _, e := range gevent.Events {
+		schema, err := gevent.GenerateSchema(e)
+		if err != nil {
+			return fmt.Errorf("generating schema for %s: %w", e.EventType(), err)
+		}
+		filename := filepath.Join(out, gevent.SchemaFilename(e.EventType(), gevent.SchemaVersion(e)))
+
+		// a published version can be updated with compatible changes, such as new fields,
+		// but a breaking change needs a new version so that consumers of the old version aren't broken
+		existing, err := os.ReadFile(filename)
+		if err == nil {
+			var published map[string]any
+			err = json.Unmarshal(existing, &published)
+			if err != nil {
+				return fmt.Errorf("parsing %s: %w", filename, err)
+			}
+			if changes := gevent.BreakingChanges(published, schema); len(changes) > 0 {
+				return fmt.Errorf("%s has breaking changes, increase the SchemaVersion of %T: %s", e.EventType(), e, strings.Join(changes, "; "))
+			}
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+
+		b, err := json.MarshalIndent(schema, "", "  ")
+		if err != nil {
+			return err
+		}
+		b = append(b, '\n')
+		err = os.WriteFile(filename, b, 0644)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
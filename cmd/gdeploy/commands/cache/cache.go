@@ -1,6 +1,7 @@
 package cache
 
 import (
+	"github.com/common-fate/common-fate/cmd/gdeploy/commands/cache/status"
 	"github.com/common-fate/common-fate/cmd/gdeploy/commands/cache/sync"
 	"github.com/urfave/cli/v2"
 )
@@ -12,5 +13,6 @@ var Command = cli.Command{
 	Action:      cli.ShowSubcommandHelp,
 	Subcommands: []*cli.Command{
 		&sync.SyncCommand,
+		&status.StatusCommand,
 	},
 }
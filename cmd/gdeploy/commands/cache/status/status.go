@@ -0,0 +1,99 @@
+package status
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/common-fate/clio"
+	"github.com/common-fate/common-fate/pkg/cfaws"
+	"github.com/common-fate/common-fate/pkg/deploy"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/target"
+	"github.com/common-fate/ddb"
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli/v2"
+)
+
+var StatusCommand = cli.Command{
+	Name:        "status",
+	Description: "Show the resource sync status of each target group",
+	Usage:       "Show the resource sync status of each target group",
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+
+		dc, err := deploy.ConfigFromContext(ctx)
+		if err != nil {
+			return err
+		}
+		o, err := dc.LoadOutput(ctx)
+		if err != nil {
+			return err
+		}
+		cfg, err := cfaws.ConfigFromContextOrDefault(ctx)
+		if err != nil {
+			return err
+		}
+		db, err := ddb.New(ctx, o.DynamoDBTable, ddb.WithDynamoDBClient(dynamodb.NewFromConfig(cfg)))
+		if err != nil {
+			return err
+		}
+
+		groups := storage.ListTargetGroups{}
+		err = db.All(ctx, &groups)
+		if err != nil {
+			return err
+		}
+		statuses := storage.ListTargetGroupSyncStatuses{}
+		err = db.All(ctx, &statuses)
+		if err != nil {
+			return err
+		}
+		statusMap := map[string]target.SyncStatus{}
+		for _, s := range statuses.Result {
+			statusMap[s.TargetGroupID] = s
+		}
+		sort.Slice(groups.Result, func(i, j int) bool {
+			return groups.Result[i].ID < groups.Result[j].ID
+		})
+
+		now := time.Now()
+		table := tablewriter.NewWriter(os.Stderr)
+		table.SetHeader([]string{"Target Group", "Last Attempt", "Last Success", "Resources", "Stale", "Last Error"})
+		for _, g := range groups.Result {
+			s, ok := statusMap[g.ID]
+			if !ok {
+				table.Append([]string{g.ID, "never", "never", "0", "-", ""})
+				continue
+			}
+			lastErr := ""
+			if s.LastError != nil {
+				lastErr = *s.LastError
+			}
+			table.Append([]string{
+				g.ID,
+				formatTime(now, s.LastAttemptAt),
+				formatTime(now, s.LastSuccessAt),
+				strconv.Itoa(s.TotalResources()),
+				strconv.FormatBool(s.IsStale(now)),
+				lastErr,
+			})
+		}
+		table.Render()
+
+		if len(groups.Result) == 0 {
+			clio.Info("There are no target groups")
+		}
+		return nil
+	},
+}
+
+func formatTime(now time.Time, t *time.Time) string {
+	if t == nil {
+		return "never"
+	}
+	return fmt.Sprintf("%s ago", now.Sub(*t).Round(time.Second))
+}
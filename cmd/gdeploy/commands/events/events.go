@@ -0,0 +1,204 @@
+package events
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/common-fate/clio"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/cfaws"
+	"github.com/common-fate/common-fate/pkg/deploy"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/service/deadlettersvc"
+	"github.com/common-fate/ddb"
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli/v2"
+)
+
+var Command = cli.Command{
+	Name:        "events",
+	Description: "Inspect, replay and discard events which the event handler or a notifier failed to handle",
+	Usage:       "Inspect, replay and discard events which the event handler or a notifier failed to handle",
+	Action:      cli.ShowSubcommandHelp,
+	Subcommands: []*cli.Command{
+		&listCommand,
+		&showCommand,
+		&replayCommand,
+		&discardCommand,
+		&replayRequestCommand,
+	},
+}
+
+var listCommand = cli.Command{
+	Name:  "list",
+	Usage: "List dead letter events, most recent failure first",
+	Flags: []cli.Flag{
+		&cli.StringFlag{Name: "request", Usage: "Only list the events for this request ID"},
+		&cli.StringFlag{Name: "subscriber", Usage: "Only list the events which this subscriber failed to handle (eventHandler or slackNotifier)"},
+	},
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+		svc, err := newDeadLetterService(ctx)
+		if err != nil {
+			return err
+		}
+		events, err := svc.List(ctx, deadlettersvc.ListOpts{RequestID: c.String("request"), Subscriber: c.String("subscriber")})
+		if err != nil {
+			return err
+		}
+		if len(events) == 0 {
+			clio.Info("There are no dead letter events")
+			return nil
+		}
+		printEvents(events)
+		return nil
+	},
+}
+
+var showCommand = cli.Command{
+	Name:      "show",
+	Usage:     "Show the payload and error of a dead letter event",
+	ArgsUsage: "<event id>",
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+		id := c.Args().First()
+		if id == "" {
+			return cli.ShowSubcommandHelp(c)
+		}
+		svc, err := newDeadLetterService(ctx)
+		if err != nil {
+			return err
+		}
+		e, err := svc.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		clio.Logf("Subscriber: %s", e.Subscriber)
+		clio.Logf("Event type: %s", e.DetailType)
+		clio.Logf("Attempts: %d", e.Attempts)
+		clio.Logf("Error: %s", e.Error)
+		clio.Logf("Detail: %s", e.Detail)
+		return nil
+	},
+}
+
+var replayCommand = cli.Command{
+	Name:      "replay",
+	Usage:     "Send a dead letter event again to the subscriber which failed to handle it",
+	ArgsUsage: "<event id>",
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+		id := c.Args().First()
+		if id == "" {
+			return cli.ShowSubcommandHelp(c)
+		}
+		svc, err := newDeadLetterService(ctx)
+		if err != nil {
+			return err
+		}
+		err = svc.Replay(ctx, id)
+		if err != nil {
+			return err
+		}
+		clio.Successf("Replayed event %s, run 'gdeploy events list' to check whether it failed again", id)
+		return nil
+	},
+}
+
+var discardCommand = cli.Command{
+	Name:      "discard",
+	Usage:     "Delete a dead letter event without handling it again",
+	ArgsUsage: "<event id>",
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+		id := c.Args().First()
+		if id == "" {
+			return cli.ShowSubcommandHelp(c)
+		}
+		svc, err := newDeadLetterService(ctx)
+		if err != nil {
+			return err
+		}
+		err = svc.Discard(ctx, id)
+		if err != nil {
+			return err
+		}
+		clio.Successf("Discarded event %s", id)
+		return nil
+	},
+}
+
+var replayRequestCommand = cli.Command{
+	Name:      "replay-request",
+	Usage:     "Replay the dead letter events for a request in the order they failed, to repair the state of the request",
+	ArgsUsage: "<request id>",
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+		requestID := c.Args().First()
+		if requestID == "" {
+			return cli.ShowSubcommandHelp(c)
+		}
+		svc, err := newDeadLetterService(ctx)
+		if err != nil {
+			return err
+		}
+		events, err := svc.ReplayRequest(ctx, requestID)
+		if err != nil {
+			return err
+		}
+		if len(events) == 0 {
+			clio.Infof("There are no dead letter events for request %s", requestID)
+			return nil
+		}
+		printEvents(events)
+		clio.Successf("Replayed %d events for request %s", len(events), requestID)
+		return nil
+	},
+}
+
+func printEvents(events []access.DeadLetterEvent) {
+	table := tablewriter.NewWriter(os.Stderr)
+	table.SetHeader([]string{"ID", "Subscriber", "Type", "Attempts", "Failed", "Error"})
+	now := time.Now()
+	for _, e := range events {
+		table.Append([]string{
+			e.ID,
+			e.Subscriber,
+			e.DetailType,
+			strconv.Itoa(e.Attempts),
+			now.Sub(e.LastFailedAt).Round(time.Second).String() + " ago",
+			e.Error,
+		})
+	}
+	table.Render()
+}
+
+func newDeadLetterService(ctx context.Context) (*deadlettersvc.Service, error) {
+	dc, err := deploy.ConfigFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	o, err := dc.LoadOutput(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := cfaws.ConfigFromContextOrDefault(ctx)
+	if err != nil {
+		return nil, err
+	}
+	db, err := ddb.New(ctx, o.DynamoDBTable, ddb.WithDynamoDBClient(dynamodb.NewFromConfig(cfg)))
+	if err != nil {
+		return nil, err
+	}
+	eventBus, err := gevent.NewSender(ctx, gevent.SenderOpts{EventBusARN: o.EventBusArn})
+	if err != nil {
+		return nil, err
+	}
+	return &deadlettersvc.Service{
+		DB:       db,
+		Eventbus: eventBus,
+	}, nil
+}
@@ -0,0 +1,214 @@
+package grants
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/clio"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/cfaws"
+	"github.com/common-fate/common-fate/pkg/deploy"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/service/bulkrevokesvc"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli/v2"
+)
+
+var bulkRevokeCommand = cli.Command{
+	Name:        "bulk-revoke",
+	Description: "Revoke access in bulk when offboarding a user or responding to an incident",
+	Usage:       "Revoke access in bulk when offboarding a user or responding to an incident",
+	Action:      cli.ShowSubcommandHelp,
+	Subcommands: []*cli.Command{
+		&bulkRevokeCreateCommand,
+		&bulkRevokeStatusCommand,
+		&bulkRevokeReindexCommand,
+	},
+}
+
+var bulkRevokeCreateCommand = cli.Command{
+	Name:  "create",
+	Usage: "Create a bulk revoke job. Exactly one of --user, --target-group, --target-field or --access-rule is required",
+	Flags: []cli.Flag{
+		&cli.StringFlag{Name: "user", Usage: "revoke active requests and cancel pending requests made by this user ID or email address"},
+		&cli.StringFlag{Name: "target-group", Usage: "revoke active grants and cancel pending requests in this target group, or narrow --target-field to this target group"},
+		&cli.StringSliceFlag{Name: "target-field", Usage: "revoke active grants and cancel pending requests to targets with this field value, in the format fieldId=value. Can be repeated"},
+		&cli.StringFlag{Name: "access-rule", Usage: "revoke active grants and cancel pending requests made through this access rule"},
+		&cli.BoolFlag{Name: "dry-run", Usage: "list the requests and targets which would be revoked without revoking them"},
+	},
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+		svc, db, err := newBulkRevokeService(ctx)
+		if err != nil {
+			return err
+		}
+		opts := bulkrevokesvc.CreateJobOpts{DryRun: c.Bool("dry-run")}
+		switch {
+		case c.String("user") != "":
+			opts.Kind = types.USER
+			opts.Filter.UserID, err = resolveUserID(ctx, db, c.String("user"))
+			if err != nil {
+				return err
+			}
+		case len(c.StringSlice("target-field")) > 0:
+			opts.Kind = types.TARGETFILTER
+			opts.Filter.TargetGroupID = c.String("target-group")
+			opts.Filter.TargetFields = map[string]string{}
+			for _, f := range c.StringSlice("target-field") {
+				k, v, ok := strings.Cut(f, "=")
+				if !ok {
+					return fmt.Errorf("invalid --target-field %q, expected fieldId=value", f)
+				}
+				opts.Filter.TargetFields[k] = v
+			}
+		case c.String("target-group") != "":
+			opts.Kind = types.TARGETGROUP
+			opts.Filter.TargetGroupID = c.String("target-group")
+		case c.String("access-rule") != "":
+			opts.Kind = types.ACCESSRULE
+			opts.Filter.AccessRuleID = c.String("access-rule")
+		default:
+			return errors.New("one of --user, --target-group, --target-field or --access-rule is required")
+		}
+
+		job, items, err := svc.CreateJob(ctx, gdeployActor, opts)
+		if err != nil {
+			return err
+		}
+		printBulkRevokeItems(items)
+		if job.DryRun {
+			clio.Infof("Dry run: %d items would be revoked or cancelled", job.Total)
+			return nil
+		}
+		clio.Successf("Created bulk revoke job %s for %d items, run 'gdeploy grants bulk-revoke status %s' to check its progress", job.ID, job.Total, job.ID)
+		return nil
+	},
+}
+
+var bulkRevokeStatusCommand = cli.Command{
+	Name:      "status",
+	Usage:     "Show the progress and per-item results of a bulk revoke job",
+	ArgsUsage: "<job id>",
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+		jobID := c.Args().First()
+		if jobID == "" {
+			return cli.ShowSubcommandHelp(c)
+		}
+		_, db, err := newBulkRevokeService(ctx)
+		if err != nil {
+			return err
+		}
+		q := storage.GetBulkRevokeJob{ID: jobID}
+		_, err = db.Query(ctx, &q)
+		if err == ddb.ErrNoItems {
+			return fmt.Errorf("bulk revoke job %s not found", jobID)
+		}
+		if err != nil {
+			return err
+		}
+		items := storage.ListBulkRevokeJobItems{JobID: jobID}
+		err = db.All(ctx, &items)
+		if err != nil {
+			return err
+		}
+		printBulkRevokeItems(items.Result)
+		job := q.Result
+		clio.Infof("Job %s is %s: %d/%d processed, %d succeeded, %d skipped, %d failed", job.ID, job.Status, job.Processed, job.Total, job.Succeeded, job.Skipped, job.Failed)
+		return nil
+	},
+}
+
+var bulkRevokeReindexCommand = cli.Command{
+	Name:  "reindex",
+	Usage: "Index active and pending requests made before upgrading, so that they're found by --access-rule and --target-group jobs",
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+		svc, _, err := newBulkRevokeService(ctx)
+		if err != nil {
+			return err
+		}
+		updated, err := svc.Reindex(ctx)
+		if err != nil {
+			return err
+		}
+		clio.Successf("Reindexed %d access groups and targets", updated)
+		return nil
+	},
+}
+
+func printBulkRevokeItems(items []access.BulkRevokeJobItem) {
+	if len(items) == 0 {
+		clio.Info("No requests or grants matched")
+		return
+	}
+	table := tablewriter.NewWriter(os.Stderr)
+	table.SetHeader([]string{"#", "Action", "Request", "Target", "Requested By", "Status", "Error"})
+	for i, item := range items {
+		table.Append([]string{
+			strconv.Itoa(i + 1),
+			string(item.Action),
+			item.RequestID,
+			item.TargetID,
+			item.RequestedBy.Email,
+			string(item.Status),
+			item.Error,
+		})
+	}
+	table.Render()
+}
+
+// resolveUserID allows users to be given by their email address.
+func resolveUserID(ctx context.Context, db ddb.Storage, user string) (string, error) {
+	if !strings.Contains(user, "@") {
+		return user, nil
+	}
+	q := storage.GetUserByEmail{Email: user}
+	_, err := db.Query(ctx, &q)
+	if err == ddb.ErrNoItems {
+		return "", fmt.Errorf("user %s not found", user)
+	}
+	if err != nil {
+		return "", err
+	}
+	return q.Result.ID, nil
+}
+
+func newBulkRevokeService(ctx context.Context) (*bulkrevokesvc.Service, ddb.Storage, error) {
+	dc, err := deploy.ConfigFromContext(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	o, err := dc.LoadOutput(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	cfg, err := cfaws.ConfigFromContextOrDefault(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	ddbClient, err := ddb.New(ctx, o.DynamoDBTable, ddb.WithDynamoDBClient(dynamodb.NewFromConfig(cfg)))
+	if err != nil {
+		return nil, nil, err
+	}
+	// status changes to requests, groups and targets are written with conditional writes
+	db := storage.NewStatusGuard(ddbClient)
+	eventBus, err := gevent.NewSender(ctx, gevent.SenderOpts{EventBusARN: o.EventBusArn})
+	if err != nil {
+		return nil, nil, err
+	}
+	return &bulkrevokesvc.Service{
+		Clock:    clock.New(),
+		DB:       db,
+		Eventbus: eventBus,
+	}, db, nil
+}
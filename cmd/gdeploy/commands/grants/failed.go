@@ -0,0 +1,149 @@
+package grants
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/clio"
+	"github.com/common-fate/common-fate/pkg/cfaws"
+	"github.com/common-fate/common-fate/pkg/deploy"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/service/accesssvc"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli/v2"
+)
+
+var failedCommand = cli.Command{
+	Name:        "failed",
+	Description: "List, retry or force-complete grants which failed after all retries were exhausted",
+	Usage:       "List, retry or force-complete grants which failed after all retries were exhausted",
+	Action:      cli.ShowSubcommandHelp,
+	Subcommands: []*cli.Command{
+		&listCommand,
+		&retryCommand,
+		&completeCommand,
+	},
+}
+
+var listCommand = cli.Command{
+	Name:  "list",
+	Usage: "List failed grants, with failed deactivations first",
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+		svc, err := newAccessService(ctx)
+		if err != nil {
+			return err
+		}
+		failed, err := svc.ListFailedGrants(ctx)
+		if err != nil {
+			return err
+		}
+		if len(failed) == 0 {
+			clio.Info("There are no failed grants")
+			return nil
+		}
+
+		table := tablewriter.NewWriter(os.Stderr)
+		table.SetHeader([]string{"Grant", "Request", "Action", "Priority", "Attempts", "Failed", "Reason"})
+		now := time.Now()
+		for _, f := range failed {
+			table.Append([]string{
+				f.GrantID,
+				f.RequestID,
+				string(f.Action),
+				string(f.Priority),
+				strconv.Itoa(f.Attempts),
+				now.Sub(f.CreatedAt).Round(time.Second).String() + " ago",
+				f.Reason,
+			})
+		}
+		table.Render()
+		return nil
+	},
+}
+
+var retryCommand = cli.Command{
+	Name:      "retry",
+	Usage:     "Retry a failed grant",
+	ArgsUsage: "<grant id>",
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+		grantID := c.Args().First()
+		if grantID == "" {
+			return cli.ShowSubcommandHelp(c)
+		}
+		svc, err := newAccessService(ctx)
+		if err != nil {
+			return err
+		}
+		err = svc.RetryFailedGrant(ctx, gdeployActor, grantID)
+		if err != nil {
+			return err
+		}
+		clio.Successf("Requested a retry for grant %s, run 'gdeploy grants failed list' to check whether it failed again", grantID)
+		return nil
+	},
+}
+
+var completeCommand = cli.Command{
+	Name:      "complete",
+	Usage:     "Force-complete a failed grant without calling the provider, once access has been removed manually",
+	ArgsUsage: "<grant id>",
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+		grantID := c.Args().First()
+		if grantID == "" {
+			return cli.ShowSubcommandHelp(c)
+		}
+		svc, err := newAccessService(ctx)
+		if err != nil {
+			return err
+		}
+		err = svc.CompleteFailedGrant(ctx, gdeployActor, grantID)
+		if err != nil {
+			return err
+		}
+		clio.Successf("Completed failed grant %s", grantID)
+		return nil
+	},
+}
+
+// gdeployActor is recorded in the request history for actions taken through gdeploy.
+var gdeployActor = identity.User{ID: "gdeploy", Email: "gdeploy"}
+
+func newAccessService(ctx context.Context) (*accesssvc.Service, error) {
+	dc, err := deploy.ConfigFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	o, err := dc.LoadOutput(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := cfaws.ConfigFromContextOrDefault(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ddbClient, err := ddb.New(ctx, o.DynamoDBTable, ddb.WithDynamoDBClient(dynamodb.NewFromConfig(cfg)))
+	if err != nil {
+		return nil, err
+	}
+	// status changes to requests, groups and targets are written with conditional writes
+	db := storage.NewStatusGuard(ddbClient)
+	eventBus, err := gevent.NewSender(ctx, gevent.SenderOpts{EventBusARN: o.EventBusArn})
+	if err != nil {
+		return nil, err
+	}
+	return &accesssvc.Service{
+		Clock:       clock.New(),
+		DB:          db,
+		EventPutter: eventBus,
+	}, nil
+}
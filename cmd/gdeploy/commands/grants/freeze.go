@@ -0,0 +1,167 @@
+package grants
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/clio"
+	"github.com/common-fate/common-fate/pkg/cfaws"
+	"github.com/common-fate/common-fate/pkg/deploy"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/service/freezesvc"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli/v2"
+)
+
+var freezeCommand = cli.Command{
+	Name:        "freeze",
+	Description: "Freeze access during a change freeze or an incident",
+	Usage:       "Freeze access during a change freeze or an incident",
+	Action:      cli.ShowSubcommandHelp,
+	Subcommands: []*cli.Command{
+		&freezeCreateCommand,
+		&freezeListCommand,
+		&freezeLiftCommand,
+	},
+}
+
+var freezeCreateCommand = cli.Command{
+	Name:  "create",
+	Usage: "Freeze access. Access is frozen globally unless --target-group or --access-rule is given",
+	Flags: []cli.Flag{
+		&cli.StringFlag{Name: "message", Usage: "the reason for the freeze, shown to users whose requests are refused", Required: true},
+		&cli.StringFlag{Name: "target-group", Usage: "only freeze access to this target group"},
+		&cli.StringFlag{Name: "access-rule", Usage: "only freeze access through this access rule"},
+		&cli.DurationFlag{Name: "duration", Usage: "end the freeze automatically after this duration, for example 2h. Freezes without a duration stay active until they are lifted"},
+		&cli.StringSliceFlag{Name: "exempt-group", Usage: "users in this group are not affected by the freeze, for example a break-glass group. Can be repeated"},
+	},
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+		svc, err := newFreezeService(ctx)
+		if err != nil {
+			return err
+		}
+		opts := freezesvc.CreateFreezeOpts{
+			Scope:        types.FreezeScopeGLOBAL,
+			Message:      c.String("message"),
+			ExemptGroups: c.StringSlice("exempt-group"),
+		}
+		switch {
+		case c.String("target-group") != "" && c.String("access-rule") != "":
+			return errors.New("only one of --target-group or --access-rule can be given")
+		case c.String("target-group") != "":
+			opts.Scope = types.FreezeScopeTARGETGROUP
+			opts.ScopeID = c.String("target-group")
+		case c.String("access-rule") != "":
+			opts.Scope = types.FreezeScopeACCESSRULE
+			opts.ScopeID = c.String("access-rule")
+		}
+		if d := c.Duration("duration"); d > 0 {
+			endsAt := time.Now().Add(d)
+			opts.EndsAt = &endsAt
+		}
+		freeze, err := svc.Create(ctx, gdeployActor, opts)
+		if err != nil {
+			return err
+		}
+		clio.Successf("Created freeze %s, run 'gdeploy grants freeze lift %s' to lift it", freeze.ID, freeze.ID)
+		return nil
+	},
+}
+
+var freezeListCommand = cli.Command{
+	Name:  "list",
+	Usage: "List access freezes",
+	Flags: []cli.Flag{
+		&cli.BoolFlag{Name: "all", Usage: "include lifted and expired freezes"},
+	},
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+		svc, err := newFreezeService(ctx)
+		if err != nil {
+			return err
+		}
+		freezes, err := svc.List(ctx)
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		table := tablewriter.NewWriter(os.Stderr)
+		table.SetHeader([]string{"ID", "Scope", "Scope ID", "Message", "Ends At", "Exempt Groups", "Active"})
+		for _, f := range freezes {
+			active := f.Active(now)
+			if !active && !c.Bool("all") {
+				continue
+			}
+			endsAt := ""
+			if f.EndsAt != nil {
+				endsAt = f.EndsAt.Format(time.RFC3339)
+			}
+			activeText := "no"
+			if active {
+				activeText = "yes"
+			}
+			table.Append([]string{f.ID, string(f.Scope), f.ScopeID, f.Message, endsAt, strings.Join(f.ExemptGroups, ", "), activeText})
+		}
+		table.Render()
+		return nil
+	},
+}
+
+var freezeLiftCommand = cli.Command{
+	Name:      "lift",
+	Usage:     "Lift an access freeze and release any activations which it held",
+	ArgsUsage: "<freeze id>",
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+		freezeID := c.Args().First()
+		if freezeID == "" {
+			return cli.ShowSubcommandHelp(c)
+		}
+		svc, err := newFreezeService(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = svc.Lift(ctx, gdeployActor, freezeID)
+		if err != nil {
+			return err
+		}
+		clio.Successf("Lifted freeze %s", freezeID)
+		return nil
+	},
+}
+
+func newFreezeService(ctx context.Context) (*freezesvc.Service, error) {
+	dc, err := deploy.ConfigFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	o, err := dc.LoadOutput(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := cfaws.ConfigFromContextOrDefault(ctx)
+	if err != nil {
+		return nil, err
+	}
+	db, err := ddb.New(ctx, o.DynamoDBTable, ddb.WithDynamoDBClient(dynamodb.NewFromConfig(cfg)))
+	if err != nil {
+		return nil, err
+	}
+	eventBus, err := gevent.NewSender(ctx, gevent.SenderOpts{EventBusARN: o.EventBusArn})
+	if err != nil {
+		return nil, err
+	}
+	return &freezesvc.Service{
+		Clock:    clock.New(),
+		DB:       db,
+		Eventbus: eventBus,
+	}, nil
+}
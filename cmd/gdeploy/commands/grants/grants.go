@@ -0,0 +1,17 @@
+package grants
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+var Command = cli.Command{
+	Name:        "grants",
+	Description: "Manage failed grants, revoke access in bulk and freeze access",
+	Usage:       "Manage failed grants, revoke access in bulk and freeze access",
+	Action:      cli.ShowSubcommandHelp,
+	Subcommands: []*cli.Command{
+		&failedCommand,
+		&bulkRevokeCommand,
+		&freezeCommand,
+	},
+}
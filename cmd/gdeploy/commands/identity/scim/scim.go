@@ -0,0 +1,110 @@
+package scim
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/common-fate/clio"
+	"github.com/common-fate/common-fate/pkg/deploy"
+	"github.com/common-fate/common-fate/pkg/gconfig"
+	"github.com/urfave/cli/v2"
+)
+
+var SCIMCommand = cli.Command{
+	Name:        "scim",
+	Description: "Configure the SCIM API, which identity providers can use to provision users and groups without waiting for identity sync",
+	Usage:       "Configure SCIM provisioning",
+	Subcommands: []*cli.Command{&enableCommand, &disableCommand},
+	Action:      cli.ShowSubcommandHelp,
+}
+
+var enableCommand = cli.Command{
+	Name:        "enable",
+	Description: "Generate a bearer token for the SCIM API and store it in SSM Parameter Store. Running this again rotates the token.",
+	Usage:       "Enable SCIM provisioning",
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+		f := c.Path("file")
+		dc, err := deploy.ConfigFromContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		if dc.Deployment.Parameters.SCIMToken != "" {
+			rotate := false
+			err = survey.AskOne(&survey.Confirm{Message: "SCIM is already enabled, do you want to rotate the token? SCIM clients will need to be updated with the new token"}, &rotate)
+			if err != nil {
+				return err
+			}
+			if !rotate {
+				return nil
+			}
+		}
+
+		b := make([]byte, 32)
+		_, err = rand.Read(b)
+		if err != nil {
+			return err
+		}
+		token := base64.RawURLEncoding.EncodeToString(b)
+
+		var value gconfig.SecretStringValue
+		cfg := gconfig.Config{
+			gconfig.SecretStringField("scimToken", &value, "the SCIM bearer token", gconfig.WithNoArgs("/granted/secrets/identity/scim/token")),
+		}
+		field, err := cfg.FindFieldByKey("scimToken")
+		if err != nil {
+			return err
+		}
+		err = field.Set(token)
+		if err != nil {
+			return err
+		}
+		dumped, err := cfg.Dump(ctx, gconfig.SSMDumper{Suffix: dc.Deployment.Parameters.DeploymentSuffix})
+		if err != nil {
+			return err
+		}
+		dc.Deployment.Parameters.SCIMToken = dumped["scimToken"]
+		err = dc.Save(f)
+		if err != nil {
+			return err
+		}
+
+		o, err := dc.LoadOutput(ctx)
+		if err != nil {
+			return err
+		}
+		clio.Success("Enabled SCIM provisioning, the token has been stored in SSM Parameter Store")
+		clio.Infof("SCIM base URL: %s/scim/v2", strings.TrimSuffix(o.APIURL, "/"))
+		clio.Infof("SCIM bearer token: %s", token)
+		clio.Warn("Copy the token into your identity provider now, it won't be shown again. Run 'gdeploy update' to apply the changes to your deployment.")
+		return nil
+	},
+}
+
+var disableCommand = cli.Command{
+	Name:        "disable",
+	Description: "Disable the SCIM API. Users and groups which were provisioned through SCIM are kept.",
+	Usage:       "Disable SCIM provisioning",
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+		f := c.Path("file")
+		dc, err := deploy.ConfigFromContext(ctx)
+		if err != nil {
+			return err
+		}
+		if dc.Deployment.Parameters.SCIMToken == "" {
+			clio.Info("SCIM isn't enabled so this command will not make any changes.")
+			return nil
+		}
+		dc.Deployment.Parameters.SCIMToken = ""
+		err = dc.Save(f)
+		if err != nil {
+			return err
+		}
+		clio.Success("Disabled SCIM provisioning. Run 'gdeploy update' to apply the changes to your deployment.")
+		return nil
+	},
+}
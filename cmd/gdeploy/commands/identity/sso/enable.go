@@ -104,6 +104,12 @@ func updateOrAddSSO(c *cli.Context, idpType string) error {
 	if err != nil {
 		return err
 	}
+	// the identity source settings aren't part of the identity provider's config, so they are carried over
+	for _, key := range identitysync.SourceKeys {
+		if v, ok := currentConfig[key]; ok {
+			newConfig[key] = v
+		}
+	}
 	dc.Deployment.Parameters.IdentityConfiguration.Upsert(idpType, newConfig)
 
 	clio.Info("The following parameters are required to setup a SAML app in your identity provider")
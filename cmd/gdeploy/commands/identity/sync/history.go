@@ -0,0 +1,75 @@
+package sync
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/common-fate/clio"
+	"github.com/common-fate/common-fate/pkg/cfaws"
+	"github.com/common-fate/common-fate/pkg/deploy"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli/v2"
+)
+
+var HistoryCommand = cli.Command{
+	Name:  "history",
+	Usage: "Show the most recent user and group syncs and the changes they made",
+	Flags: []cli.Flag{
+		&cli.IntFlag{Name: "count", Value: 20, Usage: "the number of syncs to show"},
+	},
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+
+		dc, err := deploy.ConfigFromContext(ctx)
+		if err != nil {
+			return err
+		}
+		o, err := dc.LoadOutput(ctx)
+		if err != nil {
+			return err
+		}
+		cfg, err := cfaws.ConfigFromContextOrDefault(ctx)
+		if err != nil {
+			return err
+		}
+		db, err := ddb.New(ctx, o.DynamoDBTable, ddb.WithDynamoDBClient(dynamodb.NewFromConfig(cfg)))
+		if err != nil {
+			return err
+		}
+
+		runs := storage.ListIdentitySyncRuns{}
+		_, err = db.Query(ctx, &runs, ddb.Limit(int32(c.Int("count"))))
+		if err != nil {
+			return err
+		}
+
+		table := tablewriter.NewWriter(os.Stderr)
+		table.SetHeader([]string{"Started", "Identity Provider", "Status", "Dry Run", "Users (+/~/-)", "Groups (+/~/-)", "Error"})
+		for _, r := range runs.Result {
+			errMsg := ""
+			if r.Error != nil {
+				errMsg = *r.Error
+			}
+			table.Append([]string{
+				r.StartedAt.Local().Format(time.RFC1123),
+				r.IdentityProvider,
+				string(r.Status),
+				strconv.FormatBool(r.DryRun),
+				fmt.Sprintf("%d/%d/%d", r.Changes.UsersCreated, r.Changes.UsersUpdated, r.Changes.UsersArchived),
+				fmt.Sprintf("%d/%d/%d", r.Changes.GroupsCreated, r.Changes.GroupsUpdated, r.Changes.GroupsArchived),
+				errMsg,
+			})
+		}
+		table.Render()
+
+		if len(runs.Result) == 0 {
+			clio.Info("There are no recorded syncs")
+		}
+		return nil
+	},
+}
@@ -14,11 +14,19 @@ import (
 	"github.com/common-fate/common-fate/pkg/cfaws"
 	"github.com/common-fate/common-fate/pkg/deploy"
 	"github.com/common-fate/common-fate/pkg/identity/identitysync"
+	apitypes "github.com/common-fate/common-fate/pkg/types"
+	"github.com/olekukonko/tablewriter"
 	"github.com/urfave/cli/v2"
 )
 
 var SyncCommand = cli.Command{
-	Name: "sync",
+	Name:  "sync",
+	Usage: "Sync users and groups from your identity provider",
+	Flags: []cli.Flag{
+		&cli.BoolFlag{Name: "dry-run", Usage: "show the users and groups which would be created, updated and archived, without applying the changes"},
+		&cli.BoolFlag{Name: "force", Usage: "apply the changes even if more users or groups would be archived than the archive threshold allows"},
+	},
+	Subcommands: []*cli.Command{&HistoryCommand},
 	Action: func(c *cli.Context) error {
 		ctx := c.Context
 
@@ -44,11 +52,18 @@ var SyncCommand = cli.Command{
 		si.Writer = os.Stderr
 		si.Start()
 
+		payload, err := json.Marshal(map[string]bool{
+			"dryRun": c.Bool("dry-run"),
+			"force":  c.Bool("force"),
+		})
+		if err != nil {
+			return err
+		}
 		lambdaClient := lambda.NewFromConfig(cfg)
 		res, err := lambdaClient.Invoke(ctx, &lambda.InvokeInput{
 			FunctionName:   &o.IDPSyncFunctionName,
 			InvocationType: types.InvocationTypeRequestResponse,
-			Payload:        []byte("{}"),
+			Payload:        payload,
 		})
 		si.Stop()
 		if err != nil {
@@ -60,15 +75,58 @@ var SyncCommand = cli.Command{
 		}
 		clio.Debugf("idp sync lamda invoke response: %s", string(b))
 		if res.FunctionError != nil {
-			return fmt.Errorf("user and group sync failed with lambda execution error: %s", *res.FunctionError)
-		} else if res.StatusCode == 200 {
-			idp := dc.Deployment.Parameters.IdentityProviderType
-			if idp == "" {
-				idp = identitysync.IDPTypeCognito
+			var lambdaErr struct {
+				ErrorMessage string `json:"errorMessage"`
 			}
-			clio.Successf("Successfully synced users and groups using %s", idp)
-		} else {
+			_ = json.Unmarshal(res.Payload, &lambdaErr)
+			return fmt.Errorf("user and group sync failed with lambda execution error: %s: %s", *res.FunctionError, lambdaErr.ErrorMessage)
+		} else if res.StatusCode != 200 {
 			return fmt.Errorf("user and group sync failed with lambda invoke status code: %d", res.StatusCode)
 		}
+
+		var report apitypes.IdentitySyncReport
+		err = json.Unmarshal(res.Payload, &report)
+		if err != nil {
+			return err
+		}
+		idp := dc.Deployment.Parameters.IdentityProviderType
+		if idp == "" {
+			idp = identitysync.IDPTypeCognito
+		}
+		changes := report.Run.Changes
+		if report.Run.DryRun {
+			printChanges(report)
+			clio.Infof("Dry run: %d users and %d groups would be created, %d users and %d groups would be updated and %d users and %d groups would be archived",
+				changes.UsersCreated, changes.GroupsCreated, changes.UsersUpdated, changes.GroupsUpdated, changes.UsersArchived, changes.GroupsArchived)
+			if report.Run.Status == apitypes.IdentitySyncRunStatusABORTED && report.Run.Error != nil {
+				clio.Warn(*report.Run.Error)
+			}
+			return nil
+		}
+		clio.Successf("Successfully synced users and groups using %s: %d users and %d groups created, %d users and %d groups updated, %d users and %d groups archived",
+			idp, changes.UsersCreated, changes.GroupsCreated, changes.UsersUpdated, changes.GroupsUpdated, changes.UsersArchived, changes.GroupsArchived)
 		return nil
 	}}
+
+// printChanges prints a table of the users and groups which are changed by a sync.
+func printChanges(report apitypes.IdentitySyncReport) {
+	table := tablewriter.NewWriter(os.Stderr)
+	table.SetHeader([]string{"Change", "Type", "Name", "ID"})
+	for _, c := range []struct {
+		change string
+		users  []apitypes.User
+		groups []apitypes.Group
+	}{
+		{"create", report.Users.Created, report.Groups.Created},
+		{"update", report.Users.Updated, report.Groups.Updated},
+		{"archive", report.Users.Archived, report.Groups.Archived},
+	} {
+		for _, u := range c.users {
+			table.Append([]string{c.change, "user", u.Email, u.Id})
+		}
+		for _, g := range c.groups {
+			table.Append([]string{c.change, "group", g.Name, g.Id})
+		}
+	}
+	table.Render()
+}
@@ -15,15 +15,17 @@ var Command = cli.Command{
 // These output names are defined in the CDK stack
 // the services names are defined here for this CLI command, and may be different in other usages
 var ServiceLogGroupNameMap = map[string]string{
-	"api":               "APILogGroupName",
-	"idp-sync":          "IDPSyncLogGroupName",
-	"events-concurrent": "EventsHandlerConcurrentLogGroupName",
-	"events-sequential": "EventsHandlerSequentialLogGroupName",
-	"slack-notifier":    "SlackNotifierLogGroupName",
-	"webhook":           "WebhookLogGroupName",
-	"cache-sync":        "CacheSyncLogGroupName",
-	"healthcheck":       "HealthcheckLogGroupName",
-	"granter":           "GranterLogGroupName",
+	"api":                "APILogGroupName",
+	"idp-sync":           "IDPSyncLogGroupName",
+	"events-concurrent":  "EventsHandlerConcurrentLogGroupName",
+	"events-sequential":  "EventsHandlerSequentialLogGroupName",
+	"slack-notifier":     "SlackNotifierLogGroupName",
+	"webhook":            "WebhookLogGroupName",
+	"cache-sync":         "CacheSyncLogGroupName",
+	"healthcheck":        "HealthcheckLogGroupName",
+	"drift-reconciler":   "DriftReconcilerLogGroupName",
+	"activation-expirer": "ActivationExpirerLogGroupName",
+	"granter":            "GranterLogGroupName",
 }
 
 // the services names are defined here for this CLI command, and may be different in other usages
@@ -37,4 +39,6 @@ var ServiceNames = []string{
 	"webhook",
 	"cache-sync",
 	"healthcheck",
+	"drift-reconciler",
+	"activation-expirer",
 }
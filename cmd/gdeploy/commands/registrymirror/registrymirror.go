@@ -0,0 +1,108 @@
+package registrymirror
+
+import (
+	"errors"
+	"os"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/common-fate/clio"
+	"github.com/common-fate/clio/clierr"
+	"github.com/common-fate/common-fate/internal/build"
+	"github.com/common-fate/common-fate/pkg/cfaws"
+	"github.com/common-fate/common-fate/pkg/registrymirror"
+	"github.com/common-fate/provider-registry-sdk-go/pkg/providerregistrysdk"
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli/v2"
+)
+
+var Command = cli.Command{
+	Name:        "registry-mirror",
+	Description: "Manage a self-hosted mirror of the provider registry, for deployments which cannot reach the public registry",
+	Usage:       "Manage a self-hosted mirror of the provider registry",
+	Action:      cli.ShowSubcommandHelp,
+	Subcommands: []*cli.Command{
+		&SyncCommand,
+		&ListCommand,
+	},
+}
+
+var storeFlags = []cli.Flag{
+	&cli.PathFlag{Name: "dir", Usage: "A local directory containing the mirror"},
+	&cli.StringFlag{Name: "bucket", Usage: "An S3 bucket containing the mirror"},
+	&cli.StringFlag{Name: "prefix", Usage: "The key prefix of the mirror in the S3 bucket"},
+}
+
+var SyncCommand = cli.Command{
+	Name:        "sync",
+	Description: "Copy providers from the public provider registry into the mirror",
+	Usage:       "Copy providers from the public provider registry into the mirror",
+	Flags: append([]cli.Flag{
+		&cli.StringSliceFlag{Name: "provider", Aliases: []string{"p"}, Usage: "The provider to sync, in the format publisher/name@version. May be specified multiple times", Required: true},
+		&cli.StringFlag{Name: "registry-api-url", Value: build.ProviderRegistryAPIURL, EnvVars: []string{"COMMONFATE_PROVIDER_REGISTRY_API_URL"}, Hidden: true},
+	}, storeFlags...),
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+		store, err := storeFromFlags(c)
+		if err != nil {
+			return err
+		}
+		upstream, err := providerregistrysdk.NewClientWithResponses(c.String("registry-api-url"))
+		if err != nil {
+			return err
+		}
+		for _, in := range c.StringSlice("provider") {
+			p, err := providerregistrysdk.ParseProvider(in)
+			if err != nil {
+				return clierr.New(err.Error(), clierr.Info("Providers must be in the format publisher/name@version, for example common-fate/aws@v0.4.0"))
+			}
+			err = registrymirror.Sync(ctx, upstream, store, p)
+			if err != nil {
+				return err
+			}
+			clio.Successf("Synced %s to the registry mirror", in)
+		}
+		return nil
+	},
+}
+
+var ListCommand = cli.Command{
+	Name:        "list",
+	Description: "List the providers in the mirror",
+	Usage:       "List the providers in the mirror",
+	Flags:       storeFlags,
+	Action: func(c *cli.Context) error {
+		store, err := storeFromFlags(c)
+		if err != nil {
+			return err
+		}
+		providers, err := registrymirror.ListProviders(c.Context, store)
+		if err != nil {
+			return err
+		}
+		if len(providers) == 0 {
+			clio.Info("There are no providers in the registry mirror")
+			return nil
+		}
+		table := tablewriter.NewWriter(os.Stderr)
+		table.SetHeader([]string{"Publisher", "Name", "Version"})
+		for _, p := range providers {
+			table.Append([]string{p.Publisher, p.Name, p.Version})
+		}
+		table.Render()
+		return nil
+	},
+}
+
+func storeFromFlags(c *cli.Context) (registrymirror.Store, error) {
+	if dir := c.Path("dir"); dir != "" {
+		return registrymirror.DirStore{Root: dir}, nil
+	}
+	if bucket := c.String("bucket"); bucket != "" {
+		cfg, err := cfaws.ConfigFromContextOrDefault(c.Context)
+		if err != nil {
+			return nil, err
+		}
+		return registrymirror.S3Store{Client: s3.NewFromConfig(cfg), Bucket: bucket, Prefix: c.String("prefix")}, nil
+	}
+	return nil, errors.New("one of --dir or --bucket must be provided")
+}
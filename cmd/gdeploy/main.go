@@ -9,9 +9,12 @@ import (
 	"github.com/common-fate/common-fate/cmd/gdeploy/commands/backup"
 	"github.com/common-fate/common-fate/cmd/gdeploy/commands/cache"
 	"github.com/common-fate/common-fate/cmd/gdeploy/commands/dashboard"
+	"github.com/common-fate/common-fate/cmd/gdeploy/commands/events"
+	"github.com/common-fate/common-fate/cmd/gdeploy/commands/grants"
 	"github.com/common-fate/common-fate/cmd/gdeploy/commands/identity"
 	"github.com/common-fate/common-fate/cmd/gdeploy/commands/logs"
 	"github.com/common-fate/common-fate/cmd/gdeploy/commands/notifications"
+	"github.com/common-fate/common-fate/cmd/gdeploy/commands/registrymirror"
 	"github.com/common-fate/common-fate/cmd/gdeploy/commands/release"
 	"github.com/common-fate/common-fate/cmd/gdeploy/commands/restore"
 	mw "github.com/common-fate/common-fate/cmd/gdeploy/middleware"
@@ -57,6 +60,9 @@ func main() {
 			mw.WithBeforeFuncs(&notifications.Command, mw.RequireDeploymentConfig(), mw.VerifyGDeployCompatibility(), mw.RequireAWSCredentials()),
 			mw.WithBeforeFuncs(&dashboard.Command, mw.RequireDeploymentConfig(), mw.VerifyGDeployCompatibility(), mw.RequireAWSCredentials()),
 			mw.WithBeforeFuncs(&cache.Command, mw.RequireDeploymentConfig(), mw.RequireAWSCredentials()),
+			mw.WithBeforeFuncs(&grants.Command, mw.RequireDeploymentConfig(), mw.VerifyGDeployCompatibility(), mw.RequireAWSCredentials()),
+			mw.WithBeforeFuncs(&events.Command, mw.RequireDeploymentConfig(), mw.VerifyGDeployCompatibility(), mw.RequireAWSCredentials()),
+			&registrymirror.Command,
 			mw.WithBeforeFuncs(&commands.InitCommand, mw.RequireAWSCredentials()),
 			mw.WithBeforeFuncs(&release.Command, mw.RequireDeploymentConfig()),
 		},
@@ -4,9 +4,11 @@ import (
 	"context"
 
 	"github.com/aws/aws-lambda-go/lambda"
+	"github.com/benbjohnson/clock"
 	"github.com/common-fate/apikit/logger"
 	"github.com/common-fate/common-fate/pkg/cachesync"
 	"github.com/common-fate/common-fate/pkg/config"
+	"github.com/common-fate/common-fate/pkg/gevent"
 	"github.com/common-fate/common-fate/pkg/service/cachesvc"
 	"github.com/common-fate/common-fate/pkg/service/requestroutersvc"
 	"github.com/common-fate/ddb"
@@ -29,6 +31,16 @@ func main() {
 		panic(err)
 	}
 
+	var eventBus gevent.EventPutter
+	if cfg.EventBusArn != "" {
+		eventBus, err = gevent.NewSender(ctx, gevent.SenderOpts{
+			EventBusARN: cfg.EventBusArn,
+		})
+		if err != nil {
+			panic(err)
+		}
+	}
+
 	syncer := cachesync.CacheSyncer{
 		DB: db,
 		Cache: cachesvc.Service{
@@ -36,6 +48,9 @@ func main() {
 			RequestRouter: &requestroutersvc.Service{
 				DB: db,
 			},
+			Clock:          clock.New(),
+			Eventbus:       eventBus,
+			StaleThreshold: cfg.StaleThreshold,
 		},
 	}
 	log, err := logger.Build(cfg.LogLevel)
@@ -44,5 +59,5 @@ func main() {
 	}
 	zap.ReplaceGlobals(log.Desugar())
 	zap.S().Infow("starting cache sync", "config", cfg)
-	lambda.Start(syncer.Sync)
+	lambda.Start(syncer.Handle)
 }
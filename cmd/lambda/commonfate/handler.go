@@ -66,11 +66,20 @@ func buildHandler() (*Lambda, error) {
 		IdpType:             cfg.IdpProvider,
 		IdentityConfig:      ic,
 		IdentityGroupFilter: cfg.IdentityGroupFilter,
+		ArchiveThreshold:    cfg.IdentitySyncArchiveThreshold,
+		EventBusArn:         cfg.EventBusArn,
+		ResolveNestedGroups: cfg.IdentityResolveNestedGroups,
+		DeprovisioningMode:  cfg.IdentityDeprovisioningMode,
 	})
 	if err != nil {
 		return nil, err
 	}
-	registryClient, err := providerregistrysdk.NewClientWithResponses(build.ProviderRegistryAPIURL)
+	registryURL := build.ProviderRegistryAPIURL
+	if cfg.ProviderRegistryAPIURL != "" {
+		// use a self-hosted registry mirror, for deployments which cannot reach the public registry
+		registryURL = cfg.ProviderRegistryAPIURL
+	}
+	registryClient, err := providerregistrysdk.NewClientWithResponses(registryURL)
 	if err != nil {
 		return nil, err
 	}
@@ -88,6 +97,9 @@ func buildHandler() (*Lambda, error) {
 		DeploymentConfig:       dc,
 		ProviderRegistryClient: registryClient,
 		FrontendURL:            cfg.FrontendURL,
+		CacheStaleThreshold:    cfg.CacheStaleThreshold,
+		IdempotencyKeyTTL:      cfg.IdempotencyKeyTTL,
+		SCIMToken:              cfg.SCIMToken,
 	})
 	if err != nil {
 		return nil, err
@@ -98,6 +110,7 @@ func buildHandler() (*Lambda, error) {
 		Log:            log,
 		Authenticator:  auth,
 		IdentitySyncer: idsync,
+		SCIM:           api.SCIMHandler(),
 	}
 
 	s, err := server.New(ctx, srvconf, server.WithRequestIDMiddleware(requestIDMiddleware))
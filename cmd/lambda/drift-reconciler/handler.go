@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+
+	"github.com/aws/aws-lambda-go/lambda"
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/common-fate/pkg/config"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/service/driftsvc"
+	"github.com/common-fate/common-fate/pkg/service/requestroutersvc"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+	"github.com/sethvargo/go-envconfig"
+	"go.uber.org/zap"
+)
+
+func main() {
+	var cfg config.DriftReconcilerConfig
+	ctx := context.Background()
+
+	err := envconfig.Process(ctx, &cfg)
+	if err != nil {
+		panic(err)
+	}
+	ddbClient, err := ddb.New(ctx, cfg.TableName)
+	if err != nil {
+		panic(err)
+	}
+	// status changes to requests, groups and targets are written with conditional writes
+	db := storage.NewStatusGuard(ddbClient)
+
+	reconciler := driftsvc.Service{
+		DB: db,
+		RequestRouter: &requestroutersvc.Service{
+			DB: db,
+		},
+		RuntimeGetter: driftsvc.DefaultGetter{},
+		Clock:         clock.New(),
+		Lookback:      cfg.Lookback,
+	}
+	if cfg.EventBusArn != "" {
+		reconciler.Eventbus, err = gevent.NewSender(ctx, gevent.SenderOpts{
+			EventBusARN: cfg.EventBusArn,
+		})
+		if err != nil {
+			panic(err)
+		}
+	}
+	log, err := logger.Build(cfg.LogLevel)
+	if err != nil {
+		panic(err)
+	}
+	zap.ReplaceGlobals(log.Desugar())
+	zap.S().Infow("starting drift reconciler", "config", cfg)
+	lambda.Start(reconciler.Reconcile)
+}
@@ -3,17 +3,21 @@ package main
 import (
 	"context"
 
-	"github.com/aws/aws-lambda-go/lambda"
 	"github.com/benbjohnson/clock"
 
 	"github.com/common-fate/apikit/logger"
 	"github.com/common-fate/common-fate/pkg/config"
+	"github.com/common-fate/common-fate/pkg/deadletter"
 	"github.com/common-fate/common-fate/pkg/eventhandler"
 	"github.com/common-fate/common-fate/pkg/gevent"
 	"github.com/common-fate/common-fate/pkg/handler"
+	"github.com/common-fate/common-fate/pkg/service/accesssvc"
+	"github.com/common-fate/common-fate/pkg/service/bulkrevokesvc"
+	"github.com/common-fate/common-fate/pkg/service/freezesvc"
 	"github.com/common-fate/common-fate/pkg/service/requestroutersvc"
 	"github.com/common-fate/common-fate/pkg/service/workflowsvc"
 	"github.com/common-fate/common-fate/pkg/service/workflowsvc/runtimes/live"
+	"github.com/common-fate/common-fate/pkg/storage"
 	"github.com/common-fate/common-fate/pkg/targetgroupgranter"
 	"github.com/common-fate/provider-registry-sdk-go/pkg/handlerclient"
 
@@ -38,10 +42,12 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
-	db, err := ddb.New(ctx, cfg.DynamoTable)
+	ddbClient, err := ddb.New(ctx, cfg.DynamoTable)
 	if err != nil {
 		panic(err)
 	}
+	// status changes to requests, groups and targets are written with conditional writes
+	db := storage.NewStatusGuard(ddbClient)
 	eb, err := gevent.NewSender(ctx, gevent.SenderOpts{
 		EventBusARN: cfg.EventBusArn,
 	})
@@ -51,6 +57,17 @@ func main() {
 	}
 
 	clk := clock.New()
+	retryPolicy := targetgroupgranter.RetryPolicyFromConfig(cfg.GrantRetry)
+	granter := &targetgroupgranter.Granter{
+		DB:          db,
+		EventPutter: eb,
+		RequestRouter: &requestroutersvc.Service{
+			DB: db,
+		},
+		RuntimeGetter: DefaultGetter{},
+		RetryPolicy:   &retryPolicy,
+		Freezes:       &freezesvc.Service{DB: db, Clock: clk},
+	}
 	eventHandler := eventhandler.EventHandler{
 		DB:       db,
 		Eventbus: eb,
@@ -65,14 +82,22 @@ func main() {
 				RequestRouter: &requestroutersvc.Service{
 					DB: db,
 				},
-				Granter: &targetgroupgranter.Granter{
-					DB:          db,
-					EventPutter: eb,
-					RequestRouter: &requestroutersvc.Service{
-						DB: db,
-					},
-					RuntimeGetter: DefaultGetter{},
-				},
+				Granter: granter,
+			},
+			Granter: granter,
+			Freezes: &freezesvc.Service{
+				DB:    db,
+				Clock: clk,
+			},
+		},
+		BulkRevoker: &bulkrevokesvc.Service{
+			DB:       db,
+			Clock:    clk,
+			Eventbus: eb,
+			Access: &accesssvc.Service{
+				DB:          db,
+				Clock:       clk,
+				EventPutter: eb,
 			},
 		},
 	}
@@ -82,5 +107,10 @@ func main() {
 	}
 	zap.ReplaceGlobals(log.Desugar())
 	zap.S().Infow("starting event handler with configuration", "config", cfg)
-	lambda.Start(eventHandler.HandleEvent)
+	// events which fail are kept in the dead letter store, so that they can be replayed once the cause is fixed
+	deadLetters := &deadletter.Recorder{DB: db, Clock: clk}
+	err = eb.Subscribe(ctx, gevent.EventHandlerSubscriber, deadLetters.Wrap(gevent.EventHandlerSubscriber, eventHandler.HandleEvent))
+	if err != nil {
+		panic(err)
+	}
 }
@@ -4,11 +4,13 @@ import (
 	"context"
 
 	"github.com/aws/aws-lambda-go/events"
-	"github.com/aws/aws-lambda-go/lambda"
+	"github.com/benbjohnson/clock"
 
 	"github.com/common-fate/apikit/logger"
 	"github.com/common-fate/common-fate/pkg/config"
+	"github.com/common-fate/common-fate/pkg/deadletter"
 	"github.com/common-fate/common-fate/pkg/deploy"
+	"github.com/common-fate/common-fate/pkg/gevent"
 	slacknotifier "github.com/common-fate/common-fate/pkg/notifiers/slack"
 	"github.com/common-fate/ddb"
 	"github.com/joho/godotenv"
@@ -41,7 +43,17 @@ func main() {
 		FrontendURL: cfg.FrontendURL,
 	}
 
-	lambda.Start(h.handleEvent)
+	// the notifier only receives events, so the sender doesn't need an event bus
+	eb, err := gevent.NewSender(ctx, gevent.SenderOpts{})
+	if err != nil {
+		panic(err)
+	}
+	// events which fail are kept in the dead letter store, so that they can be replayed once the cause is fixed
+	deadLetters := &deadletter.Recorder{DB: db, Clock: clock.New()}
+	err = eb.Subscribe(ctx, gevent.SlackNotifierSubscriber, deadLetters.Wrap(gevent.SlackNotifierSubscriber, h.handleEvent))
+	if err != nil {
+		panic(err)
+	}
 }
 
 type handler struct {
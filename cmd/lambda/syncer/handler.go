@@ -2,12 +2,14 @@ package main
 
 import (
 	"context"
+	"errors"
 
 	"github.com/aws/aws-lambda-go/lambda"
 	"github.com/common-fate/apikit/logger"
 	"github.com/common-fate/common-fate/pkg/config"
 	"github.com/common-fate/common-fate/pkg/deploy"
 	"github.com/common-fate/common-fate/pkg/identity/identitysync"
+	"github.com/common-fate/common-fate/pkg/types"
 	"github.com/joho/godotenv"
 	"github.com/sethvargo/go-envconfig"
 	"go.uber.org/zap"
@@ -34,6 +36,10 @@ func main() {
 		UserPoolId:          cfg.UserPoolId,
 		IdentityConfig:      ic,
 		IdentityGroupFilter: cfg.IdentityGroupFilter,
+		ArchiveThreshold:    cfg.ArchiveThreshold,
+		EventBusArn:         cfg.EventBusArn,
+		ResolveNestedGroups: cfg.ResolveNestedGroups,
+		DeprovisioningMode:  cfg.DeprovisioningMode,
 	})
 	if err != nil {
 		panic(err)
@@ -44,5 +50,27 @@ func main() {
 	}
 	zap.ReplaceGlobals(log.Desugar())
 	zap.S().Infow("starting sync", "config", ic, "idp.type", cfg.IdpProvider)
-	lambda.Start(syncer.Sync)
+	h := handler{syncer: syncer}
+	lambda.Start(h.Handle)
+}
+
+// Input is the payload of the syncer lambda. Scheduled invocations send an empty payload, which runs a normal sync.
+type Input struct {
+	DryRun bool `json:"dryRun"`
+	Force  bool `json:"force"`
+}
+
+type handler struct {
+	syncer *identitysync.IdentitySyncer
+}
+
+// Handle runs the sync and returns the report. Dry runs return the report even if the sync would be aborted.
+func (h *handler) Handle(ctx context.Context, in Input) (*types.IdentitySyncReport, error) {
+	report, err := h.syncer.Run(ctx, identitysync.RunOpts{DryRun: in.DryRun, Force: in.Force})
+	var thresholdErr *identitysync.ArchiveThresholdError
+	if err != nil && !(in.DryRun && errors.As(err, &thresholdErr)) {
+		return nil, err
+	}
+	res := report.ToAPI()
+	return &res, nil
 }
@@ -4,13 +4,16 @@ import (
 	"context"
 
 	"github.com/aws/aws-lambda-go/lambda"
+	"github.com/benbjohnson/clock"
 	"go.uber.org/zap"
 
 	"github.com/common-fate/apikit/logger"
 	"github.com/common-fate/common-fate/pkg/config"
 	"github.com/common-fate/common-fate/pkg/gevent"
 	"github.com/common-fate/common-fate/pkg/handler"
+	"github.com/common-fate/common-fate/pkg/service/freezesvc"
 	"github.com/common-fate/common-fate/pkg/service/requestroutersvc"
+	"github.com/common-fate/common-fate/pkg/storage"
 	"github.com/common-fate/common-fate/pkg/targetgroupgranter"
 	"github.com/common-fate/ddb"
 	"github.com/common-fate/provider-registry-sdk-go/pkg/handlerclient"
@@ -31,10 +34,12 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
-	db, err := ddb.New(ctx, cfg.DynamoTable)
+	ddbClient, err := ddb.New(ctx, cfg.DynamoTable)
 	if err != nil {
 		panic(err)
 	}
+	// status changes to requests, groups and targets are written with conditional writes
+	db := storage.NewStatusGuard(ddbClient)
 	eventBus, err := gevent.NewSender(ctx, gevent.SenderOpts{
 		EventBusARN: cfg.EventBusArn,
 	})
@@ -42,6 +47,7 @@ func main() {
 		panic(err)
 	}
 
+	retryPolicy := targetgroupgranter.RetryPolicyFromConfig(cfg.GrantRetry)
 	granter := targetgroupgranter.Granter{
 		DB: db,
 		RequestRouter: &requestroutersvc.Service{
@@ -49,6 +55,8 @@ func main() {
 		},
 		EventPutter:   eventBus,
 		RuntimeGetter: DefaultGetter{},
+		RetryPolicy:   &retryPolicy,
+		Freezes:       &freezesvc.Service{DB: db, Clock: clock.New()},
 	}
 	log, err := logger.Build(cfg.LogLevel)
 	if err != nil {
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/common-fate/pkg/cfaws"
+	"github.com/common-fate/common-fate/pkg/config"
+	"github.com/common-fate/common-fate/pkg/registrymirror"
+	"github.com/joho/godotenv"
+	"github.com/sethvargo/go-envconfig"
+	"go.uber.org/zap"
+)
+
+// registry-mirror serves provider manifests and schemas using the same API as the
+// public provider registry. Point Common Fate at it with the ProviderRegistryAPIURL
+// deployment parameter, and populate it with 'gdeploy registry-mirror sync'.
+func main() {
+	err := run()
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run() error {
+	var cfg config.RegistryMirrorConfig
+	ctx := context.Background()
+	_ = godotenv.Load()
+
+	err := envconfig.Process(ctx, &cfg)
+	if err != nil {
+		return err
+	}
+
+	log, err := logger.Build(cfg.LogLevel)
+	if err != nil {
+		return err
+	}
+	zap.ReplaceGlobals(log.Desugar())
+
+	var store registrymirror.Store
+	switch {
+	case cfg.Dir != "":
+		store = registrymirror.DirStore{Root: cfg.Dir}
+	case cfg.Bucket != "":
+		awsCfg, err := cfaws.ConfigFromContextOrDefault(ctx)
+		if err != nil {
+			return err
+		}
+		store = registrymirror.S3Store{Client: s3.NewFromConfig(awsCfg), Bucket: cfg.Bucket, Prefix: cfg.Prefix}
+	default:
+		return errors.New("one of COMMONFATE_REGISTRY_MIRROR_DIR or COMMONFATE_REGISTRY_MIRROR_BUCKET must be set")
+	}
+
+	s := registrymirror.Server{Store: store}
+
+	log.Infow("Starting provider registry mirror", "cfg", cfg)
+	err = http.ListenAndServe(cfg.Host, s.Handler())
+	if err != nil && err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
@@ -92,13 +92,22 @@ func run() error {
 		IdpType:             cfg.IdpProvider,
 		IdentityConfig:      ic,
 		IdentityGroupFilter: cfg.IdentityGroupFilter,
+		ArchiveThreshold:    cfg.IdentitySyncArchiveThreshold,
+		EventBusArn:         cfg.EventBusArn,
+		ResolveNestedGroups: cfg.IdentityResolveNestedGroups,
+		DeprovisioningMode:  cfg.IdentityDeprovisioningMode,
 	})
 
 	if err != nil {
 		return err
 	}
 
-	registryClient, err := providerregistrysdk.NewClientWithResponses(build.ProviderRegistryAPIURL)
+	registryURL := build.ProviderRegistryAPIURL
+	if cfg.ProviderRegistryAPIURL != "" {
+		// use a self-hosted registry mirror, for deployments which cannot reach the public registry
+		registryURL = cfg.ProviderRegistryAPIURL
+	}
+	registryClient, err := providerregistrysdk.NewClientWithResponses(registryURL)
 	if err != nil {
 		return err
 	}
@@ -115,8 +124,12 @@ func run() error {
 		DeploymentConfig:       dc,
 		UseLocalEventHandler:   os.Getenv("USE_LAMBDA_EVENT_HANDLER") != "true",
 		EventBusArn:            cfg.EventBusArn,
+		NATSURL:                cfg.NATSURL,
 		ProviderRegistryClient: registryClient,
 		FrontendURL:            cfg.FrontendURL,
+		CacheStaleThreshold:    cfg.CacheStaleThreshold,
+		IdempotencyKeyTTL:      cfg.IdempotencyKeyTTL,
+		SCIMToken:              cfg.SCIMToken,
 	})
 	if err != nil {
 		return err
@@ -127,6 +140,7 @@ func run() error {
 		Authenticator:  authMiddleware,
 		API:            api,
 		IdentitySyncer: idsync,
+		SCIM:           api.SCIMHandler(),
 	})
 	if err != nil {
 		return err
@@ -44,6 +44,17 @@ const analyticsDeploymentStage = app.node.tryGetContext(
   "analyticsDeploymentStage"
 );
 const identityGroupFilter = app.node.tryGetContext("identityGroupFilter");
+const providerRegistryApiUrl = app.node.tryGetContext("providerRegistryApiUrl");
+const scimToken = app.node.tryGetContext("scimToken");
+const identitySyncArchiveThreshold = app.node.tryGetContext(
+  "identitySyncArchiveThreshold"
+);
+const identityResolveNestedGroups = app.node.tryGetContext(
+  "identityResolveNestedGroups"
+);
+const identityDeprovisioningMode = app.node.tryGetContext(
+  "identityDeprovisioningMode"
+);
 
 let shouldRunCronHealthCheckCacheSync = app.node.tryGetContext(
   "enableCronHealthCheck"
@@ -101,6 +112,11 @@ if (stackTarget === "dev") {
     shouldRunCronHealthCheckCacheSync:
       shouldRunCronHealthCheckCacheSync || false,
     identityGroupFilter: identityGroupFilter || "",
+    providerRegistryApiUrl: providerRegistryApiUrl || "",
+    scimToken: scimToken || "",
+    identitySyncArchiveThreshold: identitySyncArchiveThreshold || "50",
+    identityResolveNestedGroups: identityResolveNestedGroups || "false",
+    identityDeprovisioningMode: identityDeprovisioningMode || "report",
     idpSyncMemory: idpSyncMemory || 128,
     idpSyncSchedule: idpSyncSchedule || "rate(5 minutes)",
     idpSyncTimeoutSeconds: idpSyncTimeoutSeconds || 30,
@@ -34,6 +34,11 @@ interface Props extends cdk.StackProps {
   analyticsDeploymentStage: string;
   shouldRunCronHealthCheckCacheSync: boolean;
   identityGroupFilter: string;
+  providerRegistryApiUrl: string;
+  scimToken: string;
+  identitySyncArchiveThreshold: string;
+  identityResolveNestedGroups: string;
+  identityDeprovisioningMode: string;
   idpSyncTimeoutSeconds: number;
   idpSyncSchedule: string;
   idpSyncMemory: number;
@@ -62,6 +67,11 @@ export class CommonFateStackDev extends cdk.Stack {
       analyticsLogLevel,
       analyticsDeploymentStage,
       identityGroupFilter,
+      providerRegistryApiUrl,
+      scimToken,
+      identitySyncArchiveThreshold,
+      identityResolveNestedGroups,
+      identityDeprovisioningMode,
       idpSyncTimeoutSeconds,
       idpSyncSchedule,
       idpSyncMemory,
@@ -146,6 +156,11 @@ export class CommonFateStackDev extends cdk.Stack {
         props.shouldRunCronHealthCheckCacheSync || false,
       targetGroupGranter: targetGroupGranter,
       identityGroupFilter,
+      providerRegistryApiUrl,
+      scimToken,
+      identitySyncArchiveThreshold,
+      identityResolveNestedGroups,
+      identityDeprovisioningMode,
     });
 
     /* Outputs */
@@ -174,6 +189,18 @@ export class CommonFateStackDev extends cdk.Stack {
       GranterV2StateMachineArn: targetGroupGranter.getStateMachineARN(),
       HealthcheckFunctionName: appBackend.getHealthChecker().getFunctionName(),
       HealthcheckLogGroupName: appBackend.getHealthChecker().getLogGroupName(),
+      DriftReconcilerFunctionName: appBackend
+        .getDriftReconciler()
+        .getFunctionName(),
+      DriftReconcilerLogGroupName: appBackend
+        .getDriftReconciler()
+        .getLogGroupName(),
+      ActivationExpirerFunctionName: appBackend
+        .getActivationExpirer()
+        .getFunctionName(),
+      ActivationExpirerLogGroupName: appBackend
+        .getActivationExpirer()
+        .getLogGroupName(),
       IDPSyncExecutionRoleARN: appBackend.getIdpSync().getExecutionRoleArn(),
       IDPSyncFunctionName: appBackend.getIdpSync().getFunctionName(),
       IDPSyncLogGroupName: appBackend.getIdpSync().getLogGroupName(),
@@ -124,6 +124,60 @@ export class CommonFateStackProd extends cdk.Stack {
       default: "",
     });
 
+    const providerRegistryApiUrl = new CfnParameter(
+      this,
+      "ProviderRegistryAPIURL",
+      {
+        type: "String",
+        description:
+          "If provided, provider schemas are fetched from this self-hosted registry mirror rather than the public provider registry.",
+        default: "",
+      }
+    );
+
+    const scimToken = new CfnParameter(this, "SCIMToken", {
+      type: "String",
+      description:
+        "If provided, the SCIM API is enabled and clients must authenticate with this bearer token. Usually an awsssm:// reference.",
+      default: "",
+      noEcho: true,
+    });
+
+    const identitySyncArchiveThreshold = new CfnParameter(
+      this,
+      "IdentitySyncArchiveThreshold",
+      {
+        type: "Number",
+        description:
+          "The percentage of users or groups which an identity sync can archive before it is aborted. Set to 0 to disable the check.",
+        default: 50,
+      }
+    );
+
+    const identityResolveNestedGroups = new CfnParameter(
+      this,
+      "IdentityResolveNestedGroups",
+      {
+        type: "String",
+        description:
+          "If true, users are made members of the groups which their groups are nested in when identity provider users and groups are synced.",
+        default: "false",
+        allowedValues: ["true", "false"],
+      }
+    );
+
+    const identityDeprovisioningMode = new CfnParameter(
+      this,
+      "IdentityDeprovisioningMode",
+      {
+        type: "String",
+        description:
+          "When identity sync archives a user, 'enforce' revokes their access, cancels their pending requests and removes them as a reviewer. 'report' only reports the access which would be cleaned up.",
+        default: "report",
+        allowedValues: ["enforce", "report"],
+      }
+    );
+
     const remoteConfigHeaders = new CfnParameter(
       this,
       "ExperimentalRemoteConfigHeaders",
@@ -286,6 +340,11 @@ export class CommonFateStackProd extends cdk.Stack {
       idpSyncTimeoutSeconds: idpSyncTimeoutSeconds.valueAsNumber,
       targetGroupGranter: targetGroupGranter,
       identityGroupFilter: identityGroupFilter.valueAsString,
+      providerRegistryApiUrl: providerRegistryApiUrl.valueAsString,
+      scimToken: scimToken.valueAsString,
+      identitySyncArchiveThreshold: identitySyncArchiveThreshold.valueAsString,
+      identityResolveNestedGroups: identityResolveNestedGroups.valueAsString,
+      identityDeprovisioningMode: identityDeprovisioningMode.valueAsString,
     });
 
     new ProductionFrontendDeployer(this, "FrontendDeployer", {
@@ -328,6 +387,18 @@ export class CommonFateStackProd extends cdk.Stack {
       GranterV2StateMachineArn: targetGroupGranter.getStateMachineARN(),
       HealthcheckFunctionName: appBackend.getHealthChecker().getFunctionName(),
       HealthcheckLogGroupName: appBackend.getHealthChecker().getLogGroupName(),
+      DriftReconcilerFunctionName: appBackend
+        .getDriftReconciler()
+        .getFunctionName(),
+      DriftReconcilerLogGroupName: appBackend
+        .getDriftReconciler()
+        .getLogGroupName(),
+      ActivationExpirerFunctionName: appBackend
+        .getActivationExpirer()
+        .getFunctionName(),
+      ActivationExpirerLogGroupName: appBackend
+        .getActivationExpirer()
+        .getLogGroupName(),
       IDPSyncExecutionRoleARN: appBackend.getIdpSync().getExecutionRoleArn(),
       IDPSyncFunctionName: appBackend.getIdpSync().getFunctionName(),
       IDPSyncLogGroupName: appBackend.getIdpSync().getLogGroupName(),
@@ -10,7 +10,9 @@ import { CfnWebACLAssociation } from "aws-cdk-lib/aws-wafv2";
 import { Construct } from "constructs";
 import * as path from "path";
 import { WebUserPool } from "./app-user-pool";
+import { ActivationExpirer } from "./activation-expirer";
 import { CacheSync } from "./cache-sync";
+import { DriftReconciler } from "./drift-reconciler";
 import { Governance } from "./governance";
 import { IdpSync } from "./idp-sync";
 import { Notifiers } from "./notifiers";
@@ -48,6 +50,11 @@ interface Props {
   idpSyncMemory: number;
   targetGroupGranter: TargetGroupGranter;
   identityGroupFilter: string;
+  providerRegistryApiUrl: string;
+  scimToken: string;
+  identitySyncArchiveThreshold: string;
+  identityResolveNestedGroups: string;
+  identityDeprovisioningMode: string;
 }
 
 export class AppBackend extends Construct {
@@ -60,6 +67,8 @@ export class AppBackend extends Construct {
   private _idpSync: IdpSync;
   private _cacheSync: CacheSync;
   private _healthChecker: HealthChecker;
+  private _driftReconciler: DriftReconciler;
+  private _activationExpirer: ActivationExpirer;
   private _KMSkey: cdk.aws_kms.Key;
   private _webhook: apigateway.Resource;
   private _webhookLambda: lambda.Function;
@@ -136,6 +145,14 @@ export class AppBackend extends Construct {
         CF_ANALYTICS_LOG_LEVEL: props.analyticsLogLevel,
         CF_ANALYTICS_DEPLOYMENT_STAGE: props.analyticsDeploymentStage,
         COMMONFATE_IDENTITY_GROUP_FILTER: props.identityGroupFilter,
+        COMMONFATE_PROVIDER_REGISTRY_API_URL: props.providerRegistryApiUrl,
+        COMMONFATE_SCIM_TOKEN: props.scimToken,
+        COMMONFATE_IDENTITY_SYNC_ARCHIVE_THRESHOLD:
+          props.identitySyncArchiveThreshold,
+        COMMONFATE_IDENTITY_RESOLVE_NESTED_GROUPS:
+          props.identityResolveNestedGroups,
+        COMMONFATE_IDENTITY_DEPROVISIONING_MODE:
+          props.identityDeprovisioningMode,
       },
       memorySize: 1024,
       runtime: lambda.Runtime.GO_1_X,
@@ -209,6 +226,17 @@ export class AppBackend extends Construct {
       }
     );
 
+    // SCIM clients authenticate with a bearer token, which is checked by the API rather than by Cognito
+    const scim = this._apigateway.root.addResource("scim");
+    const scimv2 = scim.addResource("v2");
+    const scimProxy = scimv2.addResource("{proxy+}");
+    scimProxy.addMethod(
+      "ANY",
+      new apigateway.LambdaIntegration(this._lambda, {
+        allowTestInvoke: false,
+      })
+    );
+
     const ALLOWED_HEADERS = [
       "Content-Type",
       "X-Amz-Date",
@@ -296,16 +324,35 @@ export class AppBackend extends Construct {
       idpSyncMemory: props.idpSyncMemory,
       idpSyncSchedule: props.idpSyncSchedule,
       idpSyncTimeoutSeconds: props.idpSyncTimeoutSeconds,
+      identitySyncArchiveThreshold: props.identitySyncArchiveThreshold,
+      identityResolveNestedGroups: props.identityResolveNestedGroups,
+      identityDeprovisioningMode: props.identityDeprovisioningMode,
+      eventBus: props.eventBus,
     });
     this._cacheSync = new CacheSync(this, "CacheSync", {
       dynamoTable: this._dynamoTable,
       shouldRunAsCron: props.shouldRunCronHealthCheckCacheSync,
       identityGroupFilter: props.identityGroupFilter,
+      eventBus: props.eventBus,
     });
     this._healthChecker = new HealthChecker(this, "HealthCheck", {
       dynamoTable: this._dynamoTable,
       shouldRunAsCron: props.shouldRunCronHealthCheckCacheSync,
     });
+    this._driftReconciler = new DriftReconciler(this, "DriftReconciler", {
+      dynamoTable: this._dynamoTable,
+      eventBus: props.eventBus,
+      shouldRunAsCron: props.shouldRunCronHealthCheckCacheSync,
+    });
+    this._activationExpirer = new ActivationExpirer(
+      this,
+      "ActivationExpirer",
+      {
+        dynamoTable: this._dynamoTable,
+        eventBus: props.eventBus,
+        shouldRunAsCron: props.shouldRunCronHealthCheckCacheSync,
+      }
+    );
   }
 
   /**
@@ -378,6 +425,12 @@ export class AppBackend extends Construct {
   getHealthChecker(): HealthChecker {
     return this._healthChecker;
   }
+  getDriftReconciler(): DriftReconciler {
+    return this._driftReconciler;
+  }
+  getActivationExpirer(): ActivationExpirer {
+    return this._activationExpirer;
+  }
 
   getKmsKeyArn(): string {
     return this._KMSkey.keyArn;
@@ -1,6 +1,7 @@
 import { Duration } from "aws-cdk-lib";
 import { Table } from "aws-cdk-lib/aws-dynamodb";
 import * as events from "aws-cdk-lib/aws-events";
+import { EventBus } from "aws-cdk-lib/aws-events";
 import * as targets from "aws-cdk-lib/aws-events-targets";
 import { PolicyStatement } from "aws-cdk-lib/aws-iam";
 import * as lambda from "aws-cdk-lib/aws-lambda";
@@ -13,11 +14,13 @@ interface Props {
   dynamoTable: Table;
   shouldRunAsCron: boolean;
   identityGroupFilter: string;
+  eventBus: EventBus;
 }
 
 export class CacheSync extends Construct {
   private _lambda: lambda.Function;
   private eventRule: events.Rule;
+  private staleCheckRule: events.Rule;
 
   constructor(scope: Construct, id: string, props: Props) {
     super(scope, id);
@@ -30,6 +33,7 @@ export class CacheSync extends Construct {
       timeout: Duration.seconds(60),
       environment: {
         COMMONFATE_TABLE_NAME: props.dynamoTable.tableName,
+        COMMONFATE_EVENT_BUS_ARN: props.eventBus.eventBusArn,
       },
       runtime: lambda.Runtime.GO_1_X,
       handler: "cache-sync",
@@ -37,6 +41,7 @@ export class CacheSync extends Construct {
     });
 
     props.dynamoTable.grantReadWriteData(this._lambda);
+    props.eventBus.grantPutEventsTo(this._lambda);
 
     //add event bridge trigger to lambda
     this.eventRule = new events.Rule(this, "EventBridgeCronRule", {
@@ -50,6 +55,18 @@ export class CacheSync extends Construct {
     // allow the Event Rule to invoke the Lambda function
     targets.addLambdaPermission(this.eventRule, this._lambda);
 
+    // staleness is checked on its own schedule, so that notifications are still sent if syncs time out or stop running
+    this.staleCheckRule = new events.Rule(this, "StaleCheckCronRule", {
+      schedule: events.Schedule.cron({ minute: "0/15" }),
+      enabled: props.shouldRunAsCron,
+    });
+    this.staleCheckRule.addTarget(
+      new targets.LambdaFunction(this._lambda, {
+        event: events.RuleTargetInput.fromObject({ checkStale: true }),
+      })
+    );
+    targets.addLambdaPermission(this.staleCheckRule, this._lambda);
+
     // allows to invoke the function from any account if they have the correct tag
     grantAssumeHandlerRole(this._lambda);
   }
@@ -22,6 +22,8 @@ export class Database extends Construct {
       sortKey: { name: "SK", type: dynamodb.AttributeType.STRING },
       billingMode: dynamodb.BillingMode.PAY_PER_REQUEST,
       pointInTimeRecovery: true,
+      // idempotency keys are expired by DynamoDB
+      timeToLiveAttribute: "ttl",
     });
 
     const gsi1: dynamodb.GlobalSecondaryIndexProps = {
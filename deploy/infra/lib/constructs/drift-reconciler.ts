@@ -0,0 +1,70 @@
+import { Duration } from "aws-cdk-lib";
+import { Table } from "aws-cdk-lib/aws-dynamodb";
+import * as events from "aws-cdk-lib/aws-events";
+import { EventBus } from "aws-cdk-lib/aws-events";
+import * as targets from "aws-cdk-lib/aws-events-targets";
+import * as lambda from "aws-cdk-lib/aws-lambda";
+import { Construct } from "constructs";
+import * as path from "path";
+import { grantAssumeHandlerRole } from "../helpers/permissions";
+
+interface Props {
+  dynamoTable: Table;
+  eventBus: EventBus;
+  shouldRunAsCron: boolean;
+}
+
+export class DriftReconciler extends Construct {
+  private _lambda: lambda.Function;
+  private eventRule: events.Rule;
+
+  constructor(scope: Construct, id: string, props: Props) {
+    super(scope, id);
+    const code = lambda.Code.fromAsset(
+      path.join(
+        __dirname,
+        "..",
+        "..",
+        "..",
+        "..",
+        "bin",
+        "drift-reconciler.zip"
+      )
+    );
+
+    this._lambda = new lambda.Function(this, "HandlerFunction", {
+      code,
+      timeout: Duration.minutes(5),
+      environment: {
+        COMMONFATE_TABLE_NAME: props.dynamoTable.tableName,
+        COMMONFATE_EVENT_BUS_ARN: props.eventBus.eventBusArn,
+      },
+      runtime: lambda.Runtime.GO_1_X,
+      handler: "drift-reconciler",
+    });
+
+    props.dynamoTable.grantReadWriteData(this._lambda);
+    props.eventBus.grantPutEventsTo(this._lambda);
+
+    //add event bridge trigger to lambda every 15 minutes
+    this.eventRule = new events.Rule(this, "EventBridgeCronRule", {
+      schedule: events.Schedule.cron({ minute: "0/15" }),
+      enabled: props.shouldRunAsCron,
+    });
+
+    // add the Lambda function as a target for the Event Rule
+    this.eventRule.addTarget(new targets.LambdaFunction(this._lambda));
+
+    // allow the Event Rule to invoke the Lambda function
+    targets.addLambdaPermission(this.eventRule, this._lambda);
+
+    // allows to invoke the function from any account if they have the correct tag
+    grantAssumeHandlerRole(this._lambda);
+  }
+  getLogGroupName(): string {
+    return this._lambda.logGroup.logGroupName;
+  }
+  getFunctionName(): string {
+    return this._lambda.functionName;
+  }
+}
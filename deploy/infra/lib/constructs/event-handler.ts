@@ -106,7 +106,11 @@ export class EventHandler extends Construct {
         detailType: [
           "request.revoke.initiated",
           "request.cancel.initiated",
+          "grant.revoke.initiated",
           "accessGroup.review",
+          "freeze.updated",
+          "freeze.lifted",
+          "accessGroup.activationExpired",
         ],
       },
       targets: [
@@ -127,7 +131,11 @@ export class EventHandler extends Construct {
               "anything-but": [
                 "request.revoke.initiated",
                 "request.cancel.initiated",
+                "grant.revoke.initiated",
                 "accessGroup.review",
+                "freeze.updated",
+                "freeze.lifted",
+                "accessGroup.activationExpired",
               ],
             },
           ],
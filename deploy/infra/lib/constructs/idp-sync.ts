@@ -1,6 +1,7 @@
 import { Duration, Stack } from "aws-cdk-lib";
 import { Table } from "aws-cdk-lib/aws-dynamodb";
 import * as events from "aws-cdk-lib/aws-events";
+import { EventBus } from "aws-cdk-lib/aws-events";
 import * as targets from "aws-cdk-lib/aws-events-targets";
 import { PolicyStatement } from "aws-cdk-lib/aws-iam";
 import * as lambda from "aws-cdk-lib/aws-lambda";
@@ -21,6 +22,10 @@ interface Props {
   idpSyncTimeoutSeconds: number;
   idpSyncSchedule: string;
   idpSyncMemory: number;
+  identitySyncArchiveThreshold: string;
+  identityResolveNestedGroups: string;
+  identityDeprovisioningMode: string;
+  eventBus: EventBus;
 }
 
 export class IdpSync extends Construct {
@@ -48,12 +53,20 @@ export class IdpSync extends Construct {
         CF_ANALYTICS_LOG_LEVEL: props.analyticsLogLevel,
         CF_ANALYTICS_DEPLOYMENT_STAGE: props.analyticsDeploymentStage,
         COMMONFATE_IDENTITY_GROUP_FILTER: props.identityGroupFilter,
+        COMMONFATE_IDENTITY_SYNC_ARCHIVE_THRESHOLD:
+          props.identitySyncArchiveThreshold,
+        COMMONFATE_IDENTITY_RESOLVE_NESTED_GROUPS:
+          props.identityResolveNestedGroups,
+        COMMONFATE_IDENTITY_DEPROVISIONING_MODE:
+          props.identityDeprovisioningMode,
+        COMMONFATE_EVENT_BUS_ARN: props.eventBus.eventBusArn,
       },
       runtime: lambda.Runtime.GO_1_X,
       handler: "syncer",
     });
 
     props.dynamoTable.grantReadWriteData(this._lambda);
+    props.eventBus.grantPutEventsTo(this._lambda);
 
     //add event bridge trigger to lambda
     this.eventRule = new events.Rule(this, "EventBridgeCronRule", {
@@ -76,6 +76,7 @@ export class TargetGroupGranter extends Construct {
             Payload: {
               "action": "ACTIVATE",
               "requestAccessGroupTarget.$": "$.requestAccessGroupTarget",
+              "attempt.$": "$.attempt",
             },
           },
           Retry: [
@@ -90,10 +91,38 @@ export class TargetGroupGranter extends Construct {
               BackoffRate: 2,
             },
           ],
-          Next: "Wait for Window End",
+          Next: "Check Activation is Held",
           ResultPath: "$",
           OutputPath: "$.Payload",
         },
+        "Check Activation is Held": {
+          Type: "Choice",
+          Choices: [
+            {
+              Variable: "$.heldUntil",
+              IsPresent: true,
+              Next: "Wait for Freeze End",
+            },
+            {
+              Variable: "$.retryAt",
+              IsPresent: true,
+              Next: "Wait to Retry Activation",
+            },
+          ],
+          Default: "Wait for Window End",
+          Comment:
+            "Access is not activated while it is frozen, and failed attempts are retried after a backoff",
+        },
+        "Wait to Retry Activation": {
+          Type: "Wait",
+          TimestampPath: "$.retryAt",
+          Next: "Validate End is in the Future",
+        },
+        "Wait for Freeze End": {
+          Type: "Wait",
+          TimestampPath: "$.heldUntil",
+          Next: "Validate End is in the Future",
+        },
         "Wait for Window End": {
           Type: "Wait",
           TimestampPath: "$.requestAccessGroupTarget.grant.end",
@@ -110,6 +139,7 @@ export class TargetGroupGranter extends Construct {
               "action": "DEACTIVATE",
               "requestAccessGroupTarget.$": "$.requestAccessGroupTarget",
               "state.$": "$.state",
+              "attempt.$": "$.attempt",
             },
           },
           Retry: [
@@ -125,7 +155,27 @@ export class TargetGroupGranter extends Construct {
             },
           ],
           ResultPath: "$",
-          End: true,
+          Next: "Check Deactivation is Retried",
+        },
+        "Check Deactivation is Retried": {
+          Type: "Choice",
+          Choices: [
+            {
+              Variable: "$.retryAt",
+              IsPresent: true,
+              Next: "Wait to Retry Deactivation",
+            },
+          ],
+          Default: "Access Expired",
+          Comment: "Failed attempts are retried after a backoff",
+        },
+        "Wait to Retry Deactivation": {
+          Type: "Wait",
+          TimestampPath: "$.retryAt",
+          Next: "Expire Access",
+        },
+        "Access Expired": {
+          Type: "Succeed",
         },
         "Fail": {
           Type: "Fail",
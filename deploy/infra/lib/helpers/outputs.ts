@@ -2,6 +2,8 @@ import { CfnOutput } from "aws-cdk-lib";
 import { Construct } from "constructs";
 
 export type StackOutputs = {
+  ActivationExpirerFunctionName: string;
+  ActivationExpirerLogGroupName: string;
   APILogGroupName: string;
   APIURL: string;
   CacheSyncFunctionName: string;
@@ -10,6 +12,8 @@ export type StackOutputs = {
   CloudFrontDistributionID: string;
   CloudFrontDomain: string;
   CognitoClientID: string;
+  DriftReconcilerFunctionName: string;
+  DriftReconcilerLogGroupName: string;
   DynamoDBTable: string;
   EventBusArn: string;
   EventBusLogGroupName: string;
@@ -9,6 +9,8 @@ export const IdentityProviderRegistry = {
   Google: "google",
   AWSSSO: "aws-sso",
   OneLogin: "one-login",
+  LDAP: "ldap",
+  SCIM: "generic-scim",
 } as const;
 
 export type IdentityProviderTypes =
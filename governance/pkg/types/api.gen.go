@@ -1307,25 +1307,25 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+yY0W/bthPH/xWCv9+jZjlbB3R685w0M7ahQ5D1pcsDI51tthTJHk9ujUL/+3CUHEuy",
-	"kjhJ021An+pS5N3xvh99SeWzzF3pnQVLQWafJcKHCgL97AoNcWCOoAhmeQ4hXFQGLpoJ/Ch3lsDGn8p7",
-	"o3NF2tn0XXCWx0K+hlLxL4/OA1IbUXmPbqMM//4/wlJmcpI6D1Z5PdmW5n/pvqS0CRLSfQGzuBxw7uxS",
-	"r2SdyAJCjtpzco4Jn1TpDchMzopSW6HiUkFOvH5PSiaStp6fBkJtY4AVusrH2nqh5OUaRHwmFqdB0FqR",
-	"oDXsAmJlQMSNA0efyERqgjLGOUjRDihEteX/W1VCv1guTiiueKxEUrgCelDLhspdNiE4mC5h7mwgVLrV",
-	"/RFCXA6i1HUS8dEIhcze7rqa7AVvt91X7GZvh3Vd3TTCXb+DnGRdc5I/ffEfQ/IO4h6OzOHE55EzkVVs",
-	"9O8QglqNpR4IPqwjORaBUZ1j8OCdDY1GZ4gOL9qRJ2gNHOf+zTTTxiobqCtnVsTJAoEqtFCIJboyOkUA",
-	"3OgcJtzM33SgfbfDKZDS5gvsR+1j9nh6BAKj1MGnWJKtjFHX7FSEFST3dK9bVBvjmFaeNW4odsKP9O1b",
-	"x/rwCaMDCbcUTVEiRpg0U7Vdul2fVN4UFU1Ezl1ZOiteKeJ3sUIjM7km8iFLecOls0tFMNFOjuE++2Mh",
-	"lg6FR7dCVZaKdK6M2YpSWbXSdtU9IoPQVpyjsgSFmLU+ECbR7qk5pneD4pxd1CqbA+eQidwAhibtyWTK",
-	"tbTSyEz+MJlOpjKRXtE6ypeu3CbdnKRN7u9wp297bvZ3wVQJZUyvUhnjY6RoUchMnrvNAL+YEFUJBBhk",
-	"9nYY95U2BNgTQ1xvhRJeIem8MgpFIEVV7IDmJR8qwO3OGJkPfiqTDr5gq5IZmc0vF2/OZCJnF/NfFm/O",
-	"TjuI7LEalgQ2x63n7pN7D1ZEGrRlmTyrFbcrIivjFTGMl7y0V9Qw79XAr7+fTjsvVe89upmX3m2KzHCo",
-	"ylLhdidZt7Hx6rBiFeQeHHlVJ9K7MKJ5cyESynZFH9N8eHOSSedevD3KKnr36PS2S3R90LOTB/naoxzr",
-	"0ESa8gou50VPtbv2t5Owfy7HECdPD/HifnYOVv14DHGDVT3CWkA6jN2GWJ2M2k36mf9ZFPWtvnMOxAB2",
-	"UkzGCDwHGuB3+GZ9bUpe//os6j4iRE807ugRih34dnQ6Pjz2RteIJ7sHc3N03+l6vhrRuflGCUOtRRyP",
-	"J2Tz/ZhH5IJQwsJH0R53o0gMv3q+oin9o7hNH+EEfUj/5a7TCPslXCctwADFq/GzwT56sp7GvEPa/7Iz",
-	"uxUebMHAt/CFeHOktQ69v6N81MaIaxA5b9UYKEbfgSbPN2c8GsenmusM87XeHAcnLwTc7JDbf1RkaWpc",
-	"rszaBcpevnz5k2SS2iA3nySdYPVV/XcAAAD//zxb8+GYFAAA",
+	"H4sIAAAAAAAC/+xYUW/bNhf9K8T9vkdNcrYOyPTmOWlmbEOHIOtL5wdGurbZUiRLXrk1Av33gaQcS7KS",
+	"OEnTbUCf6lLkuYf3HB1SuYFCV0YrVOQgvwGLH2t09LMuBYaBmUVOOC0KdO6ylngZJ/hHhVaEKvzkxkhR",
+	"cBJaZe+dVn7MFWusuP9lrDZoqUXkxli94dL//r/FJeSQZtqg4kak20r+L9tTyiKIy/YEpmE52plWS7GC",
+	"JoESXWGF8cU9Jn7mlZEIOUzLSijGw1JGmr35QBwSoK3xTx1ZoQLAyuraBG49KLhaIwvP2PzMMVpzYrTG",
+	"HaCtJbKwcfToKSQgCKuAc1CiHeDW8q3/v+IV9sl6cox7xmMUidsV0qNaNlTuKkJ4MFHhTCtHlotW9ycI",
+	"cTVAaZok2EdYLCF/t+tqshe83XZfsdu9HfJa3DZCX7/HgqBpfJE/Tfkfs+Q9jnu8ZQ4nvoycCdSh0b+j",
+	"c3w1Vnog+JBHcqwFRnUO4M5o5aJG59Zqe9mOPENr9DgPbyZOG2M2UBemioXJzCLVVmHJllZXISkc2o0o",
+	"MPXN/E042nfbnSFxIb/Afvges+enJ1hg1HX4OVBStZT82icV2RqTB7rXJdViHNPK85iGbCf8SN++daxv",
+	"PiaFI6aXLJJiASGNU4Va6l2feBFJhRCBma4qrdhrTggJ1FZCDmsi4/LMb7jSaskJU6FhzO7TP+ZsqS0z",
+	"Vq8srypOouBSblnFFV8JteoekY4JxS4sV4Qlm7Y54NIQ9xSP6d0gu/Apqrgq0NeABDZoXSx7kk48l1Ya",
+	"yOGHdJJOIAHDaR3ky1Z6k21Oslj7O7vTtz03+7vwrmJcyh5TCPg2uGheQg4XejOwXyhoeYWE1kH+boj7",
+	"WkhC2xODXW8ZZ4ZbEkUtuWWOONWhA8Iv+Vij3e6CMYf4FJKOfVHVlffIdHY1f3sOCUwvZ7/M356fwWLE",
+	"VkNKqAq7Nb77pD+gYsENQnmZjFcrbJcFr4wz8ma88kt7pIZ1F4O8/n4y6bxUvffodl52fyh6D7u6qrjd",
+	"7iTrNjZcHVZeBdgbBxZNAka7Ec3jhYhx1RV9TPPhzQmSzr14e1RU9O7R2V2X6OagZyePyrUnJdZhiER6",
+	"pafzajI5CngvYf9cDhAnz4d49bB3Dlb9OJk8elXPYa1BOh67y2JNMho32Y3/Z142d+bOBZI3YKdEOubA",
+	"C6SB/Q7frK/tkje/voi6T4DoieY7eoRiB7kdks4fHvugi+JB92COR/e9qWfqEZ3jN4obas3CeDgh4/dj",
+	"ESznGGcKP7H2uBu1xPCr5yuG0j9qt8kTkqBv0n956kRhv0TqZCVKpHA1fjGzj56sZ6Hu0O1/qanaMoOq",
+	"9IZvzefCzZHWwvX+jvJJSMmukRV+q1JiOfoOxDrfkvFoOz43XKe2WIvNceb0C9Fudpbbf1TkWSZ1weVa",
+	"O8pPT09/gmZxC3L7SdIBaxbN3wMAPFvz4ZgUAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
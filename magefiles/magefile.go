@@ -106,6 +106,20 @@ func (Build) HealthChecker() error {
 	}
 	return sh.RunWith(env, "go", "build", "-ldflags", ldFlags(), "-o", "bin/healthcheck", "cmd/lambda/healthcheck/handler.go")
 }
+func (Build) DriftReconciler() error {
+	env := map[string]string{
+		"GOOS":   "linux",
+		"GOARCH": "amd64",
+	}
+	return sh.RunWith(env, "go", "build", "-ldflags", ldFlags(), "-o", "bin/drift-reconciler", "cmd/lambda/drift-reconciler/handler.go")
+}
+func (Build) ActivationExpirer() error {
+	env := map[string]string{
+		"GOOS":   "linux",
+		"GOARCH": "amd64",
+	}
+	return sh.RunWith(env, "go", "build", "-ldflags", ldFlags(), "-o", "bin/activation-expirer", "cmd/lambda/activation-expirer/handler.go")
+}
 func (Build) CacheSyncer() error {
 	env := map[string]string{
 		"GOOS":   "linux",
@@ -151,6 +165,16 @@ func (Build) EventHandler() error {
 	}
 	return sh.RunWith(env, "go", "build", "-ldflags", ldFlags(), "-o", "bin/event-handler", "cmd/lambda/event-handlers/eventhandler/handler.go")
 }
+
+// EventSchemas copies every published version of the event JSON schemas into bin/event-schemas.
+// The schemas are generated from the event definitions with 'go generate ./pkg/gevent'.
+func (Build) EventSchemas() error {
+	err := os.MkdirAll("bin/event-schemas", 0755)
+	if err != nil {
+		return err
+	}
+	return sh.Run("cp", "-R", "pkg/gevent/schemas/.", "bin/event-schemas")
+}
 func (Build) FrontendAWSExports() error {
 	// create the aws-exports.js file if it doesn't exist. This prevents the frontend build from breaking.
 	f := "web/src/utils/aws-exports.js"
@@ -214,7 +238,7 @@ func PackageHealthChecker() error {
 func Package() {
 	mg.Deps(PackageBackend, PackageSlackNotifier, PackageEventHandler)
 	mg.Deps(PackageSyncer, PackageWebhook, PackageGovernance, PackageFrontendDeployer)
-	mg.Deps(PackageCacheSyncer, PackageHealthChecker, PackageTargetGroupGranter)
+	mg.Deps(PackageCacheSyncer, PackageHealthChecker, PackageTargetGroupGranter, PackageDriftReconciler, PackageActivationExpirer)
 }
 
 // PackageFrontendDeployer zips the Go frontend deployer so that it can be deployed to Lambda.
@@ -235,6 +259,18 @@ func PackageCacheSyncer() error {
 	return sh.Run("zip", "--junk-paths", "bin/cache-sync.zip", "bin/cache-sync")
 }
 
+// PackageDriftReconciler zips the Go drift reconciler so that it can be deployed to Lambda.
+func PackageDriftReconciler() error {
+	mg.Deps(Build.DriftReconciler)
+	return sh.Run("zip", "--junk-paths", "bin/drift-reconciler.zip", "bin/drift-reconciler")
+}
+
+// PackageActivationExpirer zips the Go activation expirer so that it can be deployed to Lambda.
+func PackageActivationExpirer() error {
+	mg.Deps(Build.ActivationExpirer)
+	return sh.Run("zip", "--junk-paths", "bin/activation-expirer.zip", "bin/activation-expirer")
+}
+
 // PackageNotifier zips the Go notifier so that it can be deployed to Lambda.
 func PackageSlackNotifier() error {
 	mg.Deps(Build.SlackNotifier)
@@ -341,6 +377,16 @@ func Dotenv() error {
 	myEnv["COMMONFATE_ACCESS_REMOTE_CONFIG_URL"] = cfg.Deployment.Parameters.ExperimentalRemoteConfigURL
 	myEnv["COMMONFATE_REMOTE_CONFIG_HEADERS"] = cfg.Deployment.Parameters.ExperimentalRemoteConfigHeaders
 	myEnv["COMMONFATE_IDENTITY_GROUP_FILTER"] = cfg.Deployment.Parameters.IdentityGroupFilter
+	if cfg.Deployment.Parameters.IdentitySyncArchiveThreshold != "" {
+		myEnv["COMMONFATE_IDENTITY_SYNC_ARCHIVE_THRESHOLD"] = cfg.Deployment.Parameters.IdentitySyncArchiveThreshold
+	}
+	if cfg.Deployment.Parameters.IdentityResolveNestedGroups != "" {
+		myEnv["COMMONFATE_IDENTITY_RESOLVE_NESTED_GROUPS"] = cfg.Deployment.Parameters.IdentityResolveNestedGroups
+	}
+	if cfg.Deployment.Parameters.IdentityDeprovisioningMode != "" {
+		myEnv["COMMONFATE_IDENTITY_DEPROVISIONING_MODE"] = cfg.Deployment.Parameters.IdentityDeprovisioningMode
+	}
+	myEnv["COMMONFATE_PROVIDER_REGISTRY_API_URL"] = cfg.Deployment.Parameters.ProviderRegistryAPIURL
 	myEnv["COMMONFATE_GRANTER_V2_STATE_MACHINE_ARN"] = o.GranterV2StateMachineArn
 
 	err = godotenv.Write(myEnv, ".env")
@@ -508,6 +554,12 @@ func (Release) PublishFrontendAssets(releaseBucket, versionHash string) error {
 	return sh.Run("aws", "s3", "cp", "./web/dist", fmt.Sprintf("s3://%s/%s/frontend-assets/", releaseBucket, versionHash), "--recursive")
 }
 
+func (Release) PublishEventSchemas(releaseBucket, versionHash string) error {
+	mg.Deps(Build.EventSchemas)
+	zap.S().Infow("uploading event schemas to s3", "bucket", releaseBucket)
+	return sh.Run("aws", "s3", "cp", "./bin/event-schemas", fmt.Sprintf("s3://%s/%s/event-schemas/", releaseBucket, versionHash), "--recursive")
+}
+
 // PublishManifest updates the manifest.json file in the release bucket with the latest version information,
 // so that our customer deployment tooling knows there is a new version available.
 func (Release) PublishManifest(releaseBucket, version string) error {
@@ -520,6 +572,7 @@ func (Release) Production(releaseBucket, versionHash string) {
 		mg.F(Release.PublishCDKAssets, releaseBucket, versionHash),
 		mg.F(Release.PublishFrontendAssets, releaseBucket, versionHash),
 		mg.F(Release.PublishCloudFormation, releaseBucket, versionHash),
+		mg.F(Release.PublishEventSchemas, releaseBucket, versionHash),
 	)
 
 	// only update the manifest if all of the above steps have succeeded.
@@ -0,0 +1,121 @@
+package access
+
+import (
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+)
+
+// BulkRevokeJob revokes access in bulk, for example when a user is offboarded or a provider is compromised.
+// The requests and targets which matched the filter when the job was created are stored as BulkRevokeJobItems.
+type BulkRevokeJob struct {
+	ID     string                  `json:"id" dynamodbav:"id"`
+	Kind   types.BulkRevokeJobKind `json:"kind" dynamodbav:"kind"`
+	Filter BulkRevokeFilter        `json:"filter" dynamodbav:"filter"`
+	// DryRun jobs are complete as soon as they are created, and their items are left as PLANNED
+	DryRun    bool                      `json:"dryRun" dynamodbav:"dryRun"`
+	Status    types.BulkRevokeJobStatus `json:"status" dynamodbav:"status"`
+	Total     int                       `json:"total" dynamodbav:"total"`
+	Processed int                       `json:"processed" dynamodbav:"processed"`
+	Succeeded int                       `json:"succeeded" dynamodbav:"succeeded"`
+	Skipped   int                       `json:"skipped" dynamodbav:"skipped"`
+	Failed    int                       `json:"failed" dynamodbav:"failed"`
+	// CreatedBy is the admin who created the job, they are recorded as the revoker on each request
+	CreatedBy RequestedBy `json:"createdBy" dynamodbav:"createdBy"`
+	CreatedAt time.Time   `json:"createdAt" dynamodbav:"createdAt"`
+	UpdatedAt time.Time   `json:"updatedAt" dynamodbav:"updatedAt"`
+}
+
+type BulkRevokeFilter struct {
+	UserID        string            `json:"userId,omitempty" dynamodbav:"userId,omitempty"`
+	TargetGroupID string            `json:"targetGroupId,omitempty" dynamodbav:"targetGroupId,omitempty"`
+	AccessRuleID  string            `json:"accessRuleId,omitempty" dynamodbav:"accessRuleId,omitempty"`
+	TargetFields  map[string]string `json:"targetFields,omitempty" dynamodbav:"targetFields,omitempty"`
+}
+
+func (f BulkRevokeFilter) ToAPI() types.BulkRevokeFilter {
+	out := types.BulkRevokeFilter{}
+	if f.UserID != "" {
+		out.UserId = &f.UserID
+	}
+	if f.TargetGroupID != "" {
+		out.TargetGroupId = &f.TargetGroupID
+	}
+	if f.AccessRuleID != "" {
+		out.AccessRuleId = &f.AccessRuleID
+	}
+	if len(f.TargetFields) > 0 {
+		out.TargetFields = &types.BulkRevokeFilter_TargetFields{AdditionalProperties: f.TargetFields}
+	}
+	return out
+}
+
+func (j *BulkRevokeJob) ToAPI() types.BulkRevokeJob {
+	return types.BulkRevokeJob{
+		Id:        j.ID,
+		Kind:      j.Kind,
+		Filter:    j.Filter.ToAPI(),
+		DryRun:    j.DryRun,
+		Status:    j.Status,
+		Total:     j.Total,
+		Processed: j.Processed,
+		Succeeded: j.Succeeded,
+		Skipped:   j.Skipped,
+		Failed:    j.Failed,
+		CreatedBy: j.CreatedBy.ID,
+		CreatedAt: j.CreatedAt,
+		UpdatedAt: j.UpdatedAt,
+	}
+}
+
+func (j *BulkRevokeJob) DDBKeys() (ddb.Keys, error) {
+	keys := ddb.Keys{
+		PK: keys.BulkRevokeJob.PK1,
+		SK: keys.BulkRevokeJob.SK1(j.ID),
+	}
+	return keys, nil
+}
+
+// BulkRevokeJobItem is a request or target which is revoked or cancelled by a bulk revoke job.
+type BulkRevokeJobItem struct {
+	ID        string `json:"id" dynamodbav:"id"`
+	JobID     string `json:"jobId" dynamodbav:"jobId"`
+	RequestID string `json:"requestId" dynamodbav:"requestId"`
+	// GroupID and TargetID are only set for REVOKE_TARGET items
+	GroupID     string                        `json:"groupId,omitempty" dynamodbav:"groupId,omitempty"`
+	TargetID    string                        `json:"targetId,omitempty" dynamodbav:"targetId,omitempty"`
+	RequestedBy RequestedBy                   `json:"requestedBy" dynamodbav:"requestedBy"`
+	Action      types.BulkRevokeJobItemAction `json:"action" dynamodbav:"action"`
+	Status      types.BulkRevokeJobItemStatus `json:"status" dynamodbav:"status"`
+	Error       string                        `json:"error,omitempty" dynamodbav:"error,omitempty"`
+}
+
+func (i *BulkRevokeJobItem) ToAPI() types.BulkRevokeJobItem {
+	out := types.BulkRevokeJobItem{
+		Id:          i.ID,
+		RequestId:   i.RequestID,
+		RequestedBy: i.RequestedBy.Email,
+		Action:      i.Action,
+		Status:      i.Status,
+	}
+	if i.GroupID != "" {
+		out.GroupId = &i.GroupID
+	}
+	if i.TargetID != "" {
+		out.TargetId = &i.TargetID
+	}
+	if i.Error != "" {
+		out.Error = &i.Error
+	}
+	return out
+}
+
+func (i *BulkRevokeJobItem) DDBKeys() (ddb.Keys, error) {
+	keys := ddb.Keys{
+		PK: keys.BulkRevokeJobItem.PK1,
+		SK: keys.BulkRevokeJobItem.SK1(i.JobID, i.ID),
+	}
+	return keys, nil
+}
@@ -0,0 +1,65 @@
+package access
+
+import (
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+)
+
+// DeadLetterEvent is an event which a subscriber failed to handle.
+// It is kept, along with the error, until an admin replays or discards it.
+type DeadLetterEvent struct {
+	// ID is derived from the subscriber and the ID of the event, so that retries of the same event update one record.
+	ID         string `json:"id" dynamodbav:"id"`
+	Subscriber string `json:"subscriber" dynamodbav:"subscriber"`
+	EventID    string `json:"eventId" dynamodbav:"eventId"`
+	// OrderingKey groups the events for a resource, such as the events for a request.
+	OrderingKey string `json:"orderingKey,omitempty" dynamodbav:"orderingKey,omitempty"`
+	DetailType  string `json:"detailType" dynamodbav:"detailType"`
+	// Detail is the JSON event payload
+	Detail        string    `json:"detail" dynamodbav:"detail"`
+	Error         string    `json:"error" dynamodbav:"error"`
+	Attempts      int       `json:"attempts" dynamodbav:"attempts"`
+	FirstFailedAt time.Time `json:"firstFailedAt" dynamodbav:"firstFailedAt"`
+	LastFailedAt  time.Time `json:"lastFailedAt" dynamodbav:"lastFailedAt"`
+}
+
+// DeadLetterEventID returns the ID of the dead letter record for an event delivered to a subscriber.
+func DeadLetterEventID(subscriber, eventID string) string {
+	return subscriber + "_" + eventID
+}
+
+func (e *DeadLetterEvent) ToAPI() types.DeadLetterEvent {
+	res := types.DeadLetterEvent{
+		Id:            e.ID,
+		Subscriber:    e.Subscriber,
+		EventId:       e.EventID,
+		DetailType:    e.DetailType,
+		Detail:        e.Detail,
+		Error:         e.Error,
+		Attempts:      e.Attempts,
+		FirstFailedAt: e.FirstFailedAt,
+		LastFailedAt:  e.LastFailedAt,
+	}
+	if e.OrderingKey != "" {
+		res.OrderingKey = &e.OrderingKey
+	}
+	return res
+}
+
+func (e *DeadLetterEvent) DDBKeys() (ddb.Keys, error) {
+	k := ddb.Keys{
+		PK:     keys.DeadLetterEvent.PK1,
+		SK:     keys.DeadLetterEvent.SK1(e.ID),
+		GSI2PK: keys.DeadLetterEvent.GSI2PK(e.Subscriber),
+		GSI2SK: keys.DeadLetterEvent.GSI2SK(e.LastFailedAt, e.ID),
+	}
+	// events without an ordering key can't be listed by request, so they aren't indexed
+	if e.OrderingKey != "" {
+		k.GSI1PK = keys.DeadLetterEvent.GSI1PK(e.OrderingKey)
+		k.GSI1SK = keys.DeadLetterEvent.GSI1SK(e.LastFailedAt, e.ID)
+	}
+	return k, nil
+}
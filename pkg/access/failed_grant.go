@@ -0,0 +1,49 @@
+package access
+
+import (
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+)
+
+// FailedGrant is a grant which could not be activated or deactivated after the retry policy
+// was exhausted. Failed grants are kept until an admin retries or force-completes them.
+type FailedGrant struct {
+	GrantID   string            `json:"grantId" dynamodbav:"grantId"`
+	RequestID string            `json:"requestId" dynamodbav:"requestId"`
+	GroupID   string            `json:"groupId" dynamodbav:"groupId"`
+	Action    types.GrantAction `json:"action" dynamodbav:"action"`
+	// Priority is HIGH for failed deactivations, as the user may still have access
+	Priority types.FailedGrantPriority `json:"priority" dynamodbav:"priority"`
+	Reason   string                    `json:"reason" dynamodbav:"reason"`
+	Attempts int                       `json:"attempts" dynamodbav:"attempts"`
+	// Grant is a snapshot of the grant when it failed
+	Grant GroupTarget `json:"grant" dynamodbav:"grant"`
+	// State is the state returned by the provider when the grant was activated, it is required to retry a deactivation
+	State     map[string]any `json:"state,omitempty" dynamodbav:"state,omitempty"`
+	CreatedAt time.Time      `json:"createdAt" dynamodbav:"createdAt"`
+}
+
+func (f *FailedGrant) ToAPI() types.FailedGrant {
+	return types.FailedGrant{
+		GrantId:   f.GrantID,
+		RequestId: f.RequestID,
+		GroupId:   f.GroupID,
+		Action:    f.Action,
+		Priority:  f.Priority,
+		Reason:    f.Reason,
+		Attempts:  f.Attempts,
+		Target:    f.Grant.ToAPI(),
+		CreatedAt: f.CreatedAt,
+	}
+}
+
+func (f *FailedGrant) DDBKeys() (ddb.Keys, error) {
+	keys := ddb.Keys{
+		PK: keys.FailedGrant.PK1,
+		SK: keys.FailedGrant.SK1(f.GrantID),
+	}
+	return keys, nil
+}
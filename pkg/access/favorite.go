@@ -0,0 +1,37 @@
+package access
+
+import (
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/cache"
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+)
+
+// Favorite is a target which a user has saved so that they can quickly request it again.
+type Favorite struct {
+	UserID string `json:"userId" dynamodbav:"userId"`
+	// TargetID is the deterministic ID of the cached target
+	TargetID string `json:"targetId" dynamodbav:"targetId"`
+	// Target is a snapshot of the target when it was favorited, it is used to display the favorite if the target is removed from the cache.
+	Target    cache.Target `json:"target" dynamodbav:"target"`
+	CreatedAt time.Time    `json:"createdAt" dynamodbav:"createdAt"`
+}
+
+func (f *Favorite) ToAPI(eligible bool) types.Favorite {
+	return types.Favorite{
+		TargetId:  f.TargetID,
+		Target:    f.Target.ToAPI(),
+		Eligible:  eligible,
+		CreatedAt: f.CreatedAt,
+	}
+}
+
+func (f *Favorite) DDBKeys() (ddb.Keys, error) {
+	keys := ddb.Keys{
+		PK: keys.Favorite.PK1(f.UserID),
+		SK: keys.Favorite.SK1(f.TargetID),
+	}
+	return keys, nil
+}
@@ -0,0 +1,171 @@
+package access
+
+import (
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+)
+
+// Freeze stops new access from being requested, approved or activated, for example during a change freeze or an incident.
+// A freeze applies to everything (GLOBAL), or to a single target group or access rule.
+type Freeze struct {
+	ID    string            `json:"id" dynamodbav:"id"`
+	Scope types.FreezeScope `json:"scope" dynamodbav:"scope"`
+	// ScopeID is the target group or access rule ID, it is empty for GLOBAL freezes
+	ScopeID string `json:"scopeId,omitempty" dynamodbav:"scopeId,omitempty"`
+	Message string `json:"message" dynamodbav:"message"`
+	// EndsAt is optional, freezes without an end time stay active until they are lifted
+	EndsAt *time.Time `json:"endsAt,omitempty" dynamodbav:"endsAt,omitempty"`
+	// ExemptGroups are identity groups which are not affected by the freeze, for example break-glass groups
+	ExemptGroups []string     `json:"exemptGroups" dynamodbav:"exemptGroups"`
+	CreatedBy    RequestedBy  `json:"createdBy" dynamodbav:"createdBy"`
+	CreatedAt    time.Time    `json:"createdAt" dynamodbav:"createdAt"`
+	UpdatedAt    time.Time    `json:"updatedAt" dynamodbav:"updatedAt"`
+	LiftedBy     *RequestedBy `json:"liftedBy,omitempty" dynamodbav:"liftedBy,omitempty"`
+	LiftedAt     *time.Time   `json:"liftedAt,omitempty" dynamodbav:"liftedAt,omitempty"`
+}
+
+// Active returns true if the freeze has not been lifted and has not ended.
+func (f *Freeze) Active(now time.Time) bool {
+	if f.LiftedAt != nil {
+		return false
+	}
+	return f.EndsAt == nil || f.EndsAt.After(now)
+}
+
+// Exempt returns true if any of the groups are exempt from the freeze.
+func (f *Freeze) Exempt(groups []string) bool {
+	for _, exempt := range f.ExemptGroups {
+		for _, g := range groups {
+			if g == exempt {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Applies returns true if the freeze covers any of the access rules or target groups.
+func (f *Freeze) Applies(accessRuleIDs []string, targetGroupIDs []string) bool {
+	var ids []string
+	switch f.Scope {
+	case types.FreezeScopeGLOBAL:
+		return true
+	case types.FreezeScopeACCESSRULE:
+		ids = accessRuleIDs
+	case types.FreezeScopeTARGETGROUP:
+		ids = targetGroupIDs
+	}
+	for _, id := range ids {
+		if id == f.ScopeID {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *Freeze) ToAPI(now time.Time) types.Freeze {
+	out := types.Freeze{
+		Id:           f.ID,
+		Scope:        f.Scope,
+		Message:      f.Message,
+		EndsAt:       f.EndsAt,
+		ExemptGroups: f.ExemptGroups,
+		Active:       f.Active(now),
+		CreatedBy:    f.CreatedBy.ID,
+		CreatedAt:    f.CreatedAt,
+		UpdatedAt:    f.UpdatedAt,
+		LiftedAt:     f.LiftedAt,
+	}
+	if out.ExemptGroups == nil {
+		out.ExemptGroups = []string{}
+	}
+	if f.ScopeID != "" {
+		out.ScopeId = &f.ScopeID
+	}
+	if f.LiftedBy != nil {
+		out.LiftedBy = &f.LiftedBy.ID
+	}
+	return out
+}
+
+func (f *Freeze) DDBKeys() (ddb.Keys, error) {
+	k := ddb.Keys{
+		PK: keys.Freeze.PK1,
+		SK: keys.Freeze.SK1(f.ID),
+	}
+	// lifted freezes are never active again, so they are removed from the index of active freezes
+	if f.LiftedAt == nil {
+		k.GSI1PK = keys.Freeze.GSI1PK
+		k.GSI1SK = keys.Freeze.GSI1SK(f.EndsAt, f.ID)
+	}
+	return k, nil
+}
+
+// FreezeHistoryEvent is an audit record of a change made to a freeze.
+// It includes a snapshot of the freeze after the change was made.
+type FreezeHistoryEvent struct {
+	ID        string                         `json:"id" dynamodbav:"id"`
+	FreezeID  string                         `json:"freezeId" dynamodbav:"freezeId"`
+	Action    types.FreezeHistoryEventAction `json:"action" dynamodbav:"action"`
+	Actor     RequestedBy                    `json:"actor" dynamodbav:"actor"`
+	CreatedAt time.Time                      `json:"createdAt" dynamodbav:"createdAt"`
+	Freeze    Freeze                         `json:"freeze" dynamodbav:"freeze"`
+}
+
+func (e *FreezeHistoryEvent) ToAPI(now time.Time) types.FreezeHistoryEvent {
+	return types.FreezeHistoryEvent{
+		Id:        e.ID,
+		FreezeId:  e.FreezeID,
+		Action:    e.Action,
+		Actor:     e.Actor.ID,
+		CreatedAt: e.CreatedAt,
+		Freeze:    e.Freeze.ToAPI(now),
+	}
+}
+
+func (e *FreezeHistoryEvent) DDBKeys() (ddb.Keys, error) {
+	keys := ddb.Keys{
+		PK: keys.FreezeHistoryEvent.PK1,
+		SK: keys.FreezeHistoryEvent.SK1(e.FreezeID, e.ID),
+	}
+	return keys, nil
+}
+
+// HeldActivation is an access group whose activation was held because of a freeze.
+// Held activations are released when the freeze is lifted.
+type HeldActivation struct {
+	RequestID string    `json:"requestId" dynamodbav:"requestId"`
+	GroupID   string    `json:"groupId" dynamodbav:"groupId"`
+	FreezeIDs []string  `json:"freezeIds" dynamodbav:"freezeIds"`
+	HeldAt    time.Time `json:"heldAt" dynamodbav:"heldAt"`
+}
+
+func (h *HeldActivation) DDBKeys() (ddb.Keys, error) {
+	keys := ddb.Keys{
+		PK: keys.HeldActivation.PK1,
+		SK: keys.HeldActivation.SK1(h.RequestID, h.GroupID),
+	}
+	return keys, nil
+}
+
+// FreezeScopes returns the access rule and target group IDs which freezes are matched against.
+func (p *Preflight) FreezeScopes() (accessRuleIDs []string, targetGroupIDs []string) {
+	for _, g := range p.AccessGroups {
+		accessRuleIDs = append(accessRuleIDs, g.AccessRule)
+		for _, t := range g.Targets {
+			targetGroupIDs = append(targetGroupIDs, t.TargetGroupID)
+		}
+	}
+	return accessRuleIDs, targetGroupIDs
+}
+
+// FreezeScopes returns the access rule and target group IDs which freezes are matched against.
+func (g *GroupWithTargets) FreezeScopes() (accessRuleIDs []string, targetGroupIDs []string) {
+	for _, t := range g.Targets {
+		targetGroupIDs = append(targetGroupIDs, t.TargetGroupID)
+	}
+	return []string{g.Group.AccessRuleSnapshot.ID}, targetGroupIDs
+}
@@ -0,0 +1,45 @@
+package access
+
+import (
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+)
+
+// GrantDrift is a difference between a grant and the access reported by its handler,
+// found by the drift reconciler.
+type GrantDrift struct {
+	GrantID    string               `json:"grantId" dynamodbav:"grantId"`
+	RequestID  string               `json:"requestId" dynamodbav:"requestId"`
+	GroupID    string               `json:"groupId" dynamodbav:"groupId"`
+	Kind       types.GrantDriftKind `json:"kind" dynamodbav:"kind"`
+	DetectedAt time.Time            `json:"detectedAt" dynamodbav:"detectedAt"`
+	// Resolved is true if access which outlived the grant was revoked by the reconciler
+	Resolved        bool    `json:"resolved" dynamodbav:"resolved"`
+	ResolutionError *string `json:"resolutionError,omitempty" dynamodbav:"resolutionError,omitempty"`
+	// Grant is a snapshot of the grant when the drift was detected
+	Grant GroupTarget `json:"grant" dynamodbav:"grant"`
+}
+
+func (d *GrantDrift) ToAPI() types.GrantDrift {
+	return types.GrantDrift{
+		GrantId:         d.GrantID,
+		RequestId:       d.RequestID,
+		GroupId:         d.GroupID,
+		Kind:            d.Kind,
+		DetectedAt:      d.DetectedAt,
+		Resolved:        d.Resolved,
+		ResolutionError: d.ResolutionError,
+		Target:          d.Grant.ToAPI(),
+	}
+}
+
+func (d *GrantDrift) DDBKeys() (ddb.Keys, error) {
+	keys := ddb.Keys{
+		PK: keys.GrantDrift.PK1,
+		SK: keys.GrantDrift.SK1(d.GrantID, string(d.Kind)),
+	}
+	return keys, nil
+}
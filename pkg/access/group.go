@@ -1,6 +1,7 @@
 package access
 
 import (
+	"errors"
 	"time"
 
 	"github.com/common-fate/analytics-go"
@@ -27,13 +28,18 @@ type Group struct {
 	RequestedTiming      Timing              `json:"requestedTiming" dynamodbav:"requestedTiming"`
 	FinalTiming          *FinalTiming        `json:"finalTiming" dynamodbav:"finalTiming"`
 	OverrideTiming       *Timing             `json:"overrideTimings,omitempty" dynamodbav:"overrideTimings,omitempty"`
-	RequestedBy          RequestedBy         `json:"requestedBy" dynamodbav:"requestedBy"`
-	CreatedAt            time.Time           `json:"createdAt" dynamodbav:"createdAt"`
-	UpdatedAt            time.Time           `json:"updatedAt" dynamodbav:"updatedAt"`
+	// ActivationDeadline is set when on demand access is approved, the approval expires if access isn't activated by this time
+	ActivationDeadline *time.Time  `json:"activationDeadline,omitempty" dynamodbav:"activationDeadline,omitempty"`
+	ActivatedAt        *time.Time  `json:"activatedAt,omitempty" dynamodbav:"activatedAt,omitempty"`
+	RequestedBy        RequestedBy `json:"requestedBy" dynamodbav:"requestedBy"`
+	CreatedAt          time.Time   `json:"createdAt" dynamodbav:"createdAt"`
+	UpdatedAt          time.Time   `json:"updatedAt" dynamodbav:"updatedAt"`
 	// request reviewers are users who have one or more groups to review on the request as a whole
 	RequestReviewers []string `json:"requestReviewers" dynamodbav:"requestReviewers, set"`
 	// groupReviewers are the users who are able to review this access group; id = access.Reviewer.ID
 	GroupReviewers []string `json:"groupReviewers" dynamodbav:"groupReviewers, set"`
+
+	transition pendingTransition
 }
 
 type FinalTiming struct {
@@ -56,16 +62,17 @@ func (r *GroupWithTargets) DBItems() []ddb.Keyer {
 }
 func (g *GroupWithTargets) ToAPI() types.RequestAccessGroup {
 	out := types.RequestAccessGroup{
-		Id:              g.Group.ID,
-		RequestId:       g.Group.RequestID,
-		Status:          g.Group.Status,
-		RequestedTiming: g.Group.RequestedTiming.ToAPI(),
-		Targets:         []types.RequestAccessGroupTarget{},
-		ApprovalMethod:  g.Group.ApprovalMethod,
-		CreatedAt:       g.Group.CreatedAt,
-		UpdatedAt:       g.Group.UpdatedAt,
-		RequestedBy:     types.RequestRequestedBy(g.Group.RequestedBy),
-		RequestStatus:   g.Group.RequestStatus,
+		Id:                 g.Group.ID,
+		RequestId:          g.Group.RequestID,
+		Status:             g.Group.Status,
+		RequestedTiming:    g.Group.RequestedTiming.ToAPI(),
+		Targets:            []types.RequestAccessGroupTarget{},
+		ApprovalMethod:     g.Group.ApprovalMethod,
+		CreatedAt:          g.Group.CreatedAt,
+		UpdatedAt:          g.Group.UpdatedAt,
+		RequestedBy:        types.RequestRequestedBy(g.Group.RequestedBy),
+		RequestStatus:      g.Group.RequestStatus,
+		ActivationDeadline: g.Group.ActivationDeadline,
 	}
 	if g.Group.FinalTiming != nil {
 		out.FinalTiming = &types.RequestAccessGroupFinalTiming{
@@ -91,6 +98,14 @@ func (g *GroupWithTargets) ToAPI() types.RequestAccessGroup {
 
 }
 
+// IsOnDemand is true if the group's effective timing waits for the requester to activate access.
+func (r *Group) IsOnDemand() bool {
+	if r.OverrideTiming != nil {
+		return r.OverrideTiming.OnDemand
+	}
+	return r.RequestedTiming.OnDemand
+}
+
 func (r *Group) GetInterval(opts ...func(o *GetIntervalOpts)) (start time.Time, end time.Time) {
 	if r.OverrideTiming != nil {
 		return r.OverrideTiming.GetInterval(opts...)
@@ -106,6 +121,9 @@ type Timing struct {
 	Duration time.Duration `json:"duration" dynamodbav:"duration"`
 	// If the start time is not nil, this request is for scheduled access, if it is nil, then the request is for asap access
 	StartTime *time.Time `json:"start,omitempty" dynamodbav:"start,omitempty"`
+	// OnDemand access waits after it is approved until the requester activates it.
+	// Once activated it is treated the same as asap access.
+	OnDemand bool `json:"onDemand,omitempty" dynamodbav:"onDemand,omitempty"`
 }
 
 func (t Timing) ToAnalytics() analytics.Timing {
@@ -120,12 +138,20 @@ func (t Timing) ToAnalytics() analytics.Timing {
 	}
 }
 
-// TimingFromRequestTiming converts from the api type to the internal type
-func TimingFromRequestTiming(r types.RequestAccessGroupTiming) Timing {
+// ErrOnDemandWithStartTime is returned if on demand access is requested with a start time,
+// as on demand access starts when the requester activates it.
+var ErrOnDemandWithStartTime = errors.New("on demand access can't be scheduled with a start time")
 
+// TimingFromRequestTiming converts from the api type to the internal type
+func TimingFromRequestTiming(r types.RequestAccessGroupTiming) (Timing, error) {
+	onDemand := r.OnDemand != nil && *r.OnDemand
+	if onDemand && r.StartTime != nil {
+		return Timing{}, ErrOnDemandWithStartTime
+	}
 	return Timing{
 		Duration: time.Second * time.Duration(r.DurationSeconds),
-	}
+		OnDemand: onDemand,
+	}, nil
 }
 
 // IsScheduled is true if the startTime is not nil
@@ -135,10 +161,14 @@ func (t *Timing) IsScheduled() bool {
 
 // ToAPI returns the api representation of the timing information
 func (t *Timing) ToAPI() types.RequestAccessGroupTiming {
-	return types.RequestAccessGroupTiming{
+	out := types.RequestAccessGroupTiming{
 		DurationSeconds: int(t.Duration.Seconds()),
 		StartTime:       t.StartTime,
 	}
+	if t.OnDemand {
+		out.OnDemand = &t.OnDemand
+	}
+	return out
 }
 
 // WithNow allows you to override the now time used by getInterval
@@ -169,13 +199,19 @@ type GetIntervalOpts struct {
 }
 
 func (i *Group) DDBKeys() (ddb.Keys, error) {
-	keys := ddb.Keys{
+	k := ddb.Keys{
 		PK:     keys.AccessRequestGroup.PK1,
 		SK:     keys.AccessRequestGroup.SK1(i.RequestID, i.ID),
 		GSI1PK: keys.AccessRequestGroup.GSI1PK(i.RequestedBy.ID),
 		GSI1SK: keys.AccessRequestGroup.GSI1SK(RequestStatusToPastOrUpcoming(i.RequestStatus), i.RequestID, i.ID),
 		GSI2PK: keys.AccessRequestGroup.GSI2PK(i.RequestStatus),
 		GSI2SK: keys.AccessRequestGroup.GSI2SK(i.RequestID, i.ID),
+		GSI3PK: keys.AccessRequestGroup.GSI3PK(i.AccessRuleSnapshot.ID, i.RequestStatus),
+		GSI3SK: keys.AccessRequestGroup.GSI3SK(i.RequestID, i.ID),
+	}
+	if i.Status == types.RequestAccessGroupStatusAWAITINGACTIVATION && i.ActivationDeadline != nil {
+		k.GSI4PK = keys.AccessRequestGroup.GSI4PK
+		k.GSI4SK = keys.AccessRequestGroup.GSI4SK(*i.ActivationDeadline, i.RequestID, i.ID)
 	}
-	return keys, nil
+	return k, nil
 }
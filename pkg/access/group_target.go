@@ -23,6 +23,8 @@ type GroupTarget struct {
 	TargetGroupID string     `json:"targetGroupId" dynamodbav:"targetGroupId"`
 	TargetKind    cache.Kind `json:"targetGroupFrom" dynamodbav:"targetGroupFrom"`
 	Fields        []Field    `json:"fields" dynamodbav:"fields"`
+	// TargetMetadata is a copy of the admin curated tags on the cached target at the time the request was made
+	TargetMetadata map[string]string `json:"targetMetadata,omitempty" dynamodbav:"targetMetadata,omitempty"`
 	// The grant will be populated when this target is submitted to be provisioned
 	// The start and end time are calculated and stored on the grant when it is provisioned
 	Grant     *Grant    `json:"grant" dynamodbav:"grant"`
@@ -30,6 +32,8 @@ type GroupTarget struct {
 	UpdatedAt time.Time `json:"updatedAt" dynamodbav:"updatedAt"`
 	// request reviewers are users who have one or more groups to review on the request as a whole
 	RequestReviewers []string `json:"requestReviewers" dynamodbav:"requestReviewers, set"`
+
+	transition pendingTransition
 }
 
 func (g *GroupTarget) FieldsToMap() map[string]string {
@@ -89,6 +93,9 @@ func (g *GroupTarget) ToAPI() types.RequestAccessGroupTarget {
 	for _, field := range g.Fields {
 		grant.Fields = append(grant.Fields, field.ToAPI())
 	}
+	if len(g.TargetMetadata) > 0 {
+		grant.Metadata = &types.RequestAccessGroupTarget_Metadata{AdditionalProperties: g.TargetMetadata}
+	}
 
 	return grant
 }
@@ -100,6 +107,8 @@ func (i *GroupTarget) DDBKeys() (ddb.Keys, error) {
 		GSI1SK: keys.AccessRequestGroupTarget.GSI1SK(RequestStatusToPastOrUpcoming(i.RequestStatus), i.RequestID, i.GroupID, i.ID),
 		GSI2PK: keys.AccessRequestGroupTarget.GSI2PK(i.RequestStatus),
 		GSI2SK: keys.AccessRequestGroupTarget.GSI2SK(i.RequestID, i.GroupID, i.ID),
+		GSI4PK: keys.AccessRequestGroupTarget.GSI4PK(i.TargetGroupID, i.RequestStatus),
+		GSI4SK: keys.AccessRequestGroupTarget.GSI4SK(i.RequestID, i.GroupID, i.ID),
 	}
 	return keys, nil
 }
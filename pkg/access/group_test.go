@@ -0,0 +1,70 @@
+package access
+
+import (
+	"testing"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAwaitingActivationKeys(t *testing.T) {
+	deadline := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	later := deadline.Add(500 * time.Millisecond)
+
+	awaiting := Group{ID: "grp1", RequestID: "req1", Status: types.RequestAccessGroupStatusAWAITINGACTIVATION, ActivationDeadline: &deadline}
+	k, err := awaiting.DDBKeys()
+	assert.NoError(t, err)
+	assert.Equal(t, keys.AccessRequestGroup.GSI4PK, k.GSI4PK)
+	// deadlines sort in time order, including fractional seconds
+	assert.Less(t, k.GSI4SK, keys.AccessRequestGroup.GSI4SK(later, "req0", "grp0"))
+	assert.Less(t, k.GSI4SK, keys.ActivationDeadlineKey(later))
+	assert.Greater(t, k.GSI4SK, keys.ActivationDeadlineKey(deadline))
+
+	// only groups awaiting activation are in the index
+	activated := awaiting
+	activated.Status = types.RequestAccessGroupStatusAPPROVED
+	k, err = activated.DDBKeys()
+	assert.NoError(t, err)
+	assert.Empty(t, k.GSI4PK)
+	assert.Empty(t, k.GSI4SK)
+}
+
+func TestTimingFromRequestTiming(t *testing.T) {
+	start := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	onDemand := true
+
+	type testcase struct {
+		name    string
+		give    types.RequestAccessGroupTiming
+		want    Timing
+		wantErr error
+	}
+
+	testcases := []testcase{
+		{
+			name: "asap",
+			give: types.RequestAccessGroupTiming{DurationSeconds: 60},
+			want: Timing{Duration: time.Minute},
+		},
+		{
+			name: "on demand",
+			give: types.RequestAccessGroupTiming{DurationSeconds: 60, OnDemand: &onDemand},
+			want: Timing{Duration: time.Minute, OnDemand: true},
+		},
+		{
+			name:    "on demand with a start time",
+			give:    types.RequestAccessGroupTiming{DurationSeconds: 60, OnDemand: &onDemand, StartTime: &start},
+			wantErr: ErrOnDemandWithStartTime,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := TimingFromRequestTiming(tc.give)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package access
+
+import (
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+	"github.com/common-fate/ddb"
+)
+
+// IdempotencyKey maps an Idempotency-Key sent by a client to the result of the call it was sent with,
+// so that a retried call returns the original result rather than repeating the operation.
+type IdempotencyKey struct {
+	Key       string `json:"key" dynamodbav:"key"`
+	UserID    string `json:"userId" dynamodbav:"userId"`
+	Operation string `json:"operation" dynamodbav:"operation"`
+	// RequestHash is a hash of the request body. A key can't be reused with a different body.
+	RequestHash string `json:"requestHash" dynamodbav:"requestHash"`
+	// ResultID is the ID of the resource which was created. It is empty while the call is in progress.
+	ResultID  string    `json:"resultId,omitempty" dynamodbav:"resultId,omitempty"`
+	CreatedAt time.Time `json:"createdAt" dynamodbav:"createdAt"`
+	// ExpiresAt is a unix timestamp, which is used as the DynamoDB TTL for the key.
+	ExpiresAt int64 `json:"ttl" dynamodbav:"ttl"`
+}
+
+// Expired returns true if the key has passed its TTL. DynamoDB deletes expired items in the background,
+// so expired keys can still be returned by queries for a while.
+func (k *IdempotencyKey) Expired(now time.Time) bool {
+	return k.ExpiresAt <= now.Unix()
+}
+
+func (k *IdempotencyKey) DDBKeys() (ddb.Keys, error) {
+	keys := ddb.Keys{
+		PK: keys.IdempotencyKey.PK1,
+		SK: keys.IdempotencyKey.SK1(k.UserID, k.Operation, k.Key),
+	}
+	return keys, nil
+}
+
+// GrantClaim is written with a conditional write before a target is provisioned,
+// so that a target is only provisioned once when the grant workflow is started more than once.
+//
+// Once the target is saved with its grant the claim is no longer needed, as the workflow skips targets which have a grant.
+// Claims expire so that a target can be claimed again if the workflow stopped before the target was saved.
+type GrantClaim struct {
+	TargetID  string    `json:"targetId" dynamodbav:"targetId"`
+	RequestID string    `json:"requestId" dynamodbav:"requestId"`
+	GroupID   string    `json:"groupId" dynamodbav:"groupId"`
+	ClaimedAt time.Time `json:"claimedAt" dynamodbav:"claimedAt"`
+	// ExpiresAt is a unix timestamp, which is used as the DynamoDB TTL for the claim.
+	ExpiresAt int64 `json:"ttl" dynamodbav:"ttl"`
+}
+
+func (c *GrantClaim) DDBKeys() (ddb.Keys, error) {
+	keys := ddb.Keys{
+		PK: keys.GrantClaim.PK1,
+		SK: keys.GrantClaim.SK1(c.TargetID),
+	}
+	return keys, nil
+}
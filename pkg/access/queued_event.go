@@ -0,0 +1,34 @@
+package access
+
+import (
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+	"github.com/common-fate/ddb"
+)
+
+// QueuedEvent is an event waiting to be delivered to a subscriber by the database event queue.
+// A copy of each event is stored for every subscriber, and it is deleted once the subscriber has handled it.
+type QueuedEvent struct {
+	// Sequence orders the events for a subscriber in the order they were sent.
+	Sequence   string `json:"sequence" dynamodbav:"sequence"`
+	Subscriber string `json:"subscriber" dynamodbav:"subscriber"`
+	// OrderingKey groups events which must be handled in order, such as the events for a request.
+	OrderingKey string `json:"orderingKey" dynamodbav:"orderingKey"`
+	DetailType  string `json:"detailType" dynamodbav:"detailType"`
+	// Detail is the JSON event payload
+	Detail    string `json:"detail" dynamodbav:"detail"`
+	Attempts  int    `json:"attempts" dynamodbav:"attempts"`
+	LastError string `json:"lastError,omitempty" dynamodbav:"lastError,omitempty"`
+	// NextAttemptAt is set after a failed attempt, the event isn't delivered again until this time.
+	NextAttemptAt *time.Time `json:"nextAttemptAt,omitempty" dynamodbav:"nextAttemptAt,omitempty"`
+	CreatedAt     time.Time  `json:"createdAt" dynamodbav:"createdAt"`
+}
+
+func (e *QueuedEvent) DDBKeys() (ddb.Keys, error) {
+	keys := ddb.Keys{
+		PK: keys.QueuedEvent.PK1,
+		SK: keys.QueuedEvent.SK1(e.Subscriber, e.Sequence),
+	}
+	return keys, nil
+}
@@ -27,6 +27,8 @@ type Request struct {
 	CreatedAt        time.Time   `json:"createdAt" dynamodbav:"createdAt"`
 	// request reviewers are users who have one or more groups to review on the request as a whole; id = access.Reviewer.ID
 	RequestReviewers []string `json:"requestReviewers" dynamodbav:"requestReviewers, set"`
+
+	transition pendingTransition
 }
 
 type RequestWithGroupsWithTargets struct {
@@ -50,18 +52,6 @@ func (r *RequestWithGroupsWithTargets) AllGroupsDeclined() bool {
 	}
 	return true
 }
-func (r *RequestWithGroupsWithTargets) UpdateStatus(status types.RequestStatus) {
-	r.Request.RequestStatus = status
-	for i, g := range r.Groups {
-		g.Group.RequestStatus = status
-		for i, t := range g.Targets {
-			t.RequestStatus = status
-			g.Targets[i] = t
-		}
-		r.Groups[i] = g
-	}
-}
-
 func (r *RequestWithGroupsWithTargets) DBItems() []ddb.Keyer {
 	var items []ddb.Keyer
 	items = append(items, &r.Request)
@@ -28,6 +28,16 @@ type RequestEvent struct {
 	GrantFailureReason *string                               `json:"grantFailureReason,omitempty" dynamodbav:"grantFailureReason,omitempty"`
 	RequestCreated     *bool                                 `json:"requestCreated,omitempty" dynamodbav:"requestCreated,omitempty"`
 	RecordedEvent      *map[string]string                    `json:"recordedEvent,omitempty" dynamodbav:"recordedEvent,omitempty"`
+	GrantAttempt       *GrantAttempt                         `json:"grantAttempt,omitempty" dynamodbav:"grantAttempt,omitempty"`
+}
+
+// GrantAttempt records a failed attempt to activate or deactivate a grant.
+type GrantAttempt struct {
+	Action     types.GrantAction `json:"action" dynamodbav:"action"`
+	Attempt    int               `json:"attempt" dynamodbav:"attempt"`
+	Error      string            `json:"error" dynamodbav:"error"`
+	ErrorClass string            `json:"errorClass" dynamodbav:"errorClass"`
+	WillRetry  bool              `json:"willRetry" dynamodbav:"willRetry"`
 }
 
 func NewRequestCreatedEvent(requestID string, createdAt time.Time, actor *string) RequestEvent {
@@ -71,6 +81,10 @@ func NewRecordedEvent(requestID string, actor *string, createdAt time.Time, even
 	return RequestEvent{ID: types.NewHistoryID(), Actor: actor, CreatedAt: createdAt, RequestID: requestID, RecordedEvent: &event}
 }
 
+func NewGrantAttemptEvent(requestID string, createdAt time.Time, attempt GrantAttempt) RequestEvent {
+	return RequestEvent{ID: types.NewHistoryID(), CreatedAt: createdAt, RequestID: requestID, GrantAttempt: &attempt}
+}
+
 func (r *RequestEvent) ToAPI() types.RequestEvent {
 	var toTiming *types.RequestAccessGroupTiming
 	var fromTiming *types.RequestAccessGroupTiming
@@ -106,6 +120,15 @@ func (r *RequestEvent) ToAPI() types.RequestEvent {
 		out.Target = &t
 
 	}
+	if r.GrantAttempt != nil {
+		out.GrantAttempt = &types.GrantAttempt{
+			Action:     r.GrantAttempt.Action,
+			Attempt:    r.GrantAttempt.Attempt,
+			Error:      r.GrantAttempt.Error,
+			ErrorClass: r.GrantAttempt.ErrorClass,
+			WillRetry:  r.GrantAttempt.WillRetry,
+		}
+	}
 	return out
 
 }
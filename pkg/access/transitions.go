@@ -0,0 +1,244 @@
+package access
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/common-fate/common-fate/pkg/types"
+)
+
+// RequestStatusError is used for requests where every grant failed. It isn't part of the API enum.
+const RequestStatusError = types.RequestStatus("ERROR")
+
+// GrantStatusNone is the status of a target which hasn't been submitted to be provisioned.
+const GrantStatusNone = types.RequestAccessGroupTargetStatus("")
+
+// requestTransitions lists the statuses which a request can move to from each status.
+// Statuses which aren't listed are final.
+//
+// Approved groups are granted before the other groups in the request are reviewed,
+// so a PENDING request can end in the same ways as an ACTIVE one.
+var requestTransitions = map[types.RequestStatus][]types.RequestStatus{
+	types.PENDING:  {types.ACTIVE, types.CANCELLED, types.COMPLETE, types.REVOKING, types.REVOKED, RequestStatusError},
+	types.ACTIVE:   {types.REVOKING, types.REVOKED, types.COMPLETE, RequestStatusError},
+	types.REVOKING: {types.REVOKED, types.COMPLETE, RequestStatusError},
+}
+
+// groupTransitions lists the statuses which an access group can move to from each status.
+var groupTransitions = map[types.RequestAccessGroupStatus][]types.RequestAccessGroupStatus{
+	types.RequestAccessGroupStatusPENDINGAPPROVAL:    {types.RequestAccessGroupStatusAPPROVED, types.RequestAccessGroupStatusDECLINED},
+	types.RequestAccessGroupStatusAPPROVED:           {types.RequestAccessGroupStatusAWAITINGACTIVATION},
+	types.RequestAccessGroupStatusAWAITINGACTIVATION: {types.RequestAccessGroupStatusAPPROVED, types.RequestAccessGroupStatusAPPROVALEXPIRED},
+}
+
+// grantTransitions lists the statuses which the grant for a target can move to from each status.
+// Grants in the ERROR state can be retried, or completed by an admin.
+var grantTransitions = map[types.RequestAccessGroupTargetStatus][]types.RequestAccessGroupTargetStatus{
+	GrantStatusNone: {
+		types.RequestAccessGroupTargetStatusAWAITINGSTART,
+		types.RequestAccessGroupTargetStatusERROR,
+	},
+	types.RequestAccessGroupTargetStatusAWAITINGSTART: {
+		types.RequestAccessGroupTargetStatusPENDINGPROVISIONING,
+		types.RequestAccessGroupTargetStatusACTIVE,
+		types.RequestAccessGroupTargetStatusEXPIRED,
+		types.RequestAccessGroupTargetStatusREVOKED,
+		types.RequestAccessGroupTargetStatusERROR,
+	},
+	types.RequestAccessGroupTargetStatusPENDINGPROVISIONING: {
+		types.RequestAccessGroupTargetStatusACTIVE,
+		types.RequestAccessGroupTargetStatusREVOKED,
+		types.RequestAccessGroupTargetStatusERROR,
+	},
+	types.RequestAccessGroupTargetStatusACTIVE: {
+		types.RequestAccessGroupTargetStatusEXPIRED,
+		types.RequestAccessGroupTargetStatusREVOKED,
+		types.RequestAccessGroupTargetStatusERROR,
+	},
+	types.RequestAccessGroupTargetStatusERROR: {
+		types.RequestAccessGroupTargetStatusAWAITINGSTART,
+		types.RequestAccessGroupTargetStatusACTIVE,
+		types.RequestAccessGroupTargetStatusEXPIRED,
+		types.RequestAccessGroupTargetStatusREVOKED,
+	},
+}
+
+// ErrStaleStatus is returned if an item's status was changed by another writer after it was read.
+var ErrStaleStatus = errors.New("the status was changed by another writer")
+
+// InvalidTransitionError is returned if a status change isn't allowed by the transition tables.
+type InvalidTransitionError struct {
+	// Kind is "request", "group" or "grant"
+	Kind string
+	From string
+	To   string
+}
+
+func (e InvalidTransitionError) Error() string {
+	from := e.From
+	if from == "" {
+		from = "none"
+	}
+	return fmt.Sprintf("invalid %s status transition from %s to %s", e.Kind, from, e.To)
+}
+
+func canTransition[T comparable](table map[T][]T, from, to T) bool {
+	// writing the same status again is allowed, so that replayed events are idempotent
+	if from == to {
+		return true
+	}
+	for _, s := range table[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateRequestTransition returns an InvalidTransitionError if a request can't move from one status to another.
+func ValidateRequestTransition(from, to types.RequestStatus) error {
+	if !canTransition(requestTransitions, from, to) {
+		return InvalidTransitionError{Kind: "request", From: string(from), To: string(to)}
+	}
+	return nil
+}
+
+// ValidateGroupTransition returns an InvalidTransitionError if an access group can't move from one status to another.
+func ValidateGroupTransition(from, to types.RequestAccessGroupStatus) error {
+	if !canTransition(groupTransitions, from, to) {
+		return InvalidTransitionError{Kind: "group", From: string(from), To: string(to)}
+	}
+	return nil
+}
+
+// ValidateGrantTransition returns an InvalidTransitionError if a grant can't move from one status to another.
+func ValidateGrantTransition(from, to types.RequestAccessGroupTargetStatus) error {
+	if !canTransition(grantTransitions, from, to) {
+		return InvalidTransitionError{Kind: "grant", From: string(from), To: string(to)}
+	}
+	return nil
+}
+
+// StatusTransition describes a status change which hasn't been written yet.
+// It is used as the condition for an optimistic write, which fails if the stored status is no longer From.
+type StatusTransition struct {
+	// Path is the path to the status attribute in DynamoDB.
+	Path []string
+	// From is the status when the item was read. An empty status matches items without the attribute.
+	From string
+	To   string
+}
+
+// Transitioner is implemented by items whose status can only be changed with a checked transition.
+type Transitioner interface {
+	// PendingTransition returns the status change since the item was read, if there is one.
+	PendingTransition() (StatusTransition, bool)
+	// TransitionWritten is called once the item has been written.
+	TransitionWritten()
+}
+
+// pendingTransition holds the status an item was read with, until the item is written.
+// It is not stored or sent in events.
+type pendingTransition struct {
+	set  bool
+	from string
+}
+
+// track records the status the item was read with, the first time its status is changed.
+func (p *pendingTransition) track(from string) {
+	if !p.set {
+		p.set = true
+		p.from = from
+	}
+}
+
+func (p *pendingTransition) transition(path []string, to string) (StatusTransition, bool) {
+	if !p.set || p.from == to {
+		return StatusTransition{}, false
+	}
+	return StatusTransition{Path: path, From: p.from, To: to}, true
+}
+
+// UpdateStatus moves the request to a new status, and denormalises the status onto its groups and targets.
+func (r *RequestWithGroupsWithTargets) UpdateStatus(status types.RequestStatus) error {
+	err := r.Request.TransitionStatus(status)
+	if err != nil {
+		return err
+	}
+	for i, g := range r.Groups {
+		g.Group.RequestStatus = status
+		for i, t := range g.Targets {
+			t.RequestStatus = status
+			g.Targets[i] = t
+		}
+		r.Groups[i] = g
+	}
+	return nil
+}
+
+// TransitionStatus moves the request to a new status, if the transition is allowed.
+func (r *Request) TransitionStatus(to types.RequestStatus) error {
+	err := ValidateRequestTransition(r.RequestStatus, to)
+	if err != nil {
+		return err
+	}
+	r.transition.track(string(r.RequestStatus))
+	r.RequestStatus = to
+	return nil
+}
+
+func (r *Request) PendingTransition() (StatusTransition, bool) {
+	return r.transition.transition([]string{"requestStatus"}, string(r.RequestStatus))
+}
+
+func (r *Request) TransitionWritten() {
+	r.transition = pendingTransition{}
+}
+
+// TransitionStatus moves the access group to a new status, if the transition is allowed.
+func (g *Group) TransitionStatus(to types.RequestAccessGroupStatus) error {
+	err := ValidateGroupTransition(g.Status, to)
+	if err != nil {
+		return err
+	}
+	g.transition.track(string(g.Status))
+	g.Status = to
+	return nil
+}
+
+func (g *Group) PendingTransition() (StatusTransition, bool) {
+	return g.transition.transition([]string{"status"}, string(g.Status))
+}
+
+func (g *Group) TransitionWritten() {
+	g.transition = pendingTransition{}
+}
+
+// TransitionGrantStatus moves the target's grant to a new status, if the transition is allowed.
+// The grant must be set before calling this, targets which haven't been provisioned have an empty grant status.
+func (t *GroupTarget) TransitionGrantStatus(to types.RequestAccessGroupTargetStatus) error {
+	if t.Grant == nil {
+		t.Grant = &Grant{}
+	}
+	err := ValidateGrantTransition(t.Grant.Status, to)
+	if err != nil {
+		return err
+	}
+	t.transition.track(string(t.Grant.Status))
+	// the grant is copied, as targets are often copied by value and share the pointer
+	g := *t.Grant
+	g.Status = to
+	t.Grant = &g
+	return nil
+}
+
+func (t *GroupTarget) PendingTransition() (StatusTransition, bool) {
+	if t.Grant == nil {
+		return StatusTransition{}, false
+	}
+	return t.transition.transition([]string{"grant", "status"}, string(t.Grant.Status))
+}
+
+func (t *GroupTarget) TransitionWritten() {
+	t.transition = pendingTransition{}
+}
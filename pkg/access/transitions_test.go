@@ -0,0 +1,196 @@
+package access
+
+import (
+	"testing"
+
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var allRequestStatuses = []types.RequestStatus{
+	types.PENDING, types.ACTIVE, types.REVOKING, types.REVOKED, types.COMPLETE, types.CANCELLED, RequestStatusError,
+}
+
+var allGroupStatuses = []types.RequestAccessGroupStatus{
+	types.RequestAccessGroupStatusPENDINGAPPROVAL,
+	types.RequestAccessGroupStatusAPPROVED,
+	types.RequestAccessGroupStatusDECLINED,
+	types.RequestAccessGroupStatusAWAITINGACTIVATION,
+	types.RequestAccessGroupStatusAPPROVALEXPIRED,
+}
+
+var allGrantStatuses = []types.RequestAccessGroupTargetStatus{
+	GrantStatusNone,
+	types.RequestAccessGroupTargetStatusAWAITINGSTART,
+	types.RequestAccessGroupTargetStatusPENDINGPROVISIONING,
+	types.RequestAccessGroupTargetStatusACTIVE,
+	types.RequestAccessGroupTargetStatusERROR,
+	types.RequestAccessGroupTargetStatusEXPIRED,
+	types.RequestAccessGroupTargetStatusREVOKED,
+}
+
+// TestRequestTransitions checks every pair of request statuses against the expected transitions.
+func TestRequestTransitions(t *testing.T) {
+	allowed := map[[2]types.RequestStatus]bool{
+		{types.PENDING, types.ACTIVE}:        true,
+		{types.PENDING, types.CANCELLED}:     true,
+		{types.PENDING, types.COMPLETE}:      true,
+		{types.PENDING, types.REVOKING}:      true,
+		{types.PENDING, types.REVOKED}:       true,
+		{types.PENDING, RequestStatusError}:  true,
+		{types.ACTIVE, types.REVOKING}:       true,
+		{types.ACTIVE, types.REVOKED}:        true,
+		{types.ACTIVE, types.COMPLETE}:       true,
+		{types.ACTIVE, RequestStatusError}:   true,
+		{types.REVOKING, types.REVOKED}:      true,
+		{types.REVOKING, types.COMPLETE}:     true,
+		{types.REVOKING, RequestStatusError}: true,
+	}
+	for _, from := range allRequestStatuses {
+		for _, to := range allRequestStatuses {
+			want := from == to || allowed[[2]types.RequestStatus{from, to}]
+			err := ValidateRequestTransition(from, to)
+			if want {
+				assert.NoError(t, err, "%s -> %s", from, to)
+			} else {
+				assert.Equal(t, InvalidTransitionError{Kind: "request", From: string(from), To: string(to)}, err, "%s -> %s", from, to)
+			}
+		}
+	}
+}
+
+// TestGroupTransitions checks every pair of access group statuses against the expected transitions.
+func TestGroupTransitions(t *testing.T) {
+	allowed := map[[2]types.RequestAccessGroupStatus]bool{
+		{types.RequestAccessGroupStatusPENDINGAPPROVAL, types.RequestAccessGroupStatusAPPROVED}:           true,
+		{types.RequestAccessGroupStatusPENDINGAPPROVAL, types.RequestAccessGroupStatusDECLINED}:           true,
+		{types.RequestAccessGroupStatusAPPROVED, types.RequestAccessGroupStatusAWAITINGACTIVATION}:        true,
+		{types.RequestAccessGroupStatusAWAITINGACTIVATION, types.RequestAccessGroupStatusAPPROVED}:        true,
+		{types.RequestAccessGroupStatusAWAITINGACTIVATION, types.RequestAccessGroupStatusAPPROVALEXPIRED}: true,
+	}
+	for _, from := range allGroupStatuses {
+		for _, to := range allGroupStatuses {
+			want := from == to || allowed[[2]types.RequestAccessGroupStatus{from, to}]
+			err := ValidateGroupTransition(from, to)
+			if want {
+				assert.NoError(t, err, "%s -> %s", from, to)
+			} else {
+				assert.Equal(t, InvalidTransitionError{Kind: "group", From: string(from), To: string(to)}, err, "%s -> %s", from, to)
+			}
+		}
+	}
+}
+
+// TestGrantTransitions checks every pair of grant statuses against the expected transitions.
+func TestGrantTransitions(t *testing.T) {
+	allowed := map[[2]types.RequestAccessGroupTargetStatus]bool{
+		{GrantStatusNone, types.RequestAccessGroupTargetStatusAWAITINGSTART}: true,
+		{GrantStatusNone, types.RequestAccessGroupTargetStatusERROR}:         true,
+
+		{types.RequestAccessGroupTargetStatusAWAITINGSTART, types.RequestAccessGroupTargetStatusPENDINGPROVISIONING}: true,
+		{types.RequestAccessGroupTargetStatusAWAITINGSTART, types.RequestAccessGroupTargetStatusACTIVE}:              true,
+		{types.RequestAccessGroupTargetStatusAWAITINGSTART, types.RequestAccessGroupTargetStatusEXPIRED}:             true,
+		{types.RequestAccessGroupTargetStatusAWAITINGSTART, types.RequestAccessGroupTargetStatusREVOKED}:             true,
+		{types.RequestAccessGroupTargetStatusAWAITINGSTART, types.RequestAccessGroupTargetStatusERROR}:               true,
+
+		{types.RequestAccessGroupTargetStatusPENDINGPROVISIONING, types.RequestAccessGroupTargetStatusACTIVE}:  true,
+		{types.RequestAccessGroupTargetStatusPENDINGPROVISIONING, types.RequestAccessGroupTargetStatusREVOKED}: true,
+		{types.RequestAccessGroupTargetStatusPENDINGPROVISIONING, types.RequestAccessGroupTargetStatusERROR}:   true,
+
+		{types.RequestAccessGroupTargetStatusACTIVE, types.RequestAccessGroupTargetStatusEXPIRED}: true,
+		{types.RequestAccessGroupTargetStatusACTIVE, types.RequestAccessGroupTargetStatusREVOKED}: true,
+		{types.RequestAccessGroupTargetStatusACTIVE, types.RequestAccessGroupTargetStatusERROR}:   true,
+
+		{types.RequestAccessGroupTargetStatusERROR, types.RequestAccessGroupTargetStatusAWAITINGSTART}: true,
+		{types.RequestAccessGroupTargetStatusERROR, types.RequestAccessGroupTargetStatusACTIVE}:        true,
+		{types.RequestAccessGroupTargetStatusERROR, types.RequestAccessGroupTargetStatusEXPIRED}:       true,
+		{types.RequestAccessGroupTargetStatusERROR, types.RequestAccessGroupTargetStatusREVOKED}:       true,
+	}
+	for _, from := range allGrantStatuses {
+		for _, to := range allGrantStatuses {
+			want := from == to || allowed[[2]types.RequestAccessGroupTargetStatus{from, to}]
+			err := ValidateGrantTransition(from, to)
+			if want {
+				assert.NoError(t, err, "%q -> %q", from, to)
+			} else {
+				assert.Equal(t, InvalidTransitionError{Kind: "grant", From: string(from), To: string(to)}, err, "%q -> %q", from, to)
+			}
+		}
+	}
+}
+
+func TestRequestUpdateStatus(t *testing.T) {
+	r := RequestWithGroupsWithTargets{
+		Request: Request{RequestStatus: types.PENDING},
+		Groups: []GroupWithTargets{
+			{Group: Group{RequestStatus: types.PENDING}, Targets: []GroupTarget{{RequestStatus: types.PENDING}}},
+		},
+	}
+
+	err := r.UpdateStatus(types.ACTIVE)
+	assert.NoError(t, err)
+	err = r.UpdateStatus(types.COMPLETE)
+	assert.NoError(t, err)
+
+	// the condition is on the status the request was read with
+	got, ok := r.Request.PendingTransition()
+	assert.True(t, ok)
+	assert.Equal(t, StatusTransition{Path: []string{"requestStatus"}, From: "PENDING", To: "COMPLETE"}, got)
+	assert.Equal(t, types.COMPLETE, r.Groups[0].Group.RequestStatus)
+	assert.Equal(t, types.COMPLETE, r.Groups[0].Targets[0].RequestStatus)
+
+	r.Request.TransitionWritten()
+	_, ok = r.Request.PendingTransition()
+	assert.False(t, ok)
+
+	err = r.UpdateStatus(types.ACTIVE)
+	assert.Equal(t, InvalidTransitionError{Kind: "request", From: "COMPLETE", To: "ACTIVE"}, err)
+	assert.Equal(t, types.COMPLETE, r.Request.RequestStatus)
+}
+
+func TestGroupTransitionStatus(t *testing.T) {
+	g := Group{Status: types.RequestAccessGroupStatusAPPROVED}
+	_, ok := g.PendingTransition()
+	assert.False(t, ok)
+
+	err := g.TransitionStatus(types.RequestAccessGroupStatusAPPROVED)
+	assert.NoError(t, err)
+	_, ok = g.PendingTransition()
+	assert.False(t, ok, "writing the same status doesn't need a condition")
+
+	err = g.TransitionStatus(types.RequestAccessGroupStatusDECLINED)
+	assert.Equal(t, InvalidTransitionError{Kind: "group", From: "APPROVED", To: "DECLINED"}, err)
+
+	err = g.TransitionStatus(types.RequestAccessGroupStatusAWAITINGACTIVATION)
+	assert.NoError(t, err)
+	got, ok := g.PendingTransition()
+	assert.True(t, ok)
+	assert.Equal(t, StatusTransition{Path: []string{"status"}, From: "APPROVED", To: "AWAITING_ACTIVATION"}, got)
+}
+
+func TestGroupTargetTransitionGrantStatus(t *testing.T) {
+	t.Run("target without a grant", func(t *testing.T) {
+		target := GroupTarget{}
+		err := target.TransitionGrantStatus(types.RequestAccessGroupTargetStatusAWAITINGSTART)
+		assert.NoError(t, err)
+		got, ok := target.PendingTransition()
+		assert.True(t, ok)
+		assert.Equal(t, StatusTransition{Path: []string{"grant", "status"}, From: "", To: "AWAITING_START"}, got)
+	})
+
+	t.Run("grant is copied", func(t *testing.T) {
+		original := GroupTarget{Grant: &Grant{Status: types.RequestAccessGroupTargetStatusACTIVE}}
+		target := original
+		err := target.TransitionGrantStatus(types.RequestAccessGroupTargetStatusEXPIRED)
+		assert.NoError(t, err)
+		assert.Equal(t, types.RequestAccessGroupTargetStatusACTIVE, original.Grant.Status)
+		assert.Equal(t, types.RequestAccessGroupTargetStatusEXPIRED, target.Grant.Status)
+	})
+
+	t.Run("final status", func(t *testing.T) {
+		target := GroupTarget{Grant: &Grant{Status: types.RequestAccessGroupTargetStatusREVOKED}}
+		err := target.TransitionGrantStatus(types.RequestAccessGroupTargetStatusACTIVE)
+		assert.Equal(t, InvalidTransitionError{Kind: "grant", From: "REVOKED", To: "ACTIVE"}, err)
+		assert.Equal(t, types.RequestAccessGroupTargetStatusREVOKED, target.Grant.Status)
+	})
+}
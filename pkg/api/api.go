@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"time"
 
 	registry_types "github.com/common-fate/provider-registry-sdk-go/pkg/providerregistrysdk"
 
@@ -19,17 +20,26 @@ import (
 	"github.com/common-fate/common-fate/pkg/handler"
 	"github.com/common-fate/common-fate/pkg/identity"
 	"github.com/common-fate/common-fate/pkg/identity/identitysync"
+	"github.com/common-fate/common-fate/pkg/natsqueue"
 	"github.com/common-fate/common-fate/pkg/rule"
+	"github.com/common-fate/common-fate/pkg/scim"
 	"github.com/common-fate/common-fate/pkg/service/accesssvc"
+	"github.com/common-fate/common-fate/pkg/service/bulkrevokesvc"
 	"github.com/common-fate/common-fate/pkg/service/cachesvc"
 	"github.com/common-fate/common-fate/pkg/service/cognitosvc"
+	"github.com/common-fate/common-fate/pkg/service/deadlettersvc"
+	"github.com/common-fate/common-fate/pkg/service/freezesvc"
 	"github.com/common-fate/common-fate/pkg/service/handlersvc"
 	"github.com/common-fate/common-fate/pkg/service/healthchecksvc"
+	"github.com/common-fate/common-fate/pkg/service/idempotencysvc"
+	"github.com/common-fate/common-fate/pkg/service/identitysvc"
 	"github.com/common-fate/common-fate/pkg/service/internalidentitysvc"
 	"github.com/common-fate/common-fate/pkg/service/preflightsvc"
 	"github.com/common-fate/common-fate/pkg/service/requestroutersvc"
 	"github.com/common-fate/common-fate/pkg/service/rulesvc"
+	"github.com/common-fate/common-fate/pkg/service/scimsvc"
 	"github.com/common-fate/common-fate/pkg/service/targetsvc"
+	"github.com/common-fate/common-fate/pkg/storage"
 	"github.com/common-fate/common-fate/pkg/target"
 	"github.com/common-fate/common-fate/pkg/types"
 	"github.com/common-fate/ddb"
@@ -64,7 +74,7 @@ type API struct {
 	IdentityProvider string
 	FrontendURL      string
 
-	IdentitySyncer auth.IdentitySyncer
+	IdentitySyncer IdentitySyncer
 	// Set this to nil if cognito is not configured as the IDP for the deployment
 	Cognito            CognitoService
 	InternalIdentity   InternalIdentityService
@@ -72,6 +82,13 @@ type API struct {
 	HandlerService     HandlerService
 	HealthcheckService HealthcheckService
 	PreflightService   PreflightService
+	BulkRevokeService  BulkRevokeService
+	FreezeService      FreezeService
+	DeadLetterService  DeadLetterService
+	// Idempotency is optional, if it is set Idempotency-Key headers are supported when creating requests and preflights
+	Idempotency IdempotencyService
+	// SCIM is optional, it is set if a SCIM token has been configured
+	SCIM *scim.Handler
 }
 
 //go:generate go run github.com/golang/mock/mockgen -destination=mocks/mock_cognito_service.go -package=mocks . CognitoService
@@ -86,12 +103,17 @@ type CognitoService interface {
 type AccessService interface {
 	CreateRequest(ctx context.Context, user identity.User, in types.CreateAccessRequestRequest) (*access.RequestWithGroupsWithTargets, error)
 	RevokeRequest(ctx context.Context, in access.RequestWithGroupsWithTargets) (*access.RequestWithGroupsWithTargets, error)
+	RevokeTarget(ctx context.Context, in access.RequestWithGroupsWithTargets, groupID string, targetID string) (*access.GroupTarget, error)
+	Activate(ctx context.Context, in access.RequestWithGroupsWithTargets, groupID string) (*access.GroupWithTargets, error)
 	Review(ctx context.Context, user identity.User, isAdmin bool, requestID string, groupID string, in types.ReviewRequest) error
 	CancelRequest(ctx context.Context, opts accesssvc.CancelRequestOpts) error
 	CreateAccessTemplate(ctx context.Context, user identity.User, createRequest types.CreateAccessRequestRequest) (*access.AccessTemplate, error)
-
-	// CreateFavorite(ctx context.Context, in accesssvc.CreateFavoriteOpts) (*access.Favorite, error)
-	// UpdateFavorite(ctx context.Context, in accesssvc.UpdateFavoriteOpts) (*access.Favorite, error)
+	CreateFavorite(ctx context.Context, user identity.User, targetID string) (*access.Favorite, error)
+	DeleteFavorite(ctx context.Context, user identity.User, targetID string) error
+	ListFavorites(ctx context.Context, user identity.User) ([]accesssvc.FavoriteWithEligibility, error)
+	ListFailedGrants(ctx context.Context) ([]access.FailedGrant, error)
+	RetryFailedGrant(ctx context.Context, user identity.User, grantID string) error
+	CompleteFailedGrant(ctx context.Context, user identity.User, grantID string) error
 }
 
 //go:generate go run github.com/golang/mock/mockgen -destination=mocks/mock_accessrule_service.go -package=mocks . AccessRuleService
@@ -118,6 +140,10 @@ type TargetService interface {
 	CreateRoute(ctx context.Context, group string, req types.CreateTargetGroupLink) (*target.Route, error)
 	DeleteGroup(ctx context.Context, group *target.Group) error
 	FilterResources(ctx context.Context, resources []cache.TargetGroupResource, filter types.ResourceFilter) ([]types.TargetGroupResource, error)
+	CreateMetadata(ctx context.Context, req types.CreateTargetMetadataRequest) (*target.Metadata, error)
+	UpdateMetadata(ctx context.Context, id string, req types.CreateTargetMetadataRequest) (*target.Metadata, error)
+	DeleteMetadata(ctx context.Context, id string) error
+	SyncGroup(ctx context.Context, group target.Group) (*target.SyncStatus, error)
 }
 
 //go:generate go run github.com/golang/mock/mockgen -destination=mocks/mock_handler_service.go -package=mocks . HandlerService
@@ -131,6 +157,45 @@ type PreflightService interface {
 	ProcessPreflight(ctx context.Context, user identity.User, preflightRequest types.CreatePreflightRequest) (*access.Preflight, error)
 }
 
+//go:generate go run github.com/golang/mock/mockgen -destination=mocks/mock_bulk_revoke_service.go -package=mocks . BulkRevokeService
+type BulkRevokeService interface {
+	CreateJob(ctx context.Context, user identity.User, opts bulkrevokesvc.CreateJobOpts) (*access.BulkRevokeJob, []access.BulkRevokeJobItem, error)
+}
+
+//go:generate go run github.com/golang/mock/mockgen -destination=mocks/mock_freeze_service.go -package=mocks . FreezeService
+type FreezeService interface {
+	Create(ctx context.Context, user identity.User, opts freezesvc.CreateFreezeOpts) (*access.Freeze, error)
+	Update(ctx context.Context, user identity.User, freezeID string, opts freezesvc.UpdateFreezeOpts) (*access.Freeze, error)
+	Lift(ctx context.Context, user identity.User, freezeID string) (*access.Freeze, error)
+	Get(ctx context.Context, freezeID string) (*access.Freeze, error)
+	List(ctx context.Context) ([]access.Freeze, error)
+	ListHistory(ctx context.Context, freezeID string) ([]access.FreezeHistoryEvent, error)
+}
+
+//go:generate go run github.com/golang/mock/mockgen -destination=mocks/mock_dead_letter_service.go -package=mocks . DeadLetterService
+type DeadLetterService interface {
+	List(ctx context.Context, opts deadlettersvc.ListOpts) ([]access.DeadLetterEvent, error)
+	Get(ctx context.Context, id string) (*access.DeadLetterEvent, error)
+	Discard(ctx context.Context, id string) error
+	Replay(ctx context.Context, id string) error
+	ReplayRequest(ctx context.Context, requestID string) ([]access.DeadLetterEvent, error)
+}
+
+//go:generate go run github.com/golang/mock/mockgen -destination=mocks/mock_idempotency_service.go -package=mocks . IdempotencyService
+type IdempotencyService interface {
+	Begin(ctx context.Context, opts idempotencysvc.BeginOpts) (*access.IdempotencyKey, error)
+	Finish(ctx context.Context, key access.IdempotencyKey, resultID string) error
+}
+
+//go:generate go run github.com/golang/mock/mockgen -destination=mocks/mock_identity_syncer.go -package=mocks . IdentitySyncer
+
+// IdentitySyncer syncs users and groups from the identity provider and keeps a history of the syncs.
+type IdentitySyncer interface {
+	auth.IdentitySyncer
+	Run(ctx context.Context, opts identitysync.RunOpts) (*identitysync.SyncReport, error)
+	ListRuns(ctx context.Context) ([]identity.SyncRun, error)
+}
+
 //go:generate go run github.com/golang/mock/mockgen -destination=mocks/mock_healthcheck_service.go -package=mocks . HealthcheckService
 type HealthcheckService interface {
 	Check(ctx context.Context) error
@@ -143,7 +208,7 @@ type Opts struct {
 	Log                    *zap.SugaredLogger
 	ProviderRegistryClient registry_types.ClientWithResponsesInterface
 	UseLocalEventHandler   bool
-	IdentitySyncer         auth.IdentitySyncer
+	IdentitySyncer         IdentitySyncer
 	DeploymentConfig       deploy.DeployConfigReader
 	DynamoTable            string
 	PaginationKMSKeyARN    string
@@ -154,6 +219,13 @@ type Opts struct {
 	AdminGroupID           string
 	FrontendURL            string
 	EventBusArn            string
+	CacheStaleThreshold    time.Duration
+	IdempotencyKeyTTL      time.Duration
+	// the bearer token for the SCIM API, which may be an awsssm:// reference.
+	// The SCIM API is disabled if this is empty.
+	SCIMToken string
+	// NATSURL is used by the local event handler to send events through NATS JetStream, if it is set.
+	NATSURL string
 }
 
 // New creates a new API.
@@ -170,10 +242,12 @@ func New(ctx context.Context, opts Opts) (*API, error) {
 	if err != nil {
 		return nil, err
 	}
-	db, err := ddb.New(ctx, opts.DynamoTable, ddb.WithPageTokenizer(tokenizer))
+	ddbClient, err := ddb.New(ctx, opts.DynamoTable, ddb.WithPageTokenizer(tokenizer))
 	if err != nil {
 		return nil, err
 	}
+	// status changes to requests, groups and targets are written with conditional writes
+	db := storage.NewStatusGuard(ddbClient)
 
 	clk := clock.New()
 	var eventBus gevent.EventPutter
@@ -185,7 +259,21 @@ func New(ctx context.Context, opts Opts) (*API, error) {
 			return nil, err
 		}
 	} else {
-		eventBus = eventhandler.NewLocalDevEventHandler(ctx, db, clk)
+		var ehOpts []eventhandler.LocalDevEventHandlerOptsFunc
+		if opts.NATSURL != "" {
+			transport, err := natsqueue.Connect(ctx, opts.NATSURL, gevent.Subscribers...)
+			if err != nil {
+				return nil, err
+			}
+			ehOpts = append(ehOpts, eventhandler.WithTransport(transport))
+		}
+		eventBus = eventhandler.NewLocalDevEventHandler(ctx, db, clk, ehOpts...)
+	}
+
+	freezes := &freezesvc.Service{
+		DB:       db,
+		Clock:    clk,
+		Eventbus: eventBus,
 	}
 
 	a := API{
@@ -197,13 +285,15 @@ func New(ctx context.Context, opts Opts) (*API, error) {
 			Clock: clk,
 		},
 		PreflightService: &preflightsvc.Service{
-			DB:    db,
-			Clock: clk,
+			DB:      db,
+			Clock:   clk,
+			Freezes: freezes,
 		},
 		Access: &accesssvc.Service{
 			Clock:       clk,
 			DB:          db,
 			EventPutter: eventBus,
+			Freezes:     freezes,
 			Rules: &rulesvc.Service{
 				Clock: clk,
 				DB:    db,
@@ -233,6 +323,15 @@ func New(ctx context.Context, opts Opts) (*API, error) {
 			DB:                     db,
 			Clock:                  clk,
 			ProviderRegistryClient: opts.ProviderRegistryClient,
+			Cache: &cachesvc.Service{
+				DB: db,
+				RequestRouter: &requestroutersvc.Service{
+					DB: db,
+				},
+				Clock:          clk,
+				Eventbus:       eventBus,
+				StaleThreshold: opts.CacheStaleThreshold,
+			},
 		},
 		HandlerService: &handlersvc.Service{
 			DB:    db,
@@ -242,6 +341,21 @@ func New(ctx context.Context, opts Opts) (*API, error) {
 			DB:            db,
 			RuntimeGetter: healthchecksvc.DefaultGetter{},
 		},
+		BulkRevokeService: &bulkrevokesvc.Service{
+			DB:       db,
+			Clock:    clk,
+			Eventbus: eventBus,
+		},
+		FreezeService: freezes,
+		DeadLetterService: &deadlettersvc.Service{
+			DB:       db,
+			Eventbus: eventBus,
+		},
+		Idempotency: &idempotencysvc.Service{
+			DB:    db,
+			Clock: clk,
+			TTL:   opts.IdempotencyKeyTTL,
+		},
 	}
 
 	// only initialise this if cognito is the IDP
@@ -265,9 +379,35 @@ func New(ctx context.Context, opts Opts) (*API, error) {
 
 	}
 
+	if opts.SCIMToken != "" {
+		// resolve the token if it is stored as a secret
+		values, err := (&gconfig.MapLoader{Values: map[string]string{"scimToken": opts.SCIMToken}}).Load(ctx)
+		if err != nil {
+			return nil, err
+		}
+		a.SCIM = &scim.Handler{
+			Token: values["scimToken"],
+			Service: &scimsvc.Service{
+				// users and groups are written with conditional writes, so that concurrent SCIM requests don't lose changes
+				DB:       storage.NewVersionGuard(db),
+				Clock:    clk,
+				Identity: &identitysvc.Service{DB: db},
+			},
+		}
+	}
+
 	return &a, nil
 }
 
+// SCIMHandler returns the HTTP handler for the SCIM API,
+// or nil if SCIM provisioning isn't configured.
+func (a *API) SCIMHandler() http.Handler {
+	if a.SCIM == nil {
+		return nil
+	}
+	return a.SCIM.Routes()
+}
+
 // Handler returns a HTTP handler.
 // Hander doesn't add any middleware. It is the caller's
 // responsibility to add any middleware.
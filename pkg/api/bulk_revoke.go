@@ -0,0 +1,110 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/auth"
+	"github.com/common-fate/common-fate/pkg/service/bulkrevokesvc"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+)
+
+// List bulk revoke jobs
+// (GET /api/v1/admin/bulk-revoke-jobs)
+func (a *API) AdminListBulkRevokeJobs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	q := storage.ListBulkRevokeJobs{}
+	err := a.DB.All(ctx, &q)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	// most recent first
+	sort.Slice(q.Result, func(i, j int) bool {
+		return q.Result[i].CreatedAt.After(q.Result[j].CreatedAt)
+	})
+
+	res := types.ListBulkRevokeJobsResponse{
+		Jobs: []types.BulkRevokeJob{},
+	}
+	for _, j := range q.Result {
+		res.Jobs = append(res.Jobs, j.ToAPI())
+	}
+	apio.JSON(ctx, w, res, http.StatusOK)
+}
+
+// Create a bulk revoke job
+// (POST /api/v1/admin/bulk-revoke-jobs)
+func (a *API) AdminCreateBulkRevokeJob(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var b types.CreateBulkRevokeJobRequest
+	err := apio.DecodeJSONBody(w, r, &b)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	opts := bulkrevokesvc.CreateJobOpts{
+		Kind: b.Kind,
+		Filter: access.BulkRevokeFilter{
+			UserID:        aws.ToString(b.Filter.UserId),
+			TargetGroupID: aws.ToString(b.Filter.TargetGroupId),
+			AccessRuleID:  aws.ToString(b.Filter.AccessRuleId),
+		},
+		DryRun: b.DryRun != nil && *b.DryRun,
+	}
+	if b.Filter.TargetFields != nil {
+		opts.Filter.TargetFields = b.Filter.TargetFields.AdditionalProperties
+	}
+
+	u := auth.UserFromContext(ctx)
+	job, items, err := a.BulkRevokeService.CreateJob(ctx, *u, opts)
+	var filterErr bulkrevokesvc.InvalidFilterError
+	if errors.As(err, &filterErr) {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusBadRequest))
+		return
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, bulkRevokeJobToAPI(*job, items), http.StatusCreated)
+}
+
+// Get a bulk revoke job
+// (GET /api/v1/admin/bulk-revoke-jobs/{jobId})
+func (a *API) AdminGetBulkRevokeJob(w http.ResponseWriter, r *http.Request, jobId string) {
+	ctx := r.Context()
+	q := storage.GetBulkRevokeJob{ID: jobId}
+	_, err := a.DB.Query(ctx, &q)
+	if err == ddb.ErrNoItems {
+		apio.Error(ctx, w, apio.NewRequestError(errors.New("bulk revoke job not found"), http.StatusNotFound))
+		return
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	items := storage.ListBulkRevokeJobItems{JobID: jobId}
+	err = a.DB.All(ctx, &items)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, bulkRevokeJobToAPI(*q.Result, items.Result), http.StatusOK)
+}
+
+func bulkRevokeJobToAPI(job access.BulkRevokeJob, items []access.BulkRevokeJobItem) types.BulkRevokeJob {
+	res := job.ToAPI()
+	apiItems := []types.BulkRevokeJobItem{}
+	for _, i := range items {
+		apiItems = append(apiItems, i.ToAPI())
+	}
+	res.Items = &apiItems
+	return res
+}
@@ -0,0 +1,163 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/api/mocks"
+	"github.com/common-fate/common-fate/pkg/service/bulkrevokesvc"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminCreateBulkRevokeJob(t *testing.T) {
+	createdAt := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+	job := access.BulkRevokeJob{
+		ID:        "brj_1",
+		Kind:      types.USER,
+		Filter:    access.BulkRevokeFilter{UserID: "usr_1"},
+		DryRun:    true,
+		Status:    types.FINISHED,
+		Total:     1,
+		CreatedBy: access.RequestedBy{ID: "usr_admin"},
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+	}
+	items := []access.BulkRevokeJobItem{
+		{ID: "00000", JobID: "brj_1", RequestID: "req_1", RequestedBy: access.RequestedBy{Email: "user1@example.com"}, Action: types.REVOKEREQUEST, Status: types.BulkRevokeJobItemStatusPLANNED},
+	}
+
+	type testcase struct {
+		name      string
+		give      string
+		wantOpts  *bulkrevokesvc.CreateJobOpts
+		createErr error
+		wantCode  int
+		wantBody  string
+	}
+
+	testcases := []testcase{
+		{
+			name:     "ok",
+			give:     `{"kind":"USER","filter":{"userId":"usr_1"},"dryRun":true}`,
+			wantOpts: &bulkrevokesvc.CreateJobOpts{Kind: types.USER, Filter: access.BulkRevokeFilter{UserID: "usr_1"}, DryRun: true},
+			wantCode: http.StatusCreated,
+			wantBody: `{"createdAt":"2023-01-01T12:00:00Z","createdBy":"usr_admin","dryRun":true,"failed":0,"filter":{"userId":"usr_1"},"id":"brj_1","items":[{"action":"REVOKE_REQUEST","id":"00000","requestId":"req_1","requestedBy":"user1@example.com","status":"PLANNED"}],"kind":"USER","processed":0,"skipped":0,"status":"FINISHED","succeeded":0,"total":1,"updatedAt":"2023-01-01T12:00:00Z"}`,
+		},
+		{
+			name:     "target filter",
+			give:     `{"kind":"TARGET_FILTER","filter":{"targetFields":{"accountId":"123"}}}`,
+			wantOpts: &bulkrevokesvc.CreateJobOpts{Kind: types.TARGETFILTER, Filter: access.BulkRevokeFilter{TargetFields: map[string]string{"accountId": "123"}}},
+			wantCode: http.StatusCreated,
+		},
+		{
+			name:      "missing filter field",
+			give:      `{"kind":"ACCESS_RULE","filter":{}}`,
+			wantOpts:  &bulkrevokesvc.CreateJobOpts{Kind: types.ACCESSRULE},
+			createErr: bulkrevokesvc.InvalidFilterError{Kind: types.ACCESSRULE, Field: "accessRuleId"},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  `{"error":"ACCESS_RULE jobs require filter.accessRuleId"}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			m := mocks.NewMockBulkRevokeService(ctrl)
+			if tc.wantOpts != nil {
+				var gotJob *access.BulkRevokeJob
+				if tc.createErr == nil {
+					gotJob = &job
+				}
+				m.EXPECT().CreateJob(gomock.Any(), gomock.Any(), *tc.wantOpts).Return(gotJob, items, tc.createErr)
+			}
+
+			a := API{BulkRevokeService: m}
+			handler := newTestServer(t, &a)
+
+			req, err := http.NewRequest("POST", "/api/v1/admin/bulk-revoke-jobs", strings.NewReader(tc.give))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Add("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+
+			data, err := io.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.wantBody != "" {
+				assert.Equal(t, tc.wantBody, string(data))
+			}
+		})
+	}
+}
+
+func TestAdminGetBulkRevokeJob(t *testing.T) {
+	type testcase struct {
+		name     string
+		job      *access.BulkRevokeJob
+		jobErr   error
+		items    []access.BulkRevokeJobItem
+		wantCode int
+		wantBody string
+	}
+
+	testcases := []testcase{
+		{
+			name:     "ok",
+			job:      &access.BulkRevokeJob{ID: "brj_1", Kind: types.TARGETGROUP, Filter: access.BulkRevokeFilter{TargetGroupID: "aws"}, Status: types.RUNNING, Total: 2, Processed: 1, Failed: 1},
+			items:    []access.BulkRevokeJobItem{{ID: "00000", RequestID: "req_1", GroupID: "grp_1", TargetID: "gta_1", Action: types.REVOKETARGET, Status: types.BulkRevokeJobItemStatusFAILED, Error: "provider error"}},
+			wantCode: http.StatusOK,
+			wantBody: `{"createdAt":"0001-01-01T00:00:00Z","createdBy":"","dryRun":false,"failed":1,"filter":{"targetGroupId":"aws"},"id":"brj_1","items":[{"action":"REVOKE_TARGET","error":"provider error","groupId":"grp_1","id":"00000","requestId":"req_1","requestedBy":"","status":"FAILED","targetId":"gta_1"}],"kind":"TARGET_GROUP","processed":1,"skipped":0,"status":"RUNNING","succeeded":0,"total":2,"updatedAt":"0001-01-01T00:00:00Z"}`,
+		},
+		{
+			name:     "not found",
+			jobErr:   ddb.ErrNoItems,
+			wantCode: http.StatusNotFound,
+			wantBody: `{"error":"bulk revoke job not found"}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQueryWithErr(&storage.GetBulkRevokeJob{Result: tc.job}, tc.jobErr)
+			db.MockQuery(&storage.ListBulkRevokeJobItems{Result: tc.items})
+
+			a := API{DB: db}
+			handler := newTestServer(t, &a)
+
+			req, err := http.NewRequest("GET", "/api/v1/admin/bulk-revoke-jobs/brj_1", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+
+			data, err := io.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.wantBody != "" {
+				assert.Equal(t, tc.wantBody, string(data))
+			}
+		})
+	}
+}
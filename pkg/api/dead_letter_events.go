@@ -0,0 +1,110 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/service/deadlettersvc"
+	"github.com/common-fate/common-fate/pkg/types"
+)
+
+// List dead letter events
+// (GET /api/v1/admin/dead-letter-events)
+func (a *API) AdminListDeadLetterEvents(w http.ResponseWriter, r *http.Request, params types.AdminListDeadLetterEventsParams) {
+	ctx := r.Context()
+	var opts deadlettersvc.ListOpts
+	if params.RequestId != nil {
+		opts.RequestID = *params.RequestId
+	}
+	if params.Subscriber != nil {
+		opts.Subscriber = *params.Subscriber
+	}
+	events, err := a.DeadLetterService.List(ctx, opts)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, deadLetterEventsToAPI(events), http.StatusOK)
+}
+
+// Get a dead letter event
+// (GET /api/v1/admin/dead-letter-events/{eventId})
+func (a *API) AdminGetDeadLetterEvent(w http.ResponseWriter, r *http.Request, eventId string) {
+	ctx := r.Context()
+	e, err := a.DeadLetterService.Get(ctx, eventId)
+	if err == deadlettersvc.ErrDeadLetterEventNotFound {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusNotFound))
+		return
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, e.ToAPI(), http.StatusOK)
+}
+
+// Discard a dead letter event
+// (DELETE /api/v1/admin/dead-letter-events/{eventId})
+func (a *API) AdminDiscardDeadLetterEvent(w http.ResponseWriter, r *http.Request, eventId string) {
+	ctx := r.Context()
+	err := a.DeadLetterService.Discard(ctx, eventId)
+	if err == deadlettersvc.ErrDeadLetterEventNotFound {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusNotFound))
+		return
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, nil, http.StatusNoContent)
+}
+
+// Replay a dead letter event
+// (POST /api/v1/admin/dead-letter-events/{eventId}/replay)
+func (a *API) AdminReplayDeadLetterEvent(w http.ResponseWriter, r *http.Request, eventId string) {
+	ctx := r.Context()
+	err := a.DeadLetterService.Replay(ctx, eventId)
+	if err == deadlettersvc.ErrDeadLetterEventNotFound {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusNotFound))
+		return
+	}
+	var invalid deadlettersvc.InvalidEventError
+	if errors.As(err, &invalid) {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusBadRequest))
+		return
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, nil, http.StatusAccepted)
+}
+
+// Replay the dead letter events for a request
+// (POST /api/v1/admin/requests/{requestId}/replay-events)
+func (a *API) AdminReplayRequestEvents(w http.ResponseWriter, r *http.Request, requestId string) {
+	ctx := r.Context()
+	events, err := a.DeadLetterService.ReplayRequest(ctx, requestId)
+	var invalid deadlettersvc.InvalidEventError
+	if errors.As(err, &invalid) {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusBadRequest))
+		return
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, deadLetterEventsToAPI(events), http.StatusAccepted)
+}
+
+func deadLetterEventsToAPI(events []access.DeadLetterEvent) types.ListDeadLetterEventsResponse {
+	res := types.ListDeadLetterEventsResponse{
+		Events: []types.DeadLetterEvent{},
+	}
+	for _, e := range events {
+		res.Events = append(res.Events, e.ToAPI())
+	}
+	return res
+}
@@ -0,0 +1,141 @@
+package api
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/api/mocks"
+	"github.com/common-fate/common-fate/pkg/service/deadlettersvc"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminListDeadLetterEvents(t *testing.T) {
+	failedAt := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+	event := access.DeadLetterEvent{
+		ID:            "eventHandler_1",
+		Subscriber:    "eventHandler",
+		EventID:       "1",
+		OrderingKey:   "request#req_1",
+		DetailType:    "request.created",
+		Detail:        `{}`,
+		Error:         "failed",
+		Attempts:      3,
+		FirstFailedAt: failedAt,
+		LastFailedAt:  failedAt,
+	}
+
+	type testcase struct {
+		name     string
+		url      string
+		wantOpts deadlettersvc.ListOpts
+		wantBody string
+	}
+
+	testcases := []testcase{
+		{
+			name:     "all events",
+			url:      "/api/v1/admin/dead-letter-events",
+			wantBody: `{"events":[{"attempts":3,"detail":"{}","detailType":"request.created","error":"failed","eventId":"1","firstFailedAt":"2023-01-01T12:00:00Z","id":"eventHandler_1","lastFailedAt":"2023-01-01T12:00:00Z","orderingKey":"request#req_1","subscriber":"eventHandler"}]}`,
+		},
+		{
+			name:     "filtered by request",
+			url:      "/api/v1/admin/dead-letter-events?requestId=req_1",
+			wantOpts: deadlettersvc.ListOpts{RequestID: "req_1"},
+			wantBody: `{"events":[{"attempts":3,"detail":"{}","detailType":"request.created","error":"failed","eventId":"1","firstFailedAt":"2023-01-01T12:00:00Z","id":"eventHandler_1","lastFailedAt":"2023-01-01T12:00:00Z","orderingKey":"request#req_1","subscriber":"eventHandler"}]}`,
+		},
+		{
+			name:     "filtered by subscriber",
+			url:      "/api/v1/admin/dead-letter-events?subscriber=eventHandler",
+			wantOpts: deadlettersvc.ListOpts{Subscriber: "eventHandler"},
+			wantBody: `{"events":[{"attempts":3,"detail":"{}","detailType":"request.created","error":"failed","eventId":"1","firstFailedAt":"2023-01-01T12:00:00Z","id":"eventHandler_1","lastFailedAt":"2023-01-01T12:00:00Z","orderingKey":"request#req_1","subscriber":"eventHandler"}]}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			m := mocks.NewMockDeadLetterService(ctrl)
+			m.EXPECT().List(gomock.Any(), tc.wantOpts).Return([]access.DeadLetterEvent{event}, nil)
+
+			a := API{DeadLetterService: m}
+			handler := newTestServer(t, &a)
+
+			req, err := http.NewRequest("GET", tc.url, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, http.StatusOK, rr.Code)
+			data, err := io.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tc.wantBody, string(data))
+		})
+	}
+}
+
+func TestAdminReplayDeadLetterEvent(t *testing.T) {
+	type testcase struct {
+		name      string
+		replayErr error
+		wantCode  int
+		wantBody  string
+	}
+
+	testcases := []testcase{
+		{
+			name:     "ok",
+			wantCode: http.StatusAccepted,
+		},
+		{
+			name:      "not found",
+			replayErr: deadlettersvc.ErrDeadLetterEventNotFound,
+			wantCode:  http.StatusNotFound,
+			wantBody:  `{"error":"dead letter event not found"}`,
+		},
+		{
+			name:      "event can't be parsed",
+			replayErr: deadlettersvc.InvalidEventError{ID: "eventHandler_1", Err: errors.New("unknown event type request.removed")},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  `{"error":"can't replay dead letter event eventHandler_1: unknown event type request.removed"}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			m := mocks.NewMockDeadLetterService(ctrl)
+			m.EXPECT().Replay(gomock.Any(), "eventHandler_1").Return(tc.replayErr)
+
+			a := API{DeadLetterService: m}
+			handler := newTestServer(t, &a)
+
+			req, err := http.NewRequest("POST", "/api/v1/admin/dead-letter-events/eventHandler_1/replay", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+			if tc.wantBody != "" {
+				data, err := io.ReadAll(rr.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				assert.Equal(t, tc.wantBody, string(data))
+			}
+		})
+	}
+}
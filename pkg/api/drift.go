@@ -0,0 +1,34 @@
+package api
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+)
+
+// List grant drift
+// (GET /api/v1/admin/drift)
+func (a *API) AdminListGrantDrift(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	q := storage.ListGrantDrifts{}
+	err := a.DB.All(ctx, &q)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	// most recently detected first
+	sort.Slice(q.Result, func(i, j int) bool {
+		return q.Result[i].DetectedAt.After(q.Result[j].DetectedAt)
+	})
+
+	res := types.ListGrantDriftResponse{
+		Drift: []types.GrantDrift{},
+	}
+	for _, d := range q.Result {
+		res.Drift = append(res.Drift, d.ToAPI())
+	}
+	apio.JSON(ctx, w, res, http.StatusOK)
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"net/http"
 	"strings"
 
@@ -52,64 +53,92 @@ func (a *API) UserListEntitlements(w http.ResponseWriter, r *http.Request) {
 // (GET /api/v1/entitlements/targets)
 func (a *API) UserListEntitlementTargets(w http.ResponseWriter, r *http.Request, params types.UserListEntitlementTargetsParams) {
 	ctx := r.Context()
-	var opts []func(*ddb.QueryOpts)
-	if params.NextToken != nil {
-		opts = append(opts, ddb.Page(*params.NextToken))
-	}
+	user := auth.UserFromContext(ctx)
 
 	res := types.ListTargetsResponse{
 		Targets: []types.Target{},
 	}
 
-	var results []cache.Target
-	var qo *ddb.QueryResult
-	var err error
+	var publisher, name, kind string
 	if params.Kind != nil {
 		// validation is handled for the kind param my a regex in the open API spec
 		parts := strings.Split(*params.Kind, "/")
-		q := storage.ListCachedTargetsForKind{
-			Publisher: parts[0],
-			Name:      parts[1],
-			Kind:      parts[2],
+		publisher, name, kind = parts[0], parts[1], parts[2]
+	}
+
+	opts := cache.TargetSearchOpts{
+		FieldFilters: make(map[string]string),
+	}
+	if params.Search != nil {
+		opts.Query = *params.Search
+	}
+	if params.Filter != nil {
+		for _, f := range *params.Filter {
+			// validation of the filter format is handled by a regex in the open API spec
+			fieldID, value, _ := strings.Cut(f, ":")
+			opts.FieldFilters[fieldID] = value
 		}
-		qo, err = a.DB.Query(ctx, &q, opts...)
-		if err == ddb.ErrNoItems {
-			apio.JSON(ctx, w, res, http.StatusOK)
+	}
+	if params.SortBy != nil {
+		opts.SortBy = cache.TargetSortBy(*params.SortBy)
+	}
+	if params.SortOrder != nil && *params.SortOrder == "desc" {
+		opts.Descending = true
+	}
+	if params.PageSize != nil {
+		opts.PageSize = *params.PageSize
+	}
+	if params.NextToken != nil {
+		opts.Cursor = *params.NextToken
+	}
 
-			return
+	// The search index is partitioned by group, so only the targets the user has access to are read.
+	// Each group is queried a page at a time, with the search filters applied by DynamoDB.
+	list := func(ctx context.Context, idpGroupID string, after string, limit int, pageToken string) ([]cache.TargetSearchEntry, string, error) {
+		q := storage.ListCachedTargetSearchEntriesForGroup{
+			IDPGroupID:   idpGroupID,
+			Publisher:    publisher,
+			Name:         name,
+			Kind:         kind,
+			Terms:        opts.Terms(),
+			FieldFilters: opts.FieldFilters,
+			SortBy:       opts.SortBy,
+			Descending:   opts.Descending,
+			After:        after,
 		}
-		if err != nil && err != ddb.ErrNoItems {
-			apio.Error(ctx, w, err)
-			return
+		queryOpts := []func(*ddb.QueryOpts){ddb.Limit(int32(limit))}
+		if pageToken != "" {
+			queryOpts = append(queryOpts, ddb.Page(pageToken))
 		}
-		results = q.Result
-	} else {
-		q := storage.ListCachedTargets{}
-		qo, err = a.DB.Query(ctx, &q, opts...)
+		qr, err := a.DB.Query(ctx, &q, queryOpts...)
 		if err == ddb.ErrNoItems {
-			apio.JSON(ctx, w, res, http.StatusOK)
-
-			return
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
 		}
-		if err != nil && err != ddb.ErrNoItems {
-			apio.Error(ctx, w, err)
-			return
+		var next string
+		if qr != nil {
+			next = qr.NextPage
 		}
-		results = q.Result
+		return q.Result, next, nil
 	}
 
-	if qo.NextPage != "" {
-		res.Next = &qo.NextPage
+	targets, next, err := cache.SearchTargets(ctx, user.Groups, opts, list)
+	if err == cache.ErrInvalidSearchCursor {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusBadRequest))
+		return
 	}
-	user := auth.UserFromContext(ctx)
-
-	// Filtering needs to be done in the application layer because of limits with dynamoDB filters
-	// in the end, the same amount of read units will be consumed
-
-	for _, target := range cache.Filter(results, user.Groups) {
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	if next != "" {
+		res.Next = &next
+	}
+	for _, target := range targets {
 		res.Targets = append(res.Targets, target.ToAPI())
 	}
 
 	apio.JSON(ctx, w, res, http.StatusOK)
-
 }
@@ -1,16 +1,20 @@
 package api
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 
 	"github.com/common-fate/common-fate/pkg/cache"
 	"github.com/common-fate/common-fate/pkg/identity"
 	"github.com/common-fate/common-fate/pkg/storage"
 	"github.com/common-fate/common-fate/pkg/target"
+	"github.com/common-fate/common-fate/pkg/types"
 	"github.com/common-fate/ddb"
 	"github.com/common-fate/ddb/ddbmock"
 	"github.com/stretchr/testify/assert"
@@ -104,58 +108,116 @@ func TestListEntitlements(t *testing.T) {
 
 var AccessRulesMap = make(map[string]cache.AccessRule)
 
-// db: ListCachedTargetsForKind
-// db: ListCachedTargets
+// searchDB records the target search queries, as the search filters are applied by DynamoDB
+type searchDB struct {
+	*mockClient
+	queries []storage.ListCachedTargetSearchEntriesForGroup
+}
+
+func (d *searchDB) Query(ctx context.Context, qb ddb.QueryBuilder, opts ...func(*ddb.QueryOpts)) (*ddb.QueryResult, error) {
+	if q, ok := qb.(*storage.ListCachedTargetSearchEntriesForGroup); ok {
+		d.queries = append(d.queries, *q)
+	}
+	return d.mockClient.Query(ctx, qb, opts...)
+}
+
+// db: ListCachedTargetSearchEntriesForGroup
 // response: ListTargetResponse{}
 func TestListEntitlementTargets(t *testing.T) {
 	type testcase struct {
 		name         string
 		targets      []cache.Target
+		query        string
 		want         string
-		withTestUser *identity.User
+		withTestUser identity.User
 		mockListErr  error
 		wantCode     int
+		// wantQuery is the search query made for the testAdmin group
+		wantQuery *storage.ListCachedTargetSearchEntriesForGroup
+	}
+
+	account := func(id, label string) cache.Target {
+		return cache.Target{
+			Fields: []cache.Field{
+				{
+					ID:         "id",
+					FieldTitle: "account",
+					ValueLabel: label,
+					Value:      id,
+				},
+			},
+			AccessRules: map[string]cache.AccessRule{
+				"foo": {
+					MatchedTargetGroups: []string{"id"},
+				},
+			},
+			IDPGroupsWithAccess: map[string]struct{}{"testAdmin": {}},
+		}
 	}
 
 	testcases := []testcase{
 		{
 			name:         "ok",
-			withTestUser: &identity.User{Groups: []string{"testAdmin"}},
-			targets: []cache.Target{
-				{
-					Fields: []cache.Field{
-						{
-							ID:         "id",
-							FieldTitle: "account",
-							ValueLabel: "account",
-							Value:      "0123",
-						},
-					},
-					AccessRules: map[string]cache.AccessRule{
-						"foo": {
-							MatchedTargetGroups: []string{"id"},
-						},
-					},
-					IDPGroupsWithAccess: map[string]struct{}{"testAdmin": {}},
-				},
+			withTestUser: identity.User{Groups: []string{"testAdmin"}},
+			targets:      []cache.Target{account("0123", "account")},
+			want:         `{"targets":[{"fields":[{"fieldTitle":"account","id":"id","value":"0123","valueLabel":"account"}],"id":"###id#0123#","kind":{"icon":"","kind":"","name":"","publisher":""}}]}`,
+			mockListErr:  nil,
+			wantCode:     http.StatusOK,
+		},
+		{
+			name:         "search",
+			withTestUser: identity.User{Groups: []string{"testAdmin"}},
+			targets:      []cache.Target{account("0123", "prod-admin")},
+			query:        "?search=Prod%20admin",
+			want:         `{"targets":[{"fields":[{"fieldTitle":"account","id":"id","value":"0123","valueLabel":"prod-admin"}],"id":"###id#0123#","kind":{"icon":"","kind":"","name":"","publisher":""}}]}`,
+			wantCode:     http.StatusOK,
+			wantQuery: &storage.ListCachedTargetSearchEntriesForGroup{
+				IDPGroupID:   "testAdmin",
+				Terms:        []string{"prod", "admin"},
+				FieldFilters: map[string]string{},
 			},
-			want:        `{"targets":[{"fields":[{"fieldTitle":"account","id":"id","value":"0123","valueLabel":"account"}],"id":"###id#0123#","kind":{"icon":"","kind":"","name":"","publisher":""}}]}`,
-			mockListErr: nil,
-			wantCode:    http.StatusOK,
 		},
 		{
-			name:        "no entitlements returns an empty list not an error",
-			mockListErr: ddb.ErrNoItems,
-			wantCode:    http.StatusOK,
-			targets:     []cache.Target{},
+			name:         "filter and sort",
+			withTestUser: identity.User{Groups: []string{"testAdmin"}},
+			targets:      []cache.Target{account("4567", "b")},
+			query:        "?sortOrder=desc&sortBy=kind&filter=id:4567&kind=common-fate/aws/Account",
+			want:         `{"targets":[{"fields":[{"fieldTitle":"account","id":"id","value":"4567","valueLabel":"b"}],"id":"###id#4567#","kind":{"icon":"","kind":"","name":"","publisher":""}}]}`,
+			wantCode:     http.StatusOK,
+			wantQuery: &storage.ListCachedTargetSearchEntriesForGroup{
+				IDPGroupID:   "testAdmin",
+				Publisher:    "common-fate",
+				Name:         "aws",
+				Kind:         "Account",
+				Terms:        []string{},
+				FieldFilters: map[string]string{"id": "4567"},
+				SortBy:       cache.TargetSortByKind,
+				Descending:   true,
+			},
+		},
+		{
+			name:         "invalid cursor",
+			withTestUser: identity.User{Groups: []string{"testAdmin"}},
+			targets:      []cache.Target{account("0123", "a")},
+			query:        "?nextToken=%25%25",
+			want:         `{"error":"invalid search cursor"}`,
+			wantCode:     http.StatusBadRequest,
+		},
+		{
+			name:         "no entitlements returns an empty list not an error",
+			withTestUser: identity.User{Groups: []string{"testAdmin"}},
+			mockListErr:  ddb.ErrNoItems,
+			wantCode:     http.StatusOK,
+			targets:      []cache.Target{},
 
 			want: `{"targets":[]}`,
 		},
 		{
-			name:        "internal error",
-			mockListErr: errors.New("internal error"),
-			wantCode:    http.StatusInternalServerError,
-			targets:     nil,
+			name:         "internal error",
+			withTestUser: identity.User{Groups: []string{"testAdmin"}},
+			mockListErr:  errors.New("internal error"),
+			wantCode:     http.StatusInternalServerError,
+			targets:      nil,
 
 			want: `{"error":"Internal Server Error"}`,
 		},
@@ -164,19 +226,17 @@ func TestListEntitlementTargets(t *testing.T) {
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
 
-			db := ddbmock.New(t)
-			db.MockQueryWithErr(&storage.ListCachedTargets{Result: tc.targets}, tc.mockListErr)
-			db.MockQueryWithErr(&storage.ListCachedTargetsForKind{Result: tc.targets}, tc.mockListErr)
-
-			opts := []func(*testOptions){}
-			if tc.withTestUser != nil {
-				opts = append(opts, WithRequestUser(*tc.withTestUser))
+			db := &searchDB{mockClient: ddbmock.New(t)}
+			var entries []cache.TargetSearchEntry
+			for _, target := range tc.targets {
+				entries = append(entries, cache.NewTargetSearchEntries(target)...)
 			}
+			db.MockQueryWithErr(&storage.ListCachedTargetSearchEntriesForGroup{Result: entries}, tc.mockListErr)
 
 			a := API{DB: db}
-			handler := newTestServer(t, &a, opts...)
+			handler := newTestServer(t, &a, WithRequestUser(tc.withTestUser))
 
-			req, err := http.NewRequest("GET", "/api/v1/entitlements/targets", nil)
+			req, err := http.NewRequest("GET", "/api/v1/entitlements/targets"+tc.query, nil)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -193,6 +253,57 @@ func TestListEntitlementTargets(t *testing.T) {
 			}
 
 			assert.Equal(t, tc.want, string(data))
+			if tc.wantQuery != nil && assert.Len(t, db.queries, 1) {
+				got := db.queries[0]
+				got.Result = nil
+				assert.Equal(t, *tc.wantQuery, got)
+			}
 		})
 	}
 }
+
+func TestListEntitlementTargetsPagination(t *testing.T) {
+	var entries []cache.TargetSearchEntry
+	for _, id := range []string{"0123", "4567", "8910"} {
+		entries = append(entries, cache.NewTargetSearchEntries(cache.Target{
+			Fields:              []cache.Field{{ID: "id", FieldTitle: "account", Value: id}},
+			IDPGroupsWithAccess: map[string]struct{}{"testAdmin": {}},
+		})...)
+	}
+	db := ddbmock.New(t)
+	a := API{DB: db}
+	handler := newTestServer(t, &a, WithRequestUser(identity.User{Groups: []string{"testAdmin"}}))
+
+	var gotIDs []string
+	var pages int
+	query := "?sortOrder=desc&pageSize=2"
+	for {
+		db.MockQuery(&storage.ListCachedTargetSearchEntriesForGroup{Result: entries})
+		req, err := http.NewRequest("GET", "/api/v1/entitlements/targets"+query, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		if !assert.Equal(t, http.StatusOK, rr.Code) {
+			t.Fatal(rr.Body.String())
+		}
+		var res types.ListTargetsResponse
+		err = json.NewDecoder(rr.Body).Decode(&res)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pages++
+		for _, target := range res.Targets {
+			gotIDs = append(gotIDs, target.Fields[0].Value)
+		}
+		if res.Next == nil {
+			break
+		}
+		assert.Len(t, res.Targets, 2)
+		query = "?sortOrder=desc&pageSize=2&nextToken=" + url.QueryEscape(*res.Next)
+	}
+
+	assert.Equal(t, 2, pages)
+	assert.Equal(t, []string{"8910", "4567", "0123"}, gotIDs)
+}
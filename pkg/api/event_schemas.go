@@ -0,0 +1,62 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/types"
+)
+
+// List event schemas
+// (GET /api/v1/admin/event-schemas)
+func (a *API) AdminListEventSchemas(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	schemas, err := gevent.PublishedSchemas()
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	res := types.ListEventSchemasResponse{
+		Schemas: []types.EventSchema{},
+	}
+	for _, s := range schemas {
+		res.Schemas = append(res.Schemas, eventSchemaToAPI(s))
+	}
+	apio.JSON(ctx, w, res, http.StatusOK)
+}
+
+// Get an event schema
+// (GET /api/v1/admin/event-schemas/{eventType})
+func (a *API) AdminGetEventSchema(w http.ResponseWriter, r *http.Request, eventType string, params types.AdminGetEventSchemaParams) {
+	ctx := r.Context()
+	schemas, err := gevent.PublishedSchemas()
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	// schemas are sorted by version, so the last match is the latest version
+	var found *gevent.PublishedSchema
+	for i, s := range schemas {
+		if s.EventType != eventType {
+			continue
+		}
+		if params.Version == nil || *params.Version == s.Version {
+			found = &schemas[i]
+		}
+	}
+	if found == nil {
+		apio.Error(ctx, w, apio.NewRequestError(errors.New("event schema not found"), http.StatusNotFound))
+		return
+	}
+	apio.JSON(ctx, w, eventSchemaToAPI(*found), http.StatusOK)
+}
+
+func eventSchemaToAPI(s gevent.PublishedSchema) types.EventSchema {
+	return types.EventSchema{
+		EventType: s.EventType,
+		Version:   s.Version,
+		Schema:    s.Schema,
+	}
+}
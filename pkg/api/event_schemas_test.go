@@ -0,0 +1,108 @@
+package api
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminGetEventSchema(t *testing.T) {
+	type testcase struct {
+		name        string
+		url         string
+		wantCode    int
+		wantVersion int
+	}
+
+	testcases := []testcase{
+		{
+			name:        "latest version",
+			url:         "/api/v1/admin/event-schemas/request.created",
+			wantCode:    http.StatusOK,
+			wantVersion: 1,
+		},
+		{
+			name:        "specific version",
+			url:         "/api/v1/admin/event-schemas/request.created?version=1",
+			wantCode:    http.StatusOK,
+			wantVersion: 1,
+		},
+		{
+			name:     "unknown version",
+			url:      "/api/v1/admin/event-schemas/request.created?version=100",
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:     "unknown event",
+			url:      "/api/v1/admin/event-schemas/request.unknown",
+			wantCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			a := API{}
+			handler := newTestServer(t, &a)
+
+			req, err := http.NewRequest("GET", tc.url, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+			if tc.wantCode != http.StatusOK {
+				return
+			}
+			data, err := io.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got types.EventSchema
+			err = json.Unmarshal(data, &got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, "request.created", got.EventType)
+			assert.Equal(t, tc.wantVersion, got.Version)
+			assert.Equal(t, "request.created", got.Schema["title"])
+		})
+	}
+}
+
+func TestAdminListEventSchemas(t *testing.T) {
+	a := API{}
+	handler := newTestServer(t, &a)
+
+	req, err := http.NewRequest("GET", "/api/v1/admin/event-schemas", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rr := httptest.NewRecorder()
+
+	handler.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	data, err := io.ReadAll(rr.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got types.ListEventSchemasResponse
+	err = json.Unmarshal(data, &got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var eventTypes []string
+	for _, s := range got.Schemas {
+		eventTypes = append(eventTypes, s.EventType)
+	}
+	assert.Contains(t, eventTypes, "request.created")
+	assert.Contains(t, eventTypes, "grant.activated")
+}
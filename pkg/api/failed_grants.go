@@ -0,0 +1,66 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/common-fate/pkg/auth"
+	"github.com/common-fate/common-fate/pkg/service/accesssvc"
+	"github.com/common-fate/common-fate/pkg/types"
+)
+
+// List failed grants
+// (GET /api/v1/admin/failed-grants)
+func (a *API) AdminListFailedGrants(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	failed, err := a.Access.ListFailedGrants(ctx)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	res := types.ListFailedGrantsResponse{
+		FailedGrants: []types.FailedGrant{},
+	}
+	for _, f := range failed {
+		res.FailedGrants = append(res.FailedGrants, f.ToAPI())
+	}
+	apio.JSON(ctx, w, res, http.StatusOK)
+}
+
+// Retry a failed grant
+// (POST /api/v1/admin/failed-grants/{grantId}/retry)
+func (a *API) AdminRetryFailedGrant(w http.ResponseWriter, r *http.Request, grantId string) {
+	ctx := r.Context()
+	user := auth.UserFromContext(ctx)
+
+	err := a.Access.RetryFailedGrant(ctx, *user, grantId)
+	if err == accesssvc.ErrFailedGrantNotFound {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusNotFound))
+		return
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, nil, http.StatusAccepted)
+}
+
+// Force-complete a failed grant
+// (POST /api/v1/admin/failed-grants/{grantId}/complete)
+func (a *API) AdminCompleteFailedGrant(w http.ResponseWriter, r *http.Request, grantId string) {
+	ctx := r.Context()
+	user := auth.UserFromContext(ctx)
+
+	err := a.Access.CompleteFailedGrant(ctx, *user, grantId)
+	if err == accesssvc.ErrFailedGrantNotFound {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusNotFound))
+		return
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, nil, http.StatusNoContent)
+}
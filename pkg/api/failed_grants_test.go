@@ -0,0 +1,122 @@
+package api
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/common-fate/common-fate/pkg/api/mocks"
+	"github.com/common-fate/common-fate/pkg/service/accesssvc"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminRetryFailedGrant(t *testing.T) {
+	type testcase struct {
+		name     string
+		retryErr error
+		wantCode int
+		wantBody string
+	}
+
+	testcases := []testcase{
+		{
+			name:     "ok",
+			wantCode: http.StatusAccepted,
+		},
+		{
+			name:     "not found",
+			retryErr: accesssvc.ErrFailedGrantNotFound,
+			wantCode: http.StatusNotFound,
+			wantBody: `{"error":"failed grant not found"}`,
+		},
+		{
+			name:     "internal error",
+			retryErr: errors.New("internal error"),
+			wantCode: http.StatusInternalServerError,
+			wantBody: `{"error":"Internal Server Error"}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockAccess := mocks.NewMockAccessService(ctrl)
+			mockAccess.EXPECT().RetryFailedGrant(gomock.Any(), gomock.Any(), "gra_1").Return(tc.retryErr)
+
+			a := API{Access: mockAccess}
+			handler := newTestServer(t, &a)
+
+			req, err := http.NewRequest("POST", "/api/v1/admin/failed-grants/gra_1/retry", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+
+			data, err := io.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.wantBody != "" {
+				assert.Equal(t, tc.wantBody, string(data))
+			}
+		})
+	}
+}
+
+func TestAdminCompleteFailedGrant(t *testing.T) {
+	type testcase struct {
+		name        string
+		completeErr error
+		wantCode    int
+		wantBody    string
+	}
+
+	testcases := []testcase{
+		{
+			name:     "ok",
+			wantCode: http.StatusNoContent,
+		},
+		{
+			name:        "not found",
+			completeErr: accesssvc.ErrFailedGrantNotFound,
+			wantCode:    http.StatusNotFound,
+			wantBody:    `{"error":"failed grant not found"}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockAccess := mocks.NewMockAccessService(ctrl)
+			mockAccess.EXPECT().CompleteFailedGrant(gomock.Any(), gomock.Any(), "gra_1").Return(tc.completeErr)
+
+			a := API{Access: mockAccess}
+			handler := newTestServer(t, &a)
+
+			req, err := http.NewRequest("POST", "/api/v1/admin/failed-grants/gra_1/complete", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+
+			data, err := io.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.wantBody != "" {
+				assert.Equal(t, tc.wantBody, string(data))
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/common-fate/pkg/auth"
+	"github.com/common-fate/common-fate/pkg/service/accesssvc"
+	"github.com/common-fate/common-fate/pkg/service/preflightsvc"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+)
+
+// List Favorites
+// (GET /api/v1/favorites)
+func (a *API) UserListFavorites(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user := auth.UserFromContext(ctx)
+
+	favorites, err := a.Access.ListFavorites(ctx, *user)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	res := types.ListFavoritesResponse{
+		Favorites: []types.Favorite{},
+	}
+	for _, f := range favorites {
+		res.Favorites = append(res.Favorites, f.ToAPI(f.Eligible))
+	}
+	apio.JSON(ctx, w, res, http.StatusOK)
+}
+
+// Create Favorite
+// (POST /api/v1/favorites)
+func (a *API) UserCreateFavorite(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user := auth.UserFromContext(ctx)
+
+	var b types.CreateFavoriteRequest
+	err := apio.DecodeJSONBody(w, r, &b)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	favorite, err := a.Access.CreateFavorite(ctx, *user, b.TargetId)
+	if err == accesssvc.ErrTargetNotFound {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusNotFound))
+		return
+	}
+	if err == accesssvc.ErrUserNotAuthorized {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusUnauthorized))
+		return
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, favorite.ToAPI(true), http.StatusCreated)
+}
+
+// Delete Favorite
+// (DELETE /api/v1/favorites/{targetId})
+func (a *API) UserDeleteFavorite(w http.ResponseWriter, r *http.Request, targetId string) {
+	ctx := r.Context()
+	user := auth.UserFromContext(ctx)
+
+	err := a.Access.DeleteFavorite(ctx, *user, targetId)
+	if err == accesssvc.ErrFavoriteNotFound {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusNotFound))
+		return
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Request Favorites
+// (POST /api/v1/favorites/request)
+func (a *API) UserRequestFavorites(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user := auth.UserFromContext(ctx)
+
+	var b types.RequestFavoritesRequest
+	err := apio.DecodeJSONBody(w, r, &b)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	favorites, err := a.Access.ListFavorites(ctx, *user)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	eligible := map[string]bool{}
+	for _, f := range favorites {
+		eligible[f.TargetID] = f.Eligible
+	}
+
+	var targetIDs []string
+	if b.TargetIds != nil {
+		for _, id := range *b.TargetIds {
+			isEligible, ok := eligible[id]
+			if !ok {
+				apio.Error(ctx, w, apio.NewRequestError(accesssvc.ErrFavoriteNotFound, http.StatusNotFound))
+				return
+			}
+			if !isEligible {
+				apio.Error(ctx, w, apio.NewRequestError(preflightsvc.ErrUserNotAuthorisedForRequestedTarget, http.StatusUnauthorized))
+				return
+			}
+			targetIDs = append(targetIDs, id)
+		}
+	} else {
+		for _, f := range favorites {
+			if f.Eligible {
+				targetIDs = append(targetIDs, f.TargetID)
+			}
+		}
+	}
+	if len(targetIDs) == 0 {
+		apio.Error(ctx, w, apio.NewRequestError(errors.New("there are no eligible favorites to request"), http.StatusBadRequest))
+		return
+	}
+
+	preflight, err := a.PreflightService.ProcessPreflight(ctx, *user, types.CreatePreflightRequest{Targets: targetIDs})
+	if err == preflightsvc.ErrDuplicateTargetIDsRequested {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusBadRequest))
+		return
+	}
+	if err == preflightsvc.ErrUserNotAuthorisedForRequestedTarget {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusUnauthorized))
+		return
+	}
+	if err == ddb.ErrNoItems {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusNotFound))
+		return
+	}
+	if isFrozen(err) {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusForbidden))
+		return
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	reason := ""
+	if b.Reason != nil {
+		reason = *b.Reason
+	}
+	createRequest := types.CreateAccessRequestRequest{
+		PreflightId:  preflight.ID,
+		Reason:       &reason,
+		GroupOptions: []types.CreateAccessRequestGroupOptions{},
+	}
+	// each access group is requested for the default duration of its access rule, starting immediately
+	for _, group := range preflight.AccessGroups {
+		createRequest.GroupOptions = append(createRequest.GroupOptions, types.CreateAccessRequestGroupOptions{
+			Id: group.ID,
+			Timing: types.RequestAccessGroupTiming{
+				DurationSeconds: group.TimeConstraints.DefaultDurationSeconds,
+			},
+		})
+	}
+
+	result, err := a.Access.CreateRequest(ctx, *user, createRequest)
+	if err == accesssvc.ErrPreflightNotFound {
+		err = apio.NewRequestError(err, http.StatusNotFound)
+	}
+	if isFrozen(err) {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusForbidden))
+		return
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, result.ToAPI(), http.StatusOK)
+}
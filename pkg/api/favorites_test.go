@@ -0,0 +1,110 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/api/mocks"
+	"github.com/common-fate/common-fate/pkg/service/accesssvc"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserRequestFavorites(t *testing.T) {
+	type testcase struct {
+		name              string
+		give              string
+		favorites         []accesssvc.FavoriteWithEligibility
+		wantPreflight     *types.CreatePreflightRequest
+		wantCreateRequest *types.CreateAccessRequestRequest
+		wantCode          int
+		wantBody          string
+	}
+
+	reason := ""
+	testcases := []testcase{
+		{
+			name: "requests all eligible favorites with the default duration",
+			give: `{}`,
+			favorites: []accesssvc.FavoriteWithEligibility{
+				{Favorite: access.Favorite{TargetID: "target1"}, Eligible: true},
+				{Favorite: access.Favorite{TargetID: "target2"}, Eligible: false},
+			},
+			wantPreflight: &types.CreatePreflightRequest{Targets: []string{"target1"}},
+			wantCreateRequest: &types.CreateAccessRequestRequest{
+				PreflightId: "pre_1",
+				Reason:      &reason,
+				GroupOptions: []types.CreateAccessRequestGroupOptions{
+					{Id: "agi_1", Timing: types.RequestAccessGroupTiming{DurationSeconds: 3600}},
+				},
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name: "requested target is not a favorite",
+			give: `{"targetIds":["other"]}`,
+			favorites: []accesssvc.FavoriteWithEligibility{
+				{Favorite: access.Favorite{TargetID: "target1"}, Eligible: true},
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: `{"error":"favorite not found"}`,
+		},
+		{
+			name: "no eligible favorites",
+			give: `{}`,
+			favorites: []accesssvc.FavoriteWithEligibility{
+				{Favorite: access.Favorite{TargetID: "target1"}, Eligible: false},
+			},
+			wantCode: http.StatusBadRequest,
+			wantBody: `{"error":"there are no eligible favorites to request"}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockAccess := mocks.NewMockAccessService(ctrl)
+			mockPreflight := mocks.NewMockPreflightService(ctrl)
+
+			mockAccess.EXPECT().ListFavorites(gomock.Any(), gomock.Any()).Return(tc.favorites, nil)
+			if tc.wantPreflight != nil {
+				mockPreflight.EXPECT().ProcessPreflight(gomock.Any(), gomock.Any(), *tc.wantPreflight).Return(&access.Preflight{
+					ID: "pre_1",
+					AccessGroups: []access.PreflightAccessGroup{
+						{ID: "agi_1", TimeConstraints: types.AccessRuleTimeConstraints{DefaultDurationSeconds: 3600, MaxDurationSeconds: 7200}},
+					},
+				}, nil)
+			}
+			if tc.wantCreateRequest != nil {
+				mockAccess.EXPECT().CreateRequest(gomock.Any(), gomock.Any(), *tc.wantCreateRequest).Return(&access.RequestWithGroupsWithTargets{}, nil)
+			}
+
+			a := API{Access: mockAccess, PreflightService: mockPreflight}
+			handler := newTestServer(t, &a)
+
+			req, err := http.NewRequest("POST", "/api/v1/favorites/request", strings.NewReader(tc.give))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Add("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+
+			data, err := io.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.wantBody != "" {
+				assert.Equal(t, tc.wantBody, string(data))
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/common-fate/pkg/auth"
+	"github.com/common-fate/common-fate/pkg/service/freezesvc"
+	"github.com/common-fate/common-fate/pkg/types"
+)
+
+// List access freezes
+// (GET /api/v1/admin/freezes)
+func (a *API) AdminListFreezes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	freezes, err := a.FreezeService.List(ctx)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	now := time.Now()
+	res := types.ListFreezesResponse{
+		Freezes: []types.Freeze{},
+	}
+	for _, f := range freezes {
+		res.Freezes = append(res.Freezes, f.ToAPI(now))
+	}
+	apio.JSON(ctx, w, res, http.StatusOK)
+}
+
+// Create an access freeze
+// (POST /api/v1/admin/freezes)
+func (a *API) AdminCreateFreeze(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var b types.CreateFreezeRequest
+	err := apio.DecodeJSONBody(w, r, &b)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	opts := freezesvc.CreateFreezeOpts{
+		Scope:   b.Scope,
+		ScopeID: aws.ToString(b.ScopeId),
+		Message: b.Message,
+		EndsAt:  b.EndsAt,
+	}
+	if b.ExemptGroups != nil {
+		opts.ExemptGroups = *b.ExemptGroups
+	}
+	u := auth.UserFromContext(ctx)
+	freeze, err := a.FreezeService.Create(ctx, *u, opts)
+	if err != nil {
+		apio.Error(ctx, w, freezeError(err))
+		return
+	}
+	apio.JSON(ctx, w, freeze.ToAPI(time.Now()), http.StatusCreated)
+}
+
+// Get an access freeze
+// (GET /api/v1/admin/freezes/{freezeId})
+func (a *API) AdminGetFreeze(w http.ResponseWriter, r *http.Request, freezeId string) {
+	ctx := r.Context()
+	freeze, err := a.FreezeService.Get(ctx, freezeId)
+	if err != nil {
+		apio.Error(ctx, w, freezeError(err))
+		return
+	}
+	apio.JSON(ctx, w, freeze.ToAPI(time.Now()), http.StatusOK)
+}
+
+// Update an access freeze
+// (PUT /api/v1/admin/freezes/{freezeId})
+func (a *API) AdminUpdateFreeze(w http.ResponseWriter, r *http.Request, freezeId string) {
+	ctx := r.Context()
+	var b types.UpdateFreezeRequest
+	err := apio.DecodeJSONBody(w, r, &b)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	opts := freezesvc.UpdateFreezeOpts{
+		Message: b.Message,
+		EndsAt:  b.EndsAt,
+	}
+	if b.ExemptGroups != nil {
+		opts.ExemptGroups = *b.ExemptGroups
+	}
+	u := auth.UserFromContext(ctx)
+	freeze, err := a.FreezeService.Update(ctx, *u, freezeId, opts)
+	if err != nil {
+		apio.Error(ctx, w, freezeError(err))
+		return
+	}
+	apio.JSON(ctx, w, freeze.ToAPI(time.Now()), http.StatusOK)
+}
+
+// Lift an access freeze
+// (POST /api/v1/admin/freezes/{freezeId}/lift)
+func (a *API) AdminLiftFreeze(w http.ResponseWriter, r *http.Request, freezeId string) {
+	ctx := r.Context()
+	u := auth.UserFromContext(ctx)
+	freeze, err := a.FreezeService.Lift(ctx, *u, freezeId)
+	if err != nil {
+		apio.Error(ctx, w, freezeError(err))
+		return
+	}
+	apio.JSON(ctx, w, freeze.ToAPI(time.Now()), http.StatusOK)
+}
+
+// List the history of an access freeze
+// (GET /api/v1/admin/freezes/{freezeId}/history)
+func (a *API) AdminListFreezeHistory(w http.ResponseWriter, r *http.Request, freezeId string) {
+	ctx := r.Context()
+	events, err := a.FreezeService.ListHistory(ctx, freezeId)
+	if err != nil {
+		apio.Error(ctx, w, freezeError(err))
+		return
+	}
+	now := time.Now()
+	res := types.ListFreezeHistoryResponse{
+		Events: []types.FreezeHistoryEvent{},
+	}
+	for _, e := range events {
+		res.Events = append(res.Events, e.ToAPI(now))
+	}
+	apio.JSON(ctx, w, res, http.StatusOK)
+}
+
+// freezeError wraps errors returned by the freeze service with the matching status code.
+func freezeError(err error) error {
+	var scopeErr freezesvc.InvalidScopeError
+	switch {
+	case err == freezesvc.ErrFreezeNotFound:
+		return apio.NewRequestError(err, http.StatusNotFound)
+	case err == freezesvc.ErrFreezeLifted, err == freezesvc.ErrEndsAtInPast, err == freezesvc.ErrMessageRequired, errors.As(err, &scopeErr):
+		return apio.NewRequestError(err, http.StatusBadRequest)
+	}
+	return err
+}
+
+// isFrozen returns true if a request, approval or activation was refused because of a freeze.
+func isFrozen(err error) bool {
+	var frozenErr freezesvc.FrozenError
+	return errors.As(err, &frozenErr)
+}
@@ -0,0 +1,141 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/api/mocks"
+	"github.com/common-fate/common-fate/pkg/service/freezesvc"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminCreateFreeze(t *testing.T) {
+	createdAt := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+	freeze := access.Freeze{
+		ID:           "frz_1",
+		Scope:        types.FreezeScopeTARGETGROUP,
+		ScopeID:      "aws",
+		Message:      "incident",
+		ExemptGroups: []string{"break-glass"},
+		CreatedBy:    access.RequestedBy{ID: "usr_admin"},
+		CreatedAt:    createdAt,
+		UpdatedAt:    createdAt,
+	}
+
+	type testcase struct {
+		name      string
+		give      string
+		wantOpts  freezesvc.CreateFreezeOpts
+		createErr error
+		wantCode  int
+		wantBody  string
+	}
+
+	testcases := []testcase{
+		{
+			name:     "ok",
+			give:     `{"scope":"TARGET_GROUP","scopeId":"aws","message":"incident","exemptGroups":["break-glass"]}`,
+			wantOpts: freezesvc.CreateFreezeOpts{Scope: types.FreezeScopeTARGETGROUP, ScopeID: "aws", Message: "incident", ExemptGroups: []string{"break-glass"}},
+			wantCode: http.StatusCreated,
+			wantBody: `{"active":true,"createdAt":"2023-01-01T12:00:00Z","createdBy":"usr_admin","exemptGroups":["break-glass"],"id":"frz_1","message":"incident","scope":"TARGET_GROUP","scopeId":"aws","updatedAt":"2023-01-01T12:00:00Z"}`,
+		},
+		{
+			name:      "invalid scope",
+			give:      `{"scope":"GLOBAL","scopeId":"aws","message":"incident"}`,
+			wantOpts:  freezesvc.CreateFreezeOpts{Scope: types.FreezeScopeGLOBAL, ScopeID: "aws", Message: "incident"},
+			createErr: freezesvc.InvalidScopeError{Scope: types.FreezeScopeGLOBAL, Reason: "scopeId must not be set"},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  `{"error":"invalid GLOBAL freeze: scopeId must not be set"}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			m := mocks.NewMockFreezeService(ctrl)
+			var got *access.Freeze
+			if tc.createErr == nil {
+				got = &freeze
+			}
+			m.EXPECT().Create(gomock.Any(), gomock.Any(), tc.wantOpts).Return(got, tc.createErr)
+
+			a := API{FreezeService: m}
+			handler := newTestServer(t, &a)
+
+			req, err := http.NewRequest("POST", "/api/v1/admin/freezes", strings.NewReader(tc.give))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Add("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+
+			data, err := io.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tc.wantBody, string(data))
+		})
+	}
+}
+
+func TestAdminLiftFreeze(t *testing.T) {
+	type testcase struct {
+		name     string
+		liftErr  error
+		wantCode int
+		wantBody string
+	}
+
+	testcases := []testcase{
+		{
+			name:     "not found",
+			liftErr:  freezesvc.ErrFreezeNotFound,
+			wantCode: http.StatusNotFound,
+			wantBody: `{"error":"freeze not found"}`,
+		},
+		{
+			name:     "already lifted",
+			liftErr:  freezesvc.ErrFreezeLifted,
+			wantCode: http.StatusBadRequest,
+			wantBody: `{"error":"freeze has already been lifted"}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			m := mocks.NewMockFreezeService(ctrl)
+			m.EXPECT().Lift(gomock.Any(), gomock.Any(), "frz_1").Return(nil, tc.liftErr)
+
+			a := API{FreezeService: m}
+			handler := newTestServer(t, &a)
+
+			req, err := http.NewRequest("POST", "/api/v1/admin/freezes/frz_1/lift", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+
+			data, err := io.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tc.wantBody, string(data))
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/auth"
+	"github.com/common-fate/common-fate/pkg/service/idempotencysvc"
+)
+
+// beginIdempotent claims the Idempotency-Key header sent with a call.
+// The returned key is nil if no header was sent, and has its ResultID set if the call should be replayed.
+// If false is returned an error response has already been written.
+func (a *API) beginIdempotent(ctx context.Context, w http.ResponseWriter, header *string, operation string, body any) (*access.IdempotencyKey, bool) {
+	if a.Idempotency == nil || header == nil || *header == "" {
+		return nil, true
+	}
+	u := auth.UserFromContext(ctx)
+	key, err := a.Idempotency.Begin(ctx, idempotencysvc.BeginOpts{
+		UserID:    u.ID,
+		Operation: operation,
+		Key:       *header,
+		Body:      body,
+	})
+	if err == idempotencysvc.ErrKeyInProgress {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusConflict))
+		return nil, false
+	}
+	if err == idempotencysvc.ErrKeyReused {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusUnprocessableEntity))
+		return nil, false
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return nil, false
+	}
+	return key, true
+}
+
+// finishIdempotent saves the result of a call against its idempotency key, it is deferred by the handler.
+// Keys for calls which failed are released so that the call can be retried.
+func (a *API) finishIdempotent(ctx context.Context, key *access.IdempotencyKey, resultID *string) {
+	if key == nil || key.ResultID != "" {
+		return
+	}
+	err := a.Idempotency.Finish(ctx, *key, *resultID)
+	if err != nil {
+		logger.Get(ctx).Errorw("failed to save idempotency key", "key", key.Key, "error", err)
+	}
+}
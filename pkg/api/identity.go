@@ -1,21 +1,52 @@
 package api
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/common-fate/pkg/identity/identitysync"
 	"github.com/common-fate/common-fate/pkg/types"
 )
 
 // (POST /api/v1/admin/identity/sync)
-func (a *API) AdminSyncIdentity(w http.ResponseWriter, r *http.Request) {
+func (a *API) AdminSyncIdentity(w http.ResponseWriter, r *http.Request, params types.AdminSyncIdentityParams) {
 	ctx := r.Context()
-	err := a.IdentitySyncer.Sync(ctx)
+	opts := identitysync.RunOpts{
+		DryRun: params.DryRun != nil && *params.DryRun,
+		Force:  params.Force != nil && *params.Force,
+	}
+	report, err := a.IdentitySyncer.Run(ctx, opts)
+	var thresholdErr *identitysync.ArchiveThresholdError
+	aborted := errors.As(err, &thresholdErr)
+	switch {
+	case aborted && opts.DryRun:
+		// dry runs report the changes even if the sync would be aborted, the run has the ABORTED status
+	case aborted:
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusConflict))
+		return
+	case err != nil:
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, report.ToAPI(), http.StatusOK)
+}
+
+// (GET /api/v1/admin/identity/sync-runs)
+func (a *API) AdminListIdentitySyncRuns(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	runs, err := a.IdentitySyncer.ListRuns(ctx)
 	if err != nil {
 		apio.Error(ctx, w, err)
 		return
 	}
-	apio.JSON(ctx, w, nil, http.StatusOK)
+	res := types.ListIdentitySyncRunsResponse{
+		Runs: make([]types.IdentitySyncRun, len(runs)),
+	}
+	for i, run := range runs {
+		res.Runs[i] = run.ToAPI()
+	}
+	apio.JSON(ctx, w, res, http.StatusOK)
 }
 
 // Get identity configuration
@@ -0,0 +1,147 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/api/mocks"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/identity/identitysync"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminSyncIdentity(t *testing.T) {
+	thresholdErr := &identitysync.ArchiveThresholdError{Threshold: 50, ArchivedUsers: 6, ActiveUsers: 10, ActiveGroups: 2}
+
+	type testcase struct {
+		name       string
+		url        string
+		wantOpts   identitysync.RunOpts
+		runStatus  identity.SyncRunStatus
+		runErr     error
+		wantCode   int
+		wantStatus types.IdentitySyncRunStatus
+		wantBody   string
+	}
+
+	testcases := []testcase{
+		{
+			name:       "ok",
+			url:        "/api/v1/admin/identity/sync",
+			runStatus:  identity.SyncRunStatusSucceeded,
+			wantCode:   http.StatusOK,
+			wantStatus: types.IdentitySyncRunStatusSUCCEEDED,
+		},
+		{
+			name:       "dry run reports aborted syncs",
+			url:        "/api/v1/admin/identity/sync?dryRun=true",
+			wantOpts:   identitysync.RunOpts{DryRun: true},
+			runStatus:  identity.SyncRunStatusAborted,
+			runErr:     thresholdErr,
+			wantCode:   http.StatusOK,
+			wantStatus: types.IdentitySyncRunStatusABORTED,
+		},
+		{
+			name:      "aborted",
+			url:       "/api/v1/admin/identity/sync",
+			runStatus: identity.SyncRunStatusAborted,
+			runErr:    thresholdErr,
+			wantCode:  http.StatusConflict,
+			wantBody:  `{"error":"identity sync aborted because it would archive 6 of 10 users and 0 of 2 groups, which is more than the threshold of 50%. Run the sync with force to apply the changes"}`,
+		},
+		{
+			name:       "forced",
+			url:        "/api/v1/admin/identity/sync?force=true",
+			wantOpts:   identitysync.RunOpts{Force: true},
+			runStatus:  identity.SyncRunStatusSucceeded,
+			wantCode:   http.StatusOK,
+			wantStatus: types.IdentitySyncRunStatusSUCCEEDED,
+		},
+		{
+			name:      "failed",
+			url:       "/api/v1/admin/identity/sync",
+			runStatus: identity.SyncRunStatusFailed,
+			runErr:    errors.New("identity provider unavailable"),
+			wantCode:  http.StatusInternalServerError,
+			wantBody:  `{"error":"Internal Server Error"}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			m := mocks.NewMockIdentitySyncer(ctrl)
+			report := &identitysync.SyncReport{Run: identity.SyncRun{ID: "isr_1", Status: tc.runStatus, DryRun: tc.wantOpts.DryRun}}
+			m.EXPECT().Run(gomock.Any(), tc.wantOpts).Return(report, tc.runErr)
+
+			a := API{IdentitySyncer: m}
+			handler := newTestServer(t, &a)
+
+			req, err := http.NewRequest("POST", tc.url, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+			assert.Equal(t, tc.wantCode, rr.Code)
+
+			data, err := io.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.wantBody != "" {
+				assert.Equal(t, tc.wantBody, string(data))
+				return
+			}
+			var got types.IdentitySyncReport
+			err = json.Unmarshal(data, &got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tc.wantStatus, got.Run.Status)
+			assert.Equal(t, []types.User{}, got.Users.Archived)
+		})
+	}
+}
+
+func TestAdminListIdentitySyncRuns(t *testing.T) {
+	startedAt := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+	ctrl := gomock.NewController(t)
+	m := mocks.NewMockIdentitySyncer(ctrl)
+	m.EXPECT().ListRuns(gomock.Any()).Return([]identity.SyncRun{
+		{
+			ID:               "isr_1",
+			IdentityProvider: "okta",
+			Status:           identity.SyncRunStatusSucceeded,
+			Changes:          identity.SyncChangeCounts{UsersCreated: 2, GroupsArchived: 1},
+			StartedAt:        startedAt,
+			CompletedAt:      startedAt.Add(time.Second),
+		},
+	}, nil)
+
+	a := API{IdentitySyncer: m}
+	handler := newTestServer(t, &a)
+
+	req, err := http.NewRequest("GET", "/api/v1/admin/identity/sync-runs", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rr := httptest.NewRecorder()
+
+	handler.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	data, err := io.ReadAll(rr.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, `{"runs":[{"changes":{"groupsArchived":1,"groupsCreated":0,"groupsUpdated":0,"usersArchived":0,"usersCreated":2,"usersUpdated":0},"completedAt":"2023-01-01T12:00:01Z","dryRun":false,"forced":false,"id":"isr_1","identityProvider":"okta","startedAt":"2023-01-01T12:00:00Z","status":"SUCCEEDED"}]}`, string(data))
+}
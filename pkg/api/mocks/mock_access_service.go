@@ -38,6 +38,21 @@ func (m *MockAccessService) EXPECT() *MockAccessServiceMockRecorder {
 	return m.recorder
 }
 
+// Activate mocks base method.
+func (m *MockAccessService) Activate(arg0 context.Context, arg1 access.RequestWithGroupsWithTargets, arg2 string) (*access.GroupWithTargets, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Activate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*access.GroupWithTargets)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Activate indicates an expected call of Activate.
+func (mr *MockAccessServiceMockRecorder) Activate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Activate", reflect.TypeOf((*MockAccessService)(nil).Activate), arg0, arg1, arg2)
+}
+
 // CancelRequest mocks base method.
 func (m *MockAccessService) CancelRequest(arg0 context.Context, arg1 accesssvc.CancelRequestOpts) error {
 	m.ctrl.T.Helper()
@@ -52,6 +67,20 @@ func (mr *MockAccessServiceMockRecorder) CancelRequest(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelRequest", reflect.TypeOf((*MockAccessService)(nil).CancelRequest), arg0, arg1)
 }
 
+// CompleteFailedGrant mocks base method.
+func (m *MockAccessService) CompleteFailedGrant(arg0 context.Context, arg1 identity.User, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteFailedGrant", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteFailedGrant indicates an expected call of CompleteFailedGrant.
+func (mr *MockAccessServiceMockRecorder) CompleteFailedGrant(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteFailedGrant", reflect.TypeOf((*MockAccessService)(nil).CompleteFailedGrant), arg0, arg1, arg2)
+}
+
 // CreateAccessTemplate mocks base method.
 func (m *MockAccessService) CreateAccessTemplate(arg0 context.Context, arg1 identity.User, arg2 types.CreateAccessRequestRequest) (*access.AccessTemplate, error) {
 	m.ctrl.T.Helper()
@@ -67,6 +96,21 @@ func (mr *MockAccessServiceMockRecorder) CreateAccessTemplate(arg0, arg1, arg2 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccessTemplate", reflect.TypeOf((*MockAccessService)(nil).CreateAccessTemplate), arg0, arg1, arg2)
 }
 
+// CreateFavorite mocks base method.
+func (m *MockAccessService) CreateFavorite(arg0 context.Context, arg1 identity.User, arg2 string) (*access.Favorite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFavorite", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*access.Favorite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFavorite indicates an expected call of CreateFavorite.
+func (mr *MockAccessServiceMockRecorder) CreateFavorite(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFavorite", reflect.TypeOf((*MockAccessService)(nil).CreateFavorite), arg0, arg1, arg2)
+}
+
 // CreateRequest mocks base method.
 func (m *MockAccessService) CreateRequest(arg0 context.Context, arg1 identity.User, arg2 types.CreateAccessRequestRequest) (*access.RequestWithGroupsWithTargets, error) {
 	m.ctrl.T.Helper()
@@ -82,6 +126,64 @@ func (mr *MockAccessServiceMockRecorder) CreateRequest(arg0, arg1, arg2 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRequest", reflect.TypeOf((*MockAccessService)(nil).CreateRequest), arg0, arg1, arg2)
 }
 
+// DeleteFavorite mocks base method.
+func (m *MockAccessService) DeleteFavorite(arg0 context.Context, arg1 identity.User, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFavorite", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFavorite indicates an expected call of DeleteFavorite.
+func (mr *MockAccessServiceMockRecorder) DeleteFavorite(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFavorite", reflect.TypeOf((*MockAccessService)(nil).DeleteFavorite), arg0, arg1, arg2)
+}
+
+// ListFailedGrants mocks base method.
+func (m *MockAccessService) ListFailedGrants(arg0 context.Context) ([]access.FailedGrant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFailedGrants", arg0)
+	ret0, _ := ret[0].([]access.FailedGrant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFailedGrants indicates an expected call of ListFailedGrants.
+func (mr *MockAccessServiceMockRecorder) ListFailedGrants(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFailedGrants", reflect.TypeOf((*MockAccessService)(nil).ListFailedGrants), arg0)
+}
+
+// ListFavorites mocks base method.
+func (m *MockAccessService) ListFavorites(arg0 context.Context, arg1 identity.User) ([]accesssvc.FavoriteWithEligibility, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFavorites", arg0, arg1)
+	ret0, _ := ret[0].([]accesssvc.FavoriteWithEligibility)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFavorites indicates an expected call of ListFavorites.
+func (mr *MockAccessServiceMockRecorder) ListFavorites(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFavorites", reflect.TypeOf((*MockAccessService)(nil).ListFavorites), arg0, arg1)
+}
+
+// RetryFailedGrant mocks base method.
+func (m *MockAccessService) RetryFailedGrant(arg0 context.Context, arg1 identity.User, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryFailedGrant", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RetryFailedGrant indicates an expected call of RetryFailedGrant.
+func (mr *MockAccessServiceMockRecorder) RetryFailedGrant(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryFailedGrant", reflect.TypeOf((*MockAccessService)(nil).RetryFailedGrant), arg0, arg1, arg2)
+}
+
 // Review mocks base method.
 func (m *MockAccessService) Review(arg0 context.Context, arg1 identity.User, arg2 bool, arg3, arg4 string, arg5 types.ReviewRequest) error {
 	m.ctrl.T.Helper()
@@ -110,3 +212,18 @@ func (mr *MockAccessServiceMockRecorder) RevokeRequest(arg0, arg1 interface{}) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeRequest", reflect.TypeOf((*MockAccessService)(nil).RevokeRequest), arg0, arg1)
 }
+
+// RevokeTarget mocks base method.
+func (m *MockAccessService) RevokeTarget(arg0 context.Context, arg1 access.RequestWithGroupsWithTargets, arg2, arg3 string) (*access.GroupTarget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeTarget", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*access.GroupTarget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeTarget indicates an expected call of RevokeTarget.
+func (mr *MockAccessServiceMockRecorder) RevokeTarget(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeTarget", reflect.TypeOf((*MockAccessService)(nil).RevokeTarget), arg0, arg1, arg2, arg3)
+}
@@ -0,0 +1,54 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/common-fate/common-fate/pkg/api (interfaces: BulkRevokeService)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	access "github.com/common-fate/common-fate/pkg/access"
+	identity "github.com/common-fate/common-fate/pkg/identity"
+	bulkrevokesvc "github.com/common-fate/common-fate/pkg/service/bulkrevokesvc"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockBulkRevokeService is a mock of BulkRevokeService interface.
+type MockBulkRevokeService struct {
+	ctrl     *gomock.Controller
+	recorder *MockBulkRevokeServiceMockRecorder
+}
+
+// MockBulkRevokeServiceMockRecorder is the mock recorder for MockBulkRevokeService.
+type MockBulkRevokeServiceMockRecorder struct {
+	mock *MockBulkRevokeService
+}
+
+// NewMockBulkRevokeService creates a new mock instance.
+func NewMockBulkRevokeService(ctrl *gomock.Controller) *MockBulkRevokeService {
+	mock := &MockBulkRevokeService{ctrl: ctrl}
+	mock.recorder = &MockBulkRevokeServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBulkRevokeService) EXPECT() *MockBulkRevokeServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateJob mocks base method.
+func (m *MockBulkRevokeService) CreateJob(arg0 context.Context, arg1 identity.User, arg2 bulkrevokesvc.CreateJobOpts) (*access.BulkRevokeJob, []access.BulkRevokeJobItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateJob", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*access.BulkRevokeJob)
+	ret1, _ := ret[1].([]access.BulkRevokeJobItem)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateJob indicates an expected call of CreateJob.
+func (mr *MockBulkRevokeServiceMockRecorder) CreateJob(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJob", reflect.TypeOf((*MockBulkRevokeService)(nil).CreateJob), arg0, arg1, arg2)
+}
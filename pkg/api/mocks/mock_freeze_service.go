@@ -0,0 +1,128 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/common-fate/common-fate/pkg/api (interfaces: FreezeService)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	access "github.com/common-fate/common-fate/pkg/access"
+	identity "github.com/common-fate/common-fate/pkg/identity"
+	freezesvc "github.com/common-fate/common-fate/pkg/service/freezesvc"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockFreezeService is a mock of FreezeService interface.
+type MockFreezeService struct {
+	ctrl     *gomock.Controller
+	recorder *MockFreezeServiceMockRecorder
+}
+
+// MockFreezeServiceMockRecorder is the mock recorder for MockFreezeService.
+type MockFreezeServiceMockRecorder struct {
+	mock *MockFreezeService
+}
+
+// NewMockFreezeService creates a new mock instance.
+func NewMockFreezeService(ctrl *gomock.Controller) *MockFreezeService {
+	mock := &MockFreezeService{ctrl: ctrl}
+	mock.recorder = &MockFreezeServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFreezeService) EXPECT() *MockFreezeServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockFreezeService) Create(arg0 context.Context, arg1 identity.User, arg2 freezesvc.CreateFreezeOpts) (*access.Freeze, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*access.Freeze)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockFreezeServiceMockRecorder) Create(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockFreezeService)(nil).Create), arg0, arg1, arg2)
+}
+
+// Get mocks base method.
+func (m *MockFreezeService) Get(arg0 context.Context, arg1 string) (*access.Freeze, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*access.Freeze)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockFreezeServiceMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockFreezeService)(nil).Get), arg0, arg1)
+}
+
+// Lift mocks base method.
+func (m *MockFreezeService) Lift(arg0 context.Context, arg1 identity.User, arg2 string) (*access.Freeze, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lift", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*access.Freeze)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Lift indicates an expected call of Lift.
+func (mr *MockFreezeServiceMockRecorder) Lift(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lift", reflect.TypeOf((*MockFreezeService)(nil).Lift), arg0, arg1, arg2)
+}
+
+// List mocks base method.
+func (m *MockFreezeService) List(arg0 context.Context) ([]access.Freeze, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0)
+	ret0, _ := ret[0].([]access.Freeze)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockFreezeServiceMockRecorder) List(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockFreezeService)(nil).List), arg0)
+}
+
+// ListHistory mocks base method.
+func (m *MockFreezeService) ListHistory(arg0 context.Context, arg1 string) ([]access.FreezeHistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHistory", arg0, arg1)
+	ret0, _ := ret[0].([]access.FreezeHistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHistory indicates an expected call of ListHistory.
+func (mr *MockFreezeServiceMockRecorder) ListHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHistory", reflect.TypeOf((*MockFreezeService)(nil).ListHistory), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockFreezeService) Update(arg0 context.Context, arg1 identity.User, arg2 string, arg3 freezesvc.UpdateFreezeOpts) (*access.Freeze, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*access.Freeze)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockFreezeServiceMockRecorder) Update(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockFreezeService)(nil).Update), arg0, arg1, arg2, arg3)
+}
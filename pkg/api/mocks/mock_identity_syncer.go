@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/common-fate/common-fate/pkg/api (interfaces: IdentitySyncer)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	identity "github.com/common-fate/common-fate/pkg/identity"
+	identitysync "github.com/common-fate/common-fate/pkg/identity/identitysync"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIdentitySyncer is a mock of IdentitySyncer interface.
+type MockIdentitySyncer struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdentitySyncerMockRecorder
+}
+
+// MockIdentitySyncerMockRecorder is the mock recorder for MockIdentitySyncer.
+type MockIdentitySyncerMockRecorder struct {
+	mock *MockIdentitySyncer
+}
+
+// NewMockIdentitySyncer creates a new mock instance.
+func NewMockIdentitySyncer(ctrl *gomock.Controller) *MockIdentitySyncer {
+	mock := &MockIdentitySyncer{ctrl: ctrl}
+	mock.recorder = &MockIdentitySyncerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdentitySyncer) EXPECT() *MockIdentitySyncerMockRecorder {
+	return m.recorder
+}
+
+// ListRuns mocks base method.
+func (m *MockIdentitySyncer) ListRuns(arg0 context.Context) ([]identity.SyncRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRuns", arg0)
+	ret0, _ := ret[0].([]identity.SyncRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRuns indicates an expected call of ListRuns.
+func (mr *MockIdentitySyncerMockRecorder) ListRuns(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRuns", reflect.TypeOf((*MockIdentitySyncer)(nil).ListRuns), arg0)
+}
+
+// Run mocks base method.
+func (m *MockIdentitySyncer) Run(arg0 context.Context, arg1 identitysync.RunOpts) (*identitysync.SyncReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", arg0, arg1)
+	ret0, _ := ret[0].(*identitysync.SyncReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockIdentitySyncerMockRecorder) Run(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockIdentitySyncer)(nil).Run), arg0, arg1)
+}
+
+// Sync mocks base method.
+func (m *MockIdentitySyncer) Sync(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sync", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Sync indicates an expected call of Sync.
+func (mr *MockIdentitySyncerMockRecorder) Sync(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sync", reflect.TypeOf((*MockIdentitySyncer)(nil).Sync), arg0)
+}
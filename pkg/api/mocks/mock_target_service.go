@@ -52,6 +52,21 @@ func (mr *MockTargetServiceMockRecorder) CreateGroup(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroup", reflect.TypeOf((*MockTargetService)(nil).CreateGroup), arg0, arg1)
 }
 
+// CreateMetadata mocks base method.
+func (m *MockTargetService) CreateMetadata(arg0 context.Context, arg1 types.CreateTargetMetadataRequest) (*target.Metadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMetadata", arg0, arg1)
+	ret0, _ := ret[0].(*target.Metadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMetadata indicates an expected call of CreateMetadata.
+func (mr *MockTargetServiceMockRecorder) CreateMetadata(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMetadata", reflect.TypeOf((*MockTargetService)(nil).CreateMetadata), arg0, arg1)
+}
+
 // CreateRoute mocks base method.
 func (m *MockTargetService) CreateRoute(arg0 context.Context, arg1 string, arg2 types.CreateTargetGroupLink) (*target.Route, error) {
 	m.ctrl.T.Helper()
@@ -81,6 +96,20 @@ func (mr *MockTargetServiceMockRecorder) DeleteGroup(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroup", reflect.TypeOf((*MockTargetService)(nil).DeleteGroup), arg0, arg1)
 }
 
+// DeleteMetadata mocks base method.
+func (m *MockTargetService) DeleteMetadata(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMetadata", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMetadata indicates an expected call of DeleteMetadata.
+func (mr *MockTargetServiceMockRecorder) DeleteMetadata(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMetadata", reflect.TypeOf((*MockTargetService)(nil).DeleteMetadata), arg0, arg1)
+}
+
 // FilterResources mocks base method.
 func (m *MockTargetService) FilterResources(arg0 context.Context, arg1 []cache.TargetGroupResource, arg2 []types.Operation) ([]types.TargetGroupResource, error) {
 	m.ctrl.T.Helper()
@@ -95,3 +124,33 @@ func (mr *MockTargetServiceMockRecorder) FilterResources(arg0, arg1, arg2 interf
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterResources", reflect.TypeOf((*MockTargetService)(nil).FilterResources), arg0, arg1, arg2)
 }
+
+// SyncGroup mocks base method.
+func (m *MockTargetService) SyncGroup(arg0 context.Context, arg1 target.Group) (*target.SyncStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncGroup", arg0, arg1)
+	ret0, _ := ret[0].(*target.SyncStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SyncGroup indicates an expected call of SyncGroup.
+func (mr *MockTargetServiceMockRecorder) SyncGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncGroup", reflect.TypeOf((*MockTargetService)(nil).SyncGroup), arg0, arg1)
+}
+
+// UpdateMetadata mocks base method.
+func (m *MockTargetService) UpdateMetadata(arg0 context.Context, arg1 string, arg2 types.CreateTargetMetadataRequest) (*target.Metadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMetadata", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*target.Metadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMetadata indicates an expected call of UpdateMetadata.
+func (mr *MockTargetServiceMockRecorder) UpdateMetadata(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMetadata", reflect.TypeOf((*MockTargetService)(nil).UpdateMetadata), arg0, arg1, arg2)
+}
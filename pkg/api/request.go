@@ -10,7 +10,9 @@ import (
 	"github.com/common-fate/common-fate/pkg/storage/keys"
 
 	"github.com/common-fate/common-fate/pkg/service/accesssvc"
+	"github.com/common-fate/common-fate/pkg/service/idempotencysvc"
 	"github.com/common-fate/common-fate/pkg/service/preflightsvc"
+	"github.com/common-fate/common-fate/pkg/service/rulesvc"
 	"github.com/common-fate/common-fate/pkg/storage"
 	"github.com/common-fate/common-fate/pkg/types"
 	"github.com/common-fate/ddb"
@@ -113,7 +115,7 @@ func (a *API) UserGetPreflight(w http.ResponseWriter, r *http.Request, preflight
 }
 
 // (POST /api/v1/preflight)
-func (a *API) UserRequestPreflight(w http.ResponseWriter, r *http.Request) {
+func (a *API) UserRequestPreflight(w http.ResponseWriter, r *http.Request, params types.UserRequestPreflightParams) {
 	ctx := r.Context()
 
 	var createPreflightRequest types.CreatePreflightRequest
@@ -124,6 +126,17 @@ func (a *API) UserRequestPreflight(w http.ResponseWriter, r *http.Request) {
 	}
 	user := auth.UserFromContext(ctx)
 
+	key, ok := a.beginIdempotent(ctx, w, params.IdempotencyKey, idempotencysvc.OperationPreflight, createPreflightRequest)
+	if !ok {
+		return
+	}
+	if key != nil && key.ResultID != "" {
+		a.UserGetPreflight(w, r, key.ResultID)
+		return
+	}
+	var resultID string
+	defer a.finishIdempotent(ctx, key, &resultID)
+
 	out, err := a.PreflightService.ProcessPreflight(ctx, *user, createPreflightRequest)
 	if err == preflightsvc.ErrDuplicateTargetIDsRequested {
 		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusBadRequest))
@@ -137,16 +150,21 @@ func (a *API) UserRequestPreflight(w http.ResponseWriter, r *http.Request) {
 		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusNotFound))
 		return
 	}
+	if isFrozen(err) {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusForbidden))
+		return
+	}
 	if err != nil {
 		apio.Error(ctx, w, err)
 		return
 	}
+	resultID = out.ID
 
 	apio.JSON(ctx, w, out.ToAPI(), http.StatusOK)
 }
 
 // (POST /api/v1/requests)
-func (a *API) UserPostRequests(w http.ResponseWriter, r *http.Request) {
+func (a *API) UserPostRequests(w http.ResponseWriter, r *http.Request, params types.UserPostRequestsParams) {
 	ctx := r.Context()
 	user := auth.UserFromContext(ctx)
 
@@ -157,6 +175,24 @@ func (a *API) UserPostRequests(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	key, ok := a.beginIdempotent(ctx, w, params.IdempotencyKey, idempotencysvc.OperationCreateRequest, createRequest)
+	if !ok {
+		return
+	}
+	if key != nil && key.ResultID != "" {
+		// the request was already created by an earlier call with the same key
+		q := storage.GetRequestWithGroupsWithTargets{ID: key.ResultID}
+		_, err = a.DB.Query(ctx, &q)
+		if err != nil {
+			apio.Error(ctx, w, err)
+			return
+		}
+		apio.JSON(ctx, w, q.Result.ToAPI(), http.StatusOK)
+		return
+	}
+	var resultID string
+	defer a.finishIdempotent(ctx, key, &resultID)
+
 	//request create service takes a preflight request, validates its fields and initiates the granding process
 	//on all of the entitlements in the preflight
 	result, err := a.Access.CreateRequest(ctx, *user, createRequest)
@@ -164,10 +200,18 @@ func (a *API) UserPostRequests(w http.ResponseWriter, r *http.Request) {
 		// wrap the error in a 404 status code
 		err = apio.NewRequestError(err, http.StatusNotFound)
 	}
+	if err == access.ErrOnDemandWithStartTime || err == rulesvc.ErrNoApprover {
+		err = apio.NewRequestError(err, http.StatusBadRequest)
+	}
+	if isFrozen(err) {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusForbidden))
+		return
+	}
 	if err != nil {
 		apio.Error(ctx, w, err)
 		return
 	}
+	resultID = result.Request.ID
 
 	//check to create an access template
 	if createRequest.CreateTemplate {
@@ -201,7 +245,7 @@ func (a *API) UserRevokeRequest(w http.ResponseWriter, r *http.Request, requestI
 	if q.Result.Request.RequestedBy.Email == u.Email || isAdmin {
 		req = *q.Result
 	} else { // reviewers can revoke reviewable requests
-		p := storage.GetRequestReviewer{RequestID: requestID, ReviewerID: u.Email}
+		p := storage.GetRequestReviewer{RequestID: requestID, ReviewerID: u.ID}
 		_, err := a.DB.Query(ctx, &p)
 		if err == ddb.ErrNoItems {
 			//grant not found return 404
@@ -212,6 +256,54 @@ func (a *API) UserRevokeRequest(w http.ResponseWriter, r *http.Request, requestI
 	}
 
 	result, err := a.Access.RevokeRequest(ctx, req)
+	if err != nil {
+		apio.Error(ctx, w, statusTransitionError(err))
+		return
+	}
+
+	apio.JSON(ctx, w, result.ToAPI(), http.StatusOK)
+}
+
+// Revoke a single target in an active request
+// (POST /api/v1/requests/{requestid}/groups/{groupid}/targets/{targetid}/revoke)
+func (a *API) UserRevokeRequestTarget(w http.ResponseWriter, r *http.Request, requestID string, groupID string, targetID string) {
+	ctx := r.Context()
+	isAdmin := auth.IsAdmin(ctx)
+	u := auth.UserFromContext(ctx)
+	q := storage.GetRequestWithGroupsWithTargets{ID: requestID}
+	_, err := a.DB.Query(ctx, &q)
+	if err == ddb.ErrNoItems {
+		apio.Error(ctx, w, apio.NewRequestError(errors.New("request not found or you don't have access to it"), http.StatusNotFound))
+		return
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	// user can revoke targets in their own request and admins can revoke any target,
+	// reviewers can revoke targets in reviewable requests
+	if q.Result.Request.RequestedBy.Email != u.Email && !isAdmin {
+		p := storage.GetRequestReviewer{RequestID: requestID, ReviewerID: u.ID}
+		_, err := a.DB.Query(ctx, &p)
+		if err == ddb.ErrNoItems {
+			apio.Error(ctx, w, apio.NewRequestError(errors.New("request not found or you don't have access to it"), http.StatusNotFound))
+			return
+		}
+		if err != nil {
+			apio.Error(ctx, w, err)
+			return
+		}
+	}
+
+	result, err := a.Access.RevokeTarget(ctx, *q.Result, groupID, targetID)
+	if err == accesssvc.ErrRequestTargetNotFound {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusNotFound))
+		return
+	}
+	if err == accesssvc.ErrTargetCannotBeRevoked {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusBadRequest))
+		return
+	}
 	if err != nil {
 		apio.Error(ctx, w, err)
 		return
@@ -220,6 +312,44 @@ func (a *API) UserRevokeRequest(w http.ResponseWriter, r *http.Request, requestI
 	apio.JSON(ctx, w, result.ToAPI(), http.StatusOK)
 }
 
+// Activate an approved on demand access group
+// (POST /api/v1/requests/{requestid}/groups/{groupid}/activate)
+func (a *API) UserActivateRequestGroup(w http.ResponseWriter, r *http.Request, requestID string, groupID string) {
+	ctx := r.Context()
+	u := auth.UserFromContext(ctx)
+	q := storage.GetRequestWithGroupsWithTargets{ID: requestID}
+	_, err := a.DB.Query(ctx, &q)
+	if err == ddb.ErrNoItems {
+		apio.Error(ctx, w, apio.NewRequestError(errors.New("request not found or you don't have access to it"), http.StatusNotFound))
+		return
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	// only the requester can activate their access
+	if q.Result.Request.RequestedBy.Email != u.Email {
+		apio.Error(ctx, w, apio.NewRequestError(errors.New("request not found or you don't have access to it"), http.StatusNotFound))
+		return
+	}
+
+	result, err := a.Access.Activate(ctx, *q.Result, groupID)
+	if err == accesssvc.ErrAccessGroupNotFound {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusNotFound))
+		return
+	}
+	if err == accesssvc.ErrAccessGroupNotAwaitingActivation || err == accesssvc.ErrActivationWindowExpired {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusBadRequest))
+		return
+	}
+	if err != nil {
+		apio.Error(ctx, w, statusTransitionError(err))
+		return
+	}
+
+	apio.JSON(ctx, w, result.ToAPI(), http.StatusOK)
+}
+
 func (a *API) UserCancelRequest(w http.ResponseWriter, r *http.Request, requestId string) {
 	ctx := r.Context()
 	uid := auth.UserIDFromContext(ctx)
@@ -426,3 +556,16 @@ func (a *API) UserListAccessTemplates(w http.ResponseWriter, r *http.Request) {
 
 	apio.JSON(ctx, w, res, http.StatusOK)
 }
+
+// statusTransitionError returns a 400 error if the status change isn't allowed,
+// and a 409 error if the status was changed by someone else while the request was being handled.
+func statusTransitionError(err error) error {
+	var ite access.InvalidTransitionError
+	if errors.As(err, &ite) {
+		return apio.NewRequestError(err, http.StatusBadRequest)
+	}
+	if errors.Is(err, access.ErrStaleStatus) {
+		return apio.NewRequestError(err, http.StatusConflict)
+	}
+	return err
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"errors"
 	"io"
 	"net/http"
@@ -15,6 +16,8 @@ import (
 	"github.com/common-fate/common-fate/pkg/identity"
 	"github.com/common-fate/common-fate/pkg/rule"
 	"github.com/common-fate/common-fate/pkg/service/accesssvc"
+	"github.com/common-fate/common-fate/pkg/service/idempotencysvc"
+	"github.com/common-fate/common-fate/pkg/service/rulesvc"
 	"github.com/common-fate/common-fate/pkg/storage"
 	"github.com/common-fate/common-fate/pkg/target"
 	"github.com/common-fate/common-fate/pkg/types"
@@ -243,6 +246,13 @@ func TestUserCreateRequest(t *testing.T) {
 			mockCreateErr: accesssvc.ErrPreflightNotFound,
 			wantBody:      `{"error":"preflight not found"}`,
 		},
+		{
+			name:          "no approver",
+			give:          `{"groupOptions":[{"id":"group1","timing":{"durationSeconds":3600}}],"preflightId":"1234567890","createTemplate":false,"reason":"Sample reason"}`,
+			wantCode:      http.StatusBadRequest,
+			mockCreateErr: rulesvc.ErrNoApprover,
+			wantBody:      `{"error":"no approver could be resolved for the access rule"}`,
+		},
 	}
 
 	for _, tc := range testcases {
@@ -277,6 +287,85 @@ func TestUserCreateRequest(t *testing.T) {
 
 }
 
+func TestUserCreateRequestIdempotencyKey(t *testing.T) {
+	type testcase struct {
+		name         string
+		withKey      *access.IdempotencyKey
+		withBeginErr error
+		wantCreate   bool
+		wantFinish   bool
+		wantCode     int
+		wantBody     string
+	}
+
+	testcases := []testcase{
+		{
+			name:       "new key creates the request",
+			withKey:    &access.IdempotencyKey{Key: "key1"},
+			wantCreate: true,
+			wantFinish: true,
+			wantCode:   http.StatusOK,
+		},
+		{
+			name:     "retried call returns the existing request",
+			withKey:  &access.IdempotencyKey{Key: "key1", ResultID: "req_123"},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:         "call in progress",
+			withBeginErr: idempotencysvc.ErrKeyInProgress,
+			wantCode:     http.StatusConflict,
+			wantBody:     `{"error":"a call with this idempotency key is still in progress"}`,
+		},
+		{
+			name:         "key reused",
+			withBeginErr: idempotencysvc.ErrKeyReused,
+			wantCode:     http.StatusUnprocessableEntity,
+			wantBody:     `{"error":"this idempotency key was already used with a different request body"}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockAccess := mocks.NewMockAccessService(ctrl)
+			if tc.wantCreate {
+				mockAccess.EXPECT().CreateRequest(gomock.Any(), gomock.Any(), gomock.Any()).Return(&r, nil).Times(1)
+			}
+			mockIdempotency := mocks.NewMockIdempotencyService(ctrl)
+			mockIdempotency.EXPECT().Begin(gomock.Any(), gomock.Any()).Return(tc.withKey, tc.withBeginErr).Times(1)
+			if tc.wantFinish {
+				mockIdempotency.EXPECT().Finish(gomock.Any(), *tc.withKey, "req_123").Return(nil).Times(1)
+			}
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.GetRequestWithGroupsWithTargets{Result: &r})
+			a := API{Access: mockAccess, Idempotency: mockIdempotency, DB: db}
+			handler := newTestServer(t, &a)
+
+			req, err := http.NewRequest("POST", "/api/v1/requests", strings.NewReader(`{"preflightId":"1234567890","groupOptions":[],"createTemplate":false}`))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Add("Content-Type", "application/json")
+			req.Header.Add("Idempotency-Key", "key1")
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+			data, err := io.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.wantBody != "" {
+				assert.Equal(t, tc.wantBody, string(data))
+			} else {
+				assert.Contains(t, string(data), `"id":"req_123"`)
+			}
+		})
+	}
+}
+
 func TestUserCancelRequest(t *testing.T) {
 	type testcase struct {
 		name          string
@@ -618,6 +707,240 @@ func TestRevokeRequest(t *testing.T) {
 	}
 }
 
+func TestUserRevokeRequestTarget(t *testing.T) {
+	type testcase struct {
+		name                 string
+		revokeSvcResp        *access.GroupTarget
+		withUID              string
+		withUEmail           string
+		withRevokeTargetErr  error
+		withGetRequestError  error
+		withGetReviewerError error
+		withIsAdmin          bool
+		wantCode             int
+		wantBody             string
+	}
+
+	testcases := []testcase{
+		{
+			name:                "request not found",
+			withGetRequestError: ddb.ErrNoItems,
+			wantCode:            http.StatusNotFound,
+			wantBody:            `{"error":"request not found or you don't have access to it"}`,
+		},
+		{
+			name:          "user can revoke a target in their own request",
+			withUID:       "user1",
+			withUEmail:    "user1@gmail.com",
+			revokeSvcResp: &access.GroupTarget{ID: "target1"},
+			wantCode:      http.StatusOK,
+		},
+		{
+			name:          "admin can revoke any target",
+			withUID:       "admin",
+			withUEmail:    "admin@mail.com",
+			withIsAdmin:   true,
+			revokeSvcResp: &access.GroupTarget{ID: "target1"},
+			wantCode:      http.StatusOK,
+		},
+		{
+			name:                 "user cant revoke a target in another users request",
+			withUID:              "abcd",
+			withUEmail:           "userinvalid@gmai.com",
+			withGetReviewerError: ddb.ErrNoItems,
+			wantCode:             http.StatusNotFound,
+			wantBody:             `{"error":"request not found or you don't have access to it"}`,
+		},
+		{
+			name:          "reviewer can revoke a target",
+			withUID:       "user2",
+			withUEmail:    "user2@mail.com",
+			revokeSvcResp: &access.GroupTarget{ID: "target1"},
+			wantCode:      http.StatusOK,
+		},
+		{
+			name:                "target not in group",
+			withUID:             "user1",
+			withUEmail:          "user1@gmail.com",
+			withRevokeTargetErr: accesssvc.ErrRequestTargetNotFound,
+			wantCode:            http.StatusNotFound,
+			wantBody:            `{"error":"this target doesn't exist in the access group"}`,
+		},
+		{
+			name:                "target already expired",
+			withUID:             "user1",
+			withUEmail:          "user1@gmail.com",
+			withRevokeTargetErr: accesssvc.ErrTargetCannotBeRevoked,
+			wantCode:            http.StatusBadRequest,
+			wantBody:            `{"error":"only active or pending targets can be revoked"}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQueryWithErr(&storage.GetRequestWithGroupsWithTargets{Result: &r}, tc.withGetRequestError)
+			db.MockQueryWithErr(&storage.GetRequestReviewer{Result: &access.Reviewer{
+				ReviewerID: tc.withUID,
+				RequestID:  r.Request.ID,
+			}}, tc.withGetReviewerError)
+			ctrl := gomock.NewController(t)
+			m := mocks.NewMockAccessService(ctrl)
+			m.EXPECT().RevokeTarget(gomock.Any(), gomock.Any(), "group1", "target1").Return(tc.revokeSvcResp, tc.withRevokeTargetErr).AnyTimes()
+			a := API{DB: db, Access: m}
+			handler := newTestServer(t, &a, WithIsAdmin(tc.withIsAdmin), WithRequestUser(identity.User{ID: tc.withUID, Email: tc.withUEmail}))
+
+			req, err := http.NewRequest("POST", "/api/v1/requests/123/groups/group1/targets/target1/revoke", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+			data, err := io.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+			if tc.wantBody != "" {
+				assert.Equal(t, tc.wantBody, string(data))
+			}
+		})
+	}
+}
+
+// reviewerDB returns the reviewer only when it is looked up by the reviewer's user ID,
+// as the mock client doesn't check the query parameters.
+type reviewerDB struct {
+	*mockClient
+	reviewer access.Reviewer
+}
+
+type mockClient = ddbmock.Client
+
+func (d *reviewerDB) Query(ctx context.Context, qb ddb.QueryBuilder, opts ...func(*ddb.QueryOpts)) (*ddb.QueryResult, error) {
+	if q, ok := qb.(*storage.GetRequestReviewer); ok {
+		if q.RequestID != d.reviewer.RequestID || q.ReviewerID != d.reviewer.ReviewerID {
+			return nil, ddb.ErrNoItems
+		}
+		q.Result = &d.reviewer
+		return &ddb.QueryResult{}, nil
+	}
+	return d.mockClient.Query(ctx, qb, opts...)
+}
+
+func TestReviewerCanRevokeTarget(t *testing.T) {
+	// the reviewer is neither the requester nor an admin
+	reviewer := identity.User{ID: "usr_reviewer", Email: "reviewer@mail.com"}
+
+	for _, tc := range []struct {
+		name     string
+		reviewer access.Reviewer
+		wantCode int
+	}{
+		{name: "reviewer of the request", reviewer: access.Reviewer{ReviewerID: reviewer.ID, RequestID: "123"}, wantCode: http.StatusOK},
+		{name: "reviewer of another request", reviewer: access.Reviewer{ReviewerID: reviewer.ID, RequestID: "456"}, wantCode: http.StatusNotFound},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			mock := ddbmock.New(t)
+			mock.MockQuery(&storage.GetRequestWithGroupsWithTargets{Result: &r})
+			db := &reviewerDB{mockClient: mock, reviewer: tc.reviewer}
+			ctrl := gomock.NewController(t)
+			m := mocks.NewMockAccessService(ctrl)
+			m.EXPECT().RevokeTarget(gomock.Any(), gomock.Any(), "group1", "target1").Return(&access.GroupTarget{ID: "target1"}, nil).AnyTimes()
+			a := API{DB: db, Access: m}
+			handler := newTestServer(t, &a, WithRequestUser(reviewer))
+
+			req, err := http.NewRequest("POST", "/api/v1/requests/123/groups/group1/targets/target1/revoke", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+		})
+	}
+}
+
+func TestUserActivateRequestGroup(t *testing.T) {
+	type testcase struct {
+		name                string
+		activateSvcResp     *access.GroupWithTargets
+		withUEmail          string
+		withActivateErr     error
+		withGetRequestError error
+		wantCode            int
+		wantBody            string
+	}
+
+	testcases := []testcase{
+		{
+			name:                "request not found",
+			withGetRequestError: ddb.ErrNoItems,
+			wantCode:            http.StatusNotFound,
+			wantBody:            `{"error":"request not found or you don't have access to it"}`,
+		},
+		{
+			name:            "requester can activate their access",
+			withUEmail:      "user1@gmail.com",
+			activateSvcResp: &access.GroupWithTargets{Group: access.Group{ID: "group1"}},
+			wantCode:        http.StatusOK,
+		},
+		{
+			name:       "user cant activate another users request",
+			withUEmail: "user2@mail.com",
+			wantCode:   http.StatusNotFound,
+			wantBody:   `{"error":"request not found or you don't have access to it"}`,
+		},
+		{
+			name:            "group not in request",
+			withUEmail:      "user1@gmail.com",
+			withActivateErr: accesssvc.ErrAccessGroupNotFound,
+			wantCode:        http.StatusNotFound,
+			wantBody:        `{"error":"this access group doesn't exist in the request"}`,
+		},
+		{
+			name:            "activation window passed",
+			withUEmail:      "user1@gmail.com",
+			withActivateErr: accesssvc.ErrActivationWindowExpired,
+			wantCode:        http.StatusBadRequest,
+			wantBody:        `{"error":"the activation window for this access group has passed"}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQueryWithErr(&storage.GetRequestWithGroupsWithTargets{Result: &r}, tc.withGetRequestError)
+			ctrl := gomock.NewController(t)
+			m := mocks.NewMockAccessService(ctrl)
+			m.EXPECT().Activate(gomock.Any(), gomock.Any(), "group1").Return(tc.activateSvcResp, tc.withActivateErr).AnyTimes()
+			a := API{DB: db, Access: m}
+			handler := newTestServer(t, &a, WithRequestUser(identity.User{ID: "abcd", Email: tc.withUEmail}))
+
+			req, err := http.NewRequest("POST", "/api/v1/requests/123/groups/group1/activate", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+			data, err := io.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+			if tc.wantBody != "" {
+				assert.Equal(t, tc.wantBody, string(data))
+			}
+		})
+	}
+}
+
 func TestUserListRequestEvents(t *testing.T) {
 	type testcase struct {
 		name                      string
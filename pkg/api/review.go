@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/common-fate/pkg/access"
 	"github.com/common-fate/common-fate/pkg/auth"
 	"github.com/common-fate/common-fate/pkg/service/accesssvc"
 	"github.com/common-fate/common-fate/pkg/types"
@@ -35,6 +36,14 @@ func (a *API) UserReviewRequest(w http.ResponseWriter, r *http.Request, requestI
 		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusBadRequest))
 		return
 	}
+	if err == access.ErrOnDemandWithStartTime {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusBadRequest))
+		return
+	}
+	if isFrozen(err) {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusForbidden))
+		return
+	}
 	if err != nil {
 		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusInternalServerError))
 		return
@@ -0,0 +1,93 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/common-fate/pkg/service/targetsvc"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+)
+
+// List target metadata
+// (GET /api/v1/admin/target-metadata)
+func (a *API) AdminListTargetMetadata(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	q := storage.ListTargetMetadata{}
+	err := a.DB.All(ctx, &q)
+	if err != nil && err != ddb.ErrNoItems {
+		apio.Error(ctx, w, err)
+		return
+	}
+	res := types.ListTargetMetadataResponse{Metadata: []types.TargetMetadata{}}
+	for _, m := range q.Result {
+		res.Metadata = append(res.Metadata, m.ToAPI())
+	}
+	apio.JSON(ctx, w, res, http.StatusOK)
+}
+
+// Create target metadata
+// (POST /api/v1/admin/target-metadata)
+func (a *API) AdminCreateTargetMetadata(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var req types.CreateTargetMetadataRequest
+	err := apio.DecodeJSONBody(w, r, &req)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	m, err := a.TargetService.CreateMetadata(ctx, req)
+	if errors.Is(err, targetsvc.ErrInvalidTargetMetadata) || errors.Is(err, targetsvc.ErrInvalidFieldPattern) {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusBadRequest))
+		return
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, m.ToAPI(), http.StatusCreated)
+}
+
+// Update target metadata
+// (PUT /api/v1/admin/target-metadata/{id})
+func (a *API) AdminUpdateTargetMetadata(w http.ResponseWriter, r *http.Request, id string) {
+	ctx := r.Context()
+	var req types.CreateTargetMetadataRequest
+	err := apio.DecodeJSONBody(w, r, &req)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	m, err := a.TargetService.UpdateMetadata(ctx, id, req)
+	if err == targetsvc.ErrTargetMetadataNotFound {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusNotFound))
+		return
+	}
+	if errors.Is(err, targetsvc.ErrInvalidTargetMetadata) || errors.Is(err, targetsvc.ErrInvalidFieldPattern) {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusBadRequest))
+		return
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, m.ToAPI(), http.StatusOK)
+}
+
+// Delete target metadata
+// (DELETE /api/v1/admin/target-metadata/{id})
+func (a *API) AdminDeleteTargetMetadata(w http.ResponseWriter, r *http.Request, id string) {
+	ctx := r.Context()
+	err := a.TargetService.DeleteMetadata(ctx, id)
+	if err == targetsvc.ErrTargetMetadataNotFound {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusNotFound))
+		return
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, nil, http.StatusNoContent)
+}
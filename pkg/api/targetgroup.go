@@ -3,6 +3,7 @@ package api
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/common-fate/apikit/apio"
 
@@ -25,8 +26,24 @@ func (a *API) AdminListTargetGroups(w http.ResponseWriter, r *http.Request) {
 		apio.Error(ctx, w, err)
 		return
 	}
+	statuses := storage.ListTargetGroupSyncStatuses{}
+	err = a.DB.All(ctx, &statuses)
+	if err != nil && err != ddb.ErrNoItems {
+		apio.Error(ctx, w, err)
+		return
+	}
+	statusMap := make(map[string]target.SyncStatus)
+	for _, s := range statuses.Result {
+		statusMap[s.TargetGroupID] = s
+	}
+	now := time.Now()
 	for _, tg := range q.Result {
-		response.TargetGroups = append(response.TargetGroups, tg.ToAPI())
+		apiGroup := tg.ToAPI()
+		if s, ok := statusMap[tg.ID]; ok {
+			syncStatus := s.ToAPI(now)
+			apiGroup.SyncStatus = &syncStatus
+		}
+		response.TargetGroups = append(response.TargetGroups, apiGroup)
 	}
 	apio.JSON(ctx, w, response, http.StatusOK)
 }
@@ -79,7 +96,40 @@ func (a *API) AdminGetTargetGroup(w http.ResponseWriter, r *http.Request, id str
 		apio.Error(ctx, w, err)
 		return
 	}
-	apio.JSON(ctx, w, q.Result.ToAPI(), http.StatusOK)
+	res := q.Result.ToAPI()
+	statusQuery := storage.GetTargetGroupSyncStatus{TargetGroupID: id}
+	_, err = a.DB.Query(ctx, &statusQuery)
+	if err != nil && err != ddb.ErrNoItems {
+		apio.Error(ctx, w, err)
+		return
+	}
+	if err == nil {
+		syncStatus := statusQuery.Result.ToAPI(time.Now())
+		res.SyncStatus = &syncStatus
+	}
+	apio.JSON(ctx, w, res, http.StatusOK)
+}
+
+// Sync target group resources
+// (POST /api/v1/admin/target-groups/{id}/sync)
+func (a *API) AdminSyncTargetGroup(w http.ResponseWriter, r *http.Request, id string) {
+	ctx := r.Context()
+	q := storage.GetTargetGroup{ID: id}
+	_, err := a.DB.Query(ctx, &q)
+	if err == ddb.ErrNoItems {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusNotFound))
+		return
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	status, err := a.TargetService.SyncGroup(ctx, *q.Result)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, status.ToAPI(time.Now()), http.StatusOK)
 }
 
 // (POST /api/v1/target-groups/{id}/link)
@@ -8,7 +8,9 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/common-fate/common-fate/pkg/api/mocks"
 	"github.com/common-fate/common-fate/pkg/handler"
 	"github.com/common-fate/common-fate/pkg/service/targetsvc"
@@ -117,6 +119,7 @@ func TestListTargetGroup(t *testing.T) {
 		name string
 
 		targetgroups []target.Group
+		syncStatuses []target.SyncStatus
 		want         string
 		mockListErr  error
 		wantCode     int
@@ -151,6 +154,32 @@ func TestListTargetGroup(t *testing.T) {
 
 			want: `{"targetGroups":[{"createdAt":"0001-01-01T00:00:00Z","from":{"kind":"Kind","name":"test","publisher":"common-fate","version":"v1"},"icon":"test","id":"tg1","schema":{},"updatedAt":"0001-01-01T00:00:00Z"},{"createdAt":"0001-01-01T00:00:00Z","from":{"kind":"Kind","name":"second","publisher":"common-fate","version":"v2"},"icon":"test","id":"tg2","schema":{},"updatedAt":"0001-01-01T00:00:00Z"}]}`,
 		},
+		{
+			name:     "with failed sync status",
+			wantCode: http.StatusOK,
+			targetgroups: []target.Group{
+				{
+					ID: "tg1",
+					From: target.From{
+						Publisher: "common-fate",
+						Name:      "test",
+						Version:   "v1",
+						Kind:      "Kind",
+					},
+					Icon: "test",
+				},
+			},
+			syncStatuses: []target.SyncStatus{
+				{
+					TargetGroupID:  "tg1",
+					LastAttemptAt:  &time.Time{},
+					LastError:      aws.String("handler unavailable"),
+					ResourceCounts: map[string]int{},
+				},
+			},
+
+			want: `{"targetGroups":[{"createdAt":"0001-01-01T00:00:00Z","from":{"kind":"Kind","name":"test","publisher":"common-fate","version":"v1"},"icon":"test","id":"tg1","schema":{},"syncStatus":{"diagnostics":[{"code":"SYNC_FAILED","level":"ERROR","message":"the most recent resource sync failed: handler unavailable"},{"code":"RESOURCES_STALE","level":"WARNING","message":"resources for this target group have never been synced successfully"}],"lastAttemptAt":"0001-01-01T00:00:00Z","lastError":"handler unavailable","resourceCounts":{},"stale":true,"totalResources":0},"updatedAt":"0001-01-01T00:00:00Z"}]}`,
+		},
 		{
 			name:         "no target groups returns an empty list not an error",
 			mockListErr:  ddb.ErrNoItems,
@@ -174,6 +203,7 @@ func TestListTargetGroup(t *testing.T) {
 
 			db := ddbmock.New(t)
 			db.MockQueryWithErr(&storage.ListTargetGroups{Result: tc.targetgroups}, tc.mockListErr)
+			db.MockQuery(&storage.ListTargetGroupSyncStatuses{Result: tc.syncStatuses})
 
 			a := API{DB: db}
 			handler := newTestServer(t, &a)
@@ -204,6 +234,7 @@ func TestGetTargetGroup(t *testing.T) {
 		name                       string
 		mockGetTargetGroupResponse target.Group
 		mockGetTargetGroupErr      error
+		mockSyncStatus             *target.SyncStatus
 		want                       string
 		wantCode                   int
 	}
@@ -215,6 +246,13 @@ func TestGetTargetGroup(t *testing.T) {
 			mockGetTargetGroupResponse: target.Group{ID: "123"},
 			want:                       `{"createdAt":"0001-01-01T00:00:00Z","from":{"kind":"","name":"","publisher":"","version":""},"icon":"","id":"123","schema":{},"updatedAt":"0001-01-01T00:00:00Z"}`,
 		},
+		{
+			name:                       "with sync status",
+			wantCode:                   http.StatusOK,
+			mockGetTargetGroupResponse: target.Group{ID: "123"},
+			mockSyncStatus:             &target.SyncStatus{TargetGroupID: "123", LastAttemptAt: &time.Time{}, ResourceCounts: map[string]int{"Account": 2, "PermissionSet": 3}},
+			want:                       `{"createdAt":"0001-01-01T00:00:00Z","from":{"kind":"","name":"","publisher":"","version":""},"icon":"","id":"123","schema":{},"syncStatus":{"diagnostics":[],"lastAttemptAt":"0001-01-01T00:00:00Z","resourceCounts":{"Account":2,"PermissionSet":3},"stale":false,"totalResources":5},"updatedAt":"0001-01-01T00:00:00Z"}`,
+		},
 		{
 			name:                  "group not found",
 			wantCode:              http.StatusNotFound,
@@ -229,6 +267,11 @@ func TestGetTargetGroup(t *testing.T) {
 
 			db := ddbmock.New(t)
 			db.MockQueryWithErr(&storage.GetTargetGroup{Result: &tc.mockGetTargetGroupResponse}, tc.mockGetTargetGroupErr)
+			if tc.mockSyncStatus != nil {
+				db.MockQuery(&storage.GetTargetGroupSyncStatus{Result: tc.mockSyncStatus})
+			} else {
+				db.MockQueryWithErr(&storage.GetTargetGroupSyncStatus{}, ddb.ErrNoItems)
+			}
 
 			a := API{DB: db}
 			handler := newTestServer(t, &a)
@@ -545,3 +588,70 @@ func TestListTargetGroupRoutes(t *testing.T) {
 		})
 	}
 }
+
+func TestSyncTargetGroup(t *testing.T) {
+	type testcase struct {
+		name                  string
+		mockGetTargetGroupErr error
+		mockSyncStatus        *target.SyncStatus
+		mockSyncErr           error
+		want                  string
+		wantCode              int
+	}
+
+	testcases := []testcase{
+		{
+			name:           "ok",
+			wantCode:       http.StatusOK,
+			mockSyncStatus: &target.SyncStatus{TargetGroupID: "123", LastAttemptAt: &time.Time{}, LastSuccessAt: &time.Time{}, ResourceCounts: map[string]int{"Account": 1}},
+			want:           `{"diagnostics":[{"code":"RESOURCES_STALE","level":"WARNING","message":"resources for this target group were last synced successfully`,
+		},
+		{
+			name:                  "group not found",
+			wantCode:              http.StatusNotFound,
+			mockGetTargetGroupErr: ddb.ErrNoItems,
+			want:                  `{"error":"item query returned no items"}`,
+		},
+		{
+			name:        "internal error",
+			wantCode:    http.StatusInternalServerError,
+			mockSyncErr: errors.New("internal error"),
+			want:        `{"error":"Internal Server Error"}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			db := ddbmock.New(t)
+			db.MockQueryWithErr(&storage.GetTargetGroup{Result: &target.Group{ID: "123"}}, tc.mockGetTargetGroupErr)
+
+			m := mocks.NewMockTargetService(ctrl)
+			m.EXPECT().SyncGroup(gomock.Any(), gomock.Any()).Return(tc.mockSyncStatus, tc.mockSyncErr).AnyTimes()
+
+			a := API{DB: db, TargetService: m}
+			handler := newTestServer(t, &a)
+
+			req, err := http.NewRequest("POST", "/api/v1/admin/target-groups/123/sync", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Add("Content-Type", "application/json")
+
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+
+			data, err := io.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.True(t, strings.HasPrefix(string(data), tc.want), string(data))
+		})
+	}
+}
@@ -3,6 +3,7 @@ package api
 import (
 	"errors"
 	"net/http"
+	"sort"
 
 	"github.com/common-fate/analytics-go"
 	"github.com/common-fate/apikit/apio"
@@ -147,3 +148,60 @@ func (a *API) AdminUpdateUser(w http.ResponseWriter, r *http.Request, userId str
 	}
 	apio.JSON(ctx, w, user.ToAPI(), http.StatusOK)
 }
+
+// Lists the groups which a user is a member of, and the path of nested groups which gave the user each group.
+// (GET /api/v1/admin/users/{userId}/group-memberships)
+func (a *API) AdminListUserGroupMemberships(w http.ResponseWriter, r *http.Request, userId string) {
+	ctx := r.Context()
+
+	q := storage.GetUser{ID: userId}
+	_, err := a.DB.Query(ctx, &q)
+	if err == ddb.ErrNoItems {
+		err = apio.NewRequestError(err, http.StatusNotFound)
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	gq := storage.ListGroups{}
+	err = a.DB.All(ctx, &gq)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	names := make(map[string]string)
+	for _, g := range gq.Result {
+		names[g.ID] = g.Name
+	}
+	element := func(id string) types.GroupMembershipPathElement {
+		name, ok := names[id]
+		if !ok {
+			name = id
+		}
+		return types.GroupMembershipPathElement{Id: id, Name: name}
+	}
+
+	res := types.ListUserGroupMembershipsResponse{
+		Memberships: []types.UserGroupMembership{},
+	}
+	for _, gid := range q.Result.Groups {
+		path := q.Result.GroupPaths[gid]
+		if len(path) == 0 {
+			path = []string{gid}
+		}
+		m := types.UserGroupMembership{
+			GroupId:   gid,
+			GroupName: element(gid).Name,
+			Direct:    len(path) == 1,
+		}
+		for _, id := range path {
+			m.Path = append(m.Path, element(id))
+		}
+		res.Memberships = append(res.Memberships, m)
+	}
+	sort.Slice(res.Memberships, func(i, j int) bool {
+		return res.Memberships[i].GroupName < res.Memberships[j].GroupName
+	})
+	apio.JSON(ctx, w, res, http.StatusOK)
+}
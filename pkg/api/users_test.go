@@ -217,3 +217,65 @@ func TestPostApiV1AdminUsers(t *testing.T) {
 		})
 	}
 }
+
+func TestAdminListUserGroupMemberships(t *testing.T) {
+	type testcase struct {
+		name     string
+		user     *identity.User
+		userErr  error
+		groups   []identity.Group
+		wantCode int
+		wantBody string
+	}
+
+	testcases := []testcase{
+		{
+			name: "direct and nested memberships",
+			user: &identity.User{
+				ID:     "123",
+				Groups: []string{"team", "engineering", "everyone"},
+				GroupPaths: map[string][]string{
+					"everyone": {"team", "engineering", "everyone"},
+				},
+			},
+			groups: []identity.Group{
+				{ID: "team", Name: "Team"},
+				{ID: "engineering", Name: "Engineering"},
+				{ID: "everyone", Name: "Everyone"},
+			},
+			wantCode: http.StatusOK,
+			wantBody: `{"memberships":[{"direct":true,"groupId":"engineering","groupName":"Engineering","path":[{"id":"engineering","name":"Engineering"}]},{"direct":false,"groupId":"everyone","groupName":"Everyone","path":[{"id":"team","name":"Team"},{"id":"engineering","name":"Engineering"},{"id":"everyone","name":"Everyone"}]},{"direct":true,"groupId":"team","groupName":"Team","path":[{"id":"team","name":"Team"}]}]}`,
+		},
+		{
+			name:     "user not found",
+			userErr:  ddb.ErrNoItems,
+			wantCode: http.StatusNotFound,
+			wantBody: `{"error":"item query returned no items"}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQueryWithErr(&storage.GetUser{Result: tc.user}, tc.userErr)
+			db.MockQuery(&storage.ListGroups{Result: tc.groups})
+
+			a := API{DB: db}
+			handler := newTestServer(t, &a)
+
+			req, err := http.NewRequest("GET", "/api/v1/admin/users/123/group-memberships", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+			data, err := io.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tc.wantBody, strings.TrimSpace(string(data)))
+		})
+	}
+}
@@ -78,6 +78,8 @@ type Target struct {
 	AccessRules map[string]AccessRule `json:"accessRules" dynamodbav:"accessRules"`
 	// These are idp group ids that can access this target based on the access rules
 	IDPGroupsWithAccess map[string]struct{} `json:"idpGroupsWithAccess" dynamodbav:"idpGroupsWithAccess"`
+	// Metadata contains the admin curated tags which apply to this target
+	Metadata map[string]string `json:"metadata,omitempty" dynamodbav:"metadata,omitempty"`
 }
 
 func MakeMapStringStruct(elems ...string) map[string]struct{} {
@@ -116,6 +118,9 @@ func (t *Target) ToAPI() types.Target {
 	for _, f := range t.Fields {
 		tar.Fields = append(tar.Fields, f.ToAPI())
 	}
+	if len(t.Metadata) > 0 {
+		tar.Metadata = &types.Target_Metadata{AdditionalProperties: t.Metadata}
+	}
 
 	return tar
 }
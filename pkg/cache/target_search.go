@@ -0,0 +1,194 @@
+package cache
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+	"github.com/common-fate/ddb"
+)
+
+// TargetSearchEntry is an item in the entitlement target search index.
+// An entry is written for each IDP group which has access to a target, so the targets a user can access
+// can be loaded by querying the partitions for their groups rather than scanning all cached targets.
+type TargetSearchEntry struct {
+	IDPGroupID string `json:"idpGroupId" dynamodbav:"idpGroupId"`
+	Target     Target `json:"target" dynamodbav:"target"`
+	// SearchText is a normalised string of the target field values, labels and tags used for text matching
+	SearchText string `json:"searchText" dynamodbav:"searchText"`
+	// SortLabel is a normalised string of the target field labels used for sorting
+	SortLabel string `json:"sortLabel" dynamodbav:"sortLabel"`
+	// FieldValues are the normalised values and labels of the target fields used for field filters, see FieldFilterValue
+	FieldValues []string `json:"fieldValues,omitempty" dynamodbav:"fieldValues,stringset,omitempty"`
+}
+
+func (e *TargetSearchEntry) DDBKeys() (ddb.Keys, error) {
+	keys := ddb.Keys{
+		PK:     keys.EntitlementTargetSearch.PK1(e.IDPGroupID),
+		SK:     keys.EntitlementTargetSearch.SK1(e.Target.ID()),
+		GSI1PK: keys.EntitlementTargetSearch.GSI1PK(e.IDPGroupID),
+		GSI1SK: keys.EntitlementTargetSearch.GSI1SK(e.SortLabel, e.Target.ID()),
+	}
+
+	return keys, nil
+}
+
+// SortKey returns the key which entries are sorted by in the search index
+func (e *TargetSearchEntry) SortKey(sortBy TargetSortBy) string {
+	if sortBy == TargetSortByKind {
+		return keys.EntitlementTargetSearch.SK1(e.Target.ID())
+	}
+	return keys.EntitlementTargetSearch.GSI1SK(e.SortLabel, e.Target.ID())
+}
+
+// FieldFilterValue returns the normalised value which is stored in TargetSearchEntry.FieldValues for a field value or label
+func FieldFilterValue(fieldID string, value string) string {
+	return fieldID + ":" + strings.ToLower(value)
+}
+
+// NewTargetSearchEntries returns a search index entry for each IDP group with access to the target
+func NewTargetSearchEntries(target Target) []TargetSearchEntry {
+	// ID() sorts the fields, so the labels are always built in the same order
+	target.ID()
+	var text, labels, fieldValues []string
+	seen := map[string]bool{}
+	text = append(text, target.Kind.Publisher, target.Kind.Name, target.Kind.Kind)
+	for _, f := range target.Fields {
+		text = append(text, f.FieldTitle, f.Value, f.ValueLabel)
+		labels = append(labels, f.ValueLabel)
+		// DynamoDB string sets can't contain duplicates, and the label is often the same as the value
+		for _, v := range []string{FieldFilterValue(f.ID, f.Value), FieldFilterValue(f.ID, f.ValueLabel)} {
+			if !seen[v] {
+				seen[v] = true
+				fieldValues = append(fieldValues, v)
+			}
+		}
+	}
+	// tags are included so that targets can be found by their metadata, for example "team payments"
+	for k, v := range target.Metadata {
+		text = append(text, k, v)
+	}
+	searchText := strings.ToLower(strings.Join(text, " "))
+	sortLabel := strings.ToLower(strings.Join(labels, " "))
+
+	entries := make([]TargetSearchEntry, 0, len(target.IDPGroupsWithAccess))
+	for group := range target.IDPGroupsWithAccess {
+		entries = append(entries, TargetSearchEntry{
+			IDPGroupID:  group,
+			Target:      target,
+			SearchText:  searchText,
+			SortLabel:   sortLabel,
+			FieldValues: fieldValues,
+		})
+	}
+	return entries
+}
+
+type TargetSortBy string
+
+const (
+	TargetSortByLabel TargetSortBy = "label"
+	TargetSortByKind  TargetSortBy = "kind"
+)
+
+const DefaultTargetSearchPageSize = 50
+
+var ErrInvalidSearchCursor = errors.New("invalid search cursor")
+
+type TargetSearchOpts struct {
+	// Query is matched against the target field values, labels and tags.
+	// Each whitespace separated term in the query must be contained in them.
+	Query string
+	// FieldFilters are exact matches on the value or label of a target field, keyed by field ID
+	FieldFilters map[string]string
+	SortBy       TargetSortBy
+	Descending   bool
+	PageSize     int
+	// Cursor is the value returned as the next cursor from a previous search
+	Cursor string
+}
+
+// Terms returns the normalised terms of the query
+func (o TargetSearchOpts) Terms() []string {
+	return strings.Fields(strings.ToLower(o.Query))
+}
+
+// ListTargetSearchEntries lists a page of at most limit entries in the search index for an IDP group which match the search options,
+// in the sort order of the options and after the sort key after. The returned page token is empty if there are no more entries.
+type ListTargetSearchEntries func(ctx context.Context, idpGroupID string, after string, limit int, pageToken string) ([]TargetSearchEntry, string, error)
+
+// SearchTargets returns a page of distinct targets which the groups have access to, and a cursor for the next page.
+// The returned cursor is empty if there are no more results.
+//
+// The partition of each group is read in pages until it has more entries than the page size, so that
+// the page of targets is the same as if every partition was read.
+func SearchTargets(ctx context.Context, groups []string, opts TargetSearchOpts, list ListTargetSearchEntries) ([]Target, string, error) {
+	var after string
+	if opts.Cursor != "" {
+		b, err := base64.RawURLEncoding.DecodeString(opts.Cursor)
+		if err != nil {
+			return nil, "", ErrInvalidSearchCursor
+		}
+		after = string(b)
+	}
+	pageSize := opts.PageSize
+	if pageSize <= 0 {
+		pageSize = DefaultTargetSearchPageSize
+	}
+
+	type match struct {
+		target  Target
+		sortKey string
+	}
+
+	// a user may be in several groups with access to the same target, so the matches are deduplicated by target ID
+	matches := map[string]match{}
+	for _, group := range groups {
+		var found int
+		var pageToken string
+		for {
+			entries, next, err := list(ctx, group, after, pageSize+1, pageToken)
+			if err != nil {
+				return nil, "", err
+			}
+			for _, e := range entries {
+				sortKey := e.SortKey(opts.SortBy)
+				if after != "" && (!opts.Descending && sortKey <= after || opts.Descending && sortKey >= after) {
+					continue
+				}
+				found++
+				matches[e.Target.ID()] = match{target: e.Target, sortKey: sortKey}
+			}
+			if found > pageSize || next == "" {
+				break
+			}
+			pageToken = next
+		}
+	}
+
+	sorted := make([]match, 0, len(matches))
+	for _, m := range matches {
+		sorted = append(sorted, m)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if opts.Descending {
+			return sorted[i].sortKey > sorted[j].sortKey
+		}
+		return sorted[i].sortKey < sorted[j].sortKey
+	})
+
+	var next string
+	if len(sorted) > pageSize {
+		sorted = sorted[:pageSize]
+		next = base64.RawURLEncoding.EncodeToString([]byte(sorted[pageSize-1].sortKey))
+	}
+
+	out := make([]Target, 0, len(sorted))
+	for _, m := range sorted {
+		out = append(out, m.target)
+	}
+	return out, next, nil
+}
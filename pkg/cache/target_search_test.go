@@ -0,0 +1,93 @@
+package cache
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// listEntries returns a lister which pages through the sorted entries of each group like DynamoDB does.
+// The number of queries made is counted in queries.
+func listEntries(entries []TargetSearchEntry, opts TargetSearchOpts, queries *int) ListTargetSearchEntries {
+	return func(ctx context.Context, idpGroupID string, after string, limit int, pageToken string) ([]TargetSearchEntry, string, error) {
+		*queries++
+		var group []TargetSearchEntry
+		for _, e := range entries {
+			key := e.SortKey(opts.SortBy)
+			if e.IDPGroupID != idpGroupID || after != "" && (!opts.Descending && key <= after || opts.Descending && key >= after) {
+				continue
+			}
+			group = append(group, e)
+		}
+		sort.Slice(group, func(i, j int) bool {
+			if opts.Descending {
+				return group[i].SortKey(opts.SortBy) > group[j].SortKey(opts.SortBy)
+			}
+			return group[i].SortKey(opts.SortBy) < group[j].SortKey(opts.SortBy)
+		})
+		start, _ := strconv.Atoi(pageToken)
+		end := start + limit
+		if end >= len(group) {
+			return group[start:], "", nil
+		}
+		return group[start:end], strconv.Itoa(end), nil
+	}
+}
+
+func TestSearchTargetsPagination(t *testing.T) {
+	var entries []TargetSearchEntry
+	// the same target is indexed for two groups, it should only be returned once
+	for _, label := range []string{"c", "a", "b", "d"} {
+		entries = append(entries, NewTargetSearchEntries(Target{
+			Fields:              []Field{{ID: "account", Value: label, ValueLabel: label}},
+			IDPGroupsWithAccess: MakeMapStringStruct("group1", "group2"),
+		})...)
+	}
+	groups := []string{"group1", "group2"}
+
+	var got []string
+	var cursor string
+	var queries int
+	for i := 0; i < 10; i++ {
+		opts := TargetSearchOpts{PageSize: 3, Cursor: cursor}
+		targets, next, err := SearchTargets(context.Background(), groups, opts, listEntries(entries, opts, &queries))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, target := range targets {
+			got = append(got, target.Fields[0].ValueLabel)
+		}
+		if next == "" {
+			break
+		}
+		cursor = next
+	}
+	assert.Equal(t, []string{"a", "b", "c", "d"}, got)
+	// each page reads one query page per group
+	assert.Equal(t, 4, queries)
+
+	opts := TargetSearchOpts{Descending: true, PageSize: 1}
+	targets, _, err := SearchTargets(context.Background(), groups, opts, listEntries(entries, opts, &queries))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "d", targets[0].Fields[0].ValueLabel)
+
+	_, _, err = SearchTargets(context.Background(), groups, TargetSearchOpts{Cursor: "%%"}, listEntries(entries, opts, &queries))
+	assert.Equal(t, ErrInvalidSearchCursor, err)
+}
+
+func TestNewTargetSearchEntriesFieldValues(t *testing.T) {
+	entries := NewTargetSearchEntries(Target{
+		Fields: []Field{
+			{ID: "accountId", Value: "0123", ValueLabel: "Prod"},
+			{ID: "region", Value: "us-east-1", ValueLabel: "us-east-1"},
+		},
+		IDPGroupsWithAccess: MakeMapStringStruct("group1"),
+	})
+	// values are lowercased and not duplicated, as they are stored as a string set
+	assert.Equal(t, []string{"accountId:0123", "accountId:prod", "region:us-east-1"}, entries[0].FieldValues)
+}
@@ -16,8 +16,23 @@ type CacheSyncer struct {
 	Cache               cachesvc.Service
 }
 
+// Event is the input to the cache sync lambda.
+type Event struct {
+	// CheckStale runs the staleness check for target groups instead of a sync.
+	// It is set by a separate schedule so that staleness is still checked if syncs stop running.
+	CheckStale bool `json:"checkStale"`
+}
+
+// Handle runs a sync, or checks for stale target groups if the event requests it.
+func (s *CacheSyncer) Handle(ctx context.Context, event Event) error {
+	if event.CheckStale {
+		return s.Cache.CheckStaleTargetGroups(ctx)
+	}
+	return s.Sync(ctx)
+}
+
 // Sync will attempt to sync all argument options for all providers
-// if a particular argument fails to sync, the error is logged and it continues to try syncing the other arguments/providers
+// if a particular argument fails to sync, the error is logged and recorded on the sync status of the target group, and it continues to try syncing the other arguments/providers
 func (s *CacheSyncer) Sync(ctx context.Context) error {
 	log := logger.Get(ctx)
 	q := storage.ListTargetGroups{}
@@ -27,7 +42,7 @@ func (s *CacheSyncer) Sync(ctx context.Context) error {
 	}
 	for _, tg := range q.Result {
 		log.Infow("started syncing target group resources cache", "targetgroup", tg)
-		err = s.Cache.RefreshCachedTargetGroupResources(ctx, tg)
+		_, err = s.Cache.SyncTargetGroup(ctx, tg)
 		if err != nil {
 			log.Errorw("failed to refresh resources for targetgroup", "targetgroup", tg, "error", err)
 			continue
@@ -1,5 +1,7 @@
 package config
 
+import "time"
+
 type Config struct {
 	Host              string `env:"COMMONFATE_HOST,default=0.0.0.0:8080"`
 	LogLevel          string `env:"LOG_LEVEL,default=info"`
@@ -25,6 +27,33 @@ type Config struct {
 	// a regex string that is used to filter the identity groups that are returned from the IDP
 	IdentityGroupFilter string `env:"COMMONFATE_IDENTITY_GROUP_FILTER"`
 	NoAuthEmail         string `env:"NO_AUTH_EMAIL"`
+	// the maximum time since a target group's resources were last synced before they are considered stale
+	CacheStaleThreshold time.Duration `env:"COMMONFATE_CACHE_STALE_THRESHOLD,default=1h"`
+	// how long Idempotency-Key headers are remembered for
+	IdempotencyKeyTTL time.Duration `env:"COMMONFATE_IDEMPOTENCY_KEY_TTL,default=24h"`
+	// if provided, provider schemas are fetched from this registry rather than the public provider registry
+	ProviderRegistryAPIURL string `env:"COMMONFATE_PROVIDER_REGISTRY_API_URL"`
+	// if provided, the local event handler sends events through NATS JetStream rather than the DynamoDB queue
+	NATSURL string `env:"COMMONFATE_NATS_URL"`
+	// the bearer token for the SCIM API, which may be an awsssm:// reference. The SCIM API is disabled if this is empty.
+	SCIMToken string `env:"COMMONFATE_SCIM_TOKEN"`
+	// the percentage of users or groups which an identity sync can archive before it is aborted, 0 disables the check
+	IdentitySyncArchiveThreshold int `env:"COMMONFATE_IDENTITY_SYNC_ARCHIVE_THRESHOLD,default=50"`
+	// if true, users are made members of the groups which their groups are nested in
+	IdentityResolveNestedGroups bool `env:"COMMONFATE_IDENTITY_RESOLVE_NESTED_GROUPS,default=false"`
+	// enforce cleans up the access of users who are archived by identity sync, report only reports it
+	IdentityDeprovisioningMode string `env:"COMMONFATE_IDENTITY_DEPROVISIONING_MODE,default=report"`
+	GrantRetry                 GrantRetryConfig
+}
+
+// GrantRetryConfig configures how failed grant activations and deactivations are retried.
+type GrantRetryConfig struct {
+	// the total number of attempts, including the first one
+	MaxAttempts    int           `env:"COMMONFATE_GRANT_RETRY_MAX_ATTEMPTS,default=3"`
+	InitialBackoff time.Duration `env:"COMMONFATE_GRANT_RETRY_INITIAL_BACKOFF,default=2s"`
+	MaxBackoff     time.Duration `env:"COMMONFATE_GRANT_RETRY_MAX_BACKOFF,default=30s"`
+	// the error classes which are retried, one of throttling, timeout, network, unavailable or unknown
+	ErrorClasses []string `env:"COMMONFATE_GRANT_RETRY_ERROR_CLASSES,default=throttling,timeout,network,unavailable"`
 }
 
 type NotificationsConfig struct {
@@ -43,6 +72,7 @@ type EventHandlerConfig struct {
 	DynamoTable     string `env:"COMMONFATE_TABLE_NAME,required"`
 	EventBusArn     string `env:"COMMONFATE_EVENT_BUS_ARN,required"`
 	StateMachineARN string `env:"COMMONFATE_GRANTER_V2_STATE_MACHINE_ARN"`
+	GrantRetry      GrantRetryConfig
 }
 
 type SyncConfig struct {
@@ -54,12 +84,23 @@ type SyncConfig struct {
 	// Use deploy.UnmarshalFeatureMap to unmarshal this data into a FeatureMap
 	IdentitySettings    string `env:"COMMONFATE_IDENTITY_SETTINGS,default={}"`
 	IdentityGroupFilter string `env:"COMMONFATE_IDENTITY_GROUP_FILTER"`
+	// the percentage of users or groups which a sync can archive before it is aborted, 0 disables the check
+	ArchiveThreshold int `env:"COMMONFATE_IDENTITY_SYNC_ARCHIVE_THRESHOLD,default=50"`
+	// if true, users are made members of the groups which their groups are nested in
+	ResolveNestedGroups bool `env:"COMMONFATE_IDENTITY_RESOLVE_NESTED_GROUPS,default=false"`
+	// enforce cleans up the access of users who are archived, report only reports it
+	DeprovisioningMode string `env:"COMMONFATE_IDENTITY_DEPROVISIONING_MODE,default=report"`
+	// EventBusArn is optional, if it is set a notification is sent when a sync is aborted
+	EventBusArn string `env:"COMMONFATE_EVENT_BUS_ARN"`
 }
 type CacheSyncConfig struct {
 	TableName        string `env:"COMMONFATE_TABLE_NAME,required"`
 	LogLevel         string `env:"LOG_LEVEL,default=info"`
 	Region           string `env:"AWS_REGION,required"`
 	AccessHandlerURL string `env:"COMMONFATE_ACCESS_HANDLER_URL,default=http://0.0.0.0:9092"`
+	// EventBusArn is optional, if it is set a notification is sent when a target group's resources become stale
+	EventBusArn    string        `env:"COMMONFATE_EVENT_BUS_ARN"`
+	StaleThreshold time.Duration `env:"COMMONFATE_CACHE_STALE_THRESHOLD,default=1h"`
 }
 type HealthCheckerConfig struct {
 	TableName string `env:"COMMONFATE_TABLE_NAME,required"`
@@ -67,6 +108,20 @@ type HealthCheckerConfig struct {
 	Region    string `env:"AWS_REGION,required"`
 }
 
+type DriftReconcilerConfig struct {
+	TableName   string `env:"COMMONFATE_TABLE_NAME,required"`
+	LogLevel    string `env:"LOG_LEVEL,default=info"`
+	EventBusArn string `env:"COMMONFATE_EVENT_BUS_ARN"`
+	// how long after a grant ends that it continues to be checked for access which wasn't removed
+	Lookback time.Duration `env:"COMMONFATE_DRIFT_LOOKBACK,default=24h"`
+}
+
+type ActivationExpirerConfig struct {
+	TableName   string `env:"COMMONFATE_TABLE_NAME,required"`
+	LogLevel    string `env:"LOG_LEVEL,default=info"`
+	EventBusArn string `env:"COMMONFATE_EVENT_BUS_ARN,required"`
+}
+
 type FrontendDeployerConfig struct {
 	LogLevel                             string `env:"LOG_LEVEL,default=info"`
 	Region                               string `env:"AWS_REGION,required"`
@@ -90,8 +145,18 @@ type ProviderDeploymentCLI struct {
 	CommonFateAPIURL string `env:"COMMONFATE_HOST,default=http://0.0.0.0:8080"`
 }
 
+type RegistryMirrorConfig struct {
+	Host     string `env:"COMMONFATE_REGISTRY_MIRROR_HOST,default=0.0.0.0:8090"`
+	LogLevel string `env:"LOG_LEVEL,default=info"`
+	// the mirror is served from Dir if set, otherwise from Bucket
+	Dir    string `env:"COMMONFATE_REGISTRY_MIRROR_DIR"`
+	Bucket string `env:"COMMONFATE_REGISTRY_MIRROR_BUCKET"`
+	Prefix string `env:"COMMONFATE_REGISTRY_MIRROR_PREFIX"`
+}
+
 type TargetGroupGranterConfig struct {
 	LogLevel    string `env:"LOG_LEVEL,default=info"`
 	EventBusArn string `env:"COMMONFATE_EVENT_BUS_ARN"`
 	DynamoTable string `env:"COMMONFATE_TABLE_NAME,required"`
+	GrantRetry  GrantRetryConfig
 }
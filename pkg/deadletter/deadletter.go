@@ -0,0 +1,86 @@
+// Package deadletter records events which subscribers fail to handle,
+// so that they can be inspected, replayed or discarded by an admin rather than being lost.
+package deadletter
+
+import (
+	"context"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+)
+
+// Recorder stores events which a subscriber failed to handle.
+type Recorder struct {
+	DB    ddb.Storage
+	Clock clock.Clock
+}
+
+// Wrap returns a handler which records the events that h fails to handle.
+//
+// The error is still returned, so that the transport retries the event. Each failed attempt
+// updates the same record. When an event is handled its record is removed with a conditional delete,
+// as the attempts which failed may have been made by a different process.
+// Replayed events which were sent for a different subscriber are ignored.
+func (r *Recorder) Wrap(subscriber string, h gevent.Handler) gevent.Handler {
+	return func(ctx context.Context, event events.CloudWatchEvent) error {
+		if to := gevent.ReplaySubscriber(event); to != "" && to != subscriber {
+			return nil
+		}
+		if event.ID == "" {
+			event.ID = types.NewEventID()
+		}
+		id := access.DeadLetterEventID(subscriber, event.ID)
+		// a replay which fails again updates the record it was replayed from
+		replayOf := gevent.ReplayDeadLetterID(event)
+		if replayOf != "" {
+			id = replayOf
+		}
+
+		handlerErr := h(ctx, event)
+		if handlerErr == nil {
+			_, err := storage.DeleteIfExists(ctx, r.DB, &access.DeadLetterEvent{ID: id})
+			return err
+		}
+
+		err := r.record(ctx, subscriber, id, event, handlerErr)
+		if err != nil {
+			// don't hide the handler error, the transport will retry the event
+			logger.Get(ctx).Errorw("failed to record dead letter event", "subscriber", subscriber, "eventId", event.ID, "error", err)
+		}
+		return handlerErr
+	}
+}
+
+func (r *Recorder) record(ctx context.Context, subscriber, id string, event events.CloudWatchEvent, handlerErr error) error {
+	now := r.Clock.Now()
+	q := storage.GetDeadLetterEvent{ID: id}
+	_, err := r.DB.Query(ctx, &q)
+	if err != nil && err != ddb.ErrNoItems {
+		return err
+	}
+	dle := q.Result
+	if dle == nil {
+		dle = &access.DeadLetterEvent{
+			ID:            id,
+			Subscriber:    subscriber,
+			EventID:       event.ID,
+			DetailType:    event.DetailType,
+			Detail:        string(event.Detail),
+			FirstFailedAt: now,
+		}
+		// the ordering key is used to find the events for a request when replaying them
+		if e, err := gevent.Parse(event.DetailType, event.Detail); err == nil {
+			dle.OrderingKey = gevent.OrderingKey(e)
+		}
+	}
+	dle.Attempts++
+	dle.Error = handlerErr.Error()
+	dle.LastFailedAt = now
+	return r.DB.Put(ctx, dle)
+}
@@ -0,0 +1,280 @@
+package deadletter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockClient is embedded with a different name, as the Client field would hide the Client method of ddb.Storage
+type mockClient = ddbmock.Client
+
+// testDB records the items written by the recorder. Conditional deletes are sent to a fake DynamoDB endpoint,
+// which removes the dead letter events that have been put.
+type testDB struct {
+	*mockClient
+	client *dynamodb.Client
+
+	mu      sync.Mutex
+	puts    []ddb.Keyer
+	stored  map[string]bool
+	deletes []string
+}
+
+func newTestDB(t *testing.T) *testDB {
+	db := &testDB{mockClient: ddbmock.New(t), stored: map[string]bool{}}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var in struct {
+			Key struct {
+				SK struct{ S string }
+			}
+		}
+		_ = json.NewDecoder(r.Body).Decode(&in)
+		id := strings.TrimSuffix(in.Key.SK.S, "#")
+
+		db.mu.Lock()
+		defer db.mu.Unlock()
+		w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+		if !db.stored[id] {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"__type":"com.amazonaws.dynamodb.v20120810#ConditionalCheckFailedException","message":"The conditional request failed"}`))
+			return
+		}
+		delete(db.stored, id)
+		db.deletes = append(db.deletes, id)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(srv.Close)
+	db.client = dynamodb.New(dynamodb.Options{
+		Region:           "us-east-1",
+		Credentials:      aws.AnonymousCredentials{},
+		EndpointResolver: dynamodb.EndpointResolverFromURL(srv.URL),
+		Retryer:          aws.NopRetryer{},
+	})
+	return db
+}
+
+func (d *testDB) Client() *dynamodb.Client {
+	return d.client
+}
+
+func (d *testDB) Put(ctx context.Context, item ddb.Keyer) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.puts = append(d.puts, item)
+	d.stored[item.(*access.DeadLetterEvent).ID] = true
+	return nil
+}
+
+func TestWrap(t *testing.T) {
+	clk := clock.NewMock()
+	firstFailed := clk.Now().Add(-time.Minute)
+	evt := events.CloudWatchEvent{
+		ID:         "1",
+		DetailType: gevent.GrantRetryRequestedType,
+		Detail:     []byte(`{"grantId":"gra_1","detailType":"grant.retryRequested"}`),
+	}
+	handlerErr := errors.New("provider unavailable")
+
+	type testcase struct {
+		name        string
+		give        events.CloudWatchEvent
+		existing    *access.DeadLetterEvent
+		handlerErr  error
+		wantHandled bool
+		wantErr     error
+		wantPut     *access.DeadLetterEvent
+		// wantDelete is the ID of the stored dead letter event which is deleted
+		wantDelete string
+	}
+
+	testcases := []testcase{
+		{
+			name:        "handled",
+			give:        evt,
+			wantHandled: true,
+		},
+		{
+			name:        "first failure",
+			give:        evt,
+			handlerErr:  handlerErr,
+			wantHandled: true,
+			wantErr:     handlerErr,
+			wantPut: &access.DeadLetterEvent{
+				ID:            "eventHandler_1",
+				Subscriber:    gevent.EventHandlerSubscriber,
+				EventID:       "1",
+				OrderingKey:   "grant#gra_1",
+				DetailType:    gevent.GrantRetryRequestedType,
+				Detail:        string(evt.Detail),
+				Error:         "provider unavailable",
+				Attempts:      1,
+				FirstFailedAt: clk.Now(),
+				LastFailedAt:  clk.Now(),
+			},
+		},
+		{
+			name: "retry failed again",
+			give: evt,
+			existing: &access.DeadLetterEvent{
+				ID:            "eventHandler_1",
+				Subscriber:    gevent.EventHandlerSubscriber,
+				EventID:       "1",
+				DetailType:    gevent.GrantRetryRequestedType,
+				Detail:        string(evt.Detail),
+				Error:         "timeout",
+				Attempts:      1,
+				FirstFailedAt: firstFailed,
+				LastFailedAt:  firstFailed,
+			},
+			handlerErr:  handlerErr,
+			wantHandled: true,
+			wantErr:     handlerErr,
+			wantPut: &access.DeadLetterEvent{
+				ID:            "eventHandler_1",
+				Subscriber:    gevent.EventHandlerSubscriber,
+				EventID:       "1",
+				DetailType:    gevent.GrantRetryRequestedType,
+				Detail:        string(evt.Detail),
+				Error:         "provider unavailable",
+				Attempts:      2,
+				FirstFailedAt: firstFailed,
+				LastFailedAt:  clk.Now(),
+			},
+		},
+		{
+			name: "replay for another subscriber",
+			give: events.CloudWatchEvent{
+				ID:         "2",
+				DetailType: gevent.GrantRetryRequestedType,
+				Detail:     []byte(`{"grantId":"gra_1","replaySubscriber":"slackNotifier"}`),
+			},
+		},
+		{
+			name: "replay for this subscriber",
+			give: events.CloudWatchEvent{
+				ID:         "2",
+				DetailType: gevent.GrantRetryRequestedType,
+				Detail:     []byte(`{"grantId":"gra_1","replaySubscriber":"eventHandler","replayDeadLetterId":"eventHandler_1"}`),
+			},
+			existing:    &access.DeadLetterEvent{ID: "eventHandler_1"},
+			wantHandled: true,
+			wantDelete:  "eventHandler_1",
+		},
+		{
+			name: "replay failed again",
+			give: events.CloudWatchEvent{
+				ID:         "2",
+				DetailType: gevent.GrantRetryRequestedType,
+				Detail:     []byte(`{"grantId":"gra_1","replaySubscriber":"eventHandler","replayDeadLetterId":"eventHandler_1"}`),
+			},
+			existing: &access.DeadLetterEvent{
+				ID:            "eventHandler_1",
+				Subscriber:    gevent.EventHandlerSubscriber,
+				EventID:       "1",
+				DetailType:    gevent.GrantRetryRequestedType,
+				Detail:        string(evt.Detail),
+				Error:         "timeout",
+				Attempts:      1,
+				FirstFailedAt: firstFailed,
+				LastFailedAt:  firstFailed,
+			},
+			handlerErr:  handlerErr,
+			wantHandled: true,
+			wantErr:     handlerErr,
+			wantPut: &access.DeadLetterEvent{
+				ID:            "eventHandler_1",
+				Subscriber:    gevent.EventHandlerSubscriber,
+				EventID:       "1",
+				DetailType:    gevent.GrantRetryRequestedType,
+				Detail:        string(evt.Detail),
+				Error:         "provider unavailable",
+				Attempts:      2,
+				FirstFailedAt: firstFailed,
+				LastFailedAt:  clk.Now(),
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := newTestDB(t)
+			if tc.existing != nil {
+				db.stored[tc.existing.ID] = true
+				db.MockQuery(&storage.GetDeadLetterEvent{Result: tc.existing})
+			} else {
+				db.MockQueryWithErr(&storage.GetDeadLetterEvent{}, ddb.ErrNoItems)
+			}
+			r := Recorder{DB: db, Clock: clk}
+			var handled bool
+			h := r.Wrap(gevent.EventHandlerSubscriber, func(ctx context.Context, event events.CloudWatchEvent) error {
+				handled = true
+				return tc.handlerErr
+			})
+
+			err := h(context.Background(), tc.give)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantHandled, handled)
+			if tc.wantPut != nil {
+				assert.Equal(t, []ddb.Keyer{tc.wantPut}, db.puts)
+			} else {
+				assert.Empty(t, db.puts)
+			}
+			if tc.wantDelete != "" {
+				assert.Equal(t, []string{tc.wantDelete}, db.deletes)
+			} else {
+				assert.Empty(t, db.deletes)
+			}
+		})
+	}
+}
+
+func TestWrapRetrySucceeded(t *testing.T) {
+	db := newTestDB(t)
+	db.MockQueryWithErr(&storage.GetDeadLetterEvent{}, ddb.ErrNoItems)
+	handlerErr := errors.New("provider unavailable")
+	handler := func(ctx context.Context, event events.CloudWatchEvent) error {
+		return handlerErr
+	}
+	evt := events.CloudWatchEvent{
+		ID:         "1",
+		DetailType: gevent.GrantRetryRequestedType,
+		Detail:     []byte(`{"grantId":"gra_1"}`),
+	}
+
+	failed := &Recorder{DB: db, Clock: clock.NewMock()}
+	err := failed.Wrap(gevent.EventHandlerSubscriber, handler)(context.Background(), evt)
+	assert.Equal(t, handlerErr, err)
+	assert.Len(t, db.puts, 1)
+
+	// the transport retries the event in a different process and it is handled, so the record is removed
+	handlerErr = nil
+	retried := &Recorder{DB: db, Clock: clock.NewMock()}
+	h := retried.Wrap(gevent.EventHandlerSubscriber, handler)
+	err = h(context.Background(), evt)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"eventHandler_1"}, db.deletes)
+	assert.Empty(t, db.stored)
+
+	// later deliveries of the event find no record to delete
+	err = h(context.Background(), evt)
+	assert.NoError(t, err)
+	assert.Len(t, db.deletes, 1)
+}
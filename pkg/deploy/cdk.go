@@ -56,6 +56,21 @@ func (c Config) CDKContextArgs() []string {
 	if c.Deployment.Parameters.IdentityGroupFilter != "" {
 		args = append(args, "-c", fmt.Sprintf("identityGroupFilter=%s", string(c.Deployment.Parameters.IdentityGroupFilter)))
 	}
+	if c.Deployment.Parameters.ProviderRegistryAPIURL != "" {
+		args = append(args, "-c", fmt.Sprintf("providerRegistryApiUrl=%s", string(c.Deployment.Parameters.ProviderRegistryAPIURL)))
+	}
+	if c.Deployment.Parameters.SCIMToken != "" {
+		args = append(args, "-c", fmt.Sprintf("scimToken=%s", string(c.Deployment.Parameters.SCIMToken)))
+	}
+	if c.Deployment.Parameters.IdentitySyncArchiveThreshold != "" {
+		args = append(args, "-c", fmt.Sprintf("identitySyncArchiveThreshold=%s", string(c.Deployment.Parameters.IdentitySyncArchiveThreshold)))
+	}
+	if c.Deployment.Parameters.IdentityResolveNestedGroups != "" {
+		args = append(args, "-c", fmt.Sprintf("identityResolveNestedGroups=%s", string(c.Deployment.Parameters.IdentityResolveNestedGroups)))
+	}
+	if c.Deployment.Parameters.IdentityDeprovisioningMode != "" {
+		args = append(args, "-c", fmt.Sprintf("identityDeprovisioningMode=%s", string(c.Deployment.Parameters.IdentityDeprovisioningMode)))
+	}
 	if c.Deployment.Parameters.CloudfrontWAFACLARN != "" {
 		args = append(args, "-c", fmt.Sprintf("cloudfrontWafAclArn=%s", string(c.Deployment.Parameters.CloudfrontWAFACLARN)))
 	}
@@ -132,6 +132,21 @@ type Parameters struct {
 	IDPSyncTimeoutSeconds           string         `yaml:"IDPSyncTimeoutSeconds,omitempty"`
 	IDPSyncSchedule                 string         `yaml:"IDPSyncSchedule,omitempty"`
 	IDPSyncMemory                   string         `yaml:"IDPSyncMemory,omitempty"`
+	// ProviderRegistryAPIURL points Common Fate at a self-hosted provider registry mirror.
+	// If not provided, the public provider registry is used.
+	ProviderRegistryAPIURL string `yaml:"ProviderRegistryAPIURL,omitempty"`
+	// SCIMToken is the bearer token for the SCIM API, usually an awsssm:// reference.
+	// If not provided, the SCIM API is disabled.
+	SCIMToken string `yaml:"SCIMToken,omitempty"`
+	// IdentitySyncArchiveThreshold is the percentage of users or groups which an identity sync can archive
+	// before it is aborted. If not provided, the threshold is 50%. Set to 0 to disable the check.
+	IdentitySyncArchiveThreshold string `yaml:"IdentitySyncArchiveThreshold,omitempty"`
+	// IdentityResolveNestedGroups is "true" if users should be made members of the groups which their groups are nested in.
+	// Nested groups are not resolved if this is not provided.
+	IdentityResolveNestedGroups string `yaml:"IdentityResolveNestedGroups,omitempty"`
+	// IdentityDeprovisioningMode is "enforce" if the access of users who are archived by identity sync should be cleaned up,
+	// or "report" if it should only be reported. If not provided, it is only reported.
+	IdentityDeprovisioningMode string `yaml:"IdentityDeprovisioningMode,omitempty"`
 }
 
 // UnmarshalFeatureMap parses the JSON configuration data and returns
@@ -450,6 +465,36 @@ func (c *Config) CfnParams() ([]types.Parameter, error) {
 			ParameterValue: &p.IDPSyncTimeoutSeconds,
 		})
 	}
+	if c.Deployment.Parameters.ProviderRegistryAPIURL != "" {
+		res = append(res, types.Parameter{
+			ParameterKey:   aws.String("ProviderRegistryAPIURL"),
+			ParameterValue: &p.ProviderRegistryAPIURL,
+		})
+	}
+	if c.Deployment.Parameters.SCIMToken != "" {
+		res = append(res, types.Parameter{
+			ParameterKey:   aws.String("SCIMToken"),
+			ParameterValue: &p.SCIMToken,
+		})
+	}
+	if c.Deployment.Parameters.IdentitySyncArchiveThreshold != "" {
+		res = append(res, types.Parameter{
+			ParameterKey:   aws.String("IdentitySyncArchiveThreshold"),
+			ParameterValue: &p.IdentitySyncArchiveThreshold,
+		})
+	}
+	if c.Deployment.Parameters.IdentityResolveNestedGroups != "" {
+		res = append(res, types.Parameter{
+			ParameterKey:   aws.String("IdentityResolveNestedGroups"),
+			ParameterValue: &p.IdentityResolveNestedGroups,
+		})
+	}
+	if c.Deployment.Parameters.IdentityDeprovisioningMode != "" {
+		res = append(res, types.Parameter{
+			ParameterKey:   aws.String("IdentityDeprovisioningMode"),
+			ParameterValue: &p.IdentityDeprovisioningMode,
+		})
+	}
 
 	return res, nil
 }
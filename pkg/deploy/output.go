@@ -18,6 +18,8 @@ import (
 
 // Output is the output from deploying the CDK stack to AWS.
 type Output struct {
+	ActivationExpirerFunctionName       string `json:"ActivationExpirerFunctionName"`
+	ActivationExpirerLogGroupName       string `json:"ActivationExpirerLogGroupName"`
 	APILogGroupName                     string `json:"APILogGroupName"`
 	APIURL                              string `json:"APIURL"`
 	CacheSyncFunctionName               string `json:"CacheSyncFunctionName"`
@@ -26,6 +28,8 @@ type Output struct {
 	CloudFrontDistributionID            string `json:"CloudFrontDistributionID"`
 	CloudFrontDomain                    string `json:"CloudFrontDomain"`
 	CognitoClientID                     string `json:"CognitoClientID"`
+	DriftReconcilerFunctionName         string `json:"DriftReconcilerFunctionName"`
+	DriftReconcilerLogGroupName         string `json:"DriftReconcilerLogGroupName"`
 	DynamoDBTable                       string `json:"DynamoDBTable"`
 	EventBusArn                         string `json:"EventBusArn"`
 	EventBusLogGroupName                string `json:"EventBusLogGroupName"`
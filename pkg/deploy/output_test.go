@@ -71,6 +71,10 @@ func TestOutputStructMatchesTSType(t *testing.T) {
 		HealthcheckFunctionName:             "abcdefg",
 		HealthcheckLogGroupName:             "abcdefg",
 		GranterV2StateMachineArn:            "abcdefg",
+		DriftReconcilerFunctionName:         "abcdefg",
+		DriftReconcilerLogGroupName:         "abcdefg",
+		ActivationExpirerFunctionName:       "abcdefg",
+		ActivationExpirerLogGroupName:       "abcdefg",
 	}
 	b, err := json.Marshal(output)
 	if err != nil {
@@ -129,6 +133,8 @@ func TestOutput_Get(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			o := Output{
+				ActivationExpirerFunctionName:       tt.fields.ActivationExpirerFunctionName,
+				ActivationExpirerLogGroupName:       tt.fields.ActivationExpirerLogGroupName,
 				APILogGroupName:                     tt.fields.APILogGroupName,
 				APIURL:                              tt.fields.APIURL,
 				CacheSyncFunctionName:               tt.fields.CacheSyncFunctionName,
@@ -137,6 +143,8 @@ func TestOutput_Get(t *testing.T) {
 				CloudFrontDistributionID:            tt.fields.CloudFrontDistributionID,
 				CloudFrontDomain:                    tt.fields.CloudFrontDomain,
 				CognitoClientID:                     tt.fields.CognitoClientID,
+				DriftReconcilerFunctionName:         tt.fields.DriftReconcilerFunctionName,
+				DriftReconcilerLogGroupName:         tt.fields.DriftReconcilerLogGroupName,
 				DynamoDBTable:                       tt.fields.DynamoDBTable,
 				EventBusArn:                         tt.fields.EventBusArn,
 				EventBusLogGroupName:                tt.fields.EventBusLogGroupName,
@@ -0,0 +1,25 @@
+package eventhandler
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"go.uber.org/zap"
+)
+
+// HandleBulkRevokeJobEvent processes bulk revoke jobs in the background
+func (n *EventHandler) HandleBulkRevokeJobEvent(ctx context.Context, log *zap.SugaredLogger, event events.CloudWatchEvent) error {
+	switch event.DetailType {
+	case gevent.BulkRevokeJobCreatedType:
+		var jobEvent gevent.BulkRevokeJobCreated
+		err := json.Unmarshal(event.Detail, &jobEvent)
+		if err != nil {
+			return err
+		}
+		log.Infow("running bulk revoke job", "jobId", jobEvent.JobID)
+		return n.BulkRevoker.Run(ctx, jobEvent.JobID)
+	}
+	return nil
+}
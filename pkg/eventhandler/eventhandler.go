@@ -2,17 +2,23 @@ package eventhandler
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-lambda-go/events"
 	"github.com/benbjohnson/clock"
 	"github.com/common-fate/common-fate/pkg/access"
 	"github.com/common-fate/common-fate/pkg/config"
+	"github.com/common-fate/common-fate/pkg/deadletter"
 	"github.com/common-fate/common-fate/pkg/deploy"
+	"github.com/common-fate/common-fate/pkg/eventqueue"
 	"github.com/common-fate/common-fate/pkg/gevent"
 	"github.com/common-fate/common-fate/pkg/handler"
 	slacknotifier "github.com/common-fate/common-fate/pkg/notifiers/slack"
+	"github.com/common-fate/common-fate/pkg/service/accesssvc"
+	"github.com/common-fate/common-fate/pkg/service/bulkrevokesvc"
+	"github.com/common-fate/common-fate/pkg/service/freezesvc"
 	"github.com/common-fate/common-fate/pkg/service/requestroutersvc"
 	"github.com/common-fate/common-fate/pkg/service/workflowsvc"
 	"github.com/common-fate/common-fate/pkg/service/workflowsvc/runtimes/local"
@@ -41,19 +47,30 @@ type EventPutter interface {
 //go:generate go run github.com/golang/mock/mockgen -destination=mocks/mock_workflow_service.go -package=mocks . Workflow
 type Workflow interface {
 	Revoke(ctx context.Context, requestID string, groupID string, revokerID string, revokerEmail string) error
+	RevokeTarget(ctx context.Context, requestID string, groupID string, targetID string, revokerID string, revokerEmail string) error
 	Grant(ctx context.Context, requestID string, groupID string) ([]access.GroupTarget, error)
+	Retry(ctx context.Context, grantID string) error
+}
+
+//go:generate go run github.com/golang/mock/mockgen -destination=mocks/mock_bulk_revoker.go -package=mocks . BulkRevoker
+type BulkRevoker interface {
+	Run(ctx context.Context, jobID string) error
 }
 
 // EventHandler provides handler methods for reacting to async actions during the granting process
 type EventHandler struct {
 	DB            ddb.Storage
 	Workflow      Workflow
+	BulkRevoker   BulkRevoker
 	Eventbus      EventPutter
-	eventQueue    chan gevent.EventTyper
 	SlackNotifier slacknotifier.SlackNotifier
+	// transport delivers events to the event handler and the Slack notifier in local development
+	transport gevent.Transport
 }
 type LocalDevEventHandlerOpts struct {
 	UseMockWorkflowRuntime bool
+	// Transport defaults to a queue stored in DynamoDB, so that events survive a restart
+	Transport gevent.Transport
 }
 type LocalDevEventHandlerOptsFunc func(*LocalDevEventHandlerOpts)
 
@@ -62,6 +79,13 @@ func WithUseMockWorkflowRuntime(use bool) LocalDevEventHandlerOptsFunc {
 		ldeho.UseMockWorkflowRuntime = use
 	}
 }
+
+// WithTransport sets the transport which events are sent and received with.
+func WithTransport(t gevent.Transport) LocalDevEventHandlerOptsFunc {
+	return func(ldeho *LocalDevEventHandlerOpts) {
+		ldeho.Transport = t
+	}
+}
 func NewLocalDevEventHandler(ctx context.Context, db ddb.Storage, clk clock.Clock, opts ...LocalDevEventHandlerOptsFunc) *EventHandler {
 	cfg := &LocalDevEventHandlerOpts{
 		UseMockWorkflowRuntime: true,
@@ -69,9 +93,12 @@ func NewLocalDevEventHandler(ctx context.Context, db ddb.Storage, clk clock.Cloc
 	for _, opt := range opts {
 		opt(cfg)
 	}
+	if cfg.Transport == nil {
+		cfg.Transport = eventqueue.New(db, clk, gevent.EventHandlerSubscriber, gevent.SlackNotifierSubscriber)
+	}
 	eh := &EventHandler{
-		DB:         db,
-		eventQueue: make(chan gevent.EventTyper, 100),
+		DB:        db,
+		transport: cfg.Transport,
 	}
 
 	dc, err := deploy.LoadConfig(deploy.DefaultFilename)
@@ -104,79 +131,99 @@ func NewLocalDevEventHandler(ctx context.Context, db ddb.Storage, clk clock.Cloc
 		}
 		eh.SlackNotifier = *notifier
 	}
-	var runtime workflowsvc.Runtime
+	var runtime interface {
+		workflowsvc.Runtime
+		Start(ctx context.Context)
+	}
+	// granter is used to retry failed deactivations, it is nil when the mock runtime is used
+	var granter workflowsvc.GrantHandler
 
 	if cfg.UseMockWorkflowRuntime {
 		runtime = mock.NewRuntime(db, eh, &requestroutersvc.Service{
 			DB: db,
-		})
+		}, &freezesvc.Service{DB: db, Clock: clk})
 	} else {
-		runtime = local.NewRuntime(db, &targetgroupgranter.Granter{
+		retryPolicy := targetgroupgranter.RetryPolicyFromConfig(cf.GrantRetry)
+		granter = &targetgroupgranter.Granter{
 			DB:          db,
 			EventPutter: eh,
 			RequestRouter: &requestroutersvc.Service{
 				DB: db,
 			},
 			RuntimeGetter: DefaultGetter{},
-		}, &requestroutersvc.Service{
-			DB: db,
-		})
+			RetryPolicy:   &retryPolicy,
+			Freezes:       &freezesvc.Service{DB: db, Clock: clk},
+		}
+		localRuntime := local.NewRuntime(db, granter)
+		localRuntime.Clock = clk
+		localRuntime.Eventbus = eh
+		runtime = localRuntime
 	}
+	// resume any grants which were pending when the server last stopped
+	go runtime.Start(ctx)
+	// approvals which weren't activated in time are expired by a scheduled Lambda when deployed
+	go expireActivations(ctx, clk, &accesssvc.Service{
+		DB:          db,
+		Clock:       clk,
+		EventPutter: eh,
+	})
+
 	wf := &workflowsvc.Service{
 		Runtime:  runtime,
 		DB:       db,
 		Clk:      clk,
 		Eventbus: eh,
+		Granter:  granter,
+		Freezes: &freezesvc.Service{
+			DB:    db,
+			Clock: clk,
+		},
 	}
 	eh.Eventbus = eh
 	eh.Workflow = wf
-	go func() {
-		err := eh.startProcessing(ctx)
-		if err != nil {
-			panic(err)
-		}
-	}()
+	eh.BulkRevoker = &bulkrevokesvc.Service{
+		DB:       db,
+		Clock:    clk,
+		Eventbus: eh,
+		Access: &accesssvc.Service{
+			DB:          db,
+			Clock:       clk,
+			EventPutter: eh,
+		},
+	}
+	// the event handler and the Slack notifier receive events through the same transport as they do when deployed,
+	// and events which they fail to handle are kept in the dead letter store
+	deadLetters := &deadletter.Recorder{DB: db, Clock: clk}
+	go eh.subscribe(ctx, gevent.EventHandlerSubscriber, deadLetters.Wrap(gevent.EventHandlerSubscriber, eh.HandleEvent))
+	go eh.subscribe(ctx, gevent.SlackNotifierSubscriber, deadLetters.Wrap(gevent.SlackNotifierSubscriber, eh.SlackNotifier.HandleEvent))
 	return eh
 }
 
-// call StartProcessing to process events from the queue
-func (n *EventHandler) startProcessing(ctx context.Context) error {
-	for {
-		event := <-n.eventQueue
-		d, err := json.Marshal(event)
-		if err != nil {
-			return err
-		}
-
-		//handle event for event handler
-		err = n.HandleEvent(ctx, events.CloudWatchEvent{
-			DetailType: event.EventType(),
-			Detail:     d,
-		})
-		if err != nil {
-			return err
-		}
-
-		//handle event for slack notifier
-		err = n.SlackNotifier.HandleEvent(ctx, events.CloudWatchEvent{
-			DetailType: event.EventType(),
-			Detail:     d,
-		})
-		if err != nil {
-			return err
-		}
+func (n *EventHandler) subscribe(ctx context.Context, subscriber string, h gevent.Handler) {
+	err := n.transport.Subscribe(ctx, subscriber, h)
+	if err != nil && !errors.Is(err, context.Canceled) {
+		zap.S().Errorw("event subscription stopped", "subscriber", subscriber, "error", err)
 	}
 }
 
 // Put allows the event handler to be used in place of the event putter interface in development
 func (n *EventHandler) Put(ctx context.Context, detail gevent.EventTyper) error {
-	n.eventQueue <- detail
-	return nil
+	return n.transport.Put(ctx, detail)
 }
 
 func (n *EventHandler) HandleEvent(ctx context.Context, event events.CloudWatchEvent) (err error) {
 	log := zap.S().With("event", event)
 	log.Info("received event from eventbridge")
+	// events which would make an illegal status change from the stored status are logged and dropped, retrying them wouldn't help.
+	// Events which raced another status change return access.ErrStaleStatus, so they are retried against the new status,
+	// and are kept in the dead letter store if they keep failing.
+	defer func() {
+		var ite access.InvalidTransitionError
+		if errors.As(err, &ite) {
+			log.Warnw("rejected status transition", "error", err)
+			err = nil
+		}
+	}()
 	if strings.HasPrefix(event.DetailType, "grant") {
 		err = n.HandleGrantEvent(ctx, log, event)
 		if err != nil {
@@ -188,6 +235,18 @@ func (n *EventHandler) HandleEvent(ctx context.Context, event events.CloudWatchE
 			return err
 		}
 
+	} else if strings.HasPrefix(event.DetailType, "bulkRevokeJob") {
+		err = n.HandleBulkRevokeJobEvent(ctx, log, event)
+		if err != nil {
+			return err
+		}
+
+	} else if strings.HasPrefix(event.DetailType, "freeze") {
+		err = n.HandleFreezeEvent(ctx, log, event)
+		if err != nil {
+			return err
+		}
+
 	} else if strings.HasPrefix(event.DetailType, "accessGroup") {
 		err = n.HandleAccessGroupEvents(ctx, log, event)
 		if err != nil {
@@ -212,6 +271,25 @@ func (n *EventHandler) GetRequestFromDatabase(ctx context.Context, requestID str
 	return q.Result, nil
 }
 
+// expireActivationsInterval is how often approvals which weren't activated in time are expired when running locally.
+const expireActivationsInterval = time.Minute
+
+func expireActivations(ctx context.Context, clk clock.Clock, activations *accesssvc.Service) {
+	ticker := clk.Ticker(expireActivationsInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := activations.ExpireActivations(ctx)
+			if err != nil {
+				zap.S().Errorw("failed to expire activations", "error", err)
+			}
+		}
+	}
+}
+
 func (n *EventHandler) GetGroupFromDatabase(ctx context.Context, requestID string, groupID string) (*access.GroupWithTargets, error) {
 	q := storage.GetRequestGroupWithTargets{
 		RequestID: requestID,
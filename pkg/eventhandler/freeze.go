@@ -0,0 +1,75 @@
+package eventhandler
+
+import (
+	"context"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+	"go.uber.org/zap"
+)
+
+// HandleFreezeEvent releases activations which were held by a freeze when the freeze is lifted or changed.
+func (n *EventHandler) HandleFreezeEvent(ctx context.Context, log *zap.SugaredLogger, event events.CloudWatchEvent) error {
+	switch event.DetailType {
+	case gevent.FreezeLiftedType, gevent.FreezeUpdatedType:
+		return n.releaseHeldActivations(ctx, log)
+	}
+	return nil
+}
+
+// releaseHeldActivations retries every held activation.
+// The workflow holds the activation again if it is still blocked by a freeze. A held activation is only deleted
+// once the grant has been started or the activation is dropped, so it is retried if releasing it fails.
+func (n *EventHandler) releaseHeldActivations(ctx context.Context, log *zap.SugaredLogger) error {
+	q := storage.ListHeldActivations{}
+	err := n.DB.All(ctx, &q)
+	if err != nil {
+		return err
+	}
+	for i := range q.Result {
+		held := &q.Result[i]
+		group, err := n.GetGroupFromDatabase(ctx, held.RequestID, held.GroupID)
+		if err != nil && err != ddb.ErrNoItems {
+			return err
+		}
+		// the request may have been cancelled or revoked while the activation was held
+		if err == ddb.ErrNoItems || group.Group.Status != types.RequestAccessGroupStatusAPPROVED || group.Group.RequestStatus != types.ACTIVE {
+			log.Infow("dropping held activation for group which is no longer active", "requestId", held.RequestID, "groupId", held.GroupID)
+			err = n.DB.Delete(ctx, held)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		log.Infow("releasing held activation", "requestId", held.RequestID, "groupId", held.GroupID)
+		targets, err := n.Workflow.Grant(ctx, held.RequestID, held.GroupID)
+		if err != nil {
+			return err
+		}
+		if !grantStarted(targets) {
+			// the workflow replaced the held activation because a freeze still blocks it
+			log.Infow("activation is still held by a freeze", "requestId", held.RequestID, "groupId", held.GroupID)
+			continue
+		}
+		err = n.DB.Delete(ctx, held)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// grantStarted returns true if the grant workflow has started for any of the targets.
+// The workflow doesn't add grants to the targets of a held activation.
+func grantStarted(targets []access.GroupTarget) bool {
+	for _, t := range targets {
+		if t.Grant != nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,110 @@
+package eventhandler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/eventhandler/mocks"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+// deleteDB records the items which were deleted
+type deleteDB struct {
+	*mockClient
+	deleted []ddb.Keyer
+}
+
+type mockClient = ddbmock.Client
+
+func (d *deleteDB) Delete(ctx context.Context, item ddb.Keyer) error {
+	d.deleted = append(d.deleted, item)
+	return d.mockClient.Delete(ctx, item)
+}
+
+func TestReleaseHeldActivations(t *testing.T) {
+	held := access.HeldActivation{RequestID: "req_1", GroupID: "grp_1", FreezeIDs: []string{"frz_1"}}
+	activeGroup := access.GroupWithTargets{
+		Group:   access.Group{ID: "grp_1", RequestID: "req_1", Status: types.RequestAccessGroupStatusAPPROVED, RequestStatus: types.ACTIVE},
+		Targets: []access.GroupTarget{{ID: "gta_1"}},
+	}
+	cancelledGroup := activeGroup
+	cancelledGroup.Group.RequestStatus = types.CANCELLED
+	started := []access.GroupTarget{{ID: "gta_1", Grant: &access.Grant{Status: types.RequestAccessGroupTargetStatusAWAITINGSTART}}}
+	stillHeld := []access.GroupTarget{{ID: "gta_1"}}
+	grantErr := errors.New("runtime unavailable")
+
+	type testcase struct {
+		name        string
+		group       *access.GroupWithTargets
+		groupErr    error
+		wantGrant   bool
+		grantResult []access.GroupTarget
+		grantErr    error
+		wantDeleted bool
+		wantErr     error
+	}
+
+	testcases := []testcase{
+		{
+			name:        "released",
+			group:       &activeGroup,
+			wantGrant:   true,
+			grantResult: started,
+			wantDeleted: true,
+		},
+		{
+			name:        "held again by another freeze",
+			group:       &activeGroup,
+			wantGrant:   true,
+			grantResult: stillHeld,
+		},
+		{
+			name:      "kept when the grant fails",
+			group:     &activeGroup,
+			wantGrant: true,
+			grantErr:  grantErr,
+			wantErr:   grantErr,
+		},
+		{
+			name:        "dropped when the request was cancelled",
+			group:       &cancelledGroup,
+			wantDeleted: true,
+		},
+		{
+			name:        "dropped when the group doesn't exist",
+			groupErr:    ddb.ErrNoItems,
+			wantDeleted: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock := ddbmock.New(t)
+			mock.MockQuery(&storage.ListHeldActivations{Result: []access.HeldActivation{held}})
+			mock.MockQueryWithErr(&storage.GetRequestGroupWithTargets{Result: tc.group}, tc.groupErr)
+			db := &deleteDB{mockClient: mock}
+			ctrl := gomock.NewController(t)
+			wf := mocks.NewMockWorkflow(ctrl)
+			if tc.wantGrant {
+				wf.EXPECT().Grant(gomock.Any(), "req_1", "grp_1").Return(tc.grantResult, tc.grantErr)
+			}
+			eh := EventHandler{DB: db, Workflow: wf}
+
+			err := eh.releaseHeldActivations(context.Background(), zap.NewNop().Sugar())
+			assert.Equal(t, tc.wantErr, err)
+			if tc.wantDeleted {
+				assert.Equal(t, []ddb.Keyer{&held}, db.deleted)
+			} else {
+				assert.Empty(t, db.deleted)
+			}
+		})
+	}
+}
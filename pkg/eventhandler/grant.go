@@ -24,6 +24,10 @@ func (n *EventHandler) HandleGrantEvent(ctx context.Context, log *zap.SugaredLog
 		return n.handleGrantFailed(ctx, event.Detail)
 	case gevent.GrantRevokedType:
 		return n.handleGrantRevoked(ctx, event.Detail)
+	case gevent.GrantRevokeInitiatedType:
+		return n.handleGrantRevokeInitiated(ctx, event.Detail)
+	case gevent.GrantRetryRequestedType:
+		return n.handleGrantRetryRequested(ctx, event.Detail)
 	}
 	return nil
 }
@@ -35,26 +39,8 @@ func (n *EventHandler) handleGrantActivated(ctx context.Context, detail json.Raw
 		return err
 	}
 
-	grant := grantEvent.Grant
-
-	oldStatus := grant.Grant.Status
-
-	grant.Grant.Status = types.RequestAccessGroupTargetStatusACTIVE
-
-	newStatus := grant.Grant.Status
-
-	reqEvent := access.NewTargetStatusChangeEvent(grantEvent.Grant.RequestID, grantEvent.Grant.CreatedAt, aws.String(""), oldStatus, newStatus, grant)
-
-	err = n.DB.Put(ctx, &reqEvent)
-	if err != nil {
-		return err
-	}
-
-	err = n.DB.Put(ctx, &grant)
-	if err != nil {
-		return err
-	}
-	return nil
+	_, err = n.transitionGrant(ctx, grantEvent.Grant, types.RequestAccessGroupTargetStatusACTIVE, aws.String(""))
+	return err
 }
 
 func (n *EventHandler) handleGrantExpired(ctx context.Context, detail json.RawMessage) error {
@@ -64,38 +50,12 @@ func (n *EventHandler) handleGrantExpired(ctx context.Context, detail json.RawMe
 		return err
 	}
 
-	grant := grantEvent.Grant
-
-	oldStatus := grant.Grant.Status
-
-	grant.Grant.Status = types.RequestAccessGroupTargetStatusEXPIRED
-
-	newStatus := grant.Grant.Status
-
-	reqEvent := access.NewTargetStatusChangeEvent(grantEvent.Grant.RequestID, grantEvent.Grant.CreatedAt, aws.String(""), oldStatus, newStatus, grant)
-
-	err = n.DB.Put(ctx, &reqEvent)
-	if err != nil {
-		return err
-	}
-
-	err = n.DB.Put(ctx, &grant)
-	if err != nil {
-		return err
-	}
-
-	q := storage.GetRequestGroupWithTargets{RequestID: grantEvent.Grant.RequestID, GroupID: grantEvent.Grant.GroupID}
-
-	_, err = n.DB.Query(ctx, &q)
+	_, err = n.transitionGrant(ctx, grantEvent.Grant, types.RequestAccessGroupTargetStatusEXPIRED, aws.String(""))
 	if err != nil {
 		return err
 	}
 
-	err = n.handleRequestStatusChange(ctx, q.Result.Group.RequestID)
-	if err != nil {
-		return err
-	}
-	return nil
+	return n.handleRequestStatusChange(ctx, grantEvent.Grant.RequestID)
 }
 
 func (n *EventHandler) handleGrantFailed(ctx context.Context, detail json.RawMessage) error {
@@ -105,80 +65,105 @@ func (n *EventHandler) handleGrantFailed(ctx context.Context, detail json.RawMes
 		return err
 	}
 
-	grant := grantEvent.Grant
-
-	if grant.Grant == nil {
-		grant.Grant = &access.Grant{
-			Status: types.RequestAccessGroupTargetStatusAWAITINGSTART,
-		}
-	}
-
-	var oldStatus = grant.Grant.Status
-	grant.Grant.Status = types.RequestAccessGroupTargetStatusERROR
-
-	newStatus := grant.Grant.Status
-	reqEvent := access.NewGrantFailedEvent(grantEvent.Grant.RequestID, grantEvent.Grant.CreatedAt, oldStatus, newStatus, grantEvent.Reason)
-
-	err = n.DB.Put(ctx, &reqEvent)
+	target, err := n.getStoredGrant(ctx, grantEvent.Grant)
 	if err != nil {
 		return err
 	}
-	err = n.DB.Put(ctx, &grant)
-	if err != nil {
-		return err
+
+	oldStatus := target.Grant.Status
+	if oldStatus != types.RequestAccessGroupTargetStatusERROR {
+		err = target.TransitionGrantStatus(types.RequestAccessGroupTargetStatusERROR)
+		if err != nil {
+			return err
+		}
+		if oldStatus == access.GrantStatusNone {
+			oldStatus = types.RequestAccessGroupTargetStatusAWAITINGSTART
+		}
+		reqEvent := access.NewGrantFailedEvent(target.RequestID, target.CreatedAt, oldStatus, target.Grant.Status, grantEvent.Reason)
+		err = n.DB.PutBatch(ctx, &target, &reqEvent)
+		if err != nil {
+			return err
+		}
 	}
 
-	q := storage.GetRequestGroupWithTargets{RequestID: grantEvent.Grant.RequestID, GroupID: grantEvent.Grant.GroupID}
+	return n.handleRequestStatusChange(ctx, grantEvent.Grant.RequestID)
+}
 
-	_, err = n.DB.Query(ctx, &q)
+func (n *EventHandler) handleGrantRevoked(ctx context.Context, detail json.RawMessage) error {
+	var grantEvent gevent.GrantRevoked
+	err := json.Unmarshal(detail, &grantEvent)
 	if err != nil {
 		return err
 	}
 
-	err = n.handleRequestStatusChange(ctx, q.Result.Group.RequestID)
+	_, err = n.transitionGrant(ctx, grantEvent.Grant, types.RequestAccessGroupTargetStatusREVOKED, aws.String(grantEvent.Actor))
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return n.handleRequestStatusChange(ctx, grantEvent.Grant.RequestID)
 }
 
-func (n *EventHandler) handleGrantRevoked(ctx context.Context, detail json.RawMessage) error {
-	var grantEvent gevent.GrantFailed
+func (n *EventHandler) handleGrantRevokeInitiated(ctx context.Context, detail json.RawMessage) error {
+	var grantEvent gevent.GrantRevokeInitiated
 	err := json.Unmarshal(detail, &grantEvent)
 	if err != nil {
 		return err
 	}
-
 	grant := grantEvent.Grant
+	return n.Workflow.RevokeTarget(ctx, grant.RequestID, grant.GroupID, grant.ID, grantEvent.Revoker.ID, grantEvent.Revoker.Email)
+}
 
-	oldStatus := grant.Grant.Status
-
-	grant.Grant.Status = types.RequestAccessGroupTargetStatusREVOKED
-
-	newStatus := grant.Grant.Status
-
-	reqEvent := access.NewTargetStatusChangeEvent(grantEvent.Grant.RequestID, grantEvent.Grant.CreatedAt, aws.String(""), oldStatus, newStatus, grant)
-
-	err = n.DB.Put(ctx, &reqEvent)
-	if err != nil {
-		return err
-	}
-	err = n.DB.Put(ctx, &grant)
+func (n *EventHandler) handleGrantRetryRequested(ctx context.Context, detail json.RawMessage) error {
+	var grantEvent gevent.GrantRetryRequested
+	err := json.Unmarshal(detail, &grantEvent)
 	if err != nil {
 		return err
 	}
+	return n.Workflow.Retry(ctx, grantEvent.GrantID)
+}
 
-	q := storage.GetRequestGroupWithTargets{RequestID: grantEvent.Grant.RequestID, GroupID: grantEvent.Grant.GroupID}
+// getStoredGrant loads the target from the database, so that status transitions are made from the stored status
+// rather than the status in the event payload, which may be out of date.
+func (n *EventHandler) getStoredGrant(ctx context.Context, target access.GroupTarget) (access.GroupTarget, error) {
+	q := storage.GetRequestGroupTarget{RequestID: target.RequestID, GroupID: target.GroupID, TargetID: target.ID}
+	_, err := n.DB.Query(ctx, &q)
+	if err != nil {
+		return access.GroupTarget{}, err
+	}
+	stored := *q.Result
+	if stored.Grant == nil {
+		// the target hasn't been saved with a grant yet, so use the grant from the event without a status
+		grant := access.Grant{}
+		if target.Grant != nil {
+			grant = *target.Grant
+			grant.Status = access.GrantStatusNone
+		}
+		stored.Grant = &grant
+	}
+	return stored, nil
+}
 
-	_, err = n.DB.Query(ctx, &q)
+// transitionGrant moves the stored grant for the target to a new status and records the change in the request history.
+// Replayed events where the grant already has the status are ignored.
+func (n *EventHandler) transitionGrant(ctx context.Context, target access.GroupTarget, to types.RequestAccessGroupTargetStatus, actor *string) (access.GroupTarget, error) {
+	stored, err := n.getStoredGrant(ctx, target)
 	if err != nil {
-		return err
+		return access.GroupTarget{}, err
+	}
+	oldStatus := stored.Grant.Status
+	if oldStatus == to {
+		return stored, nil
+	}
+	err = stored.TransitionGrantStatus(to)
+	if err != nil {
+		return access.GroupTarget{}, err
 	}
 
-	err = n.handleRequestStatusChange(ctx, q.Result.Group.RequestID)
+	reqEvent := access.NewTargetStatusChangeEvent(stored.RequestID, stored.CreatedAt, actor, oldStatus, to, stored)
+	err = n.DB.PutBatch(ctx, &stored, &reqEvent)
 	if err != nil {
-		return err
+		return access.GroupTarget{}, err
 	}
-	return nil
+	return stored, nil
 }
@@ -25,6 +25,10 @@ func (n *EventHandler) HandleAccessGroupEvents(ctx context.Context, log *zap.Sug
 		return n.handleAccessGroupApprovedEvent(ctx, event.Detail)
 	case gevent.AccessGroupDeclinedType:
 		return n.handleAccessGroupDeclinedDeclinedEvent(ctx, event.Detail)
+	case gevent.AccessGroupActivatedType:
+		return n.handleAccessGroupActivatedEvent(ctx, event.Detail)
+	case gevent.AccessGroupActivationExpiredType:
+		return n.handleAccessGroupActivationExpiredEvent(ctx, event.Detail)
 	}
 	return nil
 }
@@ -47,29 +51,25 @@ func (n *EventHandler) handleReviewEvent(ctx context.Context, detail json.RawMes
 	log := logger.Get(ctx)
 	if group.Group.Status != types.RequestAccessGroupStatusPENDINGAPPROVAL {
 		log.Infow("Ignoring review for group which has already been reviewed", "reviewEvent", groupEvent)
+		return nil
+	}
+	// groups which don't require approval are marked as automatically approved when the request is created
+	if group.Group.ApprovalMethod == nil {
+		reviewed := types.REVIEWED
+		group.Group.ApprovalMethod = &reviewed
 	}
-	reviewed := types.REVIEWED
-	group.Group.ApprovalMethod = &reviewed
 	group.Group.UpdatedAt = time.Now()
-	group.Group.Status = types.RequestAccessGroupStatusAPPROVED
+	newStatus := types.RequestAccessGroupStatusAPPROVED
 	if groupEvent.Review.Decision == types.ReviewDecisionDECLINED {
-		group.Group.Status = types.RequestAccessGroupStatusDECLINED
-		reqEvent := access.NewGroupStatusChangeEvent(group.Group.RequestID, group.Group.CreatedAt, aws.String(""), types.RequestAccessGroupStatusPENDINGAPPROVAL, types.RequestAccessGroupStatusDECLINED)
-
-		err := n.DB.Put(ctx, &reqEvent)
-		if err != nil {
-			return err
-		}
-	} else {
-		reqEvent := access.NewGroupStatusChangeEvent(group.Group.RequestID, group.Group.CreatedAt, aws.String(""), types.RequestAccessGroupStatusPENDINGAPPROVAL, types.RequestAccessGroupStatusAPPROVED)
-
-		err := n.DB.Put(ctx, &reqEvent)
-		if err != nil {
-			return err
-		}
+		newStatus = types.RequestAccessGroupStatusDECLINED
 	}
+	err = group.Group.TransitionStatus(newStatus)
+	if err != nil {
+		return err
+	}
+	reqEvent := access.NewGroupStatusChangeEvent(group.Group.RequestID, group.Group.CreatedAt, aws.String(""), types.RequestAccessGroupStatusPENDINGAPPROVAL, newStatus)
 
-	err = n.DB.Put(ctx, &group.Group)
+	err = n.DB.PutBatch(ctx, &group.Group, &reqEvent)
 	if err != nil {
 		return err
 	}
@@ -148,6 +148,15 @@ func (n *EventHandler) handleAccessGroupApprovedEvent(ctx context.Context, detai
 		return err
 	}
 
+	// on demand access waits for the requester to activate it before it is granted
+	if groupEvent.AccessGroup.Group.IsOnDemand() && groupEvent.AccessGroup.Group.ActivatedAt == nil {
+		return n.awaitActivation(ctx, groupEvent.AccessGroup)
+	}
+	return n.grantApprovedGroup(ctx, log, groupEvent)
+}
+
+// grantApprovedGroup starts the grant workflow for an approved group, unless it overlaps an existing grant.
+func (n *EventHandler) grantApprovedGroup(ctx context.Context, log *zap.SugaredLogger, groupEvent gevent.AccessGroupApproved) error {
 	overlapping, err := n.isGrantOverlapping(ctx, groupEvent.AccessGroup)
 	if err != nil {
 		return err
@@ -174,7 +183,10 @@ func (n *EventHandler) handleAccessGroupApprovedEvent(ctx context.Context, detai
 	// 	if all groups are reviewed update request status to active, save to ddb
 	// Then start the grant workflows
 	if allGroupsReviewed {
-		request.UpdateStatus(types.ACTIVE)
+		err = request.UpdateStatus(types.ACTIVE)
+		if err != nil {
+			return err
+		}
 		err = n.DB.PutBatch(ctx, request.DBItems()...)
 		if err != nil {
 			return err
@@ -199,9 +211,12 @@ func (n *EventHandler) handleAccessGroupDeclinedDeclinedEvent(ctx context.Contex
 	}
 	// If all groups are declined, then the request is marked as complete, because no grants will start
 	if request.AllGroupsDeclined() {
-		request.UpdateStatus(types.COMPLETE)
+		err = request.UpdateStatus(types.COMPLETE)
 	} else if request.AllGroupsReviewed() {
-		request.UpdateStatus(types.ACTIVE)
+		err = request.UpdateStatus(types.ACTIVE)
+	}
+	if err != nil {
+		return err
 	}
 	return n.DB.PutBatch(ctx, request.DBItems()...)
 }
@@ -223,3 +238,94 @@ func (n *EventHandler) handleAccessGroupOverlap(ctx context.Context, event geven
 
 	return nil
 }
+
+// awaitActivation marks an approved on demand group as waiting for the requester to activate it.
+// The approval expires if the group is not activated within the access rule's activation window.
+func (n *EventHandler) awaitActivation(ctx context.Context, approved access.GroupWithTargets) error {
+	group, err := n.GetGroupFromDatabase(ctx, approved.Group.RequestID, approved.Group.ID)
+	if err != nil {
+		return err
+	}
+	// the approved event may be delivered more than once, the activation window starts from the first delivery
+	if group.Group.Status != types.RequestAccessGroupStatusAPPROVED || group.Group.ActivatedAt != nil {
+		logger.Get(ctx).Infow("ignoring approval for group which is already awaiting activation", "requestId", group.Group.RequestID, "groupId", group.Group.ID, "status", group.Group.Status)
+		return nil
+	}
+	now := time.Now()
+	deadline := now.Add(group.Group.AccessRuleSnapshot.ActivationWindow())
+	err = group.Group.TransitionStatus(types.RequestAccessGroupStatusAWAITINGACTIVATION)
+	if err != nil {
+		return err
+	}
+	group.Group.ActivationDeadline = &deadline
+	group.Group.UpdatedAt = now
+	reqEvent := access.NewGroupStatusChangeEvent(group.Group.RequestID, now, aws.String(""), types.RequestAccessGroupStatusAPPROVED, types.RequestAccessGroupStatusAWAITINGACTIVATION)
+	err = n.DB.PutBatch(ctx, &group.Group, &reqEvent)
+	if err != nil {
+		return err
+	}
+
+	request, err := n.GetRequestFromDatabase(ctx, group.Group.RequestID)
+	if err != nil {
+		return err
+	}
+	if request.AllGroupsReviewed() && request.Request.RequestStatus == types.PENDING {
+		err = request.UpdateStatus(types.ACTIVE)
+		if err != nil {
+			return err
+		}
+		return n.DB.PutBatch(ctx, request.DBItems()...)
+	}
+	return nil
+}
+
+// handleAccessGroupActivatedEvent starts the grant workflow for an on demand group which the requester has activated.
+func (n *EventHandler) handleAccessGroupActivatedEvent(ctx context.Context, detail json.RawMessage) error {
+	log := logger.Get(ctx).With("eventType", gevent.AccessGroupActivatedType)
+	var groupEvent gevent.AccessGroupActivated
+	err := json.Unmarshal(detail, &groupEvent)
+	if err != nil {
+		return err
+	}
+	group, err := n.GetGroupFromDatabase(ctx, groupEvent.AccessGroup.Group.RequestID, groupEvent.AccessGroup.Group.ID)
+	if err != nil {
+		return err
+	}
+	// the final timing is set once the grant workflow has started, so a duplicate activation is ignored
+	if group.Group.Status != types.RequestAccessGroupStatusAPPROVED || group.Group.FinalTiming != nil {
+		log.Infow("ignoring activation for group which is not waiting to be granted", "requestId", group.Group.RequestID, "groupId", group.Group.ID, "status", group.Group.Status)
+		return nil
+	}
+	return n.grantApprovedGroup(ctx, log, gevent.AccessGroupApproved{AccessGroup: *group})
+}
+
+// handleAccessGroupActivationExpiredEvent expires the approval of an on demand group which wasn't activated in time.
+func (n *EventHandler) handleAccessGroupActivationExpiredEvent(ctx context.Context, detail json.RawMessage) error {
+	log := logger.Get(ctx).With("eventType", gevent.AccessGroupActivationExpiredType)
+	var groupEvent gevent.AccessGroupActivationExpired
+	err := json.Unmarshal(detail, &groupEvent)
+	if err != nil {
+		return err
+	}
+	group, err := n.GetGroupFromDatabase(ctx, groupEvent.AccessGroup.Group.RequestID, groupEvent.AccessGroup.Group.ID)
+	if err != nil {
+		return err
+	}
+	// the requester may have activated the group after the expiry was queued
+	if group.Group.Status != types.RequestAccessGroupStatusAWAITINGACTIVATION {
+		log.Infow("ignoring expiry for group which is not awaiting activation", "requestId", group.Group.RequestID, "groupId", group.Group.ID, "status", group.Group.Status)
+		return nil
+	}
+	now := time.Now()
+	err = group.Group.TransitionStatus(types.RequestAccessGroupStatusAPPROVALEXPIRED)
+	if err != nil {
+		return err
+	}
+	group.Group.UpdatedAt = now
+	reqEvent := access.NewGroupStatusChangeEvent(group.Group.RequestID, now, aws.String(""), types.RequestAccessGroupStatusAWAITINGACTIVATION, types.RequestAccessGroupStatusAPPROVALEXPIRED)
+	err = n.DB.PutBatch(ctx, &group.Group, &reqEvent)
+	if err != nil {
+		return err
+	}
+	return n.handleRequestStatusChange(ctx, group.Group.RequestID)
+}
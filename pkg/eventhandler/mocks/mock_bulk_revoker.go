@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/common-fate/common-fate/pkg/eventhandler (interfaces: BulkRevoker)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockBulkRevoker is a mock of BulkRevoker interface.
+type MockBulkRevoker struct {
+	ctrl     *gomock.Controller
+	recorder *MockBulkRevokerMockRecorder
+}
+
+// MockBulkRevokerMockRecorder is the mock recorder for MockBulkRevoker.
+type MockBulkRevokerMockRecorder struct {
+	mock *MockBulkRevoker
+}
+
+// NewMockBulkRevoker creates a new mock instance.
+func NewMockBulkRevoker(ctrl *gomock.Controller) *MockBulkRevoker {
+	mock := &MockBulkRevoker{ctrl: ctrl}
+	mock.recorder = &MockBulkRevokerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBulkRevoker) EXPECT() *MockBulkRevokerMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockBulkRevoker) Run(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockBulkRevokerMockRecorder) Run(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockBulkRevoker)(nil).Run), arg0, arg1)
+}
@@ -50,6 +50,20 @@ func (mr *MockWorkflowMockRecorder) Grant(arg0, arg1, arg2 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Grant", reflect.TypeOf((*MockWorkflow)(nil).Grant), arg0, arg1, arg2)
 }
 
+// Retry mocks base method.
+func (m *MockWorkflow) Retry(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Retry", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Retry indicates an expected call of Retry.
+func (mr *MockWorkflowMockRecorder) Retry(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Retry", reflect.TypeOf((*MockWorkflow)(nil).Retry), arg0, arg1)
+}
+
 // Revoke mocks base method.
 func (m *MockWorkflow) Revoke(arg0 context.Context, arg1, arg2, arg3, arg4 string) error {
 	m.ctrl.T.Helper()
@@ -63,3 +77,17 @@ func (mr *MockWorkflowMockRecorder) Revoke(arg0, arg1, arg2, arg3, arg4 interfac
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockWorkflow)(nil).Revoke), arg0, arg1, arg2, arg3, arg4)
 }
+
+// RevokeTarget mocks base method.
+func (m *MockWorkflow) RevokeTarget(arg0 context.Context, arg1, arg2, arg3, arg4, arg5 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeTarget", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeTarget indicates an expected call of RevokeTarget.
+func (mr *MockWorkflowMockRecorder) RevokeTarget(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeTarget", reflect.TypeOf((*MockWorkflow)(nil).RevokeTarget), arg0, arg1, arg2, arg3, arg4, arg5)
+}
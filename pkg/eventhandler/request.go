@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/common-fate/common-fate/pkg/access"
 	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/service/workflowsvc"
 	"github.com/common-fate/common-fate/pkg/storage"
 	"github.com/common-fate/common-fate/pkg/types"
 	"github.com/common-fate/ddb"
@@ -41,7 +42,7 @@ func (n *EventHandler) handleRequestCreated(ctx context.Context, detail json.Raw
 	for _, g := range requestEvent.Request.Groups {
 		group := g
 		if !group.Group.AccessRuleSnapshot.Approval.IsRequired() {
-			group.Group.Status = types.RequestAccessGroupStatusAPPROVED
+			// the group is moved to APPROVED when the review event is handled
 			auto := types.AUTOMATIC
 			group.Group.ApprovalMethod = &auto
 			err = n.DB.Put(ctx, &group.Group)
@@ -107,10 +108,23 @@ func (n *EventHandler) handleRequestCancelInitiated(ctx context.Context, detail
 		return err
 	}
 
+	// the request is read again, as its status may have changed since the event was sent
+	request, err := n.GetRequestFromDatabase(ctx, requestEvent.Request.Request.ID)
+	if err != nil {
+		return err
+	}
+	// the event was delivered more than once, the cancellation has already been sent
+	if request.Request.RequestStatus == types.CANCELLED {
+		return nil
+	}
+
 	//handle changing status's of request, and targets
-	requestEvent.Request.UpdateStatus(types.CANCELLED)
+	err = request.UpdateStatus(types.CANCELLED)
+	if err != nil {
+		return err
+	}
 
-	items := requestEvent.Request.DBItems()
+	items := request.DBItems()
 
 	err = n.DB.PutBatch(ctx, items...)
 	if err != nil {
@@ -120,7 +134,7 @@ func (n *EventHandler) handleRequestCancelInitiated(ctx context.Context, detail
 	//after cancelling has finished emit a cancel event where the notification will be sent out
 
 	err = n.Eventbus.Put(ctx, &gevent.RequestCancelled{
-		Request: requestEvent.Request,
+		Request: *request,
 	})
 	if err != nil {
 		return err
@@ -134,23 +148,33 @@ func (n *EventHandler) handleRequestRevokeInitiated(ctx context.Context, detail
 		return err
 	}
 
-	items := []ddb.Keyer{}
 	zap.S().Infow("revoking all groups in request")
+	revoked := 0
 	for _, group := range requestEvent.Request.Groups {
 		err := n.Workflow.Revoke(ctx, group.Group.RequestID, group.Group.ID, requestEvent.Revoker.ID, requestEvent.Revoker.Email)
+		// groups where every target has already ended or been revoked individually are skipped
+		if err == workflowsvc.ErrGrantInactive {
+			continue
+		}
 		if err != nil {
 			return err
 		}
+		revoked++
 	}
 
-	err = n.DB.PutBatch(ctx, items...)
-	if err != nil {
-		return err
+	// if there was nothing left to revoke, no grant events will be emitted so the request status is updated here
+	if revoked == 0 {
+		return n.handleRequestStatusChange(ctx, requestEvent.Request.Request.ID)
 	}
 	return nil
 }
 
-// Passes in a request ID and will handle updating the request status based on its state at any given time
+// Passes in a request ID and will handle updating the request status based on its state at any given time.
+//
+// A group is complete once every target in it has ended, and the request is complete once every group is complete.
+// Targets which were revoked individually count as ended, so a request where some targets expired and others were revoked
+// is marked as COMPLETE, unless a revoke was requested for the whole request or every target was revoked.
+// On demand groups whose approval expired before they were activated count as ended.
 func (n *EventHandler) handleRequestStatusChange(ctx context.Context, requestId string) error {
 	request := storage.GetRequestWithGroupsWithTargets{ID: requestId}
 	_, err := n.DB.Query(ctx, &request)
@@ -158,122 +182,115 @@ func (n *EventHandler) handleRequestStatusChange(ctx context.Context, requestId
 		return err
 	}
 
-	if request.Result.Request.RequestStatus == types.REVOKING {
-		//check if all grants are revoked
-		allRevoked := true
-		for _, group := range request.Result.Groups {
-			for _, target := range group.Targets {
-				if target.Grant.Status != types.RequestAccessGroupTargetStatusREVOKED {
-					allRevoked = false
-					break
-				}
-			}
+	var items []ddb.Keyer
+	allEnded, allRevoked, allError := true, true, true
+	granted := false
+	for i, group := range request.Result.Groups {
+		// an on demand group which was never activated has ended without being granted
+		if group.Group.Status == types.RequestAccessGroupStatusAPPROVALEXPIRED {
+			granted, allRevoked, allError = true, false, false
+			continue
 		}
-		if allRevoked {
-			err = n.Eventbus.Put(ctx, gevent.RequestRevoked{
-				Request: *request.Result,
-			})
-			if err != nil {
-				return err
-			}
-			oldStatus := request.Result.Request.RequestStatus
-			request.Result.UpdateStatus(types.REVOKED)
-			newStatus := request.Result.Request.RequestStatus
-
-			items := request.Result.DBItems()
-			err = n.DB.PutBatch(ctx, items...)
-			if err != nil {
-				return err
+		ended, revoked := targetsEnded(group.Targets)
+		allEnded = allEnded && ended
+		allRevoked = allRevoked && revoked
+		for _, target := range group.Targets {
+			if target.Grant == nil {
+				continue
 			}
-
-			reqEvent := access.NewRequestStatusChangeEvent(request.Result.Request.ID, request.Result.Request.CreatedAt, &request.Result.Request.RequestedBy.ID, oldStatus, newStatus)
-
-			err = n.DB.Put(ctx, &reqEvent)
-			if err != nil {
-				return err
+			granted = true
+			if target.Grant.Status != types.RequestAccessGroupTargetStatusERROR {
+				allError = false
 			}
 		}
-	}
 
-	//check if all grants are expired
-	allExpired := true
-	for _, group := range request.Result.Groups {
-		for _, target := range group.Targets {
-			if target.Grant != nil {
-				if target.Grant.Status != types.RequestAccessGroupTargetStatusEXPIRED {
-					allExpired = false
-					break
-				}
+		// roll the status up to the group while other groups in the request are still active
+		if ended && !isFinalStatus(group.Group.RequestStatus) {
+			status := types.COMPLETE
+			if revoked {
+				status = types.REVOKED
 			}
-
+			group.Group.RequestStatus = status
+			for j, target := range group.Targets {
+				target.RequestStatus = status
+				group.Targets[j] = target
+			}
+			request.Result.Groups[i] = group
+			items = append(items, request.Result.Groups[i].DBItems()...)
 		}
 	}
-	//if all grants are expired send out a request completed event
-	if allExpired {
-		err = n.Eventbus.Put(ctx, gevent.RequestComplete{
-			Request: *request.Result,
-		})
-		if err != nil {
-			return err
-		}
-		oldStatus := request.Result.Request.RequestStatus
-		request.Result.UpdateStatus(types.COMPLETE)
-
-		newStatus := request.Result.Request.RequestStatus
-
-		items := request.Result.DBItems()
-		err = n.DB.PutBatch(ctx, items...)
-		if err != nil {
-			return err
+	if !granted || isFinalStatus(request.Result.Request.RequestStatus) {
+		if len(items) == 0 {
+			return nil
 		}
+		return n.DB.PutBatch(ctx, items...)
+	}
 
-		reqEvent := access.NewRequestStatusChangeEvent(request.Result.Request.ID, request.Result.Request.CreatedAt, &request.Result.Request.RequestedBy.ID, oldStatus, newStatus)
-
-		err = n.DB.Put(ctx, &reqEvent)
-		if err != nil {
-			return err
+	oldStatus := request.Result.Request.RequestStatus
+	var evt gevent.EventTyper
+	switch {
+	case allEnded && (oldStatus == types.REVOKING || allRevoked):
+		err = request.Result.UpdateStatus(types.REVOKED)
+		evt = gevent.RequestRevoked{Request: *request.Result}
+	case allEnded:
+		err = request.Result.UpdateStatus(types.COMPLETE)
+		//if all grants are expired send out a request completed event
+		evt = gevent.RequestComplete{Request: *request.Result}
+	case allError:
+		err = request.Result.UpdateStatus(access.RequestStatusError)
+		evt = gevent.RequestCancelled{Request: *request.Result}
+	default:
+		if len(items) == 0 {
+			return nil
 		}
-
+		return n.DB.PutBatch(ctx, items...)
 	}
+	if err != nil {
+		return err
+	}
+	newStatus := request.Result.Request.RequestStatus
 
-	allError := true
-	for _, group := range request.Result.Groups {
-		for _, target := range group.Targets {
-			if target.Grant != nil {
-				if target.Grant.Status != types.RequestAccessGroupTargetStatusERROR {
-					allError = false
-					break
-				}
-			}
-
-		}
+	// the request is saved before the event is sent, so that no event is sent if the status was changed concurrently
+	err = n.DB.PutBatch(ctx, request.Result.DBItems()...)
+	if err != nil {
+		return err
 	}
-	//if all grants are expired send out a request completed event
-	if allError {
-		err = n.Eventbus.Put(ctx, gevent.RequestCancelled{
-			Request: *request.Result,
-		})
-		if err != nil {
-			return err
-		}
-		oldStatus := request.Result.Request.RequestStatus
-		request.Result.UpdateStatus(types.RequestStatus(types.ERROR))
 
-		newStatus := request.Result.Request.RequestStatus
+	err = n.Eventbus.Put(ctx, evt)
+	if err != nil {
+		return err
+	}
 
-		items := request.Result.DBItems()
-		err = n.DB.PutBatch(ctx, items...)
-		if err != nil {
-			return err
-		}
+	reqEvent := access.NewRequestStatusChangeEvent(request.Result.Request.ID, request.Result.Request.CreatedAt, &request.Result.Request.RequestedBy.ID, oldStatus, newStatus)
 
-		reqEvent := access.NewRequestStatusChangeEvent(request.Result.Request.ID, request.Result.Request.CreatedAt, &request.Result.Request.RequestedBy.ID, oldStatus, newStatus)
+	return n.DB.Put(ctx, &reqEvent)
+}
 
-		err = n.DB.Put(ctx, &reqEvent)
-		if err != nil {
-			return err
+// targetsEnded returns whether every granted target has expired or been revoked, and whether every granted target was revoked.
+// Targets which were never granted are ignored.
+func targetsEnded(targets []access.GroupTarget) (ended bool, revoked bool) {
+	granted := false
+	ended, revoked = true, true
+	for _, target := range targets {
+		if target.Grant == nil {
+			continue
+		}
+		granted = true
+		switch target.Grant.Status {
+		case types.RequestAccessGroupTargetStatusREVOKED:
+		case types.RequestAccessGroupTargetStatusEXPIRED:
+			revoked = false
+		default:
+			ended, revoked = false, false
 		}
-
 	}
-	return nil
+	if !granted {
+		return false, false
+	}
+	return ended, revoked
+}
+
+// isFinalStatus returns true if the request can no longer change status
+func isFinalStatus(status types.RequestStatus) bool {
+	return status == types.COMPLETE || status == types.REVOKED || status == types.CANCELLED || status == types.RequestStatus(types.ERROR)
 }
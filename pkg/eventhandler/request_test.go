@@ -0,0 +1,113 @@
+package eventhandler
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/eventhandler/mocks"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+// batchDB records the items which were written in batches, and fails the writes with err
+type batchDB struct {
+	*mockClient
+	written []ddb.Keyer
+	err     error
+}
+
+func (d *batchDB) PutBatch(ctx context.Context, items ...ddb.Keyer) error {
+	if d.err != nil {
+		return d.err
+	}
+	d.written = append(d.written, items...)
+	return nil
+}
+
+func TestHandleRequestCancelInitiated(t *testing.T) {
+	request := func(status types.RequestStatus) *access.RequestWithGroupsWithTargets {
+		return &access.RequestWithGroupsWithTargets{
+			Request: access.Request{ID: "req_1", RequestStatus: status},
+			Groups: []access.GroupWithTargets{{
+				Group:   access.Group{ID: "grp_1", RequestID: "req_1", RequestStatus: status},
+				Targets: []access.GroupTarget{{ID: "gta_1", GroupID: "grp_1", RequestID: "req_1", RequestStatus: status}},
+			}},
+		}
+	}
+
+	type testcase struct {
+		name   string
+		stored *access.RequestWithGroupsWithTargets
+		putErr error
+		// wantCancelled is true if the request is written as cancelled
+		wantCancelled bool
+		wantErr       error
+	}
+
+	testcases := []testcase{
+		{
+			name:          "cancelled",
+			stored:        request(types.PENDING),
+			wantCancelled: true,
+		},
+		{
+			name:   "dropped when the stored request was already cancelled",
+			stored: request(types.CANCELLED),
+		},
+		{
+			name:   "dropped when the stored request became active",
+			stored: request(types.ACTIVE),
+		},
+		{
+			name:    "stale status is returned so the event is retried",
+			stored:  request(types.PENDING),
+			putErr:  access.ErrStaleStatus,
+			wantErr: access.ErrStaleStatus,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock := ddbmock.New(t)
+			mock.MockQuery(&storage.GetRequestWithGroupsWithTargets{Result: tc.stored})
+			db := &batchDB{mockClient: mock, err: tc.putErr}
+			ctrl := gomock.NewController(t)
+			ep := mocks.NewMockEventPutter(ctrl)
+			if tc.wantCancelled {
+				ep.EXPECT().Put(gomock.Any(), gomock.AssignableToTypeOf(&gevent.RequestCancelled{})).Return(nil)
+			}
+			eh := EventHandler{DB: db, Eventbus: ep}
+
+			// the event was sent while the request was pending, and its status may have changed since
+			detail, err := json.Marshal(gevent.RequestCancelledInitiated{Request: *request(types.PENDING)})
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = eh.HandleEvent(context.Background(), events.CloudWatchEvent{DetailType: gevent.RequestCancelInitiatedType, Detail: detail})
+			assert.Equal(t, tc.wantErr, err)
+			if !tc.wantCancelled {
+				assert.Empty(t, db.written)
+				return
+			}
+			assert.Len(t, db.written, 3)
+			for _, item := range db.written {
+				switch i := item.(type) {
+				case *access.Request:
+					assert.Equal(t, types.CANCELLED, i.RequestStatus)
+				case *access.Group:
+					assert.Equal(t, types.CANCELLED, i.RequestStatus)
+				case *access.GroupTarget:
+					assert.Equal(t, types.CANCELLED, i.RequestStatus)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,222 @@
+// Package eventqueue is an event transport which queues events in DynamoDB.
+// It is used when Common Fate runs as a single server, so that events which haven't
+// been handled yet are delivered when the server restarts.
+package eventqueue
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+)
+
+const (
+	// DefaultPollInterval is how often subscribers check for events which were sent by another process, or are due to be retried.
+	DefaultPollInterval = 5 * time.Second
+	// DefaultMaxAttempts is how many times an event is delivered before it is dropped from the queue.
+	DefaultMaxAttempts = 10
+	// maxRetryDelay is the longest time a failed event waits before it is delivered again.
+	maxRetryDelay = 5 * time.Minute
+)
+
+// Queue is a gevent.Transport which stores a copy of each event for every subscriber in DynamoDB.
+// Events are deleted once they have been handled. If the handler returns an error the event is retried
+// with a backoff, and later events with the same ordering key wait until it has been handled.
+// Events which still fail after MaxAttempts are dropped, wrap the handler with a deadletter.Recorder to keep them.
+type Queue struct {
+	DB    ddb.Storage
+	Clock clock.Clock
+	// Subscribers are the names of the subscribers which receive the events.
+	// Subscribers must be registered up front so that events sent before they subscribe are kept for them.
+	Subscribers  []string
+	PollInterval time.Duration
+	MaxAttempts  int
+
+	mu           sync.Mutex
+	lastSequence int64
+	wake         map[string]chan struct{}
+}
+
+// New creates a queue which delivers events to the subscribers.
+func New(db ddb.Storage, clk clock.Clock, subscribers ...string) *Queue {
+	return &Queue{
+		DB:          db,
+		Clock:       clk,
+		Subscribers: subscribers,
+	}
+}
+
+// Put stores a copy of the event for each subscriber.
+func (q *Queue) Put(ctx context.Context, e gevent.EventTyper) error {
+	// return early if we don't have an event to send.
+	if e == nil {
+		return nil
+	}
+	evt, err := gevent.ToCloudWatchEvent(e)
+	if err != nil {
+		return err
+	}
+	now := q.Clock.Now()
+	sequence := q.nextSequence(now)
+	var items []ddb.Keyer
+	for _, subscriber := range q.Subscribers {
+		items = append(items, &access.QueuedEvent{
+			Sequence:    sequence,
+			Subscriber:  subscriber,
+			OrderingKey: gevent.OrderingKey(e),
+			DetailType:  evt.DetailType,
+			Detail:      string(evt.Detail),
+			CreatedAt:   now,
+		})
+	}
+	err = q.DB.PutBatch(ctx, items...)
+	if err != nil {
+		return err
+	}
+	for _, subscriber := range q.Subscribers {
+		select {
+		case q.wakeChannel(subscriber) <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+// Subscribe delivers queued events to the handler until ctx is cancelled.
+func (q *Queue) Subscribe(ctx context.Context, subscriber string, h gevent.Handler) error {
+	log := logger.Get(ctx).With("subscriber", subscriber)
+	interval := q.PollInterval
+	if interval == 0 {
+		interval = DefaultPollInterval
+	}
+	ticker := q.Clock.Ticker(interval)
+	defer ticker.Stop()
+	wake := q.wakeChannel(subscriber)
+	for {
+		err := q.Deliver(ctx, subscriber, h)
+		if err != nil {
+			log.Errorw("failed to deliver queued events", "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-wake:
+		case <-ticker.C:
+		}
+	}
+}
+
+// Deliver makes one pass over the events queued for the subscriber, oldest first.
+// Events which were added while the pass was running are delivered by the next pass.
+func (q *Queue) Deliver(ctx context.Context, subscriber string, h gevent.Handler) error {
+	log := logger.Get(ctx).With("subscriber", subscriber)
+	list := storage.ListQueuedEvents{Subscriber: subscriber}
+	err := q.DB.All(ctx, &list, ddb.ConsistentRead())
+	if err != nil {
+		return err
+	}
+
+	// ordering keys with an event which hasn't been handled yet
+	blocked := map[string]bool{}
+	for _, queued := range list.Result {
+		queued := queued
+		if queued.OrderingKey != "" && blocked[queued.OrderingKey] {
+			continue
+		}
+		now := q.Clock.Now()
+		if queued.NextAttemptAt != nil && queued.NextAttemptAt.After(now) {
+			blocked[queued.OrderingKey] = true
+			continue
+		}
+
+		err = h(ctx, events.CloudWatchEvent{
+			ID:         queued.Sequence,
+			DetailType: queued.DetailType,
+			Source:     "commonfate.io/granted",
+			Detail:     []byte(queued.Detail),
+		})
+		if err == nil {
+			err = q.DB.Delete(ctx, &queued)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		log.Errorw("failed to handle queued event", "detailType", queued.DetailType, "sequence", queued.Sequence, "attempts", queued.Attempts+1, "error", err)
+		queued.Attempts++
+		if queued.Attempts >= q.maxAttempts() {
+			// drop the event so that later events with the same ordering key aren't blocked forever
+			log.Errorw("dropping queued event after too many failed attempts", "detailType", queued.DetailType, "sequence", queued.Sequence, "attempts", queued.Attempts)
+			err = q.DB.Delete(ctx, &queued)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		blocked[queued.OrderingKey] = true
+		queued.LastError = err.Error()
+		next := now.Add(RetryDelay(queued.Attempts))
+		queued.NextAttemptAt = &next
+		err = q.DB.Put(ctx, &queued)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (q *Queue) maxAttempts() int {
+	if q.MaxAttempts == 0 {
+		return DefaultMaxAttempts
+	}
+	return q.MaxAttempts
+}
+
+// RetryDelay returns how long to wait before delivering an event again after it has failed.
+// The delay doubles after each attempt, up to five minutes.
+func RetryDelay(attempts int) time.Duration {
+	if attempts > 8 {
+		return maxRetryDelay
+	}
+	delay := time.Second << attempts
+	if delay > maxRetryDelay {
+		return maxRetryDelay
+	}
+	return delay
+}
+
+// nextSequence returns a sortable sequence number for an event.
+// Sequence numbers are based on the time so that they keep increasing when the server restarts.
+func (q *Queue) nextSequence(now time.Time) string {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	seq := now.UnixNano()
+	if seq <= q.lastSequence {
+		seq = q.lastSequence + 1
+	}
+	q.lastSequence = seq
+	return fmt.Sprintf("%020d", seq)
+}
+
+func (q *Queue) wakeChannel(subscriber string) chan struct{} {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.wake == nil {
+		q.wake = map[string]chan struct{}{}
+	}
+	c, ok := q.wake[subscriber]
+	if !ok {
+		c = make(chan struct{}, 1)
+		q.wake[subscriber] = c
+	}
+	return c
+}
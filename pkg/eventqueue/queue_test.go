@@ -0,0 +1,190 @@
+package eventqueue
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockClient is embedded with a different name, as the Client field would hide the Client method of ddb.Storage
+type mockClient = ddbmock.Client
+
+// testDB records the items written by the queue
+type testDB struct {
+	*mockClient
+	puts    []ddb.Keyer
+	deletes []ddb.Keyer
+}
+
+func (d *testDB) Put(ctx context.Context, item ddb.Keyer) error {
+	d.puts = append(d.puts, item)
+	return nil
+}
+
+func (d *testDB) PutBatch(ctx context.Context, items ...ddb.Keyer) error {
+	d.puts = append(d.puts, items...)
+	return nil
+}
+
+func (d *testDB) Delete(ctx context.Context, item ddb.Keyer) error {
+	d.deletes = append(d.deletes, item)
+	return nil
+}
+
+func TestPut(t *testing.T) {
+	clk := clock.NewMock()
+	db := &testDB{mockClient: ddbmock.New(t)}
+	q := New(db, clk, gevent.EventHandlerSubscriber, gevent.SlackNotifierSubscriber)
+
+	err := q.Put(context.Background(), gevent.GrantRetryRequested{GrantID: "gra_1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = q.Put(context.Background(), gevent.GrantRetryRequested{GrantID: "gra_2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Len(t, db.puts, 4)
+	first := db.puts[0].(*access.QueuedEvent)
+	second := db.puts[2].(*access.QueuedEvent)
+	assert.Equal(t, gevent.EventHandlerSubscriber, first.Subscriber)
+	assert.Equal(t, gevent.SlackNotifierSubscriber, db.puts[1].(*access.QueuedEvent).Subscriber)
+	assert.Equal(t, gevent.GrantRetryRequestedType, first.DetailType)
+	assert.Equal(t, "grant#gra_1", first.OrderingKey)
+	// events sent at the same time are still ordered
+	assert.Less(t, first.Sequence, second.Sequence)
+}
+
+func TestDeliver(t *testing.T) {
+	now := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	later := now.Add(time.Minute)
+
+	type testcase struct {
+		name string
+		give []access.QueuedEvent
+		// failSequences are the events which the handler returns an error for
+		failSequences []string
+		wantHandled   []string
+		wantDeleted   []string
+		wantRetried   []string
+	}
+
+	testcases := []testcase{
+		{
+			name: "events are handled in order and deleted",
+			give: []access.QueuedEvent{
+				{Sequence: "1", OrderingKey: "request#1"},
+				{Sequence: "2", OrderingKey: "request#2"},
+				{Sequence: "3", OrderingKey: "request#1"},
+			},
+			wantHandled: []string{"1", "2", "3"},
+			wantDeleted: []string{"1", "2", "3"},
+		},
+		{
+			name: "failed event blocks later events with the same key",
+			give: []access.QueuedEvent{
+				{Sequence: "1", OrderingKey: "request#1"},
+				{Sequence: "2", OrderingKey: "request#2"},
+				{Sequence: "3", OrderingKey: "request#1"},
+			},
+			failSequences: []string{"1"},
+			wantHandled:   []string{"1", "2"},
+			wantDeleted:   []string{"2"},
+			wantRetried:   []string{"1"},
+		},
+		{
+			name: "event waiting for a retry blocks later events",
+			give: []access.QueuedEvent{
+				{Sequence: "1", OrderingKey: "request#1", Attempts: 1, NextAttemptAt: &later},
+				{Sequence: "2", OrderingKey: "request#1"},
+				{Sequence: "3"},
+			},
+			wantHandled: []string{"3"},
+			wantDeleted: []string{"3"},
+		},
+		{
+			name: "event is dropped after the last attempt",
+			give: []access.QueuedEvent{
+				{Sequence: "1", OrderingKey: "request#1", Attempts: DefaultMaxAttempts - 1},
+				{Sequence: "2", OrderingKey: "request#1"},
+			},
+			failSequences: []string{"1"},
+			wantHandled:   []string{"1", "2"},
+			wantDeleted:   []string{"1", "2"},
+		},
+		{
+			name: "failed events without a key don't block other events",
+			give: []access.QueuedEvent{
+				{Sequence: "1"},
+				{Sequence: "2"},
+			},
+			failSequences: []string{"1"},
+			wantHandled:   []string{"1", "2"},
+			wantDeleted:   []string{"2"},
+			wantRetried:   []string{"1"},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			clk := clock.NewMock()
+			clk.Set(now)
+			db := &testDB{mockClient: ddbmock.New(t)}
+			// the handler identifies events by their detail
+			for i := range tc.give {
+				tc.give[i].Detail = tc.give[i].Sequence
+			}
+			db.MockQuery(&storage.ListQueuedEvents{Result: tc.give})
+			q := New(db, clk, gevent.EventHandlerSubscriber)
+
+			var handled []string
+			err := q.Deliver(context.Background(), gevent.EventHandlerSubscriber, func(ctx context.Context, event events.CloudWatchEvent) error {
+				seq := string(event.Detail)
+				handled = append(handled, seq)
+				for _, f := range tc.failSequences {
+					if f == seq {
+						return errors.New("failed")
+					}
+				}
+				return nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var deleted, retried []string
+			for _, d := range db.deletes {
+				deleted = append(deleted, d.(*access.QueuedEvent).Sequence)
+			}
+			for _, p := range db.puts {
+				e := p.(*access.QueuedEvent)
+				retried = append(retried, e.Sequence)
+				assert.Equal(t, 1, e.Attempts)
+				assert.Equal(t, "failed", e.LastError)
+				assert.Equal(t, now.Add(2*time.Second), *e.NextAttemptAt)
+			}
+			assert.Equal(t, tc.wantHandled, handled)
+			assert.Equal(t, tc.wantDeleted, deleted)
+			assert.Equal(t, tc.wantRetried, retried)
+		})
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	assert.Equal(t, 2*time.Second, RetryDelay(1))
+	assert.Equal(t, 4*time.Second, RetryDelay(2))
+	assert.Equal(t, 256*time.Second, RetryDelay(8))
+	assert.Equal(t, maxRetryDelay, RetryDelay(9))
+	assert.Equal(t, maxRetryDelay, RetryDelay(100))
+}
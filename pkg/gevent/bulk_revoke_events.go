@@ -0,0 +1,23 @@
+package gevent
+
+const (
+	BulkRevokeJobCreatedType = "bulkRevokeJob.created"
+)
+
+// BulkRevokeJobCreated is emitted when an admin creates a bulk revoke job.
+// The event handler processes the job in the background.
+type BulkRevokeJobCreated struct {
+	JobID string `json:"jobId"`
+}
+
+func (BulkRevokeJobCreated) EventType() string {
+	return BulkRevokeJobCreatedType
+}
+
+func (BulkRevokeJobCreated) SchemaVersion() int {
+	return 1
+}
+
+func (e BulkRevokeJobCreated) OrderingKey() string {
+	return "bulkRevokeJob#" + e.JobID
+}
@@ -0,0 +1,64 @@
+package gevent
+
+import "github.com/common-fate/common-fate/pkg/access"
+
+const (
+	FreezeCreatedType = "freeze.created"
+	FreezeUpdatedType = "freeze.updated"
+	FreezeLiftedType  = "freeze.lifted"
+)
+
+// FreezeCreated is emitted when an admin freezes access.
+type FreezeCreated struct {
+	Freeze access.Freeze `json:"freeze"`
+	Actor  User          `json:"actor"`
+}
+
+func (FreezeCreated) EventType() string {
+	return FreezeCreatedType
+}
+
+func (FreezeCreated) SchemaVersion() int {
+	return 1
+}
+
+func (e FreezeCreated) OrderingKey() string {
+	return "freeze#" + e.Freeze.ID
+}
+
+// FreezeUpdated is emitted when an admin changes the message, end time or exempt groups of a freeze.
+type FreezeUpdated struct {
+	Freeze access.Freeze `json:"freeze"`
+	Actor  User          `json:"actor"`
+}
+
+func (FreezeUpdated) EventType() string {
+	return FreezeUpdatedType
+}
+
+func (FreezeUpdated) SchemaVersion() int {
+	return 1
+}
+
+func (e FreezeUpdated) OrderingKey() string {
+	return "freeze#" + e.Freeze.ID
+}
+
+// FreezeLifted is emitted when an admin lifts a freeze.
+// The event handler releases any activations which were held by the freeze.
+type FreezeLifted struct {
+	Freeze access.Freeze `json:"freeze"`
+	Actor  User          `json:"actor"`
+}
+
+func (FreezeLifted) EventType() string {
+	return FreezeLiftedType
+}
+
+func (FreezeLifted) SchemaVersion() int {
+	return 1
+}
+
+func (e FreezeLifted) OrderingKey() string {
+	return "freeze#" + e.Freeze.ID
+}
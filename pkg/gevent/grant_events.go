@@ -9,6 +9,10 @@ const (
 	GrantExpiredType   = "grant.expired"
 	GrantFailedType    = "grant.failed"
 	GrantRevokedType   = "grant.revoked"
+	// GrantRevokeInitiatedType is emitted when a user revokes a single target in an access group
+	GrantRevokeInitiatedType = "grant.revoke.initiated"
+	// GrantRetryRequestedType is emitted when an admin retries a failed grant
+	GrantRetryRequestedType = "grant.retryRequested"
 )
 
 // GrantActivated is emitted when a grant is
@@ -23,6 +27,14 @@ func (GrantActivated) EventType() string {
 	return GrantActivatedType
 }
 
+func (GrantActivated) SchemaVersion() int {
+	return 1
+}
+
+func (e GrantActivated) OrderingKey() string {
+	return RequestOrderingKey(e.Grant.RequestID)
+}
+
 // GrantExpired is emitted when a grant is
 // expired by the Access Handler.
 // 'Expired' means that the assignment to the
@@ -36,6 +48,14 @@ func (GrantExpired) EventType() string {
 	return GrantExpiredType
 }
 
+func (GrantExpired) SchemaVersion() int {
+	return 1
+}
+
+func (e GrantExpired) OrderingKey() string {
+	return RequestOrderingKey(e.Grant.RequestID)
+}
+
 // GrantRevoked is emitted when a grant is
 // revoked by the Access Handler.
 // 'Revoked' means that the assignment to the
@@ -60,6 +80,34 @@ func (GrantRevoked) EventType() string {
 	return GrantRevokedType
 }
 
+func (GrantRevoked) SchemaVersion() int {
+	return 1
+}
+
+func (e GrantRevoked) OrderingKey() string {
+	return RequestOrderingKey(e.Grant.RequestID)
+}
+
+// GrantRevokeInitiated is emitted when a user
+// revokes access to a single target in an access group.
+// The other targets in the group remain active.
+type GrantRevokeInitiated struct {
+	Grant   access.GroupTarget `json:"grant"`
+	Revoker User               `json:"revoker"`
+}
+
+func (GrantRevokeInitiated) EventType() string {
+	return GrantRevokeInitiatedType
+}
+
+func (GrantRevokeInitiated) SchemaVersion() int {
+	return 1
+}
+
+func (e GrantRevokeInitiated) OrderingKey() string {
+	return RequestOrderingKey(e.Grant.RequestID)
+}
+
 // GrantFailed is emitted when the access handler
 // encounters an unrecoverable error when activating
 // or deactivating a grant.
@@ -73,6 +121,35 @@ func (GrantFailed) EventType() string {
 	return GrantFailedType
 }
 
+func (GrantFailed) SchemaVersion() int {
+	return 1
+}
+
+func (e GrantFailed) OrderingKey() string {
+	return RequestOrderingKey(e.Grant.RequestID)
+}
+
+// GrantRetryRequested is emitted when an admin
+// retries a grant which could not be activated
+// or deactivated after the retry policy was exhausted.
+type GrantRetryRequested struct {
+	GrantID string `json:"grantId"`
+	// the commonfate internal id of the admin who retried the grant
+	Actor string `json:"actor"`
+}
+
+func (GrantRetryRequested) EventType() string {
+	return GrantRetryRequestedType
+}
+
+func (GrantRetryRequested) SchemaVersion() int {
+	return 1
+}
+
+func (e GrantRetryRequested) OrderingKey() string {
+	return "grant#" + e.GrantID
+}
+
 // GrantEventPayload is a payload which is common to
 // all Grant events. It is used to conveniently unmarshal
 // the Grant payloads in our event handler code.
@@ -11,6 +11,9 @@ const (
 	AccessGroupReviewedType = "accessGroup.review"
 	AccessGroupApprovedType = "accessGroup.approved"
 	AccessGroupDeclinedType = "accessGroup.declined"
+	// on demand access groups
+	AccessGroupActivatedType         = "accessGroup.activated"
+	AccessGroupActivationExpiredType = "accessGroup.activationExpired"
 )
 
 type AccessGroupReviewed struct {
@@ -24,6 +27,14 @@ func (AccessGroupReviewed) EventType() string {
 	return AccessGroupReviewedType
 }
 
+func (AccessGroupReviewed) SchemaVersion() int {
+	return 1
+}
+
+func (e AccessGroupReviewed) OrderingKey() string {
+	return RequestOrderingKey(e.AccessGroup.Group.RequestID)
+}
+
 type AccessGroupApproved struct {
 	AccessGroup    access.GroupWithTargets                `json:"group"`
 	ApprovalMethod types.RequestAccessGroupApprovalMethod `json:"approvalMethod"`
@@ -34,6 +45,14 @@ func (AccessGroupApproved) EventType() string {
 	return AccessGroupApprovedType
 }
 
+func (AccessGroupApproved) SchemaVersion() int {
+	return 1
+}
+
+func (e AccessGroupApproved) OrderingKey() string {
+	return RequestOrderingKey(e.AccessGroup.Group.RequestID)
+}
+
 type AccessGroupDeclined struct {
 	AccessGroup access.GroupWithTargets `json:"group"`
 	Reviewer    User                    `json:"reviewer"`
@@ -42,3 +61,45 @@ type AccessGroupDeclined struct {
 func (AccessGroupDeclined) EventType() string {
 	return AccessGroupDeclinedType
 }
+
+func (AccessGroupDeclined) SchemaVersion() int {
+	return 1
+}
+
+func (e AccessGroupDeclined) OrderingKey() string {
+	return RequestOrderingKey(e.AccessGroup.Group.RequestID)
+}
+
+// AccessGroupActivated is emitted when the requester activates an approved on demand access group.
+type AccessGroupActivated struct {
+	AccessGroup access.GroupWithTargets `json:"group"`
+}
+
+func (AccessGroupActivated) EventType() string {
+	return AccessGroupActivatedType
+}
+
+func (AccessGroupActivated) SchemaVersion() int {
+	return 1
+}
+
+func (e AccessGroupActivated) OrderingKey() string {
+	return RequestOrderingKey(e.AccessGroup.Group.RequestID)
+}
+
+// AccessGroupActivationExpired is emitted when an approved on demand access group wasn't activated within its activation window.
+type AccessGroupActivationExpired struct {
+	AccessGroup access.GroupWithTargets `json:"group"`
+}
+
+func (AccessGroupActivationExpired) EventType() string {
+	return AccessGroupActivationExpiredType
+}
+
+func (AccessGroupActivationExpired) SchemaVersion() int {
+	return 1
+}
+
+func (e AccessGroupActivationExpired) OrderingKey() string {
+	return RequestOrderingKey(e.AccessGroup.Group.RequestID)
+}
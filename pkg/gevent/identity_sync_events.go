@@ -0,0 +1,76 @@
+package gevent
+
+import (
+	"github.com/common-fate/common-fate/pkg/identity"
+)
+
+const (
+	IdentitySyncAbortedType = "identitySync.aborted"
+	UserDeprovisionedType   = "identitySync.userDeprovisioned"
+	ReviewerRemovedType     = "identitySync.reviewerRemoved"
+)
+
+// IdentitySyncAborted is emitted when an identity sync is aborted because it would archive more than the threshold percentage of users or groups.
+type IdentitySyncAborted struct {
+	Run identity.SyncRun `json:"run"`
+	// Threshold is the maximum percentage of users or groups which a sync can archive
+	Threshold int `json:"threshold"`
+}
+
+func (IdentitySyncAborted) EventType() string {
+	return IdentitySyncAbortedType
+}
+
+func (IdentitySyncAborted) SchemaVersion() int {
+	return 1
+}
+
+func (e IdentitySyncAborted) OrderingKey() string {
+	return "identitySync#" + e.Run.ID
+}
+
+// UserDeprovisioned is emitted when identity sync archives a user, and lists the access which was cleaned up.
+// In report-only mode the access is left in place, and the event lists the access which would have been cleaned up.
+type UserDeprovisioned struct {
+	User User                        `json:"user"`
+	Mode identity.DeprovisioningMode `json:"mode"`
+	// BulkRevokeJobID is the job which revokes the user's active requests and cancels their pending requests.
+	// It is a dry run in report-only mode.
+	BulkRevokeJobID string `json:"bulkRevokeJobId"`
+	// RevokedRequests are the IDs of the user's active requests
+	RevokedRequests []string `json:"revokedRequests"`
+	// CancelledRequests are the IDs of the user's pending requests
+	CancelledRequests []string `json:"cancelledRequests"`
+	// ReviewerRemovedRequests are the IDs of the pending requests which the user could review
+	ReviewerRemovedRequests []string `json:"reviewerRemovedRequests"`
+}
+
+func (UserDeprovisioned) EventType() string {
+	return UserDeprovisionedType
+}
+
+func (UserDeprovisioned) SchemaVersion() int {
+	return 1
+}
+
+func (e UserDeprovisioned) OrderingKey() string {
+	return "user#" + e.User.ID
+}
+
+// ReviewerRemoved is emitted when a deprovisioned user is removed as a reviewer of a pending request.
+type ReviewerRemoved struct {
+	RequestID string `json:"requestId"`
+	Reviewer  User   `json:"reviewer"`
+}
+
+func (ReviewerRemoved) EventType() string {
+	return ReviewerRemovedType
+}
+
+func (ReviewerRemoved) SchemaVersion() int {
+	return 1
+}
+
+func (e ReviewerRemoved) OrderingKey() string {
+	return RequestOrderingKey(e.RequestID)
+}
@@ -0,0 +1,92 @@
+package gevent
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+// Parse decodes the detail of an event into the event type registered in Events for the detail type.
+func Parse(detailType string, detail []byte) (EventTyper, error) {
+	for _, e := range Events {
+		if e.EventType() != detailType {
+			continue
+		}
+		ptr := reflect.New(reflect.TypeOf(e))
+		err := json.Unmarshal(detail, ptr.Interface())
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s event: %w", detailType, err)
+		}
+		return ptr.Elem().Interface().(EventTyper), nil
+	}
+	return nil, fmt.Errorf("unknown event type %s", detailType)
+}
+
+// Replay sends an event again to a single subscriber, after the subscriber failed to handle it.
+// Every subscriber receives the event, but subscribers other than Subscriber ignore it.
+type Replay struct {
+	Event      EventTyper
+	Subscriber string
+	// DeadLetterID is the ID of the dead letter event which is replayed. It is removed once the replay has been handled.
+	DeadLetterID string
+}
+
+func (r Replay) EventType() string {
+	return r.Event.EventType()
+}
+
+func (r Replay) OrderingKey() string {
+	return OrderingKey(r.Event)
+}
+
+func (r Replay) SchemaVersion() int {
+	return SchemaVersion(r.Event)
+}
+
+// MarshalJSON adds replaySubscriber and replayDeadLetterId fields to the event detail.
+func (r Replay) MarshalJSON() ([]byte, error) {
+	b, err := json.Marshal(r.Event)
+	if err != nil {
+		return nil, err
+	}
+	var detail map[string]any
+	err = json.Unmarshal(b, &detail)
+	if err != nil {
+		return nil, err
+	}
+	if detail == nil {
+		detail = map[string]any{}
+	}
+	detail["replaySubscriber"] = r.Subscriber
+	if r.DeadLetterID != "" {
+		detail["replayDeadLetterId"] = r.DeadLetterID
+	}
+	return json.Marshal(detail)
+}
+
+// replayDetail holds the fields which Replay adds to the event detail.
+type replayDetail struct {
+	ReplaySubscriber   string `json:"replaySubscriber"`
+	ReplayDeadLetterID string `json:"replayDeadLetterId"`
+}
+
+func parseReplayDetail(event events.CloudWatchEvent) replayDetail {
+	var detail replayDetail
+	// events which can't be parsed are left for the subscriber to handle
+	_ = json.Unmarshal(event.Detail, &detail)
+	return detail
+}
+
+// ReplaySubscriber returns the subscriber which a replayed event was sent for,
+// or an empty string if the event isn't a replay.
+func ReplaySubscriber(event events.CloudWatchEvent) string {
+	return parseReplayDetail(event).ReplaySubscriber
+}
+
+// ReplayDeadLetterID returns the ID of the dead letter event which a replayed event was sent for,
+// or an empty string if the event isn't a replay.
+func ReplayDeadLetterID(event events.CloudWatchEvent) string {
+	return parseReplayDetail(event).ReplayDeadLetterID
+}
@@ -21,6 +21,14 @@ func (RequestCreated) EventType() string {
 	return RequestCreatedType
 }
 
+func (RequestCreated) SchemaVersion() int {
+	return 1
+}
+
+func (e RequestCreated) OrderingKey() string {
+	return RequestOrderingKey(e.Request.Request.ID)
+}
+
 type RequestComplete struct {
 	Request access.RequestWithGroupsWithTargets `json:"request"`
 }
@@ -29,6 +37,14 @@ func (RequestComplete) EventType() string {
 	return RequestCompleteType
 }
 
+func (RequestComplete) SchemaVersion() int {
+	return 1
+}
+
+func (e RequestComplete) OrderingKey() string {
+	return RequestOrderingKey(e.Request.Request.ID)
+}
+
 // Request Revoke is omitted when a user revokes a request
 type RequestRevokeInitiated struct {
 	Request access.RequestWithGroupsWithTargets `json:"request"`
@@ -39,6 +55,14 @@ func (RequestRevokeInitiated) EventType() string {
 	return RequestRevokeInitiatedType
 }
 
+func (RequestRevokeInitiated) SchemaVersion() int {
+	return 1
+}
+
+func (e RequestRevokeInitiated) OrderingKey() string {
+	return RequestOrderingKey(e.Request.Request.ID)
+}
+
 type RequestCancelledInitiated struct {
 	Request access.RequestWithGroupsWithTargets `json:"request"`
 }
@@ -47,6 +71,14 @@ func (RequestCancelledInitiated) EventType() string {
 	return RequestCancelInitiatedType
 }
 
+func (RequestCancelledInitiated) SchemaVersion() int {
+	return 1
+}
+
+func (e RequestCancelledInitiated) OrderingKey() string {
+	return RequestOrderingKey(e.Request.Request.ID)
+}
+
 type RequestRevoked struct {
 	Request access.RequestWithGroupsWithTargets `json:"request"`
 }
@@ -55,6 +87,14 @@ func (RequestRevoked) EventType() string {
 	return RequestRevokeCompletedType
 }
 
+func (RequestRevoked) SchemaVersion() int {
+	return 1
+}
+
+func (e RequestRevoked) OrderingKey() string {
+	return RequestOrderingKey(e.Request.Request.ID)
+}
+
 type RequestCancelled struct {
 	Request access.RequestWithGroupsWithTargets `json:"request"`
 }
@@ -62,3 +102,11 @@ type RequestCancelled struct {
 func (RequestCancelled) EventType() string {
 	return RequestCancelCompletedType
 }
+
+func (RequestCancelled) SchemaVersion() int {
+	return 1
+}
+
+func (e RequestCancelled) OrderingKey() string {
+	return RequestOrderingKey(e.Request.Request.ID)
+}
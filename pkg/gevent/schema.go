@@ -0,0 +1,232 @@
+package gevent
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"reflect"
+	"sort"
+
+	"github.com/common-fate/iso8601"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3gen"
+)
+
+//go:generate go run github.com/common-fate/common-fate/cmd/event-schemas -out schemas
+
+// Versioned is implemented by events with a published JSON schema.
+// The version must be increased whenever the event changes in a way which isn't backwards compatible,
+// such as removing or renaming a field or changing its type. Adding a field doesn't need a new version.
+type Versioned interface {
+	SchemaVersion() int
+}
+
+// Events lists every event which is sent on the event bus.
+// A JSON schema is published for each of them.
+var Events = []EventTyper{
+	AccessGroupReviewed{},
+	AccessGroupApproved{},
+	AccessGroupDeclined{},
+	AccessGroupActivated{},
+	AccessGroupActivationExpired{},
+	BulkRevokeJobCreated{},
+	FreezeCreated{},
+	FreezeUpdated{},
+	FreezeLifted{},
+	GrantActivated{},
+	GrantExpired{},
+	GrantFailed{},
+	GrantRevoked{},
+	GrantRevokeInitiated{},
+	GrantRetryRequested{},
+	IdentitySyncAborted{},
+	RequestCreated{},
+	RequestComplete{},
+	RequestRevokeInitiated{},
+	RequestCancelledInitiated{},
+	RequestRevoked{},
+	RequestCancelled{},
+	ReviewerRemoved{},
+	TargetGroupSyncStale{},
+	UserDeprovisioned{},
+}
+
+// publishedSchemas contains every version of the event schemas, generated with 'go generate ./pkg/gevent'.
+//
+//go:embed schemas/*.json
+var publishedSchemas embed.FS
+
+// SchemaVersion returns the schema version of the event, or 0 if it doesn't have a published schema.
+func SchemaVersion(e EventTyper) int {
+	if v, ok := e.(Versioned); ok {
+		return v.SchemaVersion()
+	}
+	return 0
+}
+
+// SchemaFilename is the name of the file which the schema for a version of an event is published as.
+func SchemaFilename(eventType string, version int) string {
+	return fmt.Sprintf("%s.v%d.json", eventType, version)
+}
+
+// PublishedSchema is a version of an event's JSON schema.
+type PublishedSchema struct {
+	EventType string
+	Version   int
+	Schema    map[string]any
+}
+
+// PublishedSchemas returns every published version of the event schemas, sorted by event type and version.
+func PublishedSchemas() ([]PublishedSchema, error) {
+	files, err := fs.Glob(publishedSchemas, "schemas/*.json")
+	if err != nil {
+		return nil, err
+	}
+	var out []PublishedSchema
+	for _, f := range files {
+		b, err := publishedSchemas.ReadFile(f)
+		if err != nil {
+			return nil, err
+		}
+		var schema map[string]any
+		err = json.Unmarshal(b, &schema)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", f, err)
+		}
+		eventType, _ := schema["title"].(string)
+		version, _ := schema["x-schema-version"].(float64)
+		out = append(out, PublishedSchema{EventType: eventType, Version: int(version), Schema: schema})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].EventType != out[j].EventType {
+			return out[i].EventType < out[j].EventType
+		}
+		return out[i].Version < out[j].Version
+	})
+	return out, nil
+}
+
+var iso8601Type = reflect.TypeOf(iso8601.Time{})
+
+// GenerateSchema generates the JSON schema for an event from its Go definition.
+// The schema describes the event detail as it is sent by ToEntry, including the detailType and schemaVersion fields.
+func GenerateSchema(e EventTyper) (map[string]any, error) {
+	ref, err := openapi3gen.NewSchemaRefForValue(e, openapi3.Schemas{},
+		// encoding/json includes exported fields without a JSON tag
+		openapi3gen.UseAllExportedFields(),
+		openapi3gen.SchemaCustomizer(func(name string, t reflect.Type, tag reflect.StructTag, schema *openapi3.Schema) error {
+			if t == iso8601Type {
+				*schema = openapi3.Schema{Type: "string", Format: "date-time"}
+			}
+			return nil
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	schema := ref.Value
+	version := SchemaVersion(e)
+	schema.WithProperty("detailType", &openapi3.Schema{Type: "string", Enum: []any{e.EventType()}})
+	schema.WithProperty("schemaVersion", &openapi3.Schema{Type: "integer", Enum: []any{version}})
+	schema.Required = []string{"detailType", "schemaVersion"}
+
+	// round trip the schema through JSON to add the JSON Schema fields to it
+	b, err := json.Marshal(schema)
+	if err != nil {
+		return nil, err
+	}
+	var out map[string]any
+	err = json.Unmarshal(b, &out)
+	if err != nil {
+		return nil, err
+	}
+	out["$schema"] = "http://json-schema.org/draft-07/schema#"
+	out["$id"] = "https://schemas.commonfate.io/events/" + SchemaFilename(e.EventType(), version)
+	out["title"] = e.EventType()
+	out["x-schema-version"] = version
+	return out, nil
+}
+
+// BreakingChanges compares two JSON schemas for an event and returns a description of each change
+// which could break consumers of the old schema: removing a field, changing the type or format of a field,
+// removing an enum value, or making a required field optional.
+func BreakingChanges(old, new map[string]any) []string {
+	return breakingChanges("", old, new)
+}
+
+func breakingChanges(path string, old, new map[string]any) []string {
+	name := path
+	if name == "" {
+		name = "the event"
+	}
+	var out []string
+	if old["type"] != new["type"] {
+		return append(out, fmt.Sprintf("%s changed type from %v to %v", name, old["type"], new["type"]))
+	}
+	if old["format"] != new["format"] {
+		out = append(out, fmt.Sprintf("%s changed format from %v to %v", name, old["format"], new["format"]))
+	}
+
+	if oldEnum, ok := old["enum"].([]any); ok {
+		newEnum, _ := new["enum"].([]any)
+		for _, v := range oldEnum {
+			if newEnum != nil && !containsValue(newEnum, v) {
+				out = append(out, fmt.Sprintf("%s no longer allows %v", name, v))
+			}
+		}
+	}
+
+	newRequired, _ := new["required"].([]any)
+	if oldRequired, ok := old["required"].([]any); ok {
+		for _, r := range oldRequired {
+			if !containsValue(newRequired, r) {
+				out = append(out, fmt.Sprintf("%s is no longer required", propertyPath(path, fmt.Sprint(r))))
+			}
+		}
+	}
+
+	oldProps, _ := old["properties"].(map[string]any)
+	newProps, _ := new["properties"].(map[string]any)
+	keys := make([]string, 0, len(oldProps))
+	for k := range oldProps {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		propPath := propertyPath(path, k)
+		newProp, ok := newProps[k].(map[string]any)
+		if !ok {
+			out = append(out, fmt.Sprintf("%s was removed", propPath))
+			continue
+		}
+		oldProp, _ := oldProps[k].(map[string]any)
+		out = append(out, breakingChanges(propPath, oldProp, newProp)...)
+	}
+
+	if oldItems, ok := old["items"].(map[string]any); ok {
+		newItems, _ := new["items"].(map[string]any)
+		out = append(out, breakingChanges(path+"[]", oldItems, newItems)...)
+	}
+	if oldValues, ok := old["additionalProperties"].(map[string]any); ok {
+		newValues, _ := new["additionalProperties"].(map[string]any)
+		out = append(out, breakingChanges(path+"{}", oldValues, newValues)...)
+	}
+	return out
+}
+
+func propertyPath(path, property string) string {
+	if path == "" {
+		return property
+	}
+	return path + "." + property
+}
+
+func containsValue(values []any, v any) bool {
+	for _, x := range values {
+		if reflect.DeepEqual(x, v) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,155 @@
+package gevent
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventsAreVersioned(t *testing.T) {
+	seen := map[string]bool{}
+	for _, e := range Events {
+		if seen[e.EventType()] {
+			t.Errorf("%s is listed more than once in Events", e.EventType())
+		}
+		seen[e.EventType()] = true
+
+		if SchemaVersion(e) < 1 {
+			t.Errorf("%T must implement Versioned with a SchemaVersion of at least 1", e)
+		}
+	}
+}
+
+// TestSchemaCompatibility fails if an event has changed in a way which breaks the schema published for its current version.
+// If this test fails, increase the SchemaVersion of the event and run 'go generate ./pkg/gevent'.
+func TestSchemaCompatibility(t *testing.T) {
+	for _, e := range Events {
+		t.Run(e.EventType(), func(t *testing.T) {
+			got, err := GenerateSchema(e)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// round trip through JSON so that the generated schema can be compared with the published one
+			b, err := json.Marshal(got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var generated map[string]any
+			err = json.Unmarshal(b, &generated)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			existing, err := publishedSchemas.ReadFile("schemas/" + SchemaFilename(e.EventType(), SchemaVersion(e)))
+			if err != nil {
+				t.Fatalf("no schema is published for version %d of %s, run 'go generate ./pkg/gevent': %s", SchemaVersion(e), e.EventType(), err)
+			}
+			var published map[string]any
+			err = json.Unmarshal(existing, &published)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if changes := BreakingChanges(published, generated); len(changes) > 0 {
+				t.Fatalf("%T has breaking changes, increase its SchemaVersion and run 'go generate ./pkg/gevent': %v", e, changes)
+			}
+			assert.Equal(t, published, generated, "the published schema is out of date, run 'go generate ./pkg/gevent'")
+		})
+	}
+}
+
+func TestBreakingChanges(t *testing.T) {
+	old := map[string]any{
+		"type":     "object",
+		"required": []any{"detailType"},
+		"properties": map[string]any{
+			"detailType": map[string]any{"type": "string", "enum": []any{"request.created"}},
+			"request": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"id":       map[string]any{"type": "string"},
+					"duration": map[string]any{"type": "integer"},
+					"tags":     map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+				},
+			},
+		},
+	}
+
+	type testcase struct {
+		name   string
+		update func(s map[string]any)
+		want   []string
+	}
+
+	testcases := []testcase{
+		{
+			name:   "unchanged",
+			update: func(s map[string]any) {},
+		},
+		{
+			name: "field added",
+			update: func(s map[string]any) {
+				request(s)["properties"].(map[string]any)["reason"] = map[string]any{"type": "string"}
+			},
+		},
+		{
+			name: "field removed",
+			update: func(s map[string]any) {
+				delete(request(s)["properties"].(map[string]any), "duration")
+			},
+			want: []string{"request.duration was removed"},
+		},
+		{
+			name: "type changed",
+			update: func(s map[string]any) {
+				request(s)["properties"].(map[string]any)["duration"] = map[string]any{"type": "string"}
+			},
+			want: []string{"request.duration changed type from integer to string"},
+		},
+		{
+			name: "item type changed",
+			update: func(s map[string]any) {
+				request(s)["properties"].(map[string]any)["tags"] = map[string]any{"type": "array", "items": map[string]any{"type": "object"}}
+			},
+			want: []string{"request.tags[] changed type from string to object"},
+		},
+		{
+			name: "enum value removed",
+			update: func(s map[string]any) {
+				s["properties"].(map[string]any)["detailType"] = map[string]any{"type": "string", "enum": []any{"request.updated"}}
+			},
+			want: []string{"detailType no longer allows request.created"},
+		},
+		{
+			name: "no longer required",
+			update: func(s map[string]any) {
+				s["required"] = []any{}
+			},
+			want: []string{"detailType is no longer required"},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			// copy the schema so that test cases don't affect each other
+			b, err := json.Marshal(old)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var updated map[string]any
+			err = json.Unmarshal(b, &updated)
+			if err != nil {
+				t.Fatal(err)
+			}
+			tc.update(updated)
+
+			got := BreakingChanges(old, updated)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func request(s map[string]any) map[string]any {
+	return s["properties"].(map[string]any)["request"].(map[string]any)
+}
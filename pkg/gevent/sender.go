@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/aws/aws-lambda-go/lambda"
 	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
 	"github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
 	"github.com/common-fate/apikit/logger"
@@ -15,6 +17,7 @@ type EventPutter interface {
 }
 
 // EventSender provides methods to submit events to a Common Fate EventBridge bus.
+// It is the Transport used in AWS deployments.
 type Sender struct {
 	client      *eventbridge.Client
 	eventBusArn string
@@ -61,3 +64,21 @@ func (s *Sender) Put(ctx context.Context, e EventTyper) error {
 	log.Infow("event putter put event to event bus", "entry", entry)
 	return nil
 }
+
+// Subscribe starts a Lambda handler for the events which EventBridge delivers to the function.
+// The EventBridge rules for each subscriber are defined in the CDK stack. The subscriber must be one of Subscribers,
+// and is logged with each event so that the function which handled it can be identified.
+//
+// EventBridge retries failed invocations, but doesn't guarantee ordering.
+// Events which must be handled in order are routed to a Lambda function with a concurrency of 1.
+func (s *Sender) Subscribe(ctx context.Context, subscriber string, h Handler) error {
+	if !IsSubscriber(subscriber) {
+		return fmt.Errorf("unknown subscriber %q", subscriber)
+	}
+	log := logger.Get(ctx).With("subscriber", subscriber)
+	lambda.StartWithOptions(func(ctx context.Context, event events.CloudWatchEvent) error {
+		log.Infow("received event", "detailType", event.DetailType, "eventId", event.ID)
+		return h(ctx, event)
+	}, lambda.WithContext(ctx))
+	return nil
+}
@@ -0,0 +1,26 @@
+package gevent
+
+import (
+	"github.com/common-fate/common-fate/pkg/target"
+)
+
+const (
+	TargetGroupSyncStaleType = "targetGroup.syncStale"
+)
+
+// TargetGroupSyncStale is emitted when the cached resources for a target group have not been successfully synced within the staleness threshold.
+type TargetGroupSyncStale struct {
+	SyncStatus target.SyncStatus `json:"syncStatus"`
+}
+
+func (TargetGroupSyncStale) EventType() string {
+	return TargetGroupSyncStaleType
+}
+
+func (TargetGroupSyncStale) SchemaVersion() int {
+	return 2
+}
+
+func (e TargetGroupSyncStale) OrderingKey() string {
+	return "targetGroup#" + e.SyncStatus.TargetGroupID
+}
@@ -25,6 +25,10 @@ func ToEntry(e EventTyper, eventBusName string) (types.PutEventsRequestEntry, er
 	}
 	// Add the detailType key to the data so it can be used for complex filtering
 	dd["detailType"] = e.EventType()
+	// Add the version of the published schema which the event conforms to
+	if version := SchemaVersion(e); version != 0 {
+		dd["schemaVersion"] = version
+	}
 	ddbytes, err := json.Marshal(dd)
 	if err != nil {
 		return types.PutEventsRequestEntry{}, err
@@ -27,6 +27,18 @@ func (e emptyBodyEvent) EventType() string {
 	return "event.emptybody"
 }
 
+type versionedEvent struct {
+	Data string `json:"data"`
+}
+
+func (e versionedEvent) EventType() string {
+	return "event.versioned"
+}
+
+func (e versionedEvent) SchemaVersion() int {
+	return 2
+}
+
 func TestToEntry(t *testing.T) {
 	type testcase struct {
 		name string
@@ -55,6 +67,16 @@ func TestToEntry(t *testing.T) {
 				DetailType:   aws.String("event.emptybody"),
 			},
 		},
+		{
+			name: "versioned",
+			give: versionedEvent{Data: "testing"},
+			want: types.PutEventsRequestEntry{
+				Source:       aws.String("commonfate.io/granted"),
+				EventBusName: aws.String("testbus"),
+				Detail:       aws.String(`{"data":"testing","detailType":"event.versioned","schemaVersion":2}`),
+				DetailType:   aws.String("event.versioned"),
+			},
+		},
 	}
 
 	for _, tc := range testcases {
@@ -0,0 +1,74 @@
+package gevent
+
+import (
+	"context"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+// The names of the subscribers which handle Common Fate events.
+const (
+	EventHandlerSubscriber  = "eventHandler"
+	SlackNotifierSubscriber = "slackNotifier"
+)
+
+// Subscribers are the names of every subscriber.
+var Subscribers = []string{EventHandlerSubscriber, SlackNotifierSubscriber}
+
+// IsSubscriber returns true if name is one of Subscribers.
+func IsSubscriber(name string) bool {
+	for _, s := range Subscribers {
+		if s == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Handler handles an event delivered by a Transport.
+// If it returns an error the event is delivered again, so handlers must be idempotent.
+type Handler func(ctx context.Context, event events.CloudWatchEvent) error
+
+// Transport sends events and delivers them to subscribers, such as the event handler and the Slack notifier.
+//
+// Delivery is at-least-once. Transports which support ordering deliver events
+// with the same ordering key to a subscriber in the order they were sent.
+type Transport interface {
+	EventPutter
+	// Subscribe delivers events to the handler until ctx is cancelled.
+	// Every subscriber receives its own copy of each event.
+	Subscribe(ctx context.Context, subscriber string, h Handler) error
+}
+
+// OrderingKeyer is implemented by events which must be delivered in order with other events for the same resource.
+type OrderingKeyer interface {
+	OrderingKey() string
+}
+
+// OrderingKey returns the key which the event is ordered by, or an empty string if the event can be delivered in any order.
+func OrderingKey(e EventTyper) string {
+	if k, ok := e.(OrderingKeyer); ok {
+		return k.OrderingKey()
+	}
+	return ""
+}
+
+// ToCloudWatchEvent returns the event in the format that EventBridge delivers it to a Lambda function,
+// so that subscribers handle events the same way regardless of the transport.
+func ToCloudWatchEvent(e EventTyper) (events.CloudWatchEvent, error) {
+	entry, err := ToEntry(e, "")
+	if err != nil {
+		return events.CloudWatchEvent{}, err
+	}
+	return events.CloudWatchEvent{
+		DetailType: aws.ToString(entry.DetailType),
+		Source:     aws.ToString(entry.Source),
+		Detail:     []byte(aws.ToString(entry.Detail)),
+	}, nil
+}
+
+// RequestOrderingKey is the ordering key of the events for a request.
+func RequestOrderingKey(requestID string) string {
+	return "request#" + requestID
+}
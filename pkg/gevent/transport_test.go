@@ -0,0 +1,72 @@
+package gevent
+
+import (
+	"testing"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderingKey(t *testing.T) {
+	type testcase struct {
+		name string
+		give EventTyper
+		want string
+	}
+
+	testcases := []testcase{
+		{
+			name: "grant events are ordered by request",
+			give: GrantActivated{Grant: access.GroupTarget{RequestID: "req_1"}},
+			want: "request#req_1",
+		},
+		{
+			name: "pointer to event",
+			give: &GrantExpired{Grant: access.GroupTarget{RequestID: "req_1"}},
+			want: "request#req_1",
+		},
+		{
+			name: "group events are ordered by request",
+			give: AccessGroupApproved{AccessGroup: access.GroupWithTargets{Group: access.Group{RequestID: "req_1"}}},
+			want: "request#req_1",
+		},
+		{
+			name: "request events",
+			give: RequestCreated{Request: access.RequestWithGroupsWithTargets{Request: access.Request{ID: "req_1"}}},
+			want: "request#req_1",
+		},
+		{
+			name: "freeze events",
+			give: FreezeLifted{Freeze: access.Freeze{ID: "frz_1"}},
+			want: "freeze#frz_1",
+		},
+		{
+			name: "events without a key",
+			give: testEvent{},
+			want: "",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, OrderingKey(tc.give))
+		})
+	}
+}
+
+func TestToCloudWatchEvent(t *testing.T) {
+	got, err := ToCloudWatchEvent(testEvent{Data: "hello"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "event.test", got.DetailType)
+	assert.Equal(t, "commonfate.io/granted", got.Source)
+	assert.JSONEq(t, `{"data":"hello","detailType":"event.test"}`, string(got.Detail))
+}
+
+func TestIsSubscriber(t *testing.T) {
+	assert.True(t, IsSubscriber(EventHandlerSubscriber))
+	assert.True(t, IsSubscriber(SlackNotifierSubscriber))
+	assert.False(t, IsSubscriber("eventhandler"))
+	assert.False(t, IsSubscriber(""))
+}
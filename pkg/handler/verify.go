@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/common-fate/provider-registry-sdk-go/pkg/handlerclient"
+	"github.com/common-fate/provider-registry-sdk-go/pkg/msg"
+)
+
+// RequestTypeVerify asks a handler whether a grant still exists in the provider.
+// Support for verify is optional, handlers which don't support it return an error.
+const RequestTypeVerify msg.RequestType = "verify"
+
+// ErrVerifyNotSupported is returned if the handler doesn't support the verify message.
+var ErrVerifyNotSupported = errors.New("handler does not support verifying grants")
+
+type Verify struct {
+	Subject string            `json:"subject"`
+	Target  msg.Target        `json:"target"`
+	Request msg.AccessRequest `json:"request"`
+	State   map[string]any    `json:"state,omitempty"`
+}
+
+func (Verify) Type() msg.RequestType { return RequestTypeVerify }
+
+type VerifyResponse struct {
+	// Exists is true if the access is still assigned in the provider.
+	Exists bool `json:"exists"`
+}
+
+// unsupportedPatterns match the errors returned by handlers which were built before verify was added.
+var unsupportedPatterns = []string{"unhandled request type", "unsupported request type", "unknown request type", "not implemented"}
+
+// VerifyGrant sends a verify message to the handler.
+func VerifyGrant(ctx context.Context, c *handlerclient.Client, req Verify) (*VerifyResponse, error) {
+	res, err := c.Executor.Execute(ctx, req)
+	if err != nil {
+		msg := strings.ToLower(err.Error())
+		for _, p := range unsupportedPatterns {
+			if strings.Contains(msg, p) {
+				return nil, ErrVerifyNotSupported
+			}
+		}
+		return nil, err
+	}
+	var vr VerifyResponse
+	err = json.Unmarshal(res.Response, &vr)
+	if err != nil {
+		return nil, err
+	}
+	return &vr, nil
+}
@@ -0,0 +1,25 @@
+package identity
+
+import "fmt"
+
+// DeprovisioningMode controls what happens to the access of users who are archived by identity sync.
+type DeprovisioningMode string
+
+const (
+	// DeprovisioningModeEnforce revokes the user's active grants, cancels their pending requests
+	// and removes them as a reviewer of pending requests.
+	DeprovisioningModeEnforce DeprovisioningMode = "enforce"
+	// DeprovisioningModeReport only reports the access which would be cleaned up, and leaves it in place.
+	DeprovisioningModeReport DeprovisioningMode = "report"
+)
+
+// ParseDeprovisioningMode returns the deprovisioning mode, which defaults to report-only if it is not set.
+func ParseDeprovisioningMode(s string) (DeprovisioningMode, error) {
+	switch DeprovisioningMode(s) {
+	case "", DeprovisioningModeReport:
+		return DeprovisioningModeReport, nil
+	case DeprovisioningModeEnforce:
+		return DeprovisioningModeEnforce, nil
+	}
+	return "", fmt.Errorf("invalid deprovisioning mode %q, must be %q or %q", s, DeprovisioningModeEnforce, DeprovisioningModeReport)
+}
@@ -10,12 +10,22 @@ import (
 
 const INTERNAL = "internal"
 
+// SCIM is the source of users and groups which are provisioned through the SCIM API.
+// Scheduled identity sync doesn't change SCIM users and groups.
+const SCIM = "scim"
+
 type IDPGroup struct {
 	ID          string
 	Name        string
 	Description string
 }
 
+// IDPGroupEdge is a nested group in the identity provider: the group with the ID Child is a member of the group with the ID Parent.
+type IDPGroupEdge struct {
+	Parent string
+	Child  string
+}
+
 func (g IDPGroup) ToInternalGroup(source string) Group {
 	now := time.Now()
 	return Group{
@@ -39,11 +49,23 @@ type Group struct {
 	Status      types.IdpStatus `json:"status" dynamodbav:"status"`
 	Users       []string        `json:"users" dynamodbav:"users"`
 	Source      string          `json:"source" dynamodbav:"source"`
+	// ExternalID is the ID of the group in the identity provider which provisioned it through SCIM
+	ExternalID string `json:"externalId,omitempty" dynamodbav:"externalId,omitempty"`
+	// Version is incremented each time the group is written, so that concurrent changes can be detected
+	Version int `json:"-" dynamodbav:"version,omitempty"`
 	// CreatedAt is a read-only field after the request has been created.
 	CreatedAt time.Time `json:"createdAt" dynamodbav:"createdAt"`
 	UpdatedAt time.Time `json:"updatedAt" dynamodbav:"updatedAt"`
 }
 
+func (g *Group) GetVersion() int {
+	return g.Version
+}
+
+func (g *Group) SetVersion(version int) {
+	g.Version = version
+}
+
 func (g *Group) ToAPI() types.Group {
 	req := types.Group{
 		Name:        g.Name,
@@ -0,0 +1,86 @@
+package identitysync
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// attributeMappings maps the names of user attributes in Common Fate to attribute paths in an identity provider.
+// Paths are separated by dots, and can index into lists, such as organizations.0.department.
+type attributeMappings map[string]string
+
+// parseAttributeMappings parses attribute mappings which are configured as name=path pairs separated by commas,
+// such as department=department,employeeType=userType.
+func parseAttributeMappings(key string, s string) (attributeMappings, error) {
+	m := make(attributeMappings)
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		name, path, ok := strings.Cut(pair, "=")
+		name = strings.TrimSpace(name)
+		path = strings.TrimSpace(path)
+		if !ok || name == "" || path == "" {
+			return nil, fmt.Errorf("invalid %s %q, attribute mappings must be name=attribute pairs separated by commas", key, pair)
+		}
+		if _, ok := m[name]; ok {
+			return nil, fmt.Errorf("invalid %s, attribute %q is mapped more than once", key, name)
+		}
+		m[name] = path
+	}
+	return m, nil
+}
+
+// Map returns the mapped attributes of a user, which are read from the user's fields in the identity provider.
+// Attributes which are missing or empty are left out, and nil is returned if there are no attributes.
+func (m attributeMappings) Map(fields map[string]any) map[string]string {
+	var res map[string]string
+	for name, path := range m {
+		v := lookupAttribute(fields, path)
+		if v == "" {
+			continue
+		}
+		if res == nil {
+			res = make(map[string]string)
+		}
+		res[name] = v
+	}
+	return res
+}
+
+// lookupAttribute returns the value at a dot separated path in fields as a string.
+// Values which aren't strings, numbers or booleans are returned as an empty string.
+func lookupAttribute(fields map[string]any, path string) string {
+	var v any = fields
+	for _, part := range strings.Split(path, ".") {
+		switch t := v.(type) {
+		case map[string]any:
+			v = t[part]
+		case []any:
+			i, err := strconv.Atoi(part)
+			if err != nil || i < 0 || i >= len(t) {
+				return ""
+			}
+			v = t[i]
+		default:
+			return ""
+		}
+	}
+	switch t := v.(type) {
+	case string:
+		return t
+	case bool:
+		return strconv.FormatBool(t)
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	case int:
+		return strconv.Itoa(t)
+	case json.Number:
+		return t.String()
+	default:
+		return ""
+	}
+}
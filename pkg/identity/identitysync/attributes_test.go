@@ -0,0 +1,81 @@
+package identitysync
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAttributeMappings(t *testing.T) {
+	type testcase struct {
+		name    string
+		give    string
+		want    attributeMappings
+		wantErr bool
+	}
+	testcases := []testcase{
+		{
+			name: "empty",
+			give: "",
+			want: attributeMappings{},
+		},
+		{
+			name: "ok",
+			give: "department=department, employeeType = organizations.0.type,",
+			want: attributeMappings{"department": "department", "employeeType": "organizations.0.type"},
+		},
+		{
+			name:    "missing attribute",
+			give:    "department",
+			wantErr: true,
+		},
+		{
+			name:    "duplicate",
+			give:    "department=department,department=dept",
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := parseAttributeMappings("attributeMappings", tc.give)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestAttributeMappingsMap(t *testing.T) {
+	fields := map[string]any{
+		"department": "Engineering",
+		"active":     true,
+		"costCenter": float64(1234),
+		"empty":      "",
+		"organizations": []any{
+			map[string]any{"title": "Engineer"},
+		},
+		"custom_attributes": map[string]any{"branch": "Sydney"},
+	}
+	m := attributeMappings{
+		"department": "department",
+		"active":     "active",
+		"costCenter": "costCenter",
+		"title":      "organizations.0.title",
+		"branch":     "custom_attributes.branch",
+		"empty":      "empty",
+		"missing":    "organizations.1.title",
+		"object":     "custom_attributes",
+	}
+	assert.Equal(t, map[string]string{
+		"department": "Engineering",
+		"active":     "true",
+		"costCenter": "1234",
+		"title":      "Engineer",
+		"branch":     "Sydney",
+	}, m.Map(fields))
+
+	assert.Nil(t, attributeMappings{}.Map(fields))
+}
@@ -7,6 +7,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"sort"
+	"strings"
 
 	"github.com/AzureAD/microsoft-authentication-library-for-go/apps/confidential"
 	"github.com/common-fate/common-fate/pkg/gconfig"
@@ -24,6 +27,11 @@ type AzureSync struct {
 	clientID        gconfig.StringValue
 	clientSecret    gconfig.SecretStringValue
 	emailIdentifier gconfig.OptionalStringValue
+	// attributeMappings are the user properties which are synced as user attributes
+	attributeMappings gconfig.OptionalStringValue
+
+	// This is initialised during the Init function call and is not saved in config
+	attributes attributeMappings
 }
 
 func (s *AzureSync) Config() gconfig.Config {
@@ -32,10 +40,16 @@ func (s *AzureSync) Config() gconfig.Config {
 		gconfig.StringField("clientId", &s.clientID, "the Azure AD client ID"),
 		gconfig.OptionalStringField("emailIdentifier", &s.emailIdentifier, "the user attribute to be used as the email address"),
 		gconfig.SecretStringField("clientSecret", &s.clientSecret, "the Azure AD client secret", gconfig.WithNoArgs("/granted/secrets/identity/azure/secret")),
+		gconfig.OptionalStringField("attributeMappings", &s.attributeMappings, "user properties to sync, as name=property pairs separated by commas (eg. department=department,employeeType=employeeType)"),
 	}
 }
 
 func (s *AzureSync) Init(ctx context.Context) error {
+	attributes, err := parseAttributeMappings("attributeMappings", s.attributeMappings.Get())
+	if err != nil {
+		return err
+	}
+	s.attributes = attributes
 	cred, err := confidential.NewCredFromSecret(s.clientSecret.Get())
 	if err != nil {
 		return err
@@ -140,11 +154,14 @@ func (a *AzureSync) idpUserFromAzureUser(ctx context.Context, azureUser map[stri
 	}
 
 	u := identity.IDPUser{
-		ID:        safeMapGet(azureUser, "id"),
-		FirstName: safeMapGet(azureUser, "givenName"),
-		LastName:  safeMapGet(azureUser, "surname"),
-		Email:     safeMapGet(azureUser, emailAttribute),
-		Groups:    userGroups,
+		ID:         safeMapGet(azureUser, "id"),
+		FirstName:  safeMapGet(azureUser, "givenName"),
+		LastName:   safeMapGet(azureUser, "surname"),
+		Email:      safeMapGet(azureUser, emailAttribute),
+		Groups:     userGroups,
+		Attributes: a.attributes.Map(azureUser),
+		// the manager is included when users are listed with $expand=manager
+		Manager: lookupAttribute(azureUser, "manager.id"),
 	}
 
 	if u.Email == "" {
@@ -213,7 +230,7 @@ func (a *AzureSync) ListUsers(ctx context.Context) ([]identity.IDPUser, error) {
 	idpUsers := []identity.IDPUser{}
 	hasMore := true
 	var nextToken *string
-	url := MSGraphBaseURL + "/users"
+	url := a.listUsersURL()
 
 	for hasMore {
 
@@ -261,6 +278,30 @@ func (a *AzureSync) ListUsers(ctx context.Context) ([]identity.IDPUser, error) {
 	return idpUsers, nil
 }
 
+// listUsersURL returns the URL to list users, including their manager.
+// The graph API only returns a default set of properties, so the properties used by attribute mappings are selected.
+func (a *AzureSync) listUsersURL() string {
+	q := url.Values{}
+	q.Set("$expand", "manager($select=id)")
+	if len(a.attributes) > 0 {
+		properties := map[string]bool{"id": true, "givenName": true, "surname": true, "mail": true, "userPrincipalName": true}
+		if a.emailIdentifier.Get() != "" {
+			properties[a.emailIdentifier.Get()] = true
+		}
+		for _, path := range a.attributes {
+			property, _, _ := strings.Cut(path, ".")
+			properties[property] = true
+		}
+		var selected []string
+		for p := range properties {
+			selected = append(selected, p)
+		}
+		sort.Strings(selected)
+		q.Set("$select", strings.Join(selected, ","))
+	}
+	return MSGraphBaseURL + "/users?" + q.Encode()
+}
+
 // idpGroupFromAzureGroup converts a azure group to the identityprovider interface group type
 func idpGroupFromAzureGroup(azureGroup AzureGroup) identity.IDPGroup {
 	return identity.IDPGroup{
@@ -19,6 +19,7 @@ func TestAzureSync_idpUserFromAzureUser(t *testing.T) {
 		clientID        gconfig.StringValue
 		clientSecret    gconfig.SecretStringValue
 		emailIdentifier gconfig.OptionalStringValue
+		attributes      attributeMappings
 	}
 	type args struct {
 		ctx       context.Context
@@ -103,6 +104,32 @@ func TestAzureSync_idpUserFromAzureUser(t *testing.T) {
 				Groups:    []string{"a", "b"},
 			},
 		},
+		{
+			name: "attributes and manager",
+			fields: fields{
+				attributes: attributeMappings{"department": "department", "employeeType": "employeeType"},
+			},
+			args: args{
+				ctx: context.Background(),
+				azureUser: map[string]interface{}{
+					"givenName":         "MOD",
+					"surname":           "Administrator",
+					"userPrincipalName": "admin@contoso.com",
+					"id":                "4562bcc8-c436-4f95-b7c0-4f8ce89dca5e",
+					"department":        "Engineering",
+					"employeeType":      nil,
+					"manager":           map[string]interface{}{"@odata.type": "#microsoft.graph.user", "id": "5f3c1a8e-6d3b-4b8e-9a2c-1d2e3f4a5b6c"},
+				},
+			},
+			want: identity.IDPUser{
+				ID:         "4562bcc8-c436-4f95-b7c0-4f8ce89dca5e",
+				FirstName:  "MOD",
+				LastName:   "Administrator",
+				Email:      "admin@contoso.com",
+				Attributes: map[string]string{"department": "Engineering"},
+				Manager:    "5f3c1a8e-6d3b-4b8e-9a2c-1d2e3f4a5b6c",
+			},
+		},
 		{
 			name: "invalid email identifier",
 			fields: fields{
@@ -130,6 +157,7 @@ func TestAzureSync_idpUserFromAzureUser(t *testing.T) {
 				clientID:        tt.fields.clientID,
 				clientSecret:    tt.fields.clientSecret,
 				emailIdentifier: tt.fields.emailIdentifier,
+				attributes:      tt.fields.attributes,
 			}
 			got, err := a.idpUserFromAzureUser(tt.args.ctx, tt.args.azureUser, tt.args.groups)
 			if (err != nil) != tt.wantErr {
@@ -0,0 +1,177 @@
+package identitysync
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/types"
+)
+
+// ArchiveThresholdError is returned when a sync is aborted because it would archive more than the
+// threshold percentage of the active users or groups.
+// This usually means that the identity provider returned incomplete results, for example
+// because of a misconfigured filter, rather than that the users were removed.
+type ArchiveThresholdError struct {
+	// Threshold is the maximum percentage of users or groups which can be archived
+	Threshold      int
+	ArchivedUsers  int
+	ActiveUsers    int
+	ArchivedGroups int
+	ActiveGroups   int
+}
+
+func (e *ArchiveThresholdError) Error() string {
+	return fmt.Sprintf("identity sync aborted because it would archive %d of %d users and %d of %d groups, which is more than the threshold of %d%%. Run the sync with force to apply the changes",
+		e.ArchivedUsers, e.ActiveUsers, e.ArchivedGroups, e.ActiveGroups, e.Threshold)
+}
+
+// checkArchiveThreshold returns an ArchiveThresholdError if the changes archive more than threshold percent of
+// the active users or groups which are managed by identity sync. A threshold of 0 disables the check.
+func checkArchiveThreshold(threshold int, internalUsers []identity.User, internalGroups []identity.Group, changes identity.SyncChanges) error {
+	if threshold <= 0 {
+		return nil
+	}
+	e := &ArchiveThresholdError{
+		Threshold:      threshold,
+		ArchivedUsers:  len(changes.ArchivedUsers),
+		ArchivedGroups: len(changes.ArchivedGroups),
+	}
+	for _, u := range internalUsers {
+		if u.Status == types.IdpStatusACTIVE && u.Source != identity.SCIM {
+			e.ActiveUsers++
+		}
+	}
+	for _, g := range internalGroups {
+		if g.Status == types.IdpStatusACTIVE && !managedOutsideSync(g.Source) {
+			e.ActiveGroups++
+		}
+	}
+
+	exceeds := func(archived, active int) bool {
+		return active > 0 && archived*100 > threshold*active
+	}
+	if exceeds(e.ArchivedUsers, e.ActiveUsers) || exceeds(e.ArchivedGroups, e.ActiveGroups) {
+		return e
+	}
+	return nil
+}
+
+// diffUsersAndGroups compares the users and groups in the database with the result of processUsersAndGroups,
+// and returns the users and groups which are created, updated and archived by the sync.
+func diffUsersAndGroups(internalUsers []identity.User, internalGroups []identity.Group, users map[string]identity.User, groups map[string]identity.Group) identity.SyncChanges {
+	var changes identity.SyncChanges
+
+	// processUsersAndGroups keys users by email and groups by their identity provider ID
+	existingUsers := make(map[string]identity.User)
+	for _, u := range internalUsers {
+		existingUsers[u.Email] = u
+	}
+	for email, u := range users {
+		existing, ok := existingUsers[email]
+		switch {
+		case !ok:
+			changes.CreatedUsers = append(changes.CreatedUsers, u)
+		case existing.Status == types.IdpStatusACTIVE && u.Status == types.IdpStatusARCHIVED:
+			changes.ArchivedUsers = append(changes.ArchivedUsers, u)
+		case userChanged(existing, u):
+			changes.UpdatedUsers = append(changes.UpdatedUsers, u)
+		}
+	}
+
+	existingGroups := make(map[string]identity.Group)
+	for _, g := range internalGroups {
+		existingGroups[g.IdpID] = g
+	}
+	for idpID, g := range groups {
+		existing, ok := existingGroups[idpID]
+		switch {
+		case !ok:
+			changes.CreatedGroups = append(changes.CreatedGroups, g)
+		case existing.Status == types.IdpStatusACTIVE && g.Status == types.IdpStatusARCHIVED:
+			changes.ArchivedGroups = append(changes.ArchivedGroups, g)
+		case groupChanged(existing, g):
+			changes.UpdatedGroups = append(changes.UpdatedGroups, g)
+		}
+	}
+
+	for _, u := range [][]identity.User{changes.CreatedUsers, changes.UpdatedUsers, changes.ArchivedUsers} {
+		sort.Slice(u, func(i, j int) bool { return u[i].Email < u[j].Email })
+	}
+	for _, g := range [][]identity.Group{changes.CreatedGroups, changes.UpdatedGroups, changes.ArchivedGroups} {
+		sort.Slice(g, func(i, j int) bool { return g[i].IdpID < g[j].IdpID })
+	}
+	return changes
+}
+
+func userChanged(a, b identity.User) bool {
+	return a.FirstName != b.FirstName ||
+		a.LastName != b.LastName ||
+		a.Status != b.Status ||
+		a.ManagerID != b.ManagerID ||
+		!sameElements(a.Groups, b.Groups) ||
+		!sameAttributes(a.Attributes, b.Attributes)
+}
+
+func groupChanged(a, b identity.Group) bool {
+	return a.Name != b.Name ||
+		a.Description != b.Description ||
+		a.Status != b.Status ||
+		a.Source != b.Source ||
+		!sameElements(a.Users, b.Users)
+}
+
+// usersEqual returns true if writing b would store the same user as a.
+// Unlike userChanged, every field is compared, and lists are compared in any order.
+func usersEqual(a, b identity.User) bool {
+	if !sameElements(a.Groups, b.Groups) || !sameElements(a.AccessRules, b.AccessRules) || !sameElements(a.IdentitySources, b.IdentitySources) || !sameAttributes(a.Attributes, b.Attributes) {
+		return false
+	}
+	a.Groups, b.Groups = nil, nil
+	a.AccessRules, b.AccessRules = nil, nil
+	a.IdentitySources, b.IdentitySources = nil, nil
+	a.Attributes, b.Attributes = nil, nil
+	if len(a.GroupPaths) == 0 && len(b.GroupPaths) == 0 {
+		a.GroupPaths, b.GroupPaths = nil, nil
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// groupsEqual returns true if writing b would store the same group as a. Members are compared in any order.
+func groupsEqual(a, b identity.Group) bool {
+	if !sameElements(a.Users, b.Users) {
+		return false
+	}
+	a.Users, b.Users = nil, nil
+	return reflect.DeepEqual(a, b)
+}
+
+// sameAttributes returns true if a and b contain the same attributes. Nil and empty maps are equal.
+func sameAttributes(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if w, ok := b[k]; !ok || v != w {
+			return false
+		}
+	}
+	return true
+}
+
+// sameElements returns true if a and b contain the same distinct elements, in any order.
+func sameElements(a, b []string) bool {
+	set := make(map[string]bool, len(a))
+	for _, v := range a {
+		set[v] = true
+	}
+	other := make(map[string]bool, len(b))
+	for _, v := range b {
+		if !set[v] {
+			return false
+		}
+		other[v] = true
+	}
+	return len(set) == len(other)
+}
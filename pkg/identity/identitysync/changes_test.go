@@ -0,0 +1,132 @@
+package identitysync
+
+import (
+	"testing"
+
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffUsersAndGroups(t *testing.T) {
+	internalUsers := []identity.User{
+		{ID: "u1", Email: "alice@example.com", FirstName: "Alice", Groups: []string{"g1", "g2"}, Status: types.IdpStatusACTIVE},
+		{ID: "u2", Email: "bob@example.com", FirstName: "Bob", Groups: []string{"g1"}, Status: types.IdpStatusACTIVE},
+		{ID: "u3", Email: "carol@example.com", FirstName: "Carol", Groups: []string{"g1"}, Status: types.IdpStatusACTIVE},
+		{ID: "u4", Email: "dan@example.com", FirstName: "Dan", Status: types.IdpStatusARCHIVED},
+		{ID: "u6", Email: "frank@example.com", FirstName: "Frank", Attributes: map[string]string{"department": "sales"}, Status: types.IdpStatusACTIVE},
+	}
+	internalGroups := []identity.Group{
+		{ID: "g1", IdpID: "g1", Name: "engineering", Users: []string{"u1", "u2", "u3"}, Status: types.IdpStatusACTIVE, Source: "okta"},
+		{ID: "g2", IdpID: "g2", Name: "admins", Users: []string{"u1"}, Status: types.IdpStatusACTIVE, Source: "okta"},
+	}
+
+	users := map[string]identity.User{
+		// group memberships in a different order aren't a change
+		"alice@example.com": {ID: "u1", Email: "alice@example.com", FirstName: "Alice", Groups: []string{"g2", "g1"}, Status: types.IdpStatusACTIVE},
+		"bob@example.com":   {ID: "u2", Email: "bob@example.com", FirstName: "Robert", Groups: []string{"g1"}, Status: types.IdpStatusACTIVE},
+		"carol@example.com": {ID: "u3", Email: "carol@example.com", FirstName: "Carol", Groups: []string{}, Status: types.IdpStatusARCHIVED},
+		"dan@example.com":   {ID: "u4", Email: "dan@example.com", FirstName: "Dan", Status: types.IdpStatusARCHIVED},
+		"eve@example.com":   {ID: "u5", Email: "eve@example.com", FirstName: "Eve", Groups: []string{"g3"}, Status: types.IdpStatusACTIVE},
+		"frank@example.com": {ID: "u6", Email: "frank@example.com", FirstName: "Frank", Attributes: map[string]string{"department": "engineering"}, ManagerID: "u1", Status: types.IdpStatusACTIVE},
+	}
+	groups := map[string]identity.Group{
+		"g1": {ID: "g1", IdpID: "g1", Name: "engineering", Users: []string{"u2", "u1"}, Status: types.IdpStatusACTIVE, Source: "okta"},
+		"g2": {ID: "g2", IdpID: "g2", Name: "admins", Users: []string{}, Status: types.IdpStatusARCHIVED, Source: "okta"},
+		"g3": {ID: "g3", IdpID: "g3", Name: "security", Users: []string{"u5"}, Status: types.IdpStatusACTIVE, Source: "okta"},
+	}
+
+	got := diffUsersAndGroups(internalUsers, internalGroups, users, groups)
+	assert.Equal(t, identity.SyncChanges{
+		CreatedUsers:   []identity.User{users["eve@example.com"]},
+		UpdatedUsers:   []identity.User{users["bob@example.com"], users["frank@example.com"]},
+		ArchivedUsers:  []identity.User{users["carol@example.com"]},
+		CreatedGroups:  []identity.Group{groups["g3"]},
+		UpdatedGroups:  []identity.Group{groups["g1"]},
+		ArchivedGroups: []identity.Group{groups["g2"]},
+	}, got)
+	assert.Equal(t, identity.SyncChangeCounts{
+		UsersCreated:   1,
+		UsersUpdated:   2,
+		UsersArchived:  1,
+		GroupsCreated:  1,
+		GroupsUpdated:  1,
+		GroupsArchived: 1,
+	}, got.Counts())
+}
+
+func TestCheckArchiveThreshold(t *testing.T) {
+	activeUsers := func(n int, source string) []identity.User {
+		var users []identity.User
+		for i := 0; i < n; i++ {
+			users = append(users, identity.User{Status: types.IdpStatusACTIVE, Source: source})
+		}
+		return users
+	}
+	archived := func(n int) []identity.User {
+		return make([]identity.User, n)
+	}
+
+	type testcase struct {
+		name      string
+		threshold int
+		users     []identity.User
+		groups    []identity.Group
+		changes   identity.SyncChanges
+		wantErr   bool
+	}
+	testcases := []testcase{
+		{
+			name:      "below threshold",
+			threshold: 50,
+			users:     activeUsers(10, ""),
+			changes:   identity.SyncChanges{ArchivedUsers: archived(5)},
+		},
+		{
+			name:      "above threshold",
+			threshold: 50,
+			users:     activeUsers(10, ""),
+			changes:   identity.SyncChanges{ArchivedUsers: archived(6)},
+			wantErr:   true,
+		},
+		{
+			name:      "disabled",
+			threshold: 0,
+			users:     activeUsers(10, ""),
+			changes:   identity.SyncChanges{ArchivedUsers: archived(10)},
+		},
+		{
+			name:      "users provisioned by SCIM are not counted",
+			threshold: 50,
+			users:     append(activeUsers(2, ""), activeUsers(8, identity.SCIM)...),
+			changes:   identity.SyncChanges{ArchivedUsers: archived(2)},
+			wantErr:   true,
+		},
+		{
+			name:      "groups above threshold",
+			threshold: 25,
+			users:     activeUsers(10, ""),
+			groups: []identity.Group{
+				{Status: types.IdpStatusACTIVE, Source: "okta"},
+				{Status: types.IdpStatusACTIVE, Source: "okta"},
+				{Status: types.IdpStatusACTIVE, Source: identity.INTERNAL},
+			},
+			changes: identity.SyncChanges{ArchivedGroups: []identity.Group{{}}},
+			wantErr: true,
+		},
+		{
+			name:      "no existing users",
+			threshold: 50,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkArchiveThreshold(tc.threshold, tc.users, tc.groups, tc.changes)
+			if tc.wantErr {
+				assert.IsType(t, &ArchiveThresholdError{}, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
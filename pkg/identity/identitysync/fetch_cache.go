@@ -0,0 +1,40 @@
+package identitysync
+
+import (
+	"context"
+	"sync"
+)
+
+type fetchCacheContextKey struct{}
+
+// fetchCache holds results which identity providers reuse between the calls made while fetching a single source,
+// such as a SCIM provider which needs the groups for ListUsers, ListGroups and ListGroupEdges.
+type fetchCache struct {
+	mu     sync.Mutex
+	values map[any]any
+}
+
+// withFetchCache returns a context which identity providers can cache results on with cachedFetch.
+func withFetchCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, fetchCacheContextKey{}, &fetchCache{values: map[any]any{}})
+}
+
+// cachedFetch returns the result of fetch, reusing an earlier result for the same key if the context was created with withFetchCache.
+// Errors are not cached.
+func cachedFetch[T any](ctx context.Context, key any, fetch func() (T, error)) (T, error) {
+	c, ok := ctx.Value(fetchCacheContextKey{}).(*fetchCache)
+	if !ok {
+		return fetch()
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if v, ok := c.values[key]; ok {
+		return v.(T), nil
+	}
+	v, err := fetch()
+	if err != nil {
+		return v, err
+	}
+	c.values[key] = v
+	return v, nil
+}
@@ -2,6 +2,7 @@ package identitysync
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/common-fate/common-fate/pkg/gconfig"
 	"github.com/common-fate/common-fate/pkg/identity"
@@ -12,10 +13,14 @@ import (
 )
 
 type GoogleSync struct {
-	client     *admin.Service
-	domain     gconfig.StringValue
-	adminEmail gconfig.StringValue
-	apiToken   gconfig.SecretStringValue
+	client            *admin.Service
+	domain            gconfig.StringValue
+	adminEmail        gconfig.StringValue
+	apiToken          gconfig.SecretStringValue
+	attributeMappings gconfig.OptionalStringValue
+
+	// This is initialised during the Init function call and is not saved in config
+	attributes attributeMappings
 }
 
 func (s *GoogleSync) Config() gconfig.Config {
@@ -23,10 +28,16 @@ func (s *GoogleSync) Config() gconfig.Config {
 		gconfig.StringField("domain", &s.domain, "the Google domain"),
 		gconfig.StringField("adminEmail", &s.adminEmail, "the Google admin email"),
 		gconfig.SecretStringField("apiToken", &s.apiToken, "the Google API token", gconfig.WithNoArgs("/granted/secrets/identity/google/token"), gconfig.WithCLIPrompt(gconfig.CLIPromptTypeFile)),
+		gconfig.OptionalStringField("attributeMappings", &s.attributeMappings, "user fields to sync, as name=field pairs separated by commas (eg. department=organizations.0.department,employeeType=customSchemas.HR.employeeType)"),
 	}
 }
 
 func (s *GoogleSync) Init(ctx context.Context) error {
+	attributes, err := parseAttributeMappings("attributeMappings", s.attributeMappings.Get())
+	if err != nil {
+		return err
+	}
+	s.attributes = attributes
 	config, err := google.JWTConfigFromJSON([]byte(s.apiToken.Get()), admin.AdminDirectoryUserReadonlyScope, admin.AdminDirectoryGroupReadonlyScope)
 	if err != nil {
 		return err
@@ -81,7 +92,12 @@ func (c *GoogleSync) ListUsers(ctx context.Context) ([]identity.IDPUser, error)
 	var paginationToken string
 	for hasMore {
 
-		userRes, err := c.client.Users.List().Domain(c.domain.Get()).PageToken(paginationToken).Do()
+		call := c.client.Users.List().Domain(c.domain.Get()).PageToken(paginationToken)
+		if len(c.attributes) > 0 {
+			// custom schemas are only returned with the full projection
+			call = call.Projection("full")
+		}
+		userRes, err := call.Do()
 		if err != nil {
 			return nil, err
 		}
@@ -100,14 +116,47 @@ func (c *GoogleSync) ListUsers(ctx context.Context) ([]identity.IDPUser, error)
 
 }
 
+// ListGroupEdges returns the groups which are members of other groups.
+func (c *GoogleSync) ListGroupEdges(ctx context.Context) ([]identity.IDPGroupEdge, error) {
+	groups, err := c.ListGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	edges := []identity.IDPGroupEdge{}
+	for _, g := range groups {
+		hasMore := true
+		var paginationToken string
+		for hasMore {
+			members, err := c.client.Members.List(g.ID).PageToken(paginationToken).Do()
+			if err != nil {
+				return nil, err
+			}
+			for _, m := range members.Members {
+				if m.Type == "GROUP" {
+					edges = append(edges, identity.IDPGroupEdge{Parent: g.ID, Child: m.Id})
+				}
+			}
+			paginationToken = members.NextPageToken
+			hasMore = paginationToken != ""
+		}
+	}
+	return edges, nil
+}
+
 // idpUserFromGoogleUser converts a Google user to the identityprovider interface user type
 func (c *GoogleSync) idpUserFromGoogleUser(ctx context.Context, googleUser *admin.User) (identity.IDPUser, error) {
+	fields, err := googleUserFields(googleUser)
+	if err != nil {
+		return identity.IDPUser{}, err
+	}
 	u := identity.IDPUser{
-		ID:        googleUser.Id,
-		FirstName: googleUser.Name.GivenName,
-		LastName:  googleUser.Name.FamilyName,
-		Email:     googleUser.PrimaryEmail,
-		Groups:    []string{},
+		ID:         googleUser.Id,
+		FirstName:  googleUser.Name.GivenName,
+		LastName:   googleUser.Name.FamilyName,
+		Email:      googleUser.PrimaryEmail,
+		Groups:     []string{},
+		Attributes: c.attributes.Map(fields),
+		Manager:    googleUserManager(fields),
 	}
 
 	userGroups, err := c.client.Groups.List().UserKey(googleUser.Id).Do()
@@ -122,6 +171,29 @@ func (c *GoogleSync) idpUserFromGoogleUser(ctx context.Context, googleUser *admi
 	return u, nil
 }
 
+// googleUserFields returns the fields of a Google user, so that they can be looked up by attribute mappings.
+func googleUserFields(googleUser *admin.User) (map[string]any, error) {
+	b, err := json.Marshal(googleUser)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]any
+	err = json.Unmarshal(b, &fields)
+	return fields, err
+}
+
+// googleUserManager returns the email of the user's manager, which is a relation of the user with the type manager.
+func googleUserManager(fields map[string]any) string {
+	relations, _ := fields["relations"].([]any)
+	for _, r := range relations {
+		relation, ok := r.(map[string]any)
+		if ok && relation["type"] == "manager" {
+			return lookupAttribute(relation, "value")
+		}
+	}
+	return ""
+}
+
 // idpGroupFromGoogleGroup converts a google group to the identityprovider interface group type
 func idpGroupFromGoogleGroup(googleGroup *admin.Group) identity.IDPGroup {
 	return identity.IDPGroup{
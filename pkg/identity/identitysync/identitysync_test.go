@@ -54,6 +54,14 @@ func TestIntegration(t *testing.T) {
 			Name:    "AWS SSO ok",
 			idpType: "aws-sso",
 		},
+		{
+			Name:    "LDAP ok",
+			idpType: "ldap",
+		},
+		{
+			Name:    "Generic SCIM ok",
+			idpType: "generic-scim",
+		},
 	}
 
 	for _, tc := range testcases {
@@ -0,0 +1,446 @@
+package identitysync
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/common-fate/pkg/gconfig"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/go-ldap/ldap/v3"
+	"github.com/pkg/errors"
+)
+
+// dnAttribute can be used as an ID attribute to identify users and groups by their distinguished name.
+const dnAttribute = "dn"
+
+// LDAPSync syncs users and groups from an LDAP directory such as Active Directory or OpenLDAP.
+//
+// Group membership is read from both the member attribute of groups and the memberOf attribute of users,
+// as Active Directory truncates the member attribute of very large groups.
+// Users are members of every group which their groups are nested in.
+type LDAPSync struct {
+	url          gconfig.StringValue
+	bindDN       gconfig.StringValue
+	bindPassword gconfig.SecretStringValue
+	userBaseDN   gconfig.StringValue
+	groupBaseDN  gconfig.OptionalStringValue
+	userFilter   gconfig.OptionalStringValue
+	groupFilter  gconfig.OptionalStringValue
+	pageSize     gconfig.OptionalStringValue
+
+	userIDAttribute           gconfig.OptionalStringValue
+	emailAttribute            gconfig.OptionalStringValue
+	firstNameAttribute        gconfig.OptionalStringValue
+	lastNameAttribute         gconfig.OptionalStringValue
+	memberOfAttribute         gconfig.OptionalStringValue
+	groupIDAttribute          gconfig.OptionalStringValue
+	groupNameAttribute        gconfig.OptionalStringValue
+	groupDescriptionAttribute gconfig.OptionalStringValue
+	groupMemberAttribute      gconfig.OptionalStringValue
+	binaryAttributes          gconfig.OptionalStringValue
+}
+
+const (
+	defaultLDAPUserFilter  = "(&(objectClass=person)(mail=*))"
+	defaultLDAPGroupFilter = "(|(objectClass=group)(objectClass=groupOfNames)(objectClass=groupOfUniqueNames))"
+	defaultLDAPPageSize    = "500"
+	// defaultLDAPBinaryAttributes are the Active Directory attributes which hold binary values
+	defaultLDAPBinaryAttributes = "objectGUID,objectSid"
+)
+
+func (s *LDAPSync) Config() gconfig.Config {
+	return gconfig.Config{
+		gconfig.StringField("url", &s.url, "the LDAP server URL (eg. ldaps://ad.example.com:636)"),
+		gconfig.StringField("bindDn", &s.bindDN, "the DN of the account used to read the directory"),
+		gconfig.SecretStringField("bindPassword", &s.bindPassword, "the password of the bind account", gconfig.WithNoArgs("/granted/secrets/identity/ldap/password")),
+		gconfig.StringField("userBaseDn", &s.userBaseDN, "the base DN to search for users in, separate multiple DNs with ';'"),
+		gconfig.OptionalStringField("groupBaseDn", &s.groupBaseDN, "the base DN to search for groups in, separate multiple DNs with ';' (defaults to the user base DN)"),
+		gconfig.OptionalStringField("userFilter", &s.userFilter, "the LDAP filter for users", gconfig.WithDefaultFunc(func() string { return defaultLDAPUserFilter })),
+		gconfig.OptionalStringField("groupFilter", &s.groupFilter, "the LDAP filter for groups", gconfig.WithDefaultFunc(func() string { return defaultLDAPGroupFilter })),
+		gconfig.OptionalStringField("pageSize", &s.pageSize, "the number of entries to fetch in each page of search results", gconfig.WithDefaultFunc(func() string { return defaultLDAPPageSize })),
+		gconfig.OptionalStringField("userIdAttribute", &s.userIDAttribute, "the attribute which uniquely identifies a user, or 'dn' to use the distinguished name (eg. objectGUID)", gconfig.WithDefaultFunc(func() string { return dnAttribute })),
+		gconfig.OptionalStringField("emailAttribute", &s.emailAttribute, "the attribute containing the user's email", gconfig.WithDefaultFunc(func() string { return "mail" })),
+		gconfig.OptionalStringField("firstNameAttribute", &s.firstNameAttribute, "the attribute containing the user's first name", gconfig.WithDefaultFunc(func() string { return "givenName" })),
+		gconfig.OptionalStringField("lastNameAttribute", &s.lastNameAttribute, "the attribute containing the user's last name", gconfig.WithDefaultFunc(func() string { return "sn" })),
+		gconfig.OptionalStringField("memberOfAttribute", &s.memberOfAttribute, "the attribute containing the DNs of the groups a user or group belongs to", gconfig.WithDefaultFunc(func() string { return "memberOf" })),
+		gconfig.OptionalStringField("groupIdAttribute", &s.groupIDAttribute, "the attribute which uniquely identifies a group, or 'dn' to use the distinguished name (eg. objectGUID)", gconfig.WithDefaultFunc(func() string { return dnAttribute })),
+		gconfig.OptionalStringField("groupNameAttribute", &s.groupNameAttribute, "the attribute containing the group's name", gconfig.WithDefaultFunc(func() string { return "cn" })),
+		gconfig.OptionalStringField("groupDescriptionAttribute", &s.groupDescriptionAttribute, "the attribute containing the group's description", gconfig.WithDefaultFunc(func() string { return "description" })),
+		gconfig.OptionalStringField("groupMemberAttribute", &s.groupMemberAttribute, "the attribute containing the DNs of the group's members (eg. member or uniqueMember)", gconfig.WithDefaultFunc(func() string { return "member" })),
+		gconfig.OptionalStringField("binaryAttributes", &s.binaryAttributes, "the attributes which hold binary values, separated by ','. IDs read from these attributes are hex encoded", gconfig.WithDefaultFunc(func() string { return defaultLDAPBinaryAttributes })),
+	}
+}
+
+func (s *LDAPSync) Init(ctx context.Context) error {
+	u, err := url.Parse(s.url.Get())
+	if err != nil {
+		return errors.Wrap(err, "parsing LDAP url")
+	}
+	if u.Scheme != "ldap" && u.Scheme != "ldaps" {
+		return fmt.Errorf("LDAP url must start with ldap:// or ldaps://, got %s", s.url.Get())
+	}
+	_, err = s.getPageSize()
+	return err
+}
+
+// TestConfig checks that the bind account can log in and that the base DNs exist.
+func (s *LDAPSync) TestConfig(ctx context.Context) error {
+	conn, closeConn, err := s.connect(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to bind while testing LDAP identity provider configuration")
+	}
+	defer closeConn()
+
+	for _, base := range append(s.userBaseDNs(), s.groupBaseDNs()...) {
+		req := ldap.NewSearchRequest(base, ldap.ScopeBaseObject, ldap.NeverDerefAliases, 1, 0, false, "(objectClass=*)", []string{"1.1"}, nil)
+		_, err := conn.Search(req)
+		if err != nil {
+			return errors.Wrapf(err, "failed to find base DN %s while testing LDAP identity provider configuration", base)
+		}
+	}
+	return nil
+}
+
+// connect dials the LDAP server and binds with the configured account.
+// The connection honours the deadline of ctx, and is closed if ctx is cancelled so that a search in progress returns.
+// The returned func must be called to close the connection.
+func (s *LDAPSync) connect(ctx context.Context) (*ldap.Conn, func(), error) {
+	u, err := url.Parse(s.url.Get())
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "parsing LDAP url")
+	}
+	port := u.Port()
+	if port == "" {
+		port = ldap.DefaultLdapPort
+		if u.Scheme == "ldaps" {
+			port = ldap.DefaultLdapsPort
+		}
+	}
+
+	d := net.Dialer{Timeout: ldap.DefaultTimeout}
+	raw, err := d.DialContext(ctx, "tcp", net.JoinHostPort(u.Hostname(), port))
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "connecting to LDAP server")
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		err = raw.SetDeadline(deadline)
+		if err != nil {
+			raw.Close()
+			return nil, nil, errors.Wrap(err, "connecting to LDAP server")
+		}
+	}
+	if u.Scheme == "ldaps" {
+		tlsConn := tls.Client(raw, &tls.Config{ServerName: u.Hostname()})
+		err = tlsConn.HandshakeContext(ctx)
+		if err != nil {
+			raw.Close()
+			return nil, nil, errors.Wrap(err, "connecting to LDAP server")
+		}
+		raw = tlsConn
+	}
+
+	conn := ldap.NewConn(raw, u.Scheme == "ldaps")
+	conn.Start()
+	conn.SetTimeout(time.Minute)
+
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-done:
+		}
+	}()
+	closeConn := func() {
+		close(done)
+		conn.Close()
+	}
+
+	err = conn.Bind(s.bindDN.Get(), s.bindPassword.Get())
+	if err != nil {
+		closeConn()
+		if ctx.Err() != nil {
+			return nil, nil, ctx.Err()
+		}
+		return nil, nil, errors.Wrapf(err, "binding to LDAP server as %s", s.bindDN.Get())
+	}
+	return conn, closeConn, nil
+}
+
+func (s *LDAPSync) ListUsers(ctx context.Context) ([]identity.IDPUser, error) {
+	log := logger.Get(ctx)
+	conn, closeConn, err := s.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer closeConn()
+
+	groups, err := s.searchGroups(conn)
+	if err != nil {
+		return nil, err
+	}
+	log.Debugw("listed LDAP groups for resolving membership", "count", len(groups.byDN))
+
+	emailAttr := orDefault(s.emailAttribute, "mail")
+	firstNameAttr := orDefault(s.firstNameAttribute, "givenName")
+	lastNameAttr := orDefault(s.lastNameAttribute, "sn")
+	memberOfAttr := orDefault(s.memberOfAttribute, "memberOf")
+	idAttr := orDefault(s.userIDAttribute, dnAttribute)
+
+	entries, err := s.search(conn, s.userBaseDNs(), orDefault(s.userFilter, defaultLDAPUserFilter), []string{idAttr, emailAttr, firstNameAttr, lastNameAttr, memberOfAttr})
+	if err != nil {
+		return nil, errors.Wrap(err, "listing LDAP users")
+	}
+
+	idpUsers := []identity.IDPUser{}
+	for _, e := range entries {
+		email := e.GetEqualFoldAttributeValue(emailAttr)
+		if email == "" {
+			log.Warnw("skipping LDAP user with no email", "dn", e.DN)
+			continue
+		}
+		id := entryID(e, idAttr, s.isBinary(idAttr))
+		if id == "" {
+			log.Warnw("skipping LDAP user with no ID attribute", "dn", e.DN, "attribute", idAttr)
+			continue
+		}
+
+		direct := groups.membersOf[dnKey(e.DN)]
+		for _, dn := range e.GetEqualFoldAttributeValues(memberOfAttr) {
+			direct = append(direct, dnKey(dn))
+		}
+
+		u := identity.IDPUser{
+			ID:        id,
+			Email:     email,
+			FirstName: e.GetEqualFoldAttributeValue(firstNameAttr),
+			LastName:  e.GetEqualFoldAttributeValue(lastNameAttr),
+			Groups:    groups.resolve(direct),
+		}
+		idpUsers = append(idpUsers, u)
+	}
+	return idpUsers, nil
+}
+
+func (s *LDAPSync) ListGroups(ctx context.Context) ([]identity.IDPGroup, error) {
+	conn, closeConn, err := s.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer closeConn()
+
+	groups, err := s.searchGroups(conn)
+	if err != nil {
+		return nil, err
+	}
+	idpGroups := []identity.IDPGroup{}
+	for _, g := range groups.byDN {
+		idpGroups = append(idpGroups, g.group)
+	}
+	sort.Slice(idpGroups, func(i, j int) bool { return idpGroups[i].Name < idpGroups[j].Name })
+	return idpGroups, nil
+}
+
+type ldapGroup struct {
+	group identity.IDPGroup
+	// parents are the DN keys of the groups which this group is nested in
+	parents []string
+}
+
+// ldapGroups is the group hierarchy of the directory, keyed by normalised DN.
+type ldapGroups struct {
+	byDN map[string]ldapGroup
+	// membersOf maps the DN key of a member to the DN keys of the groups which list it as a member
+	membersOf map[string][]string
+}
+
+func (s *LDAPSync) searchGroups(conn *ldap.Conn) (*ldapGroups, error) {
+	idAttr := orDefault(s.groupIDAttribute, dnAttribute)
+	nameAttr := orDefault(s.groupNameAttribute, "cn")
+	descriptionAttr := orDefault(s.groupDescriptionAttribute, "description")
+	memberAttr := orDefault(s.groupMemberAttribute, "member")
+	memberOfAttr := orDefault(s.memberOfAttribute, "memberOf")
+
+	entries, err := s.search(conn, s.groupBaseDNs(), orDefault(s.groupFilter, defaultLDAPGroupFilter), []string{idAttr, nameAttr, descriptionAttr, memberAttr, memberOfAttr})
+	if err != nil {
+		return nil, errors.Wrap(err, "listing LDAP groups")
+	}
+
+	groups := ldapGroups{
+		byDN:      make(map[string]ldapGroup),
+		membersOf: make(map[string][]string),
+	}
+	for _, e := range entries {
+		id := entryID(e, idAttr, s.isBinary(idAttr))
+		if id == "" {
+			continue
+		}
+		key := dnKey(e.DN)
+		name := e.GetEqualFoldAttributeValue(nameAttr)
+		if name == "" {
+			name = e.DN
+		}
+		g := ldapGroup{
+			group: identity.IDPGroup{
+				ID:          id,
+				Name:        name,
+				Description: e.GetEqualFoldAttributeValue(descriptionAttr),
+			},
+		}
+		for _, dn := range e.GetEqualFoldAttributeValues(memberOfAttr) {
+			g.parents = append(g.parents, dnKey(dn))
+		}
+		groups.byDN[key] = g
+		for _, member := range e.GetEqualFoldAttributeValues(memberAttr) {
+			m := dnKey(member)
+			groups.membersOf[m] = append(groups.membersOf[m], key)
+		}
+	}
+
+	// nested groups are listed as members of their parent groups
+	for key, g := range groups.byDN {
+		g.parents = append(g.parents, groups.membersOf[key]...)
+		groups.byDN[key] = g
+	}
+	return &groups, nil
+}
+
+// resolve returns the IDs of the groups, and of every group which they are nested in.
+// Groups which weren't found by the group search are ignored.
+func (g *ldapGroups) resolve(dnKeys []string) []string {
+	seen := map[string]bool{}
+	ids := []string{}
+	queue := append([]string{}, dnKeys...)
+	for len(queue) > 0 {
+		key := queue[0]
+		queue = queue[1:]
+		// nested groups may contain cycles, so each group is only visited once
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		group, ok := g.byDN[key]
+		if !ok {
+			continue
+		}
+		ids = append(ids, group.group.ID)
+		queue = append(queue, group.parents...)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// search runs a paged search in each of the base DNs.
+func (s *LDAPSync) search(conn *ldap.Conn, baseDNs []string, filter string, attributes []string) ([]*ldap.Entry, error) {
+	pageSize, err := s.getPageSize()
+	if err != nil {
+		return nil, err
+	}
+	var entries []*ldap.Entry
+	for _, base := range baseDNs {
+		req := ldap.NewSearchRequest(base, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 0, 0, false, filter, withoutDN(attributes), nil)
+		res, err := conn.SearchWithPaging(req, pageSize)
+		if err != nil {
+			return nil, errors.Wrapf(err, "searching %s", base)
+		}
+		entries = append(entries, res.Entries...)
+	}
+	return entries, nil
+}
+
+func (s *LDAPSync) getPageSize() (uint32, error) {
+	size, err := strconv.ParseUint(orDefault(s.pageSize, defaultLDAPPageSize), 10, 32)
+	if err != nil || size == 0 {
+		return 0, fmt.Errorf("invalid LDAP page size %q", s.pageSize.Get())
+	}
+	return uint32(size), nil
+}
+
+func (s *LDAPSync) userBaseDNs() []string {
+	return splitDNs(s.userBaseDN.Get())
+}
+
+func (s *LDAPSync) groupBaseDNs() []string {
+	if s.groupBaseDN.Get() == "" {
+		return s.userBaseDNs()
+	}
+	return splitDNs(s.groupBaseDN.Get())
+}
+
+func splitDNs(dns string) []string {
+	var res []string
+	for _, dn := range strings.Split(dns, ";") {
+		if dn = strings.TrimSpace(dn); dn != "" {
+			res = append(res, dn)
+		}
+	}
+	return res
+}
+
+// orDefault returns the value of an optional config field, or the default if it isn't set.
+func orDefault(v gconfig.OptionalStringValue, def string) string {
+	if v.Get() == "" {
+		return def
+	}
+	return v.Get()
+}
+
+// entryID returns the ID of an entry. IDs read from binary attributes, like Active Directory's objectGUID, are always hex encoded,
+// so that the ID of an entry doesn't change depending on whether its bytes happen to be valid UTF-8.
+func entryID(e *ldap.Entry, attr string, binary bool) string {
+	if strings.EqualFold(attr, dnAttribute) {
+		return e.DN
+	}
+	raw := e.GetEqualFoldRawAttributeValue(attr)
+	if binary {
+		return hex.EncodeToString(raw)
+	}
+	return string(raw)
+}
+
+// isBinary returns true if the attribute is configured as holding binary values.
+func (s *LDAPSync) isBinary(attr string) bool {
+	for _, a := range strings.Split(orDefault(s.binaryAttributes, defaultLDAPBinaryAttributes), ",") {
+		if strings.EqualFold(strings.TrimSpace(a), attr) {
+			return true
+		}
+	}
+	return false
+}
+
+// withoutDN removes the dn pseudo attribute, as the DN is always returned.
+func withoutDN(attributes []string) []string {
+	var res []string
+	for _, a := range attributes {
+		if !strings.EqualFold(a, dnAttribute) {
+			res = append(res, a)
+		}
+	}
+	return res
+}
+
+// dnKey normalises a DN so that DNs which differ only in case or spacing can be compared.
+func dnKey(dn string) string {
+	parsed, err := ldap.ParseDN(dn)
+	if err != nil {
+		return strings.ToLower(dn)
+	}
+	var rdns []string
+	for _, rdn := range parsed.RDNs {
+		var attrs []string
+		for _, a := range rdn.Attributes {
+			attrs = append(attrs, strings.ToLower(a.Type)+"="+strings.ToLower(a.Value))
+		}
+		rdns = append(rdns, strings.Join(attrs, "+"))
+	}
+	return strings.Join(rdns, ",")
+}
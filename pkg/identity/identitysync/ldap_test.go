@@ -0,0 +1,425 @@
+package identitysync
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/common-fate/common-fate/pkg/gconfig"
+	"github.com/common-fate/common-fate/pkg/identity"
+	ber "github.com/go-asn1-ber/asn1-ber"
+	"github.com/go-ldap/ldap/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testLDAPServer is a minimal in-process LDAP server which supports simple binds,
+// searches with equality, presence, and boolean filters, and the paged results control.
+type testLDAPServer struct {
+	t        *testing.T
+	ln       net.Listener
+	bindDN   string
+	password string
+	entries  []*ldap.Entry
+
+	mu    sync.Mutex
+	pages int
+}
+
+func newTestLDAPServer(t *testing.T, entries []*ldap.Entry) *testLDAPServer {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := &testLDAPServer{
+		t:        t,
+		ln:       ln,
+		bindDN:   "cn=reader,dc=example,dc=com",
+		password: "secret",
+		entries:  entries,
+	}
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *testLDAPServer) URL() string {
+	return "ldap://" + s.ln.Addr().String()
+}
+
+// Pages returns the number of search result pages which have been served.
+func (s *testLDAPServer) Pages() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.pages
+}
+
+func (s *testLDAPServer) serve(conn net.Conn) {
+	defer conn.Close()
+	for {
+		p, err := ber.ReadPacket(conn)
+		if err != nil {
+			return
+		}
+		id := p.Children[0].Value.(int64)
+		op := p.Children[1]
+		switch op.Tag {
+		case ldap.ApplicationBindRequest:
+			code := ldap.LDAPResultSuccess
+			if op.Children[1].Value.(string) != s.bindDN || op.Children[2].Data.String() != s.password {
+				code = ldap.LDAPResultInvalidCredentials
+			}
+			s.write(conn, id, result(ldap.ApplicationBindResponse, code), nil)
+		case ldap.ApplicationSearchRequest:
+			var controls *ber.Packet
+			if len(p.Children) == 3 {
+				controls = p.Children[2]
+			}
+			s.search(conn, id, op, controls)
+		default:
+			return
+		}
+	}
+}
+
+func (s *testLDAPServer) search(conn net.Conn, id int64, op *ber.Packet, controls *ber.Packet) {
+	base := dnKey(op.Children[0].Value.(string))
+	scope := op.Children[1].Value.(int64)
+	filter := op.Children[6]
+
+	var matches []*ldap.Entry
+	for _, e := range s.entries {
+		key := dnKey(e.DN)
+		inScope := key == base
+		if scope == ldap.ScopeWholeSubtree {
+			inScope = inScope || strings.HasSuffix(key, ","+base)
+		}
+		if inScope && s.match(filter, e) {
+			matches = append(matches, e)
+		}
+	}
+	if scope == ldap.ScopeBaseObject && len(matches) == 0 {
+		s.write(conn, id, result(ldap.ApplicationSearchResultDone, ldap.LDAPResultNoSuchObject), nil)
+		return
+	}
+
+	var responseControls *ber.Packet
+	if controls != nil {
+		for _, c := range controls.Children {
+			decoded, err := ldap.DecodeControl(c)
+			require.NoError(s.t, err)
+			paging, ok := decoded.(*ldap.ControlPaging)
+			if !ok {
+				continue
+			}
+			offset := 0
+			if len(paging.Cookie) > 0 {
+				offset, err = strconv.Atoi(string(paging.Cookie))
+				require.NoError(s.t, err)
+			}
+			end := offset + int(paging.PagingSize)
+			next := ldap.NewControlPaging(paging.PagingSize)
+			if end < len(matches) {
+				next.SetCookie([]byte(strconv.Itoa(end)))
+			} else {
+				end = len(matches)
+			}
+			matches = matches[offset:end]
+			responseControls = ber.Encode(ber.ClassContext, ber.TypeConstructed, 0, nil, "Controls")
+			responseControls.AppendChild(next.Encode())
+		}
+	}
+	s.mu.Lock()
+	s.pages++
+	s.mu.Unlock()
+
+	var attributes []string
+	for _, a := range op.Children[7].Children {
+		attributes = append(attributes, a.Value.(string))
+	}
+	for _, e := range matches {
+		s.write(conn, id, entryPacket(e, attributes), nil)
+	}
+	s.write(conn, id, result(ldap.ApplicationSearchResultDone, ldap.LDAPResultSuccess), responseControls)
+}
+
+// match evaluates a search filter against an entry.
+func (s *testLDAPServer) match(filter *ber.Packet, e *ldap.Entry) bool {
+	switch filter.Tag {
+	case ldap.FilterAnd:
+		for _, child := range filter.Children {
+			if !s.match(child, e) {
+				return false
+			}
+		}
+		return true
+	case ldap.FilterOr:
+		for _, child := range filter.Children {
+			if s.match(child, e) {
+				return true
+			}
+		}
+		return false
+	case ldap.FilterNot:
+		return !s.match(filter.Children[0], e)
+	case ldap.FilterEqualityMatch:
+		want := filter.Children[1].Value.(string)
+		for _, v := range e.GetEqualFoldAttributeValues(filter.Children[0].Value.(string)) {
+			if strings.EqualFold(v, want) {
+				return true
+			}
+		}
+		return false
+	case ldap.FilterPresent:
+		return len(e.GetEqualFoldRawAttributeValues(filter.Data.String())) > 0
+	}
+	s.t.Errorf("unsupported filter: %s", ldap.FilterMap[uint64(filter.Tag)])
+	return false
+}
+
+func (s *testLDAPServer) write(conn net.Conn, id int64, op *ber.Packet, controls *ber.Packet) {
+	p := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+	p.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, id, "Message ID"))
+	p.AppendChild(op)
+	if controls != nil {
+		p.AppendChild(controls)
+	}
+	_, err := conn.Write(p.Bytes())
+	require.NoError(s.t, err)
+}
+
+func result(application ber.Tag, code int) *ber.Packet {
+	p := ber.Encode(ber.ClassApplication, ber.TypeConstructed, application, nil, "Result")
+	p.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, code, "Result Code"))
+	p.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Matched DN"))
+	p.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Diagnostic Message"))
+	return p
+}
+
+func entryPacket(e *ldap.Entry, attributes []string) *ber.Packet {
+	p := ber.Encode(ber.ClassApplication, ber.TypeConstructed, ldap.ApplicationSearchResultEntry, nil, "Search Result Entry")
+	p.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, e.DN, "DN"))
+	attrs := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attributes")
+	for _, a := range e.Attributes {
+		requested := len(attributes) == 0
+		for _, name := range attributes {
+			requested = requested || strings.EqualFold(name, a.Name)
+		}
+		if !requested {
+			continue
+		}
+		attr := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attribute")
+		attr.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, a.Name, "Type"))
+		values := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "Values")
+		for _, v := range a.Values {
+			values.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, v, "Value"))
+		}
+		attr.AppendChild(values)
+		attrs.AppendChild(attr)
+	}
+	p.AppendChild(attrs)
+	return p
+}
+
+// testDirectory contains users and groups in separate OUs.
+// alice's groups are read from memberOf, bob's from the member attribute of the admins group,
+// and the platform and everyone groups are nested in each other.
+func testDirectory() []*ldap.Entry {
+	return []*ldap.Entry{
+		ldap.NewEntry("dc=example,dc=com", map[string][]string{"objectClass": {"domain"}}),
+		ldap.NewEntry("ou=people,dc=example,dc=com", map[string][]string{"objectClass": {"organizationalUnit"}}),
+		ldap.NewEntry("ou=groups,dc=example,dc=com", map[string][]string{"objectClass": {"organizationalUnit"}}),
+		ldap.NewEntry("cn=alice,ou=people,dc=example,dc=com", map[string][]string{
+			"objectClass":       {"person"},
+			"objectGUID":        {"\x01\xff\x02\xfe"},
+			"mail":              {"alice@example.com"},
+			"userPrincipalName": {"alice@corp.example.com"},
+			"givenName":         {"Alice"},
+			"sn":                {"Smith"},
+			"memberOf":          {"CN=Engineering, OU=Groups, DC=example, DC=com", "cn=unknown,dc=example,dc=com"},
+		}),
+		ldap.NewEntry("cn=bob,ou=people,dc=example,dc=com", map[string][]string{
+			"objectClass":       {"person"},
+			"objectGUID":        {"bob-guid"},
+			"mail":              {"bob@example.com"},
+			"userPrincipalName": {"bob@corp.example.com"},
+			"givenName":         {"Bob"},
+			"sn":                {"Jones"},
+		}),
+		ldap.NewEntry("cn=carol,ou=people,dc=example,dc=com", map[string][]string{
+			"objectClass": {"person"},
+			"givenName":   {"Carol"},
+		}),
+		ldap.NewEntry("cn=engineering,ou=groups,dc=example,dc=com", map[string][]string{
+			"objectClass": {"group"},
+			"cn":          {"engineering"},
+			"description": {"Engineers"},
+			"memberOf":    {"cn=platform,ou=groups,dc=example,dc=com"},
+		}),
+		ldap.NewEntry("cn=admins,ou=groups,dc=example,dc=com", map[string][]string{
+			"objectClass": {"groupOfNames"},
+			"cn":          {"admins"},
+			"member":      {"CN=Bob,OU=People,DC=example,DC=com"},
+		}),
+		ldap.NewEntry("cn=platform,ou=groups,dc=example,dc=com", map[string][]string{
+			"objectClass": {"group"},
+			"cn":          {"platform"},
+			"member":      {"cn=everyone,ou=groups,dc=example,dc=com"},
+		}),
+		ldap.NewEntry("cn=everyone,ou=groups,dc=example,dc=com", map[string][]string{
+			"objectClass": {"group"},
+			"cn":          {"everyone"},
+			"member":      {"cn=platform,ou=groups,dc=example,dc=com"},
+		}),
+	}
+}
+
+func newTestLDAPSync(t *testing.T, s *testLDAPServer, values map[string]string) *LDAPSync {
+	ctx := context.Background()
+	cfg := map[string]string{
+		"url":          s.URL(),
+		"bindDn":       s.bindDN,
+		"bindPassword": s.password,
+		"userBaseDn":   "ou=people,dc=example,dc=com",
+		"groupBaseDn":  "ou=groups,dc=example,dc=com",
+	}
+	for k, v := range values {
+		cfg[k] = v
+	}
+	l := &LDAPSync{}
+	err := l.Config().Load(ctx, &gconfig.MapLoader{Values: cfg})
+	require.NoError(t, err)
+	err = l.Init(ctx)
+	require.NoError(t, err)
+	return l
+}
+
+func TestLDAPSync(t *testing.T) {
+	ctx := context.Background()
+	s := newTestLDAPServer(t, testDirectory())
+	l := newTestLDAPSync(t, s, map[string]string{"pageSize": "2"})
+
+	groups, err := l.ListGroups(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []identity.IDPGroup{
+		{ID: "cn=admins,ou=groups,dc=example,dc=com", Name: "admins"},
+		{ID: "cn=engineering,ou=groups,dc=example,dc=com", Name: "engineering", Description: "Engineers"},
+		{ID: "cn=everyone,ou=groups,dc=example,dc=com", Name: "everyone"},
+		{ID: "cn=platform,ou=groups,dc=example,dc=com", Name: "platform"},
+	}, groups)
+	// the 4 groups are returned in 2 pages
+	assert.Equal(t, 2, s.Pages())
+
+	users, err := l.ListUsers(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []identity.IDPUser{
+		{
+			ID:        "cn=alice,ou=people,dc=example,dc=com",
+			Email:     "alice@example.com",
+			FirstName: "Alice",
+			LastName:  "Smith",
+			Groups: []string{
+				"cn=engineering,ou=groups,dc=example,dc=com",
+				"cn=everyone,ou=groups,dc=example,dc=com",
+				"cn=platform,ou=groups,dc=example,dc=com",
+			},
+		},
+		{
+			ID:        "cn=bob,ou=people,dc=example,dc=com",
+			Email:     "bob@example.com",
+			FirstName: "Bob",
+			LastName:  "Jones",
+			Groups:    []string{"cn=admins,ou=groups,dc=example,dc=com"},
+		},
+	}, users)
+}
+
+func TestLDAPSyncAttributeMappings(t *testing.T) {
+	ctx := context.Background()
+	s := newTestLDAPServer(t, testDirectory())
+	l := newTestLDAPSync(t, s, map[string]string{
+		"userFilter":       "(objectClass=person)",
+		"userIdAttribute":  "objectGUID",
+		"emailAttribute":   "userPrincipalName",
+		"groupIdAttribute": "cn",
+		// groups are searched for in the user base DN when no group base DN is set
+		"userBaseDn":  "dc=example,dc=com",
+		"groupBaseDn": "",
+	})
+
+	users, err := l.ListUsers(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []identity.IDPUser{
+		{
+			ID:        "01ff02fe",
+			Email:     "alice@corp.example.com",
+			FirstName: "Alice",
+			LastName:  "Smith",
+			Groups:    []string{"engineering", "everyone", "platform"},
+		},
+		{
+			// binary attributes are hex encoded even if the value is valid UTF-8
+			ID:        "626f622d67756964",
+			Email:     "bob@corp.example.com",
+			FirstName: "Bob",
+			LastName:  "Jones",
+			Groups:    []string{"admins"},
+		},
+	}, users)
+}
+
+func TestLDAPSyncCancelled(t *testing.T) {
+	s := newTestLDAPServer(t, testDirectory())
+	l := newTestLDAPSync(t, s, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := l.ListGroups(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestLDAPSyncTestConfig(t *testing.T) {
+	ctx := context.Background()
+	s := newTestLDAPServer(t, testDirectory())
+
+	type testcase struct {
+		name    string
+		values  map[string]string
+		wantErr bool
+	}
+	testcases := []testcase{
+		{
+			name: "ok",
+		},
+		{
+			name:    "wrong password",
+			values:  map[string]string{"bindPassword": "wrong"},
+			wantErr: true,
+		},
+		{
+			name:    "missing base DN",
+			values:  map[string]string{"groupBaseDn": "ou=groups,dc=example,dc=com;ou=missing,dc=example,dc=com"},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			l := newTestLDAPSync(t, s, tc.values)
+			err := l.TestConfig(ctx)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
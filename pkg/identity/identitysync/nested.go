@@ -0,0 +1,124 @@
+package identitysync
+
+import (
+	"context"
+	"sort"
+
+	"github.com/common-fate/common-fate/pkg/identity"
+)
+
+// NestedGroupLister is implemented by identity providers which support groups inside groups.
+// When nested group resolution is enabled, users become members of every group which their groups are nested in.
+type NestedGroupLister interface {
+	// ListGroupEdges returns every group which is a direct member of another group.
+	ListGroupEdges(ctx context.Context) ([]identity.IDPGroupEdge, error)
+}
+
+// resolveNestedGroups adds the groups which users are members of through nested groups to their groups,
+// and records the path of nested groups which gave them each group.
+// Cycles of nested groups don't prevent membership from being resolved, the groups in a cycle are returned so that they can be reported.
+func resolveNestedGroups(users []identity.IDPUser, edges []identity.IDPGroupEdge) ([]identity.IDPUser, [][]string) {
+	parents := make(map[string][]string)
+	seen := make(map[identity.IDPGroupEdge]bool)
+	for _, e := range edges {
+		if seen[e] {
+			continue
+		}
+		seen[e] = true
+		parents[e.Child] = append(parents[e.Child], e.Parent)
+	}
+	for _, p := range parents {
+		sort.Strings(p)
+	}
+
+	res := make([]identity.IDPUser, 0, len(users))
+	for _, u := range users {
+		// a breadth first search finds the shortest path to each group.
+		// groups are only visited once, so cycles end the search rather than looping forever.
+		paths := make(map[string][]string)
+		var queue []string
+		direct := append([]string{}, u.Groups...)
+		sort.Strings(direct)
+		for _, g := range direct {
+			if _, ok := paths[g]; !ok {
+				paths[g] = []string{g}
+				queue = append(queue, g)
+			}
+		}
+		for len(queue) > 0 {
+			g := queue[0]
+			queue = queue[1:]
+			for _, p := range parents[g] {
+				if _, ok := paths[p]; ok {
+					continue
+				}
+				path := make([]string, len(paths[g]), len(paths[g])+1)
+				copy(path, paths[g])
+				paths[p] = append(path, p)
+				queue = append(queue, p)
+			}
+		}
+
+		u.Groups = direct
+		u.GroupPaths = nil
+		for g, path := range paths {
+			if len(path) == 1 {
+				continue
+			}
+			if u.GroupPaths == nil {
+				u.GroupPaths = make(map[string][]string)
+			}
+			u.GroupPaths[g] = path
+			u.Groups = append(u.Groups, g)
+		}
+		sort.Strings(u.Groups)
+		res = append(res, u)
+	}
+	return res, findGroupCycles(parents)
+}
+
+// findGroupCycles returns the cycles in the graph of nested groups, which is given as a map of groups to their parent groups.
+// Each cycle is returned once, starting with the first group of the cycle which was visited.
+func findGroupCycles(parents map[string][]string) [][]string {
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := make(map[string]int)
+	var stack []string
+	var cycles [][]string
+
+	var visit func(g string)
+	visit = func(g string) {
+		state[g] = visiting
+		stack = append(stack, g)
+		for _, p := range parents[g] {
+			switch state[p] {
+			case visiting:
+				// p is on the stack, so the groups from p to g form a cycle
+				for i := len(stack) - 1; i >= 0; i-- {
+					if stack[i] == p {
+						cycles = append(cycles, append([]string{}, stack[i:]...))
+						break
+					}
+				}
+			case 0:
+				visit(p)
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[g] = visited
+	}
+
+	groups := make([]string, 0, len(parents))
+	for g := range parents {
+		groups = append(groups, g)
+	}
+	sort.Strings(groups)
+	for _, g := range groups {
+		if state[g] == 0 {
+			visit(g)
+		}
+	}
+	return cycles
+}
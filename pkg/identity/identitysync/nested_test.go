@@ -0,0 +1,107 @@
+package identitysync
+
+import (
+	"testing"
+
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveNestedGroups(t *testing.T) {
+	type testcase struct {
+		name       string
+		giveUsers  []identity.IDPUser
+		giveEdges  []identity.IDPGroupEdge
+		wantUsers  []identity.IDPUser
+		wantCycles [][]string
+	}
+	testcases := []testcase{
+		{
+			name:      "no nested groups",
+			giveUsers: []identity.IDPUser{{ID: "1", Groups: []string{"b", "a"}}},
+			wantUsers: []identity.IDPUser{{ID: "1", Groups: []string{"a", "b"}}},
+		},
+		{
+			name:      "transitive membership",
+			giveUsers: []identity.IDPUser{{ID: "1", Groups: []string{"team"}}, {ID: "2", Groups: []string{"everyone"}}},
+			giveEdges: []identity.IDPGroupEdge{
+				{Parent: "engineering", Child: "team"},
+				{Parent: "everyone", Child: "engineering"},
+				{Parent: "everyone", Child: "engineering"},
+			},
+			wantUsers: []identity.IDPUser{
+				{
+					ID:     "1",
+					Groups: []string{"engineering", "everyone", "team"},
+					GroupPaths: map[string][]string{
+						"engineering": {"team", "engineering"},
+						"everyone":    {"team", "engineering", "everyone"},
+					},
+				},
+				{ID: "2", Groups: []string{"everyone"}},
+			},
+		},
+		{
+			name:      "shortest path is used",
+			giveUsers: []identity.IDPUser{{ID: "1", Groups: []string{"team"}}},
+			giveEdges: []identity.IDPGroupEdge{
+				{Parent: "engineering", Child: "team"},
+				{Parent: "everyone", Child: "engineering"},
+				{Parent: "everyone", Child: "team"},
+			},
+			wantUsers: []identity.IDPUser{
+				{
+					ID:     "1",
+					Groups: []string{"engineering", "everyone", "team"},
+					GroupPaths: map[string][]string{
+						"engineering": {"team", "engineering"},
+						"everyone":    {"team", "everyone"},
+					},
+				},
+			},
+		},
+		{
+			name:      "direct membership takes precedence over nested membership",
+			giveUsers: []identity.IDPUser{{ID: "1", Groups: []string{"team", "engineering"}}},
+			giveEdges: []identity.IDPGroupEdge{{Parent: "engineering", Child: "team"}},
+			wantUsers: []identity.IDPUser{{ID: "1", Groups: []string{"engineering", "team"}}},
+		},
+		{
+			name:      "cycles are resolved and reported",
+			giveUsers: []identity.IDPUser{{ID: "1", Groups: []string{"a"}}},
+			giveEdges: []identity.IDPGroupEdge{
+				{Parent: "b", Child: "a"},
+				{Parent: "c", Child: "b"},
+				{Parent: "a", Child: "c"},
+			},
+			wantUsers: []identity.IDPUser{
+				{
+					ID:     "1",
+					Groups: []string{"a", "b", "c"},
+					GroupPaths: map[string][]string{
+						"b": {"a", "b"},
+						"c": {"a", "b", "c"},
+					},
+				},
+			},
+			wantCycles: [][]string{{"a", "b", "c"}},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			gotUsers, gotCycles := resolveNestedGroups(tc.giveUsers, tc.giveEdges)
+			assert.Equal(t, tc.wantUsers, gotUsers)
+			assert.Equal(t, tc.wantCycles, gotCycles)
+		})
+	}
+}
+
+func TestResolveNestedGroupsDoesNotModifyInput(t *testing.T) {
+	groups := make([]string, 1, 4)
+	groups[0] = "team"
+	users := []identity.IDPUser{{ID: "1", Groups: groups}}
+	_, _ = resolveNestedGroups(users, []identity.IDPGroupEdge{{Parent: "engineering", Child: "team"}})
+	assert.Equal(t, []string{"team"}, users[0].Groups)
+	assert.Equal(t, []string{"team", ""}, groups[:2])
+}
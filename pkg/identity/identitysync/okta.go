@@ -16,19 +16,31 @@ import (
 )
 
 type OktaSync struct {
-	client   *okta.Client
-	orgURL   gconfig.StringValue
-	apiToken gconfig.SecretStringValue
+	client            *okta.Client
+	orgURL            gconfig.StringValue
+	apiToken          gconfig.SecretStringValue
+	attributeMappings gconfig.OptionalStringValue
+	managerAttribute  gconfig.OptionalStringValue
+
+	// This is initialised during the Init function call and is not saved in config
+	attributes attributeMappings
 }
 
 func (s *OktaSync) Config() gconfig.Config {
 	return gconfig.Config{
 		gconfig.StringField("orgUrl", &s.orgURL, "the Okta organization URL"),
 		gconfig.SecretStringField("apiToken", &s.apiToken, "the Okta API token", gconfig.WithNoArgs("/granted/secrets/identity/okta/token")),
+		gconfig.OptionalStringField("attributeMappings", &s.attributeMappings, "user profile attributes to sync, as name=attribute pairs separated by commas (eg. department=department,employeeType=userType)"),
+		gconfig.OptionalStringField("managerAttribute", &s.managerAttribute, "the user profile attribute containing the ID or email of the user's manager", gconfig.WithDefaultFunc(func() string { return "managerId" })),
 	}
 }
 
 func (s *OktaSync) Init(ctx context.Context) error {
+	attributes, err := parseAttributeMappings("attributeMappings", s.attributeMappings.Get())
+	if err != nil {
+		return err
+	}
+	s.attributes = attributes
 	_, client, err := okta.NewClient(
 		ctx,
 		okta.WithOrgUrl(s.orgURL.Get()),
@@ -84,12 +96,15 @@ func (o *OktaSync) idpUserFromOktaUser(ctx context.Context, oktaUser *okta.User)
 		return identity.IDPUser{}, fmt.Errorf("okta user %s profile had no email", oktaUser.Id)
 	}
 
+	profile := map[string]any(*oktaUser.Profile)
 	u := identity.IDPUser{
-		ID:        oktaUser.Id,
-		FirstName: firstName,
-		LastName:  lastName,
-		Email:     email,
-		Groups:    []string{},
+		ID:         oktaUser.Id,
+		FirstName:  firstName,
+		LastName:   lastName,
+		Email:      email,
+		Groups:     []string{},
+		Attributes: o.attributes.Map(profile),
+		Manager:    lookupAttribute(profile, orDefault(o.managerAttribute, "managerId")),
 	}
 
 	log.Debug("listing groups for user")
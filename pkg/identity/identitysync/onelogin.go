@@ -21,7 +21,11 @@ type OneLoginSync struct {
 	// This is initialised during the Init function call and is not saved in config
 	token gconfig.SecretStringValue
 
-	baseURL gconfig.StringValue
+	baseURL           gconfig.StringValue
+	attributeMappings gconfig.OptionalStringValue
+
+	// This is initialised during the Init function call and is not saved in config
+	attributes attributeMappings
 }
 
 func (s *OneLoginSync) Config() gconfig.Config {
@@ -29,10 +33,16 @@ func (s *OneLoginSync) Config() gconfig.Config {
 		gconfig.StringField("baseURL", &s.baseURL, "your OneLogin URL (eg. https://{tenancy}.onelogin.com)"),
 		gconfig.StringField("clientId", &s.clientID, "the OneLogin client ID"),
 		gconfig.SecretStringField("clientSecret", &s.clientSecret, "the OneLogin client secret", gconfig.WithNoArgs("/granted/secrets/identity/one-login/secret")),
+		gconfig.OptionalStringField("attributeMappings", &s.attributeMappings, "user fields to sync, as name=field pairs separated by commas (eg. department=department,branch=custom_attributes.branch)"),
 	}
 }
 
 func (s *OneLoginSync) Init(ctx context.Context) error {
+	attributes, err := parseAttributeMappings("attributeMappings", s.attributeMappings.Get())
+	if err != nil {
+		return err
+	}
+	s.attributes = attributes
 
 	url := s.baseURL.Get() + "/auth/oauth2/v2/token"
 
@@ -76,13 +86,19 @@ func (s *OneLoginSync) TestConfig(ctx context.Context) error {
 	return nil
 }
 
-func (s *OneLoginSync) idpUserFromOneLoginUser(ctx context.Context, oneLoginUser *OneLoginUser) (identity.IDPUser, error) {
+// idpUserFromOneLoginUser converts a OneLogin user to the identityprovider interface user type.
+// fields are the fields of the user as returned by the OneLogin API, which are used for attribute mappings.
+func (s *OneLoginSync) idpUserFromOneLoginUser(ctx context.Context, oneLoginUser *OneLoginUser, fields map[string]any) (identity.IDPUser, error) {
 	u := identity.IDPUser{
-		ID:        strconv.Itoa(oneLoginUser.ID),
-		FirstName: oneLoginUser.Firstname,
-		LastName:  oneLoginUser.Lastname,
-		Email:     oneLoginUser.Email,
-		Groups:    []string{},
+		ID:         strconv.Itoa(oneLoginUser.ID),
+		FirstName:  oneLoginUser.Firstname,
+		LastName:   oneLoginUser.Lastname,
+		Email:      oneLoginUser.Email,
+		Groups:     []string{},
+		Attributes: s.attributes.Map(fields),
+	}
+	if oneLoginUser.ManagerUserID != 0 {
+		u.Manager = strconv.Itoa(oneLoginUser.ManagerUserID)
 	}
 
 	for _, r := range oneLoginUser.RoleID {
@@ -132,9 +148,17 @@ func (s *OneLoginSync) ListUsers(ctx context.Context) ([]identity.IDPUser, error
 		if err != nil {
 			return nil, err
 		}
-		for _, u := range lu.Users {
+		// the users are also decoded as maps, so that attribute mappings can use any of their fields
+		var fields struct {
+			Users []map[string]any `json:"data"`
+		}
+		err = json.Unmarshal(b, &fields)
+		if err != nil {
+			return nil, err
+		}
+		for i, u := range lu.Users {
 
-			user, err := s.idpUserFromOneLoginUser(ctx, &u)
+			user, err := s.idpUserFromOneLoginUser(ctx, &u, fields.Users[i])
 			if err != nil {
 				return nil, err
 			}
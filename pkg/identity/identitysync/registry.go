@@ -15,6 +15,8 @@ const (
 	IDPTypeGoogle   = "google"
 	IDPTypeAWSSSO   = "aws-sso"
 	IDPTypeOneLogin = "one-login"
+	IDPTypeLDAP     = "ldap"
+	IDPTypeSCIM     = "generic-scim"
 )
 
 type RegisteredIdentityProvider struct {
@@ -63,6 +65,16 @@ func Registry() IdentityProviderRegistry {
 				Description:      "OneLogin",
 				DocsID:           "one-login",
 			},
+			IDPTypeLDAP: {
+				IdentityProvider: &LDAPSync{},
+				Description:      "LDAP / Active Directory",
+				DocsID:           "ldap",
+			},
+			IDPTypeSCIM: {
+				IdentityProvider: &SCIMSync{},
+				Description:      "Generic SCIM 2.0 server",
+				DocsID:           "generic-scim",
+			},
 		},
 	}
 }
@@ -0,0 +1,418 @@
+package identitysync
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/common-fate/pkg/gconfig"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/scim"
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+const (
+	scimAuthBearer            = "bearer"
+	scimAuthClientCredentials = "client-credentials"
+	defaultSCIMPageSize       = "100"
+)
+
+// SCIMSync syncs users and groups from any identity provider which exposes a SCIM 2.0 server endpoint,
+// such as Keycloak or JumpCloud.
+//
+// Attribute mappings are SCIM attribute paths, such as emails[primary eq true].value or
+// urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:employeeNumber.
+// Group membership is read from both the groups attribute of users and the members attribute of groups,
+// as SCIM servers don't always return both.
+type SCIMSync struct {
+	baseURL                   gconfig.StringValue
+	authMethod                gconfig.OptionalStringValue
+	clientID                  gconfig.OptionalStringValue
+	tokenURL                  gconfig.OptionalStringValue
+	scopes                    gconfig.OptionalStringValue
+	secret                    gconfig.SecretStringValue
+	pageSize                  gconfig.OptionalStringValue
+	userFilter                gconfig.OptionalStringValue
+	groupFilter               gconfig.OptionalStringValue
+	userIDAttribute           gconfig.OptionalStringValue
+	emailAttribute            gconfig.OptionalStringValue
+	firstNameAttribute        gconfig.OptionalStringValue
+	lastNameAttribute         gconfig.OptionalStringValue
+	groupIDAttribute          gconfig.OptionalStringValue
+	groupNameAttribute        gconfig.OptionalStringValue
+	groupDescriptionAttribute gconfig.OptionalStringValue
+
+	// This is initialised during the Init function call and is not saved in config
+	client *http.Client
+}
+
+func (s *SCIMSync) Config() gconfig.Config {
+	return gconfig.Config{
+		gconfig.StringField("baseUrl", &s.baseURL, "the SCIM 2.0 base URL of your identity provider (eg. https://keycloak.example.com/realms/example/scim/v2)"),
+		gconfig.OptionalStringField("authMethod", &s.authMethod, "how to authenticate to the SCIM server, either 'bearer' or 'client-credentials'", gconfig.WithDefaultFunc(func() string { return scimAuthBearer })),
+		gconfig.OptionalStringField("clientId", &s.clientID, "the OAuth client ID (client-credentials only)"),
+		gconfig.OptionalStringField("tokenUrl", &s.tokenURL, "the OAuth token URL (client-credentials only)"),
+		gconfig.OptionalStringField("scopes", &s.scopes, "the OAuth scopes to request, separated by spaces (client-credentials only)"),
+		gconfig.SecretStringField("secret", &s.secret, "the bearer token, or the OAuth client secret when using client-credentials", gconfig.WithNoArgs("/granted/secrets/identity/generic-scim/secret")),
+		gconfig.OptionalStringField("pageSize", &s.pageSize, "the number of resources to fetch in each page of results", gconfig.WithDefaultFunc(func() string { return defaultSCIMPageSize })),
+		gconfig.OptionalStringField("userFilter", &s.userFilter, "a SCIM filter for the users to sync (eg. active eq true)"),
+		gconfig.OptionalStringField("groupFilter", &s.groupFilter, "a SCIM filter for the groups to sync"),
+		gconfig.OptionalStringField("userIdAttribute", &s.userIDAttribute, "the attribute which uniquely identifies a user", gconfig.WithDefaultFunc(func() string { return "id" })),
+		gconfig.OptionalStringField("emailAttribute", &s.emailAttribute, "the attribute containing the user's email (defaults to the primary email, or the userName)"),
+		gconfig.OptionalStringField("firstNameAttribute", &s.firstNameAttribute, "the attribute containing the user's first name", gconfig.WithDefaultFunc(func() string { return "name.givenName" })),
+		gconfig.OptionalStringField("lastNameAttribute", &s.lastNameAttribute, "the attribute containing the user's last name", gconfig.WithDefaultFunc(func() string { return "name.familyName" })),
+		gconfig.OptionalStringField("groupIdAttribute", &s.groupIDAttribute, "the attribute which uniquely identifies a group", gconfig.WithDefaultFunc(func() string { return "id" })),
+		gconfig.OptionalStringField("groupNameAttribute", &s.groupNameAttribute, "the attribute containing the group's name", gconfig.WithDefaultFunc(func() string { return "displayName" })),
+		gconfig.OptionalStringField("groupDescriptionAttribute", &s.groupDescriptionAttribute, "the attribute containing the group's description"),
+	}
+}
+
+func (s *SCIMSync) Init(ctx context.Context) error {
+	_, err := url.Parse(s.baseURL.Get())
+	if err != nil {
+		return errors.Wrap(err, "parsing SCIM base url")
+	}
+	_, err = s.getPageSize()
+	if err != nil {
+		return err
+	}
+	// check that the attribute mappings are valid
+	_, err = s.userMapping()
+	if err != nil {
+		return err
+	}
+	_, err = s.groupMapping()
+	if err != nil {
+		return err
+	}
+
+	switch orDefault(s.authMethod, scimAuthBearer) {
+	case scimAuthBearer:
+		s.client = oauth2.NewClient(ctx, oauth2.StaticTokenSource(&oauth2.Token{AccessToken: s.secret.Get()}))
+	case scimAuthClientCredentials:
+		if s.clientID.Get() == "" || s.tokenURL.Get() == "" {
+			return errors.New("clientId and tokenUrl are required when using client-credentials authentication")
+		}
+		cfg := clientcredentials.Config{
+			ClientID:     s.clientID.Get(),
+			ClientSecret: s.secret.Get(),
+			TokenURL:     s.tokenURL.Get(),
+			Scopes:       strings.Fields(s.scopes.Get()),
+		}
+		// the client isn't tied to the lifetime of ctx, as Init is called once before listing users and groups
+		s.client = cfg.Client(context.Background())
+	default:
+		return fmt.Errorf("invalid SCIM auth method %q, must be '%s' or '%s'", s.authMethod.Get(), scimAuthBearer, scimAuthClientCredentials)
+	}
+	return nil
+}
+
+func (s *SCIMSync) TestConfig(ctx context.Context) error {
+	_, err := s.list(ctx, "Users", s.userFilter.Get(), 1)
+	if err != nil {
+		return errors.Wrap(err, "failed to list users while testing SCIM identity provider configuration")
+	}
+	_, err = s.list(ctx, "Groups", s.groupFilter.Get(), 1)
+	if err != nil {
+		return errors.Wrap(err, "failed to list groups while testing SCIM identity provider configuration")
+	}
+	return nil
+}
+
+func (s *SCIMSync) ListUsers(ctx context.Context) ([]identity.IDPUser, error) {
+	log := logger.Get(ctx)
+	m, err := s.userMapping()
+	if err != nil {
+		return nil, err
+	}
+	groups, err := s.listGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resources, err := s.list(ctx, "Users", s.userFilter.Get(), 0)
+	if err != nil {
+		return nil, errors.Wrap(err, "listing SCIM users")
+	}
+
+	idpUsers := []identity.IDPUser{}
+	for _, r := range resources {
+		var u scim.User
+		err = remarshal(r, &u)
+		if err != nil {
+			return nil, errors.Wrap(err, "decoding SCIM user")
+		}
+		if u.Active != nil && !*u.Active {
+			continue
+		}
+
+		email := u.Email()
+		if m.email != nil {
+			email = m.email.String(r)
+		}
+		id := m.id.String(r)
+		if email == "" || id == "" {
+			log.Warnw("skipping SCIM user with no email or ID", "scimId", u.ID)
+			continue
+		}
+
+		// the groups of users and the members of groups reference the SCIM IDs of resources
+		groupIDs := map[string]bool{}
+		for _, g := range u.Groups {
+			if group, ok := groups.bySCIMID[g.Value]; ok {
+				groupIDs[group.ID] = true
+			}
+		}
+		for _, gid := range groups.membersOf[u.ID] {
+			groupIDs[gid] = true
+		}
+
+		user := identity.IDPUser{
+			ID:        id,
+			Email:     email,
+			FirstName: m.firstName.String(r),
+			LastName:  m.lastName.String(r),
+			Groups:    []string{},
+		}
+		for gid := range groupIDs {
+			user.Groups = append(user.Groups, gid)
+		}
+		sort.Strings(user.Groups)
+		idpUsers = append(idpUsers, user)
+	}
+	return idpUsers, nil
+}
+
+func (s *SCIMSync) ListGroups(ctx context.Context) ([]identity.IDPGroup, error) {
+	groups, err := s.listGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	idpGroups := []identity.IDPGroup{}
+	for _, g := range groups.bySCIMID {
+		idpGroups = append(idpGroups, g)
+	}
+	sort.Slice(idpGroups, func(i, j int) bool { return idpGroups[i].Name < idpGroups[j].Name })
+	return idpGroups, nil
+}
+
+// ListGroupEdges returns the groups which are members of other groups.
+func (s *SCIMSync) ListGroupEdges(ctx context.Context) ([]identity.IDPGroupEdge, error) {
+	groups, err := s.listGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	edges := []identity.IDPGroupEdge{}
+	for scimID, g := range groups.bySCIMID {
+		for _, parent := range groups.membersOf[scimID] {
+			edges = append(edges, identity.IDPGroupEdge{Parent: parent, Child: g.ID})
+		}
+	}
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].Parent != edges[j].Parent {
+			return edges[i].Parent < edges[j].Parent
+		}
+		return edges[i].Child < edges[j].Child
+	})
+	return edges, nil
+}
+
+type scimGroups struct {
+	bySCIMID map[string]identity.IDPGroup
+	// membersOf maps the SCIM ID of a user or group to the IDs of the groups which list it as a member
+	membersOf map[string][]string
+}
+
+// scimGroupsCacheKey caches the groups of a SCIM provider, as they are needed by ListUsers, ListGroups and ListGroupEdges.
+type scimGroupsCacheKey struct{ s *SCIMSync }
+
+// listGroups returns the groups, which are only read from the server once per sync.
+func (s *SCIMSync) listGroups(ctx context.Context) (*scimGroups, error) {
+	return cachedFetch(ctx, scimGroupsCacheKey{s}, func() (*scimGroups, error) { return s.fetchGroups(ctx) })
+}
+
+func (s *SCIMSync) fetchGroups(ctx context.Context) (*scimGroups, error) {
+	m, err := s.groupMapping()
+	if err != nil {
+		return nil, err
+	}
+	resources, err := s.list(ctx, "Groups", s.groupFilter.Get(), 0)
+	if err != nil {
+		return nil, errors.Wrap(err, "listing SCIM groups")
+	}
+	groups := scimGroups{
+		bySCIMID:  make(map[string]identity.IDPGroup),
+		membersOf: make(map[string][]string),
+	}
+	for _, r := range resources {
+		var g scim.Group
+		err = remarshal(r, &g)
+		if err != nil {
+			return nil, errors.Wrap(err, "decoding SCIM group")
+		}
+		id := m.id.String(r)
+		if id == "" {
+			continue
+		}
+		group := identity.IDPGroup{
+			ID:   id,
+			Name: m.name.String(r),
+		}
+		if m.description != nil {
+			group.Description = m.description.String(r)
+		}
+		if group.Name == "" {
+			group.Name = id
+		}
+		groups.bySCIMID[g.ID] = group
+		for _, member := range g.Members {
+			groups.membersOf[member.Value] = append(groups.membersOf[member.Value], id)
+		}
+	}
+	return &groups, nil
+}
+
+// list returns every resource of a type, reading pages until the server has returned all of the results.
+// If limit is greater than zero, only the first page of up to limit results is returned.
+func (s *SCIMSync) list(ctx context.Context, resourceType string, filter string, limit int) ([]map[string]any, error) {
+	if s.client == nil {
+		return nil, errors.New("SCIM identity provider has not been initialised")
+	}
+	pageSize, err := s.getPageSize()
+	if err != nil {
+		return nil, err
+	}
+	if limit > 0 {
+		pageSize = limit
+	}
+
+	var resources []map[string]any
+	startIndex := 1
+	for {
+		q := url.Values{}
+		q.Set("startIndex", strconv.Itoa(startIndex))
+		q.Set("count", strconv.Itoa(pageSize))
+		if filter != "" {
+			q.Set("filter", filter)
+		}
+		u := strings.TrimSuffix(s.baseURL.Get(), "/") + "/" + resourceType + "?" + q.Encode()
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Accept", scim.ContentType+", application/json")
+		res, err := s.client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		b, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		if res.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("SCIM server returned %s: %s", res.Status, string(b))
+		}
+
+		var page struct {
+			TotalResults int              `json:"totalResults"`
+			Resources    []map[string]any `json:"Resources"`
+		}
+		err = json.Unmarshal(b, &page)
+		if err != nil {
+			return nil, errors.Wrap(err, "decoding SCIM list response")
+		}
+		resources = append(resources, page.Resources...)
+
+		startIndex += len(page.Resources)
+		if limit > 0 || len(page.Resources) == 0 || startIndex > page.TotalResults {
+			return resources, nil
+		}
+	}
+}
+
+func (s *SCIMSync) getPageSize() (int, error) {
+	size, err := strconv.Atoi(orDefault(s.pageSize, defaultSCIMPageSize))
+	if err != nil || size <= 0 {
+		return 0, fmt.Errorf("invalid SCIM page size %q", s.pageSize.Get())
+	}
+	return size, nil
+}
+
+type scimUserMapping struct {
+	id        scim.AttributePath
+	email     *scim.AttributePath
+	firstName scim.AttributePath
+	lastName  scim.AttributePath
+}
+
+func (s *SCIMSync) userMapping() (m scimUserMapping, err error) {
+	m.id, err = parseAttributePath("userIdAttribute", orDefault(s.userIDAttribute, "id"))
+	if err != nil {
+		return
+	}
+	if s.emailAttribute.Get() != "" {
+		email, err := parseAttributePath("emailAttribute", s.emailAttribute.Get())
+		if err != nil {
+			return m, err
+		}
+		m.email = &email
+	}
+	m.firstName, err = parseAttributePath("firstNameAttribute", orDefault(s.firstNameAttribute, "name.givenName"))
+	if err != nil {
+		return
+	}
+	m.lastName, err = parseAttributePath("lastNameAttribute", orDefault(s.lastNameAttribute, "name.familyName"))
+	return
+}
+
+type scimGroupMapping struct {
+	id          scim.AttributePath
+	name        scim.AttributePath
+	description *scim.AttributePath
+}
+
+func (s *SCIMSync) groupMapping() (m scimGroupMapping, err error) {
+	m.id, err = parseAttributePath("groupIdAttribute", orDefault(s.groupIDAttribute, "id"))
+	if err != nil {
+		return
+	}
+	m.name, err = parseAttributePath("groupNameAttribute", orDefault(s.groupNameAttribute, "displayName"))
+	if err != nil {
+		return
+	}
+	if s.groupDescriptionAttribute.Get() != "" {
+		description, err := parseAttributePath("groupDescriptionAttribute", s.groupDescriptionAttribute.Get())
+		if err != nil {
+			return m, err
+		}
+		m.description = &description
+	}
+	return
+}
+
+func parseAttributePath(key string, path string) (scim.AttributePath, error) {
+	p, err := scim.ParseAttributePath(path)
+	if err != nil {
+		return p, errors.Wrapf(err, "invalid %s", key)
+	}
+	return p, nil
+}
+
+// remarshal converts a resource in its JSON object representation to a struct.
+func remarshal(resource map[string]any, v any) error {
+	b, err := json.Marshal(resource)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
@@ -0,0 +1,264 @@
+package identitysync
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/common-fate/common-fate/pkg/gconfig"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testSCIMServer serves SCIM users and groups, paginated with startIndex and count,
+// and an OAuth token endpoint which issues access tokens for client credentials.
+type testSCIMServer struct {
+	*httptest.Server
+	users  []map[string]any
+	groups []map[string]any
+	// requests counts the requests made to each resource type
+	requests map[string]int
+}
+
+const (
+	testSCIMClientID     = "client"
+	testSCIMClientSecret = "client-secret"
+	testSCIMToken        = "token"
+)
+
+func newTestSCIMServer(t *testing.T) *testSCIMServer {
+	s := &testSCIMServer{
+		requests: map[string]int{},
+		users: []map[string]any{
+			{
+				"id":       "u1",
+				"userName": "alice",
+				"name":     map[string]any{"givenName": "Alice", "familyName": "Smith"},
+				"emails": []any{
+					map[string]any{"value": "alice@home.example", "type": "home"},
+					map[string]any{"value": "alice@example.com", "type": "work", "primary": true},
+				},
+				"groups": []any{map[string]any{"value": "g1", "display": "engineering"}},
+				"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User": map[string]any{"employeeNumber": "1001"},
+			},
+			{
+				"id":       "u2",
+				"userName": "bob@example.com",
+				"name":     map[string]any{"givenName": "Bob", "familyName": "Jones"},
+				"active":   "True",
+				"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User": map[string]any{"employeeNumber": "1002"},
+			},
+			{
+				"id":       "u3",
+				"userName": "carol@example.com",
+				"active":   false,
+			},
+		},
+		groups: []map[string]any{
+			{
+				"id":          "g1",
+				"displayName": "engineering",
+				"members":     []any{map[string]any{"value": "u2"}},
+			},
+			{
+				"id":          "g2",
+				"displayName": "admins",
+				"members":     []any{map[string]any{"value": "u1"}, map[string]any{"value": "u3"}, map[string]any{"value": "g3", "type": "Group"}},
+			},
+			{
+				"id":          "g3",
+				"displayName": "empty",
+			},
+		},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		id, secret, ok := r.BasicAuth()
+		if !ok || id != testSCIMClientID || secret != testSCIMClientSecret || r.FormValue("grant_type") != "client_credentials" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"` + testSCIMToken + `","token_type":"Bearer","expires_in":3600}`))
+	})
+	list := func(resourceType string, resources []map[string]any) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Bearer "+testSCIMToken {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			s.requests[resourceType]++
+			startIndex, err := strconv.Atoi(r.URL.Query().Get("startIndex"))
+			require.NoError(t, err)
+			count, err := strconv.Atoi(r.URL.Query().Get("count"))
+			require.NoError(t, err)
+
+			page := []map[string]any{}
+			for i := startIndex - 1; i < len(resources) && len(page) < count; i++ {
+				page = append(page, resources[i])
+			}
+			err = json.NewEncoder(w).Encode(map[string]any{
+				"schemas":      []string{"urn:ietf:params:scim:api:messages:2.0:ListResponse"},
+				"totalResults": len(resources),
+				"startIndex":   startIndex,
+				"itemsPerPage": len(page),
+				"Resources":    page,
+			})
+			require.NoError(t, err)
+		}
+	}
+	mux.HandleFunc("/scim/v2/Users", list("Users", s.users))
+	mux.HandleFunc("/scim/v2/Groups", list("Groups", s.groups))
+
+	s.Server = httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+	return s
+}
+
+func newTestSCIMSync(t *testing.T, s *testSCIMServer, values map[string]string) *SCIMSync {
+	ctx := context.Background()
+	cfg := map[string]string{
+		"baseUrl": s.URL + "/scim/v2",
+		"secret":  testSCIMToken,
+	}
+	for k, v := range values {
+		cfg[k] = v
+	}
+	p := &SCIMSync{}
+	err := p.Config().Load(ctx, &gconfig.MapLoader{Values: cfg})
+	require.NoError(t, err)
+	err = p.Init(ctx)
+	require.NoError(t, err)
+	return p
+}
+
+func TestSCIMSync(t *testing.T) {
+	ctx := context.Background()
+	s := newTestSCIMServer(t)
+	p := newTestSCIMSync(t, s, map[string]string{"pageSize": "2"})
+
+	groups, err := p.ListGroups(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []identity.IDPGroup{
+		{ID: "g2", Name: "admins"},
+		{ID: "g3", Name: "empty"},
+		{ID: "g1", Name: "engineering"},
+	}, groups)
+	// the 3 groups are returned in 2 pages
+	assert.Equal(t, 2, s.requests["Groups"])
+
+	users, err := p.ListUsers(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []identity.IDPUser{
+		{ID: "u1", Email: "alice@example.com", FirstName: "Alice", LastName: "Smith", Groups: []string{"g1", "g2"}},
+		{ID: "u2", Email: "bob@example.com", FirstName: "Bob", LastName: "Jones", Groups: []string{"g1"}},
+	}, users)
+	assert.Equal(t, 2, s.requests["Users"])
+}
+
+func TestSCIMSyncListGroupEdges(t *testing.T) {
+	s := newTestSCIMServer(t)
+	p := newTestSCIMSync(t, s, nil)
+
+	edges, err := p.ListGroupEdges(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []identity.IDPGroupEdge{{Parent: "g2", Child: "g3"}}, edges)
+}
+
+func TestSCIMSyncFetchesGroupsOncePerSync(t *testing.T) {
+	s := newTestSCIMServer(t)
+	p := newTestSCIMSync(t, s, nil)
+	syncer := &IdentitySyncer{resolveNestedGroups: true}
+	src := identitySource{idpType: "scim", idp: p}
+
+	for i := 1; i <= 2; i++ {
+		_, _, _, err := syncer.fetchSource(context.Background(), src)
+		require.NoError(t, err)
+		// the groups are needed to list users, groups and group edges, but are only paged through once per sync
+		assert.Equal(t, i, s.requests["Groups"])
+	}
+}
+
+func TestSCIMSyncAttributeMappings(t *testing.T) {
+	ctx := context.Background()
+	s := newTestSCIMServer(t)
+	p := newTestSCIMSync(t, s, map[string]string{
+		"userIdAttribute":    "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:employeeNumber",
+		"emailAttribute":     "userName",
+		"groupIdAttribute":   "displayName",
+		"groupNameAttribute": "id",
+	})
+
+	users, err := p.ListUsers(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []identity.IDPUser{
+		{ID: "1001", Email: "alice", FirstName: "Alice", LastName: "Smith", Groups: []string{"admins", "engineering"}},
+		{ID: "1002", Email: "bob@example.com", FirstName: "Bob", LastName: "Jones", Groups: []string{"engineering"}},
+	}, users)
+}
+
+func TestSCIMSyncClientCredentials(t *testing.T) {
+	ctx := context.Background()
+	s := newTestSCIMServer(t)
+	p := newTestSCIMSync(t, s, map[string]string{
+		"authMethod": "client-credentials",
+		"clientId":   testSCIMClientID,
+		"tokenUrl":   s.URL + "/token",
+		"secret":     testSCIMClientSecret,
+	})
+	err := p.TestConfig(ctx)
+	assert.NoError(t, err)
+
+	p = newTestSCIMSync(t, s, map[string]string{
+		"authMethod": "client-credentials",
+		"clientId":   testSCIMClientID,
+		"tokenUrl":   s.URL + "/token",
+		"secret":     "wrong",
+	})
+	err = p.TestConfig(ctx)
+	assert.Error(t, err)
+}
+
+func TestSCIMSyncInit(t *testing.T) {
+	type testcase struct {
+		name   string
+		values map[string]string
+	}
+	testcases := []testcase{
+		{
+			name:   "invalid auth method",
+			values: map[string]string{"authMethod": "basic"},
+		},
+		{
+			name:   "client credentials without a token url",
+			values: map[string]string{"authMethod": "client-credentials", "clientId": "client"},
+		},
+		{
+			name:   "invalid attribute mapping",
+			values: map[string]string{"emailAttribute": "emails[type eq"},
+		},
+		{
+			name:   "invalid page size",
+			values: map[string]string{"pageSize": "0"},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			cfg := map[string]string{"baseUrl": "https://example.com/scim/v2", "secret": "token"}
+			for k, v := range tc.values {
+				cfg[k] = v
+			}
+			p := &SCIMSync{}
+			err := p.Config().Load(ctx, &gconfig.MapLoader{Values: cfg})
+			require.NoError(t, err)
+			err = p.Init(ctx)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package identitysync
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/common-fate/common-fate/pkg/deploy"
+	"github.com/common-fate/common-fate/pkg/gconfig"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/types"
+)
+
+// Settings for identity sources, which can be set in the configuration of any identity provider in the IdentityConfiguration.
+// They are prefixed with identity so that they don't conflict with the settings of the identity providers.
+const (
+	// SourceTagKey is the tag recorded as the source of the users and groups which are synced from the identity provider.
+	// Identity providers other than the IdentityProviderType are synced as additional identity sources if it is set.
+	SourceTagKey = "identitySource"
+	// SourceGroupFilterKey is a regex filter for the groups which are synced from the identity provider.
+	SourceGroupFilterKey = "identityGroupFilter"
+	// SourcePrecedenceKey decides which identity source sets the name and attributes of users who are in several sources.
+	// Sources with a lower precedence value take priority.
+	SourcePrecedenceKey = "identityPrecedence"
+)
+
+// SourceKeys are the identity source settings, which are kept when the configuration of an identity provider is updated.
+var SourceKeys = []string{SourceTagKey, SourceGroupFilterKey, SourcePrecedenceKey}
+
+// identitySource is an identity provider which users and groups are synced from.
+type identitySource struct {
+	// tag is recorded as the source of the groups which are synced from the identity provider,
+	// and in the identity sources of the users
+	tag         string
+	idpType     string
+	idp         IdentityProvider
+	groupFilter string
+	precedence  int
+	// primary is true for the identity provider which users sign in with.
+	// Users and groups which were synced before multiple identity sources were supported belong to the primary source.
+	primary bool
+}
+
+// loadAdditionalSources loads the identity providers in the identity configuration which are synced as additional identity sources.
+func loadAdditionalSources(ctx context.Context, primaryType string, identityConfig deploy.FeatureMap) ([]identitySource, error) {
+	var sources []identitySource
+	for idpType, cfg := range identityConfig {
+		if idpType == primaryType || cfg[SourceTagKey] == "" {
+			continue
+		}
+		idp, err := Registry().Lookup(idpType)
+		if err != nil {
+			return nil, err
+		}
+		err = idp.IdentityProvider.Config().Load(ctx, &gconfig.MapLoader{Values: cfg})
+		if err != nil {
+			return nil, fmt.Errorf("loading identity source %s: %w", cfg[SourceTagKey], err)
+		}
+		err = idp.IdentityProvider.Init(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("initialising identity source %s: %w", cfg[SourceTagKey], err)
+		}
+		src := identitySource{
+			tag:         cfg[SourceTagKey],
+			idpType:     idpType,
+			idp:         idp.IdentityProvider,
+			groupFilter: cfg[SourceGroupFilterKey],
+		}
+		src.precedence, err = parsePrecedence(cfg)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, src)
+	}
+	// sources are synced in a consistent order
+	sort.Slice(sources, func(i, j int) bool { return sources[i].tag < sources[j].tag })
+	return sources, nil
+}
+
+func parsePrecedence(cfg map[string]string) (int, error) {
+	if cfg[SourcePrecedenceKey] == "" {
+		return 0, nil
+	}
+	p, err := strconv.Atoi(cfg[SourcePrecedenceKey])
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q, must be an integer", SourcePrecedenceKey, cfg[SourcePrecedenceKey])
+	}
+	return p, nil
+}
+
+// validateSources checks that the identity sources have distinct tags which don't conflict with the sources of groups managed outside identity sync.
+func validateSources(sources []identitySource) error {
+	seen := make(map[string]bool)
+	for _, s := range sources {
+		if managedOutsideSync(s.tag) {
+			return fmt.Errorf("identity source tag %q is reserved", s.tag)
+		}
+		if seen[s.tag] {
+			return fmt.Errorf("identity source tag %q is used by more than one identity provider", s.tag)
+		}
+		seen[s.tag] = true
+	}
+	return nil
+}
+
+// syncScope is the identity source which is being synced, along with the other configured sources whose users and groups it must not change.
+type syncScope struct {
+	source     identitySource
+	primaryTag string
+	// precedence of every configured source by tag
+	precedence map[string]int
+}
+
+func newSyncScope(sources []identitySource, i int) syncScope {
+	sc := syncScope{
+		source:     sources[i],
+		precedence: make(map[string]int),
+	}
+	for _, s := range sources {
+		sc.precedence[s.tag] = s.precedence
+		if s.primary {
+			sc.primaryTag = s.tag
+		}
+	}
+	return sc
+}
+
+// isOtherSource returns true if tag is another configured identity source.
+func (sc syncScope) isOtherSource(tag string) bool {
+	_, ok := sc.precedence[tag]
+	return ok && tag != sc.source.tag
+}
+
+// managesGroup returns true if the members of groups with the given source are managed by the source being synced.
+// The primary source also manages the groups of identity providers which are no longer configured, so that they are archived.
+func (sc syncScope) managesGroup(groupSource string) bool {
+	if managedOutsideSync(groupSource) {
+		return false
+	}
+	return groupSource == sc.source.tag || (sc.source.primary && !sc.isOtherSource(groupSource))
+}
+
+// userSources returns the configured identity sources which a user was synced from.
+// Active users which weren't synced from any configured source belong to the primary source, unless they were provisioned through SCIM.
+func (sc syncScope) userSources(u identity.User) []string {
+	var sources []string
+	for _, s := range u.IdentitySources {
+		if _, ok := sc.precedence[s]; ok {
+			sources = append(sources, s)
+		}
+	}
+	if len(sources) == 0 && u.Source != identity.SCIM && u.Status != types.IdpStatusARCHIVED {
+		return []string{sc.primaryTag}
+	}
+	return sources
+}
+
+// setsProfile returns true if the source being synced has the highest precedence of the given sources of a user,
+// and so sets the user's name, attributes and manager.
+// Ties are won by the primary source, and then by the source with the lowest tag.
+func (sc syncScope) setsProfile(userSources []string) bool {
+	for _, s := range userSources {
+		if s != sc.source.tag && sc.ranksBefore(s, sc.source.tag) {
+			return false
+		}
+	}
+	return true
+}
+
+func (sc syncScope) ranksBefore(a, b string) bool {
+	if sc.precedence[a] != sc.precedence[b] {
+		return sc.precedence[a] < sc.precedence[b]
+	}
+	if a == sc.primaryTag || b == sc.primaryTag {
+		return a == sc.primaryTag
+	}
+	return a < b
+}
+
+// SourceError is returned when one or more identity sources fail to sync. The other sources are still synced.
+type SourceError struct {
+	// Errors are keyed by the tag of the identity source
+	Errors map[string]error
+}
+
+func (e *SourceError) Error() string {
+	tags := make([]string, 0, len(e.Errors))
+	for tag := range e.Errors {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	msgs := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", tag, e.Errors[tag]))
+	}
+	return "failed to sync identity sources: " + strings.Join(msgs, "; ")
+}
+
+// As finds the ArchiveThresholdError of a source which was aborted, so that aborted syncs can be detected with errors.As.
+func (e *SourceError) As(target any) bool {
+	t, ok := target.(**ArchiveThresholdError)
+	if !ok {
+		return false
+	}
+	for _, err := range e.Errors {
+		if a, ok := err.(*ArchiveThresholdError); ok {
+			*t = a
+			return true
+		}
+	}
+	return false
+}
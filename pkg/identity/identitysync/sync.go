@@ -3,14 +3,20 @@ package identitysync
 import (
 	"context"
 	"errors"
+	"sort"
+	"strings"
 	"sync"
 
+	"github.com/benbjohnson/clock"
 	"github.com/common-fate/analytics-go"
 	"github.com/common-fate/apikit/logger"
 	"github.com/common-fate/common-fate/pkg/depid"
 	"github.com/common-fate/common-fate/pkg/deploy"
 	"github.com/common-fate/common-fate/pkg/gconfig"
+	"github.com/common-fate/common-fate/pkg/gevent"
 	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/service/bulkrevokesvc"
+	"github.com/common-fate/common-fate/pkg/service/deprovisionsvc"
 	"github.com/common-fate/common-fate/pkg/service/identitysvc"
 	"github.com/common-fate/common-fate/pkg/storage"
 	"github.com/common-fate/common-fate/pkg/types"
@@ -25,15 +31,34 @@ type IdentityProvider interface {
 	gconfig.Initer
 }
 
+// DeprovisionHook is run for each user who is archived by a sync, once the changes have been saved.
+type DeprovisionHook interface {
+	Deprovision(ctx context.Context, user identity.User) error
+}
+
 type IdentitySyncer struct {
-	db      ddb.Storage
+	db ddb.Storage
+	// idp is the primary identity source, which users sign in with
 	idp     IdentityProvider
 	idpType string
+	// sourceTag and precedence of the primary identity source, the tag defaults to the idpType
+	sourceTag  string
+	precedence int
+	// sources are the additional identity sources which are synced along with the primary source
+	sources []identitySource
 	// used to prevent concurrent calls to sync
 	// prevents unexpected duplication of users and groups when used asyncronously
 	syncMutex       sync.Mutex
 	groupFilter     string
 	IdentityService identitysvc.Service
+	// archiveThreshold is the percentage of users or groups which a sync can archive before it is aborted
+	archiveThreshold int
+	clock            clock.Clock
+	// eventbus is optional, if it is set an event is sent when a sync is aborted
+	eventbus gevent.EventPutter
+	// resolveNestedGroups makes users members of the groups which their groups are nested in
+	resolveNestedGroups bool
+	deprovisionHooks    []DeprovisionHook
 }
 
 type SyncOpts struct {
@@ -42,13 +67,42 @@ type SyncOpts struct {
 	UserPoolId          string
 	IdentityConfig      deploy.FeatureMap
 	IdentityGroupFilter string
+	// ArchiveThreshold is the percentage of the active users or groups which a sync can archive
+	// before it is aborted, unless it is forced. 0 disables the check.
+	ArchiveThreshold int
+	// EventBusArn is optional, if it is set an event is sent when a sync is aborted
+	EventBusArn string
+	// ResolveNestedGroups makes users members of the groups which their groups are nested in,
+	// for identity providers which implement NestedGroupLister.
+	ResolveNestedGroups bool
+	// DeprovisioningMode is either enforce or report, and defaults to report.
+	// It controls whether the access of users who are archived is cleaned up, or only reported.
+	// Deprovisioning requires EventBusArn to be set.
+	DeprovisioningMode string
+}
+
+// RunOpts configures a single run of the identity sync.
+type RunOpts struct {
+	// DryRun reports the changes which the sync would make without applying them
+	DryRun bool
+	// Force applies the changes even if they archive more users or groups than the archive threshold
+	Force bool
+}
+
+// SyncReport is the outcome of a sync and the users and groups it created, updated and archived.
+// For dry runs and aborted syncs, the changes were not applied.
+type SyncReport struct {
+	Run     identity.SyncRun
+	Changes identity.SyncChanges
 }
 
 func NewIdentitySyncer(ctx context.Context, opts SyncOpts) (*IdentitySyncer, error) {
-	db, err := ddb.New(ctx, opts.TableName)
+	ddbClient, err := ddb.New(ctx, opts.TableName)
 	if err != nil {
 		return nil, err
 	}
+	// deprovisioning revokes and cancels requests, so status transitions are written with conditional writes
+	db := storage.NewStatusGuard(ddbClient)
 
 	idp, err := Registry().Lookup(opts.IdpType)
 	if err != nil {
@@ -83,33 +137,448 @@ func NewIdentitySyncer(ctx context.Context, opts SyncOpts) (*IdentitySyncer, err
 	if err != nil {
 		return nil, err
 	}
-	return &IdentitySyncer{
-		db:          db,
+
+	// the reserved identity source settings are optional for the primary identity provider
+	primaryCfg := opts.IdentityConfig[opts.IdpType]
+	groupFilter := opts.IdentityGroupFilter
+	if primaryCfg[SourceGroupFilterKey] != "" {
+		groupFilter = primaryCfg[SourceGroupFilterKey]
+	}
+	precedence, err := parsePrecedence(primaryCfg)
+	if err != nil {
+		return nil, err
+	}
+	sources, err := loadAdditionalSources(ctx, opts.IdpType, opts.IdentityConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	deprovisioningMode, err := identity.ParseDeprovisioningMode(opts.DeprovisioningMode)
+	if err != nil {
+		return nil, err
+	}
+
+	var eventbus gevent.EventPutter
+	var deprovisionHooks []DeprovisionHook
+	if opts.EventBusArn != "" {
+		eventbus, err = gevent.NewSender(ctx, gevent.SenderOpts{
+			EventBusARN: opts.EventBusArn,
+		})
+		if err != nil {
+			return nil, err
+		}
+		clk := clock.New()
+		deprovisionHooks = append(deprovisionHooks, &deprovisionsvc.Service{
+			DB:       db,
+			Clock:    clk,
+			Eventbus: eventbus,
+			BulkRevoke: &bulkrevokesvc.Service{
+				DB:       db,
+				Clock:    clk,
+				Eventbus: eventbus,
+			},
+			Mode: deprovisioningMode,
+		})
+	}
+
+	s := &IdentitySyncer{
+		// users and groups are written with a condition on their version, so that changes made through the SCIM API during a sync aren't overwritten
+		db:          storage.NewVersionGuard(db),
 		idp:         idp.IdentityProvider,
 		idpType:     opts.IdpType,
-		groupFilter: opts.IdentityGroupFilter,
+		sourceTag:   primaryCfg[SourceTagKey],
+		precedence:  precedence,
+		sources:     sources,
+		groupFilter: groupFilter,
 		IdentityService: identitysvc.Service{
 			DB: db,
 		},
-	}, nil
+		archiveThreshold:    opts.ArchiveThreshold,
+		clock:               clock.New(),
+		eventbus:            eventbus,
+		resolveNestedGroups: opts.ResolveNestedGroups,
+		deprovisionHooks:    deprovisionHooks,
+	}
+	err = validateSources(s.identitySources())
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
 }
 
+// identitySources returns the identity sources which are synced, starting with the primary source.
+func (s *IdentitySyncer) identitySources() []identitySource {
+	tag := s.sourceTag
+	if tag == "" {
+		tag = s.idpType
+	}
+	primary := identitySource{
+		tag:         tag,
+		idpType:     s.idpType,
+		idp:         s.idp,
+		groupFilter: s.groupFilter,
+		precedence:  s.precedence,
+		primary:     true,
+	}
+	return append([]identitySource{primary}, s.sources...)
+}
+
+// Sync applies the changes from the identity provider to the users and groups in the database.
 func (s *IdentitySyncer) Sync(ctx context.Context) error {
+	_, err := s.Run(ctx, RunOpts{})
+	return err
+}
+
+// Run syncs the users and groups from the identity provider and records the run in the sync history.
+// If the sync would archive more users or groups than the archive threshold, it is aborted with an ArchiveThresholdError
+// unless opts.Force is set. The report is returned along with any error, so that the changes can be reviewed.
+func (s *IdentitySyncer) Run(ctx context.Context, opts RunOpts) (*SyncReport, error) {
 	// prevent concurrent calls to sync
 	s.syncMutex.Lock()
 	defer s.syncMutex.Unlock()
 	log := logger.Get(ctx)
 
+	var tags []string
+	for _, src := range s.identitySources() {
+		tags = append(tags, src.tag)
+	}
+	now := s.clock.Now()
+	run := identity.SyncRun{
+		ID:               types.NewIdentitySyncRunID(),
+		IdentityProvider: strings.Join(tags, ","),
+		DryRun:           opts.DryRun,
+		Forced:           opts.Force,
+		StartedAt:        now,
+		ExpiresAt:        now.Add(identity.DefaultSyncRunRetention).Unix(),
+	}
+
+	changes, syncErr := s.sync(ctx, opts)
+	run.Changes = changes.Counts()
+	run.CompletedAt = s.clock.Now()
+	run.Status = identity.SyncRunStatusSucceeded
+	var thresholdErr *ArchiveThresholdError
+	if errors.As(syncErr, &thresholdErr) {
+		run.Status = identity.SyncRunStatusAborted
+	} else if syncErr != nil {
+		run.Status = identity.SyncRunStatusFailed
+	}
+	if syncErr != nil {
+		msg := syncErr.Error()
+		run.Error = &msg
+	}
+	log.Infow("identity sync completed", "run", run)
+
+	// dry runs report that they would be aborted, but they don't raise an alert.
+	// An alert is only raised when syncs start being aborted, rather than every few minutes until the cause is fixed.
+	if run.Status == identity.SyncRunStatusAborted && !opts.DryRun && s.eventbus != nil && !s.wasAborted(ctx) {
+		err := s.eventbus.Put(ctx, gevent.IdentitySyncAborted{Run: run, Threshold: thresholdErr.Threshold})
+		if err != nil {
+			log.Errorw("failed to send identity sync aborted event", "error", err)
+		}
+	}
+
+	err := s.db.Put(ctx, &run)
+	if err != nil {
+		return nil, err
+	}
+	return &SyncReport{Run: run, Changes: changes}, syncErr
+}
+
+// resolveNestedGroupMembership adds the groups which users are members of through nested groups, if the identity provider supports them.
+func (s *IdentitySyncer) resolveNestedGroupMembership(ctx context.Context, src identitySource, idpUsers []identity.IDPUser) ([]identity.IDPUser, error) {
+	log := logger.Get(ctx)
+	lister, ok := src.idp.(NestedGroupLister)
+	if !ok {
+		log.Warnw("nested group resolution is enabled, but the identity provider doesn't support nested groups", "idp", src.idpType)
+		return idpUsers, nil
+	}
+	edges, err := lister.ListGroupEdges(ctx)
+	if err != nil {
+		return nil, err
+	}
+	idpUsers, cycles := resolveNestedGroups(idpUsers, edges)
+	for _, c := range cycles {
+		log.Warnw("found a cycle of nested groups, members of these groups are members of all of them", "groups", c)
+	}
+	log.Infow("resolved nested groups", "edges.count", len(edges))
+	return idpUsers, nil
+}
+
+// ListRuns returns the most recent sync runs, most recent first.
+// Syncs run every few minutes, so only the latest runs are returned rather than the full history.
+func (s *IdentitySyncer) ListRuns(ctx context.Context) ([]identity.SyncRun, error) {
+	q := storage.ListIdentitySyncRuns{}
+	_, err := s.db.Query(ctx, &q, ddb.Limit(100))
+	if err != nil {
+		return nil, err
+	}
+	return q.Result, nil
+}
+
+// wasAborted returns true if the previous sync which wasn't a dry run was aborted.
+// If the previous sync can't be found it returns false, so that an alert is raised rather than missed.
+func (s *IdentitySyncer) wasAborted(ctx context.Context) bool {
+	runs, err := s.ListRuns(ctx)
+	if err != nil {
+		logger.Get(ctx).Errorw("failed to find the previous identity sync", "error", err)
+		return false
+	}
+	for _, r := range runs {
+		if !r.DryRun {
+			return r.Status == identity.SyncRunStatusAborted
+		}
+	}
+	return false
+}
+
+// maxWriteAttempts is how many times the users and groups are merged and written when they are changed by the SCIM API during a sync.
+const maxWriteAttempts = 3
+
+// fetchedSource is the users and groups which were fetched from an identity source.
+type fetchedSource struct {
+	idpUsers             []identity.IDPUser
+	idpGroups            []identity.IDPGroup
+	useIdpGroupsAsFilter bool
+	err                  error
+}
+
+// mergeResult is the users and groups after the changes of the identity sources are applied.
+type mergeResult struct {
+	// users and groups are nil if no identity source was synced
+	users  map[string]identity.User
+	groups map[string]identity.Group
+	// unapplied are the changes of the sources which weren't applied, which are still reported
+	unapplied identity.SyncChanges
+	errs      map[string]error
+	info      depid.UserInfo
+}
+
+// sync computes the changes to the users and groups, and applies them unless it is a dry run or the archive threshold is exceeded.
+//
+// Each identity source is synced in turn, starting from the users and groups as they were left by the previous source.
+// If a source fails or is aborted, its changes are not applied, but the other sources are still synced.
+//
+// The identity sources are fetched before the users and groups are read, and the users and groups are written with a
+// condition on the version they were read with. If they were changed through the SCIM API in the meantime, they are read
+// again and the changes of the identity sources are merged into them again, so that the SCIM changes aren't overwritten.
+func (s *IdentitySyncer) sync(ctx context.Context, opts RunOpts) (identity.SyncChanges, error) {
+	log := logger.Get(ctx)
+	sources := s.identitySources()
+	fetched := make([]fetchedSource, len(sources))
+	for i, src := range sources {
+		idpUsers, idpGroups, useIdpGroupsAsFilter, err := s.fetchSource(ctx, src)
+		if err != nil {
+			log.Errorw("failed to fetch users and groups from identity source", "source", src.tag, "error", err)
+		}
+		fetched[i] = fetchedSource{idpUsers: idpUsers, idpGroups: idpGroups, useIdpGroupsAsFilter: useIdpGroupsAsFilter, err: err}
+	}
+
+	for attempt := 1; ; attempt++ {
+		changes, syncErr, err := s.apply(ctx, sources, fetched, opts)
+		if errors.Is(err, storage.ErrVersionConflict) && attempt < maxWriteAttempts {
+			log.Infow("users or groups were changed during the sync, merging the changes again", "attempt", attempt)
+			continue
+		}
+		if err != nil {
+			return changes, err
+		}
+		return changes, syncErr
+	}
+}
+
+// apply reads the users and groups, merges the changes of the identity sources into them and writes the users and groups which changed.
+// It returns the error of the identity sources separately from the error reading or writing the users and groups.
+func (s *IdentitySyncer) apply(ctx context.Context, sources []identitySource, fetched []fetchedSource, opts RunOpts) (identity.SyncChanges, error, error) {
+	log := logger.Get(ctx)
+	var changes identity.SyncChanges
+
+	uq := &storage.ListUsers{}
+	_, err := s.db.Query(ctx, uq)
+	if err != nil {
+		return changes, nil, err
+	}
+	gq := &storage.ListGroups{}
+	_, err = s.db.Query(ctx, gq)
+	if err != nil {
+		return changes, nil, err
+	}
+
+	merged := s.merge(ctx, sources, fetched, uq.Result, gq.Result, opts)
+	usersMap, groupsMap := merged.users, merged.groups
+	var applied identity.SyncChanges
+	if usersMap != nil {
+		applied = diffUsersAndGroups(uq.Result, gq.Result, usersMap, groupsMap)
+	}
+	changes.Add(applied)
+	changes.Add(merged.unapplied)
+	syncErr := sourcesError(sources, merged.errs)
+
+	// nothing is applied if no source was synced
+	if opts.DryRun || usersMap == nil {
+		return changes, syncErr, nil
+	}
+
+	//update users access rules
+	usersMap, err = s.IdentityService.UpdateUserAccessRules(ctx, usersMap, groupsMap)
+	if err != nil {
+		return changes, syncErr, err
+	}
+
+	// users who are archived are marked as pending deprovisioning until every deprovision hook has succeeded,
+	// so that the hooks are run again by the next sync if they fail
+	archived := make(map[string]bool)
+	for _, u := range applied.ArchivedUsers {
+		archived[u.ID] = true
+	}
+	for k, u := range usersMap {
+		u.DeprovisionPending = u.Status == types.IdpStatusARCHIVED && (u.DeprovisionPending || (archived[u.ID] && len(s.deprovisionHooks) > 0))
+		usersMap[k] = u
+	}
+
+	// only the users and groups which changed are written, with the version they were read with.
+	// SCIM users are only written when the sync changes their memberships of identity provider groups, and SCIM groups are never written.
+	readUsers := make(map[string]identity.User)
+	for _, u := range uq.Result {
+		readUsers[u.ID] = u
+	}
+	readGroups := make(map[string]identity.Group)
+	for _, g := range gq.Result {
+		readGroups[g.ID] = g
+	}
+	var items []ddb.Keyer
+	var pending []*identity.User
+	for _, v := range usersMap {
+		vi := v
+		if read, ok := readUsers[vi.ID]; !ok || !usersEqual(read, vi) {
+			items = append(items, &vi)
+		}
+		if vi.DeprovisionPending {
+			pending = append(pending, &vi)
+		}
+	}
+	for _, v := range groupsMap {
+		vi := v
+		// SCIM groups are managed through the SCIM API
+		if vi.Source == identity.SCIM {
+			continue
+		}
+		if read, ok := readGroups[vi.ID]; !ok || !groupsEqual(read, vi) {
+			items = append(items, &vi)
+		}
+	}
+
+	if len(items) > 0 {
+		err = s.db.PutBatch(ctx, items...)
+		if err != nil {
+			return changes, syncErr, err
+		}
+	}
+	s.setDeploymentInfo(ctx, log, merged.info)
+	sort.Slice(pending, func(i, j int) bool { return pending[i].Email < pending[j].Email })
+	s.deprovision(ctx, pending)
+	return changes, syncErr, nil
+}
+
+// merge applies the changes of each identity source in turn to the users and groups.
+func (s *IdentitySyncer) merge(ctx context.Context, sources []identitySource, fetched []fetchedSource, users []identity.User, groups []identity.Group, opts RunOpts) mergeResult {
+	log := logger.Get(ctx)
+	res := mergeResult{
+		errs: make(map[string]error),
+		info: depid.UserInfo{IDP: s.idpType},
+	}
+	for i, src := range sources {
+		f := fetched[i]
+		if f.err != nil {
+			res.errs[src.tag] = f.err
+			continue
+		}
+		scope := newSyncScope(sources, i)
+		sourceUsers, sourceGroups := processUsersAndGroups(scope, f.idpUsers, f.idpGroups, users, groups, f.useIdpGroupsAsFilter)
+		if !opts.Force {
+			sourceChanges := diffUsersAndGroups(users, groups, sourceUsers, sourceGroups)
+			err := checkArchiveThreshold(s.archiveThreshold, users, groups, sourceChanges)
+			if err != nil {
+				log.Errorw("identity source sync aborted", "source", src.tag, "error", err)
+				res.errs[src.tag] = err
+				res.unapplied.Add(sourceChanges)
+				continue
+			}
+		}
+		res.users, res.groups = sourceUsers, sourceGroups
+		users, groups = userValues(res.users), groupValues(res.groups)
+		res.info.UserCount += len(f.idpUsers)
+		res.info.GroupCount += len(f.idpGroups)
+	}
+	return res
+}
+
+// deprovision runs the deprovision hooks for the archived users who are pending deprovisioning,
+// and clears the mark of the users for whom every hook succeeded.
+// The users have already been archived, so failures are logged rather than failing the sync, and the hooks are run again by the next sync.
+func (s *IdentitySyncer) deprovision(ctx context.Context, users []*identity.User) {
+	log := logger.Get(ctx)
+	for _, u := range users {
+		failed := false
+		for _, hook := range s.deprovisionHooks {
+			err := hook.Deprovision(ctx, *u)
+			if err != nil {
+				log.Errorw("failed to deprovision user", "user.id", u.ID, "user.email", u.Email, "error", err)
+				failed = true
+			}
+		}
+		if failed {
+			continue
+		}
+		err := s.clearDeprovisionPending(ctx, u)
+		if err != nil {
+			log.Errorw("failed to clear the deprovisioning mark of user", "user.id", u.ID, "user.email", u.Email, "error", err)
+		}
+	}
+}
+
+// clearDeprovisionPending writes the user without the deprovisioning mark.
+// If the user was changed since it was read, it is read again so that the change isn't overwritten.
+func (s *IdentitySyncer) clearDeprovisionPending(ctx context.Context, u *identity.User) error {
+	for attempt := 1; ; attempt++ {
+		u.DeprovisionPending = false
+		err := s.db.Put(ctx, u)
+		if !errors.Is(err, storage.ErrVersionConflict) || attempt == maxWriteAttempts {
+			return err
+		}
+		q := storage.GetUser{ID: u.ID}
+		_, err = s.db.Query(ctx, &q)
+		if err != nil {
+			return err
+		}
+		u = q.Result
+	}
+}
+
+// fetchSource fetches the users and groups from an identity source and applies its group filter.
+func (s *IdentitySyncer) fetchSource(ctx context.Context, src identitySource) ([]identity.IDPUser, []identity.IDPGroup, bool, error) {
+	log := logger.Get(ctx)
+	// providers can reuse the results of their requests for the rest of this fetch
+	ctx = withFetchCache(ctx)
+
 	//Fetch all users from IDP
 	// The IDP should return the group mappings for users, these group IDs will be internal to the IDP
-	idpUsers, err := s.idp.ListUsers(ctx)
+	idpUsers, err := src.idp.ListUsers(ctx)
 	if err != nil {
-		return err
+		return nil, nil, false, err
 	}
 	// Fetch all groups from IDP
-	idpGroups, err := s.idp.ListGroups(ctx)
+	idpGroups, err := src.idp.ListGroups(ctx)
 	if err != nil {
-		return err
+		return nil, nil, false, err
+	}
+
+	// nested groups are resolved before groups are filtered, so that users of filtered out groups
+	// are still members of the groups which they are nested in
+	if s.resolveNestedGroups {
+		idpUsers, err = s.resolveNestedGroupMembership(ctx, src, idpUsers)
+		if err != nil {
+			return nil, nil, false, err
+		}
 	}
 
 	/*
@@ -167,52 +636,48 @@ func (s *IdentitySyncer) Sync(ctx context.Context) error {
 
 
 	*/
-	filter := s.groupFilter
+	filter := src.groupFilter
 	useIdpGroupsAsFilter := filter != ""
 
 	if useIdpGroupsAsFilter {
 		// overwrite the existing groups with the filtered groups
-		log.Infow("filtering groups", "filter", filter)
+		log.Infow("filtering groups", "source", src.tag, "filter", filter)
 		idpGroups, err = FilterGroups(idpGroups, filter)
 		if err != nil {
-			return err
+			return nil, nil, false, err
 		}
 	}
 
-	log.Infow("fetched users and groups from IDP", "users.count", len(idpUsers), "groups.count", len(idpGroups))
-
-	s.setDeploymentInfo(ctx, log, depid.UserInfo{UserCount: len(idpUsers), GroupCount: len(idpGroups), IDP: s.idpType})
+	log.Infow("fetched users and groups from IDP", "source", src.tag, "users.count", len(idpUsers), "groups.count", len(idpGroups))
+	return idpUsers, idpGroups, useIdpGroupsAsFilter, nil
+}
 
-	uq := &storage.ListUsers{}
-	_, err = s.db.Query(ctx, uq)
-	if err != nil {
-		return err
+// sourcesError returns the errors of the identity sources which failed to sync.
+// When there is only one identity source its error is returned as is.
+func sourcesError(sources []identitySource, errs map[string]error) error {
+	if len(errs) == 0 {
+		return nil
 	}
-	gq := &storage.ListGroups{}
-	_, err = s.db.Query(ctx, gq)
-	if err != nil {
-		return err
+	if len(sources) == 1 {
+		return errs[sources[0].tag]
 	}
-	usersMap, groupsMap := processUsersAndGroups(s.idpType, idpUsers, idpGroups, uq.Result, gq.Result, useIdpGroupsAsFilter)
+	return &SourceError{Errors: errs}
+}
 
-	//update users access rules
-	usersMap, err = s.IdentityService.UpdateUserAccessRules(ctx, usersMap, groupsMap)
-	if err != nil {
-		return err
+func userValues(m map[string]identity.User) []identity.User {
+	res := make([]identity.User, 0, len(m))
+	for _, u := range m {
+		res = append(res, u)
 	}
+	return res
+}
 
-	items := make([]ddb.Keyer, 0, len(usersMap)+len(groupsMap))
-	for _, v := range usersMap {
-		vi := v
-
-		items = append(items, &vi)
-	}
-	for _, v := range groupsMap {
-		vi := v
-		items = append(items, &vi)
+func groupValues(m map[string]identity.Group) []identity.Group {
+	res := make([]identity.Group, 0, len(m))
+	for _, g := range m {
+		res = append(res, g)
 	}
-
-	return s.db.PutBatch(ctx, items...)
+	return res
 }
 
 // analytics event
@@ -235,7 +700,10 @@ func (s *IdentitySyncer) setDeploymentInfo(ctx context.Context, log *zap.Sugared
 // useIdpGroupsAsFilter == true: only users with groups that exist in the IDP will be returned, this is used conditionally with a regex filter that prefilters any groups. Side effects: users with no groups are removed, only filtered groups show in the UI (loss of information; for better or worse)
 //
 // useIdpGroupsAsFilter == false: users with no groups remain, all groups show in the UI. If a user/group is removed from the IDP, it will be archived in the DB
-func processUsersAndGroups(idpType string, idpUsers []identity.IDPUser, idpGroups []identity.IDPGroup, internalUsers []identity.User, internalGroups []identity.Group, useIdpGroupsAsFilter bool) (map[string]identity.User, map[string]identity.Group) {
+//
+// Only the identity source in the scope is synced. Users are only archived once no identity source returns them,
+// and the groups of the other identity sources are left as they are.
+func processUsersAndGroups(scope syncScope, idpUsers []identity.IDPUser, idpGroups []identity.IDPGroup, internalUsers []identity.User, internalGroups []identity.Group, useIdpGroupsAsFilter bool) (map[string]identity.User, map[string]identity.Group) {
 
 	idpGroupMap := make(map[string]identity.IDPGroup)
 	for _, g := range idpGroups {
@@ -261,24 +729,63 @@ func processUsersAndGroups(idpType string, idpUsers []identity.IDPUser, idpGroup
 	for _, u := range idpUserMap {
 		//update
 		if existing, ok := ddbUserMap[u.Email]; ok {
-			existing.FirstName = u.FirstName
-			existing.LastName = u.LastName
+			// the profile of SCIM users is managed through the SCIM API
+			if existing.Source == identity.SCIM {
+				continue
+			}
+			sources := scope.userSources(existing)
+			// users in several identity sources get their profile from the source with the highest precedence
+			if scope.setsProfile(sources) {
+				existing.FirstName = u.FirstName
+				existing.LastName = u.LastName
+				existing.Attributes = u.Attributes
+			}
+			existing.IdentitySources = addSource(sources, scope.source.tag)
 			ddbUserMap[u.Email] = existing
 		} else {
 			// create
-			ddbUserMap[u.Email] = u.ToInternalUser()
+			newUser := u.ToInternalUser()
+			newUser.IdentitySources = []string{scope.source.tag}
+			ddbUserMap[u.Email] = newUser
+		}
+	}
+	// managers are resolved once all users have an internal id.
+	// identity providers reference managers by either their ID or their email.
+	idpUserEmails := make(map[string]string)
+	for _, u := range idpUserMap {
+		idpUserEmails[u.ID] = u.Email
+	}
+	for email, u := range idpUserMap {
+		internalUser := ddbUserMap[email]
+		if internalUser.Source == identity.SCIM || !scope.setsProfile(internalUser.IdentitySources) {
+			continue
 		}
+		internalUser.ManagerID = ""
+		if u.Manager != "" {
+			managerEmail, ok := idpUserEmails[u.Manager]
+			if !ok {
+				managerEmail = u.Manager
+			}
+			if manager, ok := ddbUserMap[managerEmail]; ok && manager.ID != internalUser.ID {
+				internalUser.ManagerID = manager.ID
+			}
+		}
+		ddbUserMap[email] = internalUser
 	}
 	// update/create groups
 	for _, idpGroup := range idpGroups {
 		if existingGroup, ok := ddbGroupMap[idpGroup.ID]; ok { //update
+			// SCIM groups are managed through the SCIM API
+			if existingGroup.Source == identity.SCIM {
+				continue
+			}
 			existingGroup.Description = idpGroup.Description
 			existingGroup.Name = idpGroup.Name
 			existingGroup.Status = types.IdpStatusACTIVE
-			existingGroup.Source = idpType
+			existingGroup.Source = scope.source.tag
 			ddbGroupMap[idpGroup.ID] = existingGroup
 		} else { // create
-			newGroup := idpGroup.ToInternalGroup(idpType)
+			newGroup := idpGroup.ToInternalGroup(scope.source.tag)
 			ddbGroupMap[idpGroup.ID] = newGroup
 			internalGroupUsers[newGroup.ID] = make(map[string]string)
 		}
@@ -286,10 +793,26 @@ func processUsersAndGroups(idpType string, idpUsers []identity.IDPUser, idpGroup
 
 	// archive deleted users
 	for k, u := range ddbUserMap {
+		if u.Source == identity.SCIM {
+			// SCIM users are archived through the SCIM API, but they lose the memberships
+			// of identity provider groups if the identity provider no longer returns them
+			if _, ok := idpUserMap[k]; !ok {
+				u.Groups = groupsNotManagedBy(scope, u.Groups, ddbGroupMap)
+				ddbUserMap[k] = u
+			}
+			continue
+		}
 		if _, ok := idpUserMap[k]; !ok {
-			u.Status = types.IdpStatusARCHIVED
-			// Remove all group associations from archived users
-			u.Groups = []string{}
+			// users are archived once they aren't in any identity source
+			sources := removeSource(scope.userSources(u), scope.source.tag)
+			u.IdentitySources = sources
+			if len(sources) == 0 {
+				u.Status = types.IdpStatusARCHIVED
+				// Remove all group associations from archived users
+				u.Groups = []string{}
+			} else {
+				u.Groups = groupsNotManagedBy(scope, u.Groups, ddbGroupMap)
+			}
 			ddbUserMap[k] = u
 		} else {
 			u.Status = types.IdpStatusACTIVE
@@ -298,6 +821,10 @@ func processUsersAndGroups(idpType string, idpUsers []identity.IDPUser, idpGroup
 	}
 	// archive deleted groups
 	for k, g := range ddbGroupMap {
+		// groups of other identity sources are archived when those sources are synced
+		if g.Source == identity.SCIM || (g.Source != identity.INTERNAL && !scope.managesGroup(g.Source)) {
+			continue
+		}
 
 		if useIdpGroupsAsFilter {
 			if _, ok := idpGroupMap[g.ID]; !ok {
@@ -323,6 +850,10 @@ func processUsersAndGroups(idpType string, idpUsers []identity.IDPUser, idpGroup
 	}
 
 	for _, idpUser := range idpUserMap {
+		// SCIM users which were deleted through the SCIM API don't get any group memberships
+		if u := ddbUserMap[idpUser.Email]; u.Source == identity.SCIM && u.Status == types.IdpStatusARCHIVED {
+			continue
+		}
 
 		// This map ensures we have a distinct list of ids
 		internalGroupIds := map[string]string{}
@@ -358,8 +889,8 @@ func processUsersAndGroups(idpType string, idpUsers []identity.IDPUser, idpGroup
 			// if the group is internal, add it to the list of groups
 
 			source := ddbGroupMap[internalGroupId].Source
-			// if the group is internal, add it to the list of groups
-			if source == identity.INTERNAL {
+			// if the group is internal, provisioned by SCIM or from another identity source, add it to the list of groups
+			if !scope.managesGroup(source) {
 				gid := ddbGroupMap[internalGroupId].ID // not covered by tests
 				internalGroupIds[gid] = gid            // not covered by tests
 			}
@@ -370,9 +901,29 @@ func processUsersAndGroups(idpType string, idpUsers []identity.IDPUser, idpGroup
 			groupKeys = append(groupKeys, k)
 		}
 
+		// keep the nested group paths of groups which the user is still a member of
+		var groupPaths map[string][]string
+		for gid, path := range internalUser.GroupPaths {
+			if _, ok := internalGroupIds[gid]; ok && !scope.managesGroup(ddbGroupMap[gid].Source) {
+				if groupPaths == nil {
+					groupPaths = make(map[string][]string)
+				}
+				groupPaths[gid] = path
+			}
+		}
+		for gid, path := range idpUser.GroupPaths {
+			if _, ok := internalGroupIds[gid]; ok {
+				if groupPaths == nil {
+					groupPaths = make(map[string][]string)
+				}
+				groupPaths[gid] = path
+			}
+		}
+
 		internalUser.Groups = groupKeys
-		// if the user is not in any groups, archive them
-		if len(internalUser.Groups) == 0 && useIdpGroupsAsFilter {
+		internalUser.GroupPaths = groupPaths
+		// if the user is not in any groups and no other identity source has them, archive them
+		if len(internalUser.Groups) == 0 && useIdpGroupsAsFilter && internalUser.Source != identity.SCIM && len(removeSource(internalUser.IdentitySources, scope.source.tag)) == 0 {
 			internalUser.Status = types.IdpStatusARCHIVED
 		}
 		ddbUserMap[idpUser.Email] = internalUser
@@ -380,7 +931,7 @@ func processUsersAndGroups(idpType string, idpUsers []identity.IDPUser, idpGroup
 
 	// Updates the internal groups with new user mappings
 	for k, v := range ddbGroupMap {
-		if v.Source != identity.INTERNAL {
+		if scope.managesGroup(v.Source) {
 			um := internalGroupUsers[v.ID]
 			keys := make([]string, 0, len(um))
 			for k2 := range um {
@@ -393,3 +944,68 @@ func processUsersAndGroups(idpType string, idpUsers []identity.IDPUser, idpGroup
 
 	return ddbUserMap, ddbGroupMap
 }
+
+// groupsNotManagedBy returns the groups whose members aren't managed by the identity source in the scope.
+func groupsNotManagedBy(scope syncScope, groupIDs []string, groups map[string]identity.Group) []string {
+	var res []string
+	for _, gid := range groupIDs {
+		if !scope.managesGroup(groups[gid].Source) {
+			res = append(res, gid)
+		}
+	}
+	return res
+}
+
+func addSource(sources []string, tag string) []string {
+	for _, s := range sources {
+		if s == tag {
+			return sources
+		}
+	}
+	return append(sources, tag)
+}
+
+func removeSource(sources []string, tag string) []string {
+	var res []string
+	for _, s := range sources {
+		if s != tag {
+			res = append(res, s)
+		}
+	}
+	return res
+}
+
+// managedOutsideSync returns true for sources of groups whose members aren't managed by identity sync.
+// Internal groups are managed in Common Fate, and SCIM groups are managed through the SCIM API.
+func managedOutsideSync(source string) bool {
+	return source == identity.INTERNAL || source == identity.SCIM
+}
+
+func (r *SyncReport) ToAPI() types.IdentitySyncReport {
+	res := types.IdentitySyncReport{
+		Run: r.Run.ToAPI(),
+	}
+	res.Users.Created = usersToAPI(r.Changes.CreatedUsers)
+	res.Users.Updated = usersToAPI(r.Changes.UpdatedUsers)
+	res.Users.Archived = usersToAPI(r.Changes.ArchivedUsers)
+	res.Groups.Created = groupsToAPI(r.Changes.CreatedGroups)
+	res.Groups.Updated = groupsToAPI(r.Changes.UpdatedGroups)
+	res.Groups.Archived = groupsToAPI(r.Changes.ArchivedGroups)
+	return res
+}
+
+func usersToAPI(users []identity.User) []types.User {
+	res := make([]types.User, 0, len(users))
+	for _, u := range users {
+		res = append(res, u.ToAPI())
+	}
+	return res
+}
+
+func groupsToAPI(groups []identity.Group) []types.Group {
+	res := make([]types.Group, 0, len(groups))
+	for _, g := range groups {
+		res = append(res, g.ToAPI())
+	}
+	return res
+}
@@ -1,12 +1,25 @@
 package identitysync
 
 import (
+	"context"
+	"errors"
 	"sort"
 	"testing"
 	"time"
 
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/common-fate/pkg/depid"
+	eventmock "github.com/common-fate/common-fate/pkg/eventhandler/mocks"
+	"github.com/common-fate/common-fate/pkg/gconfig"
+	"github.com/common-fate/common-fate/pkg/gevent"
 	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/service/identitysvc"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/storage/keys"
 	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -680,11 +693,98 @@ func TestIdentitySyncProcessor(t *testing.T) {
 			withIdpType:          identity.INTERNAL,
 			useIdpGroupsAsFilter: true,
 		},
+		{
+			name:        "SCIM users and groups are not changed by sync",
+			withIdpType: "okta",
+			giveIdpUsers: []identity.IDPUser{
+				{ID: "1", FirstName: "josh", LastName: "wilkes", Email: "josh@test.go", Groups: []string{"idp1"}},
+			},
+			giveIdpGroups: []identity.IDPGroup{
+				{ID: "idp1", Name: "engineering"},
+			},
+			giveInternalUsers: []identity.User{
+				{ID: "usr_josh", FirstName: "Joshua", LastName: "Wilkes", Email: "josh@test.go", Groups: []string{"scim1"}, Status: types.IdpStatusACTIVE, Source: identity.SCIM, CreatedAt: now, UpdatedAt: now},
+				{ID: "usr_gone", FirstName: "gone", Email: "gone@test.go", Groups: []string{"idp1", "scim1"}, Status: types.IdpStatusACTIVE, Source: identity.SCIM, CreatedAt: now, UpdatedAt: now},
+			},
+			giveInternalGroups: []identity.Group{
+				{ID: "scim1", IdpID: "scim1", Name: "on-call", Users: []string{"usr_gone", "usr_josh"}, Status: types.IdpStatusACTIVE, Source: identity.SCIM, CreatedAt: now, UpdatedAt: now},
+				{ID: "idp1", IdpID: "idp1", Name: "engineering", Users: []string{"usr_gone"}, Status: types.IdpStatusACTIVE, Source: "okta", CreatedAt: now, UpdatedAt: now},
+			},
+			wantUserMap: map[string]identity.User{
+				// the profile isn't updated, but the user gets the memberships of identity provider groups
+				"josh@test.go": {FirstName: "Joshua", LastName: "Wilkes", Email: "josh@test.go", Groups: []string{"idp1", "scim1"}, Status: types.IdpStatusACTIVE, Source: identity.SCIM, CreatedAt: now, UpdatedAt: now},
+				// the user isn't archived, but loses the memberships of identity provider groups
+				"gone@test.go": {FirstName: "gone", Email: "gone@test.go", Groups: []string{"scim1"}, Status: types.IdpStatusACTIVE, Source: identity.SCIM, CreatedAt: now, UpdatedAt: now},
+			},
+			wantGroupMap: map[string]identity.Group{
+				"scim1": {IdpID: "scim1", Name: "on-call", Users: []string{"usr_gone", "usr_josh"}, Status: types.IdpStatusACTIVE, Source: identity.SCIM, CreatedAt: now, UpdatedAt: now},
+				"idp1":  {IdpID: "idp1", Name: "engineering", Users: []string{"usr_josh"}, Status: types.IdpStatusACTIVE, Source: "okta", CreatedAt: now, UpdatedAt: now},
+			},
+		},
+		{
+			name:        "attributes and managers are synced",
+			withIdpType: "okta",
+			giveIdpUsers: []identity.IDPUser{
+				{ID: "1", FirstName: "josh", Email: "josh@test.go", Groups: []string{}, Manager: "2", Attributes: map[string]string{"department": "engineering"}},
+				// managers can be referenced by email
+				{ID: "2", FirstName: "chris", Email: "chris@test.go", Groups: []string{}, Manager: "jack@test.go"},
+				{ID: "3", FirstName: "jack", Email: "jack@test.go", Groups: []string{}, Manager: "unknown"},
+			},
+			giveInternalUsers: []identity.User{
+				{ID: "usr_josh", FirstName: "josh", Email: "josh@test.go", Status: types.IdpStatusACTIVE, CreatedAt: now, UpdatedAt: now},
+				{ID: "usr_chris", FirstName: "chris", Email: "chris@test.go", Status: types.IdpStatusACTIVE, CreatedAt: now, UpdatedAt: now},
+				{ID: "usr_jack", FirstName: "jack", Email: "jack@test.go", ManagerID: "usr_old", Attributes: map[string]string{"department": "sales"}, Status: types.IdpStatusACTIVE, CreatedAt: now, UpdatedAt: now},
+			},
+			giveInternalGroups: []identity.Group{},
+			wantUserMap: map[string]identity.User{
+				"josh@test.go":  {FirstName: "josh", Email: "josh@test.go", Groups: []string{}, ManagerID: "usr_chris", Attributes: map[string]string{"department": "engineering"}, Status: types.IdpStatusACTIVE, CreatedAt: now, UpdatedAt: now},
+				"chris@test.go": {FirstName: "chris", Email: "chris@test.go", Groups: []string{}, ManagerID: "usr_jack", Status: types.IdpStatusACTIVE, CreatedAt: now, UpdatedAt: now},
+				// managers which can't be found are removed
+				"jack@test.go": {FirstName: "jack", Email: "jack@test.go", Groups: []string{}, Status: types.IdpStatusACTIVE, CreatedAt: now, UpdatedAt: now},
+			},
+			wantGroupMap: map[string]identity.Group{},
+		},
+		{
+			name:        "nested group paths are kept for groups which are synced",
+			withIdpType: "okta",
+			giveIdpUsers: []identity.IDPUser{
+				{
+					ID: "1", FirstName: "josh", LastName: "wilkes", Email: "josh@test.go",
+					Groups: []string{"team", "engineering", "everyone"},
+					GroupPaths: map[string][]string{
+						"engineering": {"team", "engineering"},
+						"everyone":    {"team", "engineering", "everyone"},
+					},
+				},
+			},
+			giveIdpGroups: []identity.IDPGroup{
+				{ID: "team", Name: "team"},
+				{ID: "engineering", Name: "engineering"},
+			},
+			giveInternalUsers:  []identity.User{},
+			giveInternalGroups: []identity.Group{},
+			wantUserMap: map[string]identity.User{
+				"josh@test.go": {
+					FirstName:  "josh",
+					LastName:   "wilkes",
+					Email:      "josh@test.go",
+					Groups:     []string{"engineering", "team"},
+					GroupPaths: map[string][]string{"engineering": {"team", "engineering"}},
+					Status:     types.IdpStatusACTIVE,
+				},
+			},
+			wantGroupMap: map[string]identity.Group{
+				"team":        {IdpID: "team", Name: "team", Status: types.IdpStatusACTIVE, Source: "okta"},
+				"engineering": {IdpID: "engineering", Name: "engineering", Status: types.IdpStatusACTIVE, Source: "okta"},
+			},
+			useIdpGroupsAsFilter: true,
+		},
 	}
 	for _, tc := range testcases {
 
 		t.Run(tc.name, func(t *testing.T) {
-			gotUsers, gotGroups := processUsersAndGroups(tc.withIdpType, tc.giveIdpUsers, tc.giveIdpGroups, tc.giveInternalUsers, tc.giveInternalGroups, tc.useIdpGroupsAsFilter)
+			scope := newSyncScope([]identitySource{{tag: tc.withIdpType, primary: true}}, 0)
+			gotUsers, gotGroups := processUsersAndGroups(scope, tc.giveIdpUsers, tc.giveIdpGroups, tc.giveInternalUsers, tc.giveInternalGroups, tc.useIdpGroupsAsFilter)
 			for k, u := range tc.wantUserMap {
 				got := gotUsers[k]
 				u.ID = got.ID
@@ -692,6 +792,9 @@ func TestIdentitySyncProcessor(t *testing.T) {
 					u.Groups = got.Groups
 					sort.Strings(u.Groups)
 				}
+				if u.IdentitySources == nil {
+					u.IdentitySources = got.IdentitySources
+				}
 				if u.CreatedAt.IsZero() {
 					u.CreatedAt = got.CreatedAt
 				}
@@ -732,3 +835,469 @@ func TestIdentitySyncProcessor(t *testing.T) {
 		})
 	}
 }
+
+type mockClient = ddbmock.Client
+
+// testDB records the items written by the syncer, and checks the versions of users and groups like storage.VersionGuard
+type testDB struct {
+	*mockClient
+	puts []ddb.Keyer
+	// versions are the stored versions of users and groups by their ID, items which aren't in it aren't checked
+	versions map[string]int
+}
+
+func (d *testDB) Put(ctx context.Context, item ddb.Keyer) error {
+	return d.PutBatch(ctx, item)
+}
+
+func (d *testDB) PutBatch(ctx context.Context, items ...ddb.Keyer) error {
+	for _, item := range items {
+		if v, ok := item.(storage.Versioned); ok {
+			if stored, ok := d.versions[versionedID(item)]; ok && stored != v.GetVersion() {
+				return storage.ErrVersionConflict
+			}
+		}
+	}
+	for _, item := range items {
+		if v, ok := item.(storage.Versioned); ok {
+			v.SetVersion(v.GetVersion() + 1)
+			if _, ok := d.versions[versionedID(item)]; ok {
+				d.versions[versionedID(item)] = v.GetVersion()
+			}
+		}
+	}
+	d.puts = append(d.puts, items...)
+	return nil
+}
+
+func versionedID(item ddb.Keyer) string {
+	switch i := item.(type) {
+	case *identity.User:
+		return i.ID
+	case *identity.Group:
+		return i.ID
+	}
+	return ""
+}
+
+type testIDP struct {
+	users  []identity.IDPUser
+	groups []identity.IDPGroup
+}
+
+func (p *testIDP) ListUsers(ctx context.Context) ([]identity.IDPUser, error) {
+	return p.users, nil
+}
+
+func (p *testIDP) ListGroups(ctx context.Context) ([]identity.IDPGroup, error) {
+	return p.groups, nil
+}
+
+func (p *testIDP) Config() gconfig.Config {
+	return gconfig.Config{}
+}
+
+func (p *testIDP) Init(ctx context.Context) error {
+	return nil
+}
+
+func TestIdentitySyncerRun(t *testing.T) {
+	clk := clock.NewMock()
+	internalUsers := []identity.User{
+		{ID: "u1", Email: "alice@example.com", Groups: []string{"g1"}, Status: types.IdpStatusACTIVE},
+		{ID: "u2", Email: "bob@example.com", Groups: []string{"g1"}, Status: types.IdpStatusACTIVE},
+	}
+	internalGroups := []identity.Group{
+		{ID: "g1", IdpID: "g1", Name: "everyone", Users: []string{"u1", "u2"}, Status: types.IdpStatusACTIVE, Source: "okta"},
+	}
+	// bob has been removed from the identity provider
+	idp := &testIDP{
+		users:  []identity.IDPUser{{ID: "alice", Email: "alice@example.com", Groups: []string{"g1"}}},
+		groups: []identity.IDPGroup{{ID: "g1", Name: "everyone"}},
+	}
+
+	type testcase struct {
+		name string
+		opts RunOpts
+		// previous are the earlier sync runs, most recent first
+		previous   []identity.SyncRun
+		wantStatus identity.SyncRunStatus
+		wantErr    bool
+		wantEvent  bool
+	}
+	testcases := []testcase{
+		{
+			name:       "dry run reports that the sync would be aborted",
+			opts:       RunOpts{DryRun: true},
+			wantStatus: identity.SyncRunStatusAborted,
+			wantErr:    true,
+		},
+		{
+			name:       "sync is aborted and raises an alert",
+			wantStatus: identity.SyncRunStatusAborted,
+			wantErr:    true,
+			wantEvent:  true,
+		},
+		{
+			name:       "sync is aborted after a successful sync and raises an alert",
+			previous:   []identity.SyncRun{{Status: identity.SyncRunStatusSucceeded}, {Status: identity.SyncRunStatusAborted}},
+			wantStatus: identity.SyncRunStatusAborted,
+			wantErr:    true,
+			wantEvent:  true,
+		},
+		{
+			name:       "sync is aborted again without another alert",
+			previous:   []identity.SyncRun{{Status: identity.SyncRunStatusAborted}},
+			wantStatus: identity.SyncRunStatusAborted,
+			wantErr:    true,
+		},
+		{
+			name:       "dry runs are skipped when finding the previous sync",
+			previous:   []identity.SyncRun{{DryRun: true, Status: identity.SyncRunStatusSucceeded}, {Status: identity.SyncRunStatusAborted}},
+			wantStatus: identity.SyncRunStatusAborted,
+			wantErr:    true,
+		},
+		{
+			name:       "forced dry run",
+			opts:       RunOpts{DryRun: true, Force: true},
+			wantStatus: identity.SyncRunStatusSucceeded,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			db := &testDB{mockClient: ddbmock.New(t)}
+			db.MockQuery(&storage.ListUsers{Result: internalUsers})
+			db.MockQuery(&storage.ListGroups{Result: internalGroups})
+			db.MockQuery(&storage.ListIdentitySyncRuns{Result: tc.previous})
+
+			eb := eventmock.NewMockEventPutter(ctrl)
+			if tc.wantEvent {
+				eb.EXPECT().Put(gomock.Any(), gomock.AssignableToTypeOf(gevent.IdentitySyncAborted{})).Return(nil)
+			}
+			s := &IdentitySyncer{
+				db:               db,
+				idp:              idp,
+				idpType:          "okta",
+				archiveThreshold: 25,
+				clock:            clk,
+				eventbus:         eb,
+			}
+
+			report, err := s.Run(context.Background(), tc.opts)
+			if tc.wantErr {
+				assert.IsType(t, &ArchiveThresholdError{}, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.wantStatus, report.Run.Status)
+			assert.Equal(t, tc.opts.DryRun, report.Run.DryRun)
+			assert.Equal(t, identity.SyncChangeCounts{UsersArchived: 1, GroupsUpdated: 1}, report.Run.Changes)
+			assert.Equal(t, []identity.User{{ID: "u2", Email: "bob@example.com", Groups: []string{}, Status: types.IdpStatusARCHIVED}}, report.Changes.ArchivedUsers)
+			assert.Equal(t, clk.Now().Add(identity.DefaultSyncRunRetention).Unix(), report.Run.ExpiresAt)
+
+			// only the run is saved, the users and groups are not changed
+			assert.Equal(t, []ddb.Keyer{&report.Run}, db.puts)
+		})
+	}
+}
+
+type testNestedIDP struct {
+	testIDP
+	edges []identity.IDPGroupEdge
+}
+
+func (p *testNestedIDP) ListGroupEdges(ctx context.Context) ([]identity.IDPGroupEdge, error) {
+	return p.edges, nil
+}
+
+func TestIdentitySyncerResolveNestedGroups(t *testing.T) {
+	idp := &testNestedIDP{
+		testIDP: testIDP{
+			users: []identity.IDPUser{{ID: "alice", Email: "alice@example.com", Groups: []string{"team"}}},
+			groups: []identity.IDPGroup{
+				{ID: "team", Name: "team"},
+				{ID: "engineering", Name: "engineering"},
+			},
+		},
+		edges: []identity.IDPGroupEdge{{Parent: "engineering", Child: "team"}},
+	}
+	db := &testDB{mockClient: ddbmock.New(t)}
+	db.MockQuery(&storage.ListUsers{Result: []identity.User{}})
+	db.MockQuery(&storage.ListGroups{Result: []identity.Group{}})
+	s := &IdentitySyncer{
+		db:                  db,
+		idp:                 idp,
+		idpType:             "okta",
+		clock:               clock.NewMock(),
+		resolveNestedGroups: true,
+	}
+
+	report, err := s.Run(context.Background(), RunOpts{DryRun: true})
+	assert.NoError(t, err)
+	if assert.Len(t, report.Changes.CreatedUsers, 1) {
+		u := report.Changes.CreatedUsers[0]
+		sort.Strings(u.Groups)
+		assert.Equal(t, []string{"engineering", "team"}, u.Groups)
+		assert.Equal(t, map[string][]string{"engineering": {"team", "engineering"}}, u.GroupPaths)
+	}
+}
+
+type failingIDP struct {
+	testIDP
+}
+
+func (p *failingIDP) ListUsers(ctx context.Context) ([]identity.IDPUser, error) {
+	return nil, errors.New("unauthorized")
+}
+
+func TestIdentitySyncerMultipleSources(t *testing.T) {
+	internalUsers := []identity.User{
+		{ID: "u1", Email: "alice@example.com", FirstName: "Alice", Groups: []string{"okta-eng"}, Status: types.IdpStatusACTIVE, IdentitySources: []string{"okta"}},
+		{ID: "u2", Email: "bob@example.com", FirstName: "Bob", Groups: []string{"azure-eng"}, Status: types.IdpStatusACTIVE, IdentitySources: []string{"azure"}},
+		{ID: "u3", Email: "dave@example.com", FirstName: "Dave", Groups: []string{"azure-eng", "contractors"}, Status: types.IdpStatusACTIVE, IdentitySources: []string{"azure"}},
+	}
+	internalGroups := []identity.Group{
+		{ID: "okta-eng", IdpID: "okta-eng", Name: "engineering", Users: []string{"u1"}, Status: types.IdpStatusACTIVE, Source: "okta"},
+		{ID: "azure-eng", IdpID: "azure-eng", Name: "engineering", Users: []string{"u2", "u3"}, Status: types.IdpStatusACTIVE, Source: "azure"},
+		{ID: "contractors", IdpID: "contractors", Name: "contractors", Users: []string{"u3"}, Status: types.IdpStatusACTIVE, Source: identity.INTERNAL},
+	}
+	okta := &testIDP{
+		users: []identity.IDPUser{
+			{ID: "alice", Email: "alice@example.com", FirstName: "Alice", Groups: []string{"okta-eng"}},
+			{ID: "carol", Email: "carol@example.com", FirstName: "Carol", Groups: []string{"okta-eng"}},
+		},
+		groups: []identity.IDPGroup{{ID: "okta-eng", Name: "engineering"}},
+	}
+	// dave has been removed from Azure AD
+	azure := &testIDP{
+		users: []identity.IDPUser{
+			{ID: "bob", Email: "bob@example.com", FirstName: "Bob", Groups: []string{"azure-eng"}},
+			{ID: "carol", Email: "carol@example.com", FirstName: "Caroline", Groups: []string{"azure-eng"}},
+		},
+		groups: []identity.IDPGroup{{ID: "azure-eng", Name: "engineering"}},
+	}
+
+	type testcase struct {
+		name         string
+		azure        IdentityProvider
+		wantStatus   identity.SyncRunStatus
+		wantArchived []string
+		// wantCarol is the name and identity sources of carol, who is in both identity sources
+		wantCarolName    string
+		wantCarolSources []string
+		wantCarolGroups  []string
+	}
+	testcases := []testcase{
+		{
+			name:             "users are merged by email and archived once no source has them",
+			azure:            azure,
+			wantStatus:       identity.SyncRunStatusSucceeded,
+			wantArchived:     []string{"dave@example.com"},
+			wantCarolName:    "Caroline",
+			wantCarolSources: []string{"okta", "azure"},
+			wantCarolGroups:  []string{"azure-eng", "okta-eng"},
+		},
+		{
+			name:             "a failing source doesn't archive its users",
+			azure:            &failingIDP{},
+			wantStatus:       identity.SyncRunStatusFailed,
+			wantCarolName:    "Carol",
+			wantCarolSources: []string{"okta"},
+			wantCarolGroups:  []string{"okta-eng"},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := &testDB{mockClient: ddbmock.New(t)}
+			db.MockQuery(&storage.ListUsers{Result: internalUsers})
+			db.MockQuery(&storage.ListGroups{Result: internalGroups})
+			s := &IdentitySyncer{
+				db:      db,
+				idp:     okta,
+				idpType: "okta",
+				// azure takes precedence over okta for the names of users
+				sources: []identitySource{{tag: "azure", idpType: "azure", idp: tc.azure, precedence: -1}},
+				clock:   clock.NewMock(),
+			}
+
+			report, err := s.Run(context.Background(), RunOpts{DryRun: true})
+			if tc.wantStatus == identity.SyncRunStatusFailed {
+				var sourceErr *SourceError
+				if assert.ErrorAs(t, err, &sourceErr) {
+					assert.Contains(t, sourceErr.Errors, "azure")
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.wantStatus, report.Run.Status)
+			assert.Equal(t, "okta,azure", report.Run.IdentityProvider)
+
+			var archived []string
+			for _, u := range report.Changes.ArchivedUsers {
+				archived = append(archived, u.Email)
+			}
+			assert.Equal(t, tc.wantArchived, archived)
+
+			if assert.Len(t, report.Changes.CreatedUsers, 1) {
+				carol := report.Changes.CreatedUsers[0]
+				sort.Strings(carol.Groups)
+				assert.Equal(t, tc.wantCarolName, carol.FirstName)
+				assert.Equal(t, tc.wantCarolSources, carol.IdentitySources)
+				assert.Equal(t, tc.wantCarolGroups, carol.Groups)
+			}
+		})
+	}
+}
+
+type testDeprovisionHook struct {
+	emails []string
+	fail   bool
+}
+
+func (h *testDeprovisionHook) Deprovision(ctx context.Context, user identity.User) error {
+	h.emails = append(h.emails, user.Email)
+	if h.fail {
+		return errors.New("deprovisioning failed")
+	}
+	return nil
+}
+
+func TestIdentitySyncerDeprovisionHooks(t *testing.T) {
+	internalUsers := []identity.User{
+		{ID: "u1", Email: "alice@example.com", Status: types.IdpStatusACTIVE},
+		{ID: "u2", Email: "bob@example.com", Status: types.IdpStatusACTIVE},
+		{ID: "u3", Email: "carol@example.com", Status: types.IdpStatusARCHIVED, DeprovisionPending: true},
+		{ID: "u4", Email: "dave@example.com", Status: types.IdpStatusARCHIVED},
+	}
+	// bob has been removed from the identity provider, carol was archived by a previous sync whose deprovisioning failed,
+	// and dave was archived and deprovisioned by a previous sync
+	idp := &testIDP{users: []identity.IDPUser{{ID: "alice", Email: "alice@example.com"}}}
+
+	type testcase struct {
+		name       string
+		opts       RunOpts
+		fail       bool
+		wantEmails []string
+		// wantPending are the users who are left pending deprovisioning
+		wantPending []string
+	}
+	testcases := []testcase{
+		{
+			name:       "users who are archived are deprovisioned",
+			wantEmails: []string{"bob@example.com", "carol@example.com"},
+		},
+		{
+			name:        "users stay pending when deprovisioning fails",
+			fail:        true,
+			wantEmails:  []string{"bob@example.com", "carol@example.com"},
+			wantPending: []string{"bob@example.com", "carol@example.com"},
+		},
+		{
+			name:        "dry runs don't deprovision users",
+			opts:        RunOpts{DryRun: true},
+			wantPending: []string{"carol@example.com"},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := &testDB{mockClient: ddbmock.New(t)}
+			db.MockQuery(&storage.ListUsers{Result: internalUsers})
+			db.MockQuery(&storage.ListGroups{Result: []identity.Group{}})
+			db.MockQuery(&storage.ListAccessRulesByPriority{})
+			db.MockGet(ddb.GetKey{PK: keys.Deployment.PK1, SK: keys.Deployment.SK1}, &depid.Deployment{ID: "dep_1"})
+			hook := &testDeprovisionHook{fail: tc.fail}
+			s := &IdentitySyncer{
+				db:               db,
+				idp:              idp,
+				idpType:          "okta",
+				clock:            clock.NewMock(),
+				IdentityService:  identitysvc.Service{DB: db},
+				deprovisionHooks: []DeprovisionHook{hook},
+			}
+
+			_, err := s.Run(context.Background(), tc.opts)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantEmails, hook.emails)
+
+			// the users are written again once they have been deprovisioned, so the last write of each user is its stored state
+			stored := make(map[string]identity.User)
+			for _, u := range internalUsers {
+				stored[u.Email] = u
+			}
+			for _, item := range db.puts {
+				if u, ok := item.(*identity.User); ok {
+					stored[u.Email] = *u
+				}
+			}
+			var pending []string
+			for email, u := range stored {
+				if u.DeprovisionPending {
+					pending = append(pending, email)
+				}
+			}
+			sort.Strings(pending)
+			assert.Equal(t, tc.wantPending, pending)
+		})
+	}
+}
+
+func TestIdentitySyncerSCIMWriteDuringSync(t *testing.T) {
+	// sam was provisioned through SCIM and is also in the identity provider, where they are a member of a group
+	read := []identity.User{{ID: "u1", Email: "sam@example.com", Status: types.IdpStatusACTIVE, Source: identity.SCIM, Version: 1}}
+	// sam is deprovisioned through the SCIM API after the sync read the users, but before it wrote them
+	scimWritten := []identity.User{{ID: "u1", Email: "sam@example.com", Status: types.IdpStatusARCHIVED, Source: identity.SCIM, Version: 2}}
+	idp := &testIDP{
+		users:  []identity.IDPUser{{ID: "sam", Email: "sam@example.com", Groups: []string{"eng"}}},
+		groups: []identity.IDPGroup{{ID: "eng", Name: "eng"}},
+	}
+
+	db := &testDB{mockClient: ddbmock.New(t), versions: map[string]int{"u1": 2}}
+	db.MockQuery(&storage.ListUsers{Result: read})
+	db.MockQuery(&storage.ListUsers{Result: scimWritten})
+	db.MockQuery(&storage.ListGroups{Result: []identity.Group{}})
+	db.MockQuery(&storage.ListGroups{Result: []identity.Group{}})
+	db.MockQuery(&storage.ListAccessRulesByPriority{})
+	db.MockQuery(&storage.ListAccessRulesByPriority{})
+	db.MockGet(ddb.GetKey{PK: keys.Deployment.PK1, SK: keys.Deployment.SK1}, &depid.Deployment{ID: "dep_1"})
+	s := &IdentitySyncer{
+		db:              db,
+		idp:             idp,
+		idpType:         "okta",
+		clock:           clock.NewMock(),
+		IdentityService: identitysvc.Service{DB: db},
+	}
+
+	_, err := s.Run(context.Background(), RunOpts{})
+	assert.NoError(t, err)
+
+	// the first write conflicted with the SCIM write, so the sync merged its changes into the archived user again.
+	// Archived SCIM users don't get group memberships, so sam isn't written and stays archived.
+	var groups []identity.Group
+	for _, item := range db.puts {
+		switch i := item.(type) {
+		case *identity.User:
+			t.Errorf("user %s was written", i.Email)
+		case *identity.Group:
+			groups = append(groups, *i)
+		}
+	}
+	if assert.Len(t, groups, 1) {
+		assert.Equal(t, "eng", groups[0].Name)
+		assert.Empty(t, groups[0].Users)
+	}
+	assert.Equal(t, 2, db.versions["u1"])
+}
+
+func TestClearDeprovisionPendingConflict(t *testing.T) {
+	db := &testDB{mockClient: ddbmock.New(t), versions: map[string]int{"u2": 5}}
+	// bob was changed through the SCIM API since the sync wrote him
+	db.MockQuery(&storage.GetUser{ID: "u2", Result: &identity.User{ID: "u2", Email: "bob@example.com", FirstName: "Robert", Status: types.IdpStatusARCHIVED, DeprovisionPending: true, Version: 5}})
+	s := &IdentitySyncer{db: db}
+
+	err := s.clearDeprovisionPending(context.Background(), &identity.User{ID: "u2", Email: "bob@example.com", Status: types.IdpStatusARCHIVED, DeprovisionPending: true, Version: 4})
+	assert.NoError(t, err)
+	if assert.Len(t, db.puts, 1) {
+		assert.Equal(t, &identity.User{ID: "u2", Email: "bob@example.com", FirstName: "Robert", Status: types.IdpStatusARCHIVED, Version: 6}, db.puts[0])
+	}
+}
@@ -0,0 +1,110 @@
+package identity
+
+import (
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+)
+
+// DefaultSyncRunRetention is how long the history of identity syncs is kept for.
+// Syncs run every 5 minutes, so the history is expired to keep it from growing indefinitely.
+const DefaultSyncRunRetention = 30 * 24 * time.Hour
+
+type SyncRunStatus string
+
+const (
+	SyncRunStatusSucceeded SyncRunStatus = "SUCCEEDED"
+	SyncRunStatusFailed    SyncRunStatus = "FAILED"
+	// SyncRunStatusAborted is used when a sync is stopped because it would archive too many users or groups
+	SyncRunStatusAborted SyncRunStatus = "ABORTED"
+)
+
+// SyncRun records the outcome of an identity sync, so that admins can review the changes that syncs have made.
+type SyncRun struct {
+	// ID is a KSUID, so runs are sorted by the time they started
+	ID               string `json:"id" dynamodbav:"id"`
+	IdentityProvider string `json:"identityProvider" dynamodbav:"identityProvider"`
+	// DryRun is true if the changes were reported but not applied
+	DryRun bool `json:"dryRun" dynamodbav:"dryRun"`
+	// Forced is true if the archive safety threshold was overridden
+	Forced      bool             `json:"forced" dynamodbav:"forced"`
+	Status      SyncRunStatus    `json:"status" dynamodbav:"status"`
+	Error       *string          `json:"error,omitempty" dynamodbav:"error,omitempty"`
+	Changes     SyncChangeCounts `json:"changes" dynamodbav:"changes"`
+	StartedAt   time.Time        `json:"startedAt" dynamodbav:"startedAt"`
+	CompletedAt time.Time        `json:"completedAt" dynamodbav:"completedAt"`
+	// ExpiresAt is a unix timestamp, which is used as the DynamoDB TTL for the run.
+	ExpiresAt int64 `json:"ttl" dynamodbav:"ttl"`
+}
+
+// SyncChangeCounts is the number of users and groups which a sync created, updated and archived.
+type SyncChangeCounts struct {
+	UsersCreated   int `json:"usersCreated" dynamodbav:"usersCreated"`
+	UsersUpdated   int `json:"usersUpdated" dynamodbav:"usersUpdated"`
+	UsersArchived  int `json:"usersArchived" dynamodbav:"usersArchived"`
+	GroupsCreated  int `json:"groupsCreated" dynamodbav:"groupsCreated"`
+	GroupsUpdated  int `json:"groupsUpdated" dynamodbav:"groupsUpdated"`
+	GroupsArchived int `json:"groupsArchived" dynamodbav:"groupsArchived"`
+}
+
+// SyncChanges are the users and groups which a sync creates, updates and archives.
+type SyncChanges struct {
+	CreatedUsers   []User
+	UpdatedUsers   []User
+	ArchivedUsers  []User
+	CreatedGroups  []Group
+	UpdatedGroups  []Group
+	ArchivedGroups []Group
+}
+
+// Add appends the changes of another sync, such as the sync of another identity source.
+func (c *SyncChanges) Add(other SyncChanges) {
+	c.CreatedUsers = append(c.CreatedUsers, other.CreatedUsers...)
+	c.UpdatedUsers = append(c.UpdatedUsers, other.UpdatedUsers...)
+	c.ArchivedUsers = append(c.ArchivedUsers, other.ArchivedUsers...)
+	c.CreatedGroups = append(c.CreatedGroups, other.CreatedGroups...)
+	c.UpdatedGroups = append(c.UpdatedGroups, other.UpdatedGroups...)
+	c.ArchivedGroups = append(c.ArchivedGroups, other.ArchivedGroups...)
+}
+
+func (c SyncChanges) Counts() SyncChangeCounts {
+	return SyncChangeCounts{
+		UsersCreated:   len(c.CreatedUsers),
+		UsersUpdated:   len(c.UpdatedUsers),
+		UsersArchived:  len(c.ArchivedUsers),
+		GroupsCreated:  len(c.CreatedGroups),
+		GroupsUpdated:  len(c.UpdatedGroups),
+		GroupsArchived: len(c.ArchivedGroups),
+	}
+}
+
+func (r *SyncRun) ToAPI() types.IdentitySyncRun {
+	return types.IdentitySyncRun{
+		Id:               r.ID,
+		IdentityProvider: r.IdentityProvider,
+		DryRun:           r.DryRun,
+		Forced:           r.Forced,
+		Status:           types.IdentitySyncRunStatus(r.Status),
+		Error:            r.Error,
+		Changes: types.IdentitySyncChangeCounts{
+			UsersCreated:   r.Changes.UsersCreated,
+			UsersUpdated:   r.Changes.UsersUpdated,
+			UsersArchived:  r.Changes.UsersArchived,
+			GroupsCreated:  r.Changes.GroupsCreated,
+			GroupsUpdated:  r.Changes.GroupsUpdated,
+			GroupsArchived: r.Changes.GroupsArchived,
+		},
+		StartedAt:   r.StartedAt,
+		CompletedAt: r.CompletedAt,
+	}
+}
+
+func (r *SyncRun) DDBKeys() (ddb.Keys, error) {
+	keys := ddb.Keys{
+		PK: keys.IdentitySyncRun.PK1,
+		SK: keys.IdentitySyncRun.SK1(r.ID),
+	}
+	return keys, nil
+}
@@ -17,18 +17,26 @@ type IDPUser struct {
 	Email     string
 	// groups is a list of idp group ids, these will not match the internal dynamo ids
 	Groups []string
+	// GroupPaths is set when nested groups are resolved, for the groups which the user is a member of through nested groups.
+	// It is keyed by group id, and each path starts with a group which the user is a direct member of and ends with the group.
+	GroupPaths map[string][]string
+	// Attributes are the user attributes which are mapped from the identity provider, such as department or employment type.
+	Attributes map[string]string
+	// Manager is the ID or email of the user's manager in the identity provider
+	Manager string
 }
 
 func (u IDPUser) ToInternalUser() User {
 	now := time.Now()
 	return User{
-		ID:        types.NewUserID(),
-		FirstName: u.FirstName,
-		LastName:  u.LastName,
-		Email:     u.Email,
-		Status:    types.IdpStatusACTIVE,
-		CreatedAt: now,
-		UpdatedAt: now,
+		ID:         types.NewUserID(),
+		FirstName:  u.FirstName,
+		LastName:   u.LastName,
+		Email:      u.Email,
+		Attributes: u.Attributes,
+		Status:     types.IdpStatusACTIVE,
+		CreatedAt:  now,
+		UpdatedAt:  now,
 	}
 }
 
@@ -41,13 +49,41 @@ type User struct {
 	Email       string   `json:"email" dynamodbav:"email"`
 	Groups      []string `json:"groups" dynamodbav:"groups"`
 	AccessRules []string `json:"accessRules" dynamodbav:"accessRules"`
+	// GroupPaths records how the user is a member of groups through nested groups.
+	// It is keyed by group id, and each path starts with a group which the user is a direct member of and ends with the group.
+	// Groups which the user is a direct member of are not included.
+	GroupPaths map[string][]string `json:"groupPaths,omitempty" dynamodbav:"groupPaths,omitempty"`
+	// Attributes are synced from the identity provider, such as department or employment type
+	Attributes map[string]string `json:"attributes,omitempty" dynamodbav:"attributes,omitempty"`
+	// ManagerID is the internal id of the user's manager
+	ManagerID string `json:"managerId,omitempty" dynamodbav:"managerId,omitempty"`
 
 	Status types.IdpStatus `json:"status" dynamodbav:"status"`
+	// Source is SCIM for users which were provisioned through the SCIM API, and empty for users created by identity sync
+	Source string `json:"source,omitempty" dynamodbav:"source,omitempty"`
+	// IdentitySources are the tags of the identity sources which the user was synced from.
+	// Users which were synced before multiple identity sources were supported don't have any, and belong to the primary identity source.
+	IdentitySources []string `json:"identitySources,omitempty" dynamodbav:"identitySources,omitempty"`
+	// ExternalID is the ID of the user in the identity provider which provisioned it through SCIM
+	ExternalID string `json:"externalId,omitempty" dynamodbav:"externalId,omitempty"`
+	// DeprovisionPending is set when the user is archived by identity sync, and cleared once the user has been deprovisioned.
+	// Identity sync deprovisions the archived users who are still pending, so that failed deprovisioning is retried.
+	DeprovisionPending bool `json:"-" dynamodbav:"deprovisionPending,omitempty"`
+	// Version is incremented each time the user is written, so that concurrent changes can be detected
+	Version int `json:"-" dynamodbav:"version,omitempty"`
 
 	CreatedAt time.Time `json:"createdAt" dynamodbav:"createdAt"`
 	UpdatedAt time.Time `json:"updatedAt" dynamodbav:"updatedAt"`
 }
 
+func (u *User) GetVersion() int {
+	return u.Version
+}
+
+func (u *User) SetVersion(version int) {
+	u.Version = version
+}
+
 // RemoveGroup removes the group from the list in memory, it does not update the database
 func (u *User) RemoveGroup(group string) {
 	var newGroups []string
@@ -91,6 +127,12 @@ func (u *User) ToAPI() types.User {
 		// ensures that this is never nil
 		Groups: append([]string{}, u.Groups...),
 	}
+	if len(u.Attributes) > 0 {
+		req.Attributes = &u.Attributes
+	}
+	if u.ManagerID != "" {
+		req.ManagerId = &u.ManagerID
+	}
 
 	return req
 }
@@ -0,0 +1,318 @@
+// Package natsqueue is an event transport which sends events through a NATS JetStream stream.
+// It can be used in place of the DynamoDB queue when Common Fate runs as a single server,
+// for deployments which already run a NATS cluster.
+package natsqueue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/common-fate/pkg/eventqueue"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultStream is the name of the JetStream stream which events are stored in.
+	DefaultStream = "COMMONFATE_EVENTS"
+	// DefaultPartitions is how many partitions events are spread over. Events in different partitions are handled concurrently.
+	DefaultPartitions = 8
+	// DefaultMaxDeliver is how many times an event is delivered before it is dropped.
+	DefaultMaxDeliver = eventqueue.DefaultMaxAttempts
+	// DefaultAckWait is how long a subscriber has to handle an event before it is delivered again.
+	DefaultAckWait = 5 * time.Minute
+
+	// fetchWait is how long a subscriber waits for an event before fetching again.
+	fetchWait = 5 * time.Second
+
+	// detailTypeHeader holds the detail type of the event, the message data is the event detail.
+	detailTypeHeader = "Commonfate-Detail-Type"
+	// orderingKeyHeader holds the ordering key of the event, if it has one.
+	orderingKeyHeader = "Commonfate-Ordering-Key"
+)
+
+// Queue is a gevent.Transport which publishes events to a JetStream stream.
+//
+// Events are spread over partitions by their ordering key, and every subscriber has a durable consumer
+// for each partition which handles one event at a time. Events with the same ordering key are handled
+// in the order they were sent, and events for different keys can be handled concurrently.
+//
+// Events are acknowledged once they have been handled. If the handler returns an error the event is
+// delivered again after a backoff, and later events in the partition wait until it has been handled.
+// Events which still fail after MaxDeliver attempts are dropped, wrap the handler with a deadletter.Recorder to keep them.
+type Queue struct {
+	JS nats.JetStreamContext
+	// Stream defaults to DefaultStream
+	Stream string
+	// Subscribers are the names of the subscribers which receive the events.
+	// Subscribers must be registered up front so that events sent before they subscribe are kept for them.
+	Subscribers []string
+	// Partitions defaults to DefaultPartitions. It can't be changed once the consumers have been created.
+	Partitions int
+	// MaxDeliver defaults to DefaultMaxDeliver
+	MaxDeliver int
+	// AckWait defaults to DefaultAckWait
+	AckWait time.Duration
+	// RetryDelay returns how long to wait before delivering a failed event again. It defaults to eventqueue.RetryDelay.
+	RetryDelay func(attempts int) time.Duration
+
+	// next spreads events without an ordering key over the partitions
+	next uint32
+}
+
+// New creates a queue which delivers events to the subscribers.
+func New(js nats.JetStreamContext, subscribers ...string) *Queue {
+	return &Queue{
+		JS:          js,
+		Subscribers: subscribers,
+	}
+}
+
+// Connect connects to the NATS server at url, and sets up a queue which delivers events to the subscribers.
+func Connect(ctx context.Context, url string, subscribers ...string) (*Queue, error) {
+	nc, err := nats.Connect(url)
+	if err != nil {
+		return nil, err
+	}
+	js, err := nc.JetStream()
+	if err != nil {
+		nc.Close()
+		return nil, err
+	}
+	q := New(js, subscribers...)
+	err = q.Setup(ctx)
+	if err != nil {
+		nc.Close()
+		return nil, err
+	}
+	return q, nil
+}
+
+// Setup creates the stream and a durable consumer for each subscriber and partition, or updates them if they exist.
+// It must be called before events are sent.
+func (q *Queue) Setup(ctx context.Context) error {
+	stream := &nats.StreamConfig{
+		Name:     q.stream(),
+		Subjects: []string{q.subjectPrefix() + ".>"},
+		// events are kept until every subscriber has acknowledged them
+		Retention: nats.InterestPolicy,
+		Storage:   nats.FileStorage,
+	}
+	_, err := q.JS.StreamInfo(stream.Name, nats.Context(ctx))
+	if errors.Is(err, nats.ErrStreamNotFound) {
+		_, err = q.JS.AddStream(stream, nats.Context(ctx))
+	} else if err == nil {
+		_, err = q.JS.UpdateStream(stream, nats.Context(ctx))
+	}
+	if err != nil {
+		return fmt.Errorf("setting up stream %s: %w", stream.Name, err)
+	}
+
+	for _, subscriber := range q.Subscribers {
+		for p := 0; p < q.partitions(); p++ {
+			consumer := &nats.ConsumerConfig{
+				Durable:       consumerName(subscriber, p),
+				FilterSubject: q.subject(p),
+				DeliverPolicy: nats.DeliverAllPolicy,
+				AckPolicy:     nats.AckExplicitPolicy,
+				AckWait:       q.ackWait(),
+				MaxDeliver:    q.maxDeliver(),
+				// only one event in the partition is handled at a time, so that events are handled in order
+				MaxAckPending: 1,
+			}
+			_, err := q.JS.ConsumerInfo(stream.Name, consumer.Durable, nats.Context(ctx))
+			if errors.Is(err, nats.ErrConsumerNotFound) {
+				_, err = q.JS.AddConsumer(stream.Name, consumer, nats.Context(ctx))
+			} else if err == nil {
+				_, err = q.JS.UpdateConsumer(stream.Name, consumer, nats.Context(ctx))
+			}
+			if err != nil {
+				return fmt.Errorf("setting up consumer %s: %w", consumer.Durable, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Put publishes the event to the partition for its ordering key.
+func (q *Queue) Put(ctx context.Context, e gevent.EventTyper) error {
+	// return early if we don't have an event to send.
+	if e == nil {
+		return nil
+	}
+	evt, err := gevent.ToCloudWatchEvent(e)
+	if err != nil {
+		return err
+	}
+	key := gevent.OrderingKey(e)
+	msg := nats.NewMsg(q.subject(q.partition(key)))
+	msg.Header.Set(detailTypeHeader, evt.DetailType)
+	if key != "" {
+		msg.Header.Set(orderingKeyHeader, key)
+	}
+	msg.Data = evt.Detail
+	_, err = q.JS.PublishMsg(msg, nats.Context(ctx))
+	return err
+}
+
+// Subscribe delivers events to the handler until ctx is cancelled.
+// The partitions are consumed concurrently, so the handler must be safe to call from several goroutines.
+func (q *Queue) Subscribe(ctx context.Context, subscriber string, h gevent.Handler) error {
+	var subs []*nats.Subscription
+	for p := 0; p < q.partitions(); p++ {
+		sub, err := q.JS.PullSubscribe(q.subject(p), consumerName(subscriber, p), nats.Bind(q.stream(), consumerName(subscriber, p)))
+		if err != nil {
+			for _, s := range subs {
+				_ = s.Unsubscribe()
+			}
+			return fmt.Errorf("subscribing %s to partition %d: %w", subscriber, p, err)
+		}
+		subs = append(subs, sub)
+	}
+
+	var wg sync.WaitGroup
+	for _, sub := range subs {
+		wg.Add(1)
+		go func(sub *nats.Subscription) {
+			defer wg.Done()
+			defer func() { _ = sub.Unsubscribe() }()
+			q.consume(ctx, subscriber, sub, h)
+		}(sub)
+	}
+	wg.Wait()
+	return ctx.Err()
+}
+
+// consume handles the events in a partition one at a time until ctx is cancelled.
+func (q *Queue) consume(ctx context.Context, subscriber string, sub *nats.Subscription, h gevent.Handler) {
+	log := logger.Get(ctx).With("subscriber", subscriber, "subject", sub.Subject)
+	for {
+		msgs, err := q.fetch(ctx, sub)
+		if ctx.Err() != nil {
+			return
+		}
+		if errors.Is(err, nats.ErrTimeout) || errors.Is(err, context.DeadlineExceeded) {
+			continue
+		}
+		if err != nil {
+			log.Errorw("failed to fetch events", "error", err)
+			// wait before fetching again, so that a lost connection isn't retried in a busy loop
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(fetchWait):
+			}
+			continue
+		}
+		for _, msg := range msgs {
+			err = q.handle(ctx, log, msg, h)
+			if err != nil {
+				log.Errorw("failed to acknowledge event", "error", err)
+			}
+		}
+	}
+}
+
+// fetch waits up to fetchWait for the next event in the partition.
+func (q *Queue) fetch(ctx context.Context, sub *nats.Subscription) ([]*nats.Msg, error) {
+	ctx, cancel := context.WithTimeout(ctx, fetchWait)
+	defer cancel()
+	return sub.Fetch(1, nats.Context(ctx))
+}
+
+// handle delivers a message to the handler, and acknowledges it if it was handled.
+func (q *Queue) handle(ctx context.Context, log *zap.SugaredLogger, msg *nats.Msg, h gevent.Handler) error {
+	meta, err := msg.Metadata()
+	if err != nil {
+		// the message didn't come from JetStream, so it can't be redelivered
+		return msg.Term()
+	}
+	detailType := msg.Header.Get(detailTypeHeader)
+	sequence := strconv.FormatUint(meta.Sequence.Stream, 10)
+
+	err = h(ctx, events.CloudWatchEvent{
+		ID:         sequence,
+		DetailType: detailType,
+		Source:     "commonfate.io/granted",
+		Detail:     msg.Data,
+	})
+	if err == nil {
+		return msg.AckSync(nats.Context(ctx))
+	}
+
+	attempts := int(meta.NumDelivered)
+	log.Errorw("failed to handle event", "detailType", detailType, "sequence", sequence, "attempts", attempts, "error", err)
+	if attempts >= q.maxDeliver() {
+		// drop the event so that later events in the partition aren't blocked forever
+		log.Errorw("dropping event after too many failed attempts", "detailType", detailType, "sequence", sequence, "attempts", attempts)
+		return msg.Term()
+	}
+	return msg.NakWithDelay(q.retryDelay(attempts))
+}
+
+// partition returns the partition which events with the ordering key are sent to.
+// Events without an ordering key are spread over every partition.
+func (q *Queue) partition(key string) int {
+	if key == "" {
+		return int(atomic.AddUint32(&q.next, 1) % uint32(q.partitions()))
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	return int(h.Sum32() % uint32(q.partitions()))
+}
+
+func (q *Queue) subjectPrefix() string {
+	return "commonfate.events." + q.stream()
+}
+
+func (q *Queue) subject(partition int) string {
+	return fmt.Sprintf("%s.%d", q.subjectPrefix(), partition)
+}
+
+func consumerName(subscriber string, partition int) string {
+	return fmt.Sprintf("%s-%d", subscriber, partition)
+}
+
+func (q *Queue) stream() string {
+	if q.Stream == "" {
+		return DefaultStream
+	}
+	return q.Stream
+}
+
+func (q *Queue) partitions() int {
+	if q.Partitions == 0 {
+		return DefaultPartitions
+	}
+	return q.Partitions
+}
+
+func (q *Queue) maxDeliver() int {
+	if q.MaxDeliver == 0 {
+		return DefaultMaxDeliver
+	}
+	return q.MaxDeliver
+}
+
+func (q *Queue) ackWait() time.Duration {
+	if q.AckWait == 0 {
+		return DefaultAckWait
+	}
+	return q.AckWait
+}
+
+func (q *Queue) retryDelay(attempts int) time.Duration {
+	if q.RetryDelay == nil {
+		return eventqueue.RetryDelay(attempts)
+	}
+	return q.RetryDelay(attempts)
+}
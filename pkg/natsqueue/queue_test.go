@@ -0,0 +1,206 @@
+package natsqueue
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+)
+
+// runJetStream starts a JetStream server in the test process, and returns a JetStream context connected to it.
+func runJetStream(t *testing.T) nats.JetStreamContext {
+	srv, err := server.NewServer(&server.Options{
+		Host:      "127.0.0.1",
+		Port:      server.RANDOM_PORT,
+		JetStream: true,
+		StoreDir:  t.TempDir(),
+		NoLog:     true,
+		NoSigs:    true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	go srv.Start()
+	t.Cleanup(srv.Shutdown)
+	if !srv.ReadyForConnections(10 * time.Second) {
+		t.Fatal("nats server didn't start")
+	}
+	nc, err := nats.Connect(srv.ClientURL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(nc.Close)
+	js, err := nc.JetStream()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return js
+}
+
+// recorder records the grant IDs of the events it handles, and fails the first attempts for the IDs in fail.
+type recorder struct {
+	mu      sync.Mutex
+	handled []string
+	// fail is the number of attempts to fail for each grant ID
+	fail map[string]int
+	done chan struct{}
+	want int
+}
+
+func (r *recorder) handle(ctx context.Context, event events.CloudWatchEvent) error {
+	e, err := gevent.Parse(event.DetailType, event.Detail)
+	if err != nil {
+		return err
+	}
+	var id string
+	switch e := e.(type) {
+	case gevent.GrantRetryRequested:
+		id = e.GrantID
+	case gevent.GrantActivated:
+		id = e.Grant.ID
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.handled = append(r.handled, id)
+	if len(r.handled) == r.want {
+		close(r.done)
+	}
+	if r.fail[id] > 0 {
+		r.fail[id]--
+		return errors.New("handler failed")
+	}
+	return nil
+}
+
+func (r *recorder) wait(t *testing.T) []string {
+	select {
+	case <-r.done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for events")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.handled
+}
+
+func TestQueue(t *testing.T) {
+	type testcase struct {
+		name string
+		// give are the grant IDs of the events which are sent, grant events are ordered by their grant ID
+		give        []string
+		fail        map[string]int
+		wantHandled []string
+	}
+
+	testcases := []testcase{
+		{
+			name:        "events are handled",
+			give:        []string{"gra_1", "gra_2"},
+			wantHandled: []string{"gra_1", "gra_2"},
+		},
+		{
+			name:        "failed event is retried before later events with the same key",
+			give:        []string{"gra_1", "gra_1", "gra_1"},
+			fail:        map[string]int{"gra_1": 1},
+			wantHandled: []string{"gra_1", "gra_1", "gra_1", "gra_1"},
+		},
+		{
+			name:        "event is dropped after the last attempt",
+			give:        []string{"gra_1", "gra_1"},
+			fail:        map[string]int{"gra_1": 2},
+			wantHandled: []string{"gra_1", "gra_1", "gra_1"},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			q := New(runJetStream(t), gevent.EventHandlerSubscriber, gevent.SlackNotifierSubscriber)
+			q.MaxDeliver = 2
+			q.RetryDelay = func(attempts int) time.Duration { return 0 }
+			err := q.Setup(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// events are kept for subscribers which haven't subscribed yet
+			for _, id := range tc.give {
+				err = q.Put(ctx, gevent.GrantRetryRequested{GrantID: id})
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			handler := &recorder{fail: tc.fail, done: make(chan struct{}), want: len(tc.wantHandled)}
+			notifier := &recorder{done: make(chan struct{}), want: len(tc.give)}
+			go func() { _ = q.Subscribe(ctx, gevent.EventHandlerSubscriber, handler.handle) }()
+			go func() { _ = q.Subscribe(ctx, gevent.SlackNotifierSubscriber, notifier.handle) }()
+
+			assert.ElementsMatch(t, tc.wantHandled, handler.wait(t))
+			// every subscriber receives its own copy of each event
+			assert.ElementsMatch(t, tc.give, notifier.wait(t))
+		})
+	}
+}
+
+func TestOrdering(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	q := New(runJetStream(t), gevent.EventHandlerSubscriber)
+	q.RetryDelay = func(attempts int) time.Duration { return 0 }
+	err := q.Setup(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// grant events are ordered by their request, so the events for each request are handled in the order they were sent,
+	// even though the first event for req_1 fails and is delivered again
+	want := map[string][]string{
+		"req_1": {"gta_1", "gta_1", "gta_2", "gta_3"},
+		"req_2": {"gtb_1", "gtb_2", "gtb_3"},
+	}
+	for i := 1; i <= 3; i++ {
+		for request, prefix := range map[string]string{"req_1": "gta_", "req_2": "gtb_"} {
+			err = q.Put(ctx, gevent.GrantActivated{Grant: access.GroupTarget{ID: prefix + strconv.Itoa(i), RequestID: request}})
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	h := &recorder{fail: map[string]int{"gta_1": 1}, done: make(chan struct{}), want: 7}
+	go func() { _ = q.Subscribe(ctx, gevent.EventHandlerSubscriber, h.handle) }()
+
+	got := map[string][]string{}
+	for _, id := range h.wait(t) {
+		request := "req_1"
+		if strings.HasPrefix(id, "gtb_") {
+			request = "req_2"
+		}
+		got[request] = append(got[request], id)
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestPartition(t *testing.T) {
+	q := &Queue{}
+	key := gevent.RequestOrderingKey("req_1")
+	assert.Equal(t, q.partition(key), q.partition(key))
+
+	// events without an ordering key are spread over the partitions
+	seen := map[int]bool{}
+	for i := 0; i < DefaultPartitions; i++ {
+		seen[q.partition("")] = true
+	}
+	assert.Len(t, seen, DefaultPartitions)
+}
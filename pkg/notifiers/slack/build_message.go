@@ -2,6 +2,7 @@ package slacknotifier
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -26,6 +27,8 @@ func titleCase(s string) string {
 
 type RequestMessageOpts struct {
 	Group access.Group
+	// optional targets of the group, used to display the admin curated target tags
+	Targets []access.GroupTarget
 	// AccessGroups []access.Group
 	// RequestArguments []types.With
 
@@ -109,6 +112,8 @@ func BuildRequestReviewMessage(o RequestMessageOpts) (summary string, msg slack.
 		})
 	}
 
+	requestDetails = appendTargetTags(requestDetails, o.Targets)
+
 	var richTextSummary string
 
 	if o.IsWebhook && o.WasReviewed && group.Status != types.RequestAccessGroupStatusPENDINGAPPROVAL {
@@ -229,6 +234,8 @@ func BuildRequestDetailMessage(o RequestDetailMessageOpts) (summary string, msg
 		})
 	}
 
+	requestDetails = appendTargetTags(requestDetails, o.Request.Targets)
+
 	msg = slack.NewBlockMessage(
 		slack.SectionBlock{
 			Type: slack.MBTSection,
@@ -245,3 +252,26 @@ func BuildRequestDetailMessage(o RequestDetailMessageOpts) (summary string, msg
 
 	return summary, msg
 }
+
+// appendTargetTags adds a field listing the distinct admin curated tags of the targets, if any of the targets have tags
+func appendTargetTags(details []*slack.TextBlockObject, targets []access.GroupTarget) []*slack.TextBlockObject {
+	seen := map[string]bool{}
+	var tags []string
+	for _, t := range targets {
+		for k, v := range t.TargetMetadata {
+			tag := fmt.Sprintf("%s=%s", k, v)
+			if !seen[tag] {
+				seen[tag] = true
+				tags = append(tags, tag)
+			}
+		}
+	}
+	if len(tags) == 0 {
+		return details
+	}
+	sort.Strings(tags)
+	return append(details, &slack.TextBlockObject{
+		Type: "mrkdwn",
+		Text: fmt.Sprintf("*Target Tags:*\n%s", strings.Join(tags, ", ")),
+	})
+}
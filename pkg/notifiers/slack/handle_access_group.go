@@ -30,6 +30,10 @@ func (n *SlackNotifier) HandleAccessGroupEvent(ctx context.Context, log *zap.Sug
 		// "your access to X no. of resources for Y access rule has been approved"
 		msg := fmt.Sprintf(":white_check_mark: Your request to access *%s* has been approved.", accessGroup.Group.AccessRuleSnapshot.Name)
 		fallback := fmt.Sprintf("Your request to access %s has been approved.", accessGroup.Group.AccessRuleSnapshot.Name)
+		if accessGroup.Group.IsOnDemand() {
+			msg += " Activate it in Common Fate when you are ready to use it."
+			fallback += " Activate it in Common Fate when you are ready to use it."
+		}
 		n.sendAccessGroupDetailsMessageRequestor(ctx, log, accessGroup, msg, fallback)
 
 		// REVIEWER Message Update:
@@ -146,6 +150,7 @@ func (n *SlackNotifier) sendAccessGroupUpdatesReviewer(ctx context.Context, log
 
 			_, slackMsg := BuildRequestReviewMessage(RequestMessageOpts{
 				Group:            accessGroup.Group,
+				Targets:          accessGroup.Targets,
 				ReviewURLs:       reviewURL,
 				RequestReviewer:  reviewerUserObj.Result,
 				RequestorEmail:   requestor.Email,
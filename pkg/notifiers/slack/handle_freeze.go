@@ -0,0 +1,73 @@
+package slacknotifier
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/slack-go/slack"
+	"go.uber.org/zap"
+)
+
+// HandleFreezeEvent announces changes to access freezes in the incoming webhook channels.
+func (n *SlackNotifier) HandleFreezeEvent(ctx context.Context, log *zap.SugaredLogger, event events.CloudWatchEvent) error {
+	// all freeze events have the same shape
+	var freezeEvent gevent.FreezeCreated
+	err := json.Unmarshal(event.Detail, &freezeEvent)
+	if err != nil {
+		return err
+	}
+	f := freezeEvent.Freeze
+	var summary, text string
+	switch event.DetailType {
+	case gevent.FreezeCreatedType:
+		summary = fmt.Sprintf("Access to %s has been frozen", freezeScopeName(f))
+		text = fmt.Sprintf(":snowflake: Access to %s has been frozen by %s.", freezeScopeName(f), freezeEvent.Actor.Email)
+	case gevent.FreezeUpdatedType:
+		summary = fmt.Sprintf("The freeze on %s has been updated", freezeScopeName(f))
+		text = fmt.Sprintf(":snowflake: The freeze on %s has been updated by %s.", freezeScopeName(f), freezeEvent.Actor.Email)
+	case gevent.FreezeLiftedType:
+		summary = fmt.Sprintf("The freeze on %s has been lifted", freezeScopeName(f))
+		text = fmt.Sprintf(":sunny: The freeze on %s has been lifted by %s. Held activations will now be granted.", freezeScopeName(f), freezeEvent.Actor.Email)
+	default:
+		zap.S().Infow("unhandled freeze event", "detailType", event.DetailType)
+		return nil
+	}
+	if event.DetailType != gevent.FreezeLiftedType {
+		text += fmt.Sprintf("\n*Message:*\n%s", f.Message)
+		if f.EndsAt != nil {
+			text += fmt.Sprintf("\n*Ends:* %s", f.EndsAt.Format(time.RFC1123))
+		}
+		if len(f.ExemptGroups) > 0 {
+			text += fmt.Sprintf("\n*Exempt groups:* %s", strings.Join(f.ExemptGroups, ", "))
+		}
+	}
+	msg := slack.NewBlockMessage(slack.NewSectionBlock(&slack.TextBlockObject{
+		Type: slack.MarkdownType,
+		Text: text,
+	}, nil, nil))
+
+	for _, webhook := range n.webhooks {
+		err = webhook.SendWebhookMessage(ctx, msg.Blocks, summary)
+		if err != nil {
+			log.Errorw("failed to send freeze message to incomingWebhook channel", "error", err)
+		}
+	}
+	return nil
+}
+
+func freezeScopeName(f access.Freeze) string {
+	switch f.Scope {
+	case types.FreezeScopeTARGETGROUP:
+		return fmt.Sprintf("target group *%s*", f.ScopeID)
+	case types.FreezeScopeACCESSRULE:
+		return fmt.Sprintf("access rule *%s*", f.ScopeID)
+	}
+	return "all resources"
+}
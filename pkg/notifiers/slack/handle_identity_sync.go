@@ -0,0 +1,44 @@
+package slacknotifier
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/slack-go/slack"
+	"go.uber.org/zap"
+)
+
+// HandleIdentitySyncEvent notifies the incoming webhook channels when an identity sync is aborted.
+// These are admin facing notifications, so they are not sent as direct messages.
+func (n *SlackNotifier) HandleIdentitySyncEvent(ctx context.Context, log *zap.SugaredLogger, event events.CloudWatchEvent) error {
+	switch event.DetailType {
+	case gevent.IdentitySyncAbortedType:
+		var syncEvent gevent.IdentitySyncAborted
+		err := json.Unmarshal(event.Detail, &syncEvent)
+		if err != nil {
+			return err
+		}
+		changes := syncEvent.Run.Changes
+
+		summary := "Identity sync was aborted"
+		text := fmt.Sprintf(":warning: Identity sync from *%s* was aborted because it would archive %d users and %d groups, which is more than the threshold of %d%%. No changes were applied.\nReview the changes with `gdeploy identity sync --dry-run`, and run `gdeploy identity sync --force` to apply them if they are expected.",
+			syncEvent.Run.IdentityProvider, changes.UsersArchived, changes.GroupsArchived, syncEvent.Threshold)
+		msg := slack.NewBlockMessage(slack.NewSectionBlock(&slack.TextBlockObject{
+			Type: slack.MarkdownType,
+			Text: text,
+		}, nil, nil))
+
+		for _, webhook := range n.webhooks {
+			err = webhook.SendWebhookMessage(ctx, msg.Blocks, summary)
+			if err != nil {
+				log.Errorw("failed to send identity sync aborted message to incomingWebhook channel", "error", err)
+			}
+		}
+	default:
+		zap.S().Infow("unhandled identity sync event", "detailType", event.DetailType)
+	}
+	return nil
+}
@@ -65,6 +65,7 @@ func (n *SlackNotifier) HandleRequestEvent(ctx context.Context, log *zap.Sugared
 				if HAS_SLACK_WEBHOOKS {
 					reviewerSummary, reviewerMsg := BuildRequestReviewMessage(RequestMessageOpts{
 						Group:            group.Group,
+						Targets:          group.Targets,
 						RequestorSlackID: slackUserID,
 						ReviewURLs:       reviewURL,
 						IsWebhook:        true,
@@ -89,6 +90,7 @@ func (n *SlackNotifier) HandleRequestEvent(ctx context.Context, log *zap.Sugared
 
 					reviewerSummary, reviewerMsg := BuildRequestReviewMessage(RequestMessageOpts{
 						Group:            group.Group,
+						Targets:          group.Targets,
 						RequestorSlackID: slackUserID,
 						ReviewURLs:       reviewURL,
 						IsWebhook:        false,
@@ -256,6 +258,7 @@ func (n *SlackNotifier) sendRequestUpdatesReviewer(ctx context.Context, log *zap
 
 				_, slackMsg := BuildRequestReviewMessage(RequestMessageOpts{
 					Group:            group.Group,
+					Targets:          group.Targets,
 					ReviewURLs:       reviewURL,
 					RequestorEmail:   requestor.Email,
 					WasReviewed:      req.Request.RequestStatus != types.PENDING,
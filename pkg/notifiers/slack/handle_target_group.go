@@ -0,0 +1,52 @@
+package slacknotifier
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/slack-go/slack"
+)
+
+// HandleTargetGroupEvent notifies the incoming webhook channels about problems with a target group.
+// These are admin facing notifications, so they are not sent as direct messages.
+func (n *SlackNotifier) HandleTargetGroupEvent(ctx context.Context, event events.CloudWatchEvent) error {
+	log := logger.Get(ctx)
+	switch event.DetailType {
+	case gevent.TargetGroupSyncStaleType:
+		var syncEvent gevent.TargetGroupSyncStale
+		err := json.Unmarshal(event.Detail, &syncEvent)
+		if err != nil {
+			return err
+		}
+		status := syncEvent.SyncStatus
+
+		lastSuccess := "never"
+		if status.LastSuccessAt != nil {
+			lastSuccess = status.LastSuccessAt.Format(time.RFC1123)
+		}
+		summary := fmt.Sprintf("Resources for target group %s are stale", status.TargetGroupID)
+		text := fmt.Sprintf(":warning: Resources for target group *%s* have not been synced successfully since %s.", status.TargetGroupID, lastSuccess)
+		if status.LastError != nil {
+			text += fmt.Sprintf("\n*Last Error:*\n%s", *status.LastError)
+		}
+		msg := slack.NewBlockMessage(slack.NewSectionBlock(&slack.TextBlockObject{
+			Type: slack.MarkdownType,
+			Text: text,
+		}, nil, nil))
+
+		for _, webhook := range n.webhooks {
+			err = webhook.SendWebhookMessage(ctx, msg.Blocks, summary)
+			if err != nil {
+				log.Errorw("failed to send stale target group message to incomingWebhook channel", "error", err)
+			}
+		}
+	default:
+		log.Infow("unhandled target group event", "detailType", event.DetailType)
+	}
+	return nil
+}
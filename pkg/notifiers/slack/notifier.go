@@ -56,6 +56,18 @@ func (n *SlackNotifier) Init(ctx context.Context, config *deploy.Notifications)
 func (n *SlackNotifier) HandleEvent(ctx context.Context, event events.CloudWatchEvent) (err error) {
 	log := zap.S().With("slack", event)
 	log.Info("received event from eventbridge")
+	if strings.HasPrefix(event.DetailType, "targetGroup") {
+		log.Info("targetGroup event type")
+		return n.HandleTargetGroupEvent(ctx, event)
+	}
+	if strings.HasPrefix(event.DetailType, "identitySync") {
+		log.Info("identitySync event type")
+		return n.HandleIdentitySyncEvent(ctx, log, event)
+	}
+	if strings.HasPrefix(event.DetailType, "freeze") {
+		log.Info("freeze event type")
+		return n.HandleFreezeEvent(ctx, log, event)
+	}
 	if n.directMessageClient != nil {
 		if strings.HasPrefix(event.DetailType, "request") {
 			log.Info("request event type")
@@ -0,0 +1,128 @@
+package registrymirror
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/provider-registry-sdk-go/pkg/providerregistrysdk"
+	"github.com/go-chi/chi/v5"
+)
+
+// Server implements the provider registry API, serving providers from a Store.
+type Server struct {
+	Store Store
+}
+
+var _ providerregistrysdk.ServerInterface = &Server{}
+
+// Handler returns a http.Handler serving the provider registry API.
+func (s *Server) Handler() http.Handler {
+	r := chi.NewRouter()
+	return providerregistrysdk.HandlerWithOptions(s, providerregistrysdk.ChiServerOptions{
+		BaseRouter: r,
+		ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			apio.Error(r.Context(), w, apio.NewRequestError(err, http.StatusBadRequest))
+		},
+	})
+}
+
+func (s *Server) Healthcheck(w http.ResponseWriter, r *http.Request) {
+	apio.JSON(r.Context(), w, providerregistrysdk.HealthResponse{Healthy: true}, http.StatusOK)
+}
+
+func (s *Server) ListAllProviders(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	providers, err := ListProviders(ctx, s.Store)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, providerregistrysdk.ListProvidersResponse{Providers: providers}, http.StatusOK)
+}
+
+func (s *Server) GetProvider(w http.ResponseWriter, r *http.Request, publisher string, name string, version string) {
+	ctx := r.Context()
+	p := providerregistrysdk.Provider{Publisher: publisher, Name: name, Version: version}
+	var detail providerregistrysdk.ProviderDetail
+	err := s.readJSON(ctx, p, providerFile, &detail)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, detail, http.StatusOK)
+}
+
+func (s *Server) GetProviderSetupDocs(w http.ResponseWriter, r *http.Request, publisher string, name string, version string) {
+	s.getDocs(w, r, providerregistrysdk.Provider{Publisher: publisher, Name: name, Version: version}, setupDocsFile)
+}
+
+func (s *Server) GetProviderUsageDoc(w http.ResponseWriter, r *http.Request, publisher string, name string, version string) {
+	s.getDocs(w, r, providerregistrysdk.Provider{Publisher: publisher, Name: name, Version: version}, usageDocsFile)
+}
+
+func (s *Server) getDocs(w http.ResponseWriter, r *http.Request, p providerregistrysdk.Provider, file string) {
+	ctx := r.Context()
+	docs := []string{}
+	err := s.readJSON(ctx, p, file, &docs)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, docs, http.StatusOK)
+}
+
+// readJSON reads and unmarshals a file belonging to a provider version from the store.
+// If the provider isn't valid a 400 request error is returned, and if the object does not exist a 404 request error is returned.
+func (s *Server) readJSON(ctx context.Context, p providerregistrysdk.Provider, file string, v any) error {
+	key, err := providerKey(p, file)
+	if err != nil {
+		return apio.NewRequestError(err, http.StatusBadRequest)
+	}
+	data, err := s.Store.Read(ctx, key)
+	if errors.Is(err, ErrNotFound) {
+		return apio.NewRequestError(errors.New("provider not found"), http.StatusNotFound)
+	}
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// ListProviders returns all providers in the store, sorted by publisher, name and version.
+func ListProviders(ctx context.Context, store Store) ([]providerregistrysdk.ProviderDetail, error) {
+	keys, err := store.List(ctx, providersPrefix)
+	if err != nil {
+		return nil, err
+	}
+	providers := []providerregistrysdk.ProviderDetail{}
+	for _, key := range keys {
+		if !isProviderKey(key) {
+			continue
+		}
+		data, err := store.Read(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		var detail providerregistrysdk.ProviderDetail
+		err = json.Unmarshal(data, &detail)
+		if err != nil {
+			return nil, err
+		}
+		providers = append(providers, detail)
+	}
+	sort.Slice(providers, func(i, j int) bool {
+		a, b := providers[i], providers[j]
+		if a.Publisher != b.Publisher {
+			return a.Publisher < b.Publisher
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Version < b.Version
+	})
+	return providers, nil
+}
@@ -0,0 +1,113 @@
+package registrymirror
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/common-fate/provider-registry-sdk-go/pkg/providerregistrysdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncAndServe(t *testing.T) {
+	ctx := context.Background()
+	p := providerregistrysdk.Provider{Publisher: "common-fate", Name: "aws", Version: "v0.1.0"}
+	detail := providerregistrysdk.ProviderDetail{
+		Publisher:        p.Publisher,
+		Name:             p.Name,
+		Version:          p.Version,
+		LambdaAssetS3Arn: "arn:aws:s3:::example/handler.zip",
+		CfnTemplateS3Arn: "arn:aws:s3:::example/cloudformation.json",
+		Schema:           providerregistrysdk.Schema{Id: "https://schema.commonfate.io/provider/v1alpha1"},
+	}
+
+	// seed an upstream mirror to act as the public registry
+	upstreamStore := DirStore{Root: t.TempDir()}
+	data, err := json.Marshal(detail)
+	require.NoError(t, err)
+	require.NoError(t, upstreamStore.Write(ctx, "providers/common-fate/aws/v0.1.0/provider.json", data))
+	require.NoError(t, upstreamStore.Write(ctx, "providers/common-fate/aws/v0.1.0/setup.json", []byte(`["# Setup"]`)))
+
+	upstream := httptest.NewServer((&Server{Store: upstreamStore}).Handler())
+	defer upstream.Close()
+	upstreamClient, err := providerregistrysdk.NewClientWithResponses(upstream.URL)
+	require.NoError(t, err)
+
+	store := DirStore{Root: t.TempDir()}
+	err = Sync(ctx, upstreamClient, store, p)
+	require.NoError(t, err)
+
+	mirror := httptest.NewServer((&Server{Store: store}).Handler())
+	defer mirror.Close()
+	client, err := providerregistrysdk.NewClientWithResponses(mirror.URL)
+	require.NoError(t, err)
+
+	got, err := client.GetProviderWithResponse(ctx, p.Publisher, p.Name, p.Version)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, got.StatusCode())
+	assert.Equal(t, &detail, got.JSON200)
+
+	setup, err := client.GetProviderSetupDocsWithResponse(ctx, p.Publisher, p.Name, p.Version)
+	require.NoError(t, err)
+	assert.Equal(t, &[]string{"# Setup"}, setup.JSON200)
+
+	// usage docs were not present upstream
+	usage, err := client.GetProviderUsageDocWithResponse(ctx, p.Publisher, p.Name, p.Version)
+	require.NoError(t, err)
+	assert.Equal(t, &[]string{}, usage.JSON200)
+
+	list, err := client.ListAllProvidersWithResponse(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []providerregistrysdk.ProviderDetail{detail}, list.JSON200.Providers)
+
+	missing, err := client.GetProviderWithResponse(ctx, p.Publisher, p.Name, "v9.9.9")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, missing.StatusCode())
+}
+
+func TestPathTraversal(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	store := DirStore{Root: filepath.Join(root, "mirror")}
+	// a file outside of the mirror which must not be readable or writable through it
+	require.NoError(t, os.WriteFile(filepath.Join(root, "secret.json"), []byte(`{"secret":true}`), 0600))
+
+	type testcase struct {
+		name string
+		give providerregistrysdk.Provider
+	}
+	testcases := []testcase{
+		{name: "parent directory", give: providerregistrysdk.Provider{Publisher: "..", Name: "..", Version: ".."}},
+		{name: "dots within a segment", give: providerregistrysdk.Provider{Publisher: "common-fate", Name: "aws", Version: "v1/../../.."}},
+		{name: "slash", give: providerregistrysdk.Provider{Publisher: "common-fate/aws", Name: "aws", Version: "v0.1.0"}},
+		{name: "backslash", give: providerregistrysdk.Provider{Publisher: `..\..`, Name: "aws", Version: "v0.1.0"}},
+		{name: "empty", give: providerregistrysdk.Provider{Publisher: "common-fate", Name: "", Version: "v0.1.0"}},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := providerKey(tc.give, providerFile)
+			assert.Equal(t, ErrInvalidKey, err)
+		})
+	}
+
+	_, err := store.Read(ctx, "../secret.json")
+	assert.Equal(t, ErrInvalidKey, err)
+	err = store.Write(ctx, "providers/../../secret.json", []byte(`{}`))
+	assert.Equal(t, ErrInvalidKey, err)
+	secret, err := os.ReadFile(filepath.Join(root, "secret.json"))
+	require.NoError(t, err)
+	assert.Equal(t, `{"secret":true}`, string(secret))
+
+	// escaped slashes in the request path are decoded into the version, so the provider is rejected by the server too
+	mirror := httptest.NewServer((&Server{Store: store}).Handler())
+	defer mirror.Close()
+	res, err := http.Get(mirror.URL + "/v1alpha1/providers/common-fate/aws/..%2F..%2F..%2Fsecret.json")
+	require.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
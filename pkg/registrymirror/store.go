@@ -0,0 +1,70 @@
+// Package registrymirror serves provider manifests and schemas from a local
+// directory or S3 bucket using the same API as the public provider registry.
+// It allows Common Fate to be deployed in environments which cannot reach the
+// public registry.
+package registrymirror
+
+import (
+	"context"
+	"errors"
+	"path"
+	"strings"
+
+	"github.com/common-fate/provider-registry-sdk-go/pkg/providerregistrysdk"
+)
+
+// ErrNotFound is returned by a Store when an object does not exist.
+var ErrNotFound = errors.New("not found")
+
+// ErrInvalidKey is returned when a key or a provider contains a path segment which could escape the mirror,
+// or an empty segment.
+var ErrInvalidKey = errors.New("invalid key")
+
+// Store is the backing storage for a mirror.
+// Keys are slash separated paths relative to the root of the mirror.
+type Store interface {
+	Read(ctx context.Context, key string) ([]byte, error)
+	Write(ctx context.Context, key string, data []byte) error
+	// List returns all keys in the store beginning with prefix.
+	List(ctx context.Context, prefix string) ([]string, error)
+}
+
+const (
+	providersPrefix = "providers"
+	providerFile    = "provider.json"
+	setupDocsFile   = "setup.json"
+	usageDocsFile   = "usage.json"
+)
+
+// providerKey returns the key of a file belonging to a provider version,
+// in the format providers/<publisher>/<name>/<version>/<file>
+//
+// The publisher, name and version come from request paths, so ErrInvalidKey is returned
+// if any of them aren't a single path segment.
+func providerKey(p providerregistrysdk.Provider, file string) (string, error) {
+	for _, segment := range []string{p.Publisher, p.Name, p.Version, file} {
+		if !validSegment(segment) {
+			return "", ErrInvalidKey
+		}
+	}
+	return path.Join(providersPrefix, p.Publisher, p.Name, p.Version, file), nil
+}
+
+// validSegment returns false for empty segments, and for segments containing a path separator or "..".
+func validSegment(segment string) bool {
+	return segment != "" && !strings.ContainsAny(segment, `/\`) && !strings.Contains(segment, "..")
+}
+
+// validKey returns false if any segment of the slash separated key is invalid.
+func validKey(key string) bool {
+	for _, segment := range strings.Split(key, "/") {
+		if !validSegment(segment) {
+			return false
+		}
+	}
+	return true
+}
+
+func isProviderKey(key string) bool {
+	return strings.HasPrefix(key, providersPrefix+"/") && path.Base(key) == providerFile
+}
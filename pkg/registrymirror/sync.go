@@ -0,0 +1,61 @@
+package registrymirror
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/common-fate/provider-registry-sdk-go/pkg/providerregistrysdk"
+)
+
+// Sync copies a provider version, including its schema and documentation,
+// from an upstream registry into the store.
+func Sync(ctx context.Context, upstream providerregistrysdk.ClientWithResponsesInterface, store Store, p providerregistrysdk.Provider) error {
+	res, err := upstream.GetProviderWithResponse(ctx, p.Publisher, p.Name, p.Version)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode() != http.StatusOK || res.JSON200 == nil {
+		return fmt.Errorf("fetching provider %s/%s@%s from registry: unexpected response %d: %s", p.Publisher, p.Name, p.Version, res.StatusCode(), string(res.Body))
+	}
+
+	setup, err := upstream.GetProviderSetupDocsWithResponse(ctx, p.Publisher, p.Name, p.Version)
+	if err != nil {
+		return err
+	}
+	usage, err := upstream.GetProviderUsageDocWithResponse(ctx, p.Publisher, p.Name, p.Version)
+	if err != nil {
+		return err
+	}
+
+	// docs are optional for a provider, so a missing document is stored as an empty list
+	files := map[string]any{
+		providerFile:  res.JSON200,
+		setupDocsFile: docsOrEmpty(setup.JSON200),
+		usageDocsFile: docsOrEmpty(usage.JSON200),
+	}
+	// write the provider manifest last so that a partially synced provider is not listed
+	for _, file := range []string{setupDocsFile, usageDocsFile, providerFile} {
+		data, err := json.Marshal(files[file])
+		if err != nil {
+			return err
+		}
+		key, err := providerKey(p, file)
+		if err != nil {
+			return err
+		}
+		err = store.Write(ctx, key, data)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func docsOrEmpty(docs *[]string) []string {
+	if docs == nil {
+		return []string{}
+	}
+	return *docs
+}
@@ -28,6 +28,17 @@ type AccessRule struct {
 	Approval Approval `json:"approval" dynamodbav:"approval"`
 }
 
+// DefaultActivationWindow is used for access rules which don't set an activation window.
+const DefaultActivationWindow = 24 * time.Hour
+
+// ActivationWindow is how long requesters have to activate on demand access after it is approved.
+func (a AccessRule) ActivationWindow() time.Duration {
+	if a.TimeConstraints.ActivationWindowSeconds == nil || *a.TimeConstraints.ActivationWindowSeconds <= 0 {
+		return DefaultActivationWindow
+	}
+	return time.Duration(*a.TimeConstraints.ActivationWindowSeconds) * time.Second
+}
+
 // AccessRuleMetadata defines model for AccessRuleMetadata.
 type AccessRuleMetadata struct {
 	CreatedAt time.Time `json:"createdAt" dynamodbav:"createdAt"`
@@ -45,15 +56,20 @@ type Approval struct {
 	//List of users ids represents the individual users who may approve requests for this rule.
 	// This does not represent members of the approval groups
 	Users []string `json:"users" dynamodbav:"users"`
+	// ManagerLevel, if greater than 0, makes the requester's manager this many levels above the requester an approver.
+	// 1 is the requester's manager, 2 is their manager's manager, and so on.
+	ManagerLevel int `json:"managerLevel,omitempty" dynamodbav:"managerLevel,omitempty"`
 }
 
 func (a *Approval) IsRequired() bool {
-	return len(a.Users) > 0 || len(a.Groups) > 0
+	return len(a.Users) > 0 || len(a.Groups) > 0 || a.ManagerLevel > 0
 }
 
 type Target struct {
 	TargetGroup           target.Group                    `json:"targetGroup" dynamodbav:"targetGroup"`
 	FieldFilterExpessions map[string]types.ResourceFilter `json:"fieldFilterExpessions" dynamodbav:"fieldFilterExpessions"`
+	// MetadataFilter restricts the rule to targets which have all of these admin curated tags applied
+	MetadataFilter map[string]string `json:"metadataFilter,omitempty" dynamodbav:"metadataFilter,omitempty"`
 }
 
 // // ised for admin apis, this contains the access rule target in a format for updating the access rule provider target
@@ -68,6 +84,9 @@ func (a AccessRule) ToAPI() types.AccessRule {
 	if a.Approval.Users != nil {
 		approval.Users = &a.Approval.Users
 	}
+	if a.Approval.ManagerLevel > 0 {
+		approval.ManagerLevel = &a.Approval.ManagerLevel
+	}
 
 	targets := []types.AccessRuleTarget{}
 
@@ -86,8 +105,9 @@ func (a AccessRule) ToAPI() types.AccessRule {
 		},
 		Groups: a.Groups,
 		TimeConstraints: types.AccessRuleTimeConstraints{
-			MaxDurationSeconds:     a.TimeConstraints.MaxDurationSeconds,
-			DefaultDurationSeconds: a.TimeConstraints.DefaultDurationSeconds,
+			MaxDurationSeconds:      a.TimeConstraints.MaxDurationSeconds,
+			DefaultDurationSeconds:  a.TimeConstraints.DefaultDurationSeconds,
+			ActivationWindowSeconds: a.TimeConstraints.ActivationWindowSeconds,
 		},
 		Approval: approval,
 		Targets:  targets,
@@ -99,12 +119,26 @@ func (a AccessRule) ToAPI() types.AccessRule {
 func (t Target) ToAPI() types.AccessRuleTarget {
 	filters := make(map[string]types.ResourceFilter, 0)
 
-	return types.AccessRuleTarget{
+	out := types.AccessRuleTarget{
 		FieldFilterExpessions: types.AccessRuleTarget_FieldFilterExpessions{
 			AdditionalProperties: filters,
 		},
 		TargetGroup: t.TargetGroup.ToAPI(),
 	}
+	if len(t.MetadataFilter) > 0 {
+		out.MetadataFilter = &types.AccessRuleTarget_MetadataFilter{AdditionalProperties: t.MetadataFilter}
+	}
+	return out
+}
+
+// MatchesMetadata returns true if the target has every tag in the metadata filter
+func (t Target) MatchesMetadata(metadata map[string]string) bool {
+	for k, v := range t.MetadataFilter {
+		if metadata[k] != v {
+			return false
+		}
+	}
+	return true
 }
 
 func (r *AccessRule) DDBKeys() (ddb.Keys, error) {
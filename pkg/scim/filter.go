@@ -0,0 +1,425 @@
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Filter is a parsed SCIM filter expression, as described in RFC 7644 section 3.4.2.2.
+type Filter interface {
+	// Matches returns true if the resource, in its JSON object representation, matches the filter.
+	Matches(resource map[string]any) bool
+}
+
+// ParseFilter parses a SCIM filter such as `userName eq "alice@example.com"`.
+// The eq, ne, co, sw, ew, gt, ge, lt, le and pr operators are supported,
+// combined with and, or, not, parentheses and value paths like `emails[type eq "work"]`.
+// Attribute names and string values are compared case-insensitively.
+func ParseFilter(filter string) (Filter, error) {
+	tokens, err := tokenize(filter)
+	if err != nil {
+		return nil, err
+	}
+	p := parser{tokens: tokens}
+	f, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if !p.done() {
+		return nil, fmt.Errorf("unexpected %q in filter", p.peek().text)
+	}
+	return f, nil
+}
+
+type tokenKind int
+
+const (
+	tokenWord tokenKind = iota
+	tokenString
+	tokenOpenParen
+	tokenCloseParen
+	tokenOpenBracket
+	tokenCloseBracket
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+func tokenize(s string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n':
+			i++
+		case c == '(':
+			tokens = append(tokens, token{kind: tokenOpenParen, text: "("})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{kind: tokenCloseParen, text: ")"})
+			i++
+		case c == '[':
+			tokens = append(tokens, token{kind: tokenOpenBracket, text: "["})
+			i++
+		case c == ']':
+			tokens = append(tokens, token{kind: tokenCloseBracket, text: "]"})
+			i++
+		case c == '"':
+			end := i + 1
+			for ; end < len(s); end++ {
+				if s[end] == '\\' {
+					end++
+					continue
+				}
+				if s[end] == '"' {
+					break
+				}
+			}
+			if end >= len(s) {
+				return nil, fmt.Errorf("unterminated string in filter")
+			}
+			var v string
+			err := json.Unmarshal([]byte(s[i:end+1]), &v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid string in filter: %w", err)
+			}
+			tokens = append(tokens, token{kind: tokenString, text: v})
+			i = end + 1
+		default:
+			end := i
+			for end < len(s) && !strings.ContainsRune(" \t\n()[]\"", rune(s[end])) {
+				end++
+			}
+			tokens = append(tokens, token{kind: tokenWord, text: s[i:end]})
+			i = end
+		}
+	}
+	return tokens, nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *parser) peek() token {
+	if p.done() {
+		return token{}
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() (token, error) {
+	if p.done() {
+		return token{}, fmt.Errorf("unexpected end of filter")
+	}
+	t := p.tokens[p.pos]
+	p.pos++
+	return t, nil
+}
+
+func (p *parser) peekKeyword(keyword string) bool {
+	t := p.peek()
+	return !p.done() && t.kind == tokenWord && strings.EqualFold(t.text, keyword)
+}
+
+func (p *parser) parseOr() (Filter, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekKeyword("or") {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orFilter{left, right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (Filter, error) {
+	left, err := p.parseFactor()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekKeyword("and") {
+		p.pos++
+		right, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		left = andFilter{left, right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseFactor() (Filter, error) {
+	if p.peekKeyword("not") {
+		p.pos++
+		f, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		return notFilter{f}, nil
+	}
+	t, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	if t.kind == tokenOpenParen {
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if t, err := p.next(); err != nil || t.kind != tokenCloseParen {
+			return nil, fmt.Errorf("expected ')' in filter")
+		}
+		return f, nil
+	}
+	if t.kind != tokenWord {
+		return nil, fmt.Errorf("expected an attribute but got %q", t.text)
+	}
+	attr := stripSchema(t.text)
+
+	// a value path such as emails[type eq "work"]
+	if p.peek().kind == tokenOpenBracket && !p.done() {
+		p.pos++
+		sub, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if t, err := p.next(); err != nil || t.kind != tokenCloseBracket {
+			return nil, fmt.Errorf("expected ']' in filter")
+		}
+		return valuePathFilter{attr: attr, filter: sub}, nil
+	}
+
+	op, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	if op.kind != tokenWord {
+		return nil, fmt.Errorf("expected an operator but got %q", op.text)
+	}
+	operator := strings.ToLower(op.text)
+	if operator == "pr" {
+		return presentFilter{attr: attr}, nil
+	}
+	switch operator {
+	case "eq", "ne", "co", "sw", "ew", "gt", "ge", "lt", "le":
+	default:
+		return nil, fmt.Errorf("unsupported operator %q", op.text)
+	}
+
+	v, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	var value any
+	switch {
+	case v.kind == tokenString:
+		value = v.text
+	case v.kind == tokenWord && v.text == "true":
+		value = true
+	case v.kind == tokenWord && v.text == "false":
+		value = false
+	case v.kind == tokenWord && v.text == "null":
+		value = nil
+	case v.kind == tokenWord:
+		n, err := strconv.ParseFloat(v.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %q in filter", v.text)
+		}
+		value = n
+	default:
+		return nil, fmt.Errorf("expected a value but got %q", v.text)
+	}
+	return compareFilter{attr: attr, op: operator, value: value}, nil
+}
+
+type andFilter struct{ left, right Filter }
+
+func (f andFilter) Matches(r map[string]any) bool { return f.left.Matches(r) && f.right.Matches(r) }
+
+type orFilter struct{ left, right Filter }
+
+func (f orFilter) Matches(r map[string]any) bool { return f.left.Matches(r) || f.right.Matches(r) }
+
+type notFilter struct{ f Filter }
+
+func (f notFilter) Matches(r map[string]any) bool { return !f.f.Matches(r) }
+
+type presentFilter struct{ attr string }
+
+func (f presentFilter) Matches(r map[string]any) bool {
+	for _, v := range lookup(r, f.attr) {
+		if v != nil && v != "" {
+			return true
+		}
+	}
+	return false
+}
+
+type valuePathFilter struct {
+	attr   string
+	filter Filter
+}
+
+func (f valuePathFilter) Matches(r map[string]any) bool {
+	for _, v := range lookup(r, f.attr) {
+		if m, ok := v.(map[string]any); ok && f.filter.Matches(m) {
+			return true
+		}
+	}
+	return false
+}
+
+type compareFilter struct {
+	attr  string
+	op    string
+	value any
+}
+
+func (f compareFilter) Matches(r map[string]any) bool {
+	values := lookup(r, f.attr)
+	if f.op == "ne" {
+		for _, v := range values {
+			if compare(v, "eq", f.value) {
+				return false
+			}
+		}
+		return true
+	}
+	for _, v := range values {
+		if compare(v, f.op, f.value) {
+			return true
+		}
+	}
+	return false
+}
+
+func compare(actual any, op string, expected any) bool {
+	switch e := expected.(type) {
+	case string:
+		a, ok := actual.(string)
+		if !ok {
+			return false
+		}
+		a, e = strings.ToLower(a), strings.ToLower(e)
+		switch op {
+		case "eq":
+			return a == e
+		case "co":
+			return strings.Contains(a, e)
+		case "sw":
+			return strings.HasPrefix(a, e)
+		case "ew":
+			return strings.HasSuffix(a, e)
+		case "gt":
+			return a > e
+		case "ge":
+			return a >= e
+		case "lt":
+			return a < e
+		case "le":
+			return a <= e
+		}
+	case float64:
+		a, ok := actual.(float64)
+		if !ok {
+			return false
+		}
+		switch op {
+		case "eq":
+			return a == e
+		case "gt":
+			return a > e
+		case "ge":
+			return a >= e
+		case "lt":
+			return a < e
+		case "le":
+			return a <= e
+		}
+	case bool:
+		a, ok := actual.(bool)
+		return ok && op == "eq" && a == e
+	case nil:
+		return op == "eq" && actual == nil
+	}
+	return false
+}
+
+// lookup returns the values of a possibly dotted attribute path such as name.givenName or emails.value,
+// flattening multi-valued attributes.
+func lookup(r map[string]any, path string) []any {
+	values := []any{r}
+	for _, part := range strings.Split(path, ".") {
+		var next []any
+		for _, v := range values {
+			m, ok := v.(map[string]any)
+			if !ok {
+				continue
+			}
+			key, ok := findKey(m, part)
+			if !ok {
+				continue
+			}
+			if arr, ok := m[key].([]any); ok {
+				next = append(next, arr...)
+			} else {
+				next = append(next, m[key])
+			}
+		}
+		values = next
+	}
+	return values
+}
+
+// findKey returns the key in the map which matches the attribute name case-insensitively.
+func findKey(m map[string]any, attr string) (string, bool) {
+	if _, ok := m[attr]; ok {
+		return attr, true
+	}
+	for k := range m {
+		if strings.EqualFold(k, attr) {
+			return k, true
+		}
+	}
+	return "", false
+}
+
+// stripSchema removes a schema URN prefix from an attribute, so that
+// urn:ietf:params:scim:schemas:core:2.0:User:userName becomes userName.
+func stripSchema(attr string) string {
+	for _, schema := range []string{UserSchema, GroupSchema} {
+		if len(attr) > len(schema) && strings.EqualFold(attr[:len(schema)+1], schema+":") {
+			return attr[len(schema)+1:]
+		}
+	}
+	return attr
+}
+
+// isIdentifier returns true if s is a valid attribute name.
+func isIdentifier(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' && r != '-' && r != '$' {
+			return false
+		}
+	}
+	return true
+}
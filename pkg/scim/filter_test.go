@@ -0,0 +1,66 @@
+package scim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFilter(t *testing.T) {
+	resource := map[string]any{
+		"userName":   "Alice@example.com",
+		"externalId": "00u1",
+		"active":     true,
+		"name":       map[string]any{"givenName": "Alice", "familyName": "Smith"},
+		"emails": []any{
+			map[string]any{"value": "alice@example.com", "type": "work", "primary": true},
+			map[string]any{"value": "alice@home.example", "type": "home"},
+		},
+	}
+
+	type testcase struct {
+		filter  string
+		want    bool
+		wantErr bool
+	}
+
+	testcases := []testcase{
+		{filter: `userName eq "alice@example.com"`, want: true},
+		{filter: `USERNAME EQ "alice@example.com"`, want: true},
+		{filter: `urn:ietf:params:scim:schemas:core:2.0:User:userName eq "alice@example.com"`, want: true},
+		{filter: `userName eq "bob@example.com"`, want: false},
+		{filter: `userName ne "bob@example.com"`, want: true},
+		{filter: `userName sw "alice"`, want: true},
+		{filter: `userName ew "example.com"`, want: true},
+		{filter: `userName co "@"`, want: true},
+		{filter: `externalId pr`, want: true},
+		{filter: `displayName pr`, want: false},
+		{filter: `active eq true`, want: true},
+		{filter: `name.givenName eq "Alice"`, want: true},
+		{filter: `emails.value eq "alice@home.example"`, want: true},
+		{filter: `emails[type eq "work" and value co "example.com"]`, want: true},
+		{filter: `emails[type eq "other"]`, want: false},
+		{filter: `userName eq "bob@example.com" or externalId eq "00u1"`, want: true},
+		{filter: `userName eq "alice@example.com" and externalId eq "other"`, want: false},
+		{filter: `not (externalId eq "other")`, want: true},
+		{filter: `userName eq "bob@example.com" or (active eq true and externalId eq "00u1")`, want: true},
+		{filter: `userName eq "with \"quotes\""`, want: false},
+		{filter: `userName`, wantErr: true},
+		{filter: `userName xx "a"`, wantErr: true},
+		{filter: `userName eq "unterminated`, wantErr: true},
+		{filter: `(userName eq "a"`, wantErr: true},
+		{filter: `userName eq "a" extra`, wantErr: true},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.filter, func(t *testing.T) {
+			f, err := ParseFilter(tc.filter)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, f.Matches(resource))
+		})
+	}
+}
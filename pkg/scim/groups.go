@@ -0,0 +1,189 @@
+package scim
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/service/scimsvc"
+	"github.com/go-chi/chi/v5"
+)
+
+func groupToSCIM(r *http.Request, g identity.Group) Group {
+	res := Group{
+		Schemas:     []string{GroupSchema},
+		ID:          g.ID,
+		ExternalID:  g.ExternalID,
+		DisplayName: g.Name,
+		Meta: &Meta{
+			ResourceType: "Group",
+			Created:      &g.CreatedAt,
+			LastModified: &g.UpdatedAt,
+			Location:     location(r, "Groups", g.ID),
+		},
+	}
+	for _, u := range g.Users {
+		res.Members = append(res.Members, MultiValue{Value: u})
+	}
+	return res
+}
+
+func groupInput(g Group) (scimsvc.GroupInput, error) {
+	in := scimsvc.GroupInput{
+		Name:       g.DisplayName,
+		ExternalID: g.ExternalID,
+		Members:    []string{},
+	}
+	for _, m := range g.Members {
+		in.Members = append(in.Members, m.Value)
+	}
+	if in.Name == "" {
+		return in, NewError(http.StatusBadRequest, "invalidValue", "displayName is required")
+	}
+	return in, nil
+}
+
+// excludeMembers returns true if the request asked for group members to be left out of the response,
+// which SCIM clients do to avoid fetching the members of large groups.
+func excludeMembers(r *http.Request) bool {
+	for _, attr := range strings.Split(r.URL.Query().Get("excludedAttributes"), ",") {
+		if strings.EqualFold(strings.TrimSpace(attr), "members") {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *Handler) listGroups(w http.ResponseWriter, r *http.Request) {
+	groups, err := h.Service.ListGroups(r.Context())
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	res := []Group{}
+	for _, g := range groups {
+		res = append(res, groupToSCIM(r, g))
+	}
+	resp, err := list(r, res)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if excludeMembers(r) {
+		// filters may refer to members, so they are removed after filtering
+		for i, g := range resp.Resources {
+			g := g.(Group)
+			g.Members = nil
+			resp.Resources[i] = g
+		}
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (h *Handler) getGroup(w http.ResponseWriter, r *http.Request) {
+	g, err := h.Service.GetGroup(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	res := groupToSCIM(r, *g)
+	if excludeMembers(r) {
+		res.Members = nil
+	}
+	writeJSON(w, http.StatusOK, res)
+}
+
+func (h *Handler) createGroup(w http.ResponseWriter, r *http.Request) {
+	var body Group
+	err := decode(r, &body)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	in, err := groupInput(body)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	g, err := h.Service.CreateGroup(r.Context(), in)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	res := groupToSCIM(r, *g)
+	w.Header().Set("Location", res.Meta.Location)
+	writeJSON(w, http.StatusCreated, res)
+}
+
+func (h *Handler) replaceGroup(w http.ResponseWriter, r *http.Request) {
+	var body Group
+	err := decode(r, &body)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	h.updateGroup(w, r, body, false)
+}
+
+// patchGroup applies a PATCH request to a group.
+// SCIM clients usually patch group members rather than replacing them, and expect a 204 response.
+func (h *Handler) patchGroup(w http.ResponseWriter, r *http.Request) {
+	var body PatchRequest
+	err := decode(r, &body)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	g, err := h.Service.GetGroup(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	m, err := toMap(groupToSCIM(r, *g))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if _, ok := m["members"]; !ok {
+		m["members"] = []any{}
+	}
+	err = ApplyPatch(m, body.Operations)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	var patched Group
+	err = fromMap(m, &patched)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	h.updateGroup(w, r, patched, true)
+}
+
+func (h *Handler) updateGroup(w http.ResponseWriter, r *http.Request, body Group, noContent bool) {
+	in, err := groupInput(body)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	g, err := h.Service.UpdateGroup(r.Context(), chi.URLParam(r, "id"), in)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if noContent {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	writeJSON(w, http.StatusOK, groupToSCIM(r, *g))
+}
+
+func (h *Handler) deleteGroup(w http.ResponseWriter, r *http.Request) {
+	err := h.Service.DeleteGroup(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
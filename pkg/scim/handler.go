@@ -0,0 +1,240 @@
+package scim
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/common-fate/pkg/service/scimsvc"
+	"github.com/go-chi/chi/v5"
+)
+
+// BasePath is the path which the SCIM API is served under.
+const BasePath = "/scim/v2"
+
+// Handler serves the SCIM 2.0 API for users and groups.
+type Handler struct {
+	Service Service
+	// Token is the bearer token which SCIM clients authenticate with.
+	Token string
+}
+
+// Routes returns the HTTP handler for the SCIM API. It should be mounted at BasePath.
+func (h *Handler) Routes() http.Handler {
+	r := chi.NewRouter()
+	r.Use(h.authenticate)
+	r.Get("/ServiceProviderConfig", h.serviceProviderConfig)
+	r.Get("/ResourceTypes", h.resourceTypes)
+
+	r.Get("/Users", h.listUsers)
+	r.Post("/Users", h.createUser)
+	r.Get("/Users/{id}", h.getUser)
+	r.Put("/Users/{id}", h.replaceUser)
+	r.Patch("/Users/{id}", h.patchUser)
+	r.Delete("/Users/{id}", h.deleteUser)
+
+	r.Get("/Groups", h.listGroups)
+	r.Post("/Groups", h.createGroup)
+	r.Get("/Groups/{id}", h.getGroup)
+	r.Put("/Groups/{id}", h.replaceGroup)
+	r.Patch("/Groups/{id}", h.patchGroup)
+	r.Delete("/Groups/{id}", h.deleteGroup)
+
+	r.NotFound(func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, r, NewError(http.StatusNotFound, "", "not found"))
+	})
+	r.MethodNotAllowed(func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, r, NewError(http.StatusMethodNotAllowed, "", "method not allowed"))
+	})
+	return r
+}
+
+func (h *Handler) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header := r.Header.Get("Authorization")
+		token := strings.TrimPrefix(header, "Bearer ")
+		if token == header || h.Token == "" || subtle.ConstantTimeCompare([]byte(token), []byte(h.Token)) != 1 {
+			writeError(w, r, NewError(http.StatusUnauthorized, "", "a valid bearer token is required"))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (h *Handler) serviceProviderConfig(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"schemas":        []string{ServiceProviderConfigSchema},
+		"patch":          map[string]any{"supported": true},
+		"bulk":           map[string]any{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]any{"supported": true, "maxResults": maxResults},
+		"changePassword": map[string]any{"supported": false},
+		"sort":           map[string]any{"supported": false},
+		"etag":           map[string]any{"supported": false},
+		"authenticationSchemes": []map[string]any{{
+			"type":        "oauthbearertoken",
+			"name":        "OAuth Bearer Token",
+			"description": "Authentication with a bearer token",
+			"primary":     true,
+		}},
+	})
+}
+
+func (h *Handler) resourceTypes(w http.ResponseWriter, r *http.Request) {
+	types := []any{
+		map[string]any{"schemas": []string{ResourceTypeSchema}, "id": "User", "name": "User", "endpoint": "/Users", "schema": UserSchema},
+		map[string]any{"schemas": []string{ResourceTypeSchema}, "id": "Group", "name": "Group", "endpoint": "/Groups", "schema": GroupSchema},
+	}
+	writeJSON(w, http.StatusOK, ListResponse{
+		Schemas:      []string{ListResponseSchema},
+		TotalResults: len(types),
+		StartIndex:   1,
+		ItemsPerPage: len(types),
+		Resources:    types,
+	})
+}
+
+// maxResults is the maximum number of resources returned in a single page.
+const maxResults = 200
+
+// list filters and paginates the resources using the filter, startIndex and count query parameters.
+func list[T any](r *http.Request, resources []T) (*ListResponse, error) {
+	q := r.URL.Query()
+	var filtered []any
+	if f := q.Get("filter"); f != "" {
+		filter, err := ParseFilter(f)
+		if err != nil {
+			return nil, NewError(http.StatusBadRequest, "invalidFilter", err.Error())
+		}
+		for _, res := range resources {
+			m, err := toMap(res)
+			if err != nil {
+				return nil, err
+			}
+			if filter.Matches(m) {
+				filtered = append(filtered, res)
+			}
+		}
+	} else {
+		for _, res := range resources {
+			filtered = append(filtered, res)
+		}
+	}
+
+	startIndex := 1
+	if s := q.Get("startIndex"); s != "" {
+		i, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, NewError(http.StatusBadRequest, "invalidValue", "startIndex must be an integer")
+		}
+		if i > 1 {
+			startIndex = i
+		}
+	}
+	count := maxResults
+	if c := q.Get("count"); c != "" {
+		i, err := strconv.Atoi(c)
+		if err != nil {
+			return nil, NewError(http.StatusBadRequest, "invalidValue", "count must be an integer")
+		}
+		if i < 0 {
+			i = 0
+		}
+		if i < count {
+			count = i
+		}
+	}
+
+	page := []any{}
+	if startIndex-1 < len(filtered) {
+		end := startIndex - 1 + count
+		if end > len(filtered) {
+			end = len(filtered)
+		}
+		page = filtered[startIndex-1 : end]
+	}
+
+	return &ListResponse{
+		Schemas:      []string{ListResponseSchema},
+		TotalResults: len(filtered),
+		StartIndex:   startIndex,
+		ItemsPerPage: len(page),
+		Resources:    page,
+	}, nil
+}
+
+// decode reads a JSON request body.
+func decode(r *http.Request, v any) error {
+	err := json.NewDecoder(r.Body).Decode(v)
+	if err != nil {
+		return NewError(http.StatusBadRequest, "invalidSyntax", err.Error())
+	}
+	return nil
+}
+
+// toMap converts a resource to its JSON object representation, which filters and patches are applied to.
+func toMap(v any) (map[string]any, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]any
+	err = json.Unmarshal(b, &m)
+	return m, err
+}
+
+// fromMap converts the JSON object representation of a resource back into the resource.
+func fromMap(m map[string]any, v any) error {
+	b, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	err = json.Unmarshal(b, v)
+	if err != nil {
+		return NewError(http.StatusBadRequest, "invalidValue", err.Error())
+	}
+	return nil
+}
+
+// location returns the URL of a resource.
+func location(r *http.Request, resourceType string, id string) string {
+	scheme := r.Header.Get("X-Forwarded-Proto")
+	if scheme == "" {
+		scheme = "https"
+		if r.TLS == nil {
+			scheme = "http"
+		}
+	}
+	return fmt.Sprintf("%s://%s%s/%s/%s", scheme, r.Host, BasePath, resourceType, id)
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes a SCIM error response, mapping errors from the SCIM service to HTTP status codes.
+func writeError(w http.ResponseWriter, r *http.Request, err error) {
+	var scimErr *Error
+	var memberErr scimsvc.InvalidMemberError
+	switch {
+	case errors.As(err, &scimErr):
+	case errors.Is(err, scimsvc.ErrUserNotFound), errors.Is(err, scimsvc.ErrGroupNotFound):
+		scimErr = NewError(http.StatusNotFound, "", err.Error())
+	case errors.Is(err, scimsvc.ErrUserExists), errors.Is(err, scimsvc.ErrGroupExists):
+		scimErr = NewError(http.StatusConflict, "uniqueness", err.Error())
+	case errors.Is(err, scimsvc.ErrConflict):
+		scimErr = NewError(http.StatusConflict, "", err.Error())
+	case errors.As(err, &memberErr):
+		scimErr = NewError(http.StatusBadRequest, "invalidValue", err.Error())
+	default:
+		logger.Get(r.Context()).Errorw("scim request failed", "error", err)
+		scimErr = NewError(http.StatusInternalServerError, "", "internal server error")
+	}
+	writeJSON(w, scimErr.code, scimErr)
+}
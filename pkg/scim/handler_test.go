@@ -0,0 +1,258 @@
+package scim
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/scim/mocks"
+	"github.com/common-fate/common-fate/pkg/service/scimsvc"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const testToken = "secret"
+
+func serve(t *testing.T, h *Handler, method string, url string, body string, token string) *httptest.ResponseRecorder {
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Host = "commonfate.example.com"
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rr := httptest.NewRecorder()
+	h.Routes().ServeHTTP(rr, req)
+	return rr
+}
+
+var testUser = identity.User{
+	ID:        "usr_1",
+	FirstName: "Alice",
+	LastName:  "Smith",
+	Email:     "alice@example.com",
+	Status:    types.IdpStatusACTIVE,
+	Source:    identity.SCIM,
+	CreatedAt: time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC),
+	UpdatedAt: time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC),
+}
+
+func TestAuthentication(t *testing.T) {
+	type testcase struct {
+		name     string
+		token    string
+		wantCode int
+	}
+
+	testcases := []testcase{
+		{name: "ok", token: testToken, wantCode: http.StatusOK},
+		{name: "wrong token", token: "wrong", wantCode: http.StatusUnauthorized},
+		{name: "no token", wantCode: http.StatusUnauthorized},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := &Handler{Service: mocks.NewMockService(gomock.NewController(t)), Token: testToken}
+			rr := serve(t, h, "GET", "/ServiceProviderConfig", "", tc.token)
+			assert.Equal(t, tc.wantCode, rr.Code)
+			assert.Equal(t, ContentType, rr.Header().Get("Content-Type"))
+		})
+	}
+}
+
+func TestListUsers(t *testing.T) {
+	bob := identity.User{ID: "usr_2", Email: "bob@example.com", Status: types.IdpStatusACTIVE, CreatedAt: testUser.CreatedAt, UpdatedAt: testUser.UpdatedAt}
+
+	type testcase struct {
+		name     string
+		url      string
+		wantCode int
+		wantBody string
+	}
+
+	testcases := []testcase{
+		{
+			name:     "filter",
+			url:      `/Users?filter=userName+eq+%22alice@example.com%22`,
+			wantCode: http.StatusOK,
+			wantBody: `{"schemas":["urn:ietf:params:scim:api:messages:2.0:ListResponse"],"totalResults":1,"startIndex":1,"itemsPerPage":1,"Resources":[{"schemas":["urn:ietf:params:scim:schemas:core:2.0:User"],"id":"usr_1","userName":"alice@example.com","name":{"formatted":"Alice Smith","givenName":"Alice","familyName":"Smith"},"displayName":"Alice Smith","emails":[{"value":"alice@example.com","type":"work","primary":true}],"active":true,"meta":{"resourceType":"User","created":"2023-01-01T12:00:00Z","lastModified":"2023-01-01T12:00:00Z","location":"http://commonfate.example.com/scim/v2/Users/usr_1"}}]}`,
+		},
+		{
+			name:     "pagination",
+			url:      `/Users?startIndex=2&count=1&attributes=id`,
+			wantCode: http.StatusOK,
+			wantBody: `{"schemas":["urn:ietf:params:scim:api:messages:2.0:ListResponse"],"totalResults":2,"startIndex":2,"itemsPerPage":1,"Resources":[{"schemas":["urn:ietf:params:scim:schemas:core:2.0:User"],"id":"usr_2","userName":"bob@example.com","name":{},"emails":[{"value":"bob@example.com","type":"work","primary":true}],"active":true,"meta":{"resourceType":"User","created":"2023-01-01T12:00:00Z","lastModified":"2023-01-01T12:00:00Z","location":"http://commonfate.example.com/scim/v2/Users/usr_2"}}]}`,
+		},
+		{
+			name:     "invalid filter",
+			url:      `/Users?filter=userName+xx+%22a%22`,
+			wantCode: http.StatusBadRequest,
+			wantBody: `{"schemas":["urn:ietf:params:scim:api:messages:2.0:Error"],"status":"400","scimType":"invalidFilter","detail":"unsupported operator \"xx\""}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := mocks.NewMockService(gomock.NewController(t))
+			m.EXPECT().ListUsers(gomock.Any()).Return([]identity.User{testUser, bob}, nil)
+			h := &Handler{Service: m, Token: testToken}
+
+			rr := serve(t, h, "GET", tc.url, "", testToken)
+			assert.Equal(t, tc.wantCode, rr.Code)
+			assert.Equal(t, tc.wantBody, strings.TrimSpace(rr.Body.String()))
+		})
+	}
+}
+
+func TestCreateUser(t *testing.T) {
+	type testcase struct {
+		name      string
+		body      string
+		wantInput *scimsvc.UserInput
+		createErr error
+		wantCode  int
+	}
+
+	testcases := []testcase{
+		{
+			name:      "ok",
+			body:      `{"schemas":["urn:ietf:params:scim:schemas:core:2.0:User"],"userName":"alice@example.com","externalId":"00u1","name":{"givenName":"Alice","familyName":"Smith"},"active":true}`,
+			wantInput: &scimsvc.UserInput{Email: "alice@example.com", FirstName: "Alice", LastName: "Smith", ExternalID: "00u1", Active: true},
+			wantCode:  http.StatusCreated,
+		},
+		{
+			name:      "primary email is preferred over userName",
+			body:      `{"userName":"alice","emails":[{"value":"alice@example.com","primary":"True"}]}`,
+			wantInput: &scimsvc.UserInput{Email: "alice@example.com", Active: true},
+			wantCode:  http.StatusCreated,
+		},
+		{
+			name:      "user exists",
+			body:      `{"userName":"alice@example.com"}`,
+			wantInput: &scimsvc.UserInput{Email: "alice@example.com", Active: true},
+			createErr: scimsvc.ErrUserExists,
+			wantCode:  http.StatusConflict,
+		},
+		{
+			name:      "changed concurrently",
+			body:      `{"userName":"alice@example.com"}`,
+			wantInput: &scimsvc.UserInput{Email: "alice@example.com", Active: true},
+			createErr: scimsvc.ErrConflict,
+			wantCode:  http.StatusConflict,
+		},
+		{
+			name:     "no userName",
+			body:     `{"name":{"givenName":"Alice"}}`,
+			wantCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := mocks.NewMockService(gomock.NewController(t))
+			if tc.wantInput != nil {
+				u := testUser
+				m.EXPECT().CreateUser(gomock.Any(), *tc.wantInput).Return(&u, tc.createErr)
+			}
+			h := &Handler{Service: m, Token: testToken}
+
+			rr := serve(t, h, "POST", "/Users", tc.body, testToken)
+			assert.Equal(t, tc.wantCode, rr.Code)
+			if tc.wantCode == http.StatusCreated {
+				assert.Equal(t, "http://commonfate.example.com/scim/v2/Users/usr_1", rr.Header().Get("Location"))
+			}
+		})
+	}
+}
+
+func TestPatchUser(t *testing.T) {
+	m := mocks.NewMockService(gomock.NewController(t))
+	u := testUser
+	m.EXPECT().GetUser(gomock.Any(), "usr_1").Return(&u, nil)
+	archived := testUser
+	archived.Status = types.IdpStatusARCHIVED
+	m.EXPECT().UpdateUser(gomock.Any(), "usr_1", scimsvc.UserInput{Email: "alice@example.com", FirstName: "Alice", LastName: "Jones", Active: false}).Return(&archived, nil)
+	h := &Handler{Service: m, Token: testToken}
+
+	body := `{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"Replace","path":"active","value":"False"},{"op":"replace","path":"name.familyName","value":"Jones"}]}`
+	rr := serve(t, h, "PATCH", "/Users/usr_1", body, testToken)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"active":false`)
+}
+
+func TestPatchGroupMembers(t *testing.T) {
+	m := mocks.NewMockService(gomock.NewController(t))
+	g := identity.Group{ID: "grp_1", IdpID: "grp_1", Name: "admins", Source: identity.SCIM, Status: types.IdpStatusACTIVE, Users: []string{"usr_1", "usr_2"}}
+	m.EXPECT().GetGroup(gomock.Any(), "grp_1").Return(&g, nil)
+	updated := g
+	updated.Users = []string{"usr_2", "usr_3"}
+	m.EXPECT().UpdateGroup(gomock.Any(), "grp_1", scimsvc.GroupInput{Name: "admins", Members: []string{"usr_2", "usr_3"}}).Return(&updated, nil)
+	h := &Handler{Service: m, Token: testToken}
+
+	body := `{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"remove","path":"members[value eq \"usr_1\"]"},{"op":"add","path":"members","value":[{"value":"usr_3"}]}]}`
+	rr := serve(t, h, "PATCH", "/Groups/grp_1", body, testToken)
+	assert.Equal(t, http.StatusNoContent, rr.Code)
+}
+
+func TestGetGroup(t *testing.T) {
+	type testcase struct {
+		name     string
+		url      string
+		getErr   error
+		wantCode int
+		wantBody string
+	}
+
+	testcases := []testcase{
+		{
+			name:     "ok",
+			url:      "/Groups/grp_1",
+			wantCode: http.StatusOK,
+			wantBody: `{"schemas":["urn:ietf:params:scim:schemas:core:2.0:Group"],"id":"grp_1","displayName":"admins","members":[{"value":"usr_1"}],"meta":{"resourceType":"Group","created":"0001-01-01T00:00:00Z","lastModified":"0001-01-01T00:00:00Z","location":"http://commonfate.example.com/scim/v2/Groups/grp_1"}}`,
+		},
+		{
+			name:     "excluded members",
+			url:      "/Groups/grp_1?excludedAttributes=members",
+			wantCode: http.StatusOK,
+			wantBody: `{"schemas":["urn:ietf:params:scim:schemas:core:2.0:Group"],"id":"grp_1","displayName":"admins","meta":{"resourceType":"Group","created":"0001-01-01T00:00:00Z","lastModified":"0001-01-01T00:00:00Z","location":"http://commonfate.example.com/scim/v2/Groups/grp_1"}}`,
+		},
+		{
+			name:     "not found",
+			url:      "/Groups/grp_1",
+			getErr:   scimsvc.ErrGroupNotFound,
+			wantCode: http.StatusNotFound,
+			wantBody: `{"schemas":["urn:ietf:params:scim:api:messages:2.0:Error"],"status":"404","detail":"group not found"}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := mocks.NewMockService(gomock.NewController(t))
+			g := identity.Group{ID: "grp_1", IdpID: "grp_1", Name: "admins", Source: identity.SCIM, Status: types.IdpStatusACTIVE, Users: []string{"usr_1"}}
+			if tc.getErr != nil {
+				m.EXPECT().GetGroup(gomock.Any(), "grp_1").Return(nil, tc.getErr)
+			} else {
+				m.EXPECT().GetGroup(gomock.Any(), "grp_1").Return(&g, nil)
+			}
+			h := &Handler{Service: m, Token: testToken}
+
+			rr := serve(t, h, "GET", tc.url, "", testToken)
+			assert.Equal(t, tc.wantCode, rr.Code)
+			assert.Equal(t, tc.wantBody, strings.TrimSpace(rr.Body.String()))
+		})
+	}
+}
+
+func TestDeleteUser(t *testing.T) {
+	m := mocks.NewMockService(gomock.NewController(t))
+	m.EXPECT().DeleteUser(gomock.Any(), "usr_1").Return(nil)
+	h := &Handler{Service: m, Token: testToken}
+
+	rr := serve(t, h, "DELETE", "/Users/usr_1", "", testToken)
+	assert.Equal(t, http.StatusNoContent, rr.Code)
+}
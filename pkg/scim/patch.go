@@ -0,0 +1,262 @@
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+// patchPath is a parsed PATCH path, such as members[value eq "123"] or name.givenName.
+type patchPath struct {
+	attr   string
+	filter Filter
+	// filterAttr and filterValue are set for filters like type eq "work",
+	// so that replacing a value which doesn't exist yet can create it.
+	filterAttr  string
+	filterValue any
+	sub         string
+}
+
+func parsePatchPath(path string) (patchPath, error) {
+	path = stripSchema(strings.TrimSpace(path))
+	var p patchPath
+
+	if open := strings.Index(path, "["); open != -1 {
+		close := strings.LastIndex(path, "]")
+		if close < open {
+			return p, fmt.Errorf("invalid path %q", path)
+		}
+		f, err := ParseFilter(path[open+1 : close])
+		if err != nil {
+			return p, err
+		}
+		p.attr = path[:open]
+		p.filter = f
+		if c, ok := f.(compareFilter); ok && c.op == "eq" {
+			p.filterAttr = c.attr
+			p.filterValue = c.value
+		}
+		rest := path[close+1:]
+		if rest != "" {
+			if !strings.HasPrefix(rest, ".") {
+				return p, fmt.Errorf("invalid path %q", path)
+			}
+			p.sub = rest[1:]
+		}
+	} else if attr, sub, ok := strings.Cut(path, "."); ok {
+		p.attr = attr
+		p.sub = sub
+	} else {
+		p.attr = path
+	}
+
+	if !isIdentifier(p.attr) || (p.sub != "" && !isIdentifier(p.sub)) {
+		return p, fmt.Errorf("invalid path %q", path)
+	}
+	return p, nil
+}
+
+// ApplyPatch applies the PATCH operations to a resource in its JSON object representation.
+// Operation names are case-insensitive, as some SCIM clients send "Add" and "Replace".
+func ApplyPatch(resource map[string]any, ops []PatchOperation) error {
+	for _, op := range ops {
+		name := strings.ToLower(op.Op)
+		if name != "add" && name != "replace" && name != "remove" {
+			return NewError(http.StatusBadRequest, "invalidSyntax", fmt.Sprintf("unsupported operation %q", op.Op))
+		}
+
+		var value any
+		if len(op.Value) > 0 {
+			err := json.Unmarshal(op.Value, &value)
+			if err != nil {
+				return NewError(http.StatusBadRequest, "invalidValue", err.Error())
+			}
+		}
+
+		if op.Path == "" {
+			if name == "remove" {
+				return NewError(http.StatusBadRequest, "noTarget", "a path is required for remove operations")
+			}
+			m, ok := value.(map[string]any)
+			if !ok {
+				return NewError(http.StatusBadRequest, "invalidValue", "the value must be an object when no path is provided")
+			}
+			for k, v := range m {
+				p, err := parsePatchPath(k)
+				if err != nil {
+					return NewError(http.StatusBadRequest, "invalidPath", err.Error())
+				}
+				applyPatchPath(resource, name, p, v)
+			}
+			continue
+		}
+
+		p, err := parsePatchPath(op.Path)
+		if err != nil {
+			return NewError(http.StatusBadRequest, "invalidPath", err.Error())
+		}
+		applyPatchPath(resource, name, p, value)
+	}
+	return nil
+}
+
+func applyPatchPath(r map[string]any, op string, p patchPath, value any) {
+	key, ok := findKey(r, p.attr)
+	if !ok {
+		key = p.attr
+	}
+
+	if p.filter != nil {
+		applyFiltered(r, key, op, p, value)
+		return
+	}
+
+	if p.sub != "" {
+		// a sub-attribute of a complex attribute, such as name.givenName
+		switch existing := r[key].(type) {
+		case []any:
+			for _, el := range existing {
+				if m, ok := el.(map[string]any); ok {
+					setAttr(m, op, p.sub, value)
+				}
+			}
+		case map[string]any:
+			setAttr(existing, op, p.sub, value)
+		default:
+			if op != "remove" {
+				r[key] = map[string]any{p.sub: value}
+			}
+		}
+		return
+	}
+
+	switch op {
+	case "add":
+		if existing, ok := r[key].([]any); ok {
+			r[key] = appendUnique(existing, asSlice(value)...)
+			return
+		}
+		if existing, ok := r[key].(map[string]any); ok {
+			if m, ok := value.(map[string]any); ok {
+				merge(existing, m)
+				return
+			}
+		}
+		r[key] = value
+	case "replace":
+		if existing, ok := r[key].(map[string]any); ok {
+			if m, ok := value.(map[string]any); ok {
+				merge(existing, m)
+				return
+			}
+		}
+		if _, ok := r[key].([]any); ok {
+			value = asSlice(value)
+		}
+		r[key] = value
+	case "remove":
+		// some SCIM clients remove members by passing them as the value rather than filtering the path
+		if existing, ok := r[key].([]any); ok && value != nil {
+			var res []any
+			for _, el := range existing {
+				if !containsValue(asSlice(value), el) {
+					res = append(res, el)
+				}
+			}
+			r[key] = res
+			return
+		}
+		delete(r, key)
+	}
+}
+
+// applyFiltered applies an operation to the elements of a multi-valued attribute which match the path filter.
+func applyFiltered(r map[string]any, key string, op string, p patchPath, value any) {
+	existing, _ := r[key].([]any)
+	var res []any
+	matched := false
+	for _, el := range existing {
+		m, ok := el.(map[string]any)
+		if !ok || !p.filter.Matches(m) {
+			res = append(res, el)
+			continue
+		}
+		matched = true
+		if op == "remove" && p.sub == "" {
+			continue
+		}
+		if p.sub != "" {
+			setAttr(m, op, p.sub, value)
+		} else if v, ok := value.(map[string]any); ok {
+			merge(m, v)
+		}
+		res = append(res, m)
+	}
+
+	if !matched && op != "remove" && p.filterAttr != "" {
+		el := map[string]any{p.filterAttr: p.filterValue}
+		if p.sub != "" {
+			el[p.sub] = value
+		} else if v, ok := value.(map[string]any); ok {
+			merge(el, v)
+		}
+		res = append(res, el)
+	}
+	r[key] = res
+}
+
+func setAttr(m map[string]any, op string, attr string, value any) {
+	key, ok := findKey(m, attr)
+	if !ok {
+		key = attr
+	}
+	if op == "remove" {
+		delete(m, key)
+		return
+	}
+	m[key] = value
+}
+
+func merge(dst, src map[string]any) {
+	for k, v := range src {
+		key, ok := findKey(dst, k)
+		if !ok {
+			key = k
+		}
+		dst[key] = v
+	}
+}
+
+func asSlice(v any) []any {
+	if s, ok := v.([]any); ok {
+		return s
+	}
+	return []any{v}
+}
+
+func appendUnique(s []any, values ...any) []any {
+	for _, v := range values {
+		if !containsValue(s, v) {
+			s = append(s, v)
+		}
+	}
+	return s
+}
+
+// containsValue returns true if the slice contains the element.
+// Complex elements are compared by their value sub-attribute if they have one.
+func containsValue(s []any, el any) bool {
+	for _, v := range s {
+		if reflect.DeepEqual(v, el) {
+			return true
+		}
+		a, aok := v.(map[string]any)
+		b, bok := el.(map[string]any)
+		if aok && bok && a["value"] != nil && a["value"] == b["value"] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,138 @@
+package scim
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyPatch(t *testing.T) {
+	type testcase struct {
+		name     string
+		resource string
+		ops      string
+		want     string
+		wantErr  bool
+	}
+
+	testcases := []testcase{
+		{
+			name:     "replace attribute",
+			resource: `{"userName":"alice@example.com","active":true}`,
+			ops:      `[{"op":"replace","path":"active","value":false}]`,
+			want:     `{"userName":"alice@example.com","active":false}`,
+		},
+		{
+			name:     "operation names are case insensitive",
+			resource: `{"active":true}`,
+			ops:      `[{"op":"Replace","path":"active","value":"False"}]`,
+			want:     `{"active":"False"}`,
+		},
+		{
+			name:     "replace without a path",
+			resource: `{"userName":"alice@example.com","name":{"givenName":"Alice","familyName":"Smith"}}`,
+			ops:      `[{"op":"replace","value":{"name.familyName":"Jones","userName":"alice@corp.example"}}]`,
+			want:     `{"userName":"alice@corp.example","name":{"givenName":"Alice","familyName":"Jones"}}`,
+		},
+		{
+			name:     "replace complex attribute merges sub-attributes",
+			resource: `{"name":{"givenName":"Alice","familyName":"Smith"}}`,
+			ops:      `[{"op":"replace","path":"name","value":{"familyName":"Jones"}}]`,
+			want:     `{"name":{"givenName":"Alice","familyName":"Jones"}}`,
+		},
+		{
+			name:     "add members",
+			resource: `{"members":[{"value":"usr_1"}]}`,
+			ops:      `[{"op":"add","path":"members","value":[{"value":"usr_1"},{"value":"usr_2"}]}]`,
+			want:     `{"members":[{"value":"usr_1"},{"value":"usr_2"}]}`,
+		},
+		{
+			name:     "remove member with filter",
+			resource: `{"members":[{"value":"usr_1"},{"value":"usr_2"}]}`,
+			ops:      `[{"op":"remove","path":"members[value eq \"usr_1\"]"}]`,
+			want:     `{"members":[{"value":"usr_2"}]}`,
+		},
+		{
+			name:     "remove member with value",
+			resource: `{"members":[{"value":"usr_1"},{"value":"usr_2"}]}`,
+			ops:      `[{"op":"remove","path":"members","value":[{"value":"usr_2"}]}]`,
+			want:     `{"members":[{"value":"usr_1"}]}`,
+		},
+		{
+			name:     "replace members",
+			resource: `{"members":[{"value":"usr_1"}]}`,
+			ops:      `[{"op":"replace","path":"members","value":[{"value":"usr_3"}]}]`,
+			want:     `{"members":[{"value":"usr_3"}]}`,
+		},
+		{
+			name:     "remove attribute",
+			resource: `{"externalId":"00u1","userName":"alice@example.com"}`,
+			ops:      `[{"op":"remove","path":"externalId"}]`,
+			want:     `{"userName":"alice@example.com"}`,
+		},
+		{
+			name:     "replace sub-attribute of filtered value",
+			resource: `{"emails":[{"type":"work","value":"alice@example.com"}]}`,
+			ops:      `[{"op":"replace","path":"emails[type eq \"work\"].value","value":"alice@corp.example"}]`,
+			want:     `{"emails":[{"type":"work","value":"alice@corp.example"}]}`,
+		},
+		{
+			name:     "replace filtered value which doesn't exist yet",
+			resource: `{}`,
+			ops:      `[{"op":"replace","path":"emails[type eq \"work\"].value","value":"alice@corp.example"}]`,
+			want:     `{"emails":[{"type":"work","value":"alice@corp.example"}]}`,
+		},
+		{
+			name:     "schema prefixed path",
+			resource: `{"userName":"alice@example.com"}`,
+			ops:      `[{"op":"replace","path":"urn:ietf:params:scim:schemas:core:2.0:User:userName","value":"bob@example.com"}]`,
+			want:     `{"userName":"bob@example.com"}`,
+		},
+		{
+			name:     "remove without a path",
+			resource: `{}`,
+			ops:      `[{"op":"remove"}]`,
+			wantErr:  true,
+		},
+		{
+			name:     "unsupported operation",
+			resource: `{}`,
+			ops:      `[{"op":"move","path":"userName"}]`,
+			wantErr:  true,
+		},
+		{
+			name:     "invalid path",
+			resource: `{}`,
+			ops:      `[{"op":"add","path":"members[value eq","value":"a"}]`,
+			wantErr:  true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			var resource map[string]any
+			err := json.Unmarshal([]byte(tc.resource), &resource)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var ops []PatchOperation
+			err = json.Unmarshal([]byte(tc.ops), &ops)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = ApplyPatch(resource, ops)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			got, err := json.Marshal(resource)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.JSONEq(t, tc.want, string(got))
+		})
+	}
+}
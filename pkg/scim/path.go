@@ -0,0 +1,84 @@
+package scim
+
+import (
+	"strings"
+)
+
+// AttributePath is a parsed attribute path, such as name.givenName, emails[primary eq true].value
+// or urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:employeeNumber.
+type AttributePath struct {
+	// extension is the schema URN of an extension attribute, which is nested under the URN in resources.
+	extension string
+	path      patchPath
+}
+
+// ParseAttributePath parses an attribute path which can be used to read values from resources.
+func ParseAttributePath(path string) (AttributePath, error) {
+	path = stripSchema(strings.TrimSpace(path))
+	var a AttributePath
+	if strings.HasPrefix(strings.ToLower(path), "urn:") {
+		// the attribute name follows the last colon of the URN, before any value filter
+		end := len(path)
+		if open := strings.Index(path, "["); open != -1 {
+			end = open
+		}
+		if i := strings.LastIndex(path[:end], ":"); i != -1 {
+			a.extension = path[:i]
+			path = path[i+1:]
+		}
+	}
+	p, err := parsePatchPath(path)
+	if err != nil {
+		return a, err
+	}
+	a.path = p
+	return a, nil
+}
+
+// Values returns the values of the attribute in a resource in its JSON object representation.
+// Multi-valued attributes are flattened, and missing attributes return no values.
+func (a AttributePath) Values(resource map[string]any) []any {
+	if a.extension != "" {
+		key, ok := findKey(resource, a.extension)
+		if !ok {
+			return nil
+		}
+		ext, ok := resource[key].(map[string]any)
+		if !ok {
+			return nil
+		}
+		resource = ext
+	}
+
+	if a.path.filter == nil {
+		path := a.path.attr
+		if a.path.sub != "" {
+			path += "." + a.path.sub
+		}
+		return lookup(resource, path)
+	}
+
+	var values []any
+	for _, v := range lookup(resource, a.path.attr) {
+		m, ok := v.(map[string]any)
+		if !ok || !a.path.filter.Matches(m) {
+			continue
+		}
+		if a.path.sub == "" {
+			values = append(values, m)
+		} else {
+			values = append(values, lookup(m, a.path.sub)...)
+		}
+	}
+	return values
+}
+
+// String returns the first non-empty string value of the attribute in a resource.
+func (a AttributePath) String(resource map[string]any) string {
+	for _, v := range a.Values(resource) {
+		if s, ok := v.(string); ok && s != "" {
+			return s
+		}
+	}
+	return ""
+}
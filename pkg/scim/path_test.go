@@ -0,0 +1,95 @@
+package scim
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAttributePath(t *testing.T) {
+	resource := `{
+		"userName": "alice",
+		"name": {"givenName": "Alice", "familyName": "Smith"},
+		"emails": [
+			{"value": "alice@home.example", "type": "home"},
+			{"value": "alice@example.com", "type": "work", "primary": true}
+		],
+		"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User": {
+			"employeeNumber": "1234",
+			"manager": {"value": "bob"}
+		}
+	}`
+	var r map[string]any
+	err := json.Unmarshal([]byte(resource), &r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type testcase struct {
+		name    string
+		path    string
+		want    string
+		wantErr bool
+	}
+
+	testcases := []testcase{
+		{
+			name: "attribute",
+			path: "userName",
+			want: "alice",
+		},
+		{
+			name: "attribute names are case insensitive",
+			path: "USERNAME",
+			want: "alice",
+		},
+		{
+			name: "core schema prefix",
+			path: "urn:ietf:params:scim:schemas:core:2.0:User:name.familyName",
+			want: "Smith",
+		},
+		{
+			name: "multi-valued attribute returns the first value",
+			path: "emails.value",
+			want: "alice@home.example",
+		},
+		{
+			name: "value filter",
+			path: "emails[primary eq true].value",
+			want: "alice@example.com",
+		},
+		{
+			name: "extension attribute",
+			path: "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:employeeNumber",
+			want: "1234",
+		},
+		{
+			name: "extension sub-attribute",
+			path: "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:manager.value",
+			want: "bob",
+		},
+		{
+			name: "missing attribute",
+			path: "nickName",
+			want: "",
+		},
+		{
+			name:    "invalid path",
+			path:    "emails[primary eq",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := ParseAttributePath(tc.path)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, p.String(r))
+		})
+	}
+}
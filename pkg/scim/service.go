@@ -0,0 +1,24 @@
+package scim
+
+import (
+	"context"
+
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/service/scimsvc"
+)
+
+//go:generate go run github.com/golang/mock/mockgen -destination=mocks/mock_service.go -package=mocks . Service
+
+// Service provisions users and groups. It's implemented by scimsvc.Service.
+type Service interface {
+	ListUsers(ctx context.Context) ([]identity.User, error)
+	GetUser(ctx context.Context, id string) (*identity.User, error)
+	CreateUser(ctx context.Context, in scimsvc.UserInput) (*identity.User, error)
+	UpdateUser(ctx context.Context, id string, in scimsvc.UserInput) (*identity.User, error)
+	DeleteUser(ctx context.Context, id string) error
+	ListGroups(ctx context.Context) ([]identity.Group, error)
+	GetGroup(ctx context.Context, id string) (*identity.Group, error)
+	CreateGroup(ctx context.Context, in scimsvc.GroupInput) (*identity.Group, error)
+	UpdateGroup(ctx context.Context, id string, in scimsvc.GroupInput) (*identity.Group, error)
+	DeleteGroup(ctx context.Context, id string) error
+}
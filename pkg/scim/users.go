@@ -0,0 +1,168 @@
+package scim
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/service/scimsvc"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/go-chi/chi/v5"
+)
+
+func userToSCIM(r *http.Request, u identity.User) User {
+	active := Bool(u.Status == types.IdpStatusACTIVE)
+	displayName := strings.TrimSpace(u.FirstName + " " + u.LastName)
+	res := User{
+		Schemas:     []string{UserSchema},
+		ID:          u.ID,
+		ExternalID:  u.ExternalID,
+		UserName:    u.Email,
+		DisplayName: displayName,
+		Name: &Name{
+			Formatted:  displayName,
+			GivenName:  u.FirstName,
+			FamilyName: u.LastName,
+		},
+		Emails: []MultiValue{{Value: u.Email, Type: "work", Primary: true}},
+		Active: &active,
+		Meta: &Meta{
+			ResourceType: "User",
+			Created:      &u.CreatedAt,
+			LastModified: &u.UpdatedAt,
+			Location:     location(r, "Users", u.ID),
+		},
+	}
+	return res
+}
+
+func userInput(u User) (scimsvc.UserInput, error) {
+	in := scimsvc.UserInput{
+		Email:      u.Email(),
+		ExternalID: u.ExternalID,
+		Active:     u.Active == nil || bool(*u.Active),
+	}
+	if u.Name != nil {
+		in.FirstName = u.Name.GivenName
+		in.LastName = u.Name.FamilyName
+	}
+	if in.Email == "" {
+		return in, NewError(http.StatusBadRequest, "invalidValue", "userName is required")
+	}
+	return in, nil
+}
+
+func (h *Handler) listUsers(w http.ResponseWriter, r *http.Request) {
+	users, err := h.Service.ListUsers(r.Context())
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	res := []User{}
+	for _, u := range users {
+		res = append(res, userToSCIM(r, u))
+	}
+	resp, err := list(r, res)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (h *Handler) getUser(w http.ResponseWriter, r *http.Request) {
+	u, err := h.Service.GetUser(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, userToSCIM(r, *u))
+}
+
+func (h *Handler) createUser(w http.ResponseWriter, r *http.Request) {
+	var body User
+	err := decode(r, &body)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	in, err := userInput(body)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	u, err := h.Service.CreateUser(r.Context(), in)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	res := userToSCIM(r, *u)
+	w.Header().Set("Location", res.Meta.Location)
+	writeJSON(w, http.StatusCreated, res)
+}
+
+func (h *Handler) replaceUser(w http.ResponseWriter, r *http.Request) {
+	var body User
+	err := decode(r, &body)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	h.updateUser(w, r, body)
+}
+
+func (h *Handler) patchUser(w http.ResponseWriter, r *http.Request) {
+	var body PatchRequest
+	err := decode(r, &body)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	u, err := h.Service.GetUser(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	m, err := toMap(userToSCIM(r, *u))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	// the emails attribute is derived from userName, so drop it to let a patch to userName take effect
+	delete(m, "emails")
+	err = ApplyPatch(m, body.Operations)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	var patched User
+	err = fromMap(m, &patched)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	h.updateUser(w, r, patched)
+}
+
+func (h *Handler) updateUser(w http.ResponseWriter, r *http.Request, body User) {
+	in, err := userInput(body)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	u, err := h.Service.UpdateUser(r.Context(), chi.URLParam(r, "id"), in)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, userToSCIM(r, *u))
+}
+
+func (h *Handler) deleteUser(w http.ResponseWriter, r *http.Request) {
+	err := h.Service.DeleteUser(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/common-fate/apikit/logger"
 	"github.com/common-fate/apikit/openapi"
 	"github.com/common-fate/common-fate/pkg/auth"
+	"github.com/common-fate/common-fate/pkg/scim"
 
 	"github.com/go-chi/chi/v5"
 	chiMiddleware "github.com/go-chi/chi/v5/middleware"
@@ -20,16 +21,26 @@ func (c *Server) Handler() http.Handler {
 	r.Use(logger.Middleware(c.log.Desugar()))
 	r.Use(analyticsMiddleware(c.db, c.log))
 	r.Use(sentryMiddleware)
-	r.Use(cors.Handler(cors.Options{
-		AllowedOrigins:   []string{c.cfg.FrontendURL},
-		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type"},
-		AllowCredentials: true,
-		MaxAge:           300,
-	}))
-	r.Use(auth.Middleware(c.authenticator, c.db, c.identitySyncer))
-	r.Use(auth.AdminAuthorizer(c.cfg.AdminGroup))
-	r.Use(openapi.Validator(c.swagger))
-
-	return c.api.Handler(r)
+
+	// SCIM clients authenticate with a bearer token rather than as a user
+	if c.scim != nil {
+		r.Mount(scim.BasePath, c.scim)
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Use(cors.Handler(cors.Options{
+			AllowedOrigins:   []string{c.cfg.FrontendURL},
+			AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
+			AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type"},
+			AllowCredentials: true,
+			MaxAge:           300,
+		}))
+		r.Use(auth.Middleware(c.authenticator, c.db, c.identitySyncer))
+		r.Use(auth.AdminAuthorizer(c.cfg.AdminGroup))
+		r.Use(openapi.Validator(c.swagger))
+
+		c.api.Handler(r)
+	})
+
+	return r
 }
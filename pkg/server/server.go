@@ -8,6 +8,7 @@ import (
 	"github.com/common-fate/apikit/logger"
 	"github.com/common-fate/common-fate/pkg/auth"
 	"github.com/common-fate/common-fate/pkg/config"
+	"github.com/common-fate/common-fate/pkg/storage"
 	"github.com/common-fate/common-fate/pkg/types"
 	"github.com/common-fate/ddb"
 	"github.com/getkin/kin-openapi/openapi3"
@@ -25,6 +26,7 @@ type Server struct {
 	identitySyncer      auth.IdentitySyncer
 	requestIDMiddleware func(next http.Handler) http.Handler
 	db                  ddb.Storage
+	scim                http.Handler
 }
 
 type Config struct {
@@ -36,6 +38,9 @@ type Config struct {
 	// which doesn't yet exist in our database.
 	IdentitySyncer auth.IdentitySyncer
 	API            API
+	// SCIM is optional. If it is provided, it is served under /scim/v2
+	// and authenticates requests itself rather than using the Authenticator.
+	SCIM http.Handler
 }
 
 // APIs can provider HTTP Handlers
@@ -64,10 +69,12 @@ func New(ctx context.Context, cfg Config, opts ...func(*Server)) (*Server, error
 	if err != nil {
 		return nil, err
 	}
-	db, err := ddb.New(ctx, cfg.Config.DynamoTable, ddb.WithPageTokenizer(tokenizer))
+	ddbClient, err := ddb.New(ctx, cfg.Config.DynamoTable, ddb.WithPageTokenizer(tokenizer))
 	if err != nil {
 		return nil, err
 	}
+	// status changes to requests, groups and targets are written with conditional writes
+	db := storage.NewStatusGuard(ddbClient)
 	swagger, err := types.GetSwagger()
 	if err != nil {
 		return nil, err
@@ -84,6 +91,7 @@ func New(ctx context.Context, cfg Config, opts ...func(*Server)) (*Server, error
 		requestIDMiddleware: chiMiddleware.RequestID,
 		identitySyncer:      cfg.IdentitySyncer,
 		db:                  db,
+		scim:                cfg.SCIM,
 	}
 
 	for _, o := range opts {
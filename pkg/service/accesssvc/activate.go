@@ -0,0 +1,77 @@
+package accesssvc
+
+import (
+	"context"
+
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+)
+
+// Activate starts access for an approved on demand access group.
+// The grant is started asynchronously by the event handler once the activated event is received.
+func (s *Service) Activate(ctx context.Context, in access.RequestWithGroupsWithTargets, groupID string) (*access.GroupWithTargets, error) {
+	var group *access.GroupWithTargets
+	for i := range in.Groups {
+		if in.Groups[i].Group.ID == groupID {
+			group = &in.Groups[i]
+		}
+	}
+	if group == nil {
+		return nil, ErrAccessGroupNotFound
+	}
+	if group.Group.Status != types.RequestAccessGroupStatusAWAITINGACTIVATION {
+		return nil, ErrAccessGroupNotAwaitingActivation
+	}
+	now := s.Clock.Now()
+	if group.Group.ActivationDeadline != nil && now.After(*group.Group.ActivationDeadline) {
+		return nil, ErrActivationWindowExpired
+	}
+
+	err := group.Group.TransitionStatus(types.RequestAccessGroupStatusAPPROVED)
+	if err != nil {
+		return nil, err
+	}
+	group.Group.ActivatedAt = &now
+	group.Group.UpdatedAt = now
+	err = s.DB.Put(ctx, &group.Group)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.EventPutter.Put(ctx, gevent.AccessGroupActivated{AccessGroup: *group})
+	if err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+// ExpireActivations expires the approval of on demand access groups which weren't activated before their activation deadline.
+// It is run periodically, the approvals are expired asynchronously by the event handler.
+func (s *Service) ExpireActivations(ctx context.Context) error {
+	log := logger.Get(ctx)
+	q := storage.ListRequestGroupsAwaitingActivation{DeadlineBefore: s.Clock.Now()}
+	err := s.DB.All(ctx, &q)
+	if err != nil {
+		return err
+	}
+	for _, g := range q.Result {
+		gq := storage.GetRequestGroupWithTargets{RequestID: g.RequestID, GroupID: g.ID}
+		_, err = s.DB.Query(ctx, &gq)
+		if err == ddb.ErrNoItems {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		log.Infow("expiring approval for access group which wasn't activated", "requestId", g.RequestID, "groupId", g.ID)
+		err = s.EventPutter.Put(ctx, gevent.AccessGroupActivationExpired{AccessGroup: *gq.Result})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
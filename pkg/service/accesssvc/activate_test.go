@@ -0,0 +1,128 @@
+package accesssvc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/common-fate/pkg/access"
+	eventmock "github.com/common-fate/common-fate/pkg/eventhandler/mocks"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActivate(t *testing.T) {
+	type testcase struct {
+		name      string
+		status    types.RequestAccessGroupStatus
+		deadline  time.Duration
+		groupID   string
+		wantEvent bool
+		wantErr   error
+	}
+
+	testcases := []testcase{
+		{
+			name:      "ok",
+			status:    types.RequestAccessGroupStatusAWAITINGACTIVATION,
+			deadline:  time.Hour,
+			groupID:   "group1",
+			wantEvent: true,
+		},
+		{
+			name:     "group not in request",
+			status:   types.RequestAccessGroupStatusAWAITINGACTIVATION,
+			deadline: time.Hour,
+			groupID:  "other",
+			wantErr:  ErrAccessGroupNotFound,
+		},
+		{
+			name:     "already activated",
+			status:   types.RequestAccessGroupStatusAPPROVED,
+			deadline: time.Hour,
+			groupID:  "group1",
+			wantErr:  ErrAccessGroupNotAwaitingActivation,
+		},
+		{
+			name:     "approval expired",
+			status:   types.RequestAccessGroupStatusAPPROVALEXPIRED,
+			deadline: -time.Hour,
+			groupID:  "group1",
+			wantErr:  ErrAccessGroupNotAwaitingActivation,
+		},
+		{
+			name:     "deadline passed",
+			status:   types.RequestAccessGroupStatusAWAITINGACTIVATION,
+			deadline: -time.Minute,
+			groupID:  "group1",
+			wantErr:  ErrActivationWindowExpired,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ep := eventmock.NewMockEventPutter(ctrl)
+			if tc.wantEvent {
+				ep.EXPECT().Put(gomock.Any(), gomock.AssignableToTypeOf(gevent.AccessGroupActivated{})).Return(nil).Times(1)
+			}
+			clk := clock.NewMock()
+			deadline := clk.Now().Add(tc.deadline)
+			s := Service{
+				Clock:       clk,
+				DB:          ddbmock.New(t),
+				EventPutter: ep,
+			}
+			in := access.RequestWithGroupsWithTargets{
+				Request: access.Request{ID: "req123", RequestStatus: types.ACTIVE},
+				Groups: []access.GroupWithTargets{
+					{
+						Group: access.Group{
+							ID:                 "group1",
+							RequestID:          "req123",
+							Status:             tc.status,
+							RequestedTiming:    access.Timing{Duration: time.Hour, OnDemand: true},
+							ActivationDeadline: &deadline,
+						},
+					},
+				},
+			}
+			got, err := s.Activate(context.Background(), in, tc.groupID)
+			assert.Equal(t, tc.wantErr, err)
+			if tc.wantErr == nil {
+				assert.Equal(t, types.RequestAccessGroupStatusAPPROVED, got.Group.Status)
+				assert.Equal(t, clk.Now(), *got.Group.ActivatedAt)
+			}
+		})
+	}
+}
+
+func TestExpireActivations(t *testing.T) {
+	clk := clock.NewMock()
+	passed := clk.Now().Add(-time.Minute)
+	expired := access.GroupWithTargets{
+		Group:   access.Group{ID: "expired", RequestID: "req1", Status: types.RequestAccessGroupStatusAWAITINGACTIVATION, ActivationDeadline: &passed},
+		Targets: []access.GroupTarget{{ID: "target1", GroupID: "expired", RequestID: "req1"}},
+	}
+
+	ctrl := gomock.NewController(t)
+	ep := eventmock.NewMockEventPutter(ctrl)
+	ep.EXPECT().Put(gomock.Any(), gevent.AccessGroupActivationExpired{AccessGroup: expired}).Return(nil).Times(1)
+
+	db := ddbmock.New(t)
+	// the index only returns groups awaiting activation whose deadline has passed
+	db.MockQuery(&storage.ListRequestGroupsAwaitingActivation{Result: []access.Group{expired.Group}})
+	db.MockQuery(&storage.GetRequestGroupWithTargets{Result: &expired})
+	s := Service{
+		Clock:       clk,
+		DB:          db,
+		EventPutter: ep,
+	}
+	err := s.ExpireActivations(context.Background())
+	assert.NoError(t, err)
+}
@@ -29,6 +29,14 @@ func (s *Service) CreateRequest(ctx context.Context, user identity.User, createR
 
 	preflight := preflightReq.Result
 
+	if s.Freezes != nil {
+		accessRuleIDs, targetGroupIDs := preflight.FreezeScopes()
+		err = s.Freezes.Check(ctx, user.Groups, accessRuleIDs, targetGroupIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	now := s.Clock.Now()
 
 	//count the number of targets
@@ -73,12 +81,17 @@ func (s *Service) CreateRequest(ctx context.Context, user identity.User, createR
 			return nil, err
 		}
 
+		timing, err := access.TimingFromRequestTiming(createRequest.GroupOptions[i].Timing)
+		if err != nil {
+			return nil, err
+		}
+
 		//create accessgroup object
 		accessGroup := access.Group{
 			ID:                   types.NewAccessGroupID(),
 			RequestID:            request.ID,
 			AccessRuleSnapshot:   *ar.Result,
-			RequestedTiming:      access.TimingFromRequestTiming(createRequest.GroupOptions[i].Timing),
+			RequestedTiming:      timing,
 			RequestedBy:          request.RequestedBy,
 			CreatedAt:            now,
 			UpdatedAt:            now,
@@ -87,7 +100,7 @@ func (s *Service) CreateRequest(ctx context.Context, user identity.User, createR
 			RequestPurposeReason: *createRequest.Reason,
 		}
 
-		approvers, err := s.Rules.GetApprovers(ctx, *ar.Result)
+		approvers, err := s.Rules.GetApprovers(ctx, *ar.Result, request.RequestedBy.ID)
 		if err != nil {
 			return nil, err
 		}
@@ -107,16 +120,17 @@ func (s *Service) CreateRequest(ctx context.Context, user identity.User, createR
 		}
 		for _, preflightAccessGroupTarget := range preflightAccessGroup.Targets {
 			groupTarget := access.GroupTarget{
-				ID:            types.NewGroupTargetID(),
-				GroupID:       accessGroup.ID,
-				RequestID:     request.ID,
-				RequestedBy:   request.RequestedBy,
-				CreatedAt:     now,
-				UpdatedAt:     now,
-				TargetKind:    preflightAccessGroupTarget.Target.Kind,
-				TargetCacheID: preflightAccessGroupTarget.Target.ID(),
-				RequestStatus: request.RequestStatus,
-				TargetGroupID: preflightAccessGroupTarget.TargetGroupID,
+				ID:             types.NewGroupTargetID(),
+				GroupID:        accessGroup.ID,
+				RequestID:      request.ID,
+				RequestedBy:    request.RequestedBy,
+				CreatedAt:      now,
+				UpdatedAt:      now,
+				TargetKind:     preflightAccessGroupTarget.Target.Kind,
+				TargetCacheID:  preflightAccessGroupTarget.Target.ID(),
+				RequestStatus:  request.RequestStatus,
+				TargetGroupID:  preflightAccessGroupTarget.TargetGroupID,
+				TargetMetadata: preflightAccessGroupTarget.Target.Metadata,
 			}
 			for _, f := range preflightAccessGroupTarget.Target.Fields {
 				groupTarget.Fields = append(groupTarget.Fields, access.Field{
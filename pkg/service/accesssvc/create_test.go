@@ -11,6 +11,7 @@ import (
 	"github.com/common-fate/common-fate/pkg/identity"
 	"github.com/common-fate/common-fate/pkg/rule"
 	"github.com/common-fate/common-fate/pkg/service/accesssvc/mocks"
+	"github.com/common-fate/common-fate/pkg/service/freezesvc"
 	"github.com/common-fate/common-fate/pkg/storage"
 	"github.com/common-fate/common-fate/pkg/types"
 	"github.com/common-fate/ddb/ddbmock"
@@ -27,6 +28,7 @@ func TestCreateRequest(t *testing.T) {
 		withMockPreflightErr   error
 		withMockGetAccessRules []rule.AccessRule
 		withMockGetApprovers   [][]string
+		withFreezeErr          error
 		want                   *access.RequestWithGroupsWithTargets
 		wantErr                error
 	}
@@ -46,7 +48,20 @@ func TestCreateRequest(t *testing.T) {
 		LastName:  "wow",
 		Email:     "test@example.com",
 	}
+	frozen := freezesvc.FrozenError{Freezes: []access.Freeze{{ID: "frz_1", Scope: types.FreezeScopeACCESSRULE, ScopeID: "rul_1", Message: "incident"}}}
 	testcases := []testcase{
+		{
+			name: "frozen",
+			user: user,
+			createRequest: types.CreateAccessRequestRequest{
+				Reason: &reason,
+			},
+			withMockPreflight: &access.Preflight{
+				AccessGroups: []access.PreflightAccessGroup{{ID: "group", AccessRule: "rul_1"}},
+			},
+			withFreezeErr: frozen,
+			wantErr:       frozen,
+		},
 		{
 			name: "ok",
 			user: user,
@@ -142,21 +157,25 @@ func TestCreateRequest(t *testing.T) {
 
 			rs := mocks.NewMockAccessRuleService(ctrl)
 			for _, ap := range tc.withMockGetApprovers {
-				rs.EXPECT().GetApprovers(gomock.Any(), gomock.Any()).Return(ap, nil)
+				rs.EXPECT().GetApprovers(gomock.Any(), gomock.Any(), tc.user.ID).Return(ap, nil)
 			}
 
+			fs := mocks.NewMockFreezeService(ctrl)
+			fs.EXPECT().Check(gomock.Any(), tc.user.Groups, gomock.Any(), gomock.Any()).Return(tc.withFreezeErr)
+
 			s := Service{
 				Clock:       clk,
 				DB:          db,
 				EventPutter: ep,
 				Rules:       rs,
+				Freezes:     fs,
 			}
 			got, err := s.CreateRequest(context.Background(), tc.user, tc.createRequest)
 			if tc.wantErr != nil {
 				assert.EqualError(t, err, tc.wantErr.Error())
-			} else {
-				assert.NoError(t, err)
+				return
 			}
+			assert.NoError(t, err)
 
 			// Overwrite all the IDs
 			got.Request.ID = ""
@@ -26,6 +26,22 @@ var (
 	ErrAccesGroupNotFoundOrNoAccessToReview = errors.New("this access group doesn't exist or you don't have access to review it")
 	// ErrAccessGroupAlreadyReviewed is returned if the group is already reviewed
 	ErrAccessGroupAlreadyReviewed = errors.New("this access group has already been reviewed")
+	// ErrTargetNotFound is returned if a target does not exist in the target cache
+	ErrTargetNotFound = errors.New("target not found")
+	// ErrFavoriteNotFound is returned if the target is not one of the user's favorites
+	ErrFavoriteNotFound = errors.New("favorite not found")
+	// ErrRequestTargetNotFound is returned if the target is not part of the access group being revoked
+	ErrRequestTargetNotFound = errors.New("this target doesn't exist in the access group")
+	// ErrTargetCannotBeRevoked is returned if the target's grant is not active or awaiting start
+	ErrTargetCannotBeRevoked = errors.New("only active or pending targets can be revoked")
+	// ErrFailedGrantNotFound is returned if the grant is not in the failed grants list
+	ErrFailedGrantNotFound = errors.New("failed grant not found")
+	// ErrAccessGroupNotFound is returned if the access group is not part of the request
+	ErrAccessGroupNotFound = errors.New("this access group doesn't exist in the request")
+	// ErrAccessGroupNotAwaitingActivation is returned if an access group is activated which isn't waiting to be activated
+	ErrAccessGroupNotAwaitingActivation = errors.New("only approved on demand access groups can be activated")
+	// ErrActivationWindowExpired is returned if an access group is activated after its activation deadline
+	ErrActivationWindowExpired = errors.New("the activation window for this access group has passed")
 )
 
 // InvalidStatusError is returned if a user tries to review a request which wasn't PENDING.
@@ -0,0 +1,87 @@
+package accesssvc
+
+import (
+	"context"
+	"sort"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+)
+
+// ListFailedGrants returns the grants which could not be activated or deactivated.
+// Failed deactivations are returned first, followed by the oldest failures.
+func (s *Service) ListFailedGrants(ctx context.Context) ([]access.FailedGrant, error) {
+	q := storage.ListFailedGrants{}
+	err := s.DB.All(ctx, &q)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(q.Result, func(i, j int) bool {
+		a, b := q.Result[i], q.Result[j]
+		if a.Priority != b.Priority {
+			return a.Priority == types.HIGH
+		}
+		return a.CreatedAt.Before(b.CreatedAt)
+	})
+	return q.Result, nil
+}
+
+// RetryFailedGrant asynchronously retries the failed action for a grant.
+func (s *Service) RetryFailedGrant(ctx context.Context, user identity.User, grantID string) error {
+	failed, err := s.getFailedGrant(ctx, grantID)
+	if err != nil {
+		return err
+	}
+	evt := access.NewRecordedEvent(failed.RequestID, &user.ID, s.Clock.Now(), map[string]string{
+		"action":  "grant.retried",
+		"grantId": grantID,
+		"actor":   user.Email,
+	})
+	err = s.DB.Put(ctx, &evt)
+	if err != nil {
+		return err
+	}
+	return s.EventPutter.Put(ctx, gevent.GrantRetryRequested{GrantID: grantID, Actor: user.ID})
+}
+
+// CompleteFailedGrant removes a grant from the failed grants without calling the provider,
+// for use once an admin has resolved the failure manually.
+// Failed deactivations are marked as expired, failed activations are left in the error state.
+func (s *Service) CompleteFailedGrant(ctx context.Context, user identity.User, grantID string) error {
+	failed, err := s.getFailedGrant(ctx, grantID)
+	if err != nil {
+		return err
+	}
+	evt := access.NewRecordedEvent(failed.RequestID, &user.ID, s.Clock.Now(), map[string]string{
+		"action":  "grant.forceCompleted",
+		"grantId": grantID,
+		"actor":   user.Email,
+	})
+	err = s.DB.Put(ctx, &evt)
+	if err != nil {
+		return err
+	}
+	if failed.Action == types.DEACTIVATE {
+		err = s.EventPutter.Put(ctx, gevent.GrantExpired{Grant: failed.Grant})
+		if err != nil {
+			return err
+		}
+	}
+	return s.DB.Delete(ctx, failed)
+}
+
+func (s *Service) getFailedGrant(ctx context.Context, grantID string) (*access.FailedGrant, error) {
+	q := storage.GetFailedGrant{GrantID: grantID}
+	_, err := s.DB.Query(ctx, &q)
+	if err == ddb.ErrNoItems {
+		return nil, ErrFailedGrantNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return q.Result, nil
+}
@@ -0,0 +1,90 @@
+package accesssvc
+
+import (
+	"context"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/cache"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+)
+
+// FavoriteWithEligibility is a favorite along with whether the user is still able to request the target.
+type FavoriteWithEligibility struct {
+	access.Favorite
+	// Eligible is false if the target is no longer in the cache or the user no longer has access to it
+	Eligible bool
+}
+
+// CreateFavorite saves a target as a favorite for the user.
+// The target must exist and the user must be able to request it.
+func (s *Service) CreateFavorite(ctx context.Context, user identity.User, targetID string) (*access.Favorite, error) {
+	q := storage.GetCachedTarget{ID: targetID}
+	_, err := s.DB.Query(ctx, &q)
+	if err == ddb.ErrNoItems {
+		return nil, ErrTargetNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(cache.Filter([]cache.Target{*q.Result}, user.Groups)) == 0 {
+		return nil, ErrUserNotAuthorized
+	}
+
+	favorite := access.Favorite{
+		UserID:    user.ID,
+		TargetID:  q.Result.ID(),
+		Target:    *q.Result,
+		CreatedAt: s.Clock.Now(),
+	}
+	err = s.DB.Put(ctx, &favorite)
+	if err != nil {
+		return nil, err
+	}
+	return &favorite, nil
+}
+
+// DeleteFavorite removes a target from the user's favorites.
+func (s *Service) DeleteFavorite(ctx context.Context, user identity.User, targetID string) error {
+	q := storage.GetFavorite{UserID: user.ID, TargetID: targetID}
+	_, err := s.DB.Query(ctx, &q)
+	if err == ddb.ErrNoItems {
+		return ErrFavoriteNotFound
+	}
+	if err != nil {
+		return err
+	}
+	return s.DB.Delete(ctx, q.Result)
+}
+
+// ListFavorites returns the user's favorites and checks each of them against the target cache,
+// because the targets a user can access change as access rules and target groups are updated.
+func (s *Service) ListFavorites(ctx context.Context, user identity.User) ([]FavoriteWithEligibility, error) {
+	q := storage.ListFavoritesForUser{UserID: user.ID}
+	err := s.DB.All(ctx, &q)
+	if err != nil && err != ddb.ErrNoItems {
+		return nil, err
+	}
+
+	ids := make([]string, len(q.Result))
+	for i, favorite := range q.Result {
+		ids[i] = favorite.TargetID
+	}
+	targets, err := storage.BatchGetCachedTargets(ctx, s.DB, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	out := []FavoriteWithEligibility{}
+	for _, favorite := range q.Result {
+		f := FavoriteWithEligibility{Favorite: favorite}
+		if target, ok := targets[favorite.TargetID]; ok {
+			// use the latest version of the target so that labels are up to date
+			f.Target = target
+			f.Eligible = len(cache.Filter([]cache.Target{target}, user.Groups)) > 0
+		}
+		out = append(out, f)
+	}
+	return out, nil
+}
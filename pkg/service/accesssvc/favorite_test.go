@@ -0,0 +1,166 @@
+package accesssvc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/cache"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateFavorite(t *testing.T) {
+	type testcase struct {
+		name      string
+		target    *cache.Target
+		targetErr error
+		wantErr   error
+	}
+	target := cache.Target{
+		Fields:              []cache.Field{{ID: "account", Value: "123"}},
+		IDPGroupsWithAccess: cache.MakeMapStringStruct("developers"),
+	}
+	testcases := []testcase{
+		{
+			name:   "ok",
+			target: &target,
+		},
+		{
+			name:      "target not found",
+			targetErr: ddb.ErrNoItems,
+			wantErr:   ErrTargetNotFound,
+		},
+		{
+			name: "user not in a group with access",
+			target: &cache.Target{
+				Fields:              []cache.Field{{ID: "account", Value: "123"}},
+				IDPGroupsWithAccess: cache.MakeMapStringStruct("admins"),
+			},
+			wantErr: ErrUserNotAuthorized,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQueryWithErr(&storage.GetCachedTarget{Result: tc.target}, tc.targetErr)
+			s := Service{DB: db, Clock: clock.NewMock()}
+
+			got, err := s.CreateFavorite(context.Background(), identity.User{ID: "usr_1", Groups: []string{"developers"}}, target.ID())
+			assert.Equal(t, tc.wantErr, err)
+			if tc.wantErr == nil {
+				assert.Equal(t, "usr_1", got.UserID)
+				assert.Equal(t, target.ID(), got.TargetID)
+			}
+		})
+	}
+}
+
+// mockClient is embedded with a different name, as the Client field would hide the Client method of ddb.Storage
+type mockClient = ddbmock.Client
+
+// targetsDB serves the cached targets from a fake DynamoDB endpoint, so that batch gets can be tested.
+type targetsDB struct {
+	*mockClient
+	client *dynamodb.Client
+	// batchGets is the number of BatchGetItem requests which were made
+	batchGets int
+}
+
+func newTargetsDB(t *testing.T, targets ...cache.Target) *targetsDB {
+	db := &targetsDB{mockClient: ddbmock.New(t)}
+	items := []any{}
+	for _, target := range targets {
+		item, err := attributevalue.MarshalMap(target)
+		if err != nil {
+			t.Fatal(err)
+		}
+		items = append(items, attributeValueJSON(&types.AttributeValueMemberM{Value: item}).(map[string]any)["M"])
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		db.batchGets++
+		w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"Responses": map[string]any{db.Table(): items},
+		})
+	}))
+	t.Cleanup(srv.Close)
+	db.client = dynamodb.New(dynamodb.Options{
+		Region:           "us-east-1",
+		Credentials:      aws.AnonymousCredentials{},
+		EndpointResolver: dynamodb.EndpointResolverFromURL(srv.URL),
+		Retryer:          aws.NopRetryer{},
+	})
+	return db
+}
+
+func (d *targetsDB) Client() *dynamodb.Client {
+	return d.client
+}
+
+// attributeValueJSON converts an attribute value to the DynamoDB JSON wire format
+func attributeValueJSON(av types.AttributeValue) any {
+	switch v := av.(type) {
+	case *types.AttributeValueMemberS:
+		return map[string]any{"S": v.Value}
+	case *types.AttributeValueMemberN:
+		return map[string]any{"N": v.Value}
+	case *types.AttributeValueMemberBOOL:
+		return map[string]any{"BOOL": v.Value}
+	case *types.AttributeValueMemberNULL:
+		return map[string]any{"NULL": v.Value}
+	case *types.AttributeValueMemberL:
+		l := []any{}
+		for _, e := range v.Value {
+			l = append(l, attributeValueJSON(e))
+		}
+		return map[string]any{"L": l}
+	case *types.AttributeValueMemberM:
+		m := map[string]any{}
+		for k, e := range v.Value {
+			m[k] = attributeValueJSON(e)
+		}
+		return map[string]any{"M": m}
+	}
+	panic(fmt.Sprintf("unsupported attribute value %T", av))
+}
+
+func TestListFavorites(t *testing.T) {
+	available := cache.Target{
+		Fields:              []cache.Field{{ID: "account", Value: "123"}},
+		IDPGroupsWithAccess: cache.MakeMapStringStruct("developers"),
+	}
+	removed := cache.Target{
+		Fields: []cache.Field{{ID: "account", Value: "456"}},
+	}
+
+	db := newTargetsDB(t, available)
+	db.MockQuery(&storage.ListFavoritesForUser{Result: []access.Favorite{
+		{UserID: "usr_1", TargetID: available.ID(), Target: available},
+		{UserID: "usr_1", TargetID: removed.ID(), Target: removed},
+	}})
+	s := Service{DB: db, Clock: clock.NewMock()}
+
+	got, err := s.ListFavorites(context.Background(), identity.User{ID: "usr_1", Groups: []string{"developers"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, got, 2)
+	assert.True(t, got[0].Eligible)
+	assert.False(t, got[1].Eligible)
+	// the targets are fetched in a single request rather than a query for each favorite
+	assert.Equal(t, 1, db.batchGets)
+}
@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/common-fate/common-fate/pkg/service/accesssvc (interfaces: FreezeService)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockFreezeService is a mock of FreezeService interface.
+type MockFreezeService struct {
+	ctrl     *gomock.Controller
+	recorder *MockFreezeServiceMockRecorder
+}
+
+// MockFreezeServiceMockRecorder is the mock recorder for MockFreezeService.
+type MockFreezeServiceMockRecorder struct {
+	mock *MockFreezeService
+}
+
+// NewMockFreezeService creates a new mock instance.
+func NewMockFreezeService(ctrl *gomock.Controller) *MockFreezeService {
+	mock := &MockFreezeService{ctrl: ctrl}
+	mock.recorder = &MockFreezeServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFreezeService) EXPECT() *MockFreezeServiceMockRecorder {
+	return m.recorder
+}
+
+// Check mocks base method.
+func (m *MockFreezeService) Check(arg0 context.Context, arg1, arg2, arg3 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Check", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Check indicates an expected call of Check.
+func (mr *MockFreezeServiceMockRecorder) Check(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockFreezeService)(nil).Check), arg0, arg1, arg2, arg3)
+}
@@ -36,16 +36,16 @@ func (m *MockAccessRuleService) EXPECT() *MockAccessRuleServiceMockRecorder {
 }
 
 // GetApprovers mocks base method.
-func (m *MockAccessRuleService) GetApprovers(arg0 context.Context, arg1 rule.AccessRule) ([]string, error) {
+func (m *MockAccessRuleService) GetApprovers(arg0 context.Context, arg1 rule.AccessRule, arg2 string) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetApprovers", arg0, arg1)
+	ret := m.ctrl.Call(m, "GetApprovers", arg0, arg1, arg2)
 	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetApprovers indicates an expected call of GetApprovers.
-func (mr *MockAccessRuleServiceMockRecorder) GetApprovers(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockAccessRuleServiceMockRecorder) GetApprovers(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApprovers", reflect.TypeOf((*MockAccessRuleService)(nil).GetApprovers), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApprovers", reflect.TypeOf((*MockAccessRuleService)(nil).GetApprovers), arg0, arg1, arg2)
 }
@@ -48,11 +48,28 @@ func (s *Service) Review(ctx context.Context, user identity.User, isAdmin bool,
 		return ErrAccessGroupAlreadyReviewed
 	}
 
+	// approvals are refused while access is frozen, unless the requester is exempt from the freeze
+	if s.Freezes != nil && in.Decision == types.ReviewDecisionAPPROVED {
+		requester := storage.GetUser{ID: group.Group.RequestedBy.ID}
+		_, err := s.DB.Query(ctx, &requester)
+		if err != nil {
+			return err
+		}
+		accessRuleIDs, targetGroupIDs := group.FreezeScopes()
+		err = s.Freezes.Check(ctx, requester.Result.Groups, accessRuleIDs, targetGroupIDs)
+		if err != nil {
+			return err
+		}
+	}
+
 	// would approving this request cause it to overlap an existing grant?
 	// if so, reject the review
 	var overrideTiming *access.Timing
 	if in.OverrideTiming != nil {
-		ot := access.TimingFromRequestTiming(*in.OverrideTiming)
+		ot, err := access.TimingFromRequestTiming(*in.OverrideTiming)
+		if err != nil {
+			return err
+		}
 		overrideTiming = &ot
 	}
 	groupCopy := *group
@@ -0,0 +1,89 @@
+package accesssvc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/rule"
+	"github.com/common-fate/common-fate/pkg/service/accesssvc/mocks"
+	"github.com/common-fate/common-fate/pkg/service/freezesvc"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReviewFrozen(t *testing.T) {
+	clk := clock.NewMock()
+	frozen := freezesvc.FrozenError{Freezes: []access.Freeze{{ID: "frz_1", Message: "incident"}}}
+
+	type testcase struct {
+		name      string
+		decision  types.ReviewDecision
+		freezeErr error
+		// wantCheck is true if the freezes are checked
+		wantCheck bool
+		wantErr   error
+	}
+
+	testcases := []testcase{
+		{
+			name:      "approval refused while frozen",
+			decision:  types.ReviewDecisionAPPROVED,
+			freezeErr: frozen,
+			wantCheck: true,
+			wantErr:   frozen,
+		},
+		{
+			name:      "approval allowed when not frozen",
+			decision:  types.ReviewDecisionAPPROVED,
+			wantCheck: true,
+		},
+		{
+			name:     "decline allowed while frozen",
+			decision: types.ReviewDecisionDECLINED,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			requested := clk.Now()
+			group := access.GroupWithTargets{
+				Group: access.Group{
+					ID:                 "grp_1",
+					RequestID:          "req_1",
+					Status:             types.RequestAccessGroupStatusPENDINGAPPROVAL,
+					RequestedBy:        access.RequestedBy{ID: "usr_requester"},
+					RequestedTiming:    access.Timing{Duration: time.Hour, StartTime: &requested},
+					OverrideTiming:     &access.Timing{Duration: time.Hour},
+					AccessRuleSnapshot: rule.AccessRule{ID: "rul_1"},
+				},
+				Targets: []access.GroupTarget{{ID: "gta_1", TargetGroupID: "aws"}},
+			}
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.GetRequestGroupWithTargets{Result: &group})
+			db.MockQuery(&storage.GetUser{Result: &identity.User{ID: "usr_requester", Groups: []string{"engineering"}}})
+			db.MockQuery(&storage.ListRequestWithGroupsWithTargetsForUserAndPastUpcoming{})
+
+			ctrl := gomock.NewController(t)
+			freezes := mocks.NewMockFreezeService(ctrl)
+			if tc.wantCheck {
+				freezes.EXPECT().Check(gomock.Any(), []string{"engineering"}, []string{"rul_1"}, []string{"aws"}).Return(tc.freezeErr)
+			}
+			ep := mocks.NewMockEventPutter(ctrl)
+			if tc.wantErr == nil {
+				ep.EXPECT().Put(gomock.Any(), gomock.AssignableToTypeOf(gevent.AccessGroupReviewed{})).Return(nil)
+			}
+			s := Service{Clock: clk, DB: db, EventPutter: ep, Freezes: freezes}
+
+			err := s.Review(context.Background(), identity.User{ID: "usr_admin"}, true, "req_1", "grp_1", types.ReviewRequest{Decision: tc.decision})
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
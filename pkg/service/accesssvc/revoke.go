@@ -8,6 +8,7 @@ import (
 	"github.com/common-fate/common-fate/pkg/access"
 	"github.com/common-fate/common-fate/pkg/auth"
 	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/identity"
 	"github.com/common-fate/common-fate/pkg/types"
 )
 
@@ -29,6 +30,12 @@ import (
 // }
 
 func (s *Service) RevokeRequest(ctx context.Context, in access.RequestWithGroupsWithTargets) (*access.RequestWithGroupsWithTargets, error) {
+	user := auth.UserFromContext(ctx)
+	return s.RevokeRequestAs(ctx, in, *user)
+}
+
+// RevokeRequestAs revokes a request on behalf of the revoker, for revocations which aren't made through the API such as bulk revoke jobs.
+func (s *Service) RevokeRequestAs(ctx context.Context, in access.RequestWithGroupsWithTargets, revoker identity.User) (*access.RequestWithGroupsWithTargets, error) {
 
 	//before emitting the event to start revoking we want to make sure the request is valid to be revoked
 
@@ -55,18 +62,19 @@ func (s *Service) RevokeRequest(ctx context.Context, in access.RequestWithGroups
 	}
 
 	//now that we know the request is valid we can update the request type to revoking
-	in.Request.RequestStatus = types.REVOKING
-
-	err := s.DB.Put(ctx, &in.Request)
+	err := in.Request.TransitionStatus(types.REVOKING)
 	if err != nil {
 		return nil, err
 	}
-	user := auth.UserFromContext(ctx)
 
+	err = s.DB.Put(ctx, &in.Request)
+	if err != nil {
+		return nil, err
+	}
 	// analytics event
 	analytics.FromContext(ctx).Track(&analytics.RequestRevoked{
 		RequestedBy:      in.Request.RequestedBy.ID,
-		RevokedBy:        user.ID,
+		RevokedBy:        revoker.ID,
 		RequestID:        in.Request.ID,
 		AccessGroupCount: len(in.Groups),
 		HasReason:        in.Request.Purpose.ToAnalytics(),
@@ -75,7 +83,7 @@ func (s *Service) RevokeRequest(ctx context.Context, in access.RequestWithGroups
 	//emit request group revoke event
 	err = s.EventPutter.Put(ctx, gevent.RequestRevokeInitiated{
 		Request: in,
-		Revoker: gevent.UserFromIdentityUser(*user),
+		Revoker: gevent.UserFromIdentityUser(revoker),
 	})
 	if err != nil {
 		return nil, err
@@ -85,6 +93,47 @@ func (s *Service) RevokeRequest(ctx context.Context, in access.RequestWithGroups
 
 }
 
+// RevokeTarget revokes access to a single target in an access group, while the other targets in the request remain active.
+// The target is revoked asynchronously by the event handler, and the group and request statuses are updated once the grant has been revoked.
+func (s *Service) RevokeTarget(ctx context.Context, in access.RequestWithGroupsWithTargets, groupID string, targetID string) (*access.GroupTarget, error) {
+	var target *access.GroupTarget
+	for _, group := range in.Groups {
+		if group.Group.ID != groupID {
+			continue
+		}
+		for i := range group.Targets {
+			if group.Targets[i].ID == targetID {
+				target = &group.Targets[i]
+			}
+		}
+	}
+	if target == nil {
+		return nil, ErrRequestTargetNotFound
+	}
+
+	// a target can't be revoked individually while the whole request is being revoked
+	if in.Request.RequestStatus != types.ACTIVE || target.Grant == nil {
+		return nil, ErrTargetCannotBeRevoked
+	}
+	canRevoke := target.Grant.Status == types.RequestAccessGroupTargetStatusACTIVE ||
+		target.Grant.Status == types.RequestAccessGroupTargetStatusAWAITINGSTART
+	if !canRevoke || target.Grant.End.Before(s.Clock.Now()) {
+		return nil, ErrTargetCannotBeRevoked
+	}
+
+	user := auth.UserFromContext(ctx)
+
+	err := s.EventPutter.Put(ctx, gevent.GrantRevokeInitiated{
+		Grant:   *target,
+		Revoker: gevent.UserFromIdentityUser(*user),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return target, nil
+}
+
 // type CreateRequest struct {
 // 	AccessRuleId string
 // 	Reason       *string
@@ -0,0 +1,108 @@
+package accesssvc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/auth"
+	eventmock "github.com/common-fate/common-fate/pkg/eventhandler/mocks"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/iso8601"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRevokeTarget(t *testing.T) {
+	type testcase struct {
+		name          string
+		requestStatus types.RequestStatus
+		grant         *access.Grant
+		targetID      string
+		wantEvent     bool
+		wantErr       error
+	}
+
+	clk := clock.NewMock()
+	active := &access.Grant{
+		Status: types.RequestAccessGroupTargetStatusACTIVE,
+		End:    iso8601.New(clk.Now().Add(time.Hour)),
+	}
+
+	testcases := []testcase{
+		{
+			name:          "ok",
+			requestStatus: types.ACTIVE,
+			grant:         active,
+			targetID:      "target1",
+			wantEvent:     true,
+		},
+		{
+			name:          "target not in group",
+			requestStatus: types.ACTIVE,
+			grant:         active,
+			targetID:      "other",
+			wantErr:       ErrRequestTargetNotFound,
+		},
+		{
+			name:          "request is already being revoked",
+			requestStatus: types.REVOKING,
+			grant:         active,
+			targetID:      "target1",
+			wantErr:       ErrTargetCannotBeRevoked,
+		},
+		{
+			name:          "target expired",
+			requestStatus: types.ACTIVE,
+			grant: &access.Grant{
+				Status: types.RequestAccessGroupTargetStatusEXPIRED,
+				End:    iso8601.New(clk.Now().Add(-time.Hour)),
+			},
+			targetID: "target1",
+			wantErr:  ErrTargetCannotBeRevoked,
+		},
+		{
+			name:          "target not provisioned",
+			requestStatus: types.ACTIVE,
+			targetID:      "target1",
+			wantErr:       ErrTargetCannotBeRevoked,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ep := eventmock.NewMockEventPutter(ctrl)
+			if tc.wantEvent {
+				ep.EXPECT().Put(gomock.Any(), gomock.AssignableToTypeOf(gevent.GrantRevokeInitiated{})).Return(nil).Times(1)
+			}
+
+			s := Service{
+				Clock:       clk,
+				EventPutter: ep,
+			}
+			in := access.RequestWithGroupsWithTargets{
+				Request: access.Request{ID: "req123", RequestStatus: tc.requestStatus},
+				Groups: []access.GroupWithTargets{
+					{
+						Group: access.Group{ID: "group1", RequestID: "req123"},
+						Targets: []access.GroupTarget{
+							{ID: "target1", GroupID: "group1", RequestID: "req123", Grant: tc.grant},
+							{ID: "target2", GroupID: "group1", RequestID: "req123", Grant: active},
+						},
+					},
+				},
+			}
+			ctx := auth.TestingSetUser(context.Background(), identity.User{ID: "user1", Email: "user1@example.com"})
+			got, err := s.RevokeTarget(ctx, in, "group1", tc.targetID)
+			assert.Equal(t, tc.wantErr, err)
+			if tc.wantErr == nil {
+				assert.Equal(t, tc.targetID, got.ID)
+			}
+		})
+	}
+}
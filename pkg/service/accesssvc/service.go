@@ -18,6 +18,8 @@ type Service struct {
 	DB          ddb.Storage
 	EventPutter EventPutter
 	Rules       AccessRuleService
+	// Freezes is optional, if it is set requests and approvals are refused while access is frozen
+	Freezes FreezeService
 }
 
 type CreateGrantOpts struct {
@@ -33,6 +35,13 @@ type Workflow interface {
 	Grant(ctx context.Context, group access.GroupWithTargets, subject string) ([]access.GroupTarget, error)
 }
 
+//go:generate go run github.com/golang/mock/mockgen -destination=mocks/freeze_service.go -package=mocks . FreezeService
+
+// FreezeService checks whether access is frozen.
+type FreezeService interface {
+	Check(ctx context.Context, groups []string, accessRuleIDs []string, targetGroupIDs []string) error
+}
+
 //go:generate go run github.com/golang/mock/mockgen -destination=mocks/eventputter.go -package=mocks . EventPutter
 type EventPutter interface {
 	Put(ctx context.Context, detail gevent.EventTyper) error
@@ -42,5 +51,5 @@ type EventPutter interface {
 
 // AccessRuleService can create and get rules
 type AccessRuleService interface {
-	GetApprovers(ctx context.Context, rule rule.AccessRule) ([]string, error)
+	GetApprovers(ctx context.Context, rule rule.AccessRule, requesterID string) ([]string, error)
 }
@@ -0,0 +1,88 @@
+package bulkrevokesvc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	ddbTypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+)
+
+// Reindex adds the index keys which ACCESS_RULE and TARGET_GROUP jobs are planned with to the groups and targets
+// of active and pending requests which were made before the keys existed. It returns the number of items updated.
+//
+// Only the index keys are written, on the condition that the request status hasn't changed since the requests were listed,
+// so requests which are updated while reindexing aren't overwritten.
+func (s *Service) Reindex(ctx context.Context) (int, error) {
+	updated := 0
+	for _, status := range []types.RequestStatus{types.ACTIVE, types.PENDING} {
+		q := storage.ListRequestWithGroupsWithTargetsForStatus{Status: status}
+		err := s.DB.All(ctx, &q)
+		if err != nil {
+			return updated, err
+		}
+		for _, r := range q.Result {
+			for _, g := range r.Groups {
+				keys, err := g.Group.DDBKeys()
+				if err != nil {
+					return updated, err
+				}
+				ok, err := s.setIndexKeys(ctx, keys, "GSI3PK", keys.GSI3PK, "GSI3SK", keys.GSI3SK, status)
+				if err != nil {
+					return updated, err
+				}
+				if ok {
+					updated++
+				}
+				for _, t := range g.Targets {
+					keys, err := t.DDBKeys()
+					if err != nil {
+						return updated, err
+					}
+					ok, err := s.setIndexKeys(ctx, keys, "GSI4PK", keys.GSI4PK, "GSI4SK", keys.GSI4SK, status)
+					if err != nil {
+						return updated, err
+					}
+					if ok {
+						updated++
+					}
+				}
+			}
+		}
+	}
+	return updated, nil
+}
+
+// setIndexKeys returns false if the item's status changed, in which case it was written with the index keys already.
+func (s *Service) setIndexKeys(ctx context.Context, keys ddb.Keys, pkName, pk, skName, sk string, status types.RequestStatus) (bool, error) {
+	_, err := s.DB.Client().UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(s.DB.Table()),
+		Key: map[string]ddbTypes.AttributeValue{
+			"PK": &ddbTypes.AttributeValueMemberS{Value: keys.PK},
+			"SK": &ddbTypes.AttributeValueMemberS{Value: keys.SK},
+		},
+		UpdateExpression:    aws.String("SET #pk = :pk, #sk = :sk"),
+		ConditionExpression: aws.String("requestStatus = :status"),
+		ExpressionAttributeNames: map[string]string{
+			"#pk": pkName,
+			"#sk": skName,
+		},
+		ExpressionAttributeValues: map[string]ddbTypes.AttributeValue{
+			":pk":     &ddbTypes.AttributeValueMemberS{Value: pk},
+			":sk":     &ddbTypes.AttributeValueMemberS{Value: sk},
+			":status": &ddbTypes.AttributeValueMemberS{Value: string(status)},
+		},
+	})
+	var ccfe *ddbTypes.ConditionalCheckFailedException
+	if errors.As(err, &ccfe) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
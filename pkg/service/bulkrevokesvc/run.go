@@ -0,0 +1,172 @@
+package bulkrevokesvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+)
+
+// errSkipped is returned when an item no longer needs to be revoked or cancelled,
+// because its status changed after the job was created.
+type errSkipped struct {
+	reason string
+}
+
+func (e errSkipped) Error() string {
+	return e.reason
+}
+
+// continueBefore is how long before the context deadline a job stops processing items
+// and continues in a new invocation, so that large jobs aren't limited by the Lambda timeout.
+const continueBefore = 5 * time.Second
+
+// Run processes the planned items of a job, emitting the same events as revoking or cancelling requests individually.
+// Progress is saved after each item, so if the job is interrupted running it again resumes from the first planned item.
+// Failures for individual items are recorded on the item rather than returned.
+func (s *Service) Run(ctx context.Context, jobID string) error {
+	log := logger.Get(ctx).With("bulkRevokeJobId", jobID)
+	q := storage.GetBulkRevokeJob{ID: jobID}
+	_, err := s.DB.Query(ctx, &q)
+	if err != nil {
+		return err
+	}
+	job := q.Result
+	if job.DryRun || job.Status == types.FINISHED {
+		return nil
+	}
+	items := storage.ListBulkRevokeJobItems{JobID: jobID}
+	err = s.DB.All(ctx, &items)
+	if err != nil {
+		return err
+	}
+
+	job.Status = types.RUNNING
+	job.UpdatedAt = s.Clock.Now()
+	err = s.DB.Put(ctx, job)
+	if err != nil {
+		return err
+	}
+
+	revoker := identity.User{
+		ID:        job.CreatedBy.ID,
+		Email:     job.CreatedBy.Email,
+		FirstName: job.CreatedBy.FirstName,
+		LastName:  job.CreatedBy.LastName,
+	}
+	for _, item := range items.Result {
+		if item.Status != types.BulkRevokeJobItemStatusPLANNED {
+			continue
+		}
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < continueBefore {
+			log.Infow("continuing bulk revoke job in a new invocation", "processed", job.Processed, "total", job.Total)
+			return s.Eventbus.Put(ctx, gevent.BulkRevokeJobCreated{JobID: jobID})
+		}
+		err := s.process(ctx, *job, revoker, item)
+		var skipped errSkipped
+		switch {
+		case errors.As(err, &skipped):
+			item.Status = types.BulkRevokeJobItemStatusSKIPPED
+			item.Error = skipped.reason
+			job.Skipped++
+		case err != nil:
+			log.Errorw("failed to process bulk revoke item", "item", item, "error", err)
+			item.Status = types.BulkRevokeJobItemStatusFAILED
+			item.Error = err.Error()
+			job.Failed++
+		default:
+			item.Status = types.BulkRevokeJobItemStatusSUCCEEDED
+			job.Succeeded++
+		}
+		job.Processed++
+		job.UpdatedAt = s.Clock.Now()
+		err = s.DB.PutBatch(ctx, &item, job)
+		if err != nil {
+			return err
+		}
+	}
+
+	job.Status = types.FINISHED
+	job.UpdatedAt = s.Clock.Now()
+	log.Infow("finished bulk revoke job", "succeeded", job.Succeeded, "skipped", job.Skipped, "failed", job.Failed)
+	return s.DB.Put(ctx, job)
+}
+
+func (s *Service) process(ctx context.Context, job access.BulkRevokeJob, revoker identity.User, item access.BulkRevokeJobItem) error {
+	now := s.Clock.Now()
+	switch item.Action {
+	case types.REVOKEREQUEST, types.CANCELREQUEST:
+		q := storage.GetRequestWithGroupsWithTargets{ID: item.RequestID}
+		_, err := s.DB.Query(ctx, &q)
+		if err != nil {
+			return err
+		}
+		request := q.Result
+		if item.Action == types.CANCELREQUEST {
+			if request.Request.RequestStatus != types.PENDING {
+				return errSkipped{reason: fmt.Sprintf("request is %s", request.Request.RequestStatus)}
+			}
+			err = s.record(ctx, job, item, now)
+			if err != nil {
+				return err
+			}
+			return s.Eventbus.Put(ctx, gevent.RequestCancelledInitiated{Request: *request})
+		}
+		if request.Request.RequestStatus != types.ACTIVE {
+			return errSkipped{reason: fmt.Sprintf("request is %s", request.Request.RequestStatus)}
+		}
+		_, err = s.Access.RevokeRequestAs(ctx, *request, revoker)
+		if err != nil {
+			return err
+		}
+		return s.record(ctx, job, item, now)
+
+	case types.REVOKETARGET:
+		q := storage.GetRequestGroupTarget{RequestID: item.RequestID, GroupID: item.GroupID, TargetID: item.TargetID}
+		_, err := s.DB.Query(ctx, &q)
+		if err != nil {
+			return err
+		}
+		target := q.Result
+		if target.RequestStatus != types.ACTIVE || !canRevoke(*target, now) {
+			return errSkipped{reason: "grant is no longer active"}
+		}
+		err = s.record(ctx, job, item, now)
+		if err != nil {
+			return err
+		}
+		return s.Eventbus.Put(ctx, gevent.GrantRevokeInitiated{Grant: *target, Revoker: gevent.UserFromIdentityUser(revoker)})
+	}
+	return fmt.Errorf("unsupported bulk revoke action %q", item.Action)
+}
+
+// record adds an entry to the request history linking the revocation to the job.
+func (s *Service) record(ctx context.Context, job access.BulkRevokeJob, item access.BulkRevokeJobItem, now time.Time) error {
+	detail := map[string]string{
+		"action":          "bulkRevokeJob." + string(item.Action),
+		"bulkRevokeJobId": job.ID,
+		"actor":           job.CreatedBy.Email,
+	}
+	if item.TargetID != "" {
+		detail["targetId"] = item.TargetID
+	}
+	evt := access.NewRecordedEvent(item.RequestID, &job.CreatedBy.ID, now, detail)
+	return s.DB.Put(ctx, &evt)
+}
+
+// canRevoke returns true if the target has a grant which is active or waiting to start.
+func canRevoke(target access.GroupTarget, now time.Time) bool {
+	if target.Grant == nil {
+		return false
+	}
+	status := target.Grant.Status
+	active := status == types.RequestAccessGroupTargetStatusACTIVE || status == types.RequestAccessGroupTargetStatusAWAITINGSTART
+	return active && target.Grant.End.After(now)
+}
@@ -0,0 +1,254 @@
+// Package bulkrevokesvc revokes access in bulk, for offboarding users and responding to incidents.
+package bulkrevokesvc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+)
+
+type EventPutter interface {
+	Put(ctx context.Context, detail gevent.EventTyper) error
+}
+
+// RequestRevoker revokes whole requests, it is implemented by accesssvc.
+type RequestRevoker interface {
+	RevokeRequestAs(ctx context.Context, in access.RequestWithGroupsWithTargets, revoker identity.User) (*access.RequestWithGroupsWithTargets, error)
+}
+
+type Service struct {
+	DB       ddb.Storage
+	Clock    clock.Clock
+	Eventbus EventPutter
+	// Access is used to revoke requests when running jobs, it isn't required to create jobs.
+	Access RequestRevoker
+}
+
+// InvalidFilterError is returned if the filter doesn't include the field required by the kind of job.
+type InvalidFilterError struct {
+	Kind  types.BulkRevokeJobKind
+	Field string
+}
+
+func (e InvalidFilterError) Error() string {
+	return fmt.Sprintf("%s jobs require filter.%s", e.Kind, e.Field)
+}
+
+type CreateJobOpts struct {
+	Kind   types.BulkRevokeJobKind
+	Filter access.BulkRevokeFilter
+	DryRun bool
+}
+
+// CreateJob finds the requests and targets which match the filter and stores them as the items of a new job.
+// Unless the job is a dry run, it is then queued to be processed by the event handler.
+func (s *Service) CreateJob(ctx context.Context, user identity.User, opts CreateJobOpts) (*access.BulkRevokeJob, []access.BulkRevokeJobItem, error) {
+	err := validate(opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	now := s.Clock.Now()
+	job := access.BulkRevokeJob{
+		ID:     types.NewBulkRevokeJobID(),
+		Kind:   opts.Kind,
+		Filter: opts.Filter,
+		DryRun: opts.DryRun,
+		Status: types.QUEUED,
+		CreatedBy: access.RequestedBy{
+			ID:        user.ID,
+			Email:     user.Email,
+			FirstName: user.FirstName,
+			LastName:  user.LastName,
+		},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	items, err := s.plan(ctx, job)
+	if err != nil {
+		return nil, nil, err
+	}
+	job.Total = len(items)
+	// there is nothing to process for dry runs or jobs which didn't match anything
+	if opts.DryRun || len(items) == 0 {
+		job.Status = types.FINISHED
+	}
+
+	dbItems := []ddb.Keyer{&job}
+	for i := range items {
+		dbItems = append(dbItems, &items[i])
+	}
+	err = s.DB.PutBatch(ctx, dbItems...)
+	if err != nil {
+		return nil, nil, err
+	}
+	if job.Status == types.FINISHED {
+		return &job, items, nil
+	}
+
+	err = s.Eventbus.Put(ctx, gevent.BulkRevokeJobCreated{JobID: job.ID})
+	if err != nil {
+		return nil, nil, err
+	}
+	return &job, items, nil
+}
+
+func validate(opts CreateJobOpts) error {
+	f := opts.Filter
+	switch opts.Kind {
+	case types.USER:
+		if f.UserID == "" {
+			return InvalidFilterError{Kind: opts.Kind, Field: "userId"}
+		}
+	case types.TARGETGROUP:
+		if f.TargetGroupID == "" {
+			return InvalidFilterError{Kind: opts.Kind, Field: "targetGroupId"}
+		}
+	case types.TARGETFILTER:
+		if len(f.TargetFields) == 0 {
+			return InvalidFilterError{Kind: opts.Kind, Field: "targetFields"}
+		}
+	case types.ACCESSRULE:
+		if f.AccessRuleID == "" {
+			return InvalidFilterError{Kind: opts.Kind, Field: "accessRuleId"}
+		}
+	default:
+		return fmt.Errorf("unsupported bulk revoke job kind %q", opts.Kind)
+	}
+	return nil
+}
+
+// plan returns the items which a job applies to.
+// USER jobs revoke active requests made by the user, other jobs revoke the matching grants in active requests.
+// All jobs cancel the matching pending requests, so that they can't be approved after the job has run.
+func (s *Service) plan(ctx context.Context, job access.BulkRevokeJob) ([]access.BulkRevokeJobItem, error) {
+	var items []access.BulkRevokeJobItem
+	cancelled := map[string]bool{}
+	add := func(item access.BulkRevokeJobItem) {
+		if item.Action == types.CANCELREQUEST {
+			// a pending request may match through several groups or targets
+			if cancelled[item.RequestID] {
+				return
+			}
+			cancelled[item.RequestID] = true
+		}
+		item.ID = fmt.Sprintf("%05d", len(items))
+		item.JobID = job.ID
+		item.Status = types.BulkRevokeJobItemStatusPLANNED
+		items = append(items, item)
+	}
+	now := s.Clock.Now()
+	addTarget := func(t access.GroupTarget) {
+		switch t.RequestStatus {
+		case types.ACTIVE:
+			if canRevoke(t, now) {
+				add(access.BulkRevokeJobItem{RequestID: t.RequestID, GroupID: t.GroupID, TargetID: t.ID, RequestedBy: t.RequestedBy, Action: types.REVOKETARGET})
+			}
+		case types.PENDING:
+			add(access.BulkRevokeJobItem{RequestID: t.RequestID, RequestedBy: t.RequestedBy, Action: types.CANCELREQUEST})
+		}
+	}
+
+	switch job.Kind {
+	case types.USER:
+		q := storage.ListRequestWithGroupsWithTargetsForUser{UserID: job.Filter.UserID}
+		err := s.DB.All(ctx, &q)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range q.Result {
+			switch r.Request.RequestStatus {
+			case types.ACTIVE:
+				add(access.BulkRevokeJobItem{RequestID: r.Request.ID, RequestedBy: r.Request.RequestedBy, Action: types.REVOKEREQUEST})
+			case types.PENDING:
+				add(access.BulkRevokeJobItem{RequestID: r.Request.ID, RequestedBy: r.Request.RequestedBy, Action: types.CANCELREQUEST})
+			}
+		}
+
+	case types.ACCESSRULE:
+		for _, status := range []types.RequestStatus{types.ACTIVE, types.PENDING} {
+			q := storage.ListRequestGroupsForAccessRule{AccessRuleID: job.Filter.AccessRuleID, Status: status}
+			err := s.DB.All(ctx, &q)
+			if err != nil {
+				return nil, err
+			}
+			for _, g := range q.Result {
+				if status == types.PENDING {
+					add(access.BulkRevokeJobItem{RequestID: g.RequestID, RequestedBy: g.RequestedBy, Action: types.CANCELREQUEST})
+					continue
+				}
+				gq := storage.GetRequestGroupWithTargets{RequestID: g.RequestID, GroupID: g.ID}
+				_, err := s.DB.Query(ctx, &gq)
+				if err != nil {
+					return nil, err
+				}
+				for _, t := range gq.Result.Targets {
+					addTarget(t)
+				}
+			}
+		}
+
+	case types.TARGETGROUP, types.TARGETFILTER:
+		// target filters without a target group can match targets in any target group
+		if job.Filter.TargetGroupID == "" {
+			for _, status := range []types.RequestStatus{types.ACTIVE, types.PENDING} {
+				q := storage.ListRequestWithGroupsWithTargetsForStatus{Status: status}
+				err := s.DB.All(ctx, &q)
+				if err != nil {
+					return nil, err
+				}
+				for _, r := range q.Result {
+					for _, g := range r.Groups {
+						for _, t := range g.Targets {
+							if matches(job, t) {
+								addTarget(t)
+							}
+						}
+					}
+				}
+			}
+			break
+		}
+		for _, status := range []types.RequestStatus{types.ACTIVE, types.PENDING} {
+			q := storage.ListRequestGroupTargetsForTargetGroup{TargetGroupID: job.Filter.TargetGroupID, Status: status}
+			err := s.DB.All(ctx, &q)
+			if err != nil {
+				return nil, err
+			}
+			for _, t := range q.Result {
+				if matches(job, t) {
+					addTarget(t)
+				}
+			}
+		}
+	}
+	return items, nil
+}
+
+// matches returns true if the target is selected by the job's filter.
+func matches(job access.BulkRevokeJob, target access.GroupTarget) bool {
+	f := job.Filter
+	switch job.Kind {
+	case types.TARGETGROUP:
+		return target.TargetGroupID == f.TargetGroupID
+	case types.TARGETFILTER:
+		if f.TargetGroupID != "" && target.TargetGroupID != f.TargetGroupID {
+			return false
+		}
+		fields := target.FieldsToMap()
+		for k, v := range f.TargetFields {
+			if fields[k] != v {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
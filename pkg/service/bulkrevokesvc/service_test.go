@@ -0,0 +1,306 @@
+package bulkrevokesvc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/common-fate/pkg/access"
+	eventmock "github.com/common-fate/common-fate/pkg/eventhandler/mocks"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/rule"
+	"github.com/common-fate/common-fate/pkg/service/accesssvc"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/common-fate/iso8601"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func testTarget(id string, targetGroupID string, accountID string, status types.RequestAccessGroupTargetStatus, end time.Time) access.GroupTarget {
+	return access.GroupTarget{
+		ID:            id,
+		GroupID:       "grp_1",
+		RequestID:     "req_1",
+		RequestStatus: types.ACTIVE,
+		TargetGroupID: targetGroupID,
+		Fields:        []access.Field{{ID: "accountId", Value: access.FieldValue{Type: "string", Value: accountID}}},
+		Grant:         &access.Grant{Status: status, End: iso8601.New(end)},
+	}
+}
+
+// requestsDB answers the queries which jobs are planned with from a list of requests,
+// as the mock client doesn't check the query parameters.
+type requestsDB struct {
+	*mockClient
+	requests []access.RequestWithGroupsWithTargets
+}
+
+type mockClient = ddbmock.Client
+
+func (d *requestsDB) Query(ctx context.Context, qb ddb.QueryBuilder, opts ...func(*ddb.QueryOpts)) (*ddb.QueryResult, error) {
+	switch q := qb.(type) {
+	case *storage.ListRequestWithGroupsWithTargetsForStatus:
+		for _, r := range d.requests {
+			if r.Request.RequestStatus == q.Status {
+				q.Result = append(q.Result, r)
+			}
+		}
+	case *storage.ListRequestGroupsForAccessRule:
+		for _, r := range d.requests {
+			for _, g := range r.Groups {
+				if g.Group.AccessRuleSnapshot.ID == q.AccessRuleID && g.Group.RequestStatus == q.Status {
+					q.Result = append(q.Result, g.Group)
+				}
+			}
+		}
+	case *storage.ListRequestGroupTargetsForTargetGroup:
+		for _, r := range d.requests {
+			for _, g := range r.Groups {
+				for _, t := range g.Targets {
+					if t.TargetGroupID == q.TargetGroupID && t.RequestStatus == q.Status {
+						q.Result = append(q.Result, t)
+					}
+				}
+			}
+		}
+	case *storage.GetRequestGroupWithTargets:
+		for _, r := range d.requests {
+			for i, g := range r.Groups {
+				if g.Group.RequestID == q.RequestID && g.Group.ID == q.GroupID {
+					q.Result = &r.Groups[i]
+				}
+			}
+		}
+		if q.Result == nil {
+			return nil, ddb.ErrNoItems
+		}
+	default:
+		return d.mockClient.Query(ctx, qb, opts...)
+	}
+	return &ddb.QueryResult{}, nil
+}
+
+func (d *requestsDB) All(ctx context.Context, qb ddb.QueryBuilder, opts ...func(*ddb.QueryOpts)) error {
+	_, err := d.Query(ctx, qb, opts...)
+	return err
+}
+
+func TestCreateJob(t *testing.T) {
+	clk := clock.NewMock()
+	later := clk.Now().Add(time.Hour)
+	userRequests := []access.RequestWithGroupsWithTargets{
+		{Request: access.Request{ID: "req_active", RequestStatus: types.ACTIVE}},
+		{Request: access.Request{ID: "req_pending", RequestStatus: types.PENDING}},
+		{Request: access.Request{ID: "req_complete", RequestStatus: types.COMPLETE}},
+	}
+	pendingTarget := func(id string, targetGroupID string, accountID string) access.GroupTarget {
+		t := testTarget(id, targetGroupID, accountID, "", later)
+		t.RequestID = "req_2"
+		t.GroupID = "grp_2"
+		t.RequestStatus = types.PENDING
+		t.Grant = nil
+		return t
+	}
+	requests := []access.RequestWithGroupsWithTargets{
+		{
+			Request: access.Request{ID: "req_1", RequestStatus: types.ACTIVE},
+			Groups: []access.GroupWithTargets{
+				{
+					Group: access.Group{ID: "grp_1", RequestID: "req_1", RequestStatus: types.ACTIVE, AccessRuleSnapshot: rule.AccessRule{ID: "rul_1"}},
+					Targets: []access.GroupTarget{
+						testTarget("gta_1", "aws", "123", types.RequestAccessGroupTargetStatusACTIVE, later),
+						testTarget("gta_2", "aws", "456", types.RequestAccessGroupTargetStatusACTIVE, later),
+						testTarget("gta_3", "okta", "123", types.RequestAccessGroupTargetStatusAWAITINGSTART, later),
+						testTarget("gta_4", "aws", "123", types.RequestAccessGroupTargetStatusREVOKED, later),
+					},
+				},
+			},
+		},
+		{
+			// both targets match, but the request is only cancelled once
+			Request: access.Request{ID: "req_2", RequestStatus: types.PENDING},
+			Groups: []access.GroupWithTargets{
+				{
+					Group: access.Group{ID: "grp_2", RequestID: "req_2", RequestStatus: types.PENDING, AccessRuleSnapshot: rule.AccessRule{ID: "rul_1"}},
+					Targets: []access.GroupTarget{
+						pendingTarget("gta_5", "aws", "123"),
+						pendingTarget("gta_6", "aws", "123"),
+					},
+				},
+			},
+		},
+	}
+
+	type testcase struct {
+		name        string
+		give        CreateJobOpts
+		wantEvent   bool
+		wantStatus  types.BulkRevokeJobStatus
+		wantActions map[string]types.BulkRevokeJobItemAction
+		wantErr     error
+	}
+
+	testcases := []testcase{
+		{
+			name:    "user filter required",
+			give:    CreateJobOpts{Kind: types.USER},
+			wantErr: InvalidFilterError{Kind: types.USER, Field: "userId"},
+		},
+		{
+			name:       "user revokes active and cancels pending requests",
+			give:       CreateJobOpts{Kind: types.USER, Filter: access.BulkRevokeFilter{UserID: "usr_1"}},
+			wantEvent:  true,
+			wantStatus: types.QUEUED,
+			wantActions: map[string]types.BulkRevokeJobItemAction{
+				"req_active":  types.REVOKEREQUEST,
+				"req_pending": types.CANCELREQUEST,
+			},
+		},
+		{
+			name:       "dry run doesn't queue the job",
+			give:       CreateJobOpts{Kind: types.USER, Filter: access.BulkRevokeFilter{UserID: "usr_1"}, DryRun: true},
+			wantStatus: types.FINISHED,
+			wantActions: map[string]types.BulkRevokeJobItemAction{
+				"req_active":  types.REVOKEREQUEST,
+				"req_pending": types.CANCELREQUEST,
+			},
+		},
+		{
+			name:       "target group",
+			give:       CreateJobOpts{Kind: types.TARGETGROUP, Filter: access.BulkRevokeFilter{TargetGroupID: "aws"}},
+			wantEvent:  true,
+			wantStatus: types.QUEUED,
+			wantActions: map[string]types.BulkRevokeJobItemAction{
+				"gta_1": types.REVOKETARGET,
+				"gta_2": types.REVOKETARGET,
+				"req_2": types.CANCELREQUEST,
+			},
+		},
+		{
+			name:       "target filter",
+			give:       CreateJobOpts{Kind: types.TARGETFILTER, Filter: access.BulkRevokeFilter{TargetFields: map[string]string{"accountId": "123"}}},
+			wantEvent:  true,
+			wantStatus: types.QUEUED,
+			wantActions: map[string]types.BulkRevokeJobItemAction{
+				"gta_1": types.REVOKETARGET,
+				"gta_3": types.REVOKETARGET,
+				"req_2": types.CANCELREQUEST,
+			},
+		},
+		{
+			name:       "target filter in a target group",
+			give:       CreateJobOpts{Kind: types.TARGETFILTER, Filter: access.BulkRevokeFilter{TargetGroupID: "okta", TargetFields: map[string]string{"accountId": "123"}}},
+			wantEvent:  true,
+			wantStatus: types.QUEUED,
+			wantActions: map[string]types.BulkRevokeJobItemAction{
+				"gta_3": types.REVOKETARGET,
+			},
+		},
+		{
+			name:       "access rule",
+			give:       CreateJobOpts{Kind: types.ACCESSRULE, Filter: access.BulkRevokeFilter{AccessRuleID: "rul_1"}},
+			wantEvent:  true,
+			wantStatus: types.QUEUED,
+			wantActions: map[string]types.BulkRevokeJobItemAction{
+				"gta_1": types.REVOKETARGET,
+				"gta_2": types.REVOKETARGET,
+				"gta_3": types.REVOKETARGET,
+				"req_2": types.CANCELREQUEST,
+			},
+		},
+		{
+			name:       "no matches",
+			give:       CreateJobOpts{Kind: types.ACCESSRULE, Filter: access.BulkRevokeFilter{AccessRuleID: "rul_other"}},
+			wantStatus: types.FINISHED,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock := ddbmock.New(t)
+			mock.MockQuery(&storage.ListRequestWithGroupsWithTargetsForUser{Result: userRequests})
+			db := &requestsDB{mockClient: mock, requests: requests}
+			ctrl := gomock.NewController(t)
+			ep := eventmock.NewMockEventPutter(ctrl)
+			if tc.wantEvent {
+				ep.EXPECT().Put(gomock.Any(), gomock.AssignableToTypeOf(gevent.BulkRevokeJobCreated{})).Return(nil)
+			}
+			s := Service{DB: db, Clock: clk, Eventbus: ep}
+
+			job, items, err := s.CreateJob(context.Background(), identity.User{ID: "usr_admin"}, tc.give)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.wantStatus, job.Status)
+			assert.Equal(t, len(tc.wantActions), job.Total)
+			got := map[string]types.BulkRevokeJobItemAction{}
+			for _, i := range items {
+				assert.Equal(t, types.BulkRevokeJobItemStatusPLANNED, i.Status)
+				if i.TargetID != "" {
+					got[i.TargetID] = i.Action
+				} else {
+					got[i.RequestID] = i.Action
+				}
+			}
+			if len(tc.wantActions) == 0 {
+				assert.Empty(t, got)
+			} else {
+				assert.Equal(t, tc.wantActions, got)
+			}
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	clk := clock.NewMock()
+	later := clk.Now().Add(time.Hour)
+	job := access.BulkRevokeJob{ID: "brj_1", Kind: types.USER, Status: types.QUEUED, Total: 4, CreatedBy: access.RequestedBy{ID: "usr_admin"}}
+	items := []access.BulkRevokeJobItem{
+		{ID: "00000", JobID: "brj_1", RequestID: "req_active", Action: types.REVOKEREQUEST, Status: types.BulkRevokeJobItemStatusPLANNED},
+		{ID: "00001", JobID: "brj_1", RequestID: "req_approved", Action: types.CANCELREQUEST, Status: types.BulkRevokeJobItemStatusPLANNED},
+		{ID: "00002", JobID: "brj_1", RequestID: "req_1", GroupID: "grp_1", TargetID: "gta_1", Action: types.REVOKETARGET, Status: types.BulkRevokeJobItemStatusPLANNED},
+		// already processed by a previous invocation
+		{ID: "00003", JobID: "brj_1", RequestID: "req_1", GroupID: "grp_1", TargetID: "gta_2", Action: types.REVOKETARGET, Status: types.BulkRevokeJobItemStatusSUCCEEDED},
+	}
+
+	db := ddbmock.New(t)
+	db.MockQuery(&storage.GetBulkRevokeJob{Result: &job})
+	db.MockQuery(&storage.ListBulkRevokeJobItems{Result: items})
+	activeTarget := testTarget("gta_3", "aws", "123", types.RequestAccessGroupTargetStatusACTIVE, later)
+	db.MockQuery(&storage.GetRequestWithGroupsWithTargets{Result: &access.RequestWithGroupsWithTargets{
+		Request: access.Request{ID: "req_active", RequestStatus: types.ACTIVE},
+		Groups:  []access.GroupWithTargets{{Targets: []access.GroupTarget{activeTarget}}},
+	}})
+	// the pending request was approved after the job was created, so it is skipped
+	db.MockQuery(&storage.GetRequestWithGroupsWithTargets{Result: &access.RequestWithGroupsWithTargets{Request: access.Request{ID: "req_approved", RequestStatus: types.ACTIVE}}})
+	target := testTarget("gta_1", "aws", "123", types.RequestAccessGroupTargetStatusACTIVE, later)
+	db.MockQuery(&storage.GetRequestGroupTarget{Result: &target})
+
+	ctrl := gomock.NewController(t)
+	ep := eventmock.NewMockEventPutter(ctrl)
+	gomock.InOrder(
+		ep.EXPECT().Put(gomock.Any(), gomock.AssignableToTypeOf(gevent.RequestRevokeInitiated{})).DoAndReturn(func(ctx context.Context, detail gevent.EventTyper) error {
+			evt := detail.(gevent.RequestRevokeInitiated)
+			// the request is revoked through the access service on behalf of the job's creator
+			assert.Equal(t, types.REVOKING, evt.Request.Request.RequestStatus)
+			assert.Equal(t, "usr_admin", evt.Revoker.ID)
+			return nil
+		}),
+		ep.EXPECT().Put(gomock.Any(), gomock.AssignableToTypeOf(gevent.GrantRevokeInitiated{})).Return(nil),
+	)
+	s := Service{DB: db, Clock: clk, Eventbus: ep, Access: &accesssvc.Service{DB: db, Clock: clk, EventPutter: ep}}
+
+	err := s.Run(context.Background(), "brj_1")
+	assert.NoError(t, err)
+	assert.Equal(t, types.FINISHED, job.Status)
+	assert.Equal(t, 3, job.Processed)
+	assert.Equal(t, 2, job.Succeeded)
+	assert.Equal(t, 1, job.Skipped)
+	assert.Equal(t, 0, job.Failed)
+}
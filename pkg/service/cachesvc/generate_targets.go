@@ -3,6 +3,7 @@ package cachesvc
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/common-fate/common-fate/pkg/cache"
 	"github.com/common-fate/common-fate/pkg/rule"
@@ -30,7 +31,13 @@ func (s *Service) RefreshCachedTargets(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	distictTargets := generateDistinctTargets(resourceRuleMapping, accessrulesQuery.Result)
+
+	metadataQuery := &storage.ListTargetMetadata{}
+	err = s.DB.All(ctx, metadataQuery)
+	if err != nil {
+		return err
+	}
+	distictTargets := generateDistinctTargets(resourceRuleMapping, accessrulesQuery.Result, metadataQuery.Result)
 
 	// I want to preserve the IDs of targets so they can be used when requesting access
 	// but the targets need to be deleted if they no longer exist
@@ -60,6 +67,24 @@ func (s *Service) RefreshCachedTargets(ctx context.Context) error {
 		}
 	}
 
+	// the search index has an entry for each group with access to a target.
+	// entries for groups which no longer have access, or for targets which no longer exist, are deleted
+	type searchEntry struct {
+		entry        cache.TargetSearchEntry
+		shouldUpsert bool
+	}
+	searchEntries := map[string]searchEntry{}
+	for _, opt := range existingTargetsQuery.Result {
+		for _, e := range cache.NewTargetSearchEntries(opt) {
+			searchEntries[e.IDPGroupID+"#"+opt.ID()] = searchEntry{entry: e}
+		}
+	}
+	for _, o := range distictTargets {
+		for _, e := range cache.NewTargetSearchEntries(o) {
+			searchEntries[e.IDPGroupID+"#"+o.ID()] = searchEntry{entry: e, shouldUpsert: true}
+		}
+	}
+
 	upsertItems := []ddb.Keyer{}
 	deleteItems := []ddb.Keyer{}
 	for _, v := range targets {
@@ -70,6 +95,14 @@ func (s *Service) RefreshCachedTargets(ctx context.Context) error {
 			deleteItems = append(deleteItems, &cp.target)
 		}
 	}
+	for _, v := range searchEntries {
+		cp := v
+		if v.shouldUpsert {
+			upsertItems = append(upsertItems, &cp.entry)
+		} else {
+			deleteItems = append(deleteItems, &cp.entry)
+		}
+	}
 
 	// Will create or update items
 	err = s.DB.PutBatch(ctx, upsertItems...)
@@ -219,20 +252,26 @@ func WithFallback(value *string, fallback string) string {
 }
 
 // generateDistinctTargets returns a distict map of targets
-func generateDistinctTargets(in resourceAccessRuleMapping, accessRules []rule.AccessRule) []cache.Target {
+// the admin curated metadata is applied to each target, and targets which do not match the metadata filter of the access rule target are excluded
+func generateDistinctTargets(in resourceAccessRuleMapping, accessRules []rule.AccessRule, metadata []target.Metadata) []cache.Target {
 	arMap := make(map[string]rule.AccessRule)
 	for _, ar := range accessRules {
 		arMap[ar.ID] = ar
 	}
+	// sort the metadata so that tags are applied in a consistent order when more than one pattern matches a target
+	sort.Slice(metadata, func(i, j int) bool {
+		return metadata[i].ID < metadata[j].ID
+	})
 	out := make(map[string]cache.Target)
 	for arID, ar := range in {
-		accessRuleTargetGroupsMap := make(map[string]target.Group)
+		accessRuleTargetsMap := make(map[string]rule.Target)
 		for _, accessRuleTarget := range arMap[arID].Targets {
-			accessRuleTargetGroupsMap[accessRuleTarget.TargetGroup.ID] = accessRuleTarget.TargetGroup
+			accessRuleTargetsMap[accessRuleTarget.TargetGroup.ID] = accessRuleTarget
 		}
 		for targetGroupID, targetGroupTargets := range ar {
-			targetGroup := accessRuleTargetGroupsMap[targetGroupID]
-			for _, target := range targetGroupTargets {
+			accessRuleTarget := accessRuleTargetsMap[targetGroupID]
+			targetGroup := accessRuleTarget.TargetGroup
+			for _, resources := range targetGroupTargets {
 				t := cache.Target{
 					Kind: cache.Kind{
 						Publisher: targetGroup.From.Publisher,
@@ -249,7 +288,7 @@ func generateDistinctTargets(in resourceAccessRuleMapping, accessRules []rule.Ac
 				}
 
 				// @TODO populate all the data for field type
-				for k, v := range target {
+				for k, v := range resources {
 					fieldFromSchema := GetSchemaField(targetGroup.Schema, k)
 					t.Fields = append(t.Fields, cache.Field{
 						Value:            v.ID,
@@ -260,6 +299,10 @@ func generateDistinctTargets(in resourceAccessRuleMapping, accessRules []rule.Ac
 						// ValueDescription: *string,
 					})
 				}
+				t.Metadata = target.ApplyMetadata(t, metadata)
+				if !accessRuleTarget.MatchesMetadata(t.Metadata) {
+					continue
+				}
 				o := out[t.ID()]
 				for k, v := range o.AccessRules {
 					a := t.AccessRules[k]
@@ -277,5 +320,9 @@ func generateDistinctTargets(in resourceAccessRuleMapping, accessRules []rule.Ac
 	for _, v := range out {
 		values = append(values, v)
 	}
+	// sort the targets so that the output doesn't depend on map iteration order
+	sort.Slice(values, func(i, j int) bool {
+		return values[i].ID() < values[j].ID()
+	})
 	return values
 }
@@ -77,6 +77,7 @@ func TestGenerateDistinctTargets(t *testing.T) {
 	type args struct {
 		in         map[string]map[string]Targets
 		acessRules []rule.AccessRule
+		metadata   []target.Metadata
 	}
 	tests := []struct {
 		name string
@@ -179,8 +180,58 @@ func TestGenerateDistinctTargets(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := generateDistinctTargets(tt.args.in, tt.args.acessRules)
+			got := generateDistinctTargets(tt.args.in, tt.args.acessRules, tt.args.metadata)
 			assert.Equal(t, tt.want, got)
 		})
 	}
 }
+
+func TestGenerateDistinctTargetsMetadata(t *testing.T) {
+	in := map[string]map[string]Targets{
+		"accessRule_1": {
+			"targetgroup_1": Targets{
+				map[string]cache.Resource{"accountId": {ID: "account_1", Name: "prod-payments"}},
+				map[string]cache.Resource{"accountId": {ID: "account_2", Name: "dev-payments"}},
+			},
+		},
+	}
+	tg := target.Group{ID: "targetgroup_1", From: target.From{Publisher: "common-fate", Name: "aws", Kind: "Account"}}
+	metadata := []target.Metadata{
+		{ID: "tmd_1", FieldPatterns: map[string]string{"accountId": "prod-*"}, Tags: map[string]string{"env": "prod", "team": "payments"}},
+		{ID: "tmd_2", Kind: "common-fate/aws/Account", FieldPatterns: map[string]string{"accountId": "dev-*"}, Tags: map[string]string{"env": "dev"}},
+		// exact target metadata takes precedence over the pattern
+		{ID: "tmd_0", TargetID: "common-fate#aws#Account#accountId#account_1#", Tags: map[string]string{"team": "platform"}},
+	}
+
+	tests := []struct {
+		name   string
+		filter map[string]string
+		want   map[string]map[string]string
+	}{
+		{
+			name: "tags applied",
+			want: map[string]map[string]string{
+				"account_1": {"env": "prod", "team": "platform"},
+				"account_2": {"env": "dev"},
+			},
+		},
+		{
+			name:   "metadata filter excludes targets",
+			filter: map[string]string{"env": "prod"},
+			want: map[string]map[string]string{
+				"account_1": {"env": "prod", "team": "platform"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules := []rule.AccessRule{{ID: "accessRule_1", Targets: []rule.Target{{TargetGroup: tg, MetadataFilter: tt.filter}}}}
+			got := generateDistinctTargets(in, rules, metadata)
+			tags := map[string]map[string]string{}
+			for _, target := range got {
+				tags[target.Fields[0].Value] = target.Metadata
+			}
+			assert.Equal(t, tt.want, tags)
+		})
+	}
+}
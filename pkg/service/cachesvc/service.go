@@ -1,6 +1,10 @@
 package cachesvc
 
 import (
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/common-fate/pkg/gevent"
 	"github.com/common-fate/common-fate/pkg/service/requestroutersvc"
 	"github.com/common-fate/ddb"
 )
@@ -9,4 +13,10 @@ import (
 type Service struct {
 	DB            ddb.Storage
 	RequestRouter *requestroutersvc.Service
+	Clock         clock.Clock
+	// Eventbus is optional, if it is set a notification is sent when a target group's resources become stale
+	Eventbus gevent.EventPutter
+	// StaleThreshold is the maximum time since the last successful sync before a target group's resources are considered stale.
+	// If it is not set, target.DefaultSyncStaleThreshold is used.
+	StaleThreshold time.Duration
 }
@@ -0,0 +1,105 @@
+package cachesvc
+
+import (
+	"context"
+
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/target"
+	"github.com/common-fate/ddb"
+)
+
+// SyncTargetGroup refreshes the cached resources for a target group and records the outcome in the target group's sync status.
+// The sync status is saved even if the refresh fails, in which case the refresh error is returned along with the status.
+// Staleness notifications are sent separately by CheckStaleTargetGroups.
+func (s *Service) SyncTargetGroup(ctx context.Context, tg target.Group) (*target.SyncStatus, error) {
+	q := storage.GetTargetGroupSyncStatus{TargetGroupID: tg.ID}
+	_, err := s.DB.Query(ctx, &q)
+	if err != nil && err != ddb.ErrNoItems {
+		return nil, err
+	}
+	status := q.Result
+	if status == nil {
+		status = &target.SyncStatus{TargetGroupID: tg.ID, ResourceCounts: map[string]int{}}
+	}
+
+	now := s.Clock.Now()
+	status.LastAttemptAt = &now
+	status.StaleThreshold = s.StaleThreshold
+	if status.StaleThreshold <= 0 {
+		status.StaleThreshold = target.DefaultSyncStaleThreshold
+	}
+
+	resources, refreshErr := s.refreshCachedTargetGroupResources(ctx, tg)
+	if refreshErr != nil {
+		msg := refreshErr.Error()
+		status.LastError = &msg
+	} else {
+		status.LastError = nil
+		status.LastSuccessAt = &now
+		status.ResourceCounts = map[string]int{}
+		for _, r := range resources {
+			status.ResourceCounts[r.ResourceType]++
+		}
+	}
+
+	err = s.DB.Put(ctx, status)
+	if err != nil {
+		return nil, err
+	}
+	return status, refreshErr
+}
+
+// CheckStaleTargetGroups sends a notification event for each target group whose resources have become stale.
+// It runs on its own schedule rather than as part of a sync, so that a notification is still sent if syncs stop running altogether.
+// A notification is sent once for each stale period, until the target group syncs successfully again.
+func (s *Service) CheckStaleTargetGroups(ctx context.Context) error {
+	if s.Eventbus == nil {
+		return nil
+	}
+	log := logger.Get(ctx)
+	q := storage.ListTargetGroupSyncStatuses{}
+	err := s.DB.All(ctx, &q)
+	if err != nil {
+		return err
+	}
+
+	now := s.Clock.Now()
+	for _, status := range q.Result {
+		if s.StaleThreshold > 0 {
+			status.StaleThreshold = s.StaleThreshold
+		}
+		if !status.IsStale(now) {
+			continue
+		}
+
+		notification := target.SyncStaleNotification{
+			TargetGroupID: status.TargetGroupID,
+			LastSuccessAt: status.LastSuccessAt,
+			NotifiedAt:    now,
+			ExpiresAt:     now.Add(target.SyncStaleNotificationRetention).Unix(),
+		}
+		created, err := storage.PutIfNotExists(ctx, s.DB, &notification, now)
+		if err != nil {
+			return err
+		}
+		if !created {
+			// a notification has already been sent since the last successful sync
+			continue
+		}
+
+		err = s.Eventbus.Put(ctx, gevent.TargetGroupSyncStale{SyncStatus: status})
+		if err != nil {
+			log.Errorw("failed to send target group sync stale event", "targetgroup", status.TargetGroupID, "error", err)
+			// remove the record so that the notification is retried on the next check
+			_, err = storage.DeleteIfExists(ctx, s.DB, &notification)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		log.Infow("sent target group sync stale event", "targetgroup", status.TargetGroupID)
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+package cachesvc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/benbjohnson/clock"
+	eventmock "github.com/common-fate/common-fate/pkg/eventhandler/mocks"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/target"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockClient is embedded with a different name, as the Client field would hide the Client method of ddb.Storage
+type mockClient = ddbmock.Client
+
+// notificationDB stores the stale notifications which are written with conditional writes in a fake DynamoDB endpoint.
+type notificationDB struct {
+	*mockClient
+	client *dynamodb.Client
+
+	mu     sync.Mutex
+	stored map[string]bool
+}
+
+func newNotificationDB(t *testing.T) *notificationDB {
+	db := &notificationDB{mockClient: ddbmock.New(t), stored: map[string]bool{}}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var in struct {
+			Key  struct{ SK struct{ S string } }
+			Item struct{ SK struct{ S string } }
+		}
+		_ = json.NewDecoder(r.Body).Decode(&in)
+
+		db.mu.Lock()
+		defer db.mu.Unlock()
+		w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+		switch r.Header.Get("X-Amz-Target") {
+		case "DynamoDB_20120810.PutItem":
+			if db.stored[in.Item.SK.S] {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"__type":"com.amazonaws.dynamodb.v20120810#ConditionalCheckFailedException","message":"The conditional request failed"}`))
+				return
+			}
+			db.stored[in.Item.SK.S] = true
+		case "DynamoDB_20120810.DeleteItem":
+			delete(db.stored, in.Key.SK.S)
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(srv.Close)
+	db.client = dynamodb.New(dynamodb.Options{
+		Region:           "us-east-1",
+		Credentials:      aws.AnonymousCredentials{},
+		EndpointResolver: dynamodb.EndpointResolverFromURL(srv.URL),
+		Retryer:          aws.NopRetryer{},
+	})
+	return db
+}
+
+func (d *notificationDB) Client() *dynamodb.Client {
+	return d.client
+}
+
+func TestCheckStaleTargetGroups(t *testing.T) {
+	clk := clock.NewMock()
+	clk.Set(time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC))
+	recent := clk.Now().Add(-10 * time.Minute)
+	old := clk.Now().Add(-2 * time.Hour)
+	syncErr := "provider unavailable"
+	statuses := []target.SyncStatus{
+		{TargetGroupID: "fresh", LastAttemptAt: &recent, LastSuccessAt: &recent},
+		{TargetGroupID: "stale", LastAttemptAt: &recent, LastSuccessAt: &old, LastError: &syncErr},
+		{TargetGroupID: "never-synced", LastAttemptAt: &recent, LastError: &syncErr},
+	}
+
+	ctrl := gomock.NewController(t)
+	eb := eventmock.NewMockEventPutter(ctrl)
+	db := newNotificationDB(t)
+	s := Service{DB: db, Clock: clk, Eventbus: eb}
+
+	var sent []string
+	eb.EXPECT().Put(gomock.Any(), gomock.AssignableToTypeOf(gevent.TargetGroupSyncStale{})).DoAndReturn(func(ctx context.Context, e gevent.TargetGroupSyncStale) error {
+		sent = append(sent, e.SyncStatus.TargetGroupID)
+		return nil
+	}).Times(2)
+
+	db.MockQuery(&storage.ListTargetGroupSyncStatuses{Result: statuses})
+	err := s.CheckStaleTargetGroups(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.ElementsMatch(t, []string{"stale", "never-synced"}, sent)
+
+	// the target groups are still stale, but they have already been notified
+	clk.Add(15 * time.Minute)
+	db.MockQuery(&storage.ListTargetGroupSyncStatuses{Result: statuses})
+	err = s.CheckStaleTargetGroups(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, sent, 2)
+}
+
+func TestCheckStaleTargetGroupsRetriesFailedNotification(t *testing.T) {
+	clk := clock.NewMock()
+	old := clk.Now().Add(-2 * time.Hour)
+	statuses := []target.SyncStatus{
+		{TargetGroupID: "stale", LastAttemptAt: &old, LastSuccessAt: &old},
+	}
+
+	ctrl := gomock.NewController(t)
+	eb := eventmock.NewMockEventPutter(ctrl)
+	db := newNotificationDB(t)
+	s := Service{DB: db, Clock: clk, Eventbus: eb}
+
+	gomock.InOrder(
+		eb.EXPECT().Put(gomock.Any(), gomock.AssignableToTypeOf(gevent.TargetGroupSyncStale{})).Return(errors.New("eventbridge unavailable")),
+		eb.EXPECT().Put(gomock.Any(), gomock.AssignableToTypeOf(gevent.TargetGroupSyncStale{})).Return(nil),
+	)
+
+	for i := 0; i < 2; i++ {
+		db.MockQuery(&storage.ListTargetGroupSyncStatuses{Result: statuses})
+		err := s.CheckStaleTargetGroups(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	assert.Len(t, db.stored, 1)
+}
@@ -17,12 +17,17 @@ import (
 // To prevent an extended period of time where options are unavailable, we only update the items, and delete any that are no longer present (fixes SOL-35)
 // return true if options were refetched, false if they were already cached
 func (s *Service) RefreshCachedTargetGroupResources(ctx context.Context, tg target.Group) error {
+	_, err := s.refreshCachedTargetGroupResources(ctx, tg)
+	return err
+}
 
+// refreshCachedTargetGroupResources returns the fresh resources which were fetched for the target group
+func (s *Service) refreshCachedTargetGroupResources(ctx context.Context, tg target.Group) ([]cache.TargetGroupResource, error) {
 	cachedResources := storage.ListCachedTargetGroupResourceForTargetGroup{TargetGroupID: tg.ID}
 
 	err := s.DB.All(ctx, &cachedResources)
 	if err != nil && err != ddb.ErrNoItems {
-		return err
+		return nil, err
 	}
 
 	type resource struct {
@@ -40,7 +45,7 @@ func (s *Service) RefreshCachedTargetGroupResources(ctx context.Context, tg targ
 
 	freshResources, err := s.fetchResources(ctx, tg)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	for _, o := range freshResources {
 		resources[o.UniqueKey()] = resource{
@@ -63,16 +68,16 @@ func (s *Service) RefreshCachedTargetGroupResources(ctx context.Context, tg targ
 	// Will create or update items
 	err = s.DB.PutBatch(ctx, upsertItems...)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Only deletes items that no longer exist
 	err = s.DB.DeleteBatch(ctx, deleteItems...)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return freshResources, nil
 }
 
 func (s *Service) fetchResources(ctx context.Context, tg target.Group) ([]cache.TargetGroupResource, error) {
@@ -0,0 +1,143 @@
+// Package deadlettersvc lists, replays and discards events which subscribers failed to handle.
+package deadlettersvc
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+)
+
+var ErrDeadLetterEventNotFound = errors.New("dead letter event not found")
+
+type EventPutter interface {
+	Put(ctx context.Context, detail gevent.EventTyper) error
+}
+
+type Service struct {
+	DB       ddb.Storage
+	Eventbus EventPutter
+}
+
+type ListOpts struct {
+	// RequestID only lists the events for the request, if it is set
+	RequestID string
+	// Subscriber only lists the events which the subscriber failed to handle, if it is set
+	Subscriber string
+}
+
+// List returns the dead letter events, most recent failure first.
+// Events for a request or a subscriber are queried from an index, rather than listing every event.
+func (s *Service) List(ctx context.Context, opts ListOpts) ([]access.DeadLetterEvent, error) {
+	if opts.RequestID != "" {
+		q := storage.ListDeadLetterEventsForOrderingKey{OrderingKey: gevent.RequestOrderingKey(opts.RequestID)}
+		err := s.DB.All(ctx, &q)
+		if err != nil {
+			return nil, err
+		}
+		// a request has few events, so they are filtered by subscriber here rather than with a second index
+		var res []access.DeadLetterEvent
+		for _, e := range q.Result {
+			if opts.Subscriber == "" || e.Subscriber == opts.Subscriber {
+				res = append(res, e)
+			}
+		}
+		return res, nil
+	}
+	if opts.Subscriber != "" {
+		q := storage.ListDeadLetterEventsForSubscriber{Subscriber: opts.Subscriber}
+		err := s.DB.All(ctx, &q)
+		if err != nil {
+			return nil, err
+		}
+		return q.Result, nil
+	}
+
+	q := storage.ListDeadLetterEvents{}
+	err := s.DB.All(ctx, &q)
+	if err != nil {
+		return nil, err
+	}
+	res := q.Result
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].LastFailedAt.After(res[j].LastFailedAt)
+	})
+	return res, nil
+}
+
+func (s *Service) Get(ctx context.Context, id string) (*access.DeadLetterEvent, error) {
+	q := storage.GetDeadLetterEvent{ID: id}
+	_, err := s.DB.Query(ctx, &q)
+	if err == ddb.ErrNoItems {
+		return nil, ErrDeadLetterEventNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return q.Result, nil
+}
+
+// Discard deletes a dead letter event without handling it again.
+func (s *Service) Discard(ctx context.Context, id string) error {
+	e, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	return s.DB.Delete(ctx, e)
+}
+
+// Replay sends a dead letter event again to the subscriber which failed to handle it.
+// The dead letter event is removed once the subscriber has handled it, and is updated with the new error if it fails again.
+func (s *Service) Replay(ctx context.Context, id string) error {
+	e, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	return s.replay(ctx, *e)
+}
+
+// ReplayRequest replays the dead letter events for a request in the order they first failed,
+// so that the handlers can repair the state of the request. It returns the events which were replayed.
+func (s *Service) ReplayRequest(ctx context.Context, requestID string) ([]access.DeadLetterEvent, error) {
+	events, err := s.List(ctx, ListOpts{RequestID: requestID})
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].FirstFailedAt.Before(events[j].FirstFailedAt)
+	})
+	for _, e := range events {
+		err = s.replay(ctx, e)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return events, nil
+}
+
+func (s *Service) replay(ctx context.Context, e access.DeadLetterEvent) error {
+	evt, err := gevent.Parse(e.DetailType, []byte(e.Detail))
+	if err != nil {
+		return InvalidEventError{ID: e.ID, Err: err}
+	}
+	return s.Eventbus.Put(ctx, gevent.Replay{Event: evt, Subscriber: e.Subscriber, DeadLetterID: e.ID})
+}
+
+// InvalidEventError is returned when replaying an event which can't be parsed, such as an event type which no longer exists.
+// These events can only be discarded.
+type InvalidEventError struct {
+	ID  string
+	Err error
+}
+
+func (e InvalidEventError) Error() string {
+	return "can't replay dead letter event " + e.ID + ": " + e.Err.Error()
+}
+
+func (e InvalidEventError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,175 @@
+package deadlettersvc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	eventmock "github.com/common-fate/common-fate/pkg/eventhandler/mocks"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplayRequest(t *testing.T) {
+	start := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+	created := access.DeadLetterEvent{
+		ID:            "eventHandler_1",
+		Subscriber:    gevent.EventHandlerSubscriber,
+		OrderingKey:   gevent.RequestOrderingKey("req_1"),
+		DetailType:    gevent.RequestCreatedType,
+		Detail:        `{"request":{"request":{"id":"req_1"}},"detailType":"request.created"}`,
+		FirstFailedAt: start,
+		LastFailedAt:  start.Add(time.Hour),
+	}
+	revoked := access.DeadLetterEvent{
+		ID:            "slackNotifier_2",
+		Subscriber:    gevent.SlackNotifierSubscriber,
+		OrderingKey:   gevent.RequestOrderingKey("req_1"),
+		DetailType:    gevent.RequestRevokeCompletedType,
+		Detail:        `{"request":{"id":"req_1"},"detailType":"request.revoke.completed"}`,
+		FirstFailedAt: start.Add(time.Minute),
+		LastFailedAt:  start.Add(time.Minute),
+	}
+
+	ctrl := gomock.NewController(t)
+	db := ddbmock.New(t)
+	// the events for the request are queried by their ordering key, most recent failure first
+	db.MockQuery(&storage.ListDeadLetterEventsForOrderingKey{Result: []access.DeadLetterEvent{created, revoked}})
+	eb := eventmock.NewMockEventPutter(ctrl)
+	// events are replayed in the order they first failed, to the subscriber which failed to handle them
+	gomock.InOrder(
+		eb.EXPECT().Put(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, e gevent.EventTyper) error {
+			r := e.(gevent.Replay)
+			assert.Equal(t, gevent.EventHandlerSubscriber, r.Subscriber)
+			assert.Equal(t, "eventHandler_1", r.DeadLetterID)
+			assert.Equal(t, "req_1", r.Event.(gevent.RequestCreated).Request.Request.ID)
+			return nil
+		}),
+		eb.EXPECT().Put(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, e gevent.EventTyper) error {
+			r := e.(gevent.Replay)
+			assert.Equal(t, gevent.SlackNotifierSubscriber, r.Subscriber)
+			assert.Equal(t, "slackNotifier_2", r.DeadLetterID)
+			assert.Equal(t, gevent.RequestRevokeCompletedType, r.EventType())
+			return nil
+		}),
+	)
+
+	s := Service{DB: db, Eventbus: eb}
+	got, err := s.ReplayRequest(context.Background(), "req_1")
+	assert.NoError(t, err)
+	assert.Equal(t, []access.DeadLetterEvent{created, revoked}, got)
+}
+
+func TestList(t *testing.T) {
+	failedAt := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+	handler := access.DeadLetterEvent{ID: "eventHandler_1", Subscriber: gevent.EventHandlerSubscriber, LastFailedAt: failedAt}
+	notifier := access.DeadLetterEvent{ID: "slackNotifier_2", Subscriber: gevent.SlackNotifierSubscriber, LastFailedAt: failedAt.Add(time.Minute)}
+
+	type testcase struct {
+		name string
+		give ListOpts
+		// mock is the query which the events are expected to be listed with
+		mock ddb.QueryBuilder
+		want []access.DeadLetterEvent
+	}
+
+	testcases := []testcase{
+		{
+			name: "all events are sorted by most recent failure",
+			mock: &storage.ListDeadLetterEvents{Result: []access.DeadLetterEvent{handler, notifier}},
+			want: []access.DeadLetterEvent{notifier, handler},
+		},
+		{
+			name: "by request",
+			give: ListOpts{RequestID: "req_1"},
+			mock: &storage.ListDeadLetterEventsForOrderingKey{Result: []access.DeadLetterEvent{notifier, handler}},
+			want: []access.DeadLetterEvent{notifier, handler},
+		},
+		{
+			name: "by request and subscriber",
+			give: ListOpts{RequestID: "req_1", Subscriber: gevent.EventHandlerSubscriber},
+			mock: &storage.ListDeadLetterEventsForOrderingKey{Result: []access.DeadLetterEvent{notifier, handler}},
+			want: []access.DeadLetterEvent{handler},
+		},
+		{
+			name: "by subscriber",
+			give: ListOpts{Subscriber: gevent.SlackNotifierSubscriber},
+			mock: &storage.ListDeadLetterEventsForSubscriber{Result: []access.DeadLetterEvent{notifier}},
+			want: []access.DeadLetterEvent{notifier},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQuery(tc.mock)
+
+			s := Service{DB: db}
+			got, err := s.List(context.Background(), tc.give)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestReplay(t *testing.T) {
+	type testcase struct {
+		name    string
+		give    *access.DeadLetterEvent
+		wantPut bool
+		wantErr error
+	}
+
+	testcases := []testcase{
+		{
+			name: "ok",
+			give: &access.DeadLetterEvent{
+				ID:         "eventHandler_1",
+				Subscriber: gevent.EventHandlerSubscriber,
+				DetailType: gevent.RequestCreatedType,
+				Detail:     `{"request":{"id":"req_1"}}`,
+			},
+			wantPut: true,
+		},
+		{
+			name:    "not found",
+			wantErr: ErrDeadLetterEventNotFound,
+		},
+		{
+			name: "unknown event type",
+			give: &access.DeadLetterEvent{
+				ID:         "eventHandler_1",
+				Subscriber: gevent.EventHandlerSubscriber,
+				DetailType: "request.removed",
+				Detail:     `{}`,
+			},
+			wantErr: InvalidEventError{ID: "eventHandler_1", Err: errors.New("unknown event type request.removed")},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			db := ddbmock.New(t)
+			if tc.give != nil {
+				db.MockQuery(&storage.GetDeadLetterEvent{Result: tc.give})
+			} else {
+				db.MockQueryWithErr(&storage.GetDeadLetterEvent{}, ddb.ErrNoItems)
+			}
+			eb := eventmock.NewMockEventPutter(ctrl)
+			if tc.wantPut {
+				eb.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			s := Service{DB: db, Eventbus: eb}
+			err := s.Replay(context.Background(), "eventHandler_1")
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
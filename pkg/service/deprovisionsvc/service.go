@@ -0,0 +1,143 @@
+// Package deprovisionsvc cleans up the access of users who are archived by identity sync.
+package deprovisionsvc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/service/bulkrevokesvc"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+)
+
+// Actor is recorded as the creator of the bulk revoke jobs and in the request history,
+// as deprovisioning is done by identity sync rather than by a user.
+var Actor = identity.User{
+	ID:        "identity-sync",
+	Email:     "identity-sync",
+	FirstName: "Identity",
+	LastName:  "Sync",
+}
+
+type EventPutter interface {
+	Put(ctx context.Context, detail gevent.EventTyper) error
+}
+
+type BulkRevoker interface {
+	CreateJob(ctx context.Context, user identity.User, opts bulkrevokesvc.CreateJobOpts) (*access.BulkRevokeJob, []access.BulkRevokeJobItem, error)
+}
+
+type Service struct {
+	DB         ddb.Storage
+	Clock      clock.Clock
+	Eventbus   EventPutter
+	BulkRevoke BulkRevoker
+	Mode       identity.DeprovisioningMode
+}
+
+// Deprovision revokes the active requests of a user who has been archived, cancels their pending requests,
+// and removes them as a reviewer of pending requests.
+// Active and pending requests are handled by a bulk revoke job, which is a dry run in report-only mode.
+// A UserDeprovisioned event is emitted with the requests which were, or in report-only mode would have been, changed.
+func (s *Service) Deprovision(ctx context.Context, user identity.User) error {
+	log := logger.Get(ctx).With("user.id", user.ID, "mode", s.Mode)
+	report := s.Mode != identity.DeprovisioningModeEnforce
+
+	job, items, err := s.BulkRevoke.CreateJob(ctx, Actor, bulkrevokesvc.CreateJobOpts{
+		Kind:   types.USER,
+		Filter: access.BulkRevokeFilter{UserID: user.ID},
+		DryRun: report,
+	})
+	if err != nil {
+		return err
+	}
+	evt := gevent.UserDeprovisioned{
+		User:                    gevent.UserFromIdentityUser(user),
+		Mode:                    s.Mode,
+		BulkRevokeJobID:         job.ID,
+		RevokedRequests:         []string{},
+		CancelledRequests:       []string{},
+		ReviewerRemovedRequests: []string{},
+	}
+	for _, item := range items {
+		switch item.Action {
+		case types.REVOKEREQUEST:
+			evt.RevokedRequests = append(evt.RevokedRequests, item.RequestID)
+		case types.CANCELREQUEST:
+			evt.CancelledRequests = append(evt.CancelledRequests, item.RequestID)
+		}
+	}
+
+	q := storage.ListRequestWithGroupsWithTargetsForReviewer{ReviewerID: user.ID}
+	err = s.DB.All(ctx, &q)
+	if err != nil {
+		return err
+	}
+	for _, r := range q.Result {
+		// the user's own requests are cancelled by the bulk revoke job
+		if r.Request.RequestStatus != types.PENDING || r.Request.RequestedBy.ID == user.ID {
+			continue
+		}
+		if !report {
+			err = s.removeReviewer(ctx, r, user)
+			if errors.Is(err, access.ErrStaleStatus) {
+				// the request was reviewed or cancelled since it was listed, so the user is no longer needed as a reviewer
+				log.Infow("request is no longer pending, reviewer was not removed", "request.id", r.Request.ID)
+				continue
+			}
+			if err != nil {
+				return err
+			}
+		}
+		evt.ReviewerRemovedRequests = append(evt.ReviewerRemovedRequests, r.Request.ID)
+	}
+
+	log.Infow("deprovisioned user", "event", evt)
+	return s.Eventbus.Put(ctx, evt)
+}
+
+// removeReviewer removes the user from the reviewers of a request and records it in the request history.
+// Only the reviewers are written, and access.ErrStaleStatus is returned if the request is no longer pending.
+func (s *Service) removeReviewer(ctx context.Context, request access.RequestWithGroupsWithTargets, user identity.User) error {
+	request.Request.RequestReviewers = without(request.Request.RequestReviewers, user.ID)
+	for i := range request.Groups {
+		group := &request.Groups[i]
+		group.Group.RequestReviewers = without(group.Group.RequestReviewers, user.ID)
+		group.Group.GroupReviewers = without(group.Group.GroupReviewers, user.ID)
+		for j := range group.Targets {
+			group.Targets[j].RequestReviewers = without(group.Targets[j].RequestReviewers, user.ID)
+		}
+	}
+	history := access.NewRecordedEvent(request.Request.ID, &Actor.ID, s.Clock.Now(), map[string]string{
+		"action":   "reviewer.removed",
+		"reviewer": user.Email,
+		"reason":   "the reviewer was deprovisioned by identity sync",
+	})
+	err := storage.UpdateReviewers(ctx, s.DB, request,
+		ddb.TransactWriteItem{Put: &history},
+		ddb.TransactWriteItem{Delete: &access.Reviewer{ReviewerID: user.ID, RequestID: request.Request.ID}},
+	)
+	if err != nil {
+		return err
+	}
+	return s.Eventbus.Put(ctx, gevent.ReviewerRemoved{
+		RequestID: request.Request.ID,
+		Reviewer:  gevent.UserFromIdentityUser(user),
+	})
+}
+
+func without(ids []string, id string) []string {
+	var res []string
+	for _, v := range ids {
+		if v != id {
+			res = append(res, v)
+		}
+	}
+	return res
+}
@@ -0,0 +1,222 @@
+package deprovisionsvc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/common-fate/pkg/access"
+	eventmock "github.com/common-fate/common-fate/pkg/eventhandler/mocks"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/service/bulkrevokesvc"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeprovision(t *testing.T) {
+	user := identity.User{ID: "usr_1", Email: "alice@example.com"}
+	userRequests := []access.RequestWithGroupsWithTargets{
+		{Request: access.Request{ID: "req_active", RequestStatus: types.ACTIVE, RequestedBy: access.RequestedBy{ID: "usr_1"}}},
+		{Request: access.Request{ID: "req_pending", RequestStatus: types.PENDING, RequestedBy: access.RequestedBy{ID: "usr_1"}}},
+		{Request: access.Request{ID: "req_complete", RequestStatus: types.COMPLETE, RequestedBy: access.RequestedBy{ID: "usr_1"}}},
+	}
+	reviewerRequests := []access.RequestWithGroupsWithTargets{
+		{
+			Request: access.Request{ID: "req_review", RequestStatus: types.PENDING, RequestedBy: access.RequestedBy{ID: "usr_2"}, RequestReviewers: []string{"usr_1", "usr_3"}},
+			Groups: []access.GroupWithTargets{
+				{Group: access.Group{ID: "grp_1", RequestReviewers: []string{"usr_1", "usr_3"}, GroupReviewers: []string{"usr_1"}}},
+			},
+		},
+		{Request: access.Request{ID: "req_reviewed", RequestStatus: types.ACTIVE, RequestedBy: access.RequestedBy{ID: "usr_2"}, RequestReviewers: []string{"usr_1"}}},
+	}
+
+	type testcase struct {
+		name string
+		mode identity.DeprovisioningMode
+		// reviewed is true if the pending request is reviewed before the reviewer is removed
+		reviewed bool
+		// wantEvents are the types of the events which are emitted, in order
+		wantEvents          []string
+		wantReviewerRemoved []string
+	}
+	testcases := []testcase{
+		{
+			name:                "enforce",
+			mode:                identity.DeprovisioningModeEnforce,
+			wantEvents:          []string{gevent.BulkRevokeJobCreatedType, gevent.ReviewerRemovedType, gevent.UserDeprovisionedType},
+			wantReviewerRemoved: []string{"req_review"},
+		},
+		{
+			name:                "report only",
+			mode:                identity.DeprovisioningModeReport,
+			wantEvents:          []string{gevent.UserDeprovisionedType},
+			wantReviewerRemoved: []string{"req_review"},
+		},
+		{
+			name:                "request reviewed since it was listed",
+			mode:                identity.DeprovisioningModeEnforce,
+			reviewed:            true,
+			wantEvents:          []string{gevent.BulkRevokeJobCreatedType, gevent.UserDeprovisionedType},
+			wantReviewerRemoved: []string{},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := newTestDB(t, tc.reviewed)
+			db.MockQuery(&storage.ListRequestWithGroupsWithTargetsForUser{Result: userRequests})
+			db.MockQuery(&storage.ListRequestWithGroupsWithTargetsForReviewer{Result: reviewerRequests})
+			ctrl := gomock.NewController(t)
+			ep := eventmock.NewMockEventPutter(ctrl)
+			var got []gevent.EventTyper
+			ep.EXPECT().Put(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, e gevent.EventTyper) error {
+				got = append(got, e)
+				return nil
+			}).AnyTimes()
+			clk := clock.NewMock()
+			s := Service{
+				DB:         db,
+				Clock:      clk,
+				Eventbus:   ep,
+				BulkRevoke: &bulkrevokesvc.Service{DB: db, Clock: clk, Eventbus: ep},
+				Mode:       tc.mode,
+			}
+
+			err := s.Deprovision(context.Background(), user)
+			assert.NoError(t, err)
+
+			var gotTypes []string
+			for _, e := range got {
+				gotTypes = append(gotTypes, e.EventType())
+			}
+			assert.Equal(t, tc.wantEvents, gotTypes)
+
+			deprovisioned, ok := got[len(got)-1].(gevent.UserDeprovisioned)
+			if assert.True(t, ok) {
+				assert.Equal(t, tc.mode, deprovisioned.Mode)
+				assert.NotEmpty(t, deprovisioned.BulkRevokeJobID)
+				assert.Equal(t, []string{"req_active"}, deprovisioned.RevokedRequests)
+				assert.Equal(t, []string{"req_pending"}, deprovisioned.CancelledRequests)
+				assert.Equal(t, tc.wantReviewerRemoved, deprovisioned.ReviewerRemovedRequests)
+			}
+		})
+	}
+}
+
+func TestRemoveReviewer(t *testing.T) {
+	request := access.RequestWithGroupsWithTargets{
+		Request: access.Request{ID: "req_review", RequestStatus: types.PENDING, RequestReviewers: []string{"usr_1", "usr_3"}},
+		Groups: []access.GroupWithTargets{
+			{
+				Group:   access.Group{ID: "grp_1", RequestID: "req_review", RequestStatus: types.PENDING, RequestReviewers: []string{"usr_1", "usr_3"}, GroupReviewers: []string{"usr_1"}},
+				Targets: []access.GroupTarget{{ID: "gta_1", GroupID: "grp_1", RequestID: "req_review", RequestStatus: types.PENDING, RequestReviewers: []string{"usr_1", "usr_3"}}},
+			},
+		},
+	}
+	user := identity.User{ID: "usr_1", Email: "alice@example.com"}
+
+	t.Run("ok", func(t *testing.T) {
+		db := newTestDB(t, false)
+		ctrl := gomock.NewController(t)
+		ep := eventmock.NewMockEventPutter(ctrl)
+		ep.EXPECT().Put(gomock.Any(), gevent.ReviewerRemoved{RequestID: "req_review", Reviewer: gevent.User{ID: "usr_1", Email: "alice@example.com"}}).Return(nil)
+		s := Service{DB: db, Clock: clock.NewMock(), Eventbus: ep}
+
+		err := s.removeReviewer(context.Background(), request, user)
+		assert.NoError(t, err)
+
+		if !assert.Len(t, db.transactions, 1) {
+			return
+		}
+		items := db.transactions[0].TransactItems
+		if !assert.Len(t, items, 5) {
+			return
+		}
+		// only the reviewers of the request, group and target are updated, on the condition that they are still pending
+		for _, item := range items[:3] {
+			assert.Equal(t, "#status = :status", *item.Update.ConditionExpression)
+			assert.Equal(t, "PENDING", item.Update.ExpressionAttributeValues[":status"]["S"])
+			assert.Equal(t, []any{map[string]any{"S": "usr_3"}}, item.Update.ExpressionAttributeValues[":requestReviewers"]["L"])
+		}
+		assert.Equal(t, true, items[1].Update.ExpressionAttributeValues[":groupReviewers"]["NULL"])
+		assert.Equal(t, "reviewer.removed", items[3].Put.Item["recordedEvent"]["M"].(map[string]any)["action"].(map[string]any)["S"])
+		assert.NotNil(t, items[4].Delete)
+	})
+
+	t.Run("request is no longer pending", func(t *testing.T) {
+		db := newTestDB(t, true)
+		ctrl := gomock.NewController(t)
+		ep := eventmock.NewMockEventPutter(ctrl)
+		s := Service{DB: db, Clock: clock.NewMock(), Eventbus: ep}
+
+		err := s.removeReviewer(context.Background(), request, user)
+		assert.Equal(t, access.ErrStaleStatus, err)
+	})
+}
+
+type mockClient = ddbmock.Client
+
+// testDB records the transactions which are written to a fake DynamoDB endpoint, as the mock client doesn't have a DynamoDB client
+type testDB struct {
+	*mockClient
+	client       *dynamodb.Client
+	transactions []transaction
+}
+
+// transaction is the request body of a TransactWriteItems call
+type transaction struct {
+	TransactItems []struct {
+		Put *struct {
+			Item map[string]map[string]any
+		}
+		Update *struct {
+			UpdateExpression          *string
+			ConditionExpression       *string
+			ExpressionAttributeValues map[string]map[string]any
+		}
+		Delete *struct {
+			Key map[string]map[string]any
+		}
+	}
+}
+
+func (d *testDB) Client() *dynamodb.Client {
+	return d.client
+}
+
+// newTestDB returns a database which records transactions, and fails their conditions if conditionFailed is true.
+func newTestDB(t *testing.T, conditionFailed bool) *testDB {
+	db := &testDB{mockClient: ddbmock.New(t)}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var tx transaction
+		err := json.NewDecoder(r.Body).Decode(&tx)
+		if err != nil {
+			t.Error(err)
+		}
+		db.transactions = append(db.transactions, tx)
+		w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+		if conditionFailed {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"__type":"com.amazonaws.dynamodb.v20120810#TransactionCanceledException","message":"Transaction cancelled","CancellationReasons":[{"Code":"ConditionalCheckFailed"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(srv.Close)
+	db.client = dynamodb.New(dynamodb.Options{
+		Region:           "us-east-1",
+		Credentials:      aws.AnonymousCredentials{},
+		EndpointResolver: dynamodb.EndpointResolverFromURL(srv.URL),
+		Retryer:          aws.NopRetryer{},
+	})
+	return db
+}
@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/common-fate/common-fate/pkg/service/driftsvc (interfaces: Runtime)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	handler "github.com/common-fate/common-fate/pkg/handler"
+	msg "github.com/common-fate/provider-registry-sdk-go/pkg/msg"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRuntime is a mock of Runtime interface.
+type MockRuntime struct {
+	ctrl     *gomock.Controller
+	recorder *MockRuntimeMockRecorder
+}
+
+// MockRuntimeMockRecorder is the mock recorder for MockRuntime.
+type MockRuntimeMockRecorder struct {
+	mock *MockRuntime
+}
+
+// NewMockRuntime creates a new mock instance.
+func NewMockRuntime(ctrl *gomock.Controller) *MockRuntime {
+	mock := &MockRuntime{ctrl: ctrl}
+	mock.recorder = &MockRuntimeMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRuntime) EXPECT() *MockRuntimeMockRecorder {
+	return m.recorder
+}
+
+// Revoke mocks base method.
+func (m *MockRuntime) Revoke(arg0 context.Context, arg1 msg.Revoke) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockRuntimeMockRecorder) Revoke(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockRuntime)(nil).Revoke), arg0, arg1)
+}
+
+// Verify mocks base method.
+func (m *MockRuntime) Verify(arg0 context.Context, arg1 handler.Verify) (*handler.VerifyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", arg0, arg1)
+	ret0, _ := ret[0].(*handler.VerifyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockRuntimeMockRecorder) Verify(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockRuntime)(nil).Verify), arg0, arg1)
+}
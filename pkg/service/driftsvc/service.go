@@ -0,0 +1,265 @@
+package driftsvc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/handler"
+	"github.com/common-fate/common-fate/pkg/service/requestroutersvc"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/provider-registry-sdk-go/pkg/handlerclient"
+	"github.com/common-fate/provider-registry-sdk-go/pkg/msg"
+)
+
+//go:generate go run github.com/golang/mock/mockgen -destination=mocks/runtime.go -package=mocks . Runtime
+type Runtime interface {
+	Verify(ctx context.Context, req handler.Verify) (*handler.VerifyResponse, error)
+	Revoke(ctx context.Context, req msg.Revoke) error
+}
+
+type RuntimeGetter interface {
+	GetRuntime(ctx context.Context, handler handler.Handler) (Runtime, error)
+}
+
+type DefaultGetter struct {
+}
+
+func (DefaultGetter) GetRuntime(ctx context.Context, h handler.Handler) (Runtime, error) {
+	c, err := handler.GetRuntime(ctx, h)
+	if err != nil {
+		return nil, err
+	}
+	return handlerRuntime{Client: c}, nil
+}
+
+type handlerRuntime struct {
+	*handlerclient.Client
+}
+
+func (r handlerRuntime) Verify(ctx context.Context, req handler.Verify) (*handler.VerifyResponse, error) {
+	return handler.VerifyGrant(ctx, r.Client, req)
+}
+
+type EventPutter interface {
+	Put(ctx context.Context, detail gevent.EventTyper) error
+}
+
+// Service compares grants with the access reported by their handlers.
+type Service struct {
+	DB            ddb.Storage
+	RequestRouter *requestroutersvc.Service
+	// Use DefaultGetter{}
+	// This is interfaced so it can be mocked for testing
+	RuntimeGetter RuntimeGetter
+	Clock         clock.Clock
+	// Eventbus is optional, it is used to expire active grants which outlived their end time
+	Eventbus EventPutter
+	// Lookback is how long after a grant ends that it continues to be checked
+	Lookback time.Duration
+}
+
+// maxGrantDuration is the longest duration which access rules allow.
+// Requests created longer than this before the lookback window can't have a grant which ended within it.
+const maxGrantDuration = 26 * 7 * 24 * time.Hour
+
+// Reconcile checks active grants, and grants which expired or were revoked within the lookback window.
+// Access which outlived a grant is revoked, and access which was removed before the grant ended is recorded.
+// Errors for individual grants are logged so that the remaining grants are still checked.
+func (s *Service) Reconcile(ctx context.Context) error {
+	log := logger.Get(ctx)
+	now := s.Clock.Now()
+	createdAfter := now.Add(-s.Lookback - maxGrantDuration)
+
+	var grants []access.GroupTarget
+	for _, status := range []types.RequestStatus{types.ACTIVE, types.REVOKING, types.COMPLETE, types.REVOKED} {
+		q := storage.ListRequestWithGroupsWithTargetsForStatus{Status: status}
+		// completed and revoked requests build up over time, so only load the requests which may have a grant in the lookback window
+		if status == types.COMPLETE || status == types.REVOKED {
+			q.CreatedAfter = createdAfter
+		}
+		err := s.DB.All(ctx, &q)
+		if err != nil {
+			return err
+		}
+		for _, r := range q.Result {
+			for _, g := range r.Groups {
+				for _, t := range g.Targets {
+					if s.shouldCheck(t, now) {
+						grants = append(grants, t)
+					}
+				}
+			}
+		}
+	}
+	log.Infow("checking grants for drift", "count", len(grants))
+
+	existing := storage.ListGrantDrifts{}
+	err := s.DB.All(ctx, &existing)
+	if err != nil {
+		return err
+	}
+	recorded := map[string]bool{}
+	for _, d := range existing.Result {
+		recorded[d.GrantID+string(d.Kind)] = true
+	}
+
+	runtimes := map[string]Runtime{}
+	for _, grant := range grants {
+		rt, ok := runtimes[grant.TargetGroupID]
+		if !ok {
+			var err error
+			rt, err = s.runtimeForTargetGroup(ctx, grant.TargetGroupID)
+			if err != nil {
+				log.Errorw("failed to get runtime for target group", "targetgroup", grant.TargetGroupID, "error", err)
+				continue
+			}
+			runtimes[grant.TargetGroupID] = rt
+		}
+
+		drift, err := checkGrant(ctx, rt, grant, now)
+		if errors.Is(err, handler.ErrVerifyNotSupported) {
+			log.Debugw("skipping grant as the handler doesn't support verify", "grant.id", grant.ID)
+			continue
+		}
+		if err != nil {
+			log.Errorw("failed to check grant", "grant.id", grant.ID, "error", err)
+			continue
+		}
+		// missing access is reported on every run until the grant ends, so only record it once
+		if drift == nil || (drift.Kind == types.MISSING && recorded[drift.GrantID+string(drift.Kind)]) {
+			continue
+		}
+		err = s.record(ctx, *drift)
+		if err != nil {
+			log.Errorw("failed to record grant drift", "grant.id", grant.ID, "error", err)
+		}
+	}
+	return nil
+}
+
+// shouldCheck returns true for active grants, and expired or revoked grants which were due to end within the lookback window or later.
+// The time a grant was revoked isn't stored, so revoked grants are checked until the lookback window after their scheduled end.
+func (s *Service) shouldCheck(t access.GroupTarget, now time.Time) bool {
+	if t.Grant == nil {
+		return false
+	}
+	switch t.Grant.Status {
+	case types.RequestAccessGroupTargetStatusACTIVE:
+		return true
+	case types.RequestAccessGroupTargetStatusEXPIRED, types.RequestAccessGroupTargetStatusREVOKED:
+		return t.Grant.End.After(now.Add(-s.Lookback))
+	}
+	return false
+}
+
+func (s *Service) runtimeForTargetGroup(ctx context.Context, targetGroupID string) (Runtime, error) {
+	tgq := storage.GetTargetGroup{ID: targetGroupID}
+	_, err := s.DB.Query(ctx, &tgq)
+	if err != nil {
+		return nil, err
+	}
+	routeResult, err := s.RequestRouter.Route(ctx, *tgq.Result)
+	if err != nil {
+		return nil, err
+	}
+	rt, err := s.RuntimeGetter.GetRuntime(ctx, routeResult.Handler)
+	if err != nil {
+		return nil, err
+	}
+	return routedRuntime{Runtime: rt, kind: routeResult.Route.Kind}, nil
+}
+
+// routedRuntime sets the target kind of the route on requests to the handler.
+type routedRuntime struct {
+	Runtime
+	kind string
+}
+
+func (r routedRuntime) Verify(ctx context.Context, req handler.Verify) (*handler.VerifyResponse, error) {
+	req.Target.Kind = r.kind
+	return r.Runtime.Verify(ctx, req)
+}
+
+func (r routedRuntime) Revoke(ctx context.Context, req msg.Revoke) error {
+	req.Target.Kind = r.kind
+	return r.Runtime.Revoke(ctx, req)
+}
+
+// checkGrant compares a grant with the access reported by the handler, revoking access which outlived the grant.
+// It returns nil if there is no drift.
+func checkGrant(ctx context.Context, rt Runtime, grant access.GroupTarget, now time.Time) (*access.GrantDrift, error) {
+	target := msg.Target{Arguments: grant.FieldsToMap()}
+	res, err := rt.Verify(ctx, handler.Verify{
+		Subject: string(grant.RequestedBy.Email),
+		Target:  target,
+		Request: msg.AccessRequest{ID: grant.ID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// revoked grants have ended, even if they were scheduled to end later
+	ended := !grant.Grant.End.After(now) || grant.Grant.Status == types.RequestAccessGroupTargetStatusREVOKED
+	drift := access.GrantDrift{
+		GrantID:    grant.ID,
+		RequestID:  grant.RequestID,
+		GroupID:    grant.GroupID,
+		DetectedAt: now,
+		Grant:      grant,
+	}
+	switch {
+	case ended && res.Exists:
+		drift.Kind = types.OUTLIVED
+		err = rt.Revoke(ctx, msg.Revoke{
+			Subject: string(grant.RequestedBy.Email),
+			Target:  target,
+			Request: msg.AccessRequest{ID: grant.ID},
+		})
+		if err != nil {
+			e := err.Error()
+			drift.ResolutionError = &e
+		} else {
+			drift.Resolved = true
+		}
+		return &drift, nil
+	case !ended && !res.Exists:
+		drift.Kind = types.MISSING
+		return &drift, nil
+	}
+	return nil, nil
+}
+
+// record saves the drift for the drift report and adds it to the request history.
+func (s *Service) record(ctx context.Context, drift access.GrantDrift) error {
+	detail := map[string]string{
+		"action":  "grant.drift",
+		"grantId": drift.GrantID,
+		"kind":    string(drift.Kind),
+	}
+	if drift.Kind == types.OUTLIVED {
+		if drift.Resolved {
+			detail["resolution"] = "access was revoked"
+		} else if drift.ResolutionError != nil {
+			detail["resolution"] = "failed to revoke access: " + *drift.ResolutionError
+		}
+	}
+	evt := access.NewRecordedEvent(drift.RequestID, nil, drift.DetectedAt, detail)
+	err := s.DB.PutBatch(ctx, &drift, &evt)
+	if err != nil {
+		return err
+	}
+
+	// active grants which outlived their end time were not expired by the runtime, so expire them now
+	if drift.Kind == types.OUTLIVED && drift.Resolved && s.Eventbus != nil &&
+		drift.Grant.Grant.Status == types.RequestAccessGroupTargetStatusACTIVE {
+		return s.Eventbus.Put(ctx, gevent.GrantExpired{Grant: drift.Grant})
+	}
+	return nil
+}
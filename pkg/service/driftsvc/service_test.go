@@ -0,0 +1,143 @@
+package driftsvc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/handler"
+	"github.com/common-fate/common-fate/pkg/service/driftsvc/mocks"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/iso8601"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckGrant(t *testing.T) {
+	now := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+	activeGrant := access.GroupTarget{ID: "gra_1", RequestID: "req_1", GroupID: "grp_1", Grant: &access.Grant{
+		Status: types.RequestAccessGroupTargetStatusACTIVE,
+		Start:  iso8601.New(now.Add(-time.Hour)),
+		End:    iso8601.New(now.Add(time.Hour)),
+	}}
+	expiredGrant := access.GroupTarget{ID: "gra_1", RequestID: "req_1", GroupID: "grp_1", Grant: &access.Grant{
+		Status: types.RequestAccessGroupTargetStatusEXPIRED,
+		Start:  iso8601.New(now.Add(-2 * time.Hour)),
+		End:    iso8601.New(now.Add(-time.Hour)),
+	}}
+	// revoked grants which were scheduled to end later have still ended
+	revokedGrant := access.GroupTarget{ID: "gra_1", RequestID: "req_1", GroupID: "grp_1", Grant: &access.Grant{
+		Status: types.RequestAccessGroupTargetStatusREVOKED,
+		Start:  iso8601.New(now.Add(-time.Hour)),
+		End:    iso8601.New(now.Add(time.Hour)),
+	}}
+	revokeErr := "provider error"
+
+	type testcase struct {
+		name       string
+		grant      access.GroupTarget
+		exists     bool
+		verifyErr  error
+		wantRevoke bool
+		revokeErr  error
+		want       *access.GrantDrift
+		wantErr    error
+	}
+
+	testcases := []testcase{
+		{
+			name:   "active grant exists",
+			grant:  activeGrant,
+			exists: true,
+		},
+		{
+			name:   "active grant removed early",
+			grant:  activeGrant,
+			exists: false,
+			want:   &access.GrantDrift{GrantID: "gra_1", RequestID: "req_1", GroupID: "grp_1", Kind: types.MISSING, DetectedAt: now, Grant: activeGrant},
+		},
+		{
+			name:   "expired grant removed",
+			grant:  expiredGrant,
+			exists: false,
+		},
+		{
+			name:       "expired grant outlived end time is revoked",
+			grant:      expiredGrant,
+			exists:     true,
+			wantRevoke: true,
+			want:       &access.GrantDrift{GrantID: "gra_1", RequestID: "req_1", GroupID: "grp_1", Kind: types.OUTLIVED, DetectedAt: now, Resolved: true, Grant: expiredGrant},
+		},
+		{
+			name:   "revoked grant removed",
+			grant:  revokedGrant,
+			exists: false,
+		},
+		{
+			name:       "revoked grant still exists is revoked again",
+			grant:      revokedGrant,
+			exists:     true,
+			wantRevoke: true,
+			want:       &access.GrantDrift{GrantID: "gra_1", RequestID: "req_1", GroupID: "grp_1", Kind: types.OUTLIVED, DetectedAt: now, Resolved: true, Grant: revokedGrant},
+		},
+		{
+			name:       "revoke fails",
+			grant:      expiredGrant,
+			exists:     true,
+			wantRevoke: true,
+			revokeErr:  errors.New(revokeErr),
+			want:       &access.GrantDrift{GrantID: "gra_1", RequestID: "req_1", GroupID: "grp_1", Kind: types.OUTLIVED, DetectedAt: now, ResolutionError: &revokeErr, Grant: expiredGrant},
+		},
+		{
+			name:      "verify not supported",
+			grant:     activeGrant,
+			verifyErr: handler.ErrVerifyNotSupported,
+			wantErr:   handler.ErrVerifyNotSupported,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			rt := mocks.NewMockRuntime(ctrl)
+			rt.EXPECT().Verify(gomock.Any(), gomock.Any()).Return(&handler.VerifyResponse{Exists: tc.exists}, tc.verifyErr)
+			if tc.wantRevoke {
+				rt.EXPECT().Revoke(gomock.Any(), gomock.Any()).Return(tc.revokeErr)
+			}
+
+			got, err := checkGrant(context.Background(), rt, tc.grant, now)
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestShouldCheck(t *testing.T) {
+	now := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+	s := Service{Lookback: 24 * time.Hour}
+	grant := func(status types.RequestAccessGroupTargetStatus, end time.Time) access.GroupTarget {
+		return access.GroupTarget{Grant: &access.Grant{Status: status, End: iso8601.New(end)}}
+	}
+
+	tests := []struct {
+		name  string
+		grant access.GroupTarget
+		want  bool
+	}{
+		{name: "no grant", grant: access.GroupTarget{}, want: false},
+		{name: "active", grant: grant(types.RequestAccessGroupTargetStatusACTIVE, now.Add(time.Hour)), want: true},
+		{name: "expired within lookback", grant: grant(types.RequestAccessGroupTargetStatusEXPIRED, now.Add(-time.Hour)), want: true},
+		{name: "expired before lookback", grant: grant(types.RequestAccessGroupTargetStatusEXPIRED, now.Add(-48*time.Hour)), want: false},
+		{name: "revoked before scheduled end", grant: grant(types.RequestAccessGroupTargetStatusREVOKED, now.Add(time.Hour)), want: true},
+		{name: "revoked within lookback", grant: grant(types.RequestAccessGroupTargetStatusREVOKED, now.Add(-time.Hour)), want: true},
+		{name: "revoked before lookback", grant: grant(types.RequestAccessGroupTargetStatusREVOKED, now.Add(-48*time.Hour)), want: false},
+		{name: "awaiting start", grant: grant(types.RequestAccessGroupTargetStatusAWAITINGSTART, now.Add(time.Hour)), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, s.shouldCheck(tt.grant, now))
+		})
+	}
+}
@@ -0,0 +1,295 @@
+// Package freezesvc freezes access during change freezes and incidents.
+// While a freeze is active new requests are rejected, approvals are refused and activations are held.
+package freezesvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+)
+
+var (
+	ErrFreezeNotFound = errors.New("freeze not found")
+	// ErrFreezeLifted is returned when updating or lifting a freeze which has already been lifted
+	ErrFreezeLifted = errors.New("freeze has already been lifted")
+	// ErrEndsAtInPast is returned when the end time of a freeze is not in the future
+	ErrEndsAtInPast    = errors.New("endsAt must be in the future")
+	ErrMessageRequired = errors.New("message is required")
+)
+
+// InvalidScopeError is returned if the scope ID doesn't match the scope of the freeze.
+type InvalidScopeError struct {
+	Scope  types.FreezeScope
+	Reason string
+}
+
+func (e InvalidScopeError) Error() string {
+	return fmt.Sprintf("invalid %s freeze: %s", e.Scope, e.Reason)
+}
+
+// FrozenError is returned by services which refuse to create, approve or activate access because of a freeze.
+type FrozenError struct {
+	Freezes []access.Freeze
+}
+
+func (e FrozenError) Error() string {
+	var messages []string
+	for _, f := range e.Freezes {
+		messages = append(messages, f.Message)
+	}
+	return "access is frozen: " + strings.Join(messages, "; ")
+}
+
+type EventPutter interface {
+	Put(ctx context.Context, detail gevent.EventTyper) error
+}
+
+type Service struct {
+	DB       ddb.Storage
+	Clock    clock.Clock
+	Eventbus EventPutter
+}
+
+type CreateFreezeOpts struct {
+	Scope        types.FreezeScope
+	ScopeID      string
+	Message      string
+	EndsAt       *time.Time
+	ExemptGroups []string
+}
+
+type UpdateFreezeOpts struct {
+	Message      string
+	EndsAt       *time.Time
+	ExemptGroups []string
+}
+
+func (s *Service) Create(ctx context.Context, user identity.User, opts CreateFreezeOpts) (*access.Freeze, error) {
+	err := s.validateScope(ctx, opts.Scope, opts.ScopeID)
+	if err != nil {
+		return nil, err
+	}
+	err = s.validate(opts.Message, opts.EndsAt)
+	if err != nil {
+		return nil, err
+	}
+	now := s.Clock.Now()
+	freeze := access.Freeze{
+		ID:           types.NewFreezeID(),
+		Scope:        opts.Scope,
+		ScopeID:      opts.ScopeID,
+		Message:      opts.Message,
+		EndsAt:       opts.EndsAt,
+		ExemptGroups: opts.ExemptGroups,
+		CreatedBy:    requestedBy(user),
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	if freeze.ExemptGroups == nil {
+		freeze.ExemptGroups = []string{}
+	}
+	err = s.save(ctx, user, freeze, types.CREATED)
+	if err != nil {
+		return nil, err
+	}
+	err = s.Eventbus.Put(ctx, gevent.FreezeCreated{Freeze: freeze, Actor: gevent.UserFromIdentityUser(user)})
+	if err != nil {
+		return nil, err
+	}
+	return &freeze, nil
+}
+
+func (s *Service) Update(ctx context.Context, user identity.User, freezeID string, opts UpdateFreezeOpts) (*access.Freeze, error) {
+	freeze, err := s.Get(ctx, freezeID)
+	if err != nil {
+		return nil, err
+	}
+	if freeze.LiftedAt != nil {
+		return nil, ErrFreezeLifted
+	}
+	err = s.validate(opts.Message, opts.EndsAt)
+	if err != nil {
+		return nil, err
+	}
+	freeze.Message = opts.Message
+	freeze.EndsAt = opts.EndsAt
+	freeze.ExemptGroups = opts.ExemptGroups
+	if freeze.ExemptGroups == nil {
+		freeze.ExemptGroups = []string{}
+	}
+	freeze.UpdatedAt = s.Clock.Now()
+	err = s.save(ctx, user, *freeze, types.UPDATED)
+	if err != nil {
+		return nil, err
+	}
+	err = s.Eventbus.Put(ctx, gevent.FreezeUpdated{Freeze: *freeze, Actor: gevent.UserFromIdentityUser(user)})
+	if err != nil {
+		return nil, err
+	}
+	return freeze, nil
+}
+
+// Lift ends a freeze. The event handler releases any activations held by the freeze when it receives the FreezeLifted event.
+func (s *Service) Lift(ctx context.Context, user identity.User, freezeID string) (*access.Freeze, error) {
+	freeze, err := s.Get(ctx, freezeID)
+	if err != nil {
+		return nil, err
+	}
+	if freeze.LiftedAt != nil {
+		return nil, ErrFreezeLifted
+	}
+	now := s.Clock.Now()
+	liftedBy := requestedBy(user)
+	freeze.LiftedAt = &now
+	freeze.LiftedBy = &liftedBy
+	freeze.UpdatedAt = now
+	err = s.save(ctx, user, *freeze, types.LIFTED)
+	if err != nil {
+		return nil, err
+	}
+	err = s.Eventbus.Put(ctx, gevent.FreezeLifted{Freeze: *freeze, Actor: gevent.UserFromIdentityUser(user)})
+	if err != nil {
+		return nil, err
+	}
+	return freeze, nil
+}
+
+func (s *Service) Get(ctx context.Context, freezeID string) (*access.Freeze, error) {
+	q := storage.GetFreeze{ID: freezeID}
+	_, err := s.DB.Query(ctx, &q)
+	if err == ddb.ErrNoItems {
+		return nil, ErrFreezeNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return q.Result, nil
+}
+
+// List returns all freezes, most recent first.
+func (s *Service) List(ctx context.Context) ([]access.Freeze, error) {
+	q := storage.ListFreezes{}
+	err := s.DB.All(ctx, &q)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(q.Result, func(i, j int) bool {
+		return q.Result[i].CreatedAt.After(q.Result[j].CreatedAt)
+	})
+	return q.Result, nil
+}
+
+// Blocking returns the active freezes which cover any of the access rules or target groups.
+// Freezes which any of the groups are exempt from are not returned.
+func (s *Service) Blocking(ctx context.Context, groups []string, accessRuleIDs []string, targetGroupIDs []string) ([]access.Freeze, error) {
+	now := s.Clock.Now()
+	q := storage.ListActiveFreezes{Now: now}
+	err := s.DB.All(ctx, &q)
+	if err != nil {
+		return nil, err
+	}
+	var blocking []access.Freeze
+	for _, f := range q.Result {
+		if f.Active(now) && f.Applies(accessRuleIDs, targetGroupIDs) && !f.Exempt(groups) {
+			blocking = append(blocking, f)
+		}
+	}
+	return blocking, nil
+}
+
+// Check returns a FrozenError if any active freeze covers the access rules or target groups.
+func (s *Service) Check(ctx context.Context, groups []string, accessRuleIDs []string, targetGroupIDs []string) error {
+	freezes, err := s.Blocking(ctx, groups, accessRuleIDs, targetGroupIDs)
+	if err != nil {
+		return err
+	}
+	if len(freezes) > 0 {
+		return FrozenError{Freezes: freezes}
+	}
+	return nil
+}
+
+func (s *Service) validate(message string, endsAt *time.Time) error {
+	if strings.TrimSpace(message) == "" {
+		return ErrMessageRequired
+	}
+	if endsAt != nil && !endsAt.After(s.Clock.Now()) {
+		return ErrEndsAtInPast
+	}
+	return nil
+}
+
+func (s *Service) validateScope(ctx context.Context, scope types.FreezeScope, scopeID string) error {
+	switch scope {
+	case types.FreezeScopeGLOBAL:
+		if scopeID != "" {
+			return InvalidScopeError{Scope: scope, Reason: "scopeId must not be set"}
+		}
+		return nil
+	case types.FreezeScopeTARGETGROUP:
+		if scopeID == "" {
+			return InvalidScopeError{Scope: scope, Reason: "scopeId is required"}
+		}
+		_, err := s.DB.Query(ctx, &storage.GetTargetGroup{ID: scopeID})
+		if err == ddb.ErrNoItems {
+			return InvalidScopeError{Scope: scope, Reason: "target group not found"}
+		}
+		return err
+	case types.FreezeScopeACCESSRULE:
+		if scopeID == "" {
+			return InvalidScopeError{Scope: scope, Reason: "scopeId is required"}
+		}
+		_, err := s.DB.Query(ctx, &storage.GetAccessRule{ID: scopeID})
+		if err == ddb.ErrNoItems {
+			return InvalidScopeError{Scope: scope, Reason: "access rule not found"}
+		}
+		return err
+	}
+	return InvalidScopeError{Scope: scope, Reason: "unsupported scope"}
+}
+
+// save stores the freeze along with an audit record of the change.
+func (s *Service) save(ctx context.Context, user identity.User, freeze access.Freeze, action types.FreezeHistoryEventAction) error {
+	event := access.FreezeHistoryEvent{
+		ID:        types.NewFreezeHistoryEventID(),
+		FreezeID:  freeze.ID,
+		Action:    action,
+		Actor:     requestedBy(user),
+		CreatedAt: freeze.UpdatedAt,
+		Freeze:    freeze,
+	}
+	return s.DB.PutBatch(ctx, &freeze, &event)
+}
+
+func (s *Service) ListHistory(ctx context.Context, freezeID string) ([]access.FreezeHistoryEvent, error) {
+	_, err := s.Get(ctx, freezeID)
+	if err != nil {
+		return nil, err
+	}
+	q := storage.ListFreezeHistory{FreezeID: freezeID}
+	err = s.DB.All(ctx, &q)
+	if err != nil {
+		return nil, err
+	}
+	return q.Result, nil
+}
+
+func requestedBy(user identity.User) access.RequestedBy {
+	return access.RequestedBy{
+		ID:        user.ID,
+		Email:     user.Email,
+		FirstName: user.FirstName,
+		LastName:  user.LastName,
+	}
+}
@@ -0,0 +1,196 @@
+package freezesvc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/common-fate/pkg/access"
+	eventmock "github.com/common-fate/common-fate/pkg/eventhandler/mocks"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlocking(t *testing.T) {
+	clk := clock.NewMock()
+	past := clk.Now().Add(-time.Hour)
+	future := clk.Now().Add(time.Hour)
+	freezes := []access.Freeze{
+		{ID: "global", Scope: types.FreezeScopeGLOBAL, ExemptGroups: []string{"break-glass"}},
+		{ID: "expired", Scope: types.FreezeScopeGLOBAL, EndsAt: &past},
+		{ID: "lifted", Scope: types.FreezeScopeGLOBAL, LiftedAt: &past},
+		{ID: "rule", Scope: types.FreezeScopeACCESSRULE, ScopeID: "rul_1", EndsAt: &future},
+		{ID: "target-group", Scope: types.FreezeScopeTARGETGROUP, ScopeID: "aws"},
+	}
+
+	type testcase struct {
+		name           string
+		groups         []string
+		accessRuleIDs  []string
+		targetGroupIDs []string
+		want           []string
+	}
+
+	testcases := []testcase{
+		{
+			name:           "all active freezes which apply",
+			accessRuleIDs:  []string{"rul_1"},
+			targetGroupIDs: []string{"aws"},
+			want:           []string{"global", "rule", "target-group"},
+		},
+		{
+			name:           "exempt groups skip the freeze",
+			groups:         []string{"break-glass"},
+			accessRuleIDs:  []string{"rul_2"},
+			targetGroupIDs: []string{"okta"},
+		},
+		{
+			name:           "scoped freezes only apply to their scope",
+			groups:         []string{"break-glass"},
+			accessRuleIDs:  []string{"rul_2"},
+			targetGroupIDs: []string{"okta", "aws"},
+			want:           []string{"target-group"},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.ListActiveFreezes{Result: freezes})
+			s := Service{DB: db, Clock: clk}
+			got, err := s.Blocking(context.Background(), tc.groups, tc.accessRuleIDs, tc.targetGroupIDs)
+			assert.NoError(t, err)
+			var gotIDs []string
+			for _, f := range got {
+				gotIDs = append(gotIDs, f.ID)
+			}
+			assert.Equal(t, tc.want, gotIDs)
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	clk := clock.NewMock()
+	past := clk.Now().Add(-time.Hour)
+
+	type testcase struct {
+		name      string
+		give      CreateFreezeOpts
+		mockQuery ddb.QueryBuilder
+		mockErr   error
+		wantErr   error
+	}
+
+	testcases := []testcase{
+		{
+			name: "global",
+			give: CreateFreezeOpts{Scope: types.FreezeScopeGLOBAL, Message: "incident"},
+		},
+		{
+			name:      "access rule",
+			give:      CreateFreezeOpts{Scope: types.FreezeScopeACCESSRULE, ScopeID: "rul_1", Message: "change freeze"},
+			mockQuery: &storage.GetAccessRule{ID: "rul_1"},
+		},
+		{
+			name:      "target group not found",
+			give:      CreateFreezeOpts{Scope: types.FreezeScopeTARGETGROUP, ScopeID: "aws", Message: "change freeze"},
+			mockQuery: &storage.GetTargetGroup{ID: "aws"},
+			mockErr:   ddb.ErrNoItems,
+			wantErr:   InvalidScopeError{Scope: types.FreezeScopeTARGETGROUP, Reason: "target group not found"},
+		},
+		{
+			name:    "global with scope id",
+			give:    CreateFreezeOpts{Scope: types.FreezeScopeGLOBAL, ScopeID: "aws", Message: "incident"},
+			wantErr: InvalidScopeError{Scope: types.FreezeScopeGLOBAL, Reason: "scopeId must not be set"},
+		},
+		{
+			name:    "missing message",
+			give:    CreateFreezeOpts{Scope: types.FreezeScopeGLOBAL},
+			wantErr: ErrMessageRequired,
+		},
+		{
+			name:    "ends in the past",
+			give:    CreateFreezeOpts{Scope: types.FreezeScopeGLOBAL, Message: "incident", EndsAt: &past},
+			wantErr: ErrEndsAtInPast,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			db := ddbmock.New(t)
+			if tc.mockQuery != nil {
+				db.MockQueryWithErr(tc.mockQuery, tc.mockErr)
+			}
+			eb := eventmock.NewMockEventPutter(ctrl)
+			if tc.wantErr == nil {
+				eb.EXPECT().Put(gomock.Any(), gomock.AssignableToTypeOf(gevent.FreezeCreated{})).Return(nil)
+			}
+			s := Service{DB: db, Clock: clk, Eventbus: eb}
+			got, err := s.Create(context.Background(), identity.User{ID: "usr_admin"}, tc.give)
+			assert.Equal(t, tc.wantErr, err)
+			if tc.wantErr == nil {
+				assert.Equal(t, tc.give.Scope, got.Scope)
+				assert.Equal(t, "usr_admin", got.CreatedBy.ID)
+				assert.Equal(t, []string{}, got.ExemptGroups)
+			}
+		})
+	}
+}
+
+func TestLift(t *testing.T) {
+	clk := clock.NewMock()
+	lifted := clk.Now().Add(-time.Minute)
+
+	type testcase struct {
+		name    string
+		freeze  *access.Freeze
+		wantErr error
+	}
+
+	testcases := []testcase{
+		{
+			name:   "ok",
+			freeze: &access.Freeze{ID: "frz_1", Scope: types.FreezeScopeGLOBAL},
+		},
+		{
+			name:    "already lifted",
+			freeze:  &access.Freeze{ID: "frz_1", Scope: types.FreezeScopeGLOBAL, LiftedAt: &lifted},
+			wantErr: ErrFreezeLifted,
+		},
+		{
+			name:    "not found",
+			wantErr: ErrFreezeNotFound,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			db := ddbmock.New(t)
+			if tc.freeze != nil {
+				db.MockQuery(&storage.GetFreeze{ID: "frz_1", Result: tc.freeze})
+			} else {
+				db.MockQueryWithErr(&storage.GetFreeze{ID: "frz_1"}, ddb.ErrNoItems)
+			}
+			eb := eventmock.NewMockEventPutter(ctrl)
+			if tc.wantErr == nil {
+				eb.EXPECT().Put(gomock.Any(), gomock.AssignableToTypeOf(gevent.FreezeLifted{})).Return(nil)
+			}
+			s := Service{DB: db, Clock: clk, Eventbus: eb}
+			got, err := s.Lift(context.Background(), identity.User{ID: "usr_admin"}, "frz_1")
+			assert.Equal(t, tc.wantErr, err)
+			if tc.wantErr == nil {
+				assert.False(t, got.Active(clk.Now()))
+				assert.Equal(t, "usr_admin", got.LiftedBy.ID)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+// Package idempotencysvc stores Idempotency-Key headers sent by clients, so that
+// retried API calls return the original result instead of repeating the operation.
+package idempotencysvc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+)
+
+// DefaultTTL is how long idempotency keys are kept for if a TTL isn't configured.
+const DefaultTTL = 24 * time.Hour
+
+// InProgressTTL is how long a key is claimed for before the call finishes. If the call never finishes,
+// for example because the process crashed, the key can be claimed again once this has passed.
+const InProgressTTL = 5 * time.Minute
+
+// Operations which accept an idempotency key. Keys are scoped to the user and the operation.
+const (
+	OperationCreateRequest = "createRequest"
+	OperationPreflight     = "preflight"
+)
+
+var (
+	// ErrKeyInProgress is returned if another call with the same key hasn't finished yet.
+	ErrKeyInProgress = errors.New("a call with this idempotency key is still in progress")
+	// ErrKeyReused is returned if a key is sent again with a different request body.
+	ErrKeyReused = errors.New("this idempotency key was already used with a different request body")
+)
+
+// Claimer claims idempotency keys, only one caller can claim a key until it expires.
+type Claimer interface {
+	Claim(ctx context.Context, key access.IdempotencyKey, now time.Time) (bool, error)
+}
+
+// DynamoDBClaimer claims keys with conditional writes.
+type DynamoDBClaimer struct {
+	DB ddb.Storage
+}
+
+func (c *DynamoDBClaimer) Claim(ctx context.Context, key access.IdempotencyKey, now time.Time) (bool, error) {
+	return storage.PutIfNotExists(ctx, c.DB, &key, now)
+}
+
+type Service struct {
+	DB    ddb.Storage
+	Clock clock.Clock
+	// Claimer defaults to a DynamoDBClaimer if it is not set
+	Claimer Claimer
+	// TTL defaults to DefaultTTL if it is not set
+	TTL time.Duration
+}
+
+type BeginOpts struct {
+	UserID    string
+	Operation string
+	Key       string
+	// Body is the decoded request body, it is hashed to detect keys which are reused for a different call.
+	Body any
+}
+
+// Begin claims an idempotency key before the operation is run.
+//
+// If the key was already used for a call which finished, the stored key is returned with its ResultID set,
+// and the caller should return the existing result rather than running the operation again.
+// Otherwise the caller must call Finish once the operation has completed.
+func (s *Service) Begin(ctx context.Context, opts BeginOpts) (*access.IdempotencyKey, error) {
+	hash, err := hashBody(opts.Body)
+	if err != nil {
+		return nil, err
+	}
+	now := s.Clock.Now()
+
+	q := storage.GetIdempotencyKey{UserID: opts.UserID, Operation: opts.Operation, Key: opts.Key}
+	_, err = s.DB.Query(ctx, &q)
+	if err != nil && err != ddb.ErrNoItems {
+		return nil, err
+	}
+	if err == nil && !q.Result.Expired(now) {
+		return s.existing(*q.Result, hash)
+	}
+
+	key := access.IdempotencyKey{
+		Key:         opts.Key,
+		UserID:      opts.UserID,
+		Operation:   opts.Operation,
+		RequestHash: hash,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(InProgressTTL).Unix(),
+	}
+	ok, err := s.claimer().Claim(ctx, key, now)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		// another call claimed the key after it was read
+		return nil, ErrKeyInProgress
+	}
+	return &key, nil
+}
+
+func (s *Service) existing(key access.IdempotencyKey, hash string) (*access.IdempotencyKey, error) {
+	if key.RequestHash != hash {
+		return nil, ErrKeyReused
+	}
+	if key.ResultID == "" {
+		return nil, ErrKeyInProgress
+	}
+	return &key, nil
+}
+
+// Finish stores the result of the operation against the key, and keeps the key until the TTL has passed.
+// If the operation failed, resultID is empty and the key is deleted, so that the call can be retried with the same key.
+func (s *Service) Finish(ctx context.Context, key access.IdempotencyKey, resultID string) error {
+	if resultID == "" {
+		return s.DB.Delete(ctx, &key)
+	}
+	key.ResultID = resultID
+	key.ExpiresAt = key.CreatedAt.Add(s.ttl()).Unix()
+	return s.DB.Put(ctx, &key)
+}
+
+func (s *Service) claimer() Claimer {
+	if s.Claimer != nil {
+		return s.Claimer
+	}
+	return &DynamoDBClaimer{DB: s.DB}
+}
+
+func (s *Service) ttl() time.Duration {
+	if s.TTL > 0 {
+		return s.TTL
+	}
+	return DefaultTTL
+}
+
+func hashBody(body any) (string, error) {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,154 @@
+package idempotencysvc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/stretchr/testify/assert"
+)
+
+type testClaimer struct {
+	ok     bool
+	claims []access.IdempotencyKey
+}
+
+func (c *testClaimer) Claim(ctx context.Context, key access.IdempotencyKey, now time.Time) (bool, error) {
+	c.claims = append(c.claims, key)
+	return c.ok, nil
+}
+
+func TestBegin(t *testing.T) {
+	type body struct {
+		Reason string
+	}
+	clk := clock.NewMock()
+	hash, err := hashBody(body{Reason: "deploy"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stored := func(resultID string, expiresAt time.Time) *access.IdempotencyKey {
+		return &access.IdempotencyKey{
+			Key:         "key1",
+			UserID:      "user1",
+			Operation:   OperationCreateRequest,
+			RequestHash: hash,
+			ResultID:    resultID,
+			ExpiresAt:   expiresAt.Unix(),
+		}
+	}
+
+	type testcase struct {
+		name         string
+		give         body
+		existing     *access.IdempotencyKey
+		claimOK      bool
+		wantResultID string
+		wantClaim    bool
+		wantErr      error
+	}
+	testcases := []testcase{
+		{
+			name:      "new key is claimed",
+			give:      body{Reason: "deploy"},
+			claimOK:   true,
+			wantClaim: true,
+		},
+		{
+			name:         "finished call is replayed",
+			give:         body{Reason: "deploy"},
+			existing:     stored("req_123", clk.Now().Add(time.Hour)),
+			wantResultID: "req_123",
+		},
+		{
+			name:     "key reused with a different body",
+			give:     body{Reason: "something else"},
+			existing: stored("req_123", clk.Now().Add(time.Hour)),
+			wantErr:  ErrKeyReused,
+		},
+		{
+			name:     "call still in progress",
+			give:     body{Reason: "deploy"},
+			existing: stored("", clk.Now().Add(time.Hour)),
+			wantErr:  ErrKeyInProgress,
+		},
+		{
+			name:      "expired key is claimed again",
+			give:      body{Reason: "something else"},
+			existing:  stored("req_123", clk.Now().Add(-time.Minute)),
+			claimOK:   true,
+			wantClaim: true,
+		},
+		{
+			name:      "abandoned call is claimed again",
+			give:      body{Reason: "deploy"},
+			existing:  stored("", clk.Now().Add(-time.Second)),
+			claimOK:   true,
+			wantClaim: true,
+		},
+		{
+			name:      "key claimed by a concurrent call",
+			give:      body{Reason: "deploy"},
+			wantClaim: true,
+			wantErr:   ErrKeyInProgress,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			if tc.existing != nil {
+				db.MockQuery(&storage.GetIdempotencyKey{Result: tc.existing})
+			} else {
+				db.MockQueryWithErr(&storage.GetIdempotencyKey{}, ddb.ErrNoItems)
+			}
+			claimer := &testClaimer{ok: tc.claimOK}
+			s := Service{DB: db, Clock: clk, Claimer: claimer}
+
+			got, err := s.Begin(context.Background(), BeginOpts{
+				UserID:    "user1",
+				Operation: OperationCreateRequest,
+				Key:       "key1",
+				Body:      tc.give,
+			})
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantClaim, len(claimer.claims) == 1)
+			if tc.wantErr == nil {
+				assert.Equal(t, tc.wantResultID, got.ResultID)
+			}
+			if tc.wantClaim {
+				assert.Equal(t, clk.Now().Add(InProgressTTL).Unix(), claimer.claims[0].ExpiresAt)
+			}
+		})
+	}
+}
+
+// putDB records the keys which were written
+type putDB struct {
+	*mockClient
+	put []access.IdempotencyKey
+}
+
+type mockClient = ddbmock.Client
+
+func (d *putDB) Put(ctx context.Context, item ddb.Keyer) error {
+	d.put = append(d.put, *item.(*access.IdempotencyKey))
+	return nil
+}
+
+func TestFinish(t *testing.T) {
+	clk := clock.NewMock()
+	db := &putDB{mockClient: ddbmock.New(t)}
+	s := Service{DB: db, Clock: clk}
+	key := access.IdempotencyKey{Key: "key1", CreatedAt: clk.Now(), ExpiresAt: clk.Now().Add(InProgressTTL).Unix()}
+
+	err := s.Finish(context.Background(), key, "req_123")
+	assert.NoError(t, err)
+	// finished keys are kept for the full TTL
+	assert.Equal(t, []access.IdempotencyKey{{Key: "key1", ResultID: "req_123", CreatedAt: clk.Now(), ExpiresAt: clk.Now().Add(DefaultTTL).Unix()}}, db.put)
+}
@@ -7,13 +7,17 @@ import (
 	"github.com/common-fate/common-fate/pkg/storage"
 )
 
+// UpdateUserAccessRules recalculates the access rules which the users can request, based on the groups they belong to.
+// users is keyed by email and groups should contain every group referenced by an access rule.
+// Users which aren't in the map but belong to a group of an access rule are loaded from the database and added to it.
 func (s *Service) UpdateUserAccessRules(ctx context.Context, users map[string]identity.User, groups map[string]identity.Group) (map[string]identity.User, error) {
-	//get all access rules
-
-	//reset user access rules, as we will be overriding them
-
-	for _, u := range users {
+	// reset user access rules, as we will be overriding them.
+	// groups list their members by ID, so keep track of the users by ID
+	emailsByID := map[string]string{}
+	for email, u := range users {
 		u.AccessRules = []string{}
+		users[email] = u
+		emailsByID[u.ID] = email
 	}
 
 	q := storage.ListAccessRulesByPriority{}
@@ -28,18 +32,37 @@ func (s *Service) UpdateUserAccessRules(ctx context.Context, users map[string]id
 		for _, g := range ar.Groups {
 			group := groups[g]
 
-			for _, u := range group.Users {
-				user := storage.GetUser{ID: u}
-				_, err := s.DB.Query(ctx, &user)
-				if err != nil {
-					return nil, err
+			for _, id := range group.Users {
+				email, ok := emailsByID[id]
+				if !ok {
+					user := storage.GetUser{ID: id}
+					_, err := s.DB.Query(ctx, &user)
+					if err != nil {
+						return nil, err
+					}
+					u := user.Result
+					u.AccessRules = []string{}
+					email = u.Email
+					users[email] = *u
+					emailsByID[id] = email
 				}
-				u := user.Result
-				u.AccessRules = append(u.AccessRules, ar.ID)
-				users[u.Email] = *u
+				u := users[email]
+				if !contains(u.AccessRules, ar.ID) {
+					u.AccessRules = append(u.AccessRules, ar.ID)
+				}
+				users[email] = u
 			}
 		}
 	}
 
 	return users, nil
 }
+
+func contains(s []string, e string) bool {
+	for _, a := range s {
+		if a == e {
+			return true
+		}
+	}
+	return false
+}
@@ -17,6 +17,13 @@ import (
 type Service struct {
 	DB    ddb.Storage
 	Clock clock.Clock
+	// Freezes is optional, if it is set preflights are rejected while access is frozen
+	Freezes FreezeService
+}
+
+// FreezeService checks whether access is frozen.
+type FreezeService interface {
+	Check(ctx context.Context, groups []string, accessRuleIDs []string, targetGroupIDs []string) error
 }
 
 func ValidateNoDuplicates(preflightRequest types.CreatePreflightRequest) error {
@@ -89,6 +96,13 @@ func (s *Service) ProcessPreflight(ctx context.Context, user identity.User, pref
 		CreatedAt:    now,
 		AccessGroups: accessGroups,
 	}
+	if s.Freezes != nil {
+		accessRuleIDs, targetGroupIDs := preflight.FreezeScopes()
+		err = s.Freezes.Check(ctx, user.Groups, accessRuleIDs, targetGroupIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
 	//create a preflight object in the db
 	err = s.DB.Put(ctx, &preflight)
 	if err != nil {
@@ -5,8 +5,11 @@ import (
 	"sort"
 	"sync"
 
+	"github.com/common-fate/apikit/logger"
 	"github.com/common-fate/common-fate/pkg/rule"
 	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -46,13 +49,29 @@ func (u *userMap) All() []string {
 // GetApprovers gets all the approvers for a rule, both those assigned as individuals and those
 // assigned via a group. It de-duplicates users, so if a user is assigned as an approver through
 // multiple groups they'll only be returned once.
-func (s *Service) GetApprovers(ctx context.Context, rule rule.AccessRule) ([]string, error) {
+//
+// If the rule is approved by the requester's manager, the manager is looked up from the requester's
+// manager hierarchy at the time of the request.
+//
+// ErrNoApprover is returned if the rule requires approval but no approvers could be resolved,
+// so that a request isn't created which nobody can review.
+func (s *Service) GetApprovers(ctx context.Context, rule rule.AccessRule, requesterID string) ([]string, error) {
 	users := newUserMap()
 
 	for _, u := range rule.Approval.Users {
 		users.Add(u)
 	}
 
+	if rule.Approval.ManagerLevel > 0 {
+		manager, err := s.getManager(ctx, requesterID, rule.Approval.ManagerLevel)
+		if err != nil {
+			return nil, err
+		}
+		if manager != "" {
+			users.Add(manager)
+		}
+	}
+
 	wg, gctx := errgroup.WithContext(ctx)
 	for _, g := range rule.Approval.Groups {
 		id := g
@@ -74,5 +93,53 @@ func (s *Service) GetApprovers(ctx context.Context, rule rule.AccessRule) ([]str
 	}
 
 	res := users.All()
+	if len(res) == 0 && rule.Approval.IsRequired() {
+		return nil, ErrNoApprover
+	}
 	return res, nil
 }
+
+// getManager returns the ID of the user's manager the given number of levels above the user.
+// An empty string is returned if the user's manager hierarchy doesn't have that many levels, or if the manager is archived.
+func (s *Service) getManager(ctx context.Context, userID string, level int) (string, error) {
+	log := logger.Get(ctx).With("user.id", userID, "manager.level", level)
+	seen := map[string]bool{userID: true}
+	current := userID
+	for i := 0; i < level; i++ {
+		q := storage.GetUser{ID: current}
+		_, err := s.DB.Query(ctx, &q)
+		if err == ddb.ErrNoItems {
+			log.Warnw("user in manager hierarchy not found", "missing.user.id", current)
+			return "", nil
+		}
+		if err != nil {
+			return "", err
+		}
+		if q.Result.ManagerID == "" {
+			log.Warnw("user's manager hierarchy doesn't reach the manager level of the access rule")
+			return "", nil
+		}
+		if seen[q.Result.ManagerID] {
+			log.Warnw("found a cycle in the user's manager hierarchy", "manager.id", q.Result.ManagerID)
+			return "", nil
+		}
+		seen[q.Result.ManagerID] = true
+		current = q.Result.ManagerID
+	}
+
+	// the manager is looked up to check that they are active
+	q := storage.GetUser{ID: current}
+	_, err := s.DB.Query(ctx, &q)
+	if err == ddb.ErrNoItems {
+		log.Warnw("manager not found", "manager.id", current)
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if q.Result.Status != types.IdpStatusACTIVE {
+		log.Warnw("manager is not active", "manager.id", current)
+		return "", nil
+	}
+	return current, nil
+}
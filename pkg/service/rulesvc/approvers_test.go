@@ -7,6 +7,7 @@ import (
 	"github.com/common-fate/common-fate/pkg/identity"
 	"github.com/common-fate/common-fate/pkg/rule"
 	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
 	"github.com/common-fate/ddb/ddbmock"
 	"github.com/stretchr/testify/assert"
 )
@@ -85,7 +86,7 @@ func TestGetApprovers(t *testing.T) {
 				DB: db,
 			}
 			ctx := context.Background()
-			got, err := s.GetApprovers(ctx, tc.giveRule)
+			got, err := s.GetApprovers(ctx, tc.giveRule, "usr_requester")
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -94,3 +95,95 @@ func TestGetApprovers(t *testing.T) {
 	}
 
 }
+
+func TestGetApproversManager(t *testing.T) {
+	type testcase struct {
+		name string
+		// manager level of the access rule
+		level int
+		// users returned when the manager hierarchy is looked up, in order
+		mockGetUsers []identity.User
+		want         []string
+		// users are the individual approvers of the rule
+		users   []string
+		wantErr error
+	}
+
+	requester := identity.User{ID: "usr_requester", ManagerID: "usr_manager", Status: types.IdpStatusACTIVE}
+	manager := identity.User{ID: "usr_manager", ManagerID: "usr_director", Status: types.IdpStatusACTIVE}
+	director := identity.User{ID: "usr_director", Status: types.IdpStatusACTIVE}
+
+	testcases := []testcase{
+		{
+			name:         "manager",
+			users:        []string{"usr_1"},
+			level:        1,
+			mockGetUsers: []identity.User{requester, manager},
+			want:         []string{"usr_1", "usr_manager"},
+		},
+		{
+			name:         "manager's manager",
+			users:        []string{"usr_1"},
+			level:        2,
+			mockGetUsers: []identity.User{requester, manager, director},
+			want:         []string{"usr_1", "usr_director"},
+		},
+		{
+			name:         "hierarchy doesn't reach the level",
+			users:        []string{"usr_1"},
+			level:        3,
+			mockGetUsers: []identity.User{requester, manager, director},
+			want:         []string{"usr_1"},
+		},
+		{
+			name:         "archived manager",
+			users:        []string{"usr_1"},
+			level:        1,
+			mockGetUsers: []identity.User{requester, {ID: "usr_manager", Status: types.IdpStatusARCHIVED}},
+			want:         []string{"usr_1"},
+		},
+		{
+			name:  "cycle",
+			level: 3,
+			users: []string{"usr_1"},
+			mockGetUsers: []identity.User{
+				requester,
+				{ID: "usr_manager", ManagerID: "usr_requester", Status: types.IdpStatusACTIVE},
+			},
+			want: []string{"usr_1"},
+		},
+		{
+			name:         "only approved by a manager who can't be resolved",
+			level:        1,
+			mockGetUsers: []identity.User{{ID: "usr_requester", Status: types.IdpStatusACTIVE}},
+			wantErr:      ErrNoApprover,
+		},
+		{
+			name:         "only approved by a manager",
+			level:        1,
+			mockGetUsers: []identity.User{requester, manager},
+			want:         []string{"usr_manager"},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			for i := range tc.mockGetUsers {
+				db.MockQuery(&storage.GetUser{Result: &tc.mockGetUsers[i]})
+			}
+			s := Service{
+				DB: db,
+			}
+			r := rule.AccessRule{
+				Approval: rule.Approval{
+					Users:        tc.users,
+					ManagerLevel: tc.level,
+				},
+			}
+			got, err := s.GetApprovers(context.Background(), r, "usr_requester")
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -33,10 +33,14 @@ func (s *Service) ProcessTargets(ctx context.Context, in []types.CreateAccessRul
 				filters[k] = v
 			}
 
-			deduplicateTargets[targetGroupQ.Result.ID] = rule.Target{
+			t := rule.Target{
 				TargetGroup:           *targetGroupQ.Result,
 				FieldFilterExpessions: filters,
 			}
+			if targetGroup.MetadataFilter != nil && len(targetGroup.MetadataFilter.AdditionalProperties) > 0 {
+				t.MetadataFilter = targetGroup.MetadataFilter.AdditionalProperties
+			}
+			deduplicateTargets[targetGroupQ.Result.ID] = t
 		} else {
 			//do we want to error out here or just deduplicate it automatically?
 			return nil, errors.New("duplicate target in access rule")
@@ -104,6 +108,9 @@ func (s *Service) CreateAccessRule(ctx context.Context, userID string, in types.
 	if in.TimeConstraints.DefaultDurationSeconds > 26*7*24*3600 {
 		return nil, errors.New("access rule cannot be longer than 6 months")
 	}
+	if in.TimeConstraints.ActivationWindowSeconds != nil && *in.TimeConstraints.ActivationWindowSeconds > 26*7*24*3600 {
+		return nil, errors.New("activation window cannot be longer than 6 months")
+	}
 
 	approvals := rule.Approval{}
 
@@ -115,6 +122,10 @@ func (s *Service) CreateAccessRule(ctx context.Context, userID string, in types.
 		approvals.Users = *in.Approval.Users
 	}
 
+	if in.Approval.ManagerLevel != nil {
+		approvals.ManagerLevel = *in.Approval.ManagerLevel
+	}
+
 	rul := rule.AccessRule{
 		ID:          id,
 		Approval:    approvals,
@@ -18,4 +18,8 @@ var (
 
 	// ErrAccessRuleAlreadyArchived is returned if an archive request is made for a rule which is already archived
 	ErrAccessRuleAlreadyArchived = errors.New("access rule already archived")
+
+	// ErrNoApprover is returned if an access rule requires approval, but none of its approvers could be resolved,
+	// such as when the rule is approved by the requester's manager and the requester doesn't have one.
+	ErrNoApprover = errors.New("no approver could be resolved for the access rule")
 )
@@ -66,6 +66,9 @@ func (s *Service) UpdateRule(ctx context.Context, in *UpdateOpts) (*rule.AccessR
 	if in.UpdateRequest.TimeConstraints.DefaultDurationSeconds > 26*7*24*3600 {
 		return nil, errors.New("access rule cannot be longer than 6 months")
 	}
+	if in.UpdateRequest.TimeConstraints.ActivationWindowSeconds != nil && *in.UpdateRequest.TimeConstraints.ActivationWindowSeconds > 26*7*24*3600 {
+		return nil, errors.New("activation window cannot be longer than 6 months")
+	}
 
 	approvals := rule.Approval{}
 
@@ -77,6 +80,10 @@ func (s *Service) UpdateRule(ctx context.Context, in *UpdateOpts) (*rule.AccessR
 		approvals.Users = *in.UpdateRequest.Approval.Users
 	}
 
+	if in.UpdateRequest.Approval.ManagerLevel != nil {
+		approvals.ManagerLevel = *in.UpdateRequest.Approval.ManagerLevel
+	}
+
 	meta := in.Rule.Metadata
 	meta.UpdatedAt = s.Clock.Now()
 	meta.UpdatedBy = in.UpdaterID
@@ -0,0 +1,26 @@
+package scimsvc
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrUserNotFound = errors.New("user not found")
+var ErrGroupNotFound = errors.New("group not found")
+var ErrUserExists = errors.New("a user with this email already exists")
+var ErrGroupExists = errors.New("a group with this name already exists")
+
+// ErrConflict is returned when the users or groups which an operation changes were changed by other requests
+// on every attempt.
+var ErrConflict = errors.New("the users or groups were changed by another request, try again")
+
+// ErrNotManagedBySCIM is returned when attempting to change a group which is managed by identity sync or is internal.
+var ErrNotManagedBySCIM = errors.New("group is not managed by SCIM")
+
+type InvalidMemberError struct {
+	UserID string
+}
+
+func (e InvalidMemberError) Error() string {
+	return fmt.Sprintf("user %s does not exist", e.UserID)
+}
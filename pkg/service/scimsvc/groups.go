@@ -0,0 +1,184 @@
+package scimsvc
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+)
+
+// GroupInput is the set of group attributes which can be provisioned through SCIM.
+type GroupInput struct {
+	Name       string
+	ExternalID string
+	// Members are the IDs of the users in the group
+	Members []string
+}
+
+// groupVisible returns true if the group should be returned from the SCIM API.
+// Only groups provisioned through SCIM are visible, groups from identity sync and internal groups are hidden.
+func groupVisible(g identity.Group) bool {
+	return g.Source == identity.SCIM && g.Status == types.IdpStatusACTIVE
+}
+
+// ListGroups returns the groups provisioned through SCIM, sorted by name.
+func (s *Service) ListGroups(ctx context.Context) ([]identity.Group, error) {
+	q := storage.ListGroupsForSourceAndStatus{Source: identity.SCIM, Status: types.IdpStatusACTIVE}
+	err := s.DB.All(ctx, &q)
+	if err != nil {
+		return nil, err
+	}
+	groups := q.Result
+	sort.Slice(groups, func(i, j int) bool { return groups[i].Name < groups[j].Name })
+	return groups, nil
+}
+
+// GetGroup returns the group with the given ID, or ErrGroupNotFound if the group wasn't provisioned through SCIM.
+func (s *Service) GetGroup(ctx context.Context, id string) (*identity.Group, error) {
+	g, ok, err := newDirectory(s.DB).group(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !ok || !groupVisible(g) {
+		return nil, ErrGroupNotFound
+	}
+	return &g, nil
+}
+
+// CreateGroup provisions a group and adds its members.
+func (s *Service) CreateGroup(ctx context.Context, in GroupInput) (*identity.Group, error) {
+	var id string
+	d, err := s.update(ctx, func(d *directory) error {
+		_, exists, err := d.scimGroupByName(ctx, in.Name)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return ErrGroupExists
+		}
+		now := s.Clock.Now()
+		id = types.NewGroupID()
+		g := identity.Group{
+			ID:        id,
+			IdpID:     id,
+			Status:    types.IdpStatusACTIVE,
+			Source:    identity.SCIM,
+			Users:     []string{},
+			CreatedAt: now,
+		}
+		return d.applyGroup(ctx, &g, in, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+	g := d.groups[id]
+	return &g, nil
+}
+
+// UpdateGroup replaces the attributes and the members of a group.
+func (s *Service) UpdateGroup(ctx context.Context, id string, in GroupInput) (*identity.Group, error) {
+	d, err := s.update(ctx, func(d *directory) error {
+		g, ok, err := d.group(ctx, id)
+		if err != nil {
+			return err
+		}
+		if !ok || !groupVisible(g) {
+			return ErrGroupNotFound
+		}
+		other, exists, err := d.scimGroupByName(ctx, in.Name)
+		if err != nil {
+			return err
+		}
+		if exists && other.ID != id {
+			return ErrGroupExists
+		}
+		return d.applyGroup(ctx, &g, in, s.Clock.Now())
+	})
+	if err != nil {
+		return nil, err
+	}
+	g := d.groups[id]
+	return &g, nil
+}
+
+// DeleteGroup archives a group and removes all of its members.
+func (s *Service) DeleteGroup(ctx context.Context, id string) error {
+	_, err := s.update(ctx, func(d *directory) error {
+		g, ok, err := d.group(ctx, id)
+		if err != nil {
+			return err
+		}
+		if !ok || !groupVisible(g) {
+			return ErrGroupNotFound
+		}
+		err = d.applyGroup(ctx, &g, GroupInput{Name: g.Name, ExternalID: g.ExternalID}, s.Clock.Now())
+		if err != nil {
+			return err
+		}
+		g.Status = types.IdpStatusARCHIVED
+		d.groups[g.ID] = g
+		return nil
+	})
+	return err
+}
+
+// applyGroup updates the group from the SCIM input, updating the groups of any users which were added or removed,
+// and stores it in the directory. Only the users which are added or removed are read.
+func (d *directory) applyGroup(ctx context.Context, g *identity.Group, in GroupInput, now time.Time) error {
+	var members []string
+	for _, id := range in.Members {
+		if contains(members, id) {
+			continue
+		}
+		// existing members are active, as archived users are removed from their groups
+		if !contains(g.Users, id) {
+			u, ok, err := d.user(ctx, id)
+			if err != nil {
+				return err
+			}
+			if !ok || u.Status != types.IdpStatusACTIVE {
+				return InvalidMemberError{UserID: id}
+			}
+		}
+		members = append(members, id)
+	}
+
+	for _, id := range g.Users {
+		if contains(members, id) {
+			continue
+		}
+		u, ok, err := d.user(ctx, id)
+		if err != nil {
+			return err
+		}
+		if ok {
+			u.RemoveGroup(g.ID)
+			if u.Groups == nil {
+				u.Groups = []string{}
+			}
+			u.UpdatedAt = now
+			d.users[id] = u
+		}
+	}
+	for _, id := range members {
+		if !contains(g.Users, id) {
+			u := d.users[id]
+			u.AddGroup(g.ID)
+			u.UpdatedAt = now
+			d.users[id] = u
+		}
+	}
+
+	if members == nil {
+		members = []string{}
+	}
+	g.Name = in.Name
+	g.ExternalID = in.ExternalID
+	g.Users = members
+	g.UpdatedAt = now
+	d.groups[g.ID] = *g
+	return nil
+}
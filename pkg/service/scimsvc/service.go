@@ -0,0 +1,254 @@
+package scimsvc
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/service/identitysvc"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+)
+
+// Service holds business logic relating to users and groups which are provisioned through SCIM.
+//
+// SCIM users and groups are stored with identity.SCIM as their source, so that scheduled identity sync leaves them unchanged.
+// DB should be a storage.VersionGuard, so that changes made by concurrent requests aren't lost. Operations which
+// conflict with a concurrent change are retried, and ErrConflict is returned if they still conflict.
+type Service struct {
+	DB       ddb.Storage
+	Clock    clock.Clock
+	Identity identitysvc.IdentityService
+}
+
+// maxAttempts is how many times an operation is attempted if the users or groups it changes are written concurrently.
+const maxAttempts = 3
+
+// directory holds the users and groups which a SCIM operation reads, so that the operation can be applied
+// and then only the items which changed are written back. Items are loaded the first time they are read.
+type directory struct {
+	db     ddb.Storage
+	users  map[string]identity.User
+	groups map[string]identity.Group
+
+	originalUsers  map[string]identity.User
+	originalGroups map[string]identity.Group
+}
+
+func newDirectory(db ddb.Storage) *directory {
+	return &directory{
+		db:             db,
+		users:          make(map[string]identity.User),
+		groups:         make(map[string]identity.Group),
+		originalUsers:  make(map[string]identity.User),
+		originalGroups: make(map[string]identity.Group),
+	}
+}
+
+// user returns the user with the given ID, and false if it doesn't exist.
+func (d *directory) user(ctx context.Context, id string) (identity.User, bool, error) {
+	if u, ok := d.users[id]; ok {
+		return u, true, nil
+	}
+	q := storage.GetUser{ID: id}
+	_, err := d.db.Query(ctx, &q)
+	if err == ddb.ErrNoItems {
+		return identity.User{}, false, nil
+	}
+	if err != nil {
+		return identity.User{}, false, err
+	}
+	d.addUser(*q.Result)
+	return *q.Result, true, nil
+}
+
+// userByEmail returns the user with the given email. Emails are compared as they were provided,
+// and then in lower case, as identity providers differ in how they return emails.
+func (d *directory) userByEmail(ctx context.Context, email string) (identity.User, bool, error) {
+	candidates := []string{email}
+	if lower := strings.ToLower(email); lower != email {
+		candidates = append(candidates, lower)
+	}
+	for _, e := range candidates {
+		q := storage.GetUserByEmail{Email: e}
+		_, err := d.db.Query(ctx, &q)
+		if err == ddb.ErrNoItems {
+			continue
+		}
+		if err != nil {
+			return identity.User{}, false, err
+		}
+		// prefer the copy which has been changed by the operation
+		if u, ok := d.users[q.Result.ID]; ok {
+			return u, true, nil
+		}
+		d.addUser(*q.Result)
+		return *q.Result, true, nil
+	}
+	return identity.User{}, false, nil
+}
+
+// group returns the group with the given ID, and false if it doesn't exist.
+func (d *directory) group(ctx context.Context, id string) (identity.Group, bool, error) {
+	if g, ok := d.groups[id]; ok {
+		return g, true, nil
+	}
+	q := storage.GetGroup{ID: id}
+	_, err := d.db.Query(ctx, &q)
+	if err == ddb.ErrNoItems {
+		return identity.Group{}, false, nil
+	}
+	if err != nil {
+		return identity.Group{}, false, err
+	}
+	d.addGroup(*q.Result)
+	return *q.Result, true, nil
+}
+
+// scimGroupByName returns the active SCIM group with the given name, and false if there isn't one.
+func (d *directory) scimGroupByName(ctx context.Context, name string) (identity.Group, bool, error) {
+	q := storage.GetGroupByName{Source: identity.SCIM, Status: types.IdpStatusACTIVE, Name: name}
+	_, err := d.db.Query(ctx, &q)
+	if err == ddb.ErrNoItems {
+		return identity.Group{}, false, nil
+	}
+	if err != nil {
+		return identity.Group{}, false, err
+	}
+	if g, ok := d.groups[q.Result.ID]; ok {
+		return g, true, nil
+	}
+	d.addGroup(*q.Result)
+	return *q.Result, true, nil
+}
+
+func (d *directory) addUser(u identity.User) {
+	d.users[u.ID] = u
+	d.originalUsers[u.ID] = copyUser(u)
+}
+
+func (d *directory) addGroup(g identity.Group) {
+	d.groups[g.ID] = g
+	d.originalGroups[g.ID] = copyGroup(g)
+}
+
+// update applies an operation to a new directory and saves it. If the items were changed concurrently
+// the operation is applied again to the current items.
+func (s *Service) update(ctx context.Context, apply func(d *directory) error) (*directory, error) {
+	for attempt := 1; ; attempt++ {
+		d := newDirectory(s.DB)
+		err := apply(d)
+		if err != nil {
+			return nil, err
+		}
+		err = s.save(ctx, d)
+		if errors.Is(err, storage.ErrVersionConflict) {
+			if attempt < maxAttempts {
+				continue
+			}
+			return nil, ErrConflict
+		}
+		if err != nil {
+			return nil, err
+		}
+		return d, nil
+	}
+}
+
+// save recalculates the access rules of the users which were read and writes any users and groups which have changed.
+func (s *Service) save(ctx context.Context, d *directory) error {
+	// access rules are only recalculated for the users which were read, so the groups are limited to those members
+	// rather than loading every member of the groups
+	byEmail := make(map[string]identity.User)
+	groups := make(map[string]identity.Group)
+	for _, u := range d.users {
+		byEmail[u.Email] = u
+		for _, gid := range u.Groups {
+			g, ok, err := d.group(ctx, gid)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				continue
+			}
+			var members []string
+			for _, id := range g.Users {
+				if _, ok := d.users[id]; ok {
+					members = append(members, id)
+				}
+			}
+			g.Users = members
+			groups[gid] = g
+		}
+	}
+	byEmail, err := s.Identity.UpdateUserAccessRules(ctx, byEmail, groups)
+	if err != nil {
+		return err
+	}
+
+	var items []ddb.Keyer
+	for _, u := range byEmail {
+		u := u
+		d.users[u.ID] = u
+		if original, ok := d.originalUsers[u.ID]; ok && userEqual(original, u) {
+			continue
+		}
+		items = append(items, &u)
+	}
+	for _, g := range d.groups {
+		g := g
+		if original, ok := d.originalGroups[g.ID]; ok && reflect.DeepEqual(original, g) {
+			continue
+		}
+		items = append(items, &g)
+	}
+	if len(items) == 0 {
+		return nil
+	}
+	return s.DB.PutBatch(ctx, items...)
+}
+
+// userEqual compares users, treating nil and empty access rules and groups as equal.
+func userEqual(a, b identity.User) bool {
+	if len(a.Groups) == 0 && len(b.Groups) == 0 {
+		a.Groups, b.Groups = nil, nil
+	}
+	if len(a.AccessRules) == 0 && len(b.AccessRules) == 0 {
+		a.AccessRules, b.AccessRules = nil, nil
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func copyUser(u identity.User) identity.User {
+	u.Groups = append([]string(nil), u.Groups...)
+	u.AccessRules = append([]string(nil), u.AccessRules...)
+	return u
+}
+
+func copyGroup(g identity.Group) identity.Group {
+	g.Users = append([]string(nil), g.Users...)
+	return g
+}
+
+func contains(set []string, str string) bool {
+	for _, s := range set {
+		if s == str {
+			return true
+		}
+	}
+	return false
+}
+
+func remove(set []string, str string) []string {
+	var res []string
+	for _, s := range set {
+		if s != str {
+			res = append(res, s)
+		}
+	}
+	return res
+}
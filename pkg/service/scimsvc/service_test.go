@@ -0,0 +1,368 @@
+package scimsvc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/rule"
+	"github.com/common-fate/common-fate/pkg/service/identitysvc"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockClient = ddbmock.Client
+
+// testDB serves the users, groups and access rules, and records the items written by the service
+type testDB struct {
+	*mockClient
+	storedUsers  map[string]identity.User
+	storedGroups map[string]identity.Group
+	rules        []rule.AccessRule
+	// conflicts is the number of writes which fail because the items were changed concurrently
+	conflicts int
+	// reads are the IDs of the users and groups which were read
+	reads []string
+	puts  []ddb.Keyer
+}
+
+func (d *testDB) Query(ctx context.Context, qb ddb.QueryBuilder, opts ...func(*ddb.QueryOpts)) (*ddb.QueryResult, error) {
+	switch q := qb.(type) {
+	case *storage.GetUser:
+		d.reads = append(d.reads, q.ID)
+		u, ok := d.storedUsers[q.ID]
+		if !ok {
+			return nil, ddb.ErrNoItems
+		}
+		q.Result = &u
+	case *storage.GetUserByEmail:
+		for _, u := range d.storedUsers {
+			u := u
+			if u.Email == q.Email {
+				d.reads = append(d.reads, u.ID)
+				q.Result = &u
+				return &ddb.QueryResult{}, nil
+			}
+		}
+		return nil, ddb.ErrNoItems
+	case *storage.GetGroup:
+		d.reads = append(d.reads, q.ID)
+		g, ok := d.storedGroups[q.ID]
+		if !ok {
+			return nil, ddb.ErrNoItems
+		}
+		q.Result = &g
+	case *storage.GetGroupByName:
+		for _, g := range d.storedGroups {
+			g := g
+			if g.Source == q.Source && g.Status == q.Status && g.Name == q.Name {
+				d.reads = append(d.reads, g.ID)
+				q.Result = &g
+				return &ddb.QueryResult{}, nil
+			}
+		}
+		return nil, ddb.ErrNoItems
+	case *storage.ListUsers:
+		for _, u := range d.storedUsers {
+			q.Result = append(q.Result, u)
+		}
+	case *storage.ListGroupsForSourceAndStatus:
+		for _, g := range d.storedGroups {
+			if g.Source == q.Source && g.Status == q.Status {
+				q.Result = append(q.Result, g)
+			}
+		}
+	case *storage.ListAccessRulesByPriority:
+		q.Result = d.rules
+	default:
+		return d.mockClient.Query(ctx, qb, opts...)
+	}
+	return &ddb.QueryResult{}, nil
+}
+
+func (d *testDB) All(ctx context.Context, qb ddb.QueryBuilder, opts ...func(*ddb.QueryOpts)) error {
+	_, err := d.Query(ctx, qb, opts...)
+	return err
+}
+
+func (d *testDB) PutBatch(ctx context.Context, items ...ddb.Keyer) error {
+	if d.conflicts > 0 {
+		d.conflicts--
+		return storage.ErrVersionConflict
+	}
+	d.puts = append(d.puts, items...)
+	return nil
+}
+
+func (d *testDB) users() map[string]identity.User {
+	res := make(map[string]identity.User)
+	for _, item := range d.puts {
+		if u, ok := item.(*identity.User); ok {
+			res[u.ID] = *u
+		}
+	}
+	return res
+}
+
+func (d *testDB) groups() map[string]identity.Group {
+	res := make(map[string]identity.Group)
+	for _, item := range d.puts {
+		if g, ok := item.(*identity.Group); ok {
+			res[g.ID] = *g
+		}
+	}
+	return res
+}
+
+func newTestService(t *testing.T, users []identity.User, groups []identity.Group, rules []rule.AccessRule) (*Service, *testDB) {
+	db := &testDB{
+		mockClient:   ddbmock.New(t),
+		storedUsers:  make(map[string]identity.User),
+		storedGroups: make(map[string]identity.Group),
+		rules:        rules,
+	}
+	for _, u := range users {
+		db.storedUsers[u.ID] = u
+	}
+	for _, g := range groups {
+		db.storedGroups[g.ID] = g
+	}
+	s := Service{
+		DB:       db,
+		Clock:    clock.NewMock(),
+		Identity: &identitysvc.Service{DB: db},
+	}
+	return &s, db
+}
+
+func TestCreateUser(t *testing.T) {
+	type testcase struct {
+		name      string
+		users     []identity.User
+		in        UserInput
+		wantNewID bool
+		wantID    string
+		wantErr   error
+	}
+
+	testcases := []testcase{
+		{
+			name:      "new user",
+			in:        UserInput{Email: "alice@example.com", FirstName: "Alice", Active: true},
+			wantNewID: true,
+		},
+		{
+			name:   "takes ownership of a user from identity sync",
+			users:  []identity.User{{ID: "usr_1", Email: "alice@example.com", Status: types.IdpStatusACTIVE}},
+			in:     UserInput{Email: "Alice@example.com", FirstName: "Alice", Active: true},
+			wantID: "usr_1",
+		},
+		{
+			name:   "restores a deleted SCIM user",
+			users:  []identity.User{{ID: "usr_1", Email: "alice@example.com", Status: types.IdpStatusARCHIVED, Source: identity.SCIM}},
+			in:     UserInput{Email: "alice@example.com", Active: true},
+			wantID: "usr_1",
+		},
+		{
+			name:    "user already exists",
+			users:   []identity.User{{ID: "usr_1", Email: "alice@example.com", Status: types.IdpStatusACTIVE, Source: identity.SCIM}},
+			in:      UserInput{Email: "alice@example.com", Active: true},
+			wantErr: ErrUserExists,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, db := newTestService(t, tc.users, nil, nil)
+			got, err := s.CreateUser(context.Background(), tc.in)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				assert.Empty(t, db.puts)
+				return
+			}
+			assert.NoError(t, err)
+			if tc.wantNewID {
+				assert.NotEmpty(t, got.ID)
+			} else {
+				assert.Equal(t, tc.wantID, got.ID)
+			}
+			assert.Equal(t, identity.SCIM, got.Source)
+			assert.Equal(t, types.IdpStatusACTIVE, got.Status)
+			assert.Equal(t, tc.in.Email, got.Email)
+			assert.Equal(t, *got, db.users()[got.ID])
+		})
+	}
+}
+
+func TestDeactivateUser(t *testing.T) {
+	users := []identity.User{
+		{ID: "usr_1", Email: "alice@example.com", Status: types.IdpStatusACTIVE, Source: identity.SCIM, Groups: []string{"grp_1"}, AccessRules: []string{"rul_1"}},
+	}
+	groups := []identity.Group{
+		{ID: "grp_1", IdpID: "grp_1", Name: "admins", Status: types.IdpStatusACTIVE, Source: identity.SCIM, Users: []string{"usr_1"}},
+	}
+	rules := []rule.AccessRule{{ID: "rul_1", Groups: []string{"grp_1"}}}
+	s, db := newTestService(t, users, groups, rules)
+
+	got, err := s.UpdateUser(context.Background(), "usr_1", UserInput{Email: "alice@example.com", Active: false})
+	assert.NoError(t, err)
+	assert.Equal(t, types.IdpStatusARCHIVED, got.Status)
+	assert.Empty(t, got.Groups)
+	assert.Empty(t, got.AccessRules)
+	assert.Empty(t, db.groups()["grp_1"].Users)
+}
+
+func TestCreateGroup(t *testing.T) {
+	type testcase struct {
+		name            string
+		users           []identity.User
+		in              GroupInput
+		wantAccessRules []string
+		wantErr         error
+	}
+
+	testcases := []testcase{
+		{
+			name:  "ok",
+			users: []identity.User{{ID: "usr_1", Email: "alice@example.com", Status: types.IdpStatusACTIVE, Groups: []string{"okta_1"}}},
+			in:    GroupInput{Name: "admins", Members: []string{"usr_1"}},
+		},
+		{
+			name:    "member is archived",
+			users:   []identity.User{{ID: "usr_1", Email: "alice@example.com", Status: types.IdpStatusARCHIVED}},
+			in:      GroupInput{Name: "admins", Members: []string{"usr_1"}},
+			wantErr: InvalidMemberError{UserID: "usr_1"},
+		},
+		{
+			name:    "member doesn't exist",
+			in:      GroupInput{Name: "admins", Members: []string{"usr_2"}},
+			wantErr: InvalidMemberError{UserID: "usr_2"},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, db := newTestService(t, tc.users, nil, nil)
+			got, err := s.CreateGroup(context.Background(), tc.in)
+			if tc.wantErr != nil {
+				assert.Equal(t, tc.wantErr, err)
+				assert.Empty(t, db.puts)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, got.ID, got.IdpID)
+			assert.Equal(t, identity.SCIM, got.Source)
+			assert.Equal(t, tc.in.Members, got.Users)
+			assert.Equal(t, *got, db.groups()[got.ID])
+			for _, id := range tc.in.Members {
+				assert.Contains(t, db.users()[id].Groups, got.ID)
+			}
+			// existing memberships are kept
+			assert.Contains(t, db.users()["usr_1"].Groups, "okta_1")
+		})
+	}
+}
+
+func TestUpdateGroupMembersUpdatesAccessRules(t *testing.T) {
+	users := []identity.User{
+		{ID: "usr_1", Email: "alice@example.com", Status: types.IdpStatusACTIVE, Groups: []string{"grp_1"}, AccessRules: []string{"rul_1"}},
+		{ID: "usr_2", Email: "bob@example.com", Status: types.IdpStatusACTIVE, Groups: []string{}, AccessRules: []string{}},
+		{ID: "usr_3", Email: "carol@example.com", Status: types.IdpStatusACTIVE, Groups: []string{"okta_1"}, AccessRules: []string{"rul_2"}},
+	}
+	groups := []identity.Group{
+		{ID: "grp_1", IdpID: "grp_1", Name: "admins", Status: types.IdpStatusACTIVE, Source: identity.SCIM, Users: []string{"usr_1"}},
+		{ID: "okta_1", IdpID: "okta_1", Name: "engineering", Status: types.IdpStatusACTIVE, Source: "okta", Users: []string{"usr_3"}},
+	}
+	rules := []rule.AccessRule{
+		{ID: "rul_1", Groups: []string{"grp_1"}},
+		{ID: "rul_2", Groups: []string{"okta_1"}},
+	}
+	s, db := newTestService(t, users, groups, rules)
+
+	_, err := s.UpdateGroup(context.Background(), "grp_1", GroupInput{Name: "admins", Members: []string{"usr_2"}})
+	assert.NoError(t, err)
+
+	written := db.users()
+	assert.Empty(t, written["usr_1"].Groups)
+	assert.Empty(t, written["usr_1"].AccessRules)
+	assert.Equal(t, []string{"grp_1"}, written["usr_2"].Groups)
+	assert.Equal(t, []string{"rul_1"}, written["usr_2"].AccessRules)
+	// users which didn't change aren't read or written
+	assert.NotContains(t, written, "usr_3")
+	assert.NotContains(t, db.groups(), "okta_1")
+	assert.NotContains(t, db.reads, "usr_3")
+	assert.NotContains(t, db.reads, "okta_1")
+}
+
+func TestGroupsFromIdentitySyncAreHidden(t *testing.T) {
+	groups := []identity.Group{
+		{ID: "okta_1", IdpID: "okta_1", Name: "engineering", Status: types.IdpStatusACTIVE, Source: "okta"},
+		{ID: "grp_1", IdpID: "grp_1", Name: "internal", Status: types.IdpStatusACTIVE, Source: identity.INTERNAL},
+	}
+	s, db := newTestService(t, nil, groups, nil)
+
+	_, err := s.UpdateGroup(context.Background(), "okta_1", GroupInput{Name: "engineering"})
+	assert.ErrorIs(t, err, ErrGroupNotFound)
+	_, err = s.UpdateGroup(context.Background(), "grp_1", GroupInput{Name: "internal"})
+	assert.ErrorIs(t, err, ErrGroupNotFound)
+	assert.Empty(t, db.puts)
+}
+
+func TestDeleteGroup(t *testing.T) {
+	users := []identity.User{
+		{ID: "usr_1", Email: "alice@example.com", Status: types.IdpStatusACTIVE, Groups: []string{"grp_1", "okta_1"}},
+	}
+	groups := []identity.Group{
+		{ID: "grp_1", IdpID: "grp_1", Name: "admins", Status: types.IdpStatusACTIVE, Source: identity.SCIM, Users: []string{"usr_1"}},
+	}
+	s, db := newTestService(t, users, groups, nil)
+
+	err := s.DeleteGroup(context.Background(), "grp_1")
+	assert.NoError(t, err)
+	assert.Equal(t, types.IdpStatusARCHIVED, db.groups()["grp_1"].Status)
+	assert.Empty(t, db.groups()["grp_1"].Users)
+	assert.Equal(t, []string{"okta_1"}, db.users()["usr_1"].Groups)
+}
+
+func TestConflictIsRetried(t *testing.T) {
+	users := []identity.User{
+		{ID: "usr_1", Email: "alice@example.com", Status: types.IdpStatusACTIVE, Source: identity.SCIM, Groups: []string{}},
+	}
+
+	type testcase struct {
+		name      string
+		conflicts int
+		wantErr   error
+	}
+
+	testcases := []testcase{
+		{
+			name:      "retried",
+			conflicts: maxAttempts - 1,
+		},
+		{
+			name:      "conflict on every attempt",
+			conflicts: maxAttempts,
+			wantErr:   ErrConflict,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, db := newTestService(t, users, nil, nil)
+			db.conflicts = tc.conflicts
+
+			_, err := s.UpdateUser(context.Background(), "usr_1", UserInput{Email: "alice@example.com", FirstName: "Alice", Active: true})
+			assert.Equal(t, tc.wantErr, err)
+			if tc.wantErr != nil {
+				assert.Empty(t, db.puts)
+				return
+			}
+			assert.Equal(t, "Alice", db.users()["usr_1"].FirstName)
+		})
+	}
+}
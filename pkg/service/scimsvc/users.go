@@ -0,0 +1,180 @@
+package scimsvc
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+)
+
+// UserInput is the set of user attributes which can be provisioned through SCIM.
+type UserInput struct {
+	Email      string
+	FirstName  string
+	LastName   string
+	ExternalID string
+	Active     bool
+}
+
+// userVisible returns true if the user should be returned from the SCIM API.
+// Archived users which were not provisioned through SCIM are hidden, so that deleting a user through SCIM
+// behaves as expected.
+func userVisible(u identity.User) bool {
+	return u.Status == types.IdpStatusACTIVE || u.Source == identity.SCIM
+}
+
+// ListUsers returns the users which are visible through SCIM, sorted by email.
+func (s *Service) ListUsers(ctx context.Context) ([]identity.User, error) {
+	q := storage.ListUsers{}
+	err := s.DB.All(ctx, &q)
+	if err != nil {
+		return nil, err
+	}
+	var users []identity.User
+	for _, u := range q.Result {
+		if userVisible(u) {
+			users = append(users, u)
+		}
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].Email < users[j].Email })
+	return users, nil
+}
+
+// GetUser returns the user with the given ID, or ErrUserNotFound if the user isn't visible through SCIM.
+func (s *Service) GetUser(ctx context.Context, id string) (*identity.User, error) {
+	u, ok, err := newDirectory(s.DB).user(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !ok || !userVisible(u) {
+		return nil, ErrUserNotFound
+	}
+	return &u, nil
+}
+
+// CreateUser provisions a user.
+// If a user with the same email was created by identity sync or was previously deleted, SCIM takes ownership of that user
+// rather than creating a duplicate.
+func (s *Service) CreateUser(ctx context.Context, in UserInput) (*identity.User, error) {
+	var id string
+	d, err := s.update(ctx, func(d *directory) error {
+		now := s.Clock.Now()
+		u, ok, err := d.userByEmail(ctx, in.Email)
+		if err != nil {
+			return err
+		}
+		if ok && u.Source == identity.SCIM && u.Status == types.IdpStatusACTIVE {
+			return ErrUserExists
+		}
+		if !ok {
+			u = identity.User{
+				ID:          types.NewUserID(),
+				Groups:      []string{},
+				AccessRules: []string{},
+				CreatedAt:   now,
+			}
+		}
+		id = u.ID
+		return d.applyUser(ctx, &u, in, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+	u := d.users[id]
+	return &u, nil
+}
+
+// UpdateUser replaces the attributes of a user.
+// Updating a user which was created by identity sync makes SCIM the source of that user.
+func (s *Service) UpdateUser(ctx context.Context, id string, in UserInput) (*identity.User, error) {
+	d, err := s.update(ctx, func(d *directory) error {
+		u, ok, err := d.user(ctx, id)
+		if err != nil {
+			return err
+		}
+		if !ok || !userVisible(u) {
+			return ErrUserNotFound
+		}
+		existing, ok, err := d.userByEmail(ctx, in.Email)
+		if err != nil {
+			return err
+		}
+		if ok && existing.ID != id {
+			return ErrUserExists
+		}
+		return d.applyUser(ctx, &u, in, s.Clock.Now())
+	})
+	if err != nil {
+		return nil, err
+	}
+	u := d.users[id]
+	return &u, nil
+}
+
+// DeleteUser archives a user and removes it from all of its groups.
+// The user is released from SCIM, so it may be restored by identity sync if it still exists in the identity provider.
+func (s *Service) DeleteUser(ctx context.Context, id string) error {
+	_, err := s.update(ctx, func(d *directory) error {
+		u, ok, err := d.user(ctx, id)
+		if err != nil {
+			return err
+		}
+		if !ok || !userVisible(u) {
+			return ErrUserNotFound
+		}
+		err = d.archiveUser(ctx, &u, s.Clock.Now())
+		if err != nil {
+			return err
+		}
+		u.Source = ""
+		u.ExternalID = ""
+		d.users[u.ID] = u
+		return nil
+	})
+	return err
+}
+
+// applyUser updates the user from the SCIM input and stores it in the directory.
+func (d *directory) applyUser(ctx context.Context, u *identity.User, in UserInput, now time.Time) error {
+	u.Email = in.Email
+	u.FirstName = in.FirstName
+	u.LastName = in.LastName
+	u.ExternalID = in.ExternalID
+	u.Source = identity.SCIM
+	u.UpdatedAt = now
+	if u.Groups == nil {
+		u.Groups = []string{}
+	}
+	if in.Active {
+		u.Status = types.IdpStatusACTIVE
+	} else if u.Status != types.IdpStatusARCHIVED {
+		err := d.archiveUser(ctx, u, now)
+		if err != nil {
+			return err
+		}
+	}
+	d.users[u.ID] = *u
+	return nil
+}
+
+// archiveUser archives the user and removes it from all of its groups.
+func (d *directory) archiveUser(ctx context.Context, u *identity.User, now time.Time) error {
+	for _, gid := range u.Groups {
+		g, ok, err := d.group(ctx, gid)
+		if err != nil {
+			return err
+		}
+		if ok {
+			g.Users = remove(g.Users, u.ID)
+			g.UpdatedAt = now
+			d.groups[gid] = g
+		}
+	}
+	u.Groups = []string{}
+	u.Status = types.IdpStatusARCHIVED
+	u.UpdatedAt = now
+	return nil
+}
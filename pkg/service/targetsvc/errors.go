@@ -15,4 +15,13 @@ var (
 
 	// ErrProviderNotFound is returned if a matching provider could not be found in the registry
 	ErrProviderDoesNotImplementKind = errors.New("provider does not implement the kind")
+
+	// ErrTargetMetadataNotFound is returned if target metadata with the supplied id does not exist
+	ErrTargetMetadataNotFound = errors.New("target metadata not found")
+
+	// ErrInvalidTargetMetadata is returned if target metadata does not specify exactly one of a target id or field patterns, or has no tags
+	ErrInvalidTargetMetadata = errors.New("target metadata must have tags and exactly one of targetId or fieldPatterns")
+
+	// ErrInvalidFieldPattern is returned if a field pattern is not a valid glob pattern
+	ErrInvalidFieldPattern = errors.New("invalid field pattern")
 )
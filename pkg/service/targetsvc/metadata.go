@@ -0,0 +1,92 @@
+package targetsvc
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/target"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+)
+
+// CreateMetadata saves admin curated target metadata and refreshes the cached targets so that the tags are applied
+func (s *Service) CreateMetadata(ctx context.Context, req types.CreateTargetMetadataRequest) (*target.Metadata, error) {
+	now := s.Clock.Now()
+	m := target.Metadata{
+		ID:        types.NewTargetMetadataID(),
+		CreatedAt: now,
+	}
+	err := s.saveMetadata(ctx, &m, req)
+	if err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// UpdateMetadata replaces the target and tags of existing target metadata
+func (s *Service) UpdateMetadata(ctx context.Context, id string, req types.CreateTargetMetadataRequest) (*target.Metadata, error) {
+	q := storage.GetTargetMetadata{ID: id}
+	_, err := s.DB.Query(ctx, &q)
+	if err == ddb.ErrNoItems {
+		return nil, ErrTargetMetadataNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = s.saveMetadata(ctx, q.Result, req)
+	if err != nil {
+		return nil, err
+	}
+	return q.Result, nil
+}
+
+func (s *Service) DeleteMetadata(ctx context.Context, id string) error {
+	q := storage.GetTargetMetadata{ID: id}
+	_, err := s.DB.Query(ctx, &q)
+	if err == ddb.ErrNoItems {
+		return ErrTargetMetadataNotFound
+	}
+	if err != nil {
+		return err
+	}
+	err = s.DB.Delete(ctx, q.Result)
+	if err != nil {
+		return err
+	}
+	return s.Cache.RefreshCachedTargets(ctx)
+}
+
+func (s *Service) saveMetadata(ctx context.Context, m *target.Metadata, req types.CreateTargetMetadataRequest) error {
+	m.TargetID = ""
+	m.Kind = ""
+	m.FieldPatterns = nil
+	if req.TargetId != nil {
+		m.TargetID = *req.TargetId
+	}
+	if req.Kind != nil {
+		m.Kind = *req.Kind
+	}
+	if req.FieldPatterns != nil {
+		m.FieldPatterns = req.FieldPatterns.AdditionalProperties
+	}
+	m.Tags = req.Tags.AdditionalProperties
+
+	hasPatterns := len(m.FieldPatterns) > 0
+	if len(m.Tags) == 0 || (m.TargetID == "") == !hasPatterns {
+		return ErrInvalidTargetMetadata
+	}
+	for fieldID, pattern := range m.FieldPatterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("%w for field %s: %s", ErrInvalidFieldPattern, fieldID, err)
+		}
+	}
+
+	m.UpdatedAt = s.Clock.Now()
+	err := s.DB.Put(ctx, m)
+	if err != nil {
+		return err
+	}
+	return s.Cache.RefreshCachedTargets(ctx)
+}
@@ -0,0 +1,91 @@
+package targetsvc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/common-fate/pkg/service/targetsvc/mocks"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/target"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateMetadata(t *testing.T) {
+	type testcase struct {
+		name    string
+		give    types.CreateTargetMetadataRequest
+		wantErr error
+	}
+	targetID := "common-fate#aws#Account#accountId#123#"
+	tags := types.TargetTags{AdditionalProperties: map[string]string{"env": "prod"}}
+	patterns := &types.TargetFieldPatterns{AdditionalProperties: map[string]string{"accountId": "prod-*"}}
+
+	testcases := []testcase{
+		{
+			name: "ok target id",
+			give: types.CreateTargetMetadataRequest{TargetId: &targetID, Tags: tags},
+		},
+		{
+			name: "ok field patterns",
+			give: types.CreateTargetMetadataRequest{FieldPatterns: patterns, Tags: tags},
+		},
+		{
+			name:    "no tags",
+			give:    types.CreateTargetMetadataRequest{TargetId: &targetID},
+			wantErr: ErrInvalidTargetMetadata,
+		},
+		{
+			name:    "both target id and field patterns",
+			give:    types.CreateTargetMetadataRequest{TargetId: &targetID, FieldPatterns: patterns, Tags: tags},
+			wantErr: ErrInvalidTargetMetadata,
+		},
+		{
+			name:    "neither target id or field patterns",
+			give:    types.CreateTargetMetadataRequest{Tags: tags},
+			wantErr: ErrInvalidTargetMetadata,
+		},
+		{
+			name:    "invalid pattern",
+			give:    types.CreateTargetMetadataRequest{FieldPatterns: &types.TargetFieldPatterns{AdditionalProperties: map[string]string{"accountId": "prod-["}}, Tags: tags},
+			wantErr: ErrInvalidFieldPattern,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			db := ddbmock.New(t)
+			c := mocks.NewMockCacheService(ctrl)
+			if tc.wantErr == nil {
+				c.EXPECT().RefreshCachedTargets(gomock.Any()).Return(nil)
+			}
+			s := Service{
+				Clock: clock.NewMock(),
+				DB:    db,
+				Cache: c,
+			}
+
+			got, err := s.CreateMetadata(context.Background(), tc.give)
+			assert.ErrorIs(t, err, tc.wantErr)
+			if tc.wantErr == nil {
+				assert.Equal(t, tc.give.Tags.AdditionalProperties, got.Tags)
+			}
+		})
+	}
+}
+
+func TestDeleteMetadataNotFound(t *testing.T) {
+	db := ddbmock.New(t)
+	db.MockQueryWithErr(&storage.GetTargetMetadata{Result: &target.Metadata{}}, ddb.ErrNoItems)
+	s := Service{Clock: clock.NewMock(), DB: db}
+
+	err := s.DeleteMetadata(context.Background(), "tmd_1")
+	assert.Equal(t, ErrTargetMetadataNotFound, err)
+}
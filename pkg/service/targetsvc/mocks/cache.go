@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/common-fate/common-fate/pkg/service/targetsvc (interfaces: CacheService)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	target "github.com/common-fate/common-fate/pkg/target"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCacheService is a mock of CacheService interface.
+type MockCacheService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCacheServiceMockRecorder
+}
+
+// MockCacheServiceMockRecorder is the mock recorder for MockCacheService.
+type MockCacheServiceMockRecorder struct {
+	mock *MockCacheService
+}
+
+// NewMockCacheService creates a new mock instance.
+func NewMockCacheService(ctrl *gomock.Controller) *MockCacheService {
+	mock := &MockCacheService{ctrl: ctrl}
+	mock.recorder = &MockCacheServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCacheService) EXPECT() *MockCacheServiceMockRecorder {
+	return m.recorder
+}
+
+// RefreshCachedTargets mocks base method.
+func (m *MockCacheService) RefreshCachedTargets(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshCachedTargets", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshCachedTargets indicates an expected call of RefreshCachedTargets.
+func (mr *MockCacheServiceMockRecorder) RefreshCachedTargets(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshCachedTargets", reflect.TypeOf((*MockCacheService)(nil).RefreshCachedTargets), arg0)
+}
+
+// SyncTargetGroup mocks base method.
+func (m *MockCacheService) SyncTargetGroup(arg0 context.Context, arg1 target.Group) (*target.SyncStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncTargetGroup", arg0, arg1)
+	ret0, _ := ret[0].(*target.SyncStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SyncTargetGroup indicates an expected call of SyncTargetGroup.
+func (mr *MockCacheServiceMockRecorder) SyncTargetGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncTargetGroup", reflect.TypeOf((*MockCacheService)(nil).SyncTargetGroup), arg0, arg1)
+}
@@ -1,9 +1,12 @@
 package targetsvc
 
 import (
+	"context"
+
 	registry_types "github.com/common-fate/provider-registry-sdk-go/pkg/providerregistrysdk"
 
 	"github.com/benbjohnson/clock"
+	"github.com/common-fate/common-fate/pkg/target"
 	"github.com/common-fate/ddb"
 )
 
@@ -11,4 +14,11 @@ type Service struct {
 	Clock                  clock.Clock
 	DB                     ddb.Storage
 	ProviderRegistryClient registry_types.ClientWithResponsesInterface
+	Cache                  CacheService
+}
+
+//go:generate go run github.com/golang/mock/mockgen -destination=mocks/cache.go -package=mocks . CacheService
+type CacheService interface {
+	RefreshCachedTargets(ctx context.Context) error
+	SyncTargetGroup(ctx context.Context, tg target.Group) (*target.SyncStatus, error)
 }
@@ -0,0 +1,26 @@
+package targetsvc
+
+import (
+	"context"
+
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/common-fate/pkg/target"
+)
+
+// SyncGroup refreshes the cached resources for a single target group, then regenerates the cached targets.
+// A failure to refresh the resources is recorded on the returned sync status rather than returned as an error.
+func (s *Service) SyncGroup(ctx context.Context, group target.Group) (*target.SyncStatus, error) {
+	status, err := s.Cache.SyncTargetGroup(ctx, group)
+	if status == nil {
+		return nil, err
+	}
+	if err != nil {
+		logger.Get(ctx).Errorw("failed to refresh resources for targetgroup", "targetgroup", group.ID, "error", err)
+		return status, nil
+	}
+	err = s.Cache.RefreshCachedTargets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return status, nil
+}
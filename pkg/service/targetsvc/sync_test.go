@@ -0,0 +1,60 @@
+package targetsvc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/common-fate/common-fate/pkg/service/targetsvc/mocks"
+	"github.com/common-fate/common-fate/pkg/target"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncGroup(t *testing.T) {
+	type testcase struct {
+		name          string
+		mockStatus    *target.SyncStatus
+		mockSyncErr   error
+		wantRefresh   bool
+		wantErr       error
+		wantNilStatus bool
+	}
+	status := &target.SyncStatus{TargetGroupID: "tg1"}
+	testcases := []testcase{
+		{
+			name:        "ok",
+			mockStatus:  status,
+			wantRefresh: true,
+		},
+		{
+			name:        "refresh failure is recorded on the status",
+			mockStatus:  status,
+			mockSyncErr: errors.New("handler unavailable"),
+		},
+		{
+			name:          "status could not be saved",
+			mockSyncErr:   errors.New("internal error"),
+			wantErr:       errors.New("internal error"),
+			wantNilStatus: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			c := mocks.NewMockCacheService(ctrl)
+			c.EXPECT().SyncTargetGroup(gomock.Any(), gomock.Any()).Return(tc.mockStatus, tc.mockSyncErr)
+			if tc.wantRefresh {
+				c.EXPECT().RefreshCachedTargets(gomock.Any()).Return(nil)
+			}
+			s := Service{Cache: c}
+
+			got, err := s.SyncGroup(context.Background(), target.Group{ID: "tg1"})
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantNilStatus, got == nil)
+		})
+	}
+}
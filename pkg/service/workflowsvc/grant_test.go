@@ -0,0 +1,278 @@
+package workflowsvc
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/common-fate/iso8601"
+	"github.com/stretchr/testify/assert"
+)
+
+// testClaimer holds claims in memory
+type testClaimer struct {
+	mu     sync.Mutex
+	claims map[string]bool
+}
+
+func (c *testClaimer) Claim(ctx context.Context, target access.GroupTarget, now time.Time) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.claims == nil {
+		c.claims = map[string]bool{}
+	}
+	if c.claims[target.ID] {
+		return false, nil
+	}
+	c.claims[target.ID] = true
+	return true, nil
+}
+
+func (c *testClaimer) Release(ctx context.Context, target access.GroupTarget) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.claims, target.ID)
+	return nil
+}
+
+// testRuntime records the targets which were granted
+type testRuntime struct {
+	mu      sync.Mutex
+	granted []string
+}
+
+func (r *testRuntime) Grant(ctx context.Context, grant access.GroupTarget) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.granted = append(r.granted, grant.ID)
+	return nil
+}
+
+func (r *testRuntime) Revoke(ctx context.Context, grantID string) error {
+	return nil
+}
+
+func testGroup() access.GroupWithTargets {
+	return access.GroupWithTargets{
+		Group: access.Group{
+			ID:              "group1",
+			RequestID:       "req1",
+			Status:          types.RequestAccessGroupStatusAPPROVED,
+			RequestedTiming: access.Timing{Duration: time.Hour},
+		},
+		Targets: []access.GroupTarget{
+			{ID: "target1", GroupID: "group1", RequestID: "req1"},
+			{ID: "target2", GroupID: "group1", RequestID: "req1"},
+		},
+	}
+}
+
+func TestGrantDuplicateEvents(t *testing.T) {
+	runtime := &testRuntime{}
+	db := ddbmock.New(t)
+	for i := 0; i < 3; i++ {
+		group := testGroup()
+		db.MockQuery(&storage.GetRequestGroupWithTargets{Result: &group})
+	}
+	s := Service{
+		Runtime: runtime,
+		DB:      db,
+		Clk:     clock.NewMock(),
+		Claimer: &testClaimer{},
+	}
+
+	for i := 0; i < 3; i++ {
+		_, err := s.Grant(context.Background(), "req1", "group1")
+		assert.NoError(t, err)
+	}
+	// each target is provisioned once, even though the workflow was started three times
+	assert.Equal(t, []string{"target1", "target2"}, runtime.granted)
+}
+
+func TestGrantSkipsProvisionedTargets(t *testing.T) {
+	runtime := &testRuntime{}
+	clk := clock.NewMock()
+	start := clk.Now().Add(-time.Minute)
+	group := testGroup()
+	group.Group.FinalTiming = &access.FinalTiming{Start: start, End: start.Add(time.Hour)}
+	group.Targets[0].Grant = &access.Grant{
+		Status: types.RequestAccessGroupTargetStatusACTIVE,
+		Start:  iso8601.New(start),
+		End:    iso8601.New(start.Add(time.Hour)),
+	}
+	db := ddbmock.New(t)
+	db.MockQuery(&storage.GetRequestGroupWithTargets{Result: &group})
+	s := Service{
+		Runtime: runtime,
+		DB:      db,
+		Clk:     clk,
+		Claimer: &testClaimer{},
+	}
+
+	got, err := s.Grant(context.Background(), "req1", "group1")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"target2"}, runtime.granted)
+	// the remaining target is granted with the timing the group was started with
+	assert.Equal(t, iso8601.New(start), got[1].Grant.Start)
+}
+
+// testFreezes blocks every activation with its freezes
+type testFreezes struct {
+	freezes []access.Freeze
+}
+
+func (f *testFreezes) Blocking(ctx context.Context, groups []string, accessRuleIDs []string, targetGroupIDs []string) ([]access.Freeze, error) {
+	return f.freezes, nil
+}
+
+// holdDB records the activations which were held
+type holdDB struct {
+	*mockClient
+	held []access.HeldActivation
+}
+
+type mockClient = ddbmock.Client
+
+func (d *holdDB) Put(ctx context.Context, item ddb.Keyer) error {
+	if h, ok := item.(*access.HeldActivation); ok {
+		d.held = append(d.held, *h)
+	}
+	return d.mockClient.Put(ctx, item)
+}
+
+func TestHoldForFreezes(t *testing.T) {
+	clk := clock.NewMock()
+	start := clk.Now()
+	end := start.Add(time.Hour)
+	endsAt := func(d time.Duration) *time.Time {
+		e := start.Add(d)
+		return &e
+	}
+
+	type testcase struct {
+		name      string
+		freezes   []access.Freeze
+		wantStart time.Time
+		wantHeld  bool
+	}
+
+	testcases := []testcase{
+		{
+			name:      "not frozen",
+			wantStart: start,
+		},
+		{
+			name:      "freeze ends before the grant is delayed until it ends",
+			freezes:   []access.Freeze{{ID: "frz_1", EndsAt: endsAt(10 * time.Minute)}, {ID: "frz_2", EndsAt: endsAt(20 * time.Minute)}},
+			wantStart: start.Add(20 * time.Minute),
+		},
+		{
+			name:      "freeze without an end time is held",
+			freezes:   []access.Freeze{{ID: "frz_1", EndsAt: endsAt(10 * time.Minute)}, {ID: "frz_2"}},
+			wantStart: start,
+			wantHeld:  true,
+		},
+		{
+			name:      "freeze ending after the grant is held",
+			freezes:   []access.Freeze{{ID: "frz_1", EndsAt: endsAt(2 * time.Hour)}},
+			wantStart: start,
+			wantHeld:  true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock := ddbmock.New(t)
+			mock.MockQuery(&storage.GetUser{Result: &identity.User{ID: "usr_1"}})
+			db := &holdDB{mockClient: mock}
+			s := Service{DB: db, Clk: clk, Freezes: &testFreezes{freezes: tc.freezes}}
+
+			gotStart, gotHeld, err := s.holdForFreezes(context.Background(), testGroup(), start, end)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantStart, gotStart)
+			assert.Equal(t, tc.wantHeld, gotHeld)
+			if !tc.wantHeld {
+				assert.Empty(t, db.held)
+				return
+			}
+			var ids []string
+			for _, f := range tc.freezes {
+				ids = append(ids, f.ID)
+			}
+			assert.Equal(t, []access.HeldActivation{{RequestID: "req1", GroupID: "group1", FreezeIDs: ids, HeldAt: clk.Now()}}, db.held)
+		})
+	}
+}
+
+func TestGrantHeldByFreeze(t *testing.T) {
+	runtime := &testRuntime{}
+	group := testGroup()
+	mock := ddbmock.New(t)
+	mock.MockQuery(&storage.GetRequestGroupWithTargets{Result: &group})
+	mock.MockQuery(&storage.GetUser{Result: &identity.User{ID: "usr_1"}})
+	db := &holdDB{mockClient: mock}
+	s := Service{
+		Runtime: runtime,
+		DB:      db,
+		Clk:     clock.NewMock(),
+		Claimer: &testClaimer{},
+		Freezes: &testFreezes{freezes: []access.Freeze{{ID: "frz_1"}}},
+	}
+
+	got, err := s.Grant(context.Background(), "req1", "group1")
+	assert.NoError(t, err)
+	assert.Empty(t, runtime.granted)
+	assert.Len(t, db.held, 1)
+	// held activations don't have grants, so the event handler can tell they weren't started
+	for _, target := range got {
+		assert.Nil(t, target.Grant)
+	}
+}
+
+// failingPutDB fails every batch write
+type failingPutDB struct {
+	*mockClient
+	err error
+}
+
+func (d *failingPutDB) PutBatch(ctx context.Context, items ...ddb.Keyer) error {
+	return d.err
+}
+
+func TestGrantReleasesClaimsOnFailure(t *testing.T) {
+	runtime := &testRuntime{}
+	putErr := errors.New("throttled")
+	mock := ddbmock.New(t)
+	for i := 0; i < 2; i++ {
+		group := testGroup()
+		mock.MockQuery(&storage.GetRequestGroupWithTargets{Result: &group})
+	}
+	db := &failingPutDB{mockClient: mock, err: putErr}
+	claimer := &testClaimer{}
+	s := Service{
+		Runtime: runtime,
+		DB:      db,
+		Clk:     clock.NewMock(),
+		Claimer: claimer,
+	}
+
+	_, err := s.Grant(context.Background(), "req1", "group1")
+	assert.Equal(t, putErr, err)
+	assert.Empty(t, runtime.granted)
+	assert.Empty(t, claimer.claims)
+
+	// the retried workflow provisions the targets
+	db.err = nil
+	_, err = s.Grant(context.Background(), "req1", "group1")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"target1", "target2"}, runtime.granted)
+}
@@ -0,0 +1,86 @@
+package workflowsvc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/targetgroupgranter"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+)
+
+type GrantHandler interface {
+	HandleRequest(ctx context.Context, in targetgroupgranter.InputEvent) (targetgroupgranter.GrantState, error)
+}
+
+// Retry runs the failed action for a grant again.
+// Activations are handed back to the runtime, and deactivations are run immediately by the granter.
+// If the retry fails, the granter adds the grant back to the failed grants.
+func (s *Service) Retry(ctx context.Context, grantID string) error {
+	log := logger.Get(ctx).With("grant.id", grantID)
+	q := storage.GetFailedGrant{GrantID: grantID}
+	_, err := s.DB.Query(ctx, &q, ddb.ConsistentRead())
+	if errors.Is(err, ddb.ErrNoItems) {
+		log.Infow("failed grant was already retried or completed")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	failed := q.Result
+
+	switch failed.Action {
+	case types.ACTIVATE:
+		grant := failed.Grant
+		if grant.Grant == nil || !grant.Grant.End.After(s.Clk.Now()) {
+			return ErrGrantInactive
+		}
+		err = s.DB.Delete(ctx, failed)
+		if err != nil {
+			return err
+		}
+
+		err = grant.TransitionGrantStatus(types.RequestAccessGroupTargetStatusAWAITINGSTART)
+		if err != nil {
+			return err
+		}
+		err = s.DB.Put(ctx, &grant)
+		if err != nil {
+			return err
+		}
+
+		err = s.Runtime.Grant(ctx, grant)
+		if err != nil {
+			g := *grant.Grant
+			g.Status = types.RequestAccessGroupTargetStatusERROR
+			grant.Grant = &g
+			return s.Eventbus.Put(ctx, gevent.GrantFailed{Grant: grant, Reason: err.Error()})
+		}
+		return nil
+
+	case types.DEACTIVATE:
+		if s.Granter == nil {
+			return errors.New("retrying deactivations is not supported by this runtime")
+		}
+		err = s.DB.Delete(ctx, failed)
+		if err != nil {
+			return err
+		}
+		_, err = s.Granter.HandleRequest(ctx, targetgroupgranter.InputEvent{
+			Action:                   targetgroupgranter.DEACTIVATE,
+			RequestAccessGroupTarget: failed.Grant,
+			State:                    failed.State,
+			// an admin can retry the deactivation again if it fails
+			Synchronous: true,
+		})
+		if err != nil {
+			// the granter has recorded the failure
+			log.Errorw("retried deactivation failed", "error", err)
+		}
+		return nil
+	}
+	return errors.New("unknown grant action: " + string(failed.Action))
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	aws_config "github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	sfntypes "github.com/aws/aws-sdk-go-v2/service/sfn/types"
 	"go.uber.org/zap"
 
 	"github.com/common-fate/common-fate/pkg/access"
@@ -40,7 +42,7 @@ func (r *Runtime) Grant(ctx context.Context, grant access.GroupTarget) error {
 		return err
 	}
 	sfnClient := sfn.NewFromConfig(cfg)
-	in := targetgroupgranter.WorkflowInput{RequestAccessGroupTarget: grant}
+	in := targetgroupgranter.WorkflowInput{RequestAccessGroupTarget: grant, Attempt: 1}
 
 	inJson, err := json.Marshal(in)
 	if err != nil {
@@ -56,6 +58,15 @@ func (r *Runtime) Grant(ctx context.Context, grant access.GroupTarget) error {
 
 	//running the step function
 	_, err = sfnClient.StartExecution(ctx, sei)
+	// execution names can't be reused, so grants which are retried by an admin are given the next retry execution name
+	for retry := 1; retry <= MaxExecutionRetries; retry++ {
+		var alreadyExists *sfntypes.ExecutionAlreadyExists
+		if !errors.As(err, &alreadyExists) {
+			return err
+		}
+		sei.Name = aws.String(RetryExecutionName(grant.ID, retry))
+		_, err = sfnClient.StartExecution(ctx, sei)
+	}
 	return err
 
 }
@@ -74,6 +85,17 @@ func (r *Runtime) Revoke(ctx context.Context, grantID string) error {
 	if err != nil {
 		return err
 	}
+	if out.Status != sfntypes.ExecutionStatusRunning {
+		// the grant may have been retried, in which case the latest execution has a retry name
+		retryARN, retryOut, err := r.latestRetryExecution(ctx, sfnClient, grantID)
+		if err != nil {
+			return err
+		}
+		if retryOut != nil {
+			exeARN = retryARN
+			out = retryOut
+		}
+	}
 
 	// build the previous grant from the execution input
 	var input targetgroupgranter.WorkflowInput
@@ -100,11 +122,18 @@ func (r *Runtime) Revoke(ctx context.Context, grantID string) error {
 	lastState := statefn.Events[len(statefn.Events)-1]
 
 	// if the state of the grant is in the active state
-	if lastState.Type == "WaitStateEntered" && *lastState.StateEnteredEventDetails.Name == "Wait for Window End" {
-
-		// Pull the state from the output of the activate step so it can be used when revoking access
-		exitActivateStepEvent := statefn.Events[len(statefn.Events)-2]
-		if exitActivateStepEvent.Type != "TaskStateExited" || exitActivateStepEvent.StateExitedEventDetails == nil {
+	if lastState.Type == "WaitStateEntered" && activeWaitStates[aws.ToString(lastState.StateEnteredEventDetails.Name)] {
+
+		// Pull the state from the output of the last task so it can be used when revoking access.
+		// Each task is followed by a choice of whether the action is held or retried.
+		var exitActivateStepEvent *sfntypes.HistoryEvent
+		for i := len(statefn.Events) - 2; i >= 0; i-- {
+			if statefn.Events[i].Type == "TaskStateExited" {
+				exitActivateStepEvent = &statefn.Events[i]
+				break
+			}
+		}
+		if exitActivateStepEvent == nil || exitActivateStepEvent.StateExitedEventDetails == nil {
 			return errors.New("unexpected workflow state")
 		}
 
@@ -120,6 +149,7 @@ func (r *Runtime) Revoke(ctx context.Context, grantID string) error {
 			Action:                   targetgroupgranter.DEACTIVATE,
 			RequestAccessGroupTarget: input.RequestAccessGroupTarget,
 			State:                    gs.State,
+			Synchronous:              true,
 		})
 		if err != nil {
 			zap.S().Errorw("failed to deactivate grant", "err", err)
@@ -136,6 +166,42 @@ func (r *Runtime) Revoke(ctx context.Context, grantID string) error {
 
 }
 
+// activeWaitStates are the wait states in the workflow where the grant is active, so it needs to be deactivated when it is revoked.
+var activeWaitStates = map[string]bool{
+	"Wait for Window End":        true,
+	"Wait to Retry Deactivation": true,
+}
+
+// MaxExecutionRetries is the number of times a grant can be retried by an admin, each retry starts a new execution.
+const MaxExecutionRetries = 100
+
+// latestRetryExecution returns the latest execution for a retried grant, or a nil output if the grant hasn't been retried.
+// Retry executions are named deterministically, so they are described in order until one doesn't exist.
+func (r *Runtime) latestRetryExecution(ctx context.Context, sfnClient *sfn.Client, grantID string) (string, *sfn.DescribeExecutionOutput, error) {
+	var latestARN string
+	var latest *sfn.DescribeExecutionOutput
+	for retry := 1; retry <= MaxExecutionRetries; retry++ {
+		arn := BuildExecutionARN(r.StateMachineARN, RetryExecutionName(grantID, retry))
+		out, err := sfnClient.DescribeExecution(ctx, &sfn.DescribeExecutionInput{ExecutionArn: aws.String(arn)})
+		var notExists *sfntypes.ExecutionDoesNotExist
+		if errors.As(err, &notExists) {
+			break
+		}
+		if err != nil {
+			return "", nil, err
+		}
+		latestARN, latest = arn, out
+	}
+	return latestARN, latest, nil
+}
+
+const retryExecutionSeparator = "-retry-"
+
+// RetryExecutionName returns the name of the execution used for the nth time a grant is retried.
+func RetryExecutionName(grantID string, retry int) string {
+	return fmt.Sprintf("%s%s%d", grantID, retryExecutionSeparator, retry)
+}
+
 func BuildExecutionARN(stateMachineARN string, grantID string) string {
 
 	splitARN := strings.Split(stateMachineARN, ":")
@@ -0,0 +1,76 @@
+package local
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+	"github.com/common-fate/ddb"
+)
+
+// Leaser claims exclusive ownership of a workflow, so that when several
+// replicas of the server are running only one of them acts on a workflow at a time.
+type Leaser interface {
+	// Acquire claims the lease for owner until the given time.
+	// It returns false if another owner holds a lease which has not expired.
+	Acquire(ctx context.Context, grantID string, owner string, now time.Time, until time.Time) (bool, error)
+	// Release gives up a lease held by owner.
+	Release(ctx context.Context, grantID string, owner string) error
+}
+
+// DynamoDBLeaser stores leases on the workflow items, using conditional writes.
+type DynamoDBLeaser struct {
+	DB ddb.Storage
+}
+
+func (l *DynamoDBLeaser) key(grantID string) map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		"PK": &types.AttributeValueMemberS{Value: keys.GrantWorkflow.PK1},
+		"SK": &types.AttributeValueMemberS{Value: keys.GrantWorkflow.SK1(grantID)},
+	}
+}
+
+func (l *DynamoDBLeaser) Acquire(ctx context.Context, grantID string, owner string, now time.Time, until time.Time) (bool, error) {
+	_, err := l.DB.Client().UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName:           aws.String(l.DB.Table()),
+		Key:                 l.key(grantID),
+		UpdateExpression:    aws.String("SET leaseOwner = :owner, leaseExpiresAt = :until"),
+		ConditionExpression: aws.String("attribute_exists(PK) AND (attribute_not_exists(leaseOwner) OR leaseOwner = :owner OR leaseExpiresAt < :now)"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":owner": &types.AttributeValueMemberS{Value: owner},
+			":until": &types.AttributeValueMemberN{Value: strconv.FormatInt(until.Unix(), 10)},
+			":now":   &types.AttributeValueMemberN{Value: strconv.FormatInt(now.Unix(), 10)},
+		},
+	})
+	var ccf *types.ConditionalCheckFailedException
+	if errors.As(err, &ccf) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (l *DynamoDBLeaser) Release(ctx context.Context, grantID string, owner string) error {
+	_, err := l.DB.Client().UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName:           aws.String(l.DB.Table()),
+		Key:                 l.key(grantID),
+		UpdateExpression:    aws.String("REMOVE leaseOwner, leaseExpiresAt"),
+		ConditionExpression: aws.String("leaseOwner = :owner"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":owner": &types.AttributeValueMemberS{Value: owner},
+		},
+	})
+	// the lease has already been released, or the workflow has been deleted
+	var ccf *types.ConditionalCheckFailedException
+	if errors.As(err, &ccf) {
+		return nil
+	}
+	return err
+}
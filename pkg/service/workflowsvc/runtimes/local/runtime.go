@@ -2,165 +2,357 @@ package local
 
 import (
 	"context"
+	"errors"
 	"sync"
 	"time"
 
+	"github.com/benbjohnson/clock"
 	"github.com/common-fate/apikit/logger"
 	"github.com/common-fate/common-fate/pkg/access"
-	"github.com/common-fate/common-fate/pkg/handler"
-	"github.com/common-fate/common-fate/pkg/service/requestroutersvc"
+	"github.com/common-fate/common-fate/pkg/gevent"
 	"github.com/common-fate/common-fate/pkg/storage"
 	"github.com/common-fate/common-fate/pkg/targetgroupgranter"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/common-fate/pkg/workflow"
 	"github.com/common-fate/ddb"
-	"github.com/common-fate/provider-registry-sdk-go/pkg/msg"
+	"github.com/segmentio/ksuid"
 )
 
-type grantWorkflow struct {
-	grant  access.GroupTarget
-	revoke chan struct{}
-	state  chan targetgroupgranter.GrantState
-}
+const (
+	// DefaultPollInterval is how often the database is checked for workflows which are due.
+	DefaultPollInterval = 10 * time.Second
+	// DefaultLeaseDuration is how long a runtime instance holds a workflow while acting on it.
+	// It should be longer than the time taken by a provider to grant or revoke access.
+	DefaultLeaseDuration = 5 * time.Minute
+)
+
+// ErrWorkflowLeased is returned when a grant cannot be revoked because
+// another instance is currently acting on it.
+var ErrWorkflowLeased = errors.New("the grant is currently being processed, try again shortly")
 
 type GrantHandler interface {
 	HandleRequest(ctx context.Context, in targetgroupgranter.InputEvent) (targetgroupgranter.GrantState, error)
 }
 
+type EventPutter interface {
+	Put(ctx context.Context, detail gevent.EventTyper) error
+}
+
+// Runtime provisions grants in the local and server modes, where the Step Functions
+// workflow used by the live runtime is not available.
+//
+// Pending activations and deactivations, along with the state returned by the provider,
+// are stored in the database so that they are resumed if the server restarts.
+// Workflows are leased while they are being acted on, so several replicas of the server
+// can share the same table.
 type Runtime struct {
-	DB                 ddb.Storage
-	Granter            GrantHandler
-	RequestRouter      *requestroutersvc.Service
-	grantsRevokeChans  map[string]grantWorkflow
-	grantsRevokeChansM sync.Mutex
+	DB      ddb.Storage
+	Granter GrantHandler
+	Leaser  Leaser
+	Clock   clock.Clock
+	// Eventbus is optional, it is used to report grants which could not be activated before they ended
+	Eventbus EventPutter
+	// ID identifies this runtime instance when leasing workflows
+	ID            string
+	PollInterval  time.Duration
+	LeaseDuration time.Duration
+
+	wake      chan struct{}
+	inflight  map[string]struct{}
+	inflightM sync.Mutex
 }
 
-func NewRuntime(db ddb.Storage, granter GrantHandler, router *requestroutersvc.Service) *Runtime {
+func NewRuntime(db ddb.Storage, granter GrantHandler) *Runtime {
 	return &Runtime{
-		DB:                db,
-		Granter:           granter,
-		grantsRevokeChans: make(map[string]grantWorkflow),
-		RequestRouter:     router,
+		DB:            db,
+		Granter:       granter,
+		Leaser:        &DynamoDBLeaser{DB: db},
+		Clock:         clock.New(),
+		ID:            ksuid.New().String(),
+		PollInterval:  DefaultPollInterval,
+		LeaseDuration: DefaultLeaseDuration,
+		wake:          make(chan struct{}, 1),
+		inflight:      make(map[string]struct{}),
 	}
 }
 
-func (r *Runtime) Grant(ctx context.Context, grant access.GroupTarget) error {
+// Start resumes any pending workflows, and then runs workflows as they become due.
+// It blocks until ctx is cancelled.
+func (r *Runtime) Start(ctx context.Context) {
 	log := logger.Get(ctx)
-
-	// create a channel to communicate with the goroutine
-	revokeChan := make(chan struct{})
-	stateChan := make(chan targetgroupgranter.GrantState)
-	// lock the grantsDoneChans map while adding the new channel
-	r.grantsRevokeChansM.Lock()
-	r.grantsRevokeChans[grant.ID] = grantWorkflow{
-		grant:  grant,
-		revoke: revokeChan,
-		state:  stateChan,
-	}
-	r.grantsRevokeChansM.Unlock()
-	defer func() {
-		// delete the revoking channels from the map, because the grant is now complete
-		r.grantsRevokeChansM.Lock()
-		delete(r.grantsRevokeChans, grant.ID)
-		r.grantsRevokeChansM.Unlock()
-	}()
-	// start a new goroutine to handle the grant
-	go func() {
-		// wait for start
-		if grant.Grant.Start.After(time.Now()) {
-			time.Sleep(time.Until(grant.Grant.Start.Time))
-		}
-
-		state, err := r.Granter.HandleRequest(ctx, targetgroupgranter.InputEvent{
-			Action:                   targetgroupgranter.ACTIVATE,
-			RequestAccessGroupTarget: grant,
-			State:                    map[string]any{},
-		})
-		if err != nil {
-			log.Errorw("failed to activate grant", "err", err)
-			return
-		}
-
-		log.Debugw("activated grant", "state", state)
-
-		// wait for end or cancellation
-		select {
-		case <-time.After(time.Until(grant.Grant.End.Time)):
-			// grant ended, deactivate it
-			state, err = r.Granter.HandleRequest(ctx, targetgroupgranter.InputEvent{
-				Action:                   targetgroupgranter.DEACTIVATE,
-				RequestAccessGroupTarget: grant,
-				State:                    state.State,
-			})
+	ticker := r.Clock.Ticker(r.PollInterval)
+	defer ticker.Stop()
+	for {
+		go func() {
+			err := r.processDue(ctx)
 			if err != nil {
-				log.Errorw("failed to deactivate grant", "err", err)
-				return
+				log.Errorw("failed to process grant workflows", "error", err)
 			}
-			log.Debugw("deactivated grant")
-		case <-revokeChan:
-			// grant cancelled, return the state to the state channel to be used when revoking
-			log.Debugw("cancelled grant workflow because it was revoked")
-			stateChan <- state
+		}()
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-r.wake:
 		}
+	}
+}
 
-	}()
-
-	// lock the grantsDoneChans map while removing the revoke channels
+// Grant schedules the grant to be activated at its start time.
+func (r *Runtime) Grant(ctx context.Context, grant access.GroupTarget) error {
+	now := r.Clock.Now()
+	w := workflow.Workflow{
+		GrantID:    grant.ID,
+		Grant:      grant,
+		NextAction: workflow.ActionActivate,
+		RunAt:      grant.Grant.Start.Time,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	err := r.DB.Put(ctx, &w)
+	if err != nil {
+		return err
+	}
 
-	// return immediately
+	// wake the scheduler so that grants which start immediately don't wait for the next poll
+	select {
+	case r.wake <- struct{}{}:
+	default:
+	}
 	return nil
 }
 
+// Revoke cancels the workflow for a grant, deactivating the grant if it is active.
 func (r *Runtime) Revoke(ctx context.Context, grantID string) error {
-	log := logger.Get(ctx)
-
-	// look up the done channel for the grant with the given ID
-	r.grantsRevokeChansM.Lock()
-	grantWorkflow, ok := r.grantsRevokeChans[grantID]
-	r.grantsRevokeChansM.Unlock()
+	log := logger.Get(ctx).With("grant.id", grantID)
 
-	if !ok {
-		log.Errorw("failed to find grant done channel", "grant_id", grantID)
+	w, err := r.getWorkflow(ctx, grantID)
+	if err != nil {
+		return err
+	}
+	if w == nil {
+		log.Errorw("failed to find grant workflow")
 		return nil
 	}
 
-	// signal the done channel to cancel the grant
-	close(grantWorkflow.revoke)
+	// leases are held per instance, so also check that this instance isn't already acting on the workflow
+	if !r.startInflight(grantID) {
+		return ErrWorkflowLeased
+	}
+	defer r.endInflight(grantID)
 
-	state := <-grantWorkflow.state
+	ok, release, err := r.lease(ctx, grantID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrWorkflowLeased
+	}
+	defer release()
 
-	tgq := storage.GetTargetGroup{
-		ID: grantWorkflow.grant.TargetGroupID,
+	// read the workflow again now that we hold the lease, in case it changed in the meantime
+	w, err = r.getWorkflow(ctx, grantID)
+	if err != nil {
+		return err
+	}
+	if w == nil {
+		log.Infow("grant workflow completed before it could be revoked")
+		return nil
 	}
 
-	_, err := r.DB.Query(ctx, &tgq)
+	// grants which have not been activated yet don't need to be deactivated in the provider
+	if w.NextAction == workflow.ActionDeactivate {
+		_, err = r.Granter.HandleRequest(ctx, targetgroupgranter.InputEvent{
+			Action:                   targetgroupgranter.DEACTIVATE,
+			RequestAccessGroupTarget: w.Grant,
+			State:                    w.State,
+			Synchronous:              true,
+		})
+		if err != nil {
+			log.Errorw("failed to deactivate grant", "error", err)
+			return err
+		}
+	}
+
+	return r.DB.Delete(ctx, w)
+}
+
+// processDue runs all workflows which are due, and waits for them to finish.
+func (r *Runtime) processDue(ctx context.Context) error {
+	log := logger.Get(ctx)
+	q := storage.ListGrantWorkflows{}
+	err := r.DB.All(ctx, &q)
 	if err != nil {
 		return err
 	}
 
-	routeResult, err := r.RequestRouter.Route(ctx, *tgq.Result)
+	now := r.Clock.Now()
+	var wg sync.WaitGroup
+	for _, w := range q.Result {
+		if !w.IsDue(now) || !r.startInflight(w.GrantID) {
+			continue
+		}
+		wg.Add(1)
+		go func(w workflow.Workflow) {
+			defer wg.Done()
+			defer r.endInflight(w.GrantID)
+			err := r.run(ctx, w.GrantID)
+			if err != nil {
+				log.Errorw("failed to run grant workflow", "grant.id", w.GrantID, "action", w.NextAction, "error", err)
+			}
+		}(w)
+	}
+	wg.Wait()
+	return nil
+}
+
+// run takes the next action for a workflow, if the action is due.
+func (r *Runtime) run(ctx context.Context, grantID string) error {
+	log := logger.Get(ctx).With("grant.id", grantID)
+
+	ok, release, err := r.lease(ctx, grantID)
 	if err != nil {
 		return err
 	}
-	runtime, err := handler.GetRuntime(ctx, routeResult.Handler)
+	if !ok {
+		log.Debugw("skipping grant workflow which is leased by another instance")
+		return nil
+	}
+	defer release()
+
+	// read the workflow again now that we hold the lease, as another instance may have acted on it
+	w, err := r.getWorkflow(ctx, grantID)
 	if err != nil {
 		return err
 	}
+	now := r.Clock.Now()
+	if w == nil || !w.IsDue(now) {
+		return nil
+	}
 
-	//call the provider revoke
-	req := msg.Revoke{
-		Subject: grantWorkflow.grant.RequestedBy.Email,
-		Target: msg.Target{
-			Kind:      routeResult.Route.Kind,
-			Arguments: grantWorkflow.grant.FieldsToMap(),
-		},
-		Request: msg.AccessRequest{
-			ID: grantID,
-		},
-		State: state.State,
+	switch w.NextAction {
+	case workflow.ActionActivate:
+		if !w.Grant.Grant.End.After(now) {
+			// this can happen if the server was stopped for the whole duration of the grant
+			log.Warnw("grant ended before it could be activated")
+			if r.Eventbus != nil {
+				g := w.Grant
+				grant := *g.Grant
+				grant.Status = types.RequestAccessGroupTargetStatusERROR
+				g.Grant = &grant
+				err = r.Eventbus.Put(ctx, gevent.GrantFailed{Grant: g, Reason: "the grant ended before it could be activated"})
+				if err != nil {
+					return err
+				}
+			}
+			return r.DB.Delete(ctx, w)
+		}
+
+		state, err := r.Granter.HandleRequest(ctx, targetgroupgranter.InputEvent{
+			Action:                   targetgroupgranter.ACTIVATE,
+			RequestAccessGroupTarget: w.Grant,
+			State:                    map[string]any{},
+			Attempt:                  w.Attempt,
+		})
+		if err != nil {
+			// the granter marks the grant as failed, so there is nothing left to do
+			log.Errorw("failed to activate grant", "error", err)
+			return r.DB.Delete(ctx, w)
+		}
+		if state.HeldUntil != nil {
+			// the grant is activated once the freeze ends, or reported as failed if it has ended by then
+			log.Infow("activation held by freeze", "heldUntil", state.HeldUntil)
+			w.RunAt = *state.HeldUntil
+			w.Attempt = state.Attempt
+			w.UpdatedAt = now
+			return r.DB.Put(ctx, w)
+		}
+		if state.RetryAt != nil {
+			log.Infow("retrying grant activation", "retryAt", state.RetryAt, "attempt", state.Attempt)
+			return r.scheduleRetry(ctx, w, state, now)
+		}
+		log.Debugw("activated grant", "state", state)
+
+		w.NextAction = workflow.ActionDeactivate
+		w.RunAt = w.Grant.Grant.End.Time
+		w.State = state.State
+		w.Attempt = state.Attempt
+		w.UpdatedAt = now
+		return r.DB.Put(ctx, w)
+
+	case workflow.ActionDeactivate:
+		state, err := r.Granter.HandleRequest(ctx, targetgroupgranter.InputEvent{
+			Action:                   targetgroupgranter.DEACTIVATE,
+			RequestAccessGroupTarget: w.Grant,
+			State:                    w.State,
+			Attempt:                  w.Attempt,
+		})
+		if err != nil {
+			log.Errorw("failed to deactivate grant", "error", err)
+			return r.DB.Delete(ctx, w)
+		}
+		if state.RetryAt != nil {
+			log.Infow("retrying grant deactivation", "retryAt", state.RetryAt, "attempt", state.Attempt)
+			return r.scheduleRetry(ctx, w, state, now)
+		}
+		log.Debugw("deactivated grant")
+		return r.DB.Delete(ctx, w)
+	}
+
+	log.Errorw("removing grant workflow with unknown action", "action", w.NextAction)
+	return r.DB.Delete(ctx, w)
+}
+
+// scheduleRetry reschedules the workflow's next action for when the granter asked for a failed attempt to be retried.
+func (r *Runtime) scheduleRetry(ctx context.Context, w *workflow.Workflow, state targetgroupgranter.GrantState, now time.Time) error {
+	w.RunAt = *state.RetryAt
+	w.Attempt = state.Attempt
+	w.UpdatedAt = now
+	return r.DB.Put(ctx, w)
+}
+
+// lease claims the workflow for this instance. The returned release func must be called
+// once the instance has finished acting on the workflow.
+func (r *Runtime) lease(ctx context.Context, grantID string) (ok bool, release func(), err error) {
+	now := r.Clock.Now()
+	ok, err = r.Leaser.Acquire(ctx, grantID, r.ID, now, now.Add(r.LeaseDuration))
+	if err != nil || !ok {
+		return false, nil, err
+	}
+	release = func() {
+		err := r.Leaser.Release(ctx, grantID, r.ID)
+		if err != nil {
+			logger.Get(ctx).Errorw("failed to release grant workflow lease", "grant.id", grantID, "error", err)
+		}
 	}
+	return true, release, nil
+}
 
-	err = runtime.Revoke(ctx, req)
+// getWorkflow returns nil if the workflow does not exist.
+func (r *Runtime) getWorkflow(ctx context.Context, grantID string) (*workflow.Workflow, error) {
+	q := storage.GetGrantWorkflow{GrantID: grantID}
+	_, err := r.DB.Query(ctx, &q, ddb.ConsistentRead())
+	if errors.Is(err, ddb.ErrNoItems) {
+		return nil, nil
+	}
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	return q.Result, nil
+}
+
+func (r *Runtime) startInflight(grantID string) bool {
+	r.inflightM.Lock()
+	defer r.inflightM.Unlock()
+	if _, ok := r.inflight[grantID]; ok {
+		return false
+	}
+	r.inflight[grantID] = struct{}{}
+	return true
+}
+
+func (r *Runtime) endInflight(grantID string) {
+	r.inflightM.Lock()
+	defer r.inflightM.Unlock()
+	delete(r.inflight, grantID)
 }
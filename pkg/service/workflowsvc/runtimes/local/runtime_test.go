@@ -0,0 +1,308 @@
+package local
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/targetgroupgranter"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/common-fate/pkg/workflow"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/common-fate/iso8601"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testGranter struct {
+	mu     sync.Mutex
+	events []targetgroupgranter.InputEvent
+	state  map[string]any
+	// heldUntil is returned for activations, as if the grant was frozen
+	heldUntil *time.Time
+	// retryAt is returned for every action, as if the attempt failed with a retryable error
+	retryAt *time.Time
+}
+
+func (g *testGranter) HandleRequest(ctx context.Context, in targetgroupgranter.InputEvent) (targetgroupgranter.GrantState, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.events = append(g.events, in)
+	if in.Action == targetgroupgranter.ACTIVATE && g.heldUntil != nil {
+		return targetgroupgranter.GrantState{RequestAccessGroupTarget: in.RequestAccessGroupTarget, HeldUntil: g.heldUntil}, nil
+	}
+	if g.retryAt != nil {
+		return targetgroupgranter.GrantState{RequestAccessGroupTarget: in.RequestAccessGroupTarget, State: in.State, RetryAt: g.retryAt, Attempt: in.Attempt + 1}, nil
+	}
+	return targetgroupgranter.GrantState{RequestAccessGroupTarget: in.RequestAccessGroupTarget, State: g.state}, nil
+}
+
+type mockClient = ddbmock.Client
+
+// putRecorder records the items written to the mock database
+type putRecorder struct {
+	*mockClient
+	puts []ddb.Keyer
+}
+
+func (d *putRecorder) Put(ctx context.Context, item ddb.Keyer) error {
+	d.puts = append(d.puts, item)
+	return nil
+}
+
+// testLeaser holds leases in memory
+type testLeaser struct {
+	mu     sync.Mutex
+	leases map[string]string
+}
+
+func (l *testLeaser) Acquire(ctx context.Context, grantID string, owner string, now time.Time, until time.Time) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.leases == nil {
+		l.leases = map[string]string{}
+	}
+	if current, ok := l.leases[grantID]; ok && current != owner {
+		return false, nil
+	}
+	l.leases[grantID] = owner
+	return true, nil
+}
+
+func (l *testLeaser) Release(ctx context.Context, grantID string, owner string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.leases[grantID] == owner {
+		delete(l.leases, grantID)
+	}
+	return nil
+}
+
+func newTestRuntime(db ddb.Storage, clk clock.Clock, granter GrantHandler, leaser Leaser) *Runtime {
+	r := NewRuntime(db, granter)
+	r.Clock = clk
+	r.Leaser = leaser
+	return r
+}
+
+func TestRuntimeRun(t *testing.T) {
+	clk := clock.NewMock()
+	now := clk.Now()
+	grant := access.GroupTarget{
+		ID: "gta_1",
+		Grant: &access.Grant{
+			Subject: "test@commonfate.io",
+			Status:  types.RequestAccessGroupTargetStatusAWAITINGSTART,
+			Start:   iso8601.New(now.Add(-time.Minute)),
+			End:     iso8601.New(now.Add(time.Hour)),
+		},
+	}
+
+	type testcase struct {
+		name        string
+		give        workflow.Workflow
+		leasedBy    string
+		wantActions []targetgroupgranter.EventType
+		wantState   map[string]any
+	}
+
+	testcases := []testcase{
+		{
+			name:        "activates due grant",
+			give:        workflow.Workflow{GrantID: grant.ID, Grant: grant, NextAction: workflow.ActionActivate, RunAt: now.Add(-time.Minute)},
+			wantActions: []targetgroupgranter.EventType{targetgroupgranter.ACTIVATE},
+		},
+		{
+			name: "deactivates with stored provider state after restart",
+			give: workflow.Workflow{
+				GrantID: grant.ID, Grant: grant, NextAction: workflow.ActionDeactivate, RunAt: now.Add(-time.Second),
+				State: map[string]any{"assignment": "123"},
+			},
+			wantActions: []targetgroupgranter.EventType{targetgroupgranter.DEACTIVATE},
+			wantState:   map[string]any{"assignment": "123"},
+		},
+		{
+			name: "does not run workflow which is not due",
+			give: workflow.Workflow{GrantID: grant.ID, Grant: grant, NextAction: workflow.ActionDeactivate, RunAt: now.Add(time.Hour)},
+		},
+		{
+			name:     "does not run workflow leased by another instance",
+			give:     workflow.Workflow{GrantID: grant.ID, Grant: grant, NextAction: workflow.ActionActivate, RunAt: now.Add(-time.Minute)},
+			leasedBy: "other",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.ListGrantWorkflows{Result: []workflow.Workflow{tc.give}})
+			db.MockQuery(&storage.GetGrantWorkflow{Result: &tc.give})
+
+			granter := &testGranter{}
+			leaser := &testLeaser{}
+			if tc.leasedBy != "" {
+				leaser.leases = map[string]string{tc.give.GrantID: tc.leasedBy}
+			}
+			r := newTestRuntime(db, clk, granter, leaser)
+
+			err := r.processDue(context.Background())
+			require.NoError(t, err)
+
+			var gotActions []targetgroupgranter.EventType
+			for _, e := range granter.events {
+				gotActions = append(gotActions, e.Action)
+				if e.Action == targetgroupgranter.DEACTIVATE {
+					assert.Equal(t, tc.wantState, e.State)
+				}
+			}
+			assert.Equal(t, tc.wantActions, gotActions)
+
+			// leases held by this instance are released once the workflow has run
+			if tc.leasedBy == "" {
+				assert.Empty(t, leaser.leases)
+			}
+		})
+	}
+}
+
+func TestRuntimeRunHeldByFreeze(t *testing.T) {
+	clk := clock.NewMock()
+	now := clk.Now()
+	// a scheduled grant which starts during a freeze
+	grant := access.GroupTarget{
+		ID: "gta_1",
+		Grant: &access.Grant{
+			Start: iso8601.New(now.Add(-time.Minute)),
+			End:   iso8601.New(now.Add(4 * time.Hour)),
+		},
+	}
+	give := workflow.Workflow{GrantID: grant.ID, Grant: grant, NextAction: workflow.ActionActivate, RunAt: now.Add(-time.Minute)}
+	freezeEnd := now.Add(2 * time.Hour)
+
+	mock := ddbmock.New(t)
+	mock.MockQuery(&storage.ListGrantWorkflows{Result: []workflow.Workflow{give}})
+	mock.MockQuery(&storage.GetGrantWorkflow{Result: &give})
+	db := &putRecorder{mockClient: mock}
+
+	granter := &testGranter{heldUntil: &freezeEnd}
+	r := newTestRuntime(db, clk, granter, &testLeaser{})
+
+	err := r.processDue(context.Background())
+	require.NoError(t, err)
+
+	// the activation is attempted again once the freeze ends
+	require.Len(t, db.puts, 1)
+	got := db.puts[0].(*workflow.Workflow)
+	assert.Equal(t, workflow.ActionActivate, got.NextAction)
+	assert.Equal(t, freezeEnd, got.RunAt)
+}
+
+func TestRuntimeRunRetry(t *testing.T) {
+	clk := clock.NewMock()
+	now := clk.Now()
+	grant := access.GroupTarget{
+		ID: "gta_1",
+		Grant: &access.Grant{
+			Start: iso8601.New(now.Add(-time.Hour)),
+			End:   iso8601.New(now.Add(-time.Minute)),
+		},
+	}
+	state := map[string]any{"id": "123"}
+	give := workflow.Workflow{GrantID: grant.ID, Grant: grant, NextAction: workflow.ActionDeactivate, RunAt: now.Add(-time.Minute), State: state, Attempt: 1}
+	retryAt := now.Add(2 * time.Second)
+
+	mock := ddbmock.New(t)
+	mock.MockQuery(&storage.ListGrantWorkflows{Result: []workflow.Workflow{give}})
+	mock.MockQuery(&storage.GetGrantWorkflow{Result: &give})
+	db := &putRecorder{mockClient: mock}
+
+	granter := &testGranter{retryAt: &retryAt}
+	r := newTestRuntime(db, clk, granter, &testLeaser{})
+
+	err := r.processDue(context.Background())
+	require.NoError(t, err)
+
+	// the deactivation is attempted again once the backoff has passed, rather than the workflow being removed
+	require.Len(t, granter.events, 1)
+	assert.Equal(t, 1, granter.events[0].Attempt)
+	require.Len(t, db.puts, 1)
+	got := db.puts[0].(*workflow.Workflow)
+	assert.Equal(t, workflow.ActionDeactivate, got.NextAction)
+	assert.Equal(t, retryAt, got.RunAt)
+	assert.Equal(t, 2, got.Attempt)
+	assert.Equal(t, state, got.State)
+}
+
+func TestRuntimeRevoke(t *testing.T) {
+	clk := clock.NewMock()
+	now := clk.Now()
+	grant := access.GroupTarget{
+		ID: "gta_1",
+		Grant: &access.Grant{
+			Start: iso8601.New(now.Add(-time.Minute)),
+			End:   iso8601.New(now.Add(time.Hour)),
+		},
+	}
+
+	type testcase struct {
+		name        string
+		give        *workflow.Workflow
+		leasedBy    string
+		wantErr     error
+		wantActions []targetgroupgranter.EventType
+	}
+
+	testcases := []testcase{
+		{
+			name:        "active grant is deactivated",
+			give:        &workflow.Workflow{GrantID: grant.ID, Grant: grant, NextAction: workflow.ActionDeactivate, State: map[string]any{"a": "b"}},
+			wantActions: []targetgroupgranter.EventType{targetgroupgranter.DEACTIVATE},
+		},
+		{
+			name: "pending grant is cancelled without calling the provider",
+			give: &workflow.Workflow{GrantID: grant.ID, Grant: grant, NextAction: workflow.ActionActivate},
+		},
+		{
+			name: "missing workflow",
+		},
+		{
+			name:     "leased by another instance",
+			give:     &workflow.Workflow{GrantID: grant.ID, Grant: grant, NextAction: workflow.ActionDeactivate},
+			leasedBy: "other",
+			wantErr:  ErrWorkflowLeased,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			if tc.give == nil {
+				db.MockQueryWithErr(&storage.GetGrantWorkflow{}, ddb.ErrNoItems)
+			} else {
+				db.MockQuery(&storage.GetGrantWorkflow{Result: tc.give})
+				db.MockQuery(&storage.GetGrantWorkflow{Result: tc.give})
+			}
+
+			granter := &testGranter{}
+			leaser := &testLeaser{}
+			if tc.leasedBy != "" {
+				leaser.leases = map[string]string{grant.ID: tc.leasedBy}
+			}
+			r := newTestRuntime(db, clk, granter, leaser)
+
+			err := r.Revoke(context.Background(), grant.ID)
+			assert.Equal(t, tc.wantErr, err)
+
+			var gotActions []targetgroupgranter.EventType
+			for _, e := range granter.events {
+				gotActions = append(gotActions, e.Action)
+			}
+			assert.Equal(t, tc.wantActions, gotActions)
+		})
+	}
+}
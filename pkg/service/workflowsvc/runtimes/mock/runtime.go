@@ -44,11 +44,16 @@ type Runtime struct {
 	runtime *local.Runtime
 }
 
-func NewRuntime(db ddb.Storage, eventBus EventPutter, router *requestroutersvc.Service) *Runtime {
+func NewRuntime(db ddb.Storage, eventBus EventPutter, router *requestroutersvc.Service, freezes targetgroupgranter.FreezeService) *Runtime {
 	return &Runtime{local.NewRuntime(db, &targetgroupgranter.Granter{
 		DB: db, EventPutter: eventBus, RequestRouter: router,
-		RuntimeGetter: &MockRuntimeGetter{},
-	}, router)}
+		RuntimeGetter: &MockRuntimeGetter{}, Freezes: freezes,
+	})}
+}
+
+// Start runs the underlying local runtime, see local.Runtime.Start.
+func (r *Runtime) Start(ctx context.Context) {
+	r.runtime.Start(ctx)
 }
 
 func (r *Runtime) Grant(ctx context.Context, grant access.GroupTarget) error {
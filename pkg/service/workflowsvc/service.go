@@ -2,6 +2,7 @@ package workflowsvc
 
 import (
 	"context"
+	"time"
 
 	"github.com/benbjohnson/clock"
 	"github.com/common-fate/apikit/logger"
@@ -30,6 +31,56 @@ type Service struct {
 	DB       ddb.Storage
 	Clk      clock.Clock
 	Eventbus EventPutter
+	// Granter is optional, it is used to retry failed deactivations
+	Granter GrantHandler
+	// Freezes is optional, if it is set activations are held while access is frozen
+	Freezes FreezeService
+	// Claimer defaults to a DynamoDBClaimer if it is not set
+	Claimer GrantClaimer
+}
+
+// ClaimTTL is how long a target stays claimed for. The claim only needs to last until the target is saved with its grant.
+const ClaimTTL = time.Hour
+
+// GrantClaimer claims targets before they are provisioned, so that a target is only provisioned once
+// when the grant workflow is started more than once for a group, for example if an event is delivered twice.
+type GrantClaimer interface {
+	// Claim returns false if the target has already been claimed.
+	Claim(ctx context.Context, target access.GroupTarget, now time.Time) (bool, error)
+	// Release removes the claim, so that the target can be claimed again.
+	Release(ctx context.Context, target access.GroupTarget) error
+}
+
+// DynamoDBClaimer claims targets with conditional writes.
+type DynamoDBClaimer struct {
+	DB ddb.Storage
+}
+
+func (c *DynamoDBClaimer) Claim(ctx context.Context, target access.GroupTarget, now time.Time) (bool, error) {
+	claim := access.GrantClaim{
+		TargetID:  target.ID,
+		RequestID: target.RequestID,
+		GroupID:   target.GroupID,
+		ClaimedAt: now,
+		ExpiresAt: now.Add(ClaimTTL).Unix(),
+	}
+	return storage.PutIfNotExists(ctx, c.DB, &claim, now)
+}
+
+func (c *DynamoDBClaimer) Release(ctx context.Context, target access.GroupTarget) error {
+	return c.DB.Delete(ctx, &access.GrantClaim{TargetID: target.ID})
+}
+
+func (s *Service) claimer() GrantClaimer {
+	if s.Claimer != nil {
+		return s.Claimer
+	}
+	return &DynamoDBClaimer{DB: s.DB}
+}
+
+// FreezeService returns the freezes which block access.
+type FreezeService interface {
+	Blocking(ctx context.Context, groups []string, accessRuleIDs []string, targetGroupIDs []string) ([]access.Freeze, error)
 }
 
 func (s *Service) Grant(ctx context.Context, requestID string, groupID string) ([]access.GroupTarget, error) {
@@ -46,6 +97,42 @@ func (s *Service) Grant(ctx context.Context, requestID string, groupID string) (
 	group := q.Result
 
 	start, end := group.Group.GetInterval(access.WithNow(s.Clk.Now()))
+	// the workflow has already been started for this group, keep the timing it was started with
+	if group.Group.FinalTiming != nil {
+		start, end = group.Group.FinalTiming.Start, group.Group.FinalTiming.End
+	}
+
+	if s.Freezes != nil && group.Group.FinalTiming == nil {
+		var held bool
+		start, held, err = s.holdForFreezes(ctx, *group, start, end)
+		if err != nil {
+			return nil, err
+		}
+		if held {
+			log.Infow("activation held by freeze")
+			return group.Targets, nil
+		}
+	}
+
+	// claim the targets before anything is written, so that a duplicate workflow doesn't change the timing
+	// of a group which is already being provisioned
+	var claimed []int
+	for i, target := range group.Targets {
+		if target.Grant != nil {
+			continue
+		}
+		ok, err := s.claimer().Claim(ctx, target, s.Clk.Now())
+		if err != nil {
+			return nil, s.releaseClaims(ctx, group.Targets, claimed, err)
+		}
+		if ok {
+			claimed = append(claimed, i)
+		}
+	}
+	if len(claimed) == 0 {
+		log.Infow("grant workflow has already been started for every target in the group")
+		return group.Targets, nil
+	}
 
 	//update the group with the start and end time
 
@@ -53,24 +140,37 @@ func (s *Service) Grant(ctx context.Context, requestID string, groupID string) (
 		Start: start,
 		End:   end,
 	}
-	err = s.DB.Put(ctx, &group.Group)
-	if err != nil {
-		return nil, err
-	}
 
 	log.Infow("found group and calculated timing", "group", group, "start", start, "end", end)
-	for i, target := range group.Targets {
+	items := []ddb.Keyer{&group.Group}
+	for _, i := range claimed {
+		target := &group.Targets[i]
 		target.Grant = &access.Grant{
 			Subject: group.Group.RequestedBy.Email,
 			Start:   iso8601.New(start),
 			End:     iso8601.New(end),
-			Status:  types.RequestAccessGroupTargetStatusAWAITINGSTART,
 		}
+		err = target.TransitionGrantStatus(types.RequestAccessGroupTargetStatusAWAITINGSTART)
+		if err != nil {
+			return nil, s.releaseClaims(ctx, group.Targets, claimed, err)
+		}
+		items = append(items, target)
+	}
+	// the targets are saved before they are passed to the runtime, so that the status events it sends are applied on top of them.
+	// only the claimed targets are written, the others are being provisioned by another workflow
+	err = s.DB.PutBatch(ctx, items...)
+	if err != nil {
+		return nil, s.releaseClaims(ctx, group.Targets, claimed, err)
+	}
 
+	for _, i := range claimed {
+		target := group.Targets[i]
 		err := s.Runtime.Grant(ctx, target)
 		if err != nil {
-			//override the status here to error
-			target.Grant.Status = types.RequestAccessGroupTargetStatusERROR
+			// the event handler moves the stored grant to the error status
+			g := *target.Grant
+			g.Status = types.RequestAccessGroupTargetStatusERROR
+			target.Grant = &g
 			evt := gevent.GrantFailed{
 				Grant:  target,
 				Reason: err.Error(),
@@ -79,20 +179,69 @@ func (s *Service) Grant(ctx context.Context, requestID string, groupID string) (
 			if err != nil {
 				return nil, err
 			}
+			group.Targets[i] = target
 		}
+	}
+	return group.Targets, nil
+}
 
-		group.Targets[i] = target
+// releaseClaims releases the claimed targets when the workflow fails before they are saved, so that a retried workflow can provision them.
+// The original error is returned, failures to release are logged as the claims expire after ClaimTTL.
+func (s *Service) releaseClaims(ctx context.Context, targets []access.GroupTarget, claimed []int, cause error) error {
+	for _, i := range claimed {
+		err := s.claimer().Release(ctx, targets[i])
+		if err != nil {
+			logger.Get(ctx).Errorw("failed to release grant claim", "targetId", targets[i].ID, "error", err)
+		}
+	}
+	return cause
+}
 
+// holdForFreezes checks whether the group is blocked by any freezes.
+// If every blocking freeze ends before the grant does, the start of the grant is delayed until the freezes end.
+// Otherwise the activation is stored as held, and is released by the event handler when the freeze is lifted.
+func (s *Service) holdForFreezes(ctx context.Context, group access.GroupWithTargets, start, end time.Time) (time.Time, bool, error) {
+	requester := storage.GetUser{ID: group.Group.RequestedBy.ID}
+	_, err := s.DB.Query(ctx, &requester)
+	if err != nil {
+		return start, false, err
 	}
-	err = s.DB.PutBatch(ctx, group.DBItems()...)
+	accessRuleIDs, targetGroupIDs := group.FreezeScopes()
+	freezes, err := s.Freezes.Blocking(ctx, requester.Result.Groups, accessRuleIDs, targetGroupIDs)
 	if err != nil {
-		return nil, err
+		return start, false, err
 	}
-	return group.Targets, nil
+	if len(freezes) == 0 {
+		return start, false, nil
+	}
+
+	hold := access.HeldActivation{
+		RequestID: group.Group.RequestID,
+		GroupID:   group.Group.ID,
+		HeldAt:    s.Clk.Now(),
+	}
+	var frozenUntil time.Time
+	var openEnded bool
+	for _, f := range freezes {
+		hold.FreezeIDs = append(hold.FreezeIDs, f.ID)
+		if f.EndsAt == nil {
+			openEnded = true
+		} else if f.EndsAt.After(frozenUntil) {
+			frozenUntil = *f.EndsAt
+		}
+	}
+	if !openEnded && frozenUntil.Before(end) {
+		if frozenUntil.After(start) {
+			start = frozenUntil
+		}
+		return start, false, nil
+	}
+	return start, true, s.DB.Put(ctx, &hold)
 }
 
 // // Revoke attepmts to syncronously revoke access to a request
 // // If it is successful, the request is updated in the database, and the updated request is returned from this method
+// Targets in the group which are no longer active are skipped, ErrGrantInactive is only returned if none of the targets could be revoked.
 func (s *Service) Revoke(ctx context.Context, requestID string, groupID string, revokerID string, revokerEmail string) error {
 	q := storage.GetRequestGroupWithTargets{
 		RequestID: requestID,
@@ -103,33 +252,65 @@ func (s *Service) Revoke(ctx context.Context, requestID string, groupID string,
 		return err
 	}
 	group := q.Result
+	revoked := 0
 	for _, target := range group.Targets {
-
-		//Cannot request to revoke/cancel grant if it is not active or pending (state function has been created and executed)
-		canRevoke := target.Grant.Status == types.RequestAccessGroupTargetStatusACTIVE ||
-			target.Grant.Status == types.RequestAccessGroupTargetStatusAWAITINGSTART
-
-		if !canRevoke || target.Grant.End.Before(s.Clk.Now()) {
-			return ErrGrantInactive
-		}
-
-		log := logger.Get(ctx)
-		log.Infow("Can revoke. calling runtime revoke.")
-
-		err = s.Runtime.Revoke(ctx, target.ID)
-		if err != nil {
-			log.Errorw("error revoking", err)
-
-			return err
+		if !s.canRevoke(target) {
+			continue
 		}
-		//emit request group revoke event
-		err = s.Eventbus.Put(ctx, gevent.GrantRevoked{
-			Grant: target,
-		})
+		err = s.revokeTarget(ctx, target, revokerID, revokerEmail)
 		if err != nil {
 			return err
 		}
+		revoked++
+	}
+	if revoked == 0 {
+		return ErrGrantInactive
 	}
 
 	return nil
 }
+
+// RevokeTarget revokes access to a single target in an access group, leaving the other targets in the group active.
+func (s *Service) RevokeTarget(ctx context.Context, requestID string, groupID string, targetID string, revokerID string, revokerEmail string) error {
+	q := storage.GetRequestGroupTarget{
+		RequestID: requestID,
+		GroupID:   groupID,
+		TargetID:  targetID,
+	}
+	_, err := s.DB.Query(ctx, &q, ddb.ConsistentRead())
+	if err != nil {
+		return err
+	}
+	if !s.canRevoke(*q.Result) {
+		return ErrGrantInactive
+	}
+	return s.revokeTarget(ctx, *q.Result, revokerID, revokerEmail)
+}
+
+// canRevoke returns true if the target has a grant which is active or pending (state function has been created and executed)
+func (s *Service) canRevoke(target access.GroupTarget) bool {
+	if target.Grant == nil {
+		return false
+	}
+	canRevoke := target.Grant.Status == types.RequestAccessGroupTargetStatusACTIVE ||
+		target.Grant.Status == types.RequestAccessGroupTargetStatusAWAITINGSTART
+
+	return canRevoke && !target.Grant.End.Before(s.Clk.Now())
+}
+
+func (s *Service) revokeTarget(ctx context.Context, target access.GroupTarget, revokerID string, revokerEmail string) error {
+	log := logger.Get(ctx).With("requestId", target.RequestID, "groupId", target.GroupID, "targetId", target.ID)
+	log.Infow("Can revoke. calling runtime revoke.")
+
+	err := s.Runtime.Revoke(ctx, target.ID)
+	if err != nil {
+		log.Errorw("error revoking", "error", err)
+		return err
+	}
+	//emit request group revoke event
+	return s.Eventbus.Put(ctx, gevent.GrantRevoked{
+		Grant:        target,
+		Actor:        revokerID,
+		RevokerEmail: revokerEmail,
+	})
+}
@@ -0,0 +1,75 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/common-fate/pkg/cache"
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+	"github.com/common-fate/ddb"
+)
+
+// batchGetLimit is the maximum number of keys DynamoDB accepts in a BatchGetItem request
+const batchGetLimit = 100
+
+// BatchGetCachedTargets fetches the cached targets with the given IDs using BatchGetItem, rather than querying for each target.
+// The result is keyed by target ID. Targets which are no longer in the cache are not included.
+func BatchGetCachedTargets(ctx context.Context, db ddb.Storage, ids []string) (map[string]cache.Target, error) {
+	out := map[string]cache.Target{}
+	seen := map[string]bool{}
+	var batch []map[string]types.AttributeValue
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		batch = append(batch, map[string]types.AttributeValue{
+			"PK": &types.AttributeValueMemberS{Value: keys.EntitlementTarget.PK1},
+			"SK": &types.AttributeValueMemberS{Value: keys.EntitlementTarget.SK1(id)},
+		})
+		if len(batch) == batchGetLimit {
+			err := batchGetCachedTargets(ctx, db, batch, out)
+			if err != nil {
+				return nil, err
+			}
+			batch = nil
+		}
+	}
+	if len(batch) > 0 {
+		err := batchGetCachedTargets(ctx, db, batch, out)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+func batchGetCachedTargets(ctx context.Context, db ddb.Storage, batch []map[string]types.AttributeValue, out map[string]cache.Target) error {
+	requestItems := map[string]types.KeysAndAttributes{
+		db.Table(): {Keys: batch},
+	}
+	// DynamoDB may return some of the keys as unprocessed if the request exceeds the table's capacity, these are requested again
+	for len(requestItems) > 0 {
+		res, err := db.Client().BatchGetItem(ctx, &dynamodb.BatchGetItemInput{
+			RequestItems: requestItems,
+		})
+		if err != nil {
+			return err
+		}
+		for _, item := range res.Responses[db.Table()] {
+			var target cache.Target
+			err = attributevalue.UnmarshalMap(item, &target)
+			if err != nil {
+				return err
+			}
+			out[target.ID()] = target
+		}
+		requestItems = res.UnprocessedKeys
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+	return nil
+}
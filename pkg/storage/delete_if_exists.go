@@ -0,0 +1,31 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/common-fate/ddb"
+)
+
+// DeleteIfExists deletes the item with a conditional write. It returns false without an error
+// if there is no item with the same keys.
+func DeleteIfExists(ctx context.Context, db ddb.Storage, item ddb.Keyer) (bool, error) {
+	key, err := itemKey(item)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = db.Client().DeleteItem(ctx, &dynamodb.DeleteItemInput{
+		TableName:           aws.String(db.Table()),
+		Key:                 key,
+		ConditionExpression: aws.String("attribute_exists(PK)"),
+	})
+	if conditionFailed(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
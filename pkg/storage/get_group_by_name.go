@@ -0,0 +1,42 @@
+package storage
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+	gendTypes "github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+)
+
+// GetGroupByName returns the group from the source with the status and the name.
+type GetGroupByName struct {
+	Source string
+	Status gendTypes.IdpStatus
+	Name   string
+	Result *identity.Group
+}
+
+func (g *GetGroupByName) BuildQuery() (*dynamodb.QueryInput, error) {
+	qi := &dynamodb.QueryInput{
+		Limit:                  aws.Int32(1),
+		IndexName:              &keys.IndexNames.GSI2,
+		KeyConditionExpression: aws.String("GSI2PK = :pk1 and GSI2SK = :sk1"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk1": &types.AttributeValueMemberS{Value: keys.Groups.GSI2PK},
+			":sk1": &types.AttributeValueMemberS{Value: keys.Groups.GSI2SK(g.Source, string(g.Status), g.Name)},
+		},
+	}
+
+	return qi, nil
+}
+
+func (g *GetGroupByName) UnmarshalQueryOutput(out *dynamodb.QueryOutput) (*ddb.UnmarshalResult, error) {
+	if len(out.Items) != 1 {
+		return nil, ddb.ErrNoItems
+	}
+
+	return &ddb.UnmarshalResult{}, attributevalue.UnmarshalMap(out.Items[0], &g.Result)
+}
@@ -0,0 +1,36 @@
+package storage
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+	"github.com/common-fate/common-fate/pkg/target"
+	"github.com/common-fate/ddb"
+)
+
+type GetTargetGroupSyncStatus struct {
+	TargetGroupID string
+	Result        *target.SyncStatus `ddb:"result"`
+}
+
+func (g *GetTargetGroupSyncStatus) BuildQuery() (*dynamodb.QueryInput, error) {
+	qi := dynamodb.QueryInput{
+		Limit:                  aws.Int32(1),
+		KeyConditionExpression: aws.String("PK = :pk AND SK = :sk"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk": &types.AttributeValueMemberS{Value: keys.TargetGroupSyncStatus.PK1},
+			":sk": &types.AttributeValueMemberS{Value: keys.TargetGroupSyncStatus.SK1(g.TargetGroupID)},
+		},
+	}
+	return &qi, nil
+}
+
+func (g *GetTargetGroupSyncStatus) UnmarshalQueryOutput(out *dynamodb.QueryOutput) (*ddb.UnmarshalResult, error) {
+	if len(out.Items) != 1 {
+		return nil, ddb.ErrNoItems
+	}
+
+	return &ddb.UnmarshalResult{}, attributevalue.UnmarshalMap(out.Items[0], &g.Result)
+}
@@ -2,6 +2,7 @@ package keys
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/common-fate/common-fate/pkg/types"
 )
@@ -61,6 +62,12 @@ type accessRequestGroupKeys struct {
 	GSI1SK func(pastUpcoming AccessRequestPastUpcoming, requestID string, groupId string) string
 	GSI2PK func(status types.RequestStatus) string
 	GSI2SK func(requestID string, groupId string) string
+	// list groups for an access rule and request status, used by bulk revoke jobs
+	GSI3PK func(accessRuleID string, status types.RequestStatus) string
+	GSI3SK func(requestID string, groupId string) string
+	// list groups awaiting activation by their activation deadline, only these groups have GSI4 keys
+	GSI4PK string
+	GSI4SK func(activationDeadline time.Time, requestID string, groupId string) string
 }
 
 var AccessRequestGroup = accessRequestGroupKeys{
@@ -76,6 +83,21 @@ var AccessRequestGroup = accessRequestGroupKeys{
 	GSI2SK: func(requestID string, groupId string) string {
 		return fmt.Sprintf("%s%s#%s%s#", AccessRequestKey, requestID, AccessRequestGroupKey, groupId)
 	},
+	GSI3PK: func(accessRuleID string, status types.RequestStatus) string {
+		return fmt.Sprintf("%s%s#%s#", AccessRequestGroupKey, accessRuleID, status)
+	},
+	GSI3SK: func(requestID string, groupId string) string {
+		return fmt.Sprintf("%s%s#%s%s#", AccessRequestKey, requestID, AccessRequestGroupKey, groupId)
+	},
+	GSI4PK: AccessRequestGroupKey + string(types.RequestAccessGroupStatusAWAITINGACTIVATION) + "#",
+	GSI4SK: func(activationDeadline time.Time, requestID string, groupId string) string {
+		return fmt.Sprintf("%s#%s%s#%s%s#", ActivationDeadlineKey(activationDeadline), AccessRequestKey, requestID, AccessRequestGroupKey, groupId)
+	},
+}
+
+// ActivationDeadlineKey formats an activation deadline so that deadlines sort in time order.
+func ActivationDeadlineKey(t time.Time) string {
+	return SortableTime(t)
 }
 
 type accessRequestGroupTargetKeys struct {
@@ -85,6 +107,9 @@ type accessRequestGroupTargetKeys struct {
 	GSI1SK func(pastUpcoming AccessRequestPastUpcoming, requestID string, groupId string, targetId string) string
 	GSI2PK func(status types.RequestStatus) string
 	GSI2SK func(requestID string, groupId string, targetId string) string
+	// list targets for a target group and request status, used by bulk revoke jobs
+	GSI4PK func(targetGroupID string, status types.RequestStatus) string
+	GSI4SK func(requestID string, groupId string, targetId string) string
 }
 
 var AccessRequestGroupTarget = accessRequestGroupTargetKeys{
@@ -100,6 +125,12 @@ var AccessRequestGroupTarget = accessRequestGroupTargetKeys{
 	GSI2SK: func(requestID string, groupId string, targetId string) string {
 		return fmt.Sprintf("%s%s#%s%s#%s%s#", AccessRequestKey, requestID, AccessRequestGroupKey, groupId, AccessRequestGroupTargetKey, targetId)
 	},
+	GSI4PK: func(targetGroupID string, status types.RequestStatus) string {
+		return fmt.Sprintf("%s%s#%s#", AccessRequestGroupTargetKey, targetGroupID, status)
+	},
+	GSI4SK: func(requestID string, groupId string, targetId string) string {
+		return fmt.Sprintf("%s%s#%s%s#%s%s#", AccessRequestKey, requestID, AccessRequestGroupKey, groupId, AccessRequestGroupTargetKey, targetId)
+	},
 }
 
 type accessRequestGroupTargetInstructionsKeys struct {
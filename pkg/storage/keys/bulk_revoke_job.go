@@ -0,0 +1,27 @@
+package keys
+
+const BulkRevokeJobKey = "BULK_REVOKE_JOB#"
+
+type bulkRevokeJobKeys struct {
+	PK1 string
+	SK1 func(jobID string) string
+}
+
+var BulkRevokeJob = bulkRevokeJobKeys{
+	PK1: BulkRevokeJobKey,
+	SK1: func(jobID string) string { return jobID + "#" },
+}
+
+const BulkRevokeJobItemKey = "BULK_REVOKE_JOB_ITEM#"
+
+type bulkRevokeJobItemKeys struct {
+	PK1    string
+	SK1    func(jobID string, itemID string) string
+	SK1Job func(jobID string) string
+}
+
+var BulkRevokeJobItem = bulkRevokeJobItemKeys{
+	PK1:    BulkRevokeJobItemKey,
+	SK1:    func(jobID string, itemID string) string { return jobID + "#" + itemID + "#" },
+	SK1Job: func(jobID string) string { return jobID + "#" },
+}
@@ -0,0 +1,23 @@
+package keys
+
+import "time"
+
+const DeadLetterEventKey = "DEAD_LETTER_EVENT#"
+
+type deadLetterEventKeys struct {
+	PK1    string
+	SK1    func(id string) string
+	GSI1PK func(orderingKey string) string
+	GSI1SK func(lastFailedAt time.Time, id string) string
+	GSI2PK func(subscriber string) string
+	GSI2SK func(lastFailedAt time.Time, id string) string
+}
+
+var DeadLetterEvent = deadLetterEventKeys{
+	PK1:    DeadLetterEventKey,
+	SK1:    func(id string) string { return id + "#" },
+	GSI1PK: func(orderingKey string) string { return DeadLetterEventKey + orderingKey + "#" },
+	GSI1SK: func(lastFailedAt time.Time, id string) string { return SortableTime(lastFailedAt) + "#" + id + "#" },
+	GSI2PK: func(subscriber string) string { return DeadLetterEventKey + subscriber + "#" },
+	GSI2SK: func(lastFailedAt time.Time, id string) string { return SortableTime(lastFailedAt) + "#" + id + "#" },
+}
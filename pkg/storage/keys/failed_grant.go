@@ -0,0 +1,13 @@
+package keys
+
+const FailedGrantKey = "FAILED_GRANT#"
+
+type failedGrantKeys struct {
+	PK1 string
+	SK1 func(grantID string) string
+}
+
+var FailedGrant = failedGrantKeys{
+	PK1: FailedGrantKey,
+	SK1: func(grantID string) string { return grantID + "#" },
+}
@@ -0,0 +1,16 @@
+package keys
+
+const FavoriteKey = "FAVORITE#"
+
+type favoriteKeys struct {
+	PK1 func(userID string) string
+	SK1 func(targetID string) string
+}
+
+// Favorites are partitioned by user, so that listing a user's favorites only reads their own partition.
+// They are keyed by the deterministic cache.Target ID, so a favorite continues to refer to the
+// same target across cache refreshes.
+var Favorite = favoriteKeys{
+	PK1: func(userID string) string { return FavoriteKey + userID },
+	SK1: func(targetID string) string { return targetID },
+}
@@ -0,0 +1,56 @@
+package keys
+
+import "time"
+
+const FreezeKey = "FREEZE#"
+
+// freezeNoEnd sorts after every end time, so freezes without an end time are always returned by queries for active freezes
+const freezeNoEnd = "OPEN"
+
+type freezeKeys struct {
+	PK1 string
+	SK1 func(freezeID string) string
+	// GSI1 indexes freezes which haven't been lifted by their end time
+	GSI1PK    string
+	GSI1SK    func(endsAt *time.Time, freezeID string) string
+	GSI1SKNow func(now time.Time) string
+}
+
+var Freeze = freezeKeys{
+	PK1:    FreezeKey,
+	SK1:    func(freezeID string) string { return freezeID + "#" },
+	GSI1PK: FreezeKey + "ACTIVE#",
+	GSI1SK: func(endsAt *time.Time, freezeID string) string {
+		if endsAt == nil {
+			return freezeNoEnd + "#" + freezeID + "#"
+		}
+		return SortableTime(*endsAt) + "#" + freezeID + "#"
+	},
+	GSI1SKNow: func(now time.Time) string { return SortableTime(now) },
+}
+
+const FreezeHistoryEventKey = "FREEZE_HISTORY#"
+
+type freezeHistoryEventKeys struct {
+	PK1       string
+	SK1       func(freezeID string, eventID string) string
+	SK1Freeze func(freezeID string) string
+}
+
+var FreezeHistoryEvent = freezeHistoryEventKeys{
+	PK1:       FreezeHistoryEventKey,
+	SK1:       func(freezeID string, eventID string) string { return freezeID + "#" + eventID + "#" },
+	SK1Freeze: func(freezeID string) string { return freezeID + "#" },
+}
+
+const HeldActivationKey = "HELD_ACTIVATION#"
+
+type heldActivationKeys struct {
+	PK1 string
+	SK1 func(requestID string, groupID string) string
+}
+
+var HeldActivation = heldActivationKeys{
+	PK1: HeldActivationKey,
+	SK1: func(requestID string, groupID string) string { return requestID + "#" + groupID + "#" },
+}
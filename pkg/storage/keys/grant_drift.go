@@ -0,0 +1,13 @@
+package keys
+
+const GrantDriftKey = "GRANT_DRIFT#"
+
+type grantDriftKeys struct {
+	PK1 string
+	SK1 func(grantID string, kind string) string
+}
+
+var GrantDrift = grantDriftKeys{
+	PK1: GrantDriftKey,
+	SK1: func(grantID string, kind string) string { return grantID + "#" + kind + "#" },
+}
@@ -0,0 +1,13 @@
+package keys
+
+const GrantWorkflowKey = "GRANT_WORKFLOW#"
+
+type grantWorkflowKeys struct {
+	PK1 string
+	SK1 func(grantID string) string
+}
+
+var GrantWorkflow = grantWorkflowKeys{
+	PK1: GrantWorkflowKey,
+	SK1: func(grantID string) string { return grantID + "#" },
+}
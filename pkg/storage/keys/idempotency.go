@@ -0,0 +1,27 @@
+package keys
+
+const IdempotencyKeyKey = "IDEMPOTENCY_KEY#"
+
+type idempotencyKeyKeys struct {
+	PK1 string
+	SK1 func(userID string, operation string, key string) string
+}
+
+var IdempotencyKey = idempotencyKeyKeys{
+	PK1: IdempotencyKeyKey,
+	SK1: func(userID string, operation string, key string) string {
+		return userID + "#" + operation + "#" + key + "#"
+	},
+}
+
+const GrantClaimKey = "GRANT_CLAIM#"
+
+type grantClaimKeys struct {
+	PK1 string
+	SK1 func(targetID string) string
+}
+
+var GrantClaim = grantClaimKeys{
+	PK1: GrantClaimKey,
+	SK1: func(targetID string) string { return targetID + "#" },
+}
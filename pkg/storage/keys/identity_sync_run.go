@@ -0,0 +1,14 @@
+package keys
+
+const IdentitySyncRunKey = "IDENTITY_SYNC_RUN#"
+
+type identitySyncRunKeys struct {
+	PK1 string
+	SK1 func(id string) string
+}
+
+// SK1 is the run ID, which is a KSUID so that runs are sorted by the time they started.
+var IdentitySyncRun = identitySyncRunKeys{
+	PK1: IdentitySyncRunKey,
+	SK1: func(id string) string { return id + "#" },
+}
@@ -0,0 +1,15 @@
+package keys
+
+const QueuedEventKey = "QUEUED_EVENT#"
+
+type queuedEventKeys struct {
+	PK1       string
+	SK1       func(subscriber string, sequence string) string
+	SK1Prefix func(subscriber string) string
+}
+
+var QueuedEvent = queuedEventKeys{
+	PK1:       QueuedEventKey,
+	SK1:       func(subscriber string, sequence string) string { return subscriber + "#" + sequence + "#" },
+	SK1Prefix: func(subscriber string) string { return subscriber + "#" },
+}
@@ -0,0 +1,25 @@
+package keys
+
+const TargetGroupSyncStatusKey = "TARGET_GROUP_SYNC_STATUS#"
+
+type targetGroupSyncStatusKeys struct {
+	PK1 string
+	SK1 func(targetGroupID string) string
+}
+
+var TargetGroupSyncStatus = targetGroupSyncStatusKeys{
+	PK1: TargetGroupSyncStatusKey,
+	SK1: func(targetGroupID string) string { return targetGroupID + "#" },
+}
+
+const TargetGroupSyncStaleNotificationKey = "TARGET_GROUP_SYNC_STALE_NOTIFICATION#"
+
+type targetGroupSyncStaleNotificationKeys struct {
+	PK1 string
+	SK1 func(targetGroupID string, lastSuccess string) string
+}
+
+var TargetGroupSyncStaleNotification = targetGroupSyncStaleNotificationKeys{
+	PK1: TargetGroupSyncStaleNotificationKey,
+	SK1: func(targetGroupID string, lastSuccess string) string { return targetGroupID + "#" + lastSuccess + "#" },
+}
@@ -0,0 +1,13 @@
+package keys
+
+const TargetMetadataKey = "TARGET_METADATA#"
+
+type targetMetadataKeys struct {
+	PK1 string
+	SK1 func(id string) string
+}
+
+var TargetMetadata = targetMetadataKeys{
+	PK1: TargetMetadataKey,
+	SK1: func(id string) string { return id + "#" },
+}
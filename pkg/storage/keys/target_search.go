@@ -0,0 +1,23 @@
+package keys
+
+const EntitlementTargetSearchKey = "ENTITLEMENT_TARGET_SEARCH#"
+
+type entitlementTargetSearchKeys struct {
+	PK1                  func(idpGroupID string) string
+	SK1                  func(targetID string) string
+	SK1PublisherNameKind func(publisher, name, kind string) string
+	GSI1PK               func(idpGroupID string) string
+	GSI1SK               func(sortLabel string, targetID string) string
+}
+
+// EntitlementTargetSearch keys are partitioned by IDP group so that the targets a user can access
+// can be read by querying only the partitions for their groups.
+// The sort key is the target ID, which is prefixed with the publisher, name and kind of the target.
+// GSI1 has the same partitions, sorted by the labels of the target fields.
+var EntitlementTargetSearch = entitlementTargetSearchKeys{
+	PK1:                  func(idpGroupID string) string { return EntitlementTargetSearchKey + idpGroupID + "#" },
+	SK1:                  func(targetID string) string { return targetID },
+	SK1PublisherNameKind: func(publisher, name, kind string) string { return publisher + "#" + name + "#" + kind + "#" },
+	GSI1PK:               func(idpGroupID string) string { return EntitlementTargetSearchKey + idpGroupID + "#" },
+	GSI1SK:               func(sortLabel string, targetID string) string { return sortLabel + "#" + targetID },
+}
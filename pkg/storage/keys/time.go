@@ -0,0 +1,8 @@
+package keys
+
+import "time"
+
+// SortableTime formats a time for use in a sort key, so that the keys sort in time order.
+func SortableTime(t time.Time) string {
+	return t.UTC().Format("2006-01-02T15:04:05.000000000Z")
+}
@@ -0,0 +1,29 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+)
+
+// ListActiveFreezes lists the freezes which haven't been lifted and haven't ended at Now.
+type ListActiveFreezes struct {
+	Now    time.Time
+	Result []access.Freeze `ddb:"result"`
+}
+
+func (l *ListActiveFreezes) BuildQuery() (*dynamodb.QueryInput, error) {
+	qi := dynamodb.QueryInput{
+		IndexName:              &keys.IndexNames.GSI1,
+		KeyConditionExpression: aws.String("GSI1PK = :pk1 AND GSI1SK > :sk1"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk1": &types.AttributeValueMemberS{Value: keys.Freeze.GSI1PK},
+			":sk1": &types.AttributeValueMemberS{Value: keys.Freeze.GSI1SKNow(l.Now)},
+		},
+	}
+	return &qi, nil
+}
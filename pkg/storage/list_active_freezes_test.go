@@ -0,0 +1,39 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbtest"
+)
+
+func TestListActiveFreezes(t *testing.T) {
+	ts := newTestingStorage(t)
+	err := ts.deleteAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	ending := access.Freeze{ID: types.NewFreezeID(), Scope: types.FreezeScopeGLOBAL, EndsAt: &future, CreatedAt: now}
+	open := access.Freeze{ID: types.NewFreezeID(), Scope: types.FreezeScopeGLOBAL, CreatedAt: now}
+	ended := access.Freeze{ID: types.NewFreezeID(), Scope: types.FreezeScopeGLOBAL, EndsAt: &past, CreatedAt: now}
+	lifted := access.Freeze{ID: types.NewFreezeID(), Scope: types.FreezeScopeGLOBAL, LiftedAt: &past, CreatedAt: now}
+
+	ddbtest.PutFixtures(t, ts.db, []ddb.Keyer{&ending, &open, &ended, &lifted})
+	tc := []ddbtest.QueryTestCase{
+		{
+			Name:  "ok",
+			Query: &ListActiveFreezes{Now: now},
+			// freezes without an end time are sorted last
+			Want: &ListActiveFreezes{Now: now, Result: []access.Freeze{ending, open}},
+		},
+	}
+
+	ddbtest.RunQueryTests(t, ts.db, tc)
+}
@@ -0,0 +1,25 @@
+package storage
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+)
+
+type ListBulkRevokeJobItems struct {
+	JobID  string
+	Result []access.BulkRevokeJobItem `ddb:"result"`
+}
+
+func (l *ListBulkRevokeJobItems) BuildQuery() (*dynamodb.QueryInput, error) {
+	qi := dynamodb.QueryInput{
+		KeyConditionExpression: aws.String("PK = :pk AND begins_with(SK, :sk)"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk": &types.AttributeValueMemberS{Value: keys.BulkRevokeJobItem.PK1},
+			":sk": &types.AttributeValueMemberS{Value: keys.BulkRevokeJobItem.SK1Job(l.JobID)},
+		},
+	}
+	return &qi, nil
+}
@@ -0,0 +1,124 @@
+package storage
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/common-fate/pkg/cache"
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+)
+
+// ListCachedTargetSearchEntriesForGroup lists the entries in the target search index for an IDP group.
+// If Publisher, Name and Kind are set, only entries for targets of that kind are returned.
+// Entries are sorted by SortBy, and if After is set only the entries after that sort key are returned.
+type ListCachedTargetSearchEntriesForGroup struct {
+	IDPGroupID string
+	Publisher  string
+	Name       string
+	Kind       string
+	// Terms must each be contained in the search text of an entry
+	Terms []string
+	// FieldFilters are exact matches on the value or label of a target field, keyed by field ID
+	FieldFilters map[string]string
+	SortBy       cache.TargetSortBy
+	Descending   bool
+	After        string
+	Result       []cache.TargetSearchEntry `ddb:"result"`
+}
+
+func (l *ListCachedTargetSearchEntriesForGroup) BuildQuery() (*dynamodb.QueryInput, error) {
+	qi := dynamodb.QueryInput{
+		ScanIndexForward:          aws.Bool(!l.Descending),
+		ExpressionAttributeNames:  map[string]string{},
+		ExpressionAttributeValues: map[string]types.AttributeValue{},
+	}
+	var filters []string
+	hasKind := l.Publisher != "" && l.Name != "" && l.Kind != ""
+
+	if l.SortBy == cache.TargetSortByKind {
+		qi.ExpressionAttributeValues[":pk"] = &types.AttributeValueMemberS{Value: keys.EntitlementTargetSearch.PK1(l.IDPGroupID)}
+		keyCondition := "PK = :pk"
+		if hasKind {
+			// the target ID is prefixed with the kind, so the entries for a kind are a range of sort keys
+			prefix := keys.EntitlementTargetSearch.SK1PublisherNameKind(l.Publisher, l.Name, l.Kind)
+			from, to := prefix, prefix+string(utf8.MaxRune)
+			if l.After != "" && !l.Descending && l.After > from {
+				from = l.After
+			}
+			if l.After != "" && l.Descending && l.After < to {
+				to = l.After
+			}
+			// a cursor outside of the range is from a search for a different kind
+			if from > to {
+				return nil, cache.ErrInvalidSearchCursor
+			}
+			keyCondition += " AND SK BETWEEN :from AND :to"
+			qi.ExpressionAttributeValues[":from"] = &types.AttributeValueMemberS{Value: from}
+			qi.ExpressionAttributeValues[":to"] = &types.AttributeValueMemberS{Value: to}
+		} else if l.After != "" {
+			keyCondition += " AND SK " + afterOperator(l.Descending) + " :after"
+			qi.ExpressionAttributeValues[":after"] = &types.AttributeValueMemberS{Value: l.After}
+		}
+		qi.KeyConditionExpression = aws.String(keyCondition)
+	} else {
+		qi.IndexName = &keys.IndexNames.GSI1
+		qi.ExpressionAttributeValues[":pk1"] = &types.AttributeValueMemberS{Value: keys.EntitlementTargetSearch.GSI1PK(l.IDPGroupID)}
+		keyCondition := "GSI1PK = :pk1"
+		if l.After != "" {
+			keyCondition += " AND GSI1SK " + afterOperator(l.Descending) + " :after"
+			qi.ExpressionAttributeValues[":after"] = &types.AttributeValueMemberS{Value: l.After}
+		}
+		qi.KeyConditionExpression = aws.String(keyCondition)
+		if hasKind {
+			qi.ExpressionAttributeNames["#target"] = "target"
+			qi.ExpressionAttributeNames["#kind"] = "kind"
+			qi.ExpressionAttributeNames["#publisher"] = "publisher"
+			qi.ExpressionAttributeNames["#name"] = "name"
+			qi.ExpressionAttributeValues[":publisher"] = &types.AttributeValueMemberS{Value: l.Publisher}
+			qi.ExpressionAttributeValues[":name"] = &types.AttributeValueMemberS{Value: l.Name}
+			qi.ExpressionAttributeValues[":kind"] = &types.AttributeValueMemberS{Value: l.Kind}
+			filters = append(filters, "#target.#kind.#publisher = :publisher", "#target.#kind.#name = :name", "#target.#kind.#kind = :kind")
+		}
+	}
+
+	for i, term := range l.Terms {
+		qi.ExpressionAttributeNames["#searchText"] = "searchText"
+		v := ":term" + strconv.Itoa(i)
+		qi.ExpressionAttributeValues[v] = &types.AttributeValueMemberS{Value: term}
+		filters = append(filters, "contains(#searchText, "+v+")")
+	}
+
+	// the filters are sorted by field ID so that the query is the same for each page
+	fieldIDs := make([]string, 0, len(l.FieldFilters))
+	for fieldID := range l.FieldFilters {
+		fieldIDs = append(fieldIDs, fieldID)
+	}
+	sort.Strings(fieldIDs)
+	for i, fieldID := range fieldIDs {
+		qi.ExpressionAttributeNames["#fieldValues"] = "fieldValues"
+		v := ":field" + strconv.Itoa(i)
+		qi.ExpressionAttributeValues[v] = &types.AttributeValueMemberS{Value: cache.FieldFilterValue(fieldID, l.FieldFilters[fieldID])}
+		filters = append(filters, "contains(#fieldValues, "+v+")")
+	}
+
+	if len(filters) > 0 {
+		qi.FilterExpression = aws.String(strings.Join(filters, " AND "))
+	}
+	if len(qi.ExpressionAttributeNames) == 0 {
+		qi.ExpressionAttributeNames = nil
+	}
+	return &qi, nil
+}
+
+// afterOperator returns the comparison for sort keys which come after a key in the sort order
+func afterOperator(descending bool) string {
+	if descending {
+		return "<"
+	}
+	return ">"
+}
@@ -0,0 +1,108 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/common-fate/pkg/cache"
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListCachedTargetSearchEntriesForGroupQuery(t *testing.T) {
+	type testcase struct {
+		name             string
+		give             ListCachedTargetSearchEntriesForGroup
+		wantIndex        *string
+		wantKeyCondition string
+		wantFilter       *string
+		wantValues       map[string]string
+		wantErr          error
+	}
+
+	testcases := []testcase{
+		{
+			name:             "sorted by label",
+			give:             ListCachedTargetSearchEntriesForGroup{IDPGroupID: "admins"},
+			wantIndex:        &keys.IndexNames.GSI1,
+			wantKeyCondition: "GSI1PK = :pk1",
+			wantValues:       map[string]string{":pk1": "ENTITLEMENT_TARGET_SEARCH#admins#"},
+		},
+		{
+			name: "filters are applied by DynamoDB",
+			give: ListCachedTargetSearchEntriesForGroup{
+				IDPGroupID:   "admins",
+				Publisher:    "common-fate",
+				Name:         "aws",
+				Kind:         "Account",
+				Terms:        []string{"prod"},
+				FieldFilters: map[string]string{"region": "US-EAST-1", "accountId": "0123"},
+				Descending:   true,
+				After:        "prod#common-fate#aws#Account#accountId#4567#",
+			},
+			wantIndex:        &keys.IndexNames.GSI1,
+			wantKeyCondition: "GSI1PK = :pk1 AND GSI1SK < :after",
+			wantFilter:       aws.String("#target.#kind.#publisher = :publisher AND #target.#kind.#name = :name AND #target.#kind.#kind = :kind AND contains(#searchText, :term0) AND contains(#fieldValues, :field0) AND contains(#fieldValues, :field1)"),
+			wantValues: map[string]string{
+				":pk1":       "ENTITLEMENT_TARGET_SEARCH#admins#",
+				":after":     "prod#common-fate#aws#Account#accountId#4567#",
+				":publisher": "common-fate",
+				":name":      "aws",
+				":kind":      "Account",
+				":term0":     "prod",
+				":field0":    "accountId:0123",
+				":field1":    "region:us-east-1",
+			},
+		},
+		{
+			name:             "sorted by kind after a cursor",
+			give:             ListCachedTargetSearchEntriesForGroup{IDPGroupID: "admins", SortBy: cache.TargetSortByKind, After: "common-fate#aws#Account#accountId#0123#"},
+			wantKeyCondition: "PK = :pk AND SK > :after",
+			wantValues: map[string]string{
+				":pk":    "ENTITLEMENT_TARGET_SEARCH#admins#",
+				":after": "common-fate#aws#Account#accountId#0123#",
+			},
+		},
+		{
+			name: "kind is a range of sort keys",
+			give: ListCachedTargetSearchEntriesForGroup{
+				IDPGroupID: "admins", SortBy: cache.TargetSortByKind, Publisher: "common-fate", Name: "aws", Kind: "Account",
+				After: "common-fate#aws#Account#accountId#0123#",
+			},
+			wantKeyCondition: "PK = :pk AND SK BETWEEN :from AND :to",
+			wantValues: map[string]string{
+				":pk":   "ENTITLEMENT_TARGET_SEARCH#admins#",
+				":from": "common-fate#aws#Account#accountId#0123#",
+				":to":   "common-fate#aws#Account#\U0010FFFF",
+			},
+		},
+		{
+			name: "cursor for a different kind",
+			give: ListCachedTargetSearchEntriesForGroup{
+				IDPGroupID: "admins", SortBy: cache.TargetSortByKind, Publisher: "common-fate", Name: "aws", Kind: "Account",
+				After: "common-fate#okta#Group#groupId#0123#",
+			},
+			wantErr: cache.ErrInvalidSearchCursor,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.give.BuildQuery()
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.wantIndex, got.IndexName)
+			assert.Equal(t, tc.wantKeyCondition, aws.ToString(got.KeyConditionExpression))
+			assert.Equal(t, tc.wantFilter, got.FilterExpression)
+			assert.Equal(t, !tc.give.Descending, aws.ToBool(got.ScanIndexForward))
+			values := map[string]string{}
+			for k, v := range got.ExpressionAttributeValues {
+				values[k] = v.(*types.AttributeValueMemberS).Value
+			}
+			assert.Equal(t, tc.wantValues, values)
+		})
+	}
+}
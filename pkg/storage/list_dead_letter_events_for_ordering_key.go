@@ -0,0 +1,28 @@
+package storage
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+)
+
+// ListDeadLetterEventsForOrderingKey lists the dead letter events with an ordering key, such as the events for a request,
+// most recent failure first.
+type ListDeadLetterEventsForOrderingKey struct {
+	OrderingKey string
+	Result      []access.DeadLetterEvent `ddb:"result"`
+}
+
+func (l *ListDeadLetterEventsForOrderingKey) BuildQuery() (*dynamodb.QueryInput, error) {
+	qi := dynamodb.QueryInput{
+		IndexName:              &keys.IndexNames.GSI1,
+		ScanIndexForward:       aws.Bool(false),
+		KeyConditionExpression: aws.String("GSI1PK = :pk1"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk1": &types.AttributeValueMemberS{Value: keys.DeadLetterEvent.GSI1PK(l.OrderingKey)},
+		},
+	}
+	return &qi, nil
+}
@@ -0,0 +1,27 @@
+package storage
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+)
+
+// ListDeadLetterEventsForSubscriber lists the dead letter events which a subscriber failed to handle, most recent failure first.
+type ListDeadLetterEventsForSubscriber struct {
+	Subscriber string
+	Result     []access.DeadLetterEvent `ddb:"result"`
+}
+
+func (l *ListDeadLetterEventsForSubscriber) BuildQuery() (*dynamodb.QueryInput, error) {
+	qi := dynamodb.QueryInput{
+		IndexName:              &keys.IndexNames.GSI2,
+		ScanIndexForward:       aws.Bool(false),
+		KeyConditionExpression: aws.String("GSI2PK = :pk1"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk1": &types.AttributeValueMemberS{Value: keys.DeadLetterEvent.GSI2PK(l.Subscriber)},
+		},
+	}
+	return &qi, nil
+}
@@ -0,0 +1,25 @@
+package storage
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+)
+
+type ListFreezeHistory struct {
+	FreezeID string
+	Result   []access.FreezeHistoryEvent `ddb:"result"`
+}
+
+func (l *ListFreezeHistory) BuildQuery() (*dynamodb.QueryInput, error) {
+	qi := dynamodb.QueryInput{
+		KeyConditionExpression: aws.String("PK = :pk AND begins_with(SK, :sk)"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk": &types.AttributeValueMemberS{Value: keys.FreezeHistoryEvent.PK1},
+			":sk": &types.AttributeValueMemberS{Value: keys.FreezeHistoryEvent.SK1Freeze(l.FreezeID)},
+		},
+	}
+	return &qi, nil
+}
@@ -0,0 +1,25 @@
+package storage
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+)
+
+// ListIdentitySyncRuns lists identity sync runs, most recent first.
+type ListIdentitySyncRuns struct {
+	Result []identity.SyncRun `ddb:"result"`
+}
+
+func (l *ListIdentitySyncRuns) BuildQuery() (*dynamodb.QueryInput, error) {
+	qi := dynamodb.QueryInput{
+		ScanIndexForward:       aws.Bool(false),
+		KeyConditionExpression: aws.String("PK = :pk1"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk1": &types.AttributeValueMemberS{Value: keys.IdentitySyncRun.PK1},
+		},
+	}
+	return &qi, nil
+}
@@ -0,0 +1,26 @@
+package storage
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+)
+
+// ListQueuedEvents lists the events waiting to be delivered to a subscriber, oldest first.
+type ListQueuedEvents struct {
+	Subscriber string
+	Result     []access.QueuedEvent `ddb:"result"`
+}
+
+func (l *ListQueuedEvents) BuildQuery() (*dynamodb.QueryInput, error) {
+	qi := dynamodb.QueryInput{
+		KeyConditionExpression: aws.String("PK = :pk and begins_with(SK, :sk)"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk": &types.AttributeValueMemberS{Value: keys.QueuedEvent.PK1},
+			":sk": &types.AttributeValueMemberS{Value: keys.QueuedEvent.SK1Prefix(l.Subscriber)},
+		},
+	}
+	return &qi, nil
+}
@@ -0,0 +1,28 @@
+package storage
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+	gendTypes "github.com/common-fate/common-fate/pkg/types"
+)
+
+// ListRequestGroupTargetsForTargetGroup lists the targets in a target group, for requests with the given status.
+type ListRequestGroupTargetsForTargetGroup struct {
+	TargetGroupID string
+	Status        gendTypes.RequestStatus
+	Result        []access.GroupTarget `ddb:"result"`
+}
+
+func (l *ListRequestGroupTargetsForTargetGroup) BuildQuery() (*dynamodb.QueryInput, error) {
+	qi := dynamodb.QueryInput{
+		IndexName:              aws.String(keys.IndexNames.GSI4),
+		KeyConditionExpression: aws.String("GSI4PK = :pk1"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk1": &types.AttributeValueMemberS{Value: keys.AccessRequestGroupTarget.GSI4PK(l.TargetGroupID, l.Status)},
+		},
+	}
+	return &qi, nil
+}
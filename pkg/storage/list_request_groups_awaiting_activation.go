@@ -0,0 +1,30 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+)
+
+// ListRequestGroupsAwaitingActivation lists the on demand access groups awaiting activation
+// whose activation deadline is before DeadlineBefore.
+type ListRequestGroupsAwaitingActivation struct {
+	DeadlineBefore time.Time
+	Result         []access.Group `ddb:"result"`
+}
+
+func (l *ListRequestGroupsAwaitingActivation) BuildQuery() (*dynamodb.QueryInput, error) {
+	qi := dynamodb.QueryInput{
+		IndexName:              aws.String(keys.IndexNames.GSI4),
+		KeyConditionExpression: aws.String("GSI4PK = :pk1 and GSI4SK < :sk1"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk1": &types.AttributeValueMemberS{Value: keys.AccessRequestGroup.GSI4PK},
+			":sk1": &types.AttributeValueMemberS{Value: keys.ActivationDeadlineKey(l.DeadlineBefore)},
+		},
+	}
+	return &qi, nil
+}
@@ -0,0 +1,28 @@
+package storage
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+	gendTypes "github.com/common-fate/common-fate/pkg/types"
+)
+
+// ListRequestGroupsForAccessRule lists the access groups requested with an access rule, for requests with the given status.
+type ListRequestGroupsForAccessRule struct {
+	AccessRuleID string
+	Status       gendTypes.RequestStatus
+	Result       []access.Group `ddb:"result"`
+}
+
+func (l *ListRequestGroupsForAccessRule) BuildQuery() (*dynamodb.QueryInput, error) {
+	qi := dynamodb.QueryInput{
+		IndexName:              aws.String(keys.IndexNames.GSI3),
+		KeyConditionExpression: aws.String("GSI3PK = :pk1"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk1": &types.AttributeValueMemberS{Value: keys.AccessRequestGroup.GSI3PK(l.AccessRuleID, l.Status)},
+		},
+	}
+	return &qi, nil
+}
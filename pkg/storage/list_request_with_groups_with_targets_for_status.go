@@ -1,6 +1,8 @@
 package storage
 
 import (
+	"time"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	ddbTypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
@@ -8,11 +10,15 @@ import (
 	"github.com/common-fate/common-fate/pkg/storage/keys"
 	"github.com/common-fate/common-fate/pkg/types"
 	"github.com/common-fate/ddb"
+	"github.com/segmentio/ksuid"
 )
 
 type ListRequestWithGroupsWithTargetsForStatus struct {
 	Status types.RequestStatus
-	Result []access.RequestWithGroupsWithTargets
+	// CreatedAfter optionally limits the results to requests created after this time.
+	// Request IDs are KSUIDs, which sort by the time they were created.
+	CreatedAfter time.Time
+	Result       []access.RequestWithGroupsWithTargets
 }
 
 func (g *ListRequestWithGroupsWithTargetsForStatus) BuildQuery() (*dynamodb.QueryInput, error) {
@@ -24,6 +30,15 @@ func (g *ListRequestWithGroupsWithTargetsForStatus) BuildQuery() (*dynamodb.Quer
 			":pk1": &ddbTypes.AttributeValueMemberS{Value: keys.AccessRequest.GSI2PK(g.Status)},
 		},
 	}
+	if !g.CreatedAfter.IsZero() {
+		// the smallest KSUID for the time, so that every request created after it sorts after it
+		id, err := ksuid.FromParts(g.CreatedAfter, make([]byte, 16))
+		if err != nil {
+			return nil, err
+		}
+		qi.KeyConditionExpression = aws.String("GSI2PK = :pk1 and GSI2SK >= :sk1")
+		qi.ExpressionAttributeValues[":sk1"] = &ddbTypes.AttributeValueMemberS{Value: keys.AccessRequest.GSI2SK("req_" + id.String())}
+	}
 
 	return qi, nil
 }
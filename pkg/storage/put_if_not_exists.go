@@ -0,0 +1,42 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/ddb"
+)
+
+// PutIfNotExists writes the item with a conditional write. It returns false without writing the item
+// if an item with the same keys already exists.
+//
+// Existing items with a "ttl" attribute which has passed are overwritten, as DynamoDB deletes expired items in the background.
+func PutIfNotExists(ctx context.Context, db ddb.Storage, item ddb.Keyer, now time.Time) (bool, error) {
+	attrs, err := marshalItem(item)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = db.Client().PutItem(ctx, &dynamodb.PutItemInput{
+		TableName:                aws.String(db.Table()),
+		Item:                     attrs,
+		ConditionExpression:      aws.String("attribute_not_exists(PK) OR #ttl < :now"),
+		ExpressionAttributeNames: map[string]string{"#ttl": "ttl"},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":now": &types.AttributeValueMemberN{Value: strconv.FormatInt(now.Unix(), 10)},
+		},
+	})
+	var ccf *types.ConditionalCheckFailedException
+	if errors.As(err, &ccf) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,241 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/ddb"
+)
+
+// maxTransactionItems is the maximum number of items in a DynamoDB transaction.
+const maxTransactionItems = 100
+
+// StatusGuard wraps a ddb.Storage and makes status transitions on requests, groups and targets
+// with optimistic concurrency. An item with a pending access.StatusTransition is written with a condition
+// that its stored status is still the status it was read with, otherwise access.ErrStaleStatus is returned.
+//
+// Items without a pending transition are written as normal.
+type StatusGuard struct {
+	ddb.Storage
+}
+
+// NewStatusGuard wraps db so that status transitions are written with conditional writes.
+func NewStatusGuard(db ddb.Storage) *StatusGuard {
+	return &StatusGuard{Storage: db}
+}
+
+func (s *StatusGuard) Put(ctx context.Context, item ddb.Keyer) error {
+	if !hasPendingTransition(item) {
+		return s.Storage.Put(ctx, item)
+	}
+	put, err := buildStatusPut(s.Table(), item)
+	if err != nil {
+		return err
+	}
+	_, err = s.Client().PutItem(ctx, &dynamodb.PutItemInput{
+		TableName:                 put.TableName,
+		Item:                      put.Item,
+		ConditionExpression:       put.ConditionExpression,
+		ExpressionAttributeNames:  put.ExpressionAttributeNames,
+		ExpressionAttributeValues: put.ExpressionAttributeValues,
+	})
+	if err != nil {
+		return statusWriteError(err)
+	}
+	transitionsWritten(item)
+	return nil
+}
+
+// PutBatch writes the items in a transaction if any of them have a pending transition,
+// so that none of the items are written if a status is stale.
+// Batches larger than the DynamoDB transaction limit are written in several transactions.
+func (s *StatusGuard) PutBatch(ctx context.Context, items ...ddb.Keyer) error {
+	guarded := false
+	for _, item := range items {
+		if hasPendingTransition(item) {
+			guarded = true
+			break
+		}
+	}
+	if !guarded {
+		return s.Storage.PutBatch(ctx, items...)
+	}
+	tx := make([]ddb.TransactWriteItem, len(items))
+	for i, item := range items {
+		tx[i] = ddb.TransactWriteItem{Put: item}
+	}
+	return s.TransactWriteItems(ctx, tx)
+}
+
+func (s *StatusGuard) TransactWriteItems(ctx context.Context, tx []ddb.TransactWriteItem) error {
+	guarded := false
+	for _, entry := range tx {
+		if hasPendingTransition(entry.Put) {
+			guarded = true
+			break
+		}
+	}
+	if !guarded {
+		return s.Storage.TransactWriteItems(ctx, tx)
+	}
+
+	for i := 0; i < len(tx); i += maxTransactionItems {
+		end := len(tx)
+		if i+maxTransactionItems < end {
+			end = i + maxTransactionItems
+		}
+		twi, err := buildStatusTransaction(s.Table(), tx[i:end])
+		if err != nil {
+			return err
+		}
+		_, err = s.Client().TransactWriteItems(ctx, twi)
+		if err != nil {
+			return statusWriteError(err)
+		}
+		for _, entry := range tx[i:end] {
+			transitionsWritten(entry.Put)
+		}
+	}
+	return nil
+}
+
+func hasPendingTransition(item ddb.Keyer) bool {
+	t, ok := item.(access.Transitioner)
+	if !ok {
+		return false
+	}
+	_, ok = t.PendingTransition()
+	return ok
+}
+
+func transitionsWritten(item ddb.Keyer) {
+	if t, ok := item.(access.Transitioner); ok {
+		t.TransitionWritten()
+	}
+}
+
+// statusWriteError converts a failed condition into access.ErrStaleStatus.
+func statusWriteError(err error) error {
+	if conditionFailed(err) {
+		return access.ErrStaleStatus
+	}
+	return err
+}
+
+// conditionFailed returns true if a write or a transaction failed because a condition wasn't met.
+func conditionFailed(err error) bool {
+	var ccf *types.ConditionalCheckFailedException
+	if errors.As(err, &ccf) {
+		return true
+	}
+	var tce *types.TransactionCanceledException
+	if errors.As(err, &tce) {
+		for _, r := range tce.CancellationReasons {
+			if aws.ToString(r.Code) == "ConditionalCheckFailed" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func buildStatusTransaction(table string, tx []ddb.TransactWriteItem) (*dynamodb.TransactWriteItemsInput, error) {
+	twi := dynamodb.TransactWriteItemsInput{
+		TransactItems: make([]types.TransactWriteItem, len(tx)),
+	}
+	for i, entry := range tx {
+		if entry.Put == nil && entry.Delete == nil {
+			return nil, errors.New("no operation defined for transaction")
+		}
+		if entry.Put != nil && entry.Delete != nil {
+			return nil, errors.New("both Put and Delete operations were defined for a transaction")
+		}
+		if entry.Put != nil {
+			put, err := buildStatusPut(table, entry.Put)
+			if err != nil {
+				return nil, err
+			}
+			twi.TransactItems[i] = types.TransactWriteItem{Put: put}
+			continue
+		}
+		key, err := itemKey(entry.Delete)
+		if err != nil {
+			return nil, err
+		}
+		twi.TransactItems[i] = types.TransactWriteItem{
+			Delete: &types.Delete{
+				TableName: aws.String(table),
+				Key:       key,
+			},
+		}
+	}
+	return &twi, nil
+}
+
+// buildStatusPut builds a put for the item, with a condition on the item's status if it has a pending transition.
+func buildStatusPut(table string, item ddb.Keyer) (*types.Put, error) {
+	attrs, err := marshalItem(item)
+	if err != nil {
+		return nil, err
+	}
+	put := types.Put{
+		TableName: aws.String(table),
+		Item:      attrs,
+	}
+	t, ok := item.(access.Transitioner)
+	if !ok {
+		return &put, nil
+	}
+	transition, ok := t.PendingTransition()
+	if !ok {
+		return &put, nil
+	}
+
+	names := map[string]string{}
+	var path []string
+	for i, p := range transition.Path {
+		name := fmt.Sprintf("#status%d", i)
+		names[name] = p
+		path = append(path, name)
+	}
+	statusPath := strings.Join(path, ".")
+	put.ExpressionAttributeNames = names
+	put.ExpressionAttributeValues = map[string]types.AttributeValue{
+		":from": &types.AttributeValueMemberS{Value: transition.From},
+	}
+	if transition.From == "" {
+		put.ConditionExpression = aws.String(fmt.Sprintf("attribute_not_exists(%s) OR %s = :from", statusPath, statusPath))
+	} else {
+		put.ConditionExpression = aws.String(fmt.Sprintf("%s = :from", statusPath))
+	}
+	return &put, nil
+}
+
+// marshalItem marshals the item the same way as ddb.Put, skipping empty index keys.
+func marshalItem(item ddb.Keyer) (map[string]types.AttributeValue, error) {
+	itemKeys, err := item.DDBKeys()
+	if err != nil {
+		return nil, err
+	}
+	attrs, err := attributevalue.MarshalMap(item)
+	if err != nil {
+		return nil, err
+	}
+	keyAttrs, err := attributevalue.MarshalMap(itemKeys)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range keyAttrs {
+		if s, ok := v.(*types.AttributeValueMemberS); ok && s.Value != "" {
+			attrs[k] = v
+		}
+	}
+	return attrs, nil
+}
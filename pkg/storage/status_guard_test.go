@@ -0,0 +1,158 @@
+package storage
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	ctypes "github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildStatusPut(t *testing.T) {
+	type testcase struct {
+		name       string
+		give       func() ddb.Keyer
+		wantCond   *string
+		wantNames  map[string]string
+		wantValues map[string]types.AttributeValue
+	}
+
+	testcases := []testcase{
+		{
+			name: "no transition",
+			give: func() ddb.Keyer {
+				return &access.Group{ID: "grp_1", RequestID: "req_1", Status: ctypes.RequestAccessGroupStatusAPPROVED}
+			},
+		},
+		{
+			name: "group transition",
+			give: func() ddb.Keyer {
+				g := access.Group{ID: "grp_1", RequestID: "req_1", Status: ctypes.RequestAccessGroupStatusPENDINGAPPROVAL}
+				_ = g.TransitionStatus(ctypes.RequestAccessGroupStatusAPPROVED)
+				return &g
+			},
+			wantCond:   aws.String("#status0 = :from"),
+			wantNames:  map[string]string{"#status0": "status"},
+			wantValues: map[string]types.AttributeValue{":from": &types.AttributeValueMemberS{Value: "PENDING_APPROVAL"}},
+		},
+		{
+			name: "first grant",
+			give: func() ddb.Keyer {
+				target := access.GroupTarget{ID: "gta_1", GroupID: "grp_1", RequestID: "req_1"}
+				_ = target.TransitionGrantStatus(ctypes.RequestAccessGroupTargetStatusAWAITINGSTART)
+				return &target
+			},
+			wantCond:   aws.String("attribute_not_exists(#status0.#status1) OR #status0.#status1 = :from"),
+			wantNames:  map[string]string{"#status0": "grant", "#status1": "status"},
+			wantValues: map[string]types.AttributeValue{":from": &types.AttributeValueMemberS{Value: ""}},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := buildStatusPut("table", tc.give())
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, "table", aws.ToString(got.TableName))
+			assert.Equal(t, tc.wantCond, got.ConditionExpression)
+			if tc.wantNames != nil {
+				assert.Equal(t, tc.wantNames, got.ExpressionAttributeNames)
+				assert.Equal(t, tc.wantValues, got.ExpressionAttributeValues)
+			}
+			assert.NotNil(t, got.Item["PK"])
+		})
+	}
+}
+
+func TestStatusWriteError(t *testing.T) {
+	assert.Equal(t, access.ErrStaleStatus, statusWriteError(&types.ConditionalCheckFailedException{}))
+	assert.Equal(t, access.ErrStaleStatus, statusWriteError(&types.TransactionCanceledException{
+		CancellationReasons: []types.CancellationReason{{Code: aws.String("None")}, {Code: aws.String("ConditionalCheckFailed")}},
+	}))
+	other := &types.TransactionCanceledException{
+		CancellationReasons: []types.CancellationReason{{Code: aws.String("TransactionConflict")}},
+	}
+	assert.Equal(t, other, statusWriteError(other))
+}
+
+func TestBuildStatusTransaction(t *testing.T) {
+	g := access.Group{ID: "grp_1", RequestID: "req_1", Status: ctypes.RequestAccessGroupStatusAPPROVED}
+	_ = g.TransitionStatus(ctypes.RequestAccessGroupStatusAWAITINGACTIVATION)
+	evt := access.NewGroupStatusChangeEvent("req_1", g.CreatedAt, aws.String(""), ctypes.RequestAccessGroupStatusAPPROVED, ctypes.RequestAccessGroupStatusAWAITINGACTIVATION)
+
+	got, err := buildStatusTransaction("table", []ddb.TransactWriteItem{{Put: &g}, {Put: &evt}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, got.TransactItems, 2)
+	assert.Equal(t, "#status0 = :from", aws.ToString(got.TransactItems[0].Put.ConditionExpression))
+	assert.Nil(t, got.TransactItems[1].Put.ConditionExpression)
+}
+
+// conditionFailedDB returns a DynamoDB client which fails every conditional write, as DynamoDB does when the stored status has changed.
+type conditionFailedDB struct {
+	*mockClient
+	client *dynamodb.Client
+	// targets are the operations which were called
+	targets []string
+}
+
+type mockClient = ddbmock.Client
+
+func (d *conditionFailedDB) Client() *dynamodb.Client {
+	return d.client
+}
+
+func newConditionFailedDB(t *testing.T) *conditionFailedDB {
+	db := &conditionFailedDB{mockClient: ddbmock.New(t)}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		target := r.Header.Get("X-Amz-Target")
+		db.targets = append(db.targets, target)
+		w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+		w.WriteHeader(http.StatusBadRequest)
+		if target == "DynamoDB_20120810.TransactWriteItems" {
+			_, _ = w.Write([]byte(`{"__type":"com.amazonaws.dynamodb.v20120810#TransactionCanceledException","message":"Transaction cancelled","CancellationReasons":[{"Code":"None"},{"Code":"ConditionalCheckFailed"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"__type":"com.amazonaws.dynamodb.v20120810#ConditionalCheckFailedException","message":"The conditional request failed"}`))
+	}))
+	t.Cleanup(srv.Close)
+	db.client = dynamodb.New(dynamodb.Options{
+		Region:           "us-east-1",
+		Credentials:      aws.AnonymousCredentials{},
+		EndpointResolver: dynamodb.EndpointResolverFromURL(srv.URL),
+		Retryer:          aws.NopRetryer{},
+	})
+	return db
+}
+
+func TestStatusGuardStaleStatus(t *testing.T) {
+	group := func() *access.Group {
+		g := access.Group{ID: "grp_1", RequestID: "req_1", Status: ctypes.RequestAccessGroupStatusPENDINGAPPROVAL}
+		_ = g.TransitionStatus(ctypes.RequestAccessGroupStatusAPPROVED)
+		return &g
+	}
+	ctx := context.Background()
+
+	db := newConditionFailedDB(t)
+	guard := NewStatusGuard(db)
+	g := group()
+	err := guard.Put(ctx, g)
+	assert.Equal(t, access.ErrStaleStatus, err)
+	// the transition is still pending, as it wasn't written
+	_, pending := g.PendingTransition()
+	assert.True(t, pending)
+
+	err = guard.PutBatch(ctx, group(), &access.Request{ID: "req_1"})
+	assert.Equal(t, access.ErrStaleStatus, err)
+	assert.Equal(t, []string{"DynamoDB_20120810.PutItem", "DynamoDB_20120810.TransactWriteItems"}, db.targets)
+}
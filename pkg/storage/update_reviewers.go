@@ -0,0 +1,155 @@
+package storage
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/ddb"
+)
+
+// UpdateReviewers writes the reviewers of a request, its groups and its targets along with the items in tx.
+// Only the reviewer attributes are updated, with a condition that each item still has the request status
+// it was read with, so that a request which was reviewed or cancelled since it was read isn't overwritten.
+// access.ErrStaleStatus is returned if a status has changed, and none of the items are written.
+//
+// The items in tx are written in the same transaction as the last of the reviewer updates.
+func UpdateReviewers(ctx context.Context, db ddb.Storage, request access.RequestWithGroupsWithTargets, tx ...ddb.TransactWriteItem) error {
+	twis, err := buildReviewersTransactions(db.Table(), request, tx)
+	if err != nil {
+		return err
+	}
+	for _, twi := range twis {
+		_, err = db.Client().TransactWriteItems(ctx, twi)
+		if err != nil {
+			return statusWriteError(err)
+		}
+	}
+	return nil
+}
+
+// buildReviewersTransactions builds the updates of the reviewers of the request followed by the items in tx,
+// split into transactions of at most maxTransactionItems.
+func buildReviewersTransactions(table string, request access.RequestWithGroupsWithTargets, tx []ddb.TransactWriteItem) ([]*dynamodb.TransactWriteItemsInput, error) {
+	var items []types.TransactWriteItem
+	add := func(item ddb.Keyer, status string, reviewers map[string][]string) error {
+		update, err := buildReviewersUpdate(table, item, status, reviewers)
+		if err != nil {
+			return err
+		}
+		items = append(items, types.TransactWriteItem{Update: update})
+		return nil
+	}
+
+	r := request.Request
+	err := add(&r, string(r.RequestStatus), map[string][]string{"requestReviewers": r.RequestReviewers})
+	if err != nil {
+		return nil, err
+	}
+	for _, g := range request.Groups {
+		group := g.Group
+		err = add(&group, string(group.RequestStatus), map[string][]string{
+			"requestReviewers": group.RequestReviewers,
+			"groupReviewers":   group.GroupReviewers,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range g.Targets {
+			target := t
+			err = add(&target, string(target.RequestStatus), map[string][]string{"requestReviewers": target.RequestReviewers})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, entry := range tx {
+		if entry.Put == nil && entry.Delete == nil {
+			return nil, errors.New("no operation defined for transaction")
+		}
+		if entry.Put != nil && entry.Delete != nil {
+			return nil, errors.New("both Put and Delete operations were defined for a transaction")
+		}
+		if entry.Put != nil {
+			attrs, err := marshalItem(entry.Put)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, types.TransactWriteItem{Put: &types.Put{TableName: aws.String(table), Item: attrs}})
+			continue
+		}
+		key, err := itemKey(entry.Delete)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, types.TransactWriteItem{Delete: &types.Delete{TableName: aws.String(table), Key: key}})
+	}
+
+	var res []*dynamodb.TransactWriteItemsInput
+	for i := 0; i < len(items); i += maxTransactionItems {
+		end := len(items)
+		if i+maxTransactionItems < end {
+			end = i + maxTransactionItems
+		}
+		res = append(res, &dynamodb.TransactWriteItemsInput{TransactItems: items[i:end]})
+	}
+	return res, nil
+}
+
+// buildReviewersUpdate builds an update which sets the reviewer attributes of the item,
+// with a condition that its request status is still status.
+func buildReviewersUpdate(table string, item ddb.Keyer, status string, reviewers map[string][]string) (*types.Update, error) {
+	key, err := itemKey(item)
+	if err != nil {
+		return nil, err
+	}
+	update := types.Update{
+		TableName:                aws.String(table),
+		Key:                      key,
+		ConditionExpression:      aws.String("#status = :status"),
+		ExpressionAttributeNames: map[string]string{"#status": "requestStatus"},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":status": &types.AttributeValueMemberS{Value: status},
+		},
+	}
+	expr := ""
+	for _, attr := range []string{"requestReviewers", "groupReviewers"} {
+		ids, ok := reviewers[attr]
+		if !ok {
+			continue
+		}
+		value, err := attributevalue.Marshal(ids)
+		if err != nil {
+			return nil, err
+		}
+		if expr != "" {
+			expr += ", "
+		}
+		expr += "#" + attr + " = :" + attr
+		update.ExpressionAttributeNames["#"+attr] = attr
+		update.ExpressionAttributeValues[":"+attr] = value
+	}
+	update.UpdateExpression = aws.String("SET " + expr)
+	return &update, nil
+}
+
+// itemKey returns the primary key of the item.
+func itemKey(item ddb.Keyer) (map[string]types.AttributeValue, error) {
+	keys, err := item.DDBKeys()
+	if err != nil {
+		return nil, err
+	}
+	keyAttrs, err := attributevalue.MarshalMap(keys)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]types.AttributeValue{
+		"PK": keyAttrs["PK"],
+		"SK": keyAttrs["SK"],
+	}, nil
+}
@@ -0,0 +1,66 @@
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	ctypes "github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildReviewersTransactions(t *testing.T) {
+	request := access.RequestWithGroupsWithTargets{
+		Request: access.Request{ID: "req_1", RequestStatus: ctypes.PENDING, RequestReviewers: []string{"usr_2"}},
+		Groups: []access.GroupWithTargets{
+			{
+				Group:   access.Group{ID: "grp_1", RequestID: "req_1", RequestStatus: ctypes.PENDING, RequestReviewers: []string{"usr_2"}},
+				Targets: []access.GroupTarget{{ID: "gta_1", GroupID: "grp_1", RequestID: "req_1", RequestStatus: ctypes.PENDING}},
+			},
+		},
+	}
+	evt := access.NewRecordedEvent("req_1", nil, request.Request.CreatedAt, map[string]string{"action": "reviewer.removed"})
+
+	got, err := buildReviewersTransactions("table", request, []ddb.TransactWriteItem{
+		{Put: &evt},
+		{Delete: &access.Reviewer{ReviewerID: "usr_1", RequestID: "req_1"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !assert.Len(t, got, 1) || !assert.Len(t, got[0].TransactItems, 5) {
+		return
+	}
+	items := got[0].TransactItems
+
+	// only the reviewers are updated, on the condition that the request is still pending
+	pending := &types.AttributeValueMemberS{Value: "PENDING"}
+	request0 := items[0].Update
+	assert.Equal(t, "SET #requestReviewers = :requestReviewers", aws.ToString(request0.UpdateExpression))
+	assert.Equal(t, "#status = :status", aws.ToString(request0.ConditionExpression))
+	assert.Equal(t, pending, request0.ExpressionAttributeValues[":status"])
+	assert.Equal(t, &types.AttributeValueMemberL{Value: []types.AttributeValue{&types.AttributeValueMemberS{Value: "usr_2"}}}, request0.ExpressionAttributeValues[":requestReviewers"])
+
+	group := items[1].Update
+	assert.Equal(t, "SET #requestReviewers = :requestReviewers, #groupReviewers = :groupReviewers", aws.ToString(group.UpdateExpression))
+	assert.Equal(t, &types.AttributeValueMemberNULL{Value: true}, group.ExpressionAttributeValues[":groupReviewers"])
+
+	target := items[2].Update
+	assert.Equal(t, "SET #requestReviewers = :requestReviewers", aws.ToString(target.UpdateExpression))
+	assert.Equal(t, pending, target.ExpressionAttributeValues[":status"])
+
+	assert.Nil(t, items[3].Put.ConditionExpression)
+	assert.NotNil(t, items[4].Delete.Key["PK"])
+}
+
+func TestUpdateReviewersStaleStatus(t *testing.T) {
+	db := newConditionFailedDB(t)
+	request := access.RequestWithGroupsWithTargets{Request: access.Request{ID: "req_1", RequestStatus: ctypes.PENDING}}
+
+	err := UpdateReviewers(context.Background(), db, request)
+	assert.Equal(t, access.ErrStaleStatus, err)
+	assert.Equal(t, []string{"DynamoDB_20120810.TransactWriteItems"}, db.targets)
+}
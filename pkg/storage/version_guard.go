@@ -0,0 +1,141 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/ddb"
+)
+
+// ErrVersionConflict is returned by VersionGuard when an item has been written by someone else since it was read.
+var ErrVersionConflict = errors.New("item has been changed since it was read")
+
+// Versioned is implemented by items which are written with optimistic concurrency by VersionGuard.
+type Versioned interface {
+	ddb.Keyer
+	// GetVersion returns the version the item was read with. It is zero for new items, and for items
+	// which were written before they were versioned.
+	GetVersion() int
+	// SetVersion is called with the new version once the item has been written.
+	SetVersion(version int)
+}
+
+// VersionGuard wraps a ddb.Storage and writes Versioned items with a condition that their stored version
+// is still the version they were read with, otherwise ErrVersionConflict is returned. The version is
+// incremented each time an item is written.
+//
+// Other items are written as normal.
+type VersionGuard struct {
+	ddb.Storage
+}
+
+// NewVersionGuard wraps db so that versioned items are written with conditional writes.
+func NewVersionGuard(db ddb.Storage) *VersionGuard {
+	return &VersionGuard{Storage: db}
+}
+
+func (v *VersionGuard) Put(ctx context.Context, item ddb.Keyer) error {
+	versioned, ok := item.(Versioned)
+	if !ok {
+		return v.Storage.Put(ctx, item)
+	}
+	put, err := buildVersionedPut(v.Table(), versioned)
+	if err != nil {
+		return err
+	}
+	_, err = v.Client().PutItem(ctx, &dynamodb.PutItemInput{
+		TableName:                 put.TableName,
+		Item:                      put.Item,
+		ConditionExpression:       put.ConditionExpression,
+		ExpressionAttributeNames:  put.ExpressionAttributeNames,
+		ExpressionAttributeValues: put.ExpressionAttributeValues,
+	})
+	if err != nil {
+		return versionWriteError(err)
+	}
+	versioned.SetVersion(versioned.GetVersion() + 1)
+	return nil
+}
+
+// PutBatch writes the items in a transaction if any of them are versioned, so that none of the items
+// are written if one of them has changed.
+// Batches larger than the DynamoDB transaction limit are written in several transactions, and the
+// transactions before a conflict are still written.
+func (v *VersionGuard) PutBatch(ctx context.Context, items ...ddb.Keyer) error {
+	guarded := false
+	for _, item := range items {
+		if _, ok := item.(Versioned); ok {
+			guarded = true
+			break
+		}
+	}
+	if !guarded {
+		return v.Storage.PutBatch(ctx, items...)
+	}
+
+	for i := 0; i < len(items); i += maxTransactionItems {
+		end := len(items)
+		if i+maxTransactionItems < end {
+			end = i + maxTransactionItems
+		}
+		twi := dynamodb.TransactWriteItemsInput{}
+		for _, item := range items[i:end] {
+			put, err := buildVersionedPut(v.Table(), item)
+			if err != nil {
+				return err
+			}
+			twi.TransactItems = append(twi.TransactItems, types.TransactWriteItem{Put: put})
+		}
+		_, err := v.Client().TransactWriteItems(ctx, &twi)
+		if err != nil {
+			return versionWriteError(err)
+		}
+		for _, item := range items[i:end] {
+			if versioned, ok := item.(Versioned); ok {
+				versioned.SetVersion(versioned.GetVersion() + 1)
+			}
+		}
+	}
+	return nil
+}
+
+// buildVersionedPut builds a put for the item, with a condition on the item's version if it is versioned.
+func buildVersionedPut(table string, item ddb.Keyer) (*types.Put, error) {
+	attrs, err := marshalItem(item)
+	if err != nil {
+		return nil, err
+	}
+	put := types.Put{
+		TableName: aws.String(table),
+		Item:      attrs,
+	}
+	versioned, ok := item.(Versioned)
+	if !ok {
+		return &put, nil
+	}
+
+	read := versioned.GetVersion()
+	attrs["version"] = &types.AttributeValueMemberN{Value: strconv.Itoa(read + 1)}
+	put.ExpressionAttributeNames = map[string]string{"#version": "version"}
+	if read == 0 {
+		put.ConditionExpression = aws.String("attribute_not_exists(#version)")
+		return &put, nil
+	}
+	put.ConditionExpression = aws.String("#version = :version")
+	put.ExpressionAttributeValues = map[string]types.AttributeValue{
+		":version": &types.AttributeValueMemberN{Value: strconv.Itoa(read)},
+	}
+	return &put, nil
+}
+
+// versionWriteError converts a failed condition into ErrVersionConflict.
+func versionWriteError(err error) error {
+	if conditionFailed(err) {
+		return ErrVersionConflict
+	}
+	return err
+}
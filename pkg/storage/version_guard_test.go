@@ -0,0 +1,72 @@
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/ddb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildVersionedPut(t *testing.T) {
+	type testcase struct {
+		name        string
+		give        ddb.Keyer
+		wantCond    *string
+		wantValues  map[string]types.AttributeValue
+		wantVersion types.AttributeValue
+	}
+
+	testcases := []testcase{
+		{
+			name: "not versioned",
+			give: &access.Request{ID: "req_1"},
+		},
+		{
+			name:        "new user",
+			give:        &identity.User{ID: "usr_1"},
+			wantCond:    aws.String("attribute_not_exists(#version)"),
+			wantVersion: &types.AttributeValueMemberN{Value: "1"},
+		},
+		{
+			name:        "existing group",
+			give:        &identity.Group{ID: "grp_1", Version: 3},
+			wantCond:    aws.String("#version = :version"),
+			wantValues:  map[string]types.AttributeValue{":version": &types.AttributeValueMemberN{Value: "3"}},
+			wantVersion: &types.AttributeValueMemberN{Value: "4"},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := buildVersionedPut("table", tc.give)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tc.wantCond, got.ConditionExpression)
+			assert.Equal(t, tc.wantValues, got.ExpressionAttributeValues)
+			assert.Equal(t, tc.wantVersion, got.Item["version"])
+			assert.NotNil(t, got.Item["PK"])
+		})
+	}
+}
+
+func TestVersionGuardConflict(t *testing.T) {
+	ctx := context.Background()
+	db := newConditionFailedDB(t)
+	guard := NewVersionGuard(db)
+
+	u := &identity.User{ID: "usr_1", Version: 2}
+	err := guard.Put(ctx, u)
+	assert.Equal(t, ErrVersionConflict, err)
+	// the version is only incremented once the item has been written
+	assert.Equal(t, 2, u.Version)
+
+	err = guard.PutBatch(ctx, u, &identity.Group{ID: "grp_1"})
+	assert.Equal(t, ErrVersionConflict, err)
+	assert.Equal(t, []string{"DynamoDB_20120810.PutItem", "DynamoDB_20120810.TransactWriteItems"}, db.targets)
+}
@@ -0,0 +1,112 @@
+package target
+
+import (
+	"path"
+	"strings"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/cache"
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+)
+
+// Metadata is a set of admin curated tags, such as the owning team or environment, which are applied to cached targets.
+// Metadata is applied either to a single target by its ID, or to every target whose fields match all of the FieldPatterns.
+type Metadata struct {
+	ID string `json:"id" dynamodbav:"id"`
+	// TargetID is the deterministic ID of a cache.Target
+	TargetID string `json:"targetId,omitempty" dynamodbav:"targetId,omitempty"`
+	// Kind optionally restricts FieldPatterns to targets of a kind, in the format publisher/name/kind
+	Kind string `json:"kind,omitempty" dynamodbav:"kind,omitempty"`
+	// FieldPatterns is a map of field ID to a glob pattern, matched against the value or label of the field
+	FieldPatterns map[string]string `json:"fieldPatterns,omitempty" dynamodbav:"fieldPatterns,omitempty"`
+	Tags          map[string]string `json:"tags" dynamodbav:"tags"`
+	CreatedAt     time.Time         `json:"createdAt" dynamodbav:"createdAt"`
+	UpdatedAt     time.Time         `json:"updatedAt" dynamodbav:"updatedAt"`
+}
+
+// Matches returns true if the metadata should be applied to the target
+func (m *Metadata) Matches(t cache.Target) bool {
+	if m.TargetID != "" {
+		return m.TargetID == t.ID()
+	}
+	if len(m.FieldPatterns) == 0 {
+		return false
+	}
+	if m.Kind != "" && m.Kind != strings.Join([]string{t.Kind.Publisher, t.Kind.Name, t.Kind.Kind}, "/") {
+		return false
+	}
+	for fieldID, pattern := range m.FieldPatterns {
+		matched := false
+		for _, f := range t.Fields {
+			if f.ID != fieldID {
+				continue
+			}
+			// invalid patterns are rejected when the metadata is saved, so the error is ignored here
+			valueMatch, _ := path.Match(pattern, f.Value)
+			labelMatch, _ := path.Match(pattern, f.ValueLabel)
+			matched = valueMatch || labelMatch
+			break
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+// ApplyMetadata returns the tags which apply to the target.
+// Tags from field pattern metadata are applied first, so that tags set for a specific target ID take precedence.
+func ApplyMetadata(t cache.Target, metadata []Metadata) map[string]string {
+	var tags map[string]string
+	apply := func(m Metadata) {
+		if !m.Matches(t) {
+			return
+		}
+		if tags == nil {
+			tags = make(map[string]string)
+		}
+		for k, v := range m.Tags {
+			tags[k] = v
+		}
+	}
+	for _, m := range metadata {
+		if m.TargetID == "" {
+			apply(m)
+		}
+	}
+	for _, m := range metadata {
+		if m.TargetID != "" {
+			apply(m)
+		}
+	}
+	return tags
+}
+
+func (m *Metadata) ToAPI() types.TargetMetadata {
+	out := types.TargetMetadata{
+		Id:        m.ID,
+		Tags:      types.TargetTags{AdditionalProperties: m.Tags},
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+	if m.TargetID != "" {
+		out.TargetId = &m.TargetID
+	}
+	if m.Kind != "" {
+		out.Kind = &m.Kind
+	}
+	if len(m.FieldPatterns) > 0 {
+		out.FieldPatterns = &types.TargetFieldPatterns{AdditionalProperties: m.FieldPatterns}
+	}
+	return out
+}
+
+func (m *Metadata) DDBKeys() (ddb.Keys, error) {
+	keys := ddb.Keys{
+		PK: keys.TargetMetadata.PK1,
+		SK: keys.TargetMetadata.SK1(m.ID),
+	}
+	return keys, nil
+}
@@ -0,0 +1,34 @@
+package target
+
+import (
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+	"github.com/common-fate/ddb"
+)
+
+// SyncStaleNotificationRetention is how long a stale notification is recorded for.
+// If a target group is still stale once the record expires, another notification is sent as a reminder.
+const SyncStaleNotificationRetention = 7 * 24 * time.Hour
+
+// SyncStaleNotification records that a notification was sent for a target group being stale.
+// It is keyed by the last successful sync, so only one notification is sent until the target group syncs successfully again.
+type SyncStaleNotification struct {
+	TargetGroupID string     `json:"targetGroupId" dynamodbav:"targetGroupId"`
+	LastSuccessAt *time.Time `json:"lastSuccessAt,omitempty" dynamodbav:"lastSuccessAt,omitempty"`
+	NotifiedAt    time.Time  `json:"notifiedAt" dynamodbav:"notifiedAt"`
+	// ExpiresAt is a unix timestamp, which is used as the DynamoDB TTL for the notification.
+	ExpiresAt int64 `json:"ttl" dynamodbav:"ttl"`
+}
+
+func (n *SyncStaleNotification) DDBKeys() (ddb.Keys, error) {
+	lastSuccess := "NEVER"
+	if n.LastSuccessAt != nil {
+		lastSuccess = keys.SortableTime(*n.LastSuccessAt)
+	}
+	keys := ddb.Keys{
+		PK: keys.TargetGroupSyncStaleNotification.PK1,
+		SK: keys.TargetGroupSyncStaleNotification.SK1(n.TargetGroupID, lastSuccess),
+	}
+	return keys, nil
+}
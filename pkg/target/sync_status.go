@@ -0,0 +1,105 @@
+package target
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+	"github.com/common-fate/common-fate/pkg/types"
+	"github.com/common-fate/ddb"
+)
+
+// DefaultSyncStaleThreshold is used when a staleness threshold is not configured.
+// The cache sync runs every 5 minutes, so an hour without a successful sync indicates a persistent problem.
+const DefaultSyncStaleThreshold = time.Hour
+
+// SyncStatus records the outcome of syncing the cached resources for a target group.
+// It is stored separately to the target group so that a sync does not overwrite changes made to the group by an admin.
+type SyncStatus struct {
+	TargetGroupID string     `json:"targetGroupId" dynamodbav:"targetGroupId"`
+	LastAttemptAt *time.Time `json:"lastAttemptAt,omitempty" dynamodbav:"lastAttemptAt,omitempty"`
+	LastSuccessAt *time.Time `json:"lastSuccessAt,omitempty" dynamodbav:"lastSuccessAt,omitempty"`
+	// LastError is the error from the most recent attempt, it is cleared when a sync succeeds
+	LastError *string `json:"lastError,omitempty" dynamodbav:"lastError,omitempty"`
+	// ResourceCounts is the number of resources of each type as of the last successful sync
+	ResourceCounts map[string]int `json:"resourceCounts" dynamodbav:"resourceCounts"`
+	// StaleThreshold is the threshold which was configured when the status was last updated
+	StaleThreshold time.Duration `json:"staleThreshold" dynamodbav:"staleThreshold"`
+}
+
+// IsStale returns true if the resources have not been successfully synced within the threshold.
+// A target group which has never synced successfully is stale if the most recent attempt failed.
+func (s *SyncStatus) IsStale(now time.Time) bool {
+	threshold := s.StaleThreshold
+	if threshold <= 0 {
+		threshold = DefaultSyncStaleThreshold
+	}
+	if s.LastSuccessAt != nil {
+		return now.Sub(*s.LastSuccessAt) > threshold
+	}
+	return s.LastAttemptAt != nil && s.LastError != nil
+}
+
+// Diagnostics returns the diagnostics which should be shown to an admin for the sync status
+func (s *SyncStatus) Diagnostics(now time.Time) []Diagnostic {
+	diagnostics := []Diagnostic{}
+	if s.LastError != nil {
+		diagnostics = append(diagnostics, Diagnostic{
+			Level:   types.ERROR,
+			Code:    "SYNC_FAILED",
+			Message: fmt.Sprintf("the most recent resource sync failed: %s", *s.LastError),
+		})
+	}
+	if s.IsStale(now) {
+		msg := "resources for this target group have never been synced successfully"
+		if s.LastSuccessAt != nil {
+			msg = fmt.Sprintf("resources for this target group were last synced successfully %s ago", now.Sub(*s.LastSuccessAt).Round(time.Minute))
+		}
+		diagnostics = append(diagnostics, Diagnostic{
+			Level:   types.WARNING,
+			Code:    "RESOURCES_STALE",
+			Message: msg,
+		})
+	}
+	return diagnostics
+}
+
+// TotalResources returns the sum of the resource counts
+func (s *SyncStatus) TotalResources() int {
+	total := 0
+	for _, c := range s.ResourceCounts {
+		total += c
+	}
+	return total
+}
+
+func (s *SyncStatus) ToAPI(now time.Time) types.TargetGroupSyncStatus {
+	out := types.TargetGroupSyncStatus{
+		LastAttemptAt:  s.LastAttemptAt,
+		LastSuccessAt:  s.LastSuccessAt,
+		LastError:      s.LastError,
+		ResourceCounts: types.TargetGroupSyncStatus_ResourceCounts{AdditionalProperties: map[string]int{}},
+		TotalResources: s.TotalResources(),
+		Stale:          s.IsStale(now),
+		Diagnostics:    []types.Diagnostic{},
+	}
+	for k, v := range s.ResourceCounts {
+		out.ResourceCounts.AdditionalProperties[k] = v
+	}
+	for _, d := range s.Diagnostics(now) {
+		out.Diagnostics = append(out.Diagnostics, types.Diagnostic{
+			Code:    d.Code,
+			Level:   d.Level,
+			Message: d.Message,
+		})
+	}
+	return out
+}
+
+func (s *SyncStatus) DDBKeys() (ddb.Keys, error) {
+	keys := ddb.Keys{
+		PK: keys.TargetGroupSyncStatus.PK1,
+		SK: keys.TargetGroupSyncStatus.SK1(s.TargetGroupID),
+	}
+	return keys, nil
+}
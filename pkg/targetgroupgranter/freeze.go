@@ -0,0 +1,58 @@
+package targetgroupgranter
+
+import (
+	"context"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/storage"
+)
+
+// FreezeRecheckInterval is how long an activation which is blocked by a freeze without an end time waits
+// before the freezes are checked again.
+const FreezeRecheckInterval = 5 * time.Minute
+
+// FreezeService returns the freezes which block access.
+type FreezeService interface {
+	Blocking(ctx context.Context, groups []string, accessRuleIDs []string, targetGroupIDs []string) ([]access.Freeze, error)
+}
+
+// heldUntil returns when a grant which is blocked by a freeze should be activated instead, or nil if it isn't blocked.
+// Grants are held until the blocking freezes end. Freezes without an end time are checked again after FreezeRecheckInterval.
+//
+// Freezes are checked when the grant is approved, but grants which were approved before a freeze and start during it
+// are only held here.
+func (g *Granter) heldUntil(ctx context.Context, target access.GroupTarget, now time.Time) (*time.Time, error) {
+	if g.Freezes == nil {
+		return nil, nil
+	}
+	requester := storage.GetUser{ID: target.RequestedBy.ID}
+	_, err := g.DB.Query(ctx, &requester)
+	if err != nil {
+		return nil, err
+	}
+	group := storage.GetRequestGroupWithTargets{RequestID: target.RequestID, GroupID: target.GroupID}
+	_, err = g.DB.Query(ctx, &group)
+	if err != nil {
+		return nil, err
+	}
+	accessRuleIDs := []string{group.Result.Group.AccessRuleSnapshot.ID}
+	freezes, err := g.Freezes.Blocking(ctx, requester.Result.Groups, accessRuleIDs, []string{target.TargetGroupID})
+	if err != nil {
+		return nil, err
+	}
+	if len(freezes) == 0 {
+		return nil, nil
+	}
+	var until time.Time
+	for _, f := range freezes {
+		end := now.Add(FreezeRecheckInterval)
+		if f.EndsAt != nil {
+			end = *f.EndsAt
+		}
+		if end.After(until) {
+			until = end
+		}
+	}
+	return &until, nil
+}
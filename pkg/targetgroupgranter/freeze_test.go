@@ -0,0 +1,86 @@
+package targetgroupgranter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/identity"
+	"github.com/common-fate/common-fate/pkg/rule"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/common-fate/iso8601"
+	"github.com/stretchr/testify/assert"
+)
+
+// testFreezes returns the freezes which block the access rule
+type testFreezes struct {
+	freezes      []access.Freeze
+	gotRuleIDs   []string
+	gotTargetIDs []string
+}
+
+func (f *testFreezes) Blocking(ctx context.Context, groups []string, accessRuleIDs []string, targetGroupIDs []string) ([]access.Freeze, error) {
+	f.gotRuleIDs, f.gotTargetIDs = accessRuleIDs, targetGroupIDs
+	return f.freezes, nil
+}
+
+func TestHandleRequestHeldByFreeze(t *testing.T) {
+	now := time.Now()
+	freezeEnd := now.Add(2 * time.Hour)
+	// the grant was approved before the freeze was created, and starts during it
+	target := access.GroupTarget{
+		ID:            "gta_1",
+		GroupID:       "grp_1",
+		RequestID:     "req_1",
+		TargetGroupID: "tg_1",
+		RequestedBy:   access.RequestedBy{ID: "usr_1"},
+		Grant: &access.Grant{
+			Start: iso8601.New(now),
+			End:   iso8601.New(now.Add(4 * time.Hour)),
+		},
+	}
+
+	type testcase struct {
+		name    string
+		freezes []access.Freeze
+		// wantHeldUntil is compared to the time the grant is held until, a zero duration means it isn't held
+		wantHeldUntil func(got time.Time) bool
+	}
+	testcases := []testcase{
+		{
+			name:          "held until the freeze ends",
+			freezes:       []access.Freeze{{ID: "frz_1", EndsAt: &freezeEnd}},
+			wantHeldUntil: func(got time.Time) bool { return got.Equal(freezeEnd) },
+		},
+		{
+			name:    "freeze without an end is checked again",
+			freezes: []access.Freeze{{ID: "frz_1"}},
+			wantHeldUntil: func(got time.Time) bool {
+				return !got.Before(now.Add(FreezeRecheckInterval)) && got.Before(now.Add(FreezeRecheckInterval+time.Minute))
+			},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.GetUser{Result: &identity.User{ID: "usr_1", Groups: []string{"everyone"}}})
+			db.MockQuery(&storage.GetRequestGroupWithTargets{Result: &access.GroupWithTargets{
+				Group: access.Group{ID: "grp_1", RequestID: "req_1", AccessRuleSnapshot: rule.AccessRule{ID: "rul_1"}},
+			}})
+			freezes := &testFreezes{freezes: tc.freezes}
+			// the provider isn't invoked, so the granter doesn't need a runtime
+			g := Granter{DB: db, Freezes: freezes}
+
+			got, err := g.HandleRequest(context.Background(), InputEvent{Action: ACTIVATE, RequestAccessGroupTarget: target})
+			assert.NoError(t, err)
+			if assert.NotNil(t, got.HeldUntil) {
+				assert.True(t, tc.wantHeldUntil(*got.HeldUntil), "held until %s", got.HeldUntil)
+			}
+			assert.Equal(t, target, got.RequestAccessGroupTarget)
+			assert.Equal(t, []string{"rul_1"}, freezes.gotRuleIDs)
+			assert.Equal(t, []string{"tg_1"}, freezes.gotTargetIDs)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"runtime"
+	"time"
 
 	"github.com/common-fate/apikit/logger"
 	"github.com/common-fate/ddb"
@@ -31,9 +32,15 @@ type Granter struct {
 	RequestRouter *requestroutersvc.Service
 	EventPutter   EventPutter
 	RuntimeGetter RuntimeGetter
+	// RetryPolicy is optional, DefaultRetryPolicy is used if it is nil
+	RetryPolicy *RetryPolicy
+	// Freezes is optional, if it is set activations are held while access is frozen
+	Freezes FreezeService
 }
 type WorkflowInput struct {
 	RequestAccessGroupTarget access.GroupTarget `json:"requestAccessGroupTarget"`
+	// Attempt is passed to the first activation attempt, it is always 1
+	Attempt int `json:"attempt"`
 }
 type EventType string
 
@@ -45,12 +52,26 @@ const (
 type GrantState struct {
 	RequestAccessGroupTarget access.GroupTarget `json:"requestAccessGroupTarget"`
 	State                    map[string]any     `json:"state"`
+	// HeldUntil is set when an activation is held by a freeze. The grant wasn't activated, and the runtime
+	// activates it again at this time.
+	HeldUntil *time.Time `json:"heldUntil,omitempty"`
+	// RetryAt is set when an attempt failed with an error which should be retried. The runtime waits until this time
+	// and then calls the granter again with the same action and Attempt, rather than the granter waiting between attempts.
+	RetryAt *time.Time `json:"retryAt,omitempty"`
+	// Attempt is the attempt number to use when the granter is next called.
+	// After a successful activation it is reset to 1 for the deactivation.
+	Attempt int `json:"attempt"`
 }
 type InputEvent struct {
 	Action                   EventType          `json:"action"`
 	RequestAccessGroupTarget access.GroupTarget `json:"requestAccessGroupTarget"`
 	// Will be available for revoke events
 	State map[string]any `json:"state,omitempty"`
+	// Attempt is the number of the attempt, starting at 1. A zero value is treated as the first attempt.
+	Attempt int `json:"attempt,omitempty"`
+	// Synchronous is set by callers which wait for the result and can't schedule a retry, such as revoking a grant from the API.
+	// Failed attempts are not retried.
+	Synchronous bool `json:"synchronous,omitempty"`
 }
 
 func errWithFileMeta(err error) error {
@@ -65,6 +86,17 @@ func (g *Granter) HandleRequest(ctx context.Context, in InputEvent) (GrantState,
 	log := logger.Get(ctx) //.With("grant.id", grant.ID)
 	log.Infow("Handling event", "event", in)
 
+	if in.Action == ACTIVATE {
+		until, err := g.heldUntil(ctx, requestAccessGroupTarget, time.Now())
+		if err != nil {
+			return GrantState{}, errWithFileMeta(err)
+		}
+		if until != nil {
+			log.Infow("activation held by freeze", "heldUntil", until)
+			return GrantState{RequestAccessGroupTarget: requestAccessGroupTarget, HeldUntil: until, Attempt: 1}, nil
+		}
+	}
+
 	tgq := storage.GetTargetGroup{
 		ID: in.RequestAccessGroupTarget.TargetGroupID,
 	}
@@ -81,16 +113,132 @@ func (g *Granter) HandleRequest(ctx context.Context, in InputEvent) (GrantState,
 	if err != nil {
 		return GrantState{}, errWithFileMeta(err)
 	}
-	items := []ddb.Keyer{}
-	var grantResponse *msg.GrantResponse
+	policy := g.retryPolicy()
+	attempt := in.Attempt
+	if attempt < 1 {
+		attempt = 1
+	}
+	grantResponse, err := g.invoke(ctx, runtime, routeResult, in)
+	if err != nil && !errors.Is(err, errUnsupportedAction) {
+		class := ClassifyError(err)
+		willRetry := !in.Synchronous && attempt < policy.MaxAttempts && policy.Retryable(class)
+		log.Warnw("grant attempt failed", "action", in.Action, "attempt", attempt, "error", err, "error.class", class, "willRetry", willRetry)
+		g.recordAttempt(ctx, requestAccessGroupTarget, access.GrantAttempt{
+			Action:     types.GrantAction(in.Action),
+			Attempt:    attempt,
+			Error:      err.Error(),
+			ErrorClass: class,
+			WillRetry:  willRetry,
+		})
+		if willRetry {
+			// the runtime waits before the next attempt, so that the wait isn't billed as Lambda execution time
+			retryAt := time.Now().Add(policy.Backoff(attempt))
+			return GrantState{
+				RequestAccessGroupTarget: requestAccessGroupTarget,
+				State:                    in.State,
+				RetryAt:                  &retryAt,
+				Attempt:                  attempt + 1,
+			}, nil
+		}
+	}
+
+	// emit an event and return early if we failed (de)provisioning the grant
+	if err != nil {
+		log.Errorf("error while handling granter event", "error", err.Error(), "event", in)
+		requestAccessGroupTarget.Grant.Status = types.RequestAccessGroupTargetStatusERROR
+
+		if !errors.Is(err, errUnsupportedAction) {
+			// keep the grant so that an admin can retry or force-complete it
+			failed := access.FailedGrant{
+				GrantID:   requestAccessGroupTarget.ID,
+				RequestID: requestAccessGroupTarget.RequestID,
+				GroupID:   requestAccessGroupTarget.GroupID,
+				Action:    types.GrantAction(in.Action),
+				Priority:  types.NORMAL,
+				Reason:    err.Error(),
+				Attempts:  attempt,
+				Grant:     requestAccessGroupTarget,
+				State:     in.State,
+				CreatedAt: time.Now(),
+			}
+			if in.Action == DEACTIVATE {
+				failed.Priority = types.HIGH
+			}
+			putErr := g.DB.Put(ctx, &failed)
+			if putErr != nil {
+				log.Errorw("failed to save failed grant", "error", putErr)
+			}
+		}
+
+		eventErr := g.EventPutter.Put(ctx, gevent.GrantFailed{Grant: requestAccessGroupTarget, Reason: err.Error()})
+		if eventErr != nil {
+			return GrantState{}, errWithFileMeta(errors.Wrapf(err, "failed to emit event, emit error: %s", eventErr.Error()))
+		}
+		return GrantState{}, errWithFileMeta(err)
+	}
+
+	// Emit an event based on whether we activated or deactivated the grant.
+	var evt gevent.EventTyper
+	switch in.Action {
+	case ACTIVATE:
+		// grant.Grant.Status = types.RequestAccessGroupTargetStatusACTIVE
+		evt = &gevent.GrantActivated{Grant: requestAccessGroupTarget}
+	case DEACTIVATE:
+		// grant.Grant.Status = types.RequestAccessGroupTargetStatusEXPIRED
+		evt = &gevent.GrantExpired{Grant: requestAccessGroupTarget}
+
+	}
+
+	log.Infow("emitting event", "event", evt, "action", in.Action)
+	err = g.EventPutter.Put(ctx, evt)
+	if err != nil {
+		return GrantState{}, errWithFileMeta(err)
+	}
+	out := GrantState{
+		RequestAccessGroupTarget: requestAccessGroupTarget,
+		Attempt:                  1,
+	}
+
+	if grantResponse != nil {
+		out.State = grantResponse.State
+		instructions := access.Instructions{
+			Instructions:  grantResponse.AccessInstructions,
+			GroupTargetID: requestAccessGroupTarget.ID,
+			RequestedBy:   requestAccessGroupTarget.RequestedBy.ID,
+		}
+		// the grant status is saved by the event handler when it handles the event emitted above,
+		// so only the instructions are written here
+		err = g.DB.Put(ctx, &instructions)
+		// If there is an error writing instructions, don't return the error.
+		// instead just continue so that the grant can be revoked
+		if err != nil {
+			log.Errorw("failed to write access instructions to DynamoDB", "error", err)
+		}
+	}
+	return out, nil
+}
+
+var errUnsupportedAction = errors.New("invocation type not supported, type must be one of [ACTIVATE, DEACTIVATE]")
+
+func (g *Granter) retryPolicy() RetryPolicy {
+	if g.RetryPolicy != nil {
+		return *g.RetryPolicy
+	}
+	return DefaultRetryPolicy
+}
+
+// invoke calls the provider once to activate or deactivate the grant.
+func (g *Granter) invoke(ctx context.Context, runtime *handlerclient.Client, routeResult *requestroutersvc.RouteResult, in InputEvent) (*msg.GrantResponse, error) {
+	log := logger.Get(ctx)
+	requestAccessGroupTarget := in.RequestAccessGroupTarget
 	switch in.Action {
 	case ACTIVATE:
 		log.Infow("activating grant")
-		grantResponse, err = func() (out *msg.GrantResponse, err error) {
+		return func() (out *msg.GrantResponse, err error) {
 			defer func() {
 				if r := recover(); r != nil {
 					log.Errorw("recovered panic while granting access", "error", r, "target group", requestAccessGroupTarget.TargetKind)
-					err = fmt.Errorf("internal server error invoking targetgroup:handler:kind %s:%s:%s", requestAccessGroupTarget.TargetKind, routeResult.Handler.ID, routeResult.Route.Kind)
+					err = &ProviderPanicError{TargetKind: requestAccessGroupTarget.TargetKind, HandlerID: routeResult.Handler.ID, Kind: routeResult.Route.Kind}
 				}
 			}()
 			req := msg.Grant{
@@ -108,11 +256,11 @@ func (g *Granter) HandleRequest(ctx context.Context, in InputEvent) (GrantState,
 		}()
 	case DEACTIVATE:
 		log.Infow("deactivating grant")
-		err = func() (err error) {
+		return nil, func() (err error) {
 			defer func() {
 				if r := recover(); r != nil {
 					log.Errorw("recovered panic while deactivating access", "error", r, "target group", requestAccessGroupTarget.TargetKind)
-					err = fmt.Errorf("internal server error invoking targetgroup:handler:kind %s:%s:%s", requestAccessGroupTarget.TargetKind, routeResult.Handler.ID, routeResult.Route.Kind)
+					err = &ProviderPanicError{TargetKind: requestAccessGroupTarget.TargetKind, HandlerID: routeResult.Handler.ID, Kind: routeResult.Route.Kind}
 				}
 			}()
 
@@ -130,62 +278,16 @@ func (g *Granter) HandleRequest(ctx context.Context, in InputEvent) (GrantState,
 
 			return runtime.Revoke(ctx, req)
 		}()
-	default:
-		err = fmt.Errorf("invocation type: %s not supported, type must be one of [ACTIVATE, DEACTIVATE]", in.Action)
 	}
+	return nil, errors.Wrapf(errUnsupportedAction, "invocation type: %s", in.Action)
+}
 
-	// emit an event and return early if we failed (de)provisioning the grant
-	if err != nil {
-		log.Errorf("error while handling granter event", "error", err.Error(), "event", in)
-		requestAccessGroupTarget.Grant.Status = types.RequestAccessGroupTargetStatusERROR
-
-		eventErr := g.EventPutter.Put(ctx, gevent.GrantFailed{Grant: requestAccessGroupTarget, Reason: err.Error()})
-		if eventErr != nil {
-			return GrantState{}, errWithFileMeta(errors.Wrapf(err, "failed to emit event, emit error: %s", eventErr.Error()))
-		}
-		return GrantState{}, errWithFileMeta(err)
-	}
-
-	// Emit an event based on whether we activated or deactivated the grant.
-	var evt gevent.EventTyper
-	switch in.Action {
-	case ACTIVATE:
-		// grant.Grant.Status = types.RequestAccessGroupTargetStatusACTIVE
-		evt = &gevent.GrantActivated{Grant: requestAccessGroupTarget}
-	case DEACTIVATE:
-		// grant.Grant.Status = types.RequestAccessGroupTargetStatusEXPIRED
-		evt = &gevent.GrantExpired{Grant: requestAccessGroupTarget}
-
-	}
-
-	log.Infow("emitting event", "event", evt, "action", in.Action)
-	err = g.EventPutter.Put(ctx, evt)
-	if err != nil {
-		return GrantState{}, errWithFileMeta(err)
-	}
-	out := GrantState{
-		RequestAccessGroupTarget: requestAccessGroupTarget,
-	}
-
-	if grantResponse != nil {
-		out.State = grantResponse.State
-		instructions := access.Instructions{
-			Instructions:  grantResponse.AccessInstructions,
-			GroupTargetID: requestAccessGroupTarget.ID,
-			RequestedBy:   requestAccessGroupTarget.RequestedBy.ID,
-		}
-		items = append(items, &instructions)
-		//Save the new grant status and the instructions
-
-	}
-	// Should be fine, it there is potential that
-	groupTarget := requestAccessGroupTarget
-	items = append(items, &groupTarget)
-	err = g.DB.PutBatch(ctx, items...)
-	// If there is an error writing instructions, don't return the error.
-	// instead just continue so that the grant can be revoked
+// recordAttempt adds a failed attempt to the request history.
+// Errors are logged rather than returned so that they don't interrupt the retries.
+func (g *Granter) recordAttempt(ctx context.Context, grant access.GroupTarget, attempt access.GrantAttempt) {
+	evt := access.NewGrantAttemptEvent(grant.RequestID, time.Now(), attempt)
+	err := g.DB.Put(ctx, &evt)
 	if err != nil {
-		log.Errorw("failed to write access instructions to DynamoDB", "error", err)
+		logger.Get(ctx).Errorw("failed to record grant attempt", "error", err)
 	}
-	return out, nil
 }
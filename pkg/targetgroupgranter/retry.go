@@ -0,0 +1,180 @@
+package targetgroupgranter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aws/smithy-go"
+	"github.com/common-fate/common-fate/pkg/cache"
+	"github.com/common-fate/common-fate/pkg/config"
+)
+
+// Error classes are used to decide whether a failed activation or deactivation
+// should be retried.
+const (
+	ErrorClassThrottling  = "throttling"
+	ErrorClassTimeout     = "timeout"
+	ErrorClassNetwork     = "network"
+	ErrorClassUnavailable = "unavailable"
+	// ErrorClassUnknown is used for any error which doesn't match another class.
+	// Include it in RetryableErrorClasses to retry all errors.
+	ErrorClassUnknown = "unknown"
+	// ErrorClassPanic is used when a provider panics. Panics are deterministic, so they are never retried.
+	ErrorClassPanic = "panic"
+)
+
+// ProviderPanicError is returned when a provider panics while granting or revoking access.
+type ProviderPanicError struct {
+	TargetKind cache.Kind
+	HandlerID  string
+	Kind       string
+}
+
+func (e *ProviderPanicError) Error() string {
+	return fmt.Sprintf("internal server error invoking targetgroup:handler:kind %s:%s:%s", e.TargetKind, e.HandlerID, e.Kind)
+}
+
+// RetryPolicy controls how many times the granter calls a provider before
+// giving up on a grant.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Multiplier     float64
+	// RetryableErrorClasses lists the error classes which are retried.
+	RetryableErrorClasses []string
+}
+
+// DefaultRetryPolicy retries transient provider errors twice.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:           3,
+	InitialBackoff:        2 * time.Second,
+	MaxBackoff:            30 * time.Second,
+	Multiplier:            2,
+	RetryableErrorClasses: []string{ErrorClassThrottling, ErrorClassTimeout, ErrorClassNetwork, ErrorClassUnavailable},
+}
+
+// errorClassPatterns are matched against the error message, as errors returned by
+// provider runtimes are usually flattened into strings.
+// Internal server errors aren't matched, as they are usually caused by a bug in the provider and won't succeed on a retry.
+var errorClassPatterns = []struct {
+	class    string
+	patterns []string
+}{
+	{ErrorClassThrottling, []string{"throttl", "rate exceeded", "too many requests", "rate limit"}},
+	{ErrorClassTimeout, []string{"timeout", "timed out", "deadline exceeded"}},
+	{ErrorClassNetwork, []string{"connection reset", "connection refused", "broken pipe", "no such host", "unexpected eof"}},
+	{ErrorClassUnavailable, []string{"service unavailable", "bad gateway", "temporarily unavailable"}},
+}
+
+// throttlingErrorCodes are the AWS API error codes which are returned when a request is throttled.
+var throttlingErrorCodes = map[string]bool{
+	"ThrottlingException":      true,
+	"TooManyRequestsException": true,
+	"RequestLimitExceeded":     true,
+}
+
+// ClassifyError returns the error class for an error returned by a provider.
+// Typed errors and HTTP status codes are checked before falling back to matching the error message.
+func ClassifyError(err error) string {
+	if err == nil {
+		return ""
+	}
+	var panicErr *ProviderPanicError
+	if errors.As(err, &panicErr) {
+		return ErrorClassPanic
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return ErrorClassTimeout
+	}
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return ErrorClassNetwork
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		if netErr.Timeout() {
+			return ErrorClassTimeout
+		}
+		return ErrorClassNetwork
+	}
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) && throttlingErrorCodes[apiErr.ErrorCode()] {
+		return ErrorClassThrottling
+	}
+	var statusErr interface{ HTTPStatusCode() int }
+	if errors.As(err, &statusErr) {
+		switch statusErr.HTTPStatusCode() {
+		case http.StatusTooManyRequests:
+			return ErrorClassThrottling
+		case http.StatusBadGateway, http.StatusServiceUnavailable:
+			return ErrorClassUnavailable
+		case http.StatusGatewayTimeout:
+			return ErrorClassTimeout
+		case http.StatusInternalServerError:
+			return ErrorClassUnknown
+		}
+	}
+	msg := strings.ToLower(err.Error())
+	for _, c := range errorClassPatterns {
+		for _, p := range c.patterns {
+			if strings.Contains(msg, p) {
+				return c.class
+			}
+		}
+	}
+	return ErrorClassUnknown
+}
+
+// Retryable returns true if errors of the class should be retried.
+// Panics are never retried.
+func (p RetryPolicy) Retryable(class string) bool {
+	if class == ErrorClassPanic {
+		return false
+	}
+	for _, c := range p.RetryableErrorClasses {
+		if c == class {
+			return true
+		}
+	}
+	return false
+}
+
+// Backoff returns how long to wait after the given attempt fails. Attempts start at 1.
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	d := p.InitialBackoff
+	for i := 1; i < attempt; i++ {
+		d = time.Duration(float64(d) * p.Multiplier)
+		if p.MaxBackoff > 0 && d >= p.MaxBackoff {
+			return p.MaxBackoff
+		}
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		return p.MaxBackoff
+	}
+	return d
+}
+
+// RetryPolicyFromConfig builds a retry policy from environment config.
+func RetryPolicyFromConfig(cfg config.GrantRetryConfig) RetryPolicy {
+	p := DefaultRetryPolicy
+	if cfg.MaxAttempts > 0 {
+		p.MaxAttempts = cfg.MaxAttempts
+	}
+	if cfg.InitialBackoff > 0 {
+		p.InitialBackoff = cfg.InitialBackoff
+	}
+	if cfg.MaxBackoff > 0 {
+		p.MaxBackoff = cfg.MaxBackoff
+	}
+	if len(cfg.ErrorClasses) > 0 {
+		p.RetryableErrorClasses = cfg.ErrorClasses
+	}
+	return p
+}
@@ -0,0 +1,157 @@
+package targetgroupgranter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/smithy-go"
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/gevent"
+	"github.com/common-fate/common-fate/pkg/handler"
+	"github.com/common-fate/common-fate/pkg/service/requestroutersvc"
+	"github.com/common-fate/common-fate/pkg/storage"
+	"github.com/common-fate/common-fate/pkg/target"
+	"github.com/common-fate/common-fate/pkg/targetgroupgranter/mocks"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/common-fate/provider-registry-sdk-go/pkg/handlerclient"
+	"github.com/common-fate/provider-registry-sdk-go/pkg/msg"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		name string
+		give error
+		want string
+	}{
+		{name: "deadline", give: fmt.Errorf("calling provider: %w", context.DeadlineExceeded), want: ErrorClassTimeout},
+		{name: "throttling", give: errors.New("ThrottlingException: Rate exceeded"), want: ErrorClassThrottling},
+		{name: "too many requests", give: errors.New("status 429: Too Many Requests"), want: ErrorClassThrottling},
+		{name: "network", give: errors.New("read tcp: connection reset by peer"), want: ErrorClassNetwork},
+		{name: "unavailable", give: errors.New("503 Service Unavailable"), want: ErrorClassUnavailable},
+		{name: "unknown", give: errors.New("user does not exist"), want: ErrorClassUnknown},
+		{name: "panic", give: &ProviderPanicError{HandlerID: "aws", Kind: "Account"}, want: ErrorClassPanic},
+		{name: "wrapped panic", give: fmt.Errorf("granting: %w", &ProviderPanicError{}), want: ErrorClassPanic},
+		{name: "internal server error", give: errors.New("500 Internal Server Error"), want: ErrorClassUnknown},
+		{name: "io eof", give: fmt.Errorf("reading response: %w", io.ErrUnexpectedEOF), want: ErrorClassNetwork},
+		{name: "unexpected eof message", give: errors.New("read: unexpected EOF"), want: ErrorClassNetwork},
+		{name: "eof in another word", give: errors.New("field geofence is invalid"), want: ErrorClassUnknown},
+		{name: "aws throttling code", give: &smithy.GenericAPIError{Code: "TooManyRequestsException"}, want: ErrorClassThrottling},
+		{name: "status 503", give: statusError(http.StatusServiceUnavailable), want: ErrorClassUnavailable},
+		{name: "status 500", give: statusError(http.StatusInternalServerError), want: ErrorClassUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ClassifyError(tt.give))
+		})
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := RetryPolicy{InitialBackoff: time.Second, MaxBackoff: 5 * time.Second, Multiplier: 2}
+	got := []time.Duration{p.Backoff(1), p.Backoff(2), p.Backoff(3), p.Backoff(4)}
+	assert.Equal(t, []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second}, got)
+}
+
+// statusError is an error with an HTTP status code, like the errors returned by the AWS SDK.
+type statusError int
+
+func (e statusError) Error() string       { return http.StatusText(int(e)) }
+func (e statusError) HTTPStatusCode() int { return int(e) }
+
+func TestRetryPolicyRetryable(t *testing.T) {
+	assert.True(t, DefaultRetryPolicy.Retryable(ErrorClassThrottling))
+	assert.False(t, DefaultRetryPolicy.Retryable(ErrorClassUnknown))
+
+	retryAll := RetryPolicy{RetryableErrorClasses: []string{ErrorClassUnknown, ErrorClassPanic}}
+	assert.False(t, retryAll.Retryable(ErrorClassPanic))
+}
+
+// unavailableRuntime returns a handler runtime which fails every call with a retryable error
+type unavailableRuntime struct{}
+
+func (unavailableRuntime) GetRuntime(ctx context.Context, h handler.Handler) (*handlerclient.Client, error) {
+	return &handlerclient.Client{Executor: unavailableRuntime{}}, nil
+}
+
+func (unavailableRuntime) Execute(ctx context.Context, request msg.Request) (*msg.Result, error) {
+	return nil, errors.New("503 Service Unavailable")
+}
+
+func TestHandleRequestRetry(t *testing.T) {
+	grant := access.GroupTarget{
+		ID:            "gta_1",
+		RequestID:     "req_1",
+		TargetGroupID: "tg_1",
+		Grant:         &access.Grant{},
+	}
+
+	type testcase struct {
+		name        string
+		give        InputEvent
+		wantRetry   bool
+		wantAttempt int
+	}
+	testcases := []testcase{
+		{
+			name:        "first attempt is retried",
+			give:        InputEvent{Action: ACTIVATE, RequestAccessGroupTarget: grant},
+			wantRetry:   true,
+			wantAttempt: 2,
+		},
+		{
+			name:        "deactivation keeps its state",
+			give:        InputEvent{Action: DEACTIVATE, RequestAccessGroupTarget: grant, State: map[string]any{"id": "123"}, Attempt: 2},
+			wantRetry:   true,
+			wantAttempt: 3,
+		},
+		{
+			name: "last attempt fails the grant",
+			give: InputEvent{Action: DEACTIVATE, RequestAccessGroupTarget: grant, Attempt: 3},
+		},
+		{
+			name: "synchronous calls are not retried",
+			give: InputEvent{Action: DEACTIVATE, RequestAccessGroupTarget: grant, Synchronous: true},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.GetTargetGroup{Result: &target.Group{ID: "tg_1"}})
+			db.MockQuery(&storage.ListTargetRoutesForGroup{Result: []target.Route{{Group: "tg_1", Handler: "aws", Valid: true}}})
+			db.MockQuery(&storage.ListValidTargetRoutesForGroupByPriority{Result: []target.Route{{Group: "tg_1", Handler: "aws", Valid: true}}})
+			db.MockQuery(&storage.GetHandler{Result: &handler.Handler{ID: "aws"}})
+			ctrl := gomock.NewController(t)
+			eb := mocks.NewMockEventPutter(ctrl)
+			if !tc.wantRetry {
+				eb.EXPECT().Put(gomock.Any(), gomock.AssignableToTypeOf(gevent.GrantFailed{})).Return(nil)
+			}
+			g := Granter{
+				DB:            db,
+				RequestRouter: &requestroutersvc.Service{DB: db},
+				EventPutter:   eb,
+				RuntimeGetter: unavailableRuntime{},
+			}
+
+			before := time.Now()
+			got, err := g.HandleRequest(context.Background(), tc.give)
+			if !tc.wantRetry {
+				assert.Error(t, err)
+				return
+			}
+			// the granter returns straight away, and the runtime waits before the next attempt
+			assert.NoError(t, err)
+			if assert.NotNil(t, got.RetryAt) {
+				assert.True(t, got.RetryAt.After(before))
+			}
+			assert.Equal(t, tc.wantAttempt, got.Attempt)
+			assert.Equal(t, tc.give.State, got.State)
+		})
+	}
+}
@@ -24,6 +24,68 @@ import (
 	"github.com/go-chi/chi/v5"
 )
 
+// Defines values for BulkRevokeJobStatus.
+const (
+	FINISHED BulkRevokeJobStatus = "FINISHED"
+	QUEUED   BulkRevokeJobStatus = "QUEUED"
+	RUNNING  BulkRevokeJobStatus = "RUNNING"
+)
+
+// Defines values for BulkRevokeJobItemAction.
+const (
+	CANCELREQUEST BulkRevokeJobItemAction = "CANCEL_REQUEST"
+	REVOKEREQUEST BulkRevokeJobItemAction = "REVOKE_REQUEST"
+	REVOKETARGET  BulkRevokeJobItemAction = "REVOKE_TARGET"
+)
+
+// Defines values for BulkRevokeJobItemStatus.
+const (
+	BulkRevokeJobItemStatusFAILED    BulkRevokeJobItemStatus = "FAILED"
+	BulkRevokeJobItemStatusPLANNED   BulkRevokeJobItemStatus = "PLANNED"
+	BulkRevokeJobItemStatusSKIPPED   BulkRevokeJobItemStatus = "SKIPPED"
+	BulkRevokeJobItemStatusSUCCEEDED BulkRevokeJobItemStatus = "SUCCEEDED"
+)
+
+// Defines values for BulkRevokeJobKind.
+const (
+	ACCESSRULE   BulkRevokeJobKind = "ACCESS_RULE"
+	TARGETFILTER BulkRevokeJobKind = "TARGET_FILTER"
+	TARGETGROUP  BulkRevokeJobKind = "TARGET_GROUP"
+	USER         BulkRevokeJobKind = "USER"
+)
+
+// Defines values for FailedGrantPriority.
+const (
+	HIGH   FailedGrantPriority = "HIGH"
+	NORMAL FailedGrantPriority = "NORMAL"
+)
+
+// Defines values for FreezeHistoryEventAction.
+const (
+	CREATED FreezeHistoryEventAction = "CREATED"
+	LIFTED  FreezeHistoryEventAction = "LIFTED"
+	UPDATED FreezeHistoryEventAction = "UPDATED"
+)
+
+// Defines values for GrantAction.
+const (
+	ACTIVATE   GrantAction = "ACTIVATE"
+	DEACTIVATE GrantAction = "DEACTIVATE"
+)
+
+// Defines values for GrantDriftKind.
+const (
+	MISSING  GrantDriftKind = "MISSING"
+	OUTLIVED GrantDriftKind = "OUTLIVED"
+)
+
+// Defines values for IdentitySyncRunStatus.
+const (
+	IdentitySyncRunStatusABORTED   IdentitySyncRunStatus = "ABORTED"
+	IdentitySyncRunStatusFAILED    IdentitySyncRunStatus = "FAILED"
+	IdentitySyncRunStatusSUCCEEDED IdentitySyncRunStatus = "SUCCEEDED"
+)
+
 // Defines values for IdpStatus.
 const (
 	IdpStatusACTIVE   IdpStatus = "ACTIVE"
@@ -45,9 +107,11 @@ const (
 
 // Defines values for RequestAccessGroupStatus.
 const (
-	RequestAccessGroupStatusAPPROVED        RequestAccessGroupStatus = "APPROVED"
-	RequestAccessGroupStatusDECLINED        RequestAccessGroupStatus = "DECLINED"
-	RequestAccessGroupStatusPENDINGAPPROVAL RequestAccessGroupStatus = "PENDING_APPROVAL"
+	RequestAccessGroupStatusAPPROVALEXPIRED    RequestAccessGroupStatus = "APPROVAL_EXPIRED"
+	RequestAccessGroupStatusAPPROVED           RequestAccessGroupStatus = "APPROVED"
+	RequestAccessGroupStatusAWAITINGACTIVATION RequestAccessGroupStatus = "AWAITING_ACTIVATION"
+	RequestAccessGroupStatusDECLINED           RequestAccessGroupStatus = "DECLINED"
+	RequestAccessGroupStatusPENDINGAPPROVAL    RequestAccessGroupStatus = "PENDING_APPROVAL"
 )
 
 // Defines values for RequestAccessGroupTargetStatus.
@@ -104,6 +168,9 @@ type AccessRule struct {
 type AccessRuleApproverConfig struct {
 	Groups *[]string `json:"groups,omitempty"`
 
+	// If set, the requester's manager this many levels above the requester can approve the request, e.g. 1 for their manager and 2 for their manager's manager.
+	ManagerLevel *int `json:"managerLevel,omitempty"`
+
 	// The user IDs of the approvers for the request.
 	Users *[]string `json:"users,omitempty"`
 }
@@ -120,7 +187,10 @@ type AccessRuleMetadata struct {
 // a request body for an Access Rule Target
 type AccessRuleTarget struct {
 	FieldFilterExpessions AccessRuleTarget_FieldFilterExpessions `json:"fieldFilterExpessions"`
-	TargetGroup           TargetGroup                            `json:"targetGroup"`
+
+	// if set, only targets with all of these metadata tags are included in the rule, for example {"env": "prod"}
+	MetadataFilter *AccessRuleTarget_MetadataFilter `json:"metadataFilter,omitempty"`
+	TargetGroup    TargetGroup                      `json:"targetGroup"`
 }
 
 // AccessRuleTarget_FieldFilterExpessions defines model for AccessRuleTarget.FieldFilterExpessions.
@@ -128,8 +198,16 @@ type AccessRuleTarget_FieldFilterExpessions struct {
 	AdditionalProperties map[string]ResourceFilter `json:"-"`
 }
 
+// if set, only targets with all of these metadata tags are included in the rule, for example {"env": "prod"}
+type AccessRuleTarget_MetadataFilter struct {
+	AdditionalProperties map[string]string `json:"-"`
+}
+
 // Time configuration for an Access Rule.
 type AccessRuleTimeConstraints struct {
+	// How long in seconds requesters have to activate on demand access after it is approved. Defaults to 24 hours.
+	ActivationWindowSeconds *int `json:"activationWindowSeconds,omitempty"`
+
 	// The default duration in seconds the access is allowed for.
 	DefaultDurationSeconds int `json:"defaultDurationSeconds"`
 
@@ -157,6 +235,79 @@ type AccessTemplateAccessGroup struct {
 	TimeConstraints AccessRuleTimeConstraints `json:"timeConstraints"`
 }
 
+// Selects the requests and targets which a bulk revoke job applies to. The fields which are required depend on the kind of job.
+type BulkRevokeFilter struct {
+	// Required for ACCESS_RULE jobs.
+	AccessRuleId *string `json:"accessRuleId,omitempty"`
+
+	// Required for TARGET_FILTER jobs. Targets match if every field ID in the filter has the given value, for example {"accountId": "123456789012"}.
+	TargetFields *BulkRevokeFilter_TargetFields `json:"targetFields,omitempty"`
+
+	// Required for TARGET_GROUP jobs. Optionally narrows TARGET_FILTER jobs to a single target group.
+	TargetGroupId *string `json:"targetGroupId,omitempty"`
+
+	// Required for USER jobs.
+	UserId *string `json:"userId,omitempty"`
+}
+
+// Required for TARGET_FILTER jobs. Targets match if every field ID in the filter has the given value, for example {"accountId": "123456789012"}.
+type BulkRevokeFilter_TargetFields struct {
+	AdditionalProperties map[string]string `json:"-"`
+}
+
+// A background job which revokes access in bulk.
+type BulkRevokeJob struct {
+	CreatedAt time.Time `json:"createdAt"`
+	CreatedBy string    `json:"createdBy"`
+	DryRun    bool      `json:"dryRun"`
+	Failed    int       `json:"failed"`
+
+	// Selects the requests and targets which a bulk revoke job applies to. The fields which are required depend on the kind of job.
+	Filter BulkRevokeFilter     `json:"filter"`
+	Id     string               `json:"id"`
+	Items  *[]BulkRevokeJobItem `json:"items,omitempty"`
+
+	// USER revokes active requests and cancels pending requests made by a user. TARGET_GROUP, TARGET_FILTER and ACCESS_RULE revoke the matching active grants and cancel the matching pending requests.
+	Kind      BulkRevokeJobKind `json:"kind"`
+	Processed int               `json:"processed"`
+	Skipped   int               `json:"skipped"`
+
+	// Jobs are QUEUED until the event handler starts processing them. Dry run jobs are FINISHED as soon as they are created.
+	Status    BulkRevokeJobStatus `json:"status"`
+	Succeeded int                 `json:"succeeded"`
+	Total     int                 `json:"total"`
+	UpdatedAt time.Time           `json:"updatedAt"`
+}
+
+// Jobs are QUEUED until the event handler starts processing them. Dry run jobs are FINISHED as soon as they are created.
+type BulkRevokeJobStatus string
+
+// A request or target which is revoked or cancelled by a bulk revoke job.
+type BulkRevokeJobItem struct {
+	// REVOKE_REQUEST revokes every grant in an active request, CANCEL_REQUEST cancels a pending request, REVOKE_TARGET revokes a single grant.
+	Action    BulkRevokeJobItemAction `json:"action"`
+	Error     *string                 `json:"error,omitempty"`
+	GroupId   *string                 `json:"groupId,omitempty"`
+	Id        string                  `json:"id"`
+	RequestId string                  `json:"requestId"`
+
+	// The email address of the user who made the request.
+	RequestedBy string `json:"requestedBy"`
+
+	// PLANNED items have not been processed yet. Items are SKIPPED if the request or grant changed status after the job was created.
+	Status   BulkRevokeJobItemStatus `json:"status"`
+	TargetId *string                 `json:"targetId,omitempty"`
+}
+
+// REVOKE_REQUEST revokes every grant in an active request, CANCEL_REQUEST cancels a pending request, REVOKE_TARGET revokes a single grant.
+type BulkRevokeJobItemAction string
+
+// PLANNED items have not been processed yet. Items are SKIPPED if the request or grant changed status after the job was created.
+type BulkRevokeJobItemStatus string
+
+// USER revokes active requests and cancels pending requests made by a user. TARGET_GROUP, TARGET_FILTER and ACCESS_RULE revoke the matching active grants and cancel the matching pending requests.
+type BulkRevokeJobKind string
+
 // CreateAccessRequestGroupOptions defines model for CreateAccessRequestGroupOptions.
 type CreateAccessRequestGroupOptions struct {
 	Id     string                   `json:"id"`
@@ -166,7 +317,10 @@ type CreateAccessRequestGroupOptions struct {
 // a request body for creating a Access Rule Target
 type CreateAccessRuleTarget struct {
 	FieldFilterExpessions CreateAccessRuleTarget_FieldFilterExpessions `json:"fieldFilterExpessions"`
-	TargetGroupId         string                                       `json:"targetGroupId"`
+
+	// if set, only targets with all of these metadata tags are included in the rule, for example {"env": "prod"}
+	MetadataFilter *CreateAccessRuleTarget_MetadataFilter `json:"metadataFilter,omitempty"`
+	TargetGroupId  string                                 `json:"targetGroupId"`
 }
 
 // CreateAccessRuleTarget_FieldFilterExpessions defines model for CreateAccessRuleTarget.FieldFilterExpessions.
@@ -174,6 +328,33 @@ type CreateAccessRuleTarget_FieldFilterExpessions struct {
 	AdditionalProperties map[string]ResourceFilter `json:"-"`
 }
 
+// if set, only targets with all of these metadata tags are included in the rule, for example {"env": "prod"}
+type CreateAccessRuleTarget_MetadataFilter struct {
+	AdditionalProperties map[string]string `json:"-"`
+}
+
+// An event which a subscriber failed to handle.
+type DeadLetterEvent struct {
+	Attempts int `json:"attempts"`
+
+	// the JSON event payload
+	Detail     string `json:"detail"`
+	DetailType string `json:"detailType"`
+
+	// the error returned by the last attempt
+	Error         string    `json:"error"`
+	EventId       string    `json:"eventId"`
+	FirstFailedAt time.Time `json:"firstFailedAt"`
+	Id            string    `json:"id"`
+	LastFailedAt  time.Time `json:"lastFailedAt"`
+
+	// groups the events for a resource, such as the events for a request
+	OrderingKey *string `json:"orderingKey,omitempty"`
+
+	// the subscriber which failed to handle the event
+	Subscriber string `json:"subscriber"`
+}
+
 // Diagnostic defines model for Diagnostic.
 type Diagnostic struct {
 	Code    string   `json:"code"`
@@ -181,6 +362,120 @@ type Diagnostic struct {
 	Message string   `json:"message"`
 }
 
+// A published version of the JSON schema for an event.
+type EventSchema struct {
+	EventType string `json:"eventType"`
+
+	// the JSON schema document
+	Schema  map[string]interface{} `json:"schema"`
+	Version int                    `json:"version"`
+}
+
+// A grant which could not be activated or deactivated after all retries were exhausted.
+type FailedGrant struct {
+	// The provider action taken for a grant.
+	Action    GrantAction `json:"action"`
+	Attempts  int         `json:"attempts"`
+	CreatedAt time.Time   `json:"createdAt"`
+	GrantId   string      `json:"grantId"`
+	GroupId   string      `json:"groupId"`
+
+	// HIGH for failed deactivations, where the user may still have access.
+	Priority  FailedGrantPriority `json:"priority"`
+	Reason    string              `json:"reason"`
+	RequestId string              `json:"requestId"`
+
+	// A temporary assignment of a user to a principal.
+	Target RequestAccessGroupTarget `json:"target"`
+}
+
+// HIGH for failed deactivations, where the user may still have access.
+type FailedGrantPriority string
+
+// A target which a user has saved as a favorite
+type Favorite struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// false if the target no longer exists or the user no longer has access to it
+	Eligible bool   `json:"eligible"`
+	Target   Target `json:"target"`
+	TargetId string `json:"targetId"`
+}
+
+// A freeze stops new access from being requested, approved or activated.
+type Freeze struct {
+	Active    bool       `json:"active"`
+	CreatedAt time.Time  `json:"createdAt"`
+	CreatedBy string     `json:"createdBy"`
+	EndsAt    *time.Time `json:"endsAt,omitempty"`
+
+	// Users in any of these groups are not affected by the freeze, for example break-glass groups.
+	ExemptGroups []string    `json:"exemptGroups"`
+	Id           string      `json:"id"`
+	LiftedAt     *time.Time  `json:"liftedAt,omitempty"`
+	LiftedBy     *string     `json:"liftedBy,omitempty"`
+	Message      string      `json:"message"`
+	Scope        FreezeScope `json:"scope"`
+
+	// The target group or access rule ID. Not set for GLOBAL freezes.
+	ScopeId   *string   `json:"scopeId,omitempty"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// An audit record of a change made to a freeze.
+type FreezeHistoryEvent struct {
+	Action    FreezeHistoryEventAction `json:"action"`
+	Actor     string                   `json:"actor"`
+	CreatedAt time.Time                `json:"createdAt"`
+
+	// A freeze stops new access from being requested, approved or activated.
+	Freeze   Freeze `json:"freeze"`
+	FreezeId string `json:"freezeId"`
+	Id       string `json:"id"`
+}
+
+// FreezeHistoryEventAction defines model for FreezeHistoryEvent.Action.
+type FreezeHistoryEventAction string
+
+// FreezeScope defines model for FreezeScope.
+type FreezeScope string
+
+// The provider action taken for a grant.
+type GrantAction string
+
+// A failed attempt to activate or deactivate a grant.
+type GrantAttempt struct {
+	// The provider action taken for a grant.
+	Action     GrantAction `json:"action"`
+	Attempt    int         `json:"attempt"`
+	Error      string      `json:"error"`
+	ErrorClass string      `json:"errorClass"`
+	WillRetry  bool        `json:"willRetry"`
+}
+
+// A difference between a grant recorded by Common Fate and the access reported by its handler.
+type GrantDrift struct {
+	DetectedAt time.Time `json:"detectedAt"`
+	GrantId    string    `json:"grantId"`
+	GroupId    string    `json:"groupId"`
+
+	// OUTLIVED if access still exists after the grant ended, MISSING if access was removed before the grant ended.
+	Kind      GrantDriftKind `json:"kind"`
+	RequestId string         `json:"requestId"`
+
+	// the error returned when revoking access which outlived the grant
+	ResolutionError *string `json:"resolutionError,omitempty"`
+
+	// true if the reconciler revoked access which outlived the grant
+	Resolved bool `json:"resolved"`
+
+	// A temporary assignment of a user to a principal.
+	Target RequestAccessGroupTarget `json:"target"`
+}
+
+// OUTLIVED if access still exists after the grant ended, MISSING if access was removed before the grant ended.
+type GrantDriftKind string
+
 // Group defines model for Group.
 type Group struct {
 	Description string   `json:"description"`
@@ -191,6 +486,64 @@ type Group struct {
 	Source      string   `json:"source"`
 }
 
+// GroupMembershipPathElement defines model for GroupMembershipPathElement.
+type GroupMembershipPathElement struct {
+	Id string `json:"id"`
+
+	// the name of the group, or its ID if the group has not been synced
+	Name string `json:"name"`
+}
+
+// The number of users and groups which a sync created, updated and archived.
+type IdentitySyncChangeCounts struct {
+	GroupsArchived int `json:"groupsArchived"`
+	GroupsCreated  int `json:"groupsCreated"`
+	GroupsUpdated  int `json:"groupsUpdated"`
+	UsersArchived  int `json:"usersArchived"`
+	UsersCreated   int `json:"usersCreated"`
+	UsersUpdated   int `json:"usersUpdated"`
+}
+
+// The outcome of an identity sync and the users and groups which it created, updated and archived.
+type IdentitySyncReport struct {
+	Groups struct {
+		Archived []Group `json:"archived"`
+		Created  []Group `json:"created"`
+		Updated  []Group `json:"updated"`
+	} `json:"groups"`
+
+	// A record of an identity sync.
+	Run   IdentitySyncRun `json:"run"`
+	Users struct {
+		Archived []User `json:"archived"`
+		Created  []User `json:"created"`
+		Updated  []User `json:"updated"`
+	} `json:"users"`
+}
+
+// A record of an identity sync.
+type IdentitySyncRun struct {
+	// The number of users and groups which a sync created, updated and archived.
+	Changes     IdentitySyncChangeCounts `json:"changes"`
+	CompletedAt time.Time                `json:"completedAt"`
+
+	// true if the changes were reported but not applied
+	DryRun bool    `json:"dryRun"`
+	Error  *string `json:"error,omitempty"`
+
+	// true if the archive safety threshold was overridden
+	Forced           bool      `json:"forced"`
+	Id               string    `json:"id"`
+	IdentityProvider string    `json:"identityProvider"`
+	StartedAt        time.Time `json:"startedAt"`
+
+	// ABORTED if the sync was stopped because more users or groups would have been archived than the safety threshold allows
+	Status IdentitySyncRunStatus `json:"status"`
+}
+
+// ABORTED if the sync was stopped because more users or groups would have been archived than the safety threshold allows
+type IdentitySyncRunStatus string
+
 // IdpStatus defines model for IdpStatus.
 type IdpStatus string
 
@@ -242,6 +595,9 @@ type Request struct {
 type RequestAccessGroup struct {
 	AccessRule RequestAccessGroupAccessRule `json:"accessRule"`
 
+	// For on demand access, the time by which the requester must activate access before the approval expires.
+	ActivationDeadline *time.Time `json:"activationDeadline,omitempty"`
+
 	// Describes whether a request has been approved automatically or from a review
 	ApprovalMethod *RequestAccessGroupApprovalMethod `json:"approvalMethod,omitempty"`
 	CreatedAt      time.Time                         `json:"createdAt"`
@@ -293,7 +649,10 @@ type RequestAccessGroupTarget struct {
 	AccessGroupId string        `json:"accessGroupId"`
 	Fields        []TargetField `json:"fields"`
 	Id            string        `json:"id"`
-	RequestId     string        `json:"requestId"`
+
+	// admin curated tags for the target at the time it was requested
+	Metadata  *RequestAccessGroupTarget_Metadata `json:"metadata,omitempty"`
+	RequestId string                             `json:"requestId"`
 
 	// The user who requested access
 	RequestedBy RequestRequestedBy `json:"requestedBy"`
@@ -304,6 +663,11 @@ type RequestAccessGroupTarget struct {
 	TargetKind    TargetKind                     `json:"targetKind"`
 }
 
+// admin curated tags for the target at the time it was requested
+type RequestAccessGroupTarget_Metadata struct {
+	AdditionalProperties map[string]string `json:"-"`
+}
+
 // Instructions on how to access the requested resource.
 //
 // The `instructions` field will be null if no instructions are available.
@@ -319,6 +683,9 @@ type RequestAccessGroupTargetStatus string
 type RequestAccessGroupTiming struct {
 	DurationSeconds int `json:"durationSeconds"`
 
+	// If true, access starts when the requester activates it after approval rather than when it is approved. The approval expires if access isn't activated within the access rule's activation window.
+	OnDemand *bool `json:"onDemand,omitempty"`
+
 	// iso8601 timestamp in UTC timezone
 	StartTime *time.Time `json:"startTime,omitempty"`
 }
@@ -335,11 +702,14 @@ type RequestEvent struct {
 	FromGroupStatus *RequestAccessGroupStatus `json:"fromGroupStatus,omitempty"`
 
 	// The status of an Access Request.
-	FromStatus         *RequestStatus            `json:"fromStatus,omitempty"`
-	FromTiming         *RequestAccessGroupTiming `json:"fromTiming,omitempty"`
-	GrantCreated       *bool                     `json:"grantCreated,omitempty"`
-	GrantFailureReason *string                   `json:"grantFailureReason,omitempty"`
-	Id                 string                    `json:"id"`
+	FromStatus *RequestStatus            `json:"fromStatus,omitempty"`
+	FromTiming *RequestAccessGroupTiming `json:"fromTiming,omitempty"`
+
+	// A failed attempt to activate or deactivate a grant.
+	GrantAttempt       *GrantAttempt `json:"grantAttempt,omitempty"`
+	GrantCreated       *bool         `json:"grantCreated,omitempty"`
+	GrantFailureReason *string       `json:"grantFailureReason,omitempty"`
+	Id                 string        `json:"id"`
 
 	// An event which was recorded relating to the grant.
 	RecordedEvent  *map[string]string `json:"recordedEvent,omitempty"`
@@ -410,6 +780,14 @@ type Target struct {
 	Fields []TargetField `json:"fields"`
 	Id     string        `json:"id"`
 	Kind   TargetKind    `json:"kind"`
+
+	// admin curated tags for the target, such as the owning team or environment
+	Metadata *Target_Metadata `json:"metadata,omitempty"`
+}
+
+// admin curated tags for the target, such as the owning team or environment
+type Target_Metadata struct {
+	AdditionalProperties map[string]string `json:"-"`
 }
 
 // TargetField defines model for TargetField.
@@ -422,16 +800,24 @@ type TargetField struct {
 	ValueLabel       string  `json:"valueLabel"`
 }
 
+// a map of field ID to a glob pattern which is matched against the field value or label, for example {"accountName": "prod-*"}
+type TargetFieldPatterns struct {
+	AdditionalProperties map[string]string `json:"-"`
+}
+
 // TargetGroup defines model for TargetGroup.
 type TargetGroup struct {
 	CreatedAt *time.Time `json:"createdAt,omitempty"`
 
 	// Specifies a particular Access Provider to create a Target Group schema from.
-	From      TargetGroupFrom   `json:"from"`
-	Icon      string            `json:"icon"`
-	Id        string            `json:"id"`
-	Schema    TargetGroupSchema `json:"schema"`
-	UpdatedAt *time.Time        `json:"updatedAt,omitempty"`
+	From   TargetGroupFrom   `json:"from"`
+	Icon   string            `json:"icon"`
+	Id     string            `json:"id"`
+	Schema TargetGroupSchema `json:"schema"`
+
+	// The status of the most recent resource sync for a target group
+	SyncStatus *TargetGroupSyncStatus `json:"syncStatus,omitempty"`
+	UpdatedAt  *time.Time             `json:"updatedAt,omitempty"`
 }
 
 // Specifies a particular Access Provider to create a Target Group schema from.
@@ -463,6 +849,28 @@ type TargetGroupSchemaArgument struct {
 	Title          string                  `json:"title"`
 }
 
+// The status of the most recent resource sync for a target group
+type TargetGroupSyncStatus struct {
+	Diagnostics   []Diagnostic `json:"diagnostics"`
+	LastAttemptAt *time.Time   `json:"lastAttemptAt,omitempty"`
+
+	// the error from the most recent sync attempt, if it failed
+	LastError     *string    `json:"lastError,omitempty"`
+	LastSuccessAt *time.Time `json:"lastSuccessAt,omitempty"`
+
+	// the number of cached resources by resource type, as of the last successful sync
+	ResourceCounts TargetGroupSyncStatus_ResourceCounts `json:"resourceCounts"`
+
+	// true if the resources have not been successfully synced within the staleness threshold
+	Stale          bool `json:"stale"`
+	TotalResources int  `json:"totalResources"`
+}
+
+// the number of cached resources by resource type, as of the last successful sync
+type TargetGroupSyncStatus_ResourceCounts struct {
+	AdditionalProperties map[string]int `json:"-"`
+}
+
 // TargetKind defines model for TargetKind.
 type TargetKind struct {
 	Icon      string `json:"icon"`
@@ -471,6 +879,25 @@ type TargetKind struct {
 	Publisher string `json:"publisher"`
 }
 
+// Admin curated tags which are applied to targets, either by target ID or by matching target fields against glob patterns
+type TargetMetadata struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// a map of field ID to a glob pattern which is matched against the field value or label, for example {"accountName": "prod-*"}
+	FieldPatterns *TargetFieldPatterns `json:"fieldPatterns,omitempty"`
+	Id            string               `json:"id"`
+
+	// if set, field patterns are only matched against targets of this kind
+	Kind *string `json:"kind,omitempty"`
+
+	// a map of tag key to tag value, for example {"team": "payments"}
+	Tags TargetTags `json:"tags"`
+
+	// if set, the tags are applied to the target with this ID
+	TargetId  *string   `json:"targetId,omitempty"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
 // TargetRoute defines model for TargetRoute.
 type TargetRoute struct {
 	Diagnostics   []Diagnostic `json:"diagnostics"`
@@ -481,18 +908,39 @@ type TargetRoute struct {
 	Valid         bool         `json:"valid"`
 }
 
+// a map of tag key to tag value, for example {"team": "payments"}
+type TargetTags struct {
+	AdditionalProperties map[string]string `json:"-"`
+}
+
 // User defines model for User.
 type User struct {
-	Email     string    `json:"email"`
-	FirstName string    `json:"firstName"`
-	Groups    []string  `json:"groups"`
-	Id        string    `json:"id"`
-	LastName  string    `json:"lastName"`
+	// Attributes synced from the identity provider, such as department or employment type.
+	Attributes *map[string]string `json:"attributes,omitempty"`
+	Email      string             `json:"email"`
+	FirstName  string             `json:"firstName"`
+	Groups     []string           `json:"groups"`
+	Id         string             `json:"id"`
+	LastName   string             `json:"lastName"`
+
+	// The ID of the user's manager.
+	ManagerId *string   `json:"managerId,omitempty"`
 	Picture   string    `json:"picture"`
 	Status    IdpStatus `json:"status"`
 	UpdatedAt time.Time `json:"updatedAt"`
 }
 
+// A group which a user is a member of.
+type UserGroupMembership struct {
+	// true if the user is a direct member of the group, rather than a member of a nested group
+	Direct    bool   `json:"direct"`
+	GroupId   string `json:"groupId"`
+	GroupName string `json:"groupName"`
+
+	// The groups which gave the user membership of the group, starting with a group which the user is a direct member of and ending with the group itself.
+	Path []GroupMembershipPathElement `json:"path"`
+}
+
 // AccessInstructionsResponse defines model for AccessInstructionsResponse.
 type AccessInstructionsResponse struct {
 	// Instructions on how to access the requested resource.
@@ -543,11 +991,51 @@ type ListAccessTemplatesResponse struct {
 	Next            *string          `json:"next,omitempty"`
 }
 
+// ListBulkRevokeJobsResponse defines model for ListBulkRevokeJobsResponse.
+type ListBulkRevokeJobsResponse struct {
+	Jobs []BulkRevokeJob `json:"jobs"`
+}
+
+// ListDeadLetterEventsResponse defines model for ListDeadLetterEventsResponse.
+type ListDeadLetterEventsResponse struct {
+	Events []DeadLetterEvent `json:"events"`
+}
+
 // ListEntitlementsResponse defines model for ListEntitlementsResponse.
 type ListEntitlementsResponse struct {
 	Entitlements []TargetKind `json:"entitlements"`
 }
 
+// ListEventSchemasResponse defines model for ListEventSchemasResponse.
+type ListEventSchemasResponse struct {
+	Schemas []EventSchema `json:"schemas"`
+}
+
+// ListFailedGrantsResponse defines model for ListFailedGrantsResponse.
+type ListFailedGrantsResponse struct {
+	FailedGrants []FailedGrant `json:"failedGrants"`
+}
+
+// ListFavoritesResponse defines model for ListFavoritesResponse.
+type ListFavoritesResponse struct {
+	Favorites []Favorite `json:"favorites"`
+}
+
+// ListFreezeHistoryResponse defines model for ListFreezeHistoryResponse.
+type ListFreezeHistoryResponse struct {
+	Events []FreezeHistoryEvent `json:"events"`
+}
+
+// ListFreezesResponse defines model for ListFreezesResponse.
+type ListFreezesResponse struct {
+	Freezes []Freeze `json:"freezes"`
+}
+
+// ListGrantDriftResponse defines model for ListGrantDriftResponse.
+type ListGrantDriftResponse struct {
+	Drift []GrantDrift `json:"drift"`
+}
+
 // ListGrantsResponse defines model for ListGrantsResponse.
 type ListGrantsResponse struct {
 	Grants []RequestAccessGroupTarget `json:"grants"`
@@ -566,6 +1054,11 @@ type ListHandlersResponse struct {
 	Res  []TGHandler `json:"res"`
 }
 
+// ListIdentitySyncRunsResponse defines model for ListIdentitySyncRunsResponse.
+type ListIdentitySyncRunsResponse struct {
+	Runs []IdentitySyncRun `json:"runs"`
+}
+
 // ListRequestEventsResponse defines model for ListRequestEventsResponse.
 type ListRequestEventsResponse struct {
 	Events []RequestEvent `json:"events"`
@@ -592,12 +1085,22 @@ type ListTargetGroupRoutes struct {
 	Routes []TargetRoute `json:"routes"`
 }
 
+// ListTargetMetadataResponse defines model for ListTargetMetadataResponse.
+type ListTargetMetadataResponse struct {
+	Metadata []TargetMetadata `json:"metadata"`
+}
+
 // ListTargetsResponse defines model for ListTargetsResponse.
 type ListTargetsResponse struct {
 	Next    *string  `json:"next,omitempty"`
 	Targets []Target `json:"targets"`
 }
 
+// ListUserGroupMembershipsResponse defines model for ListUserGroupMembershipsResponse.
+type ListUserGroupMembershipsResponse struct {
+	Memberships []UserGroupMembership `json:"memberships"`
+}
+
 // ListUserResponse defines model for ListUserResponse.
 type ListUserResponse struct {
 	Next  *string `json:"next"`
@@ -635,6 +1138,31 @@ type CreateAccessRuleRequest struct {
 	TimeConstraints AccessRuleTimeConstraints `json:"timeConstraints"`
 }
 
+// CreateBulkRevokeJobRequest defines model for CreateBulkRevokeJobRequest.
+type CreateBulkRevokeJobRequest struct {
+	DryRun *bool `json:"dryRun,omitempty"`
+
+	// Selects the requests and targets which a bulk revoke job applies to. The fields which are required depend on the kind of job.
+	Filter BulkRevokeFilter `json:"filter"`
+
+	// USER revokes active requests and cancels pending requests made by a user. TARGET_GROUP, TARGET_FILTER and ACCESS_RULE revoke the matching active grants and cancel the matching pending requests.
+	Kind BulkRevokeJobKind `json:"kind"`
+}
+
+// CreateFavoriteRequest defines model for CreateFavoriteRequest.
+type CreateFavoriteRequest struct {
+	TargetId string `json:"targetId"`
+}
+
+// CreateFreezeRequest defines model for CreateFreezeRequest.
+type CreateFreezeRequest struct {
+	EndsAt       *time.Time  `json:"endsAt,omitempty"`
+	ExemptGroups *[]string   `json:"exemptGroups,omitempty"`
+	Message      string      `json:"message"`
+	Scope        FreezeScope `json:"scope"`
+	ScopeId      *string     `json:"scopeId,omitempty"`
+}
+
 // CreateGroupRequest defines model for CreateGroupRequest.
 type CreateGroupRequest struct {
 	Description *string  `json:"description,omitempty"`
@@ -661,6 +1189,17 @@ type CreateTargetGroupRequest struct {
 	Id   string          `json:"id"`
 }
 
+// CreateTargetMetadataRequest defines model for CreateTargetMetadataRequest.
+type CreateTargetMetadataRequest struct {
+	// a map of field ID to a glob pattern which is matched against the field value or label, for example {"accountName": "prod-*"}
+	FieldPatterns *TargetFieldPatterns `json:"fieldPatterns,omitempty"`
+	Kind          *string              `json:"kind,omitempty"`
+
+	// a map of tag key to tag value, for example {"team": "payments"}
+	Tags     TargetTags `json:"tags"`
+	TargetId *string    `json:"targetId,omitempty"`
+}
+
 // CreateUserRequest defines model for CreateUserRequest.
 type CreateUserRequest struct {
 	Email     openapi_types.Email `json:"email"`
@@ -679,6 +1218,12 @@ type RegisterHandlerRequest struct {
 	Runtime string `json:"runtime"`
 }
 
+// RequestFavoritesRequest defines model for RequestFavoritesRequest.
+type RequestFavoritesRequest struct {
+	Reason    *string   `json:"reason,omitempty"`
+	TargetIds *[]string `json:"targetIds,omitempty"`
+}
+
 // ResouceFilterRequest defines model for ResouceFilterRequest.
 type ResouceFilterRequest = ResourceFilter
 
@@ -691,12 +1236,34 @@ type ReviewRequest struct {
 	OverrideTiming *RequestAccessGroupTiming `json:"overrideTiming,omitempty"`
 }
 
+// UpdateFreezeRequest defines model for UpdateFreezeRequest.
+type UpdateFreezeRequest struct {
+	EndsAt       *time.Time `json:"endsAt,omitempty"`
+	ExemptGroups *[]string  `json:"exemptGroups,omitempty"`
+	Message      string     `json:"message"`
+}
+
 // AdminListAccessRulesParams defines parameters for AdminListAccessRules.
 type AdminListAccessRulesParams struct {
 	// Next page token
 	NextToken *string `form:"nextToken,omitempty" json:"nextToken,omitempty"`
 }
 
+// AdminListDeadLetterEventsParams defines parameters for AdminListDeadLetterEvents.
+type AdminListDeadLetterEventsParams struct {
+	// only list the events for this request
+	RequestId *string `form:"requestId,omitempty" json:"requestId,omitempty"`
+
+	// only list the events which this subscriber failed to handle, such as eventHandler or slackNotifier
+	Subscriber *string `form:"subscriber,omitempty" json:"subscriber,omitempty"`
+}
+
+// AdminGetEventSchemaParams defines parameters for AdminGetEventSchema.
+type AdminGetEventSchemaParams struct {
+	// the schema version to return
+	Version *int `form:"version,omitempty" json:"version,omitempty"`
+}
+
 // AdminListGroupsParams defines parameters for AdminListGroups.
 type AdminListGroupsParams struct {
 	// encrypted token containing pagination info
@@ -707,6 +1274,15 @@ type AdminListGroupsParams struct {
 // AdminListGroupsParamsSource defines parameters for AdminListGroups.
 type AdminListGroupsParamsSource string
 
+// AdminSyncIdentityParams defines parameters for AdminSyncIdentity.
+type AdminSyncIdentityParams struct {
+	// if true, the changes are reported but not applied
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+
+	// if true, the changes are applied even if more users or groups would be archived than the safety threshold allows
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
+}
+
 // AdminListRequestsParams defines parameters for AdminListRequests.
 type AdminListRequestsParams struct {
 	// omit this param to view all results
@@ -746,6 +1322,33 @@ type UserListEntitlementTargetsParams struct {
 
 	// filter targets by kind
 	Kind *string `form:"kind,omitempty" json:"kind,omitempty"`
+
+	// search over target field values, labels and tags, each term must be contained in them
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+
+	// filter targets by field value, in the form fieldId:value. Can be provided multiple times.
+	Filter *[]string `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// the attribute to sort targets by, defaults to label
+	SortBy *UserListEntitlementTargetsParamsSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+
+	// the sort direction, defaults to asc
+	SortOrder *UserListEntitlementTargetsParamsSortOrder `form:"sortOrder,omitempty" json:"sortOrder,omitempty"`
+
+	// the maximum number of targets to return, defaults to 50
+	PageSize *int `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+}
+
+// UserListEntitlementTargetsParamsSortBy defines parameters for UserListEntitlementTargets.
+type UserListEntitlementTargetsParamsSortBy string
+
+// UserListEntitlementTargetsParamsSortOrder defines parameters for UserListEntitlementTargets.
+type UserListEntitlementTargetsParamsSortOrder string
+
+// UserRequestPreflightParams defines parameters for UserRequestPreflight.
+type UserRequestPreflightParams struct {
+	// A unique key for this call. Retries with the same key return the original result instead of creating a duplicate.
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
 }
 
 // UserListRequestsParams defines parameters for UserListRequests.
@@ -760,6 +1363,12 @@ type UserListRequestsParams struct {
 // UserListRequestsParamsFilter defines parameters for UserListRequests.
 type UserListRequestsParamsFilter string
 
+// UserPostRequestsParams defines parameters for UserPostRequests.
+type UserPostRequestsParams struct {
+	// A unique key for this call. Retries with the same key return the original result instead of creating a duplicate.
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
+}
+
 // UserListReviewsParams defines parameters for UserListReviews.
 type UserListReviewsParams struct {
 	// omit this param to view all results
@@ -778,6 +1387,15 @@ type AdminCreateAccessRuleJSONRequestBody CreateAccessRuleRequest
 // AdminUpdateAccessRuleJSONRequestBody defines body for AdminUpdateAccessRule for application/json ContentType.
 type AdminUpdateAccessRuleJSONRequestBody CreateAccessRuleRequest
 
+// AdminCreateBulkRevokeJobJSONRequestBody defines body for AdminCreateBulkRevokeJob for application/json ContentType.
+type AdminCreateBulkRevokeJobJSONRequestBody CreateBulkRevokeJobRequest
+
+// AdminCreateFreezeJSONRequestBody defines body for AdminCreateFreeze for application/json ContentType.
+type AdminCreateFreezeJSONRequestBody CreateFreezeRequest
+
+// AdminUpdateFreezeJSONRequestBody defines body for AdminUpdateFreeze for application/json ContentType.
+type AdminUpdateFreezeJSONRequestBody UpdateFreezeRequest
+
 // AdminCreateGroupJSONRequestBody defines body for AdminCreateGroup for application/json ContentType.
 type AdminCreateGroupJSONRequestBody CreateGroupRequest
 
@@ -796,12 +1414,24 @@ type AdminCreateTargetGroupLinkJSONRequestBody CreateTargetGroupLink
 // AdminFilterTargetGroupResourcesJSONRequestBody defines body for AdminFilterTargetGroupResources for application/json ContentType.
 type AdminFilterTargetGroupResourcesJSONRequestBody = AdminFilterTargetGroupResourcesJSONBody
 
+// AdminCreateTargetMetadataJSONRequestBody defines body for AdminCreateTargetMetadata for application/json ContentType.
+type AdminCreateTargetMetadataJSONRequestBody CreateTargetMetadataRequest
+
+// AdminUpdateTargetMetadataJSONRequestBody defines body for AdminUpdateTargetMetadata for application/json ContentType.
+type AdminUpdateTargetMetadataJSONRequestBody CreateTargetMetadataRequest
+
 // AdminCreateUserJSONRequestBody defines body for AdminCreateUser for application/json ContentType.
 type AdminCreateUserJSONRequestBody CreateUserRequest
 
 // AdminUpdateUserJSONRequestBody defines body for AdminUpdateUser for application/json ContentType.
 type AdminUpdateUserJSONRequestBody AdminUpdateUserJSONBody
 
+// UserCreateFavoriteJSONRequestBody defines body for UserCreateFavorite for application/json ContentType.
+type UserCreateFavoriteJSONRequestBody CreateFavoriteRequest
+
+// UserRequestFavoritesJSONRequestBody defines body for UserRequestFavorites for application/json ContentType.
+type UserRequestFavoritesJSONRequestBody RequestFavoritesRequest
+
 // UserRequestPreflightJSONRequestBody defines body for UserRequestPreflight for application/json ContentType.
 type UserRequestPreflightJSONRequestBody CreatePreflightRequest
 
@@ -864,25 +1494,25 @@ func (a AccessRuleTarget_FieldFilterExpessions) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for CreateAccessRuleTarget_FieldFilterExpessions. Returns the specified
+// Getter for additional properties for AccessRuleTarget_MetadataFilter. Returns the specified
 // element and whether it was found
-func (a CreateAccessRuleTarget_FieldFilterExpessions) Get(fieldName string) (value ResourceFilter, found bool) {
+func (a AccessRuleTarget_MetadataFilter) Get(fieldName string) (value string, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for CreateAccessRuleTarget_FieldFilterExpessions
-func (a *CreateAccessRuleTarget_FieldFilterExpessions) Set(fieldName string, value ResourceFilter) {
+// Setter for additional properties for AccessRuleTarget_MetadataFilter
+func (a *AccessRuleTarget_MetadataFilter) Set(fieldName string, value string) {
 	if a.AdditionalProperties == nil {
-		a.AdditionalProperties = make(map[string]ResourceFilter)
+		a.AdditionalProperties = make(map[string]string)
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for CreateAccessRuleTarget_FieldFilterExpessions to handle AdditionalProperties
-func (a *CreateAccessRuleTarget_FieldFilterExpessions) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for AccessRuleTarget_MetadataFilter to handle AdditionalProperties
+func (a *AccessRuleTarget_MetadataFilter) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
@@ -890,9 +1520,9 @@ func (a *CreateAccessRuleTarget_FieldFilterExpessions) UnmarshalJSON(b []byte) e
 	}
 
 	if len(object) != 0 {
-		a.AdditionalProperties = make(map[string]ResourceFilter)
+		a.AdditionalProperties = make(map[string]string)
 		for fieldName, fieldBuf := range object {
-			var fieldVal ResourceFilter
+			var fieldVal string
 			err := json.Unmarshal(fieldBuf, &fieldVal)
 			if err != nil {
 				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
@@ -903,8 +1533,8 @@ func (a *CreateAccessRuleTarget_FieldFilterExpessions) UnmarshalJSON(b []byte) e
 	return nil
 }
 
-// Override default JSON handling for CreateAccessRuleTarget_FieldFilterExpessions to handle AdditionalProperties
-func (a CreateAccessRuleTarget_FieldFilterExpessions) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for AccessRuleTarget_MetadataFilter to handle AdditionalProperties
+func (a AccessRuleTarget_MetadataFilter) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
@@ -917,25 +1547,25 @@ func (a CreateAccessRuleTarget_FieldFilterExpessions) MarshalJSON() ([]byte, err
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for TargetGroupSchema. Returns the specified
+// Getter for additional properties for BulkRevokeFilter_TargetFields. Returns the specified
 // element and whether it was found
-func (a TargetGroupSchema) Get(fieldName string) (value TargetGroupSchemaArgument, found bool) {
+func (a BulkRevokeFilter_TargetFields) Get(fieldName string) (value string, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for TargetGroupSchema
-func (a *TargetGroupSchema) Set(fieldName string, value TargetGroupSchemaArgument) {
+// Setter for additional properties for BulkRevokeFilter_TargetFields
+func (a *BulkRevokeFilter_TargetFields) Set(fieldName string, value string) {
 	if a.AdditionalProperties == nil {
-		a.AdditionalProperties = make(map[string]TargetGroupSchemaArgument)
+		a.AdditionalProperties = make(map[string]string)
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for TargetGroupSchema to handle AdditionalProperties
-func (a *TargetGroupSchema) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for BulkRevokeFilter_TargetFields to handle AdditionalProperties
+func (a *BulkRevokeFilter_TargetFields) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
@@ -943,9 +1573,9 @@ func (a *TargetGroupSchema) UnmarshalJSON(b []byte) error {
 	}
 
 	if len(object) != 0 {
-		a.AdditionalProperties = make(map[string]TargetGroupSchemaArgument)
+		a.AdditionalProperties = make(map[string]string)
 		for fieldName, fieldBuf := range object {
-			var fieldVal TargetGroupSchemaArgument
+			var fieldVal string
 			err := json.Unmarshal(fieldBuf, &fieldVal)
 			if err != nil {
 				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
@@ -956,8 +1586,8 @@ func (a *TargetGroupSchema) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for TargetGroupSchema to handle AdditionalProperties
-func (a TargetGroupSchema) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for BulkRevokeFilter_TargetFields to handle AdditionalProperties
+func (a BulkRevokeFilter_TargetFields) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
@@ -970,253 +1600,790 @@ func (a TargetGroupSchema) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// RequestEditorFn  is the function signature for the RequestEditor callback function
-type RequestEditorFn func(ctx context.Context, req *http.Request) error
-
-// Doer performs HTTP requests.
-//
-// The standard http.Client implements this interface.
-type HttpRequestDoer interface {
-	Do(req *http.Request) (*http.Response, error)
+// Getter for additional properties for CreateAccessRuleTarget_FieldFilterExpessions. Returns the specified
+// element and whether it was found
+func (a CreateAccessRuleTarget_FieldFilterExpessions) Get(fieldName string) (value ResourceFilter, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
 }
 
-// Client which conforms to the OpenAPI3 specification for this service.
-type Client struct {
-	// The endpoint of the server conforming to this interface, with scheme,
-	// https://api.deepmap.com for example. This can contain a path relative
-	// to the server, such as https://api.deepmap.com/dev-test, and all the
-	// paths in the swagger spec will be appended to the server.
-	Server string
+// Setter for additional properties for CreateAccessRuleTarget_FieldFilterExpessions
+func (a *CreateAccessRuleTarget_FieldFilterExpessions) Set(fieldName string, value ResourceFilter) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]ResourceFilter)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
 
-	// Doer for performing requests, typically a *http.Client with any
-	// customized settings, such as certificate chains.
-	Client HttpRequestDoer
+// Override default JSON handling for CreateAccessRuleTarget_FieldFilterExpessions to handle AdditionalProperties
+func (a *CreateAccessRuleTarget_FieldFilterExpessions) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
 
-	// A list of callbacks for modifying requests which are generated before sending over
-	// the network.
-	RequestEditors []RequestEditorFn
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]ResourceFilter)
+		for fieldName, fieldBuf := range object {
+			var fieldVal ResourceFilter
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
 }
 
-// ClientOption allows setting custom parameters during construction
-type ClientOption func(*Client) error
+// Override default JSON handling for CreateAccessRuleTarget_FieldFilterExpessions to handle AdditionalProperties
+func (a CreateAccessRuleTarget_FieldFilterExpessions) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
 
-// Creates a new Client, with reasonable defaults
-func NewClient(server string, opts ...ClientOption) (*Client, error) {
-	// create a client with sane default values
-	client := Client{
-		Server: server,
-	}
-	// mutate client and add all optional params
-	for _, o := range opts {
-		if err := o(&client); err != nil {
-			return nil, err
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
 		}
 	}
-	// ensure the server URL always has a trailing slash
-	if !strings.HasSuffix(client.Server, "/") {
-		client.Server += "/"
-	}
-	// create httpClient, if not already present
-	if client.Client == nil {
-		client.Client = &http.Client{}
-	}
-	return &client, nil
+	return json.Marshal(object)
 }
 
-// WithHTTPClient allows overriding the default Doer, which is
-// automatically created using http.Client. This is useful for tests.
-func WithHTTPClient(doer HttpRequestDoer) ClientOption {
-	return func(c *Client) error {
-		c.Client = doer
-		return nil
+// Getter for additional properties for CreateAccessRuleTarget_MetadataFilter. Returns the specified
+// element and whether it was found
+func (a CreateAccessRuleTarget_MetadataFilter) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
 	}
+	return
 }
 
-// WithRequestEditorFn allows setting up a callback function, which will be
-// called right before sending the request. This can be used to mutate the request.
-func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
-	return func(c *Client) error {
-		c.RequestEditors = append(c.RequestEditors, fn)
-		return nil
+// Setter for additional properties for CreateAccessRuleTarget_MetadataFilter
+func (a *CreateAccessRuleTarget_MetadataFilter) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
 	}
+	a.AdditionalProperties[fieldName] = value
 }
 
-// The interface specification for the client above.
-type ClientInterface interface {
-	// UserListAccessTemplates request
-	UserListAccessTemplates(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+// Override default JSON handling for CreateAccessRuleTarget_MetadataFilter to handle AdditionalProperties
+func (a *CreateAccessRuleTarget_MetadataFilter) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
 
-	// AdminListAccessRules request
-	AdminListAccessRules(ctx context.Context, params *AdminListAccessRulesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
 
-	// AdminCreateAccessRule request with any body
-	AdminCreateAccessRuleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// Override default JSON handling for CreateAccessRuleTarget_MetadataFilter to handle AdditionalProperties
+func (a CreateAccessRuleTarget_MetadataFilter) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
 
-	AdminCreateAccessRule(ctx context.Context, body AdminCreateAccessRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
 
-	// AdminDeleteAccessRule request
-	AdminDeleteAccessRule(ctx context.Context, ruleId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+// Getter for additional properties for RequestAccessGroupTarget_Metadata. Returns the specified
+// element and whether it was found
+func (a RequestAccessGroupTarget_Metadata) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
 
-	// AdminGetAccessRule request
-	AdminGetAccessRule(ctx context.Context, ruleId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+// Setter for additional properties for RequestAccessGroupTarget_Metadata
+func (a *RequestAccessGroupTarget_Metadata) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
 
-	// AdminUpdateAccessRule request with any body
-	AdminUpdateAccessRuleWithBody(ctx context.Context, ruleId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// Override default JSON handling for RequestAccessGroupTarget_Metadata to handle AdditionalProperties
+func (a *RequestAccessGroupTarget_Metadata) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
 
-	AdminUpdateAccessRule(ctx context.Context, ruleId string, body AdminUpdateAccessRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
 
-	// AdminGetDeploymentVersion request
-	AdminGetDeploymentVersion(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+// Override default JSON handling for RequestAccessGroupTarget_Metadata to handle AdditionalProperties
+func (a RequestAccessGroupTarget_Metadata) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
 
-	// AdminListGroups request
-	AdminListGroups(ctx context.Context, params *AdminListGroupsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
 
-	// AdminCreateGroup request with any body
-	AdminCreateGroupWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// Getter for additional properties for Target_Metadata. Returns the specified
+// element and whether it was found
+func (a Target_Metadata) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
 
-	AdminCreateGroup(ctx context.Context, body AdminCreateGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// Setter for additional properties for Target_Metadata
+func (a *Target_Metadata) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
 
-	// AdminDeleteGroup request
-	AdminDeleteGroup(ctx context.Context, groupId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+// Override default JSON handling for Target_Metadata to handle AdditionalProperties
+func (a *Target_Metadata) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
 
-	// AdminGetGroup request
-	AdminGetGroup(ctx context.Context, groupId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
 
-	// AdminUpdateGroup request with any body
-	AdminUpdateGroupWithBody(ctx context.Context, groupId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// Override default JSON handling for Target_Metadata to handle AdditionalProperties
+func (a Target_Metadata) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
 
-	AdminUpdateGroup(ctx context.Context, groupId string, body AdminUpdateGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
 
-	// AdminListHandlers request
-	AdminListHandlers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+// Getter for additional properties for TargetFieldPatterns. Returns the specified
+// element and whether it was found
+func (a TargetFieldPatterns) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
 
-	// AdminRegisterHandler request with any body
-	AdminRegisterHandlerWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// Setter for additional properties for TargetFieldPatterns
+func (a *TargetFieldPatterns) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
 
-	AdminRegisterHandler(ctx context.Context, body AdminRegisterHandlerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// Override default JSON handling for TargetFieldPatterns to handle AdditionalProperties
+func (a *TargetFieldPatterns) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
 
-	// AdminDeleteHandler request
-	AdminDeleteHandler(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
 
-	// AdminGetHandler request
-	AdminGetHandler(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+// Override default JSON handling for TargetFieldPatterns to handle AdditionalProperties
+func (a TargetFieldPatterns) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
 
-	// AdminHealthcheckHandlers request
-	AdminHealthcheckHandlers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
 
-	// AdminGetIdentityConfiguration request
-	AdminGetIdentityConfiguration(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+// Getter for additional properties for TargetGroupSchema. Returns the specified
+// element and whether it was found
+func (a TargetGroupSchema) Get(fieldName string) (value TargetGroupSchemaArgument, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
 
-	// AdminSyncIdentity request
-	AdminSyncIdentity(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+// Setter for additional properties for TargetGroupSchema
+func (a *TargetGroupSchema) Set(fieldName string, value TargetGroupSchemaArgument) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]TargetGroupSchemaArgument)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
 
-	// AdminListRequests request
-	AdminListRequests(ctx context.Context, params *AdminListRequestsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+// Override default JSON handling for TargetGroupSchema to handle AdditionalProperties
+func (a *TargetGroupSchema) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
 
-	// AdminListTargetGroups request
-	AdminListTargetGroups(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]TargetGroupSchemaArgument)
+		for fieldName, fieldBuf := range object {
+			var fieldVal TargetGroupSchemaArgument
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
 
-	// AdminCreateTargetGroup request with any body
-	AdminCreateTargetGroupWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// Override default JSON handling for TargetGroupSchema to handle AdditionalProperties
+func (a TargetGroupSchema) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
 
-	AdminCreateTargetGroup(ctx context.Context, body AdminCreateTargetGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
 
-	// AdminDeleteTargetGroup request
-	AdminDeleteTargetGroup(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+// Getter for additional properties for TargetGroupSyncStatus_ResourceCounts. Returns the specified
+// element and whether it was found
+func (a TargetGroupSyncStatus_ResourceCounts) Get(fieldName string) (value int, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
 
-	// AdminGetTargetGroup request
-	AdminGetTargetGroup(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+// Setter for additional properties for TargetGroupSyncStatus_ResourceCounts
+func (a *TargetGroupSyncStatus_ResourceCounts) Set(fieldName string, value int) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]int)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
 
-	// AdminCreateTargetGroupLink request with any body
-	AdminCreateTargetGroupLinkWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// Override default JSON handling for TargetGroupSyncStatus_ResourceCounts to handle AdditionalProperties
+func (a *TargetGroupSyncStatus_ResourceCounts) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
 
-	AdminCreateTargetGroupLink(ctx context.Context, id string, body AdminCreateTargetGroupLinkJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]int)
+		for fieldName, fieldBuf := range object {
+			var fieldVal int
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
 
-	// AdminGetTargetGroupResources request
-	AdminGetTargetGroupResources(ctx context.Context, id string, resourceType string, reqEditors ...RequestEditorFn) (*http.Response, error)
+// Override default JSON handling for TargetGroupSyncStatus_ResourceCounts to handle AdditionalProperties
+func (a TargetGroupSyncStatus_ResourceCounts) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
 
-	// AdminFilterTargetGroupResources request with any body
-	AdminFilterTargetGroupResourcesWithBody(ctx context.Context, id string, resourceType string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
 
-	AdminFilterTargetGroupResources(ctx context.Context, id string, resourceType string, body AdminFilterTargetGroupResourcesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// Getter for additional properties for TargetTags. Returns the specified
+// element and whether it was found
+func (a TargetTags) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
 
-	// AdminListTargetRoutes request
-	AdminListTargetRoutes(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+// Setter for additional properties for TargetTags
+func (a *TargetTags) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
 
-	// AdminRemoveTargetGroupLink request
-	AdminRemoveTargetGroupLink(ctx context.Context, id string, params *AdminRemoveTargetGroupLinkParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+// Override default JSON handling for TargetTags to handle AdditionalProperties
+func (a *TargetTags) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
 
-	// AdminListUsers request
-	AdminListUsers(ctx context.Context, params *AdminListUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
 
-	// AdminCreateUser request with any body
-	AdminCreateUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// Override default JSON handling for TargetTags to handle AdditionalProperties
+func (a TargetTags) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
 
-	AdminCreateUser(ctx context.Context, body AdminCreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
 
-	// AdminUpdateUser request with any body
-	AdminUpdateUserWithBody(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
-	AdminUpdateUser(ctx context.Context, userId string, body AdminUpdateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
 
-	// UserListEntitlements request
-	UserListEntitlements(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
 
-	// UserListEntitlementTargets request
-	UserListEntitlementTargets(ctx context.Context, params *UserListEntitlementTargetsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
 
-	// UserRequestPreflight request with any body
-	UserRequestPreflightWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
 
-	UserRequestPreflight(ctx context.Context, body UserRequestPreflightJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
 
-	// UserGetPreflight request
-	UserGetPreflight(ctx context.Context, preflightId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
 
-	// UserListRequests request
-	UserListRequests(ctx context.Context, params *UserListRequestsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
 
-	// UserPostRequests request with any body
-	UserPostRequestsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
 
-	UserPostRequests(ctx context.Context, body UserPostRequestsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// The interface specification for the client above.
+type ClientInterface interface {
+	// UserListAccessTemplates request
+	UserListAccessTemplates(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// UserGetRequest request
-	UserGetRequest(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// AdminListAccessRules request
+	AdminListAccessRules(ctx context.Context, params *AdminListAccessRulesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// UserListRequestEvents request
-	UserListRequestEvents(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// AdminCreateAccessRule request with any body
+	AdminCreateAccessRuleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// UserReviewRequest request with any body
-	UserReviewRequestWithBody(ctx context.Context, requestId string, groupId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	AdminCreateAccessRule(ctx context.Context, body AdminCreateAccessRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	UserReviewRequest(ctx context.Context, requestId string, groupId string, body UserReviewRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// AdminDeleteAccessRule request
+	AdminDeleteAccessRule(ctx context.Context, ruleId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// UserCancelRequest request
-	UserCancelRequest(ctx context.Context, requestid string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// AdminGetAccessRule request
+	AdminGetAccessRule(ctx context.Context, ruleId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// UserRevokeRequest request
-	UserRevokeRequest(ctx context.Context, requestid string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// AdminUpdateAccessRule request with any body
+	AdminUpdateAccessRuleWithBody(ctx context.Context, ruleId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// UserListReviews request
-	UserListReviews(ctx context.Context, params *UserListReviewsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+	AdminUpdateAccessRule(ctx context.Context, ruleId string, body AdminUpdateAccessRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// GetGroupTargetInstructions request
-	GetGroupTargetInstructions(ctx context.Context, targetId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// AdminListBulkRevokeJobs request
+	AdminListBulkRevokeJobs(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// UserGetMe request
-	UserGetMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// AdminCreateBulkRevokeJob request with any body
+	AdminCreateBulkRevokeJobWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// UserGetUser request
-	UserGetUser(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*http.Response, error)
-}
+	AdminCreateBulkRevokeJob(ctx context.Context, body AdminCreateBulkRevokeJobJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-func (c *Client) UserListAccessTemplates(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserListAccessTemplatesRequest(c.Server)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
+	// AdminGetBulkRevokeJob request
+	AdminGetBulkRevokeJob(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminListDeadLetterEvents request
+	AdminListDeadLetterEvents(ctx context.Context, params *AdminListDeadLetterEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminDiscardDeadLetterEvent request
+	AdminDiscardDeadLetterEvent(ctx context.Context, eventId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminGetDeadLetterEvent request
+	AdminGetDeadLetterEvent(ctx context.Context, eventId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminReplayDeadLetterEvent request
+	AdminReplayDeadLetterEvent(ctx context.Context, eventId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminGetDeploymentVersion request
+	AdminGetDeploymentVersion(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminListGrantDrift request
+	AdminListGrantDrift(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminListEventSchemas request
+	AdminListEventSchemas(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminGetEventSchema request
+	AdminGetEventSchema(ctx context.Context, eventType string, params *AdminGetEventSchemaParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminListFailedGrants request
+	AdminListFailedGrants(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminCompleteFailedGrant request
+	AdminCompleteFailedGrant(ctx context.Context, grantId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminRetryFailedGrant request
+	AdminRetryFailedGrant(ctx context.Context, grantId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminListFreezes request
+	AdminListFreezes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminCreateFreeze request with any body
+	AdminCreateFreezeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AdminCreateFreeze(ctx context.Context, body AdminCreateFreezeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminGetFreeze request
+	AdminGetFreeze(ctx context.Context, freezeId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminUpdateFreeze request with any body
+	AdminUpdateFreezeWithBody(ctx context.Context, freezeId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AdminUpdateFreeze(ctx context.Context, freezeId string, body AdminUpdateFreezeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminListFreezeHistory request
+	AdminListFreezeHistory(ctx context.Context, freezeId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminLiftFreeze request
+	AdminLiftFreeze(ctx context.Context, freezeId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminListGroups request
+	AdminListGroups(ctx context.Context, params *AdminListGroupsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminCreateGroup request with any body
+	AdminCreateGroupWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AdminCreateGroup(ctx context.Context, body AdminCreateGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminDeleteGroup request
+	AdminDeleteGroup(ctx context.Context, groupId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminGetGroup request
+	AdminGetGroup(ctx context.Context, groupId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminUpdateGroup request with any body
+	AdminUpdateGroupWithBody(ctx context.Context, groupId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AdminUpdateGroup(ctx context.Context, groupId string, body AdminUpdateGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminListHandlers request
+	AdminListHandlers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminRegisterHandler request with any body
+	AdminRegisterHandlerWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AdminRegisterHandler(ctx context.Context, body AdminRegisterHandlerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminDeleteHandler request
+	AdminDeleteHandler(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminGetHandler request
+	AdminGetHandler(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminHealthcheckHandlers request
+	AdminHealthcheckHandlers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminGetIdentityConfiguration request
+	AdminGetIdentityConfiguration(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminSyncIdentity request
+	AdminSyncIdentity(ctx context.Context, params *AdminSyncIdentityParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminListIdentitySyncRuns request
+	AdminListIdentitySyncRuns(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminListRequests request
+	AdminListRequests(ctx context.Context, params *AdminListRequestsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminReplayRequestEvents request
+	AdminReplayRequestEvents(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminListTargetGroups request
+	AdminListTargetGroups(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminCreateTargetGroup request with any body
+	AdminCreateTargetGroupWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AdminCreateTargetGroup(ctx context.Context, body AdminCreateTargetGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminDeleteTargetGroup request
+	AdminDeleteTargetGroup(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminGetTargetGroup request
+	AdminGetTargetGroup(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminCreateTargetGroupLink request with any body
+	AdminCreateTargetGroupLinkWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AdminCreateTargetGroupLink(ctx context.Context, id string, body AdminCreateTargetGroupLinkJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminGetTargetGroupResources request
+	AdminGetTargetGroupResources(ctx context.Context, id string, resourceType string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminFilterTargetGroupResources request with any body
+	AdminFilterTargetGroupResourcesWithBody(ctx context.Context, id string, resourceType string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AdminFilterTargetGroupResources(ctx context.Context, id string, resourceType string, body AdminFilterTargetGroupResourcesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminListTargetRoutes request
+	AdminListTargetRoutes(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminSyncTargetGroup request
+	AdminSyncTargetGroup(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminRemoveTargetGroupLink request
+	AdminRemoveTargetGroupLink(ctx context.Context, id string, params *AdminRemoveTargetGroupLinkParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminListTargetMetadata request
+	AdminListTargetMetadata(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminCreateTargetMetadata request with any body
+	AdminCreateTargetMetadataWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AdminCreateTargetMetadata(ctx context.Context, body AdminCreateTargetMetadataJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminDeleteTargetMetadata request
+	AdminDeleteTargetMetadata(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminUpdateTargetMetadata request with any body
+	AdminUpdateTargetMetadataWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AdminUpdateTargetMetadata(ctx context.Context, id string, body AdminUpdateTargetMetadataJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminListUsers request
+	AdminListUsers(ctx context.Context, params *AdminListUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminCreateUser request with any body
+	AdminCreateUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AdminCreateUser(ctx context.Context, body AdminCreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminUpdateUser request with any body
+	AdminUpdateUserWithBody(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AdminUpdateUser(ctx context.Context, userId string, body AdminUpdateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminListUserGroupMemberships request
+	AdminListUserGroupMemberships(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserListEntitlements request
+	UserListEntitlements(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserListEntitlementTargets request
+	UserListEntitlementTargets(ctx context.Context, params *UserListEntitlementTargetsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserListFavorites request
+	UserListFavorites(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserCreateFavorite request with any body
+	UserCreateFavoriteWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UserCreateFavorite(ctx context.Context, body UserCreateFavoriteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserRequestFavorites request with any body
+	UserRequestFavoritesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UserRequestFavorites(ctx context.Context, body UserRequestFavoritesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserDeleteFavorite request
+	UserDeleteFavorite(ctx context.Context, targetId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserRequestPreflight request with any body
+	UserRequestPreflightWithBody(ctx context.Context, params *UserRequestPreflightParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UserRequestPreflight(ctx context.Context, params *UserRequestPreflightParams, body UserRequestPreflightJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserGetPreflight request
+	UserGetPreflight(ctx context.Context, preflightId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserListRequests request
+	UserListRequests(ctx context.Context, params *UserListRequestsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserPostRequests request with any body
+	UserPostRequestsWithBody(ctx context.Context, params *UserPostRequestsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UserPostRequests(ctx context.Context, params *UserPostRequestsParams, body UserPostRequestsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserGetRequest request
+	UserGetRequest(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserListRequestEvents request
+	UserListRequestEvents(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserReviewRequest request with any body
+	UserReviewRequestWithBody(ctx context.Context, requestId string, groupId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UserReviewRequest(ctx context.Context, requestId string, groupId string, body UserReviewRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserCancelRequest request
+	UserCancelRequest(ctx context.Context, requestid string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserActivateRequestGroup request
+	UserActivateRequestGroup(ctx context.Context, requestid string, groupid string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserRevokeRequestTarget request
+	UserRevokeRequestTarget(ctx context.Context, requestid string, groupid string, targetid string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserRevokeRequest request
+	UserRevokeRequest(ctx context.Context, requestid string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserListReviews request
+	UserListReviews(ctx context.Context, params *UserListReviewsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetGroupTargetInstructions request
+	GetGroupTargetInstructions(ctx context.Context, targetId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserGetMe request
+	UserGetMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserGetUser request
+	UserGetUser(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) UserListAccessTemplates(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserListAccessTemplatesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
@@ -1307,8 +2474,8 @@ func (c *Client) AdminUpdateAccessRule(ctx context.Context, ruleId string, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) AdminGetDeploymentVersion(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAdminGetDeploymentVersionRequest(c.Server)
+func (c *Client) AdminListBulkRevokeJobs(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminListBulkRevokeJobsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1319,8 +2486,8 @@ func (c *Client) AdminGetDeploymentVersion(ctx context.Context, reqEditors ...Re
 	return c.Client.Do(req)
 }
 
-func (c *Client) AdminListGroups(ctx context.Context, params *AdminListGroupsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAdminListGroupsRequest(c.Server, params)
+func (c *Client) AdminCreateBulkRevokeJobWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminCreateBulkRevokeJobRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1331,8 +2498,8 @@ func (c *Client) AdminListGroups(ctx context.Context, params *AdminListGroupsPar
 	return c.Client.Do(req)
 }
 
-func (c *Client) AdminCreateGroupWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAdminCreateGroupRequestWithBody(c.Server, contentType, body)
+func (c *Client) AdminCreateBulkRevokeJob(ctx context.Context, body AdminCreateBulkRevokeJobJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminCreateBulkRevokeJobRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1343,8 +2510,8 @@ func (c *Client) AdminCreateGroupWithBody(ctx context.Context, contentType strin
 	return c.Client.Do(req)
 }
 
-func (c *Client) AdminCreateGroup(ctx context.Context, body AdminCreateGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAdminCreateGroupRequest(c.Server, body)
+func (c *Client) AdminGetBulkRevokeJob(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminGetBulkRevokeJobRequest(c.Server, jobId)
 	if err != nil {
 		return nil, err
 	}
@@ -1355,8 +2522,8 @@ func (c *Client) AdminCreateGroup(ctx context.Context, body AdminCreateGroupJSON
 	return c.Client.Do(req)
 }
 
-func (c *Client) AdminDeleteGroup(ctx context.Context, groupId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAdminDeleteGroupRequest(c.Server, groupId)
+func (c *Client) AdminListDeadLetterEvents(ctx context.Context, params *AdminListDeadLetterEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminListDeadLetterEventsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1367,8 +2534,8 @@ func (c *Client) AdminDeleteGroup(ctx context.Context, groupId string, reqEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) AdminGetGroup(ctx context.Context, groupId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAdminGetGroupRequest(c.Server, groupId)
+func (c *Client) AdminDiscardDeadLetterEvent(ctx context.Context, eventId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminDiscardDeadLetterEventRequest(c.Server, eventId)
 	if err != nil {
 		return nil, err
 	}
@@ -1379,8 +2546,8 @@ func (c *Client) AdminGetGroup(ctx context.Context, groupId string, reqEditors .
 	return c.Client.Do(req)
 }
 
-func (c *Client) AdminUpdateGroupWithBody(ctx context.Context, groupId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAdminUpdateGroupRequestWithBody(c.Server, groupId, contentType, body)
+func (c *Client) AdminGetDeadLetterEvent(ctx context.Context, eventId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminGetDeadLetterEventRequest(c.Server, eventId)
 	if err != nil {
 		return nil, err
 	}
@@ -1391,8 +2558,8 @@ func (c *Client) AdminUpdateGroupWithBody(ctx context.Context, groupId string, c
 	return c.Client.Do(req)
 }
 
-func (c *Client) AdminUpdateGroup(ctx context.Context, groupId string, body AdminUpdateGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAdminUpdateGroupRequest(c.Server, groupId, body)
+func (c *Client) AdminReplayDeadLetterEvent(ctx context.Context, eventId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminReplayDeadLetterEventRequest(c.Server, eventId)
 	if err != nil {
 		return nil, err
 	}
@@ -1403,8 +2570,8 @@ func (c *Client) AdminUpdateGroup(ctx context.Context, groupId string, body Admi
 	return c.Client.Do(req)
 }
 
-func (c *Client) AdminListHandlers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAdminListHandlersRequest(c.Server)
+func (c *Client) AdminGetDeploymentVersion(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminGetDeploymentVersionRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1415,8 +2582,8 @@ func (c *Client) AdminListHandlers(ctx context.Context, reqEditors ...RequestEdi
 	return c.Client.Do(req)
 }
 
-func (c *Client) AdminRegisterHandlerWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAdminRegisterHandlerRequestWithBody(c.Server, contentType, body)
+func (c *Client) AdminListGrantDrift(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminListGrantDriftRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1427,8 +2594,8 @@ func (c *Client) AdminRegisterHandlerWithBody(ctx context.Context, contentType s
 	return c.Client.Do(req)
 }
 
-func (c *Client) AdminRegisterHandler(ctx context.Context, body AdminRegisterHandlerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAdminRegisterHandlerRequest(c.Server, body)
+func (c *Client) AdminListEventSchemas(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminListEventSchemasRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1439,8 +2606,8 @@ func (c *Client) AdminRegisterHandler(ctx context.Context, body AdminRegisterHan
 	return c.Client.Do(req)
 }
 
-func (c *Client) AdminDeleteHandler(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAdminDeleteHandlerRequest(c.Server, id)
+func (c *Client) AdminGetEventSchema(ctx context.Context, eventType string, params *AdminGetEventSchemaParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminGetEventSchemaRequest(c.Server, eventType, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1451,8 +2618,8 @@ func (c *Client) AdminDeleteHandler(ctx context.Context, id string, reqEditors .
 	return c.Client.Do(req)
 }
 
-func (c *Client) AdminGetHandler(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAdminGetHandlerRequest(c.Server, id)
+func (c *Client) AdminListFailedGrants(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminListFailedGrantsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1463,8 +2630,8 @@ func (c *Client) AdminGetHandler(ctx context.Context, id string, reqEditors ...R
 	return c.Client.Do(req)
 }
 
-func (c *Client) AdminHealthcheckHandlers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAdminHealthcheckHandlersRequest(c.Server)
+func (c *Client) AdminCompleteFailedGrant(ctx context.Context, grantId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminCompleteFailedGrantRequest(c.Server, grantId)
 	if err != nil {
 		return nil, err
 	}
@@ -1475,8 +2642,8 @@ func (c *Client) AdminHealthcheckHandlers(ctx context.Context, reqEditors ...Req
 	return c.Client.Do(req)
 }
 
-func (c *Client) AdminGetIdentityConfiguration(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAdminGetIdentityConfigurationRequest(c.Server)
+func (c *Client) AdminRetryFailedGrant(ctx context.Context, grantId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminRetryFailedGrantRequest(c.Server, grantId)
 	if err != nil {
 		return nil, err
 	}
@@ -1487,8 +2654,8 @@ func (c *Client) AdminGetIdentityConfiguration(ctx context.Context, reqEditors .
 	return c.Client.Do(req)
 }
 
-func (c *Client) AdminSyncIdentity(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAdminSyncIdentityRequest(c.Server)
+func (c *Client) AdminListFreezes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminListFreezesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1499,8 +2666,8 @@ func (c *Client) AdminSyncIdentity(ctx context.Context, reqEditors ...RequestEdi
 	return c.Client.Do(req)
 }
 
-func (c *Client) AdminListRequests(ctx context.Context, params *AdminListRequestsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAdminListRequestsRequest(c.Server, params)
+func (c *Client) AdminCreateFreezeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminCreateFreezeRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1511,8 +2678,8 @@ func (c *Client) AdminListRequests(ctx context.Context, params *AdminListRequest
 	return c.Client.Do(req)
 }
 
-func (c *Client) AdminListTargetGroups(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAdminListTargetGroupsRequest(c.Server)
+func (c *Client) AdminCreateFreeze(ctx context.Context, body AdminCreateFreezeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminCreateFreezeRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1523,8 +2690,8 @@ func (c *Client) AdminListTargetGroups(ctx context.Context, reqEditors ...Reques
 	return c.Client.Do(req)
 }
 
-func (c *Client) AdminCreateTargetGroupWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAdminCreateTargetGroupRequestWithBody(c.Server, contentType, body)
+func (c *Client) AdminGetFreeze(ctx context.Context, freezeId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminGetFreezeRequest(c.Server, freezeId)
 	if err != nil {
 		return nil, err
 	}
@@ -1535,8 +2702,8 @@ func (c *Client) AdminCreateTargetGroupWithBody(ctx context.Context, contentType
 	return c.Client.Do(req)
 }
 
-func (c *Client) AdminCreateTargetGroup(ctx context.Context, body AdminCreateTargetGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAdminCreateTargetGroupRequest(c.Server, body)
+func (c *Client) AdminUpdateFreezeWithBody(ctx context.Context, freezeId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminUpdateFreezeRequestWithBody(c.Server, freezeId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1547,8 +2714,8 @@ func (c *Client) AdminCreateTargetGroup(ctx context.Context, body AdminCreateTar
 	return c.Client.Do(req)
 }
 
-func (c *Client) AdminDeleteTargetGroup(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAdminDeleteTargetGroupRequest(c.Server, id)
+func (c *Client) AdminUpdateFreeze(ctx context.Context, freezeId string, body AdminUpdateFreezeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminUpdateFreezeRequest(c.Server, freezeId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1559,8 +2726,8 @@ func (c *Client) AdminDeleteTargetGroup(ctx context.Context, id string, reqEdito
 	return c.Client.Do(req)
 }
 
-func (c *Client) AdminGetTargetGroup(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAdminGetTargetGroupRequest(c.Server, id)
+func (c *Client) AdminListFreezeHistory(ctx context.Context, freezeId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminListFreezeHistoryRequest(c.Server, freezeId)
 	if err != nil {
 		return nil, err
 	}
@@ -1571,8 +2738,8 @@ func (c *Client) AdminGetTargetGroup(ctx context.Context, id string, reqEditors
 	return c.Client.Do(req)
 }
 
-func (c *Client) AdminCreateTargetGroupLinkWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAdminCreateTargetGroupLinkRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) AdminLiftFreeze(ctx context.Context, freezeId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminLiftFreezeRequest(c.Server, freezeId)
 	if err != nil {
 		return nil, err
 	}
@@ -1583,8 +2750,8 @@ func (c *Client) AdminCreateTargetGroupLinkWithBody(ctx context.Context, id stri
 	return c.Client.Do(req)
 }
 
-func (c *Client) AdminCreateTargetGroupLink(ctx context.Context, id string, body AdminCreateTargetGroupLinkJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAdminCreateTargetGroupLinkRequest(c.Server, id, body)
+func (c *Client) AdminListGroups(ctx context.Context, params *AdminListGroupsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminListGroupsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1595,8 +2762,8 @@ func (c *Client) AdminCreateTargetGroupLink(ctx context.Context, id string, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) AdminGetTargetGroupResources(ctx context.Context, id string, resourceType string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAdminGetTargetGroupResourcesRequest(c.Server, id, resourceType)
+func (c *Client) AdminCreateGroupWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminCreateGroupRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1607,8 +2774,8 @@ func (c *Client) AdminGetTargetGroupResources(ctx context.Context, id string, re
 	return c.Client.Do(req)
 }
 
-func (c *Client) AdminFilterTargetGroupResourcesWithBody(ctx context.Context, id string, resourceType string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAdminFilterTargetGroupResourcesRequestWithBody(c.Server, id, resourceType, contentType, body)
+func (c *Client) AdminCreateGroup(ctx context.Context, body AdminCreateGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminCreateGroupRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1619,8 +2786,8 @@ func (c *Client) AdminFilterTargetGroupResourcesWithBody(ctx context.Context, id
 	return c.Client.Do(req)
 }
 
-func (c *Client) AdminFilterTargetGroupResources(ctx context.Context, id string, resourceType string, body AdminFilterTargetGroupResourcesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAdminFilterTargetGroupResourcesRequest(c.Server, id, resourceType, body)
+func (c *Client) AdminDeleteGroup(ctx context.Context, groupId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminDeleteGroupRequest(c.Server, groupId)
 	if err != nil {
 		return nil, err
 	}
@@ -1631,8 +2798,8 @@ func (c *Client) AdminFilterTargetGroupResources(ctx context.Context, id string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) AdminListTargetRoutes(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAdminListTargetRoutesRequest(c.Server, id)
+func (c *Client) AdminGetGroup(ctx context.Context, groupId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminGetGroupRequest(c.Server, groupId)
 	if err != nil {
 		return nil, err
 	}
@@ -1643,8 +2810,8 @@ func (c *Client) AdminListTargetRoutes(ctx context.Context, id string, reqEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) AdminRemoveTargetGroupLink(ctx context.Context, id string, params *AdminRemoveTargetGroupLinkParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAdminRemoveTargetGroupLinkRequest(c.Server, id, params)
+func (c *Client) AdminUpdateGroupWithBody(ctx context.Context, groupId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminUpdateGroupRequestWithBody(c.Server, groupId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1655,8 +2822,8 @@ func (c *Client) AdminRemoveTargetGroupLink(ctx context.Context, id string, para
 	return c.Client.Do(req)
 }
 
-func (c *Client) AdminListUsers(ctx context.Context, params *AdminListUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAdminListUsersRequest(c.Server, params)
+func (c *Client) AdminUpdateGroup(ctx context.Context, groupId string, body AdminUpdateGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminUpdateGroupRequest(c.Server, groupId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1667,8 +2834,8 @@ func (c *Client) AdminListUsers(ctx context.Context, params *AdminListUsersParam
 	return c.Client.Do(req)
 }
 
-func (c *Client) AdminCreateUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAdminCreateUserRequestWithBody(c.Server, contentType, body)
+func (c *Client) AdminListHandlers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminListHandlersRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1679,8 +2846,8 @@ func (c *Client) AdminCreateUserWithBody(ctx context.Context, contentType string
 	return c.Client.Do(req)
 }
 
-func (c *Client) AdminCreateUser(ctx context.Context, body AdminCreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAdminCreateUserRequest(c.Server, body)
+func (c *Client) AdminRegisterHandlerWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminRegisterHandlerRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1691,8 +2858,8 @@ func (c *Client) AdminCreateUser(ctx context.Context, body AdminCreateUserJSONRe
 	return c.Client.Do(req)
 }
 
-func (c *Client) AdminUpdateUserWithBody(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAdminUpdateUserRequestWithBody(c.Server, userId, contentType, body)
+func (c *Client) AdminRegisterHandler(ctx context.Context, body AdminRegisterHandlerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminRegisterHandlerRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1703,8 +2870,8 @@ func (c *Client) AdminUpdateUserWithBody(ctx context.Context, userId string, con
 	return c.Client.Do(req)
 }
 
-func (c *Client) AdminUpdateUser(ctx context.Context, userId string, body AdminUpdateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAdminUpdateUserRequest(c.Server, userId, body)
+func (c *Client) AdminDeleteHandler(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminDeleteHandlerRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1715,8 +2882,8 @@ func (c *Client) AdminUpdateUser(ctx context.Context, userId string, body AdminU
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserListEntitlements(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserListEntitlementsRequest(c.Server)
+func (c *Client) AdminGetHandler(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminGetHandlerRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1727,8 +2894,8 @@ func (c *Client) UserListEntitlements(ctx context.Context, reqEditors ...Request
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserListEntitlementTargets(ctx context.Context, params *UserListEntitlementTargetsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserListEntitlementTargetsRequest(c.Server, params)
+func (c *Client) AdminHealthcheckHandlers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminHealthcheckHandlersRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1739,8 +2906,8 @@ func (c *Client) UserListEntitlementTargets(ctx context.Context, params *UserLis
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserRequestPreflightWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserRequestPreflightRequestWithBody(c.Server, contentType, body)
+func (c *Client) AdminGetIdentityConfiguration(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminGetIdentityConfigurationRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1751,8 +2918,8 @@ func (c *Client) UserRequestPreflightWithBody(ctx context.Context, contentType s
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserRequestPreflight(ctx context.Context, body UserRequestPreflightJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserRequestPreflightRequest(c.Server, body)
+func (c *Client) AdminSyncIdentity(ctx context.Context, params *AdminSyncIdentityParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminSyncIdentityRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1763,8 +2930,8 @@ func (c *Client) UserRequestPreflight(ctx context.Context, body UserRequestPrefl
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserGetPreflight(ctx context.Context, preflightId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserGetPreflightRequest(c.Server, preflightId)
+func (c *Client) AdminListIdentitySyncRuns(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminListIdentitySyncRunsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1775,8 +2942,8 @@ func (c *Client) UserGetPreflight(ctx context.Context, preflightId string, reqEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserListRequests(ctx context.Context, params *UserListRequestsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserListRequestsRequest(c.Server, params)
+func (c *Client) AdminListRequests(ctx context.Context, params *AdminListRequestsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminListRequestsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1787,8 +2954,8 @@ func (c *Client) UserListRequests(ctx context.Context, params *UserListRequestsP
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserPostRequestsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserPostRequestsRequestWithBody(c.Server, contentType, body)
+func (c *Client) AdminReplayRequestEvents(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminReplayRequestEventsRequest(c.Server, requestId)
 	if err != nil {
 		return nil, err
 	}
@@ -1799,8 +2966,8 @@ func (c *Client) UserPostRequestsWithBody(ctx context.Context, contentType strin
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserPostRequests(ctx context.Context, body UserPostRequestsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserPostRequestsRequest(c.Server, body)
+func (c *Client) AdminListTargetGroups(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminListTargetGroupsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1811,8 +2978,8 @@ func (c *Client) UserPostRequests(ctx context.Context, body UserPostRequestsJSON
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserGetRequest(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserGetRequestRequest(c.Server, requestId)
+func (c *Client) AdminCreateTargetGroupWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminCreateTargetGroupRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1823,8 +2990,8 @@ func (c *Client) UserGetRequest(ctx context.Context, requestId string, reqEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserListRequestEvents(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserListRequestEventsRequest(c.Server, requestId)
+func (c *Client) AdminCreateTargetGroup(ctx context.Context, body AdminCreateTargetGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminCreateTargetGroupRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1835,8 +3002,8 @@ func (c *Client) UserListRequestEvents(ctx context.Context, requestId string, re
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserReviewRequestWithBody(ctx context.Context, requestId string, groupId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserReviewRequestRequestWithBody(c.Server, requestId, groupId, contentType, body)
+func (c *Client) AdminDeleteTargetGroup(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminDeleteTargetGroupRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1847,8 +3014,8 @@ func (c *Client) UserReviewRequestWithBody(ctx context.Context, requestId string
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserReviewRequest(ctx context.Context, requestId string, groupId string, body UserReviewRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserReviewRequestRequest(c.Server, requestId, groupId, body)
+func (c *Client) AdminGetTargetGroup(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminGetTargetGroupRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1859,8 +3026,8 @@ func (c *Client) UserReviewRequest(ctx context.Context, requestId string, groupI
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserCancelRequest(ctx context.Context, requestid string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserCancelRequestRequest(c.Server, requestid)
+func (c *Client) AdminCreateTargetGroupLinkWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminCreateTargetGroupLinkRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1871,8 +3038,8 @@ func (c *Client) UserCancelRequest(ctx context.Context, requestid string, reqEdi
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserRevokeRequest(ctx context.Context, requestid string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserRevokeRequestRequest(c.Server, requestid)
+func (c *Client) AdminCreateTargetGroupLink(ctx context.Context, id string, body AdminCreateTargetGroupLinkJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminCreateTargetGroupLinkRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1883,8 +3050,8 @@ func (c *Client) UserRevokeRequest(ctx context.Context, requestid string, reqEdi
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserListReviews(ctx context.Context, params *UserListReviewsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserListReviewsRequest(c.Server, params)
+func (c *Client) AdminGetTargetGroupResources(ctx context.Context, id string, resourceType string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminGetTargetGroupResourcesRequest(c.Server, id, resourceType)
 	if err != nil {
 		return nil, err
 	}
@@ -1895,8 +3062,8 @@ func (c *Client) UserListReviews(ctx context.Context, params *UserListReviewsPar
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetGroupTargetInstructions(ctx context.Context, targetId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetGroupTargetInstructionsRequest(c.Server, targetId)
+func (c *Client) AdminFilterTargetGroupResourcesWithBody(ctx context.Context, id string, resourceType string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminFilterTargetGroupResourcesRequestWithBody(c.Server, id, resourceType, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1907,8 +3074,8 @@ func (c *Client) GetGroupTargetInstructions(ctx context.Context, targetId string
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserGetMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserGetMeRequest(c.Server)
+func (c *Client) AdminFilterTargetGroupResources(ctx context.Context, id string, resourceType string, body AdminFilterTargetGroupResourcesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminFilterTargetGroupResourcesRequest(c.Server, id, resourceType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1919,8 +3086,8 @@ func (c *Client) UserGetMe(ctx context.Context, reqEditors ...RequestEditorFn) (
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserGetUser(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserGetUserRequest(c.Server, userId)
+func (c *Client) AdminListTargetRoutes(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminListTargetRoutesRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1931,461 +3098,496 @@ func (c *Client) UserGetUser(ctx context.Context, userId string, reqEditors ...R
 	return c.Client.Do(req)
 }
 
-// NewUserListAccessTemplatesRequest generates requests for UserListAccessTemplates
-func NewUserListAccessTemplatesRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) AdminSyncTargetGroup(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminSyncTargetGroupRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/access-templates")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) AdminRemoveTargetGroupLink(ctx context.Context, id string, params *AdminRemoveTargetGroupLinkParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminRemoveTargetGroupLinkRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewAdminListAccessRulesRequest generates requests for AdminListAccessRules
-func NewAdminListAccessRulesRequest(server string, params *AdminListAccessRulesParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) AdminListTargetMetadata(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminListTargetMetadataRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/admin/access-rules")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) AdminCreateTargetMetadataWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminCreateTargetMetadataRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	queryValues := queryURL.Query()
-
-	if params.NextToken != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "nextToken", runtime.ParamLocationQuery, *params.NextToken); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL.RawQuery = queryValues.Encode()
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) AdminCreateTargetMetadata(ctx context.Context, body AdminCreateTargetMetadataJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminCreateTargetMetadataRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewAdminCreateAccessRuleRequest calls the generic AdminCreateAccessRule builder with application/json body
-func NewAdminCreateAccessRuleRequest(server string, body AdminCreateAccessRuleJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) AdminDeleteTargetMetadata(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminDeleteTargetMetadataRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewAdminCreateAccessRuleRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewAdminCreateAccessRuleRequestWithBody generates requests for AdminCreateAccessRule with any type of body
-func NewAdminCreateAccessRuleRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) AdminUpdateTargetMetadataWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminUpdateTargetMetadataRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/admin/access-rules")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) AdminUpdateTargetMetadata(ctx context.Context, id string, body AdminUpdateTargetMetadataJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminUpdateTargetMetadataRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewAdminDeleteAccessRuleRequest generates requests for AdminDeleteAccessRule
-func NewAdminDeleteAccessRuleRequest(server string, ruleId string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ruleId", runtime.ParamLocationPath, ruleId)
+func (c *Client) AdminListUsers(ctx context.Context, params *AdminListUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminListUsersRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) AdminCreateUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminCreateUserRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/admin/access-rules/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) AdminCreateUser(ctx context.Context, body AdminCreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminCreateUserRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewAdminGetAccessRuleRequest generates requests for AdminGetAccessRule
-func NewAdminGetAccessRuleRequest(server string, ruleId string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ruleId", runtime.ParamLocationPath, ruleId)
+func (c *Client) AdminUpdateUserWithBody(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminUpdateUserRequestWithBody(c.Server, userId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) AdminUpdateUser(ctx context.Context, userId string, body AdminUpdateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminUpdateUserRequest(c.Server, userId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/admin/access-rules/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) AdminListUserGroupMemberships(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminListUserGroupMembershipsRequest(c.Server, userId)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewAdminUpdateAccessRuleRequest calls the generic AdminUpdateAccessRule builder with application/json body
-func NewAdminUpdateAccessRuleRequest(server string, ruleId string, body AdminUpdateAccessRuleJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) UserListEntitlements(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserListEntitlementsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewAdminUpdateAccessRuleRequestWithBody(server, ruleId, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewAdminUpdateAccessRuleRequestWithBody generates requests for AdminUpdateAccessRule with any type of body
-func NewAdminUpdateAccessRuleRequestWithBody(server string, ruleId string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ruleId", runtime.ParamLocationPath, ruleId)
+func (c *Client) UserListEntitlementTargets(ctx context.Context, params *UserListEntitlementTargetsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserListEntitlementTargetsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UserListFavorites(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserListFavoritesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/admin/access-rules/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UserCreateFavoriteWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserCreateFavoriteRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewAdminGetDeploymentVersionRequest generates requests for AdminGetDeploymentVersion
-func NewAdminGetDeploymentVersionRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) UserCreateFavorite(ctx context.Context, body UserCreateFavoriteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserCreateFavoriteRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/admin/deployment/version")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UserRequestFavoritesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserRequestFavoritesRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewAdminListGroupsRequest generates requests for AdminListGroups
-func NewAdminListGroupsRequest(server string, params *AdminListGroupsParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) UserRequestFavorites(ctx context.Context, body UserRequestFavoritesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserRequestFavoritesRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/admin/groups")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UserDeleteFavorite(ctx context.Context, targetId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserDeleteFavoriteRequest(c.Server, targetId)
 	if err != nil {
 		return nil, err
 	}
-
-	queryValues := queryURL.Query()
-
-	if params.NextToken != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "nextToken", runtime.ParamLocationQuery, *params.NextToken); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-	}
-
-	if params.Source != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "source", runtime.ParamLocationQuery, *params.Source); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL.RawQuery = queryValues.Encode()
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) UserRequestPreflightWithBody(ctx context.Context, params *UserRequestPreflightParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserRequestPreflightRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewAdminCreateGroupRequest calls the generic AdminCreateGroup builder with application/json body
-func NewAdminCreateGroupRequest(server string, body AdminCreateGroupJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) UserRequestPreflight(ctx context.Context, params *UserRequestPreflightParams, body UserRequestPreflightJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserRequestPreflightRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewAdminCreateGroupRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewAdminCreateGroupRequestWithBody generates requests for AdminCreateGroup with any type of body
-func NewAdminCreateGroupRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) UserGetPreflight(ctx context.Context, preflightId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserGetPreflightRequest(c.Server, preflightId)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/admin/groups")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UserListRequests(ctx context.Context, params *UserListRequestsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserListRequestsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewAdminDeleteGroupRequest generates requests for AdminDeleteGroup
-func NewAdminDeleteGroupRequest(server string, groupId string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+func (c *Client) UserPostRequestsWithBody(ctx context.Context, params *UserPostRequestsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserPostRequestsRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UserPostRequests(ctx context.Context, params *UserPostRequestsParams, body UserPostRequestsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserPostRequestsRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/admin/groups/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UserGetRequest(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserGetRequestRequest(c.Server, requestId)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewAdminGetGroupRequest generates requests for AdminGetGroup
-func NewAdminGetGroupRequest(server string, groupId string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+func (c *Client) UserListRequestEvents(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserListRequestEventsRequest(c.Server, requestId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UserReviewRequestWithBody(ctx context.Context, requestId string, groupId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserReviewRequestRequestWithBody(c.Server, requestId, groupId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/admin/groups/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UserReviewRequest(ctx context.Context, requestId string, groupId string, body UserReviewRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserReviewRequestRequest(c.Server, requestId, groupId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewAdminUpdateGroupRequest calls the generic AdminUpdateGroup builder with application/json body
-func NewAdminUpdateGroupRequest(server string, groupId string, body AdminUpdateGroupJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) UserCancelRequest(ctx context.Context, requestid string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserCancelRequestRequest(c.Server, requestid)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewAdminUpdateGroupRequestWithBody(server, groupId, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewAdminUpdateGroupRequestWithBody generates requests for AdminUpdateGroup with any type of body
-func NewAdminUpdateGroupRequestWithBody(server string, groupId string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+func (c *Client) UserActivateRequestGroup(ctx context.Context, requestid string, groupid string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserActivateRequestGroupRequest(c.Server, requestid, groupid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam0 string
+func (c *Client) UserRevokeRequestTarget(ctx context.Context, requestid string, groupid string, targetid string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserRevokeRequestTargetRequest(c.Server, requestid, groupid, targetid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+func (c *Client) UserRevokeRequest(ctx context.Context, requestid string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserRevokeRequestRequest(c.Server, requestid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserListReviews(ctx context.Context, params *UserListReviewsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserListReviewsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetGroupTargetInstructions(ctx context.Context, targetId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetGroupTargetInstructionsRequest(c.Server, targetId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserGetMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserGetMeRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserGetUser(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserGetUserRequest(c.Server, userId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewUserListAccessTemplatesRequest generates requests for UserListAccessTemplates
+func NewUserListAccessTemplatesRequest(server string) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/admin/groups/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/access-templates")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2395,18 +3597,16 @@ func NewAdminUpdateGroupRequestWithBody(server string, groupId string, contentTy
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewAdminListHandlersRequest generates requests for AdminListHandlers
-func NewAdminListHandlersRequest(server string) (*http.Request, error) {
+// NewAdminListAccessRulesRequest generates requests for AdminListAccessRules
+func NewAdminListAccessRulesRequest(server string, params *AdminListAccessRulesParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2414,7 +3614,7 @@ func NewAdminListHandlersRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/admin/handlers")
+	operationPath := fmt.Sprintf("/api/v1/admin/access-rules")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2424,6 +3624,26 @@ func NewAdminListHandlersRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
+	queryValues := queryURL.Query()
+
+	if params.NextToken != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "nextToken", runtime.ParamLocationQuery, *params.NextToken); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -2432,19 +3652,19 @@ func NewAdminListHandlersRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewAdminRegisterHandlerRequest calls the generic AdminRegisterHandler builder with application/json body
-func NewAdminRegisterHandlerRequest(server string, body AdminRegisterHandlerJSONRequestBody) (*http.Request, error) {
+// NewAdminCreateAccessRuleRequest calls the generic AdminCreateAccessRule builder with application/json body
+func NewAdminCreateAccessRuleRequest(server string, body AdminCreateAccessRuleJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewAdminRegisterHandlerRequestWithBody(server, "application/json", bodyReader)
+	return NewAdminCreateAccessRuleRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewAdminRegisterHandlerRequestWithBody generates requests for AdminRegisterHandler with any type of body
-func NewAdminRegisterHandlerRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewAdminCreateAccessRuleRequestWithBody generates requests for AdminCreateAccessRule with any type of body
+func NewAdminCreateAccessRuleRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2452,7 +3672,7 @@ func NewAdminRegisterHandlerRequestWithBody(server string, contentType string, b
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/admin/handlers")
+	operationPath := fmt.Sprintf("/api/v1/admin/access-rules")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2472,13 +3692,13 @@ func NewAdminRegisterHandlerRequestWithBody(server string, contentType string, b
 	return req, nil
 }
 
-// NewAdminDeleteHandlerRequest generates requests for AdminDeleteHandler
-func NewAdminDeleteHandlerRequest(server string, id string) (*http.Request, error) {
+// NewAdminDeleteAccessRuleRequest generates requests for AdminDeleteAccessRule
+func NewAdminDeleteAccessRuleRequest(server string, ruleId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ruleId", runtime.ParamLocationPath, ruleId)
 	if err != nil {
 		return nil, err
 	}
@@ -2488,7 +3708,7 @@ func NewAdminDeleteHandlerRequest(server string, id string) (*http.Request, erro
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/admin/handlers/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/admin/access-rules/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2506,13 +3726,13 @@ func NewAdminDeleteHandlerRequest(server string, id string) (*http.Request, erro
 	return req, nil
 }
 
-// NewAdminGetHandlerRequest generates requests for AdminGetHandler
-func NewAdminGetHandlerRequest(server string, id string) (*http.Request, error) {
+// NewAdminGetAccessRuleRequest generates requests for AdminGetAccessRule
+func NewAdminGetAccessRuleRequest(server string, ruleId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ruleId", runtime.ParamLocationPath, ruleId)
 	if err != nil {
 		return nil, err
 	}
@@ -2522,7 +3742,7 @@ func NewAdminGetHandlerRequest(server string, id string) (*http.Request, error)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/admin/handlers/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/admin/access-rules/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2540,16 +3760,34 @@ func NewAdminGetHandlerRequest(server string, id string) (*http.Request, error)
 	return req, nil
 }
 
-// NewAdminHealthcheckHandlersRequest generates requests for AdminHealthcheckHandlers
-func NewAdminHealthcheckHandlersRequest(server string) (*http.Request, error) {
+// NewAdminUpdateAccessRuleRequest calls the generic AdminUpdateAccessRule builder with application/json body
+func NewAdminUpdateAccessRuleRequest(server string, ruleId string, body AdminUpdateAccessRuleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAdminUpdateAccessRuleRequestWithBody(server, ruleId, "application/json", bodyReader)
+}
+
+// NewAdminUpdateAccessRuleRequestWithBody generates requests for AdminUpdateAccessRule with any type of body
+func NewAdminUpdateAccessRuleRequestWithBody(server string, ruleId string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ruleId", runtime.ParamLocationPath, ruleId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/admin/healthcheck-handlers")
+	operationPath := fmt.Sprintf("/api/v1/admin/access-rules/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2559,16 +3797,18 @@ func NewAdminHealthcheckHandlersRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewAdminGetIdentityConfigurationRequest generates requests for AdminGetIdentityConfiguration
-func NewAdminGetIdentityConfigurationRequest(server string) (*http.Request, error) {
+// NewAdminListBulkRevokeJobsRequest generates requests for AdminListBulkRevokeJobs
+func NewAdminListBulkRevokeJobsRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2576,7 +3816,7 @@ func NewAdminGetIdentityConfigurationRequest(server string) (*http.Request, erro
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/admin/identity")
+	operationPath := fmt.Sprintf("/api/v1/admin/bulk-revoke-jobs")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2594,8 +3834,19 @@ func NewAdminGetIdentityConfigurationRequest(server string) (*http.Request, erro
 	return req, nil
 }
 
-// NewAdminSyncIdentityRequest generates requests for AdminSyncIdentity
-func NewAdminSyncIdentityRequest(server string) (*http.Request, error) {
+// NewAdminCreateBulkRevokeJobRequest calls the generic AdminCreateBulkRevokeJob builder with application/json body
+func NewAdminCreateBulkRevokeJobRequest(server string, body AdminCreateBulkRevokeJobJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAdminCreateBulkRevokeJobRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAdminCreateBulkRevokeJobRequestWithBody generates requests for AdminCreateBulkRevokeJob with any type of body
+func NewAdminCreateBulkRevokeJobRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2603,7 +3854,7 @@ func NewAdminSyncIdentityRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/admin/identity/sync")
+	operationPath := fmt.Sprintf("/api/v1/admin/bulk-revoke-jobs")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2613,24 +3864,60 @@ func NewAdminSyncIdentityRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewAdminListRequestsRequest generates requests for AdminListRequests
-func NewAdminListRequestsRequest(server string, params *AdminListRequestsParams) (*http.Request, error) {
+// NewAdminGetBulkRevokeJobRequest generates requests for AdminGetBulkRevokeJob
+func NewAdminGetBulkRevokeJobRequest(server string, jobId string) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "jobId", runtime.ParamLocationPath, jobId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/admin/requests")
+	operationPath := fmt.Sprintf("/api/v1/admin/bulk-revoke-jobs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminListDeadLetterEventsRequest generates requests for AdminListDeadLetterEvents
+func NewAdminListDeadLetterEventsRequest(server string, params *AdminListDeadLetterEventsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/dead-letter-events")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2642,9 +3929,9 @@ func NewAdminListRequestsRequest(server string, params *AdminListRequestsParams)
 
 	queryValues := queryURL.Query()
 
-	if params.Status != nil {
+	if params.RequestId != nil {
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "requestId", runtime.ParamLocationQuery, *params.RequestId); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -2658,9 +3945,9 @@ func NewAdminListRequestsRequest(server string, params *AdminListRequestsParams)
 
 	}
 
-	if params.NextToken != nil {
+	if params.Subscriber != nil {
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "nextToken", runtime.ParamLocationQuery, *params.NextToken); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "subscriber", runtime.ParamLocationQuery, *params.Subscriber); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -2684,16 +3971,23 @@ func NewAdminListRequestsRequest(server string, params *AdminListRequestsParams)
 	return req, nil
 }
 
-// NewAdminListTargetGroupsRequest generates requests for AdminListTargetGroups
-func NewAdminListTargetGroupsRequest(server string) (*http.Request, error) {
+// NewAdminDiscardDeadLetterEventRequest generates requests for AdminDiscardDeadLetterEvent
+func NewAdminDiscardDeadLetterEventRequest(server string, eventId string) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "eventId", runtime.ParamLocationPath, eventId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/admin/target-groups")
+	operationPath := fmt.Sprintf("/api/v1/admin/dead-letter-events/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2703,7 +3997,7 @@ func NewAdminListTargetGroupsRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2711,27 +4005,23 @@ func NewAdminListTargetGroupsRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewAdminCreateTargetGroupRequest calls the generic AdminCreateTargetGroup builder with application/json body
-func NewAdminCreateTargetGroupRequest(server string, body AdminCreateTargetGroupJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewAdminGetDeadLetterEventRequest generates requests for AdminGetDeadLetterEvent
+func NewAdminGetDeadLetterEventRequest(server string, eventId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "eventId", runtime.ParamLocationPath, eventId)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewAdminCreateTargetGroupRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewAdminCreateTargetGroupRequestWithBody generates requests for AdminCreateTargetGroup with any type of body
-func NewAdminCreateTargetGroupRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/admin/target-groups")
+	operationPath := fmt.Sprintf("/api/v1/admin/dead-letter-events/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2741,23 +4031,21 @@ func NewAdminCreateTargetGroupRequestWithBody(server string, contentType string,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewAdminDeleteTargetGroupRequest generates requests for AdminDeleteTargetGroup
-func NewAdminDeleteTargetGroupRequest(server string, id string) (*http.Request, error) {
+// NewAdminReplayDeadLetterEventRequest generates requests for AdminReplayDeadLetterEvent
+func NewAdminReplayDeadLetterEventRequest(server string, eventId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "eventId", runtime.ParamLocationPath, eventId)
 	if err != nil {
 		return nil, err
 	}
@@ -2767,7 +4055,7 @@ func NewAdminDeleteTargetGroupRequest(server string, id string) (*http.Request,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/admin/target-groups/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/admin/dead-letter-events/%s/replay", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2777,7 +4065,7 @@ func NewAdminDeleteTargetGroupRequest(server string, id string) (*http.Request,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2785,23 +4073,16 @@ func NewAdminDeleteTargetGroupRequest(server string, id string) (*http.Request,
 	return req, nil
 }
 
-// NewAdminGetTargetGroupRequest generates requests for AdminGetTargetGroup
-func NewAdminGetTargetGroupRequest(server string, id string) (*http.Request, error) {
+// NewAdminGetDeploymentVersionRequest generates requests for AdminGetDeploymentVersion
+func NewAdminGetDeploymentVersionRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/admin/target-groups/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/admin/deployment/version")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2819,34 +4100,43 @@ func NewAdminGetTargetGroupRequest(server string, id string) (*http.Request, err
 	return req, nil
 }
 
-// NewAdminCreateTargetGroupLinkRequest calls the generic AdminCreateTargetGroupLink builder with application/json body
-func NewAdminCreateTargetGroupLinkRequest(server string, id string, body AdminCreateTargetGroupLinkJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewAdminListGrantDriftRequest generates requests for AdminListGrantDrift
+func NewAdminListGrantDriftRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewAdminCreateTargetGroupLinkRequestWithBody(server, id, "application/json", bodyReader)
-}
 
-// NewAdminCreateTargetGroupLinkRequestWithBody generates requests for AdminCreateTargetGroupLink with any type of body
-func NewAdminCreateTargetGroupLinkRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	operationPath := fmt.Sprintf("/api/v1/admin/drift")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	var pathParam0 string
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
+	return req, nil
+}
+
+// NewAdminListEventSchemasRequest generates requests for AdminListEventSchemas
+func NewAdminListEventSchemasRequest(server string) (*http.Request, error) {
+	var err error
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/admin/target-groups/%s/link", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/admin/event-schemas")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2856,30 +4146,21 @@ func NewAdminCreateTargetGroupLinkRequestWithBody(server string, id string, cont
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewAdminGetTargetGroupResourcesRequest generates requests for AdminGetTargetGroupResources
-func NewAdminGetTargetGroupResourcesRequest(server string, id string, resourceType string) (*http.Request, error) {
+// NewAdminGetEventSchemaRequest generates requests for AdminGetEventSchema
+func NewAdminGetEventSchemaRequest(server string, eventType string, params *AdminGetEventSchemaParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "resourceType", runtime.ParamLocationPath, resourceType)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "eventType", runtime.ParamLocationPath, eventType)
 	if err != nil {
 		return nil, err
 	}
@@ -2889,7 +4170,7 @@ func NewAdminGetTargetGroupResourcesRequest(server string, id string, resourceTy
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/admin/target-groups/%s/resources/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/admin/event-schemas/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2899,49 +4180,44 @@ func NewAdminGetTargetGroupResourcesRequest(server string, id string, resourceTy
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	queryValues := queryURL.Query()
 
-	return req, nil
-}
+	if params.Version != nil {
 
-// NewAdminFilterTargetGroupResourcesRequest calls the generic AdminFilterTargetGroupResources builder with application/json body
-func NewAdminFilterTargetGroupResourcesRequest(server string, id string, resourceType string, body AdminFilterTargetGroupResourcesJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewAdminFilterTargetGroupResourcesRequestWithBody(server, id, resourceType, "application/json", bodyReader)
-}
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "version", runtime.ParamLocationQuery, *params.Version); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-// NewAdminFilterTargetGroupResourcesRequestWithBody generates requests for AdminFilterTargetGroupResources with any type of body
-func NewAdminFilterTargetGroupResourcesRequestWithBody(server string, id string, resourceType string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	}
 
-	var pathParam0 string
+	queryURL.RawQuery = queryValues.Encode()
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam1 string
+	return req, nil
+}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "resourceType", runtime.ParamLocationPath, resourceType)
-	if err != nil {
-		return nil, err
-	}
+// NewAdminListFailedGrantsRequest generates requests for AdminListFailedGrants
+func NewAdminListFailedGrantsRequest(server string) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/admin/target-groups/%s/resources/%s/filters", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/admin/failed-grants")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2951,23 +4227,21 @@ func NewAdminFilterTargetGroupResourcesRequestWithBody(server string, id string,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewAdminListTargetRoutesRequest generates requests for AdminListTargetRoutes
-func NewAdminListTargetRoutesRequest(server string, id string) (*http.Request, error) {
+// NewAdminCompleteFailedGrantRequest generates requests for AdminCompleteFailedGrant
+func NewAdminCompleteFailedGrantRequest(server string, grantId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "grantId", runtime.ParamLocationPath, grantId)
 	if err != nil {
 		return nil, err
 	}
@@ -2977,7 +4251,7 @@ func NewAdminListTargetRoutesRequest(server string, id string) (*http.Request, e
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/admin/target-groups/%s/routes", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/admin/failed-grants/%s/complete", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2987,7 +4261,7 @@ func NewAdminListTargetRoutesRequest(server string, id string) (*http.Request, e
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2995,13 +4269,13 @@ func NewAdminListTargetRoutesRequest(server string, id string) (*http.Request, e
 	return req, nil
 }
 
-// NewAdminRemoveTargetGroupLinkRequest generates requests for AdminRemoveTargetGroupLink
-func NewAdminRemoveTargetGroupLinkRequest(server string, id string, params *AdminRemoveTargetGroupLinkParams) (*http.Request, error) {
+// NewAdminRetryFailedGrantRequest generates requests for AdminRetryFailedGrant
+func NewAdminRetryFailedGrantRequest(server string, grantId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "grantId", runtime.ParamLocationPath, grantId)
 	if err != nil {
 		return nil, err
 	}
@@ -3011,7 +4285,7 @@ func NewAdminRemoveTargetGroupLinkRequest(server string, id string, params *Admi
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/admin/target-groups/%s/unlink", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/admin/failed-grants/%s/retry", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3021,34 +4295,6 @@ func NewAdminRemoveTargetGroupLinkRequest(server string, id string, params *Admi
 		return nil, err
 	}
 
-	queryValues := queryURL.Query()
-
-	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "deploymentId", runtime.ParamLocationQuery, params.DeploymentId); err != nil {
-		return nil, err
-	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-		return nil, err
-	} else {
-		for k, v := range parsed {
-			for _, v2 := range v {
-				queryValues.Add(k, v2)
-			}
-		}
-	}
-
-	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "kind", runtime.ParamLocationQuery, params.Kind); err != nil {
-		return nil, err
-	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-		return nil, err
-	} else {
-		for k, v := range parsed {
-			for _, v2 := range v {
-				queryValues.Add(k, v2)
-			}
-		}
-	}
-
-	queryURL.RawQuery = queryValues.Encode()
-
 	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -3057,8 +4303,8 @@ func NewAdminRemoveTargetGroupLinkRequest(server string, id string, params *Admi
 	return req, nil
 }
 
-// NewAdminListUsersRequest generates requests for AdminListUsers
-func NewAdminListUsersRequest(server string, params *AdminListUsersParams) (*http.Request, error) {
+// NewAdminListFreezesRequest generates requests for AdminListFreezes
+func NewAdminListFreezesRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3066,7 +4312,7 @@ func NewAdminListUsersRequest(server string, params *AdminListUsersParams) (*htt
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/admin/users")
+	operationPath := fmt.Sprintf("/api/v1/admin/freezes")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3076,26 +4322,6 @@ func NewAdminListUsersRequest(server string, params *AdminListUsersParams) (*htt
 		return nil, err
 	}
 
-	queryValues := queryURL.Query()
-
-	if params.NextToken != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "nextToken", runtime.ParamLocationQuery, *params.NextToken); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-	}
-
-	queryURL.RawQuery = queryValues.Encode()
-
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -3104,19 +4330,19 @@ func NewAdminListUsersRequest(server string, params *AdminListUsersParams) (*htt
 	return req, nil
 }
 
-// NewAdminCreateUserRequest calls the generic AdminCreateUser builder with application/json body
-func NewAdminCreateUserRequest(server string, body AdminCreateUserJSONRequestBody) (*http.Request, error) {
+// NewAdminCreateFreezeRequest calls the generic AdminCreateFreeze builder with application/json body
+func NewAdminCreateFreezeRequest(server string, body AdminCreateFreezeJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewAdminCreateUserRequestWithBody(server, "application/json", bodyReader)
+	return NewAdminCreateFreezeRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewAdminCreateUserRequestWithBody generates requests for AdminCreateUser with any type of body
-func NewAdminCreateUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewAdminCreateFreezeRequestWithBody generates requests for AdminCreateFreeze with any type of body
+func NewAdminCreateFreezeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3124,7 +4350,7 @@ func NewAdminCreateUserRequestWithBody(server string, contentType string, body i
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/admin/users")
+	operationPath := fmt.Sprintf("/api/v1/admin/freezes")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3144,24 +4370,58 @@ func NewAdminCreateUserRequestWithBody(server string, contentType string, body i
 	return req, nil
 }
 
-// NewAdminUpdateUserRequest calls the generic AdminUpdateUser builder with application/json body
-func NewAdminUpdateUserRequest(server string, userId string, body AdminUpdateUserJSONRequestBody) (*http.Request, error) {
+// NewAdminGetFreezeRequest generates requests for AdminGetFreeze
+func NewAdminGetFreezeRequest(server string, freezeId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "freezeId", runtime.ParamLocationPath, freezeId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/freezes/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminUpdateFreezeRequest calls the generic AdminUpdateFreeze builder with application/json body
+func NewAdminUpdateFreezeRequest(server string, freezeId string, body AdminUpdateFreezeJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewAdminUpdateUserRequestWithBody(server, userId, "application/json", bodyReader)
+	return NewAdminUpdateFreezeRequestWithBody(server, freezeId, "application/json", bodyReader)
 }
 
-// NewAdminUpdateUserRequestWithBody generates requests for AdminUpdateUser with any type of body
-func NewAdminUpdateUserRequestWithBody(server string, userId string, contentType string, body io.Reader) (*http.Request, error) {
+// NewAdminUpdateFreezeRequestWithBody generates requests for AdminUpdateFreeze with any type of body
+func NewAdminUpdateFreezeRequestWithBody(server string, freezeId string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "freezeId", runtime.ParamLocationPath, freezeId)
 	if err != nil {
 		return nil, err
 	}
@@ -3171,7 +4431,7 @@ func NewAdminUpdateUserRequestWithBody(server string, userId string, contentType
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/admin/users/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/admin/freezes/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3181,7 +4441,7 @@ func NewAdminUpdateUserRequestWithBody(server string, userId string, contentType
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -3191,16 +4451,23 @@ func NewAdminUpdateUserRequestWithBody(server string, userId string, contentType
 	return req, nil
 }
 
-// NewUserListEntitlementsRequest generates requests for UserListEntitlements
-func NewUserListEntitlementsRequest(server string) (*http.Request, error) {
+// NewAdminListFreezeHistoryRequest generates requests for AdminListFreezeHistory
+func NewAdminListFreezeHistoryRequest(server string, freezeId string) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "freezeId", runtime.ParamLocationPath, freezeId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/entitlements")
+	operationPath := fmt.Sprintf("/api/v1/admin/freezes/%s/history", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3218,16 +4485,23 @@ func NewUserListEntitlementsRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewUserListEntitlementTargetsRequest generates requests for UserListEntitlementTargets
-func NewUserListEntitlementTargetsRequest(server string, params *UserListEntitlementTargetsParams) (*http.Request, error) {
+// NewAdminLiftFreezeRequest generates requests for AdminLiftFreeze
+func NewAdminLiftFreezeRequest(server string, freezeId string) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "freezeId", runtime.ParamLocationPath, freezeId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/entitlements/targets")
+	operationPath := fmt.Sprintf("/api/v1/admin/freezes/%s/lift", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3237,17 +4511,44 @@ func NewUserListEntitlementTargetsRequest(server string, params *UserListEntitle
 		return nil, err
 	}
 
-	queryValues := queryURL.Query()
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	if params.NextToken != nil {
+	return req, nil
+}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "nextToken", runtime.ParamLocationQuery, *params.NextToken); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
+// NewAdminListGroupsRequest generates requests for AdminListGroups
+func NewAdminListGroupsRequest(server string, params *AdminListGroupsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/groups")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.NextToken != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "nextToken", runtime.ParamLocationQuery, *params.NextToken); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
 					queryValues.Add(k, v2)
 				}
 			}
@@ -3255,9 +4556,9 @@ func NewUserListEntitlementTargetsRequest(server string, params *UserListEntitle
 
 	}
 
-	if params.Kind != nil {
+	if params.Source != nil {
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "kind", runtime.ParamLocationQuery, *params.Kind); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "source", runtime.ParamLocationQuery, *params.Source); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -3281,19 +4582,19 @@ func NewUserListEntitlementTargetsRequest(server string, params *UserListEntitle
 	return req, nil
 }
 
-// NewUserRequestPreflightRequest calls the generic UserRequestPreflight builder with application/json body
-func NewUserRequestPreflightRequest(server string, body UserRequestPreflightJSONRequestBody) (*http.Request, error) {
+// NewAdminCreateGroupRequest calls the generic AdminCreateGroup builder with application/json body
+func NewAdminCreateGroupRequest(server string, body AdminCreateGroupJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUserRequestPreflightRequestWithBody(server, "application/json", bodyReader)
+	return NewAdminCreateGroupRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewUserRequestPreflightRequestWithBody generates requests for UserRequestPreflight with any type of body
-func NewUserRequestPreflightRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewAdminCreateGroupRequestWithBody generates requests for AdminCreateGroup with any type of body
+func NewAdminCreateGroupRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3301,7 +4602,7 @@ func NewUserRequestPreflightRequestWithBody(server string, contentType string, b
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/preflight")
+	operationPath := fmt.Sprintf("/api/v1/admin/groups")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3321,13 +4622,13 @@ func NewUserRequestPreflightRequestWithBody(server string, contentType string, b
 	return req, nil
 }
 
-// NewUserGetPreflightRequest generates requests for UserGetPreflight
-func NewUserGetPreflightRequest(server string, preflightId string) (*http.Request, error) {
+// NewAdminDeleteGroupRequest generates requests for AdminDeleteGroup
+func NewAdminDeleteGroupRequest(server string, groupId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "preflightId", runtime.ParamLocationPath, preflightId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
 	if err != nil {
 		return nil, err
 	}
@@ -3337,7 +4638,7 @@ func NewUserGetPreflightRequest(server string, preflightId string) (*http.Reques
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/preflight/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/admin/groups/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3347,7 +4648,7 @@ func NewUserGetPreflightRequest(server string, preflightId string) (*http.Reques
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -3355,16 +4656,23 @@ func NewUserGetPreflightRequest(server string, preflightId string) (*http.Reques
 	return req, nil
 }
 
-// NewUserListRequestsRequest generates requests for UserListRequests
-func NewUserListRequestsRequest(server string, params *UserListRequestsParams) (*http.Request, error) {
+// NewAdminGetGroupRequest generates requests for AdminGetGroup
+func NewAdminGetGroupRequest(server string, groupId string) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/requests")
+	operationPath := fmt.Sprintf("/api/v1/admin/groups/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3374,42 +4682,6 @@ func NewUserListRequestsRequest(server string, params *UserListRequestsParams) (
 		return nil, err
 	}
 
-	queryValues := queryURL.Query()
-
-	if params.NextToken != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "nextToken", runtime.ParamLocationQuery, *params.NextToken); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-	}
-
-	if params.Filter != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-	}
-
-	queryURL.RawQuery = queryValues.Encode()
-
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -3418,27 +4690,34 @@ func NewUserListRequestsRequest(server string, params *UserListRequestsParams) (
 	return req, nil
 }
 
-// NewUserPostRequestsRequest calls the generic UserPostRequests builder with application/json body
-func NewUserPostRequestsRequest(server string, body UserPostRequestsJSONRequestBody) (*http.Request, error) {
+// NewAdminUpdateGroupRequest calls the generic AdminUpdateGroup builder with application/json body
+func NewAdminUpdateGroupRequest(server string, groupId string, body AdminUpdateGroupJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUserPostRequestsRequestWithBody(server, "application/json", bodyReader)
+	return NewAdminUpdateGroupRequestWithBody(server, groupId, "application/json", bodyReader)
 }
 
-// NewUserPostRequestsRequestWithBody generates requests for UserPostRequests with any type of body
-func NewUserPostRequestsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewAdminUpdateGroupRequestWithBody generates requests for AdminUpdateGroup with any type of body
+func NewAdminUpdateGroupRequestWithBody(server string, groupId string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/requests")
+	operationPath := fmt.Sprintf("/api/v1/admin/groups/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3448,7 +4727,7 @@ func NewUserPostRequestsRequestWithBody(server string, contentType string, body
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -3458,23 +4737,16 @@ func NewUserPostRequestsRequestWithBody(server string, contentType string, body
 	return req, nil
 }
 
-// NewUserGetRequestRequest generates requests for UserGetRequest
-func NewUserGetRequestRequest(server string, requestId string) (*http.Request, error) {
+// NewAdminListHandlersRequest generates requests for AdminListHandlers
+func NewAdminListHandlersRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "requestId", runtime.ParamLocationPath, requestId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/requests/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/admin/handlers")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3492,23 +4764,27 @@ func NewUserGetRequestRequest(server string, requestId string) (*http.Request, e
 	return req, nil
 }
 
-// NewUserListRequestEventsRequest generates requests for UserListRequestEvents
-func NewUserListRequestEventsRequest(server string, requestId string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "requestId", runtime.ParamLocationPath, requestId)
+// NewAdminRegisterHandlerRequest calls the generic AdminRegisterHandler builder with application/json body
+func NewAdminRegisterHandlerRequest(server string, body AdminRegisterHandlerJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAdminRegisterHandlerRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAdminRegisterHandlerRequestWithBody generates requests for AdminRegisterHandler with any type of body
+func NewAdminRegisterHandlerRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/requests/%s/events", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/admin/handlers")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3518,39 +4794,23 @@ func NewUserListRequestEventsRequest(server string, requestId string) (*http.Req
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
+	req.Header.Add("Content-Type", contentType)
 
-// NewUserReviewRequestRequest calls the generic UserReviewRequest builder with application/json body
-func NewUserReviewRequestRequest(server string, requestId string, groupId string, body UserReviewRequestJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUserReviewRequestRequestWithBody(server, requestId, groupId, "application/json", bodyReader)
+	return req, nil
 }
 
-// NewUserReviewRequestRequestWithBody generates requests for UserReviewRequest with any type of body
-func NewUserReviewRequestRequestWithBody(server string, requestId string, groupId string, contentType string, body io.Reader) (*http.Request, error) {
+// NewAdminDeleteHandlerRequest generates requests for AdminDeleteHandler
+func NewAdminDeleteHandlerRequest(server string, id string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "requestId", runtime.ParamLocationPath, requestId)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
@@ -3560,7 +4820,7 @@ func NewUserReviewRequestRequestWithBody(server string, requestId string, groupI
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/requests/%s/review/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/admin/handlers/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3570,23 +4830,21 @@ func NewUserReviewRequestRequestWithBody(server string, requestId string, groupI
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewUserCancelRequestRequest generates requests for UserCancelRequest
-func NewUserCancelRequestRequest(server string, requestid string) (*http.Request, error) {
+// NewAdminGetHandlerRequest generates requests for AdminGetHandler
+func NewAdminGetHandlerRequest(server string, id string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "requestid", runtime.ParamLocationPath, requestid)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
@@ -3596,7 +4854,7 @@ func NewUserCancelRequestRequest(server string, requestid string) (*http.Request
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/requests/%s/cancel", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/admin/handlers/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3606,7 +4864,7 @@ func NewUserCancelRequestRequest(server string, requestid string) (*http.Request
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -3614,23 +4872,43 @@ func NewUserCancelRequestRequest(server string, requestid string) (*http.Request
 	return req, nil
 }
 
-// NewUserRevokeRequestRequest generates requests for UserRevokeRequest
-func NewUserRevokeRequestRequest(server string, requestid string) (*http.Request, error) {
+// NewAdminHealthcheckHandlersRequest generates requests for AdminHealthcheckHandlers
+func NewAdminHealthcheckHandlersRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "requestid", runtime.ParamLocationPath, requestid)
+	operationPath := fmt.Sprintf("/api/v1/admin/healthcheck-handlers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
+	return req, nil
+}
+
+// NewAdminGetIdentityConfigurationRequest generates requests for AdminGetIdentityConfiguration
+func NewAdminGetIdentityConfigurationRequest(server string) (*http.Request, error) {
+	var err error
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/requests/%s/revoke", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/admin/identity")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3640,7 +4918,7 @@ func NewUserRevokeRequestRequest(server string, requestid string) (*http.Request
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -3648,8 +4926,8 @@ func NewUserRevokeRequestRequest(server string, requestid string) (*http.Request
 	return req, nil
 }
 
-// NewUserListReviewsRequest generates requests for UserListReviews
-func NewUserListReviewsRequest(server string, params *UserListReviewsParams) (*http.Request, error) {
+// NewAdminSyncIdentityRequest generates requests for AdminSyncIdentity
+func NewAdminSyncIdentityRequest(server string, params *AdminSyncIdentityParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3657,7 +4935,7 @@ func NewUserListReviewsRequest(server string, params *UserListReviewsParams) (*h
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/reviews")
+	operationPath := fmt.Sprintf("/api/v1/admin/identity/sync")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3669,9 +4947,9 @@ func NewUserListReviewsRequest(server string, params *UserListReviewsParams) (*h
 
 	queryValues := queryURL.Query()
 
-	if params.Status != nil {
+	if params.DryRun != nil {
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dryRun", runtime.ParamLocationQuery, *params.DryRun); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -3685,9 +4963,9 @@ func NewUserListReviewsRequest(server string, params *UserListReviewsParams) (*h
 
 	}
 
-	if params.NextToken != nil {
+	if params.Force != nil {
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "nextToken", runtime.ParamLocationQuery, *params.NextToken); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "force", runtime.ParamLocationQuery, *params.Force); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -3703,7 +4981,7 @@ func NewUserListReviewsRequest(server string, params *UserListReviewsParams) (*h
 
 	queryURL.RawQuery = queryValues.Encode()
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -3711,23 +4989,16 @@ func NewUserListReviewsRequest(server string, params *UserListReviewsParams) (*h
 	return req, nil
 }
 
-// NewGetGroupTargetInstructionsRequest generates requests for GetGroupTargetInstructions
-func NewGetGroupTargetInstructionsRequest(server string, targetId string) (*http.Request, error) {
+// NewAdminListIdentitySyncRunsRequest generates requests for AdminListIdentitySyncRuns
+func NewAdminListIdentitySyncRunsRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "targetId", runtime.ParamLocationPath, targetId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/targets/%s/access-instructions", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/admin/identity/sync-runs")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3745,8 +5016,8 @@ func NewGetGroupTargetInstructionsRequest(server string, targetId string) (*http
 	return req, nil
 }
 
-// NewUserGetMeRequest generates requests for UserGetMe
-func NewUserGetMeRequest(server string) (*http.Request, error) {
+// NewAdminListRequestsRequest generates requests for AdminListRequests
+func NewAdminListRequestsRequest(server string, params *AdminListRequestsParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3754,7 +5025,7 @@ func NewUserGetMeRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/users/me")
+	operationPath := fmt.Sprintf("/api/v1/admin/requests")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3764,6 +5035,42 @@ func NewUserGetMeRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
+	queryValues := queryURL.Query()
+
+	if params.Status != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.NextToken != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "nextToken", runtime.ParamLocationQuery, *params.NextToken); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -3772,13 +5079,13 @@ func NewUserGetMeRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewUserGetUserRequest generates requests for UserGetUser
-func NewUserGetUserRequest(server string, userId string) (*http.Request, error) {
+// NewAdminReplayRequestEventsRequest generates requests for AdminReplayRequestEvents
+func NewAdminReplayRequestEventsRequest(server string, requestId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "requestId", runtime.ParamLocationPath, requestId)
 	if err != nil {
 		return nil, err
 	}
@@ -3788,7 +5095,7 @@ func NewUserGetUserRequest(server string, userId string) (*http.Request, error)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/users/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/admin/requests/%s/replay-events", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3798,7 +5105,7 @@ func NewUserGetUserRequest(server string, userId string) (*http.Request, error)
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -3806,2127 +5113,7695 @@ func NewUserGetUserRequest(server string, userId string) (*http.Request, error)
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewAdminListTargetGroupsRequest generates requests for AdminListTargetGroups
+func NewAdminListTargetGroupsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/target-groups")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+
+	return req, nil
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+// NewAdminCreateTargetGroupRequest calls the generic AdminCreateTargetGroup builder with application/json body
+func NewAdminCreateTargetGroupRequest(server string, body AdminCreateTargetGroupJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAdminCreateTargetGroupRequestWithBody(server, "application/json", bodyReader)
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// UserListAccessTemplates request
-	UserListAccessTemplatesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserListAccessTemplatesResponse, error)
-
-	// AdminListAccessRules request
-	AdminListAccessRulesWithResponse(ctx context.Context, params *AdminListAccessRulesParams, reqEditors ...RequestEditorFn) (*AdminListAccessRulesResponse, error)
+// NewAdminCreateTargetGroupRequestWithBody generates requests for AdminCreateTargetGroup with any type of body
+func NewAdminCreateTargetGroupRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// AdminCreateAccessRule request with any body
-	AdminCreateAccessRuleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminCreateAccessRuleResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	AdminCreateAccessRuleWithResponse(ctx context.Context, body AdminCreateAccessRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminCreateAccessRuleResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/admin/target-groups")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// AdminDeleteAccessRule request
-	AdminDeleteAccessRuleWithResponse(ctx context.Context, ruleId string, reqEditors ...RequestEditorFn) (*AdminDeleteAccessRuleResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// AdminGetAccessRule request
-	AdminGetAccessRuleWithResponse(ctx context.Context, ruleId string, reqEditors ...RequestEditorFn) (*AdminGetAccessRuleResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// AdminUpdateAccessRule request with any body
-	AdminUpdateAccessRuleWithBodyWithResponse(ctx context.Context, ruleId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminUpdateAccessRuleResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	AdminUpdateAccessRuleWithResponse(ctx context.Context, ruleId string, body AdminUpdateAccessRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminUpdateAccessRuleResponse, error)
+	return req, nil
+}
 
-	// AdminGetDeploymentVersion request
-	AdminGetDeploymentVersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminGetDeploymentVersionResponse, error)
+// NewAdminDeleteTargetGroupRequest generates requests for AdminDeleteTargetGroup
+func NewAdminDeleteTargetGroupRequest(server string, id string) (*http.Request, error) {
+	var err error
 
-	// AdminListGroups request
-	AdminListGroupsWithResponse(ctx context.Context, params *AdminListGroupsParams, reqEditors ...RequestEditorFn) (*AdminListGroupsResponse, error)
+	var pathParam0 string
 
-	// AdminCreateGroup request with any body
-	AdminCreateGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminCreateGroupResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
 
-	AdminCreateGroupWithResponse(ctx context.Context, body AdminCreateGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminCreateGroupResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// AdminDeleteGroup request
-	AdminDeleteGroupWithResponse(ctx context.Context, groupId string, reqEditors ...RequestEditorFn) (*AdminDeleteGroupResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/admin/target-groups/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// AdminGetGroup request
-	AdminGetGroupWithResponse(ctx context.Context, groupId string, reqEditors ...RequestEditorFn) (*AdminGetGroupResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// AdminUpdateGroup request with any body
-	AdminUpdateGroupWithBodyWithResponse(ctx context.Context, groupId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminUpdateGroupResponse, error)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	AdminUpdateGroupWithResponse(ctx context.Context, groupId string, body AdminUpdateGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminUpdateGroupResponse, error)
+	return req, nil
+}
 
-	// AdminListHandlers request
-	AdminListHandlersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListHandlersResponse, error)
+// NewAdminGetTargetGroupRequest generates requests for AdminGetTargetGroup
+func NewAdminGetTargetGroupRequest(server string, id string) (*http.Request, error) {
+	var err error
 
-	// AdminRegisterHandler request with any body
-	AdminRegisterHandlerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminRegisterHandlerResponse, error)
+	var pathParam0 string
 
-	AdminRegisterHandlerWithResponse(ctx context.Context, body AdminRegisterHandlerJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminRegisterHandlerResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
 
-	// AdminDeleteHandler request
-	AdminDeleteHandlerWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*AdminDeleteHandlerResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// AdminGetHandler request
-	AdminGetHandlerWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*AdminGetHandlerResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/admin/target-groups/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// AdminHealthcheckHandlers request
-	AdminHealthcheckHandlersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminHealthcheckHandlersResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// AdminGetIdentityConfiguration request
-	AdminGetIdentityConfigurationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminGetIdentityConfigurationResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// AdminSyncIdentity request
-	AdminSyncIdentityWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminSyncIdentityResponse, error)
+	return req, nil
+}
 
-	// AdminListRequests request
-	AdminListRequestsWithResponse(ctx context.Context, params *AdminListRequestsParams, reqEditors ...RequestEditorFn) (*AdminListRequestsResponse, error)
+// NewAdminCreateTargetGroupLinkRequest calls the generic AdminCreateTargetGroupLink builder with application/json body
+func NewAdminCreateTargetGroupLinkRequest(server string, id string, body AdminCreateTargetGroupLinkJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAdminCreateTargetGroupLinkRequestWithBody(server, id, "application/json", bodyReader)
+}
 
-	// AdminListTargetGroups request
-	AdminListTargetGroupsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListTargetGroupsResponse, error)
+// NewAdminCreateTargetGroupLinkRequestWithBody generates requests for AdminCreateTargetGroupLink with any type of body
+func NewAdminCreateTargetGroupLinkRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// AdminCreateTargetGroup request with any body
-	AdminCreateTargetGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminCreateTargetGroupResponse, error)
+	var pathParam0 string
 
-	AdminCreateTargetGroupWithResponse(ctx context.Context, body AdminCreateTargetGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminCreateTargetGroupResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
 
-	// AdminDeleteTargetGroup request
-	AdminDeleteTargetGroupWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*AdminDeleteTargetGroupResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// AdminGetTargetGroup request
-	AdminGetTargetGroupWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*AdminGetTargetGroupResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/admin/target-groups/%s/link", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// AdminCreateTargetGroupLink request with any body
-	AdminCreateTargetGroupLinkWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminCreateTargetGroupLinkResponse, error)
-
-	AdminCreateTargetGroupLinkWithResponse(ctx context.Context, id string, body AdminCreateTargetGroupLinkJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminCreateTargetGroupLinkResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// AdminGetTargetGroupResources request
-	AdminGetTargetGroupResourcesWithResponse(ctx context.Context, id string, resourceType string, reqEditors ...RequestEditorFn) (*AdminGetTargetGroupResourcesResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// AdminFilterTargetGroupResources request with any body
-	AdminFilterTargetGroupResourcesWithBodyWithResponse(ctx context.Context, id string, resourceType string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminFilterTargetGroupResourcesResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	AdminFilterTargetGroupResourcesWithResponse(ctx context.Context, id string, resourceType string, body AdminFilterTargetGroupResourcesJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminFilterTargetGroupResourcesResponse, error)
+	return req, nil
+}
 
-	// AdminListTargetRoutes request
-	AdminListTargetRoutesWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*AdminListTargetRoutesResponse, error)
+// NewAdminGetTargetGroupResourcesRequest generates requests for AdminGetTargetGroupResources
+func NewAdminGetTargetGroupResourcesRequest(server string, id string, resourceType string) (*http.Request, error) {
+	var err error
 
-	// AdminRemoveTargetGroupLink request
-	AdminRemoveTargetGroupLinkWithResponse(ctx context.Context, id string, params *AdminRemoveTargetGroupLinkParams, reqEditors ...RequestEditorFn) (*AdminRemoveTargetGroupLinkResponse, error)
+	var pathParam0 string
 
-	// AdminListUsers request
-	AdminListUsersWithResponse(ctx context.Context, params *AdminListUsersParams, reqEditors ...RequestEditorFn) (*AdminListUsersResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
 
-	// AdminCreateUser request with any body
-	AdminCreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminCreateUserResponse, error)
+	var pathParam1 string
 
-	AdminCreateUserWithResponse(ctx context.Context, body AdminCreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminCreateUserResponse, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "resourceType", runtime.ParamLocationPath, resourceType)
+	if err != nil {
+		return nil, err
+	}
 
-	// AdminUpdateUser request with any body
-	AdminUpdateUserWithBodyWithResponse(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminUpdateUserResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	AdminUpdateUserWithResponse(ctx context.Context, userId string, body AdminUpdateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminUpdateUserResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/admin/target-groups/%s/resources/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// UserListEntitlements request
-	UserListEntitlementsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserListEntitlementsResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// UserListEntitlementTargets request
-	UserListEntitlementTargetsWithResponse(ctx context.Context, params *UserListEntitlementTargetsParams, reqEditors ...RequestEditorFn) (*UserListEntitlementTargetsResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// UserRequestPreflight request with any body
-	UserRequestPreflightWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserRequestPreflightResponse, error)
+	return req, nil
+}
 
-	UserRequestPreflightWithResponse(ctx context.Context, body UserRequestPreflightJSONRequestBody, reqEditors ...RequestEditorFn) (*UserRequestPreflightResponse, error)
+// NewAdminFilterTargetGroupResourcesRequest calls the generic AdminFilterTargetGroupResources builder with application/json body
+func NewAdminFilterTargetGroupResourcesRequest(server string, id string, resourceType string, body AdminFilterTargetGroupResourcesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAdminFilterTargetGroupResourcesRequestWithBody(server, id, resourceType, "application/json", bodyReader)
+}
 
-	// UserGetPreflight request
-	UserGetPreflightWithResponse(ctx context.Context, preflightId string, reqEditors ...RequestEditorFn) (*UserGetPreflightResponse, error)
+// NewAdminFilterTargetGroupResourcesRequestWithBody generates requests for AdminFilterTargetGroupResources with any type of body
+func NewAdminFilterTargetGroupResourcesRequestWithBody(server string, id string, resourceType string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// UserListRequests request
-	UserListRequestsWithResponse(ctx context.Context, params *UserListRequestsParams, reqEditors ...RequestEditorFn) (*UserListRequestsResponse, error)
+	var pathParam0 string
 
-	// UserPostRequests request with any body
-	UserPostRequestsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserPostRequestsResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
 
-	UserPostRequestsWithResponse(ctx context.Context, body UserPostRequestsJSONRequestBody, reqEditors ...RequestEditorFn) (*UserPostRequestsResponse, error)
+	var pathParam1 string
 
-	// UserGetRequest request
-	UserGetRequestWithResponse(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*UserGetRequestResponse, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "resourceType", runtime.ParamLocationPath, resourceType)
+	if err != nil {
+		return nil, err
+	}
 
-	// UserListRequestEvents request
-	UserListRequestEventsWithResponse(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*UserListRequestEventsResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// UserReviewRequest request with any body
-	UserReviewRequestWithBodyWithResponse(ctx context.Context, requestId string, groupId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserReviewRequestResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/admin/target-groups/%s/resources/%s/filters", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	UserReviewRequestWithResponse(ctx context.Context, requestId string, groupId string, body UserReviewRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*UserReviewRequestResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// UserCancelRequest request
-	UserCancelRequestWithResponse(ctx context.Context, requestid string, reqEditors ...RequestEditorFn) (*UserCancelRequestResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// UserRevokeRequest request
-	UserRevokeRequestWithResponse(ctx context.Context, requestid string, reqEditors ...RequestEditorFn) (*UserRevokeRequestResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// UserListReviews request
-	UserListReviewsWithResponse(ctx context.Context, params *UserListReviewsParams, reqEditors ...RequestEditorFn) (*UserListReviewsResponse, error)
+	return req, nil
+}
 
-	// GetGroupTargetInstructions request
-	GetGroupTargetInstructionsWithResponse(ctx context.Context, targetId string, reqEditors ...RequestEditorFn) (*GetGroupTargetInstructionsResponse, error)
+// NewAdminListTargetRoutesRequest generates requests for AdminListTargetRoutes
+func NewAdminListTargetRoutesRequest(server string, id string) (*http.Request, error) {
+	var err error
 
-	// UserGetMe request
-	UserGetMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserGetMeResponse, error)
+	var pathParam0 string
 
-	// UserGetUser request
-	UserGetUserWithResponse(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*UserGetUserResponse, error)
-}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
 
-type UserListAccessTemplatesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		AccessTemplates []AccessTemplate `json:"accessTemplates"`
-		Next            *string          `json:"next,omitempty"`
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	JSON500 *struct {
-		Error string `json:"error"`
+
+	operationPath := fmt.Sprintf("/api/v1/admin/target-groups/%s/routes", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r UserListAccessTemplatesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserListAccessTemplatesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	return req, nil
 }
 
-type AdminListAccessRulesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		AccessRules []AccessRule `json:"accessRules"`
-		Next        *string      `json:"next"`
+// NewAdminSyncTargetGroupRequest generates requests for AdminSyncTargetGroup
+func NewAdminSyncTargetGroupRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
-	JSON401 *struct {
-		Error string `json:"error"`
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	JSON500 *struct {
-		Error string `json:"error"`
+
+	operationPath := fmt.Sprintf("/api/v1/admin/target-groups/%s/sync", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r AdminListAccessRulesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AdminListAccessRulesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	return req, nil
 }
 
-type AdminCreateAccessRuleResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *AccessRule
-	JSON400      *struct {
-		Error string `json:"error"`
-	}
-	JSON401 *struct {
-		Error string `json:"error"`
+// NewAdminRemoveTargetGroupLinkRequest generates requests for AdminRemoveTargetGroupLink
+func NewAdminRemoveTargetGroupLinkRequest(server string, id string, params *AdminRemoveTargetGroupLinkParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
-	JSON500 *struct {
-		Error string `json:"error"`
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r AdminCreateAccessRuleResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/api/v1/admin/target-groups/%s/unlink", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AdminCreateAccessRuleResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type AdminDeleteAccessRuleResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *struct {
-		Error string `json:"error"`
-	}
-	JSON404 *struct {
-		Error string `json:"error"`
-	}
-	JSON500 *struct {
-		Error string `json:"error"`
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "deploymentId", runtime.ParamLocationQuery, params.DeploymentId); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r AdminDeleteAccessRuleResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "kind", runtime.ParamLocationQuery, params.Kind); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AdminDeleteAccessRuleResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	return req, nil
 }
 
-type AdminGetAccessRuleResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AccessRule
-	JSON401      *struct {
-		Error string `json:"error"`
-	}
-	JSON404 *struct {
-		Error string `json:"error"`
+// NewAdminListTargetMetadataRequest generates requests for AdminListTargetMetadata
+func NewAdminListTargetMetadataRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	JSON500 *struct {
-		Error string `json:"error"`
+
+	operationPath := fmt.Sprintf("/api/v1/admin/target-metadata")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r AdminGetAccessRuleResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AdminGetAccessRuleResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type AdminUpdateAccessRuleResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AccessRule
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r AdminUpdateAccessRuleResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewAdminCreateTargetMetadataRequest calls the generic AdminCreateTargetMetadata builder with application/json body
+func NewAdminCreateTargetMetadataRequest(server string, body AdminCreateTargetMetadataJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewAdminCreateTargetMetadataRequestWithBody(server, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AdminUpdateAccessRuleResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewAdminCreateTargetMetadataRequestWithBody generates requests for AdminCreateTargetMetadata with any type of body
+func NewAdminCreateTargetMetadataRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-type AdminGetDeploymentVersionResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		// The deployment version. Will be a semver, such as "v0.9.0" for official releases, or "dev+GIT_HASH" for pre-release builds.
-		Version string `json:"version"`
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r AdminGetDeploymentVersionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/api/v1/admin/target-metadata")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AdminGetDeploymentVersionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type AdminListGroupsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Groups []Group `json:"groups"`
-		Next   *string `json:"next"`
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r AdminListGroupsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AdminListGroupsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewAdminDeleteTargetMetadataRequest generates requests for AdminDeleteTargetMetadata
+func NewAdminDeleteTargetMetadataRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type AdminCreateGroupResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Group
-	JSON400      *struct {
-		Error string `json:"error"`
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	JSON401 *struct {
-		Error string `json:"error"`
+
+	operationPath := fmt.Sprintf("/api/v1/admin/target-metadata/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	JSON500 *struct {
-		Error string `json:"error"`
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r AdminCreateGroupResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AdminCreateGroupResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewAdminUpdateTargetMetadataRequest calls the generic AdminUpdateTargetMetadata builder with application/json body
+func NewAdminUpdateTargetMetadataRequest(server string, id string, body AdminUpdateTargetMetadataJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewAdminUpdateTargetMetadataRequestWithBody(server, id, "application/json", bodyReader)
 }
 
-type AdminDeleteGroupResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON404      *struct {
-		Error string `json:"error"`
+// NewAdminUpdateTargetMetadataRequestWithBody generates requests for AdminUpdateTargetMetadata with any type of body
+func NewAdminUpdateTargetMetadataRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
-	JSON500 *struct {
-		Error string `json:"error"`
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r AdminDeleteGroupResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/api/v1/admin/target-metadata/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AdminDeleteGroupResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type AdminGetGroupResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Group
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r AdminGetGroupResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewAdminListUsersRequest generates requests for AdminListUsers
+func NewAdminListUsersRequest(server string, params *AdminListUsersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AdminGetGroupResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/api/v1/admin/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type AdminUpdateGroupResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Group
-	JSON400      *struct {
-		Error string `json:"error"`
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	JSON500 *struct {
-		Error string `json:"error"`
+
+	queryValues := queryURL.Query()
+
+	if params.NextToken != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "nextToken", runtime.ParamLocationQuery, *params.NextToken); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r AdminUpdateGroupResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AdminUpdateGroupResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewAdminCreateUserRequest calls the generic AdminCreateUser builder with application/json body
+func NewAdminCreateUserRequest(server string, body AdminCreateUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewAdminCreateUserRequestWithBody(server, "application/json", bodyReader)
 }
 
-type AdminListHandlersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Next string      `json:"next"`
-		Res  []TGHandler `json:"res"`
+// NewAdminCreateUserRequestWithBody generates requests for AdminCreateUser with any type of body
+func NewAdminCreateUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	JSON401 *struct {
-		Error string `json:"error"`
+
+	operationPath := fmt.Sprintf("/api/v1/admin/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	JSON500 *struct {
-		Error string `json:"error"`
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r AdminListHandlersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AdminListHandlersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewAdminUpdateUserRequest calls the generic AdminUpdateUser builder with application/json body
+func NewAdminUpdateUserRequest(server string, userId string, body AdminUpdateUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewAdminUpdateUserRequestWithBody(server, userId, "application/json", bodyReader)
 }
 
-type AdminRegisterHandlerResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *TGHandler
-	JSON400      *struct {
-		Error string `json:"error"`
+// NewAdminUpdateUserRequestWithBody generates requests for AdminUpdateUser with any type of body
+func NewAdminUpdateUserRequestWithBody(server string, userId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
 	}
-	JSON401 *struct {
-		Error string `json:"error"`
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	JSON500 *struct {
-		Error string `json:"error"`
+
+	operationPath := fmt.Sprintf("/api/v1/admin/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r AdminRegisterHandlerResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AdminRegisterHandlerResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-type AdminDeleteHandlerResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON204      *map[string]interface{}
-	JSON401      *struct {
-		Error string `json:"error"`
+// NewAdminListUserGroupMembershipsRequest generates requests for AdminListUserGroupMemberships
+func NewAdminListUserGroupMembershipsRequest(server string, userId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
 	}
-	JSON404 *struct {
-		Error string `json:"error"`
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	JSON500 *struct {
-		Error string `json:"error"`
+
+	operationPath := fmt.Sprintf("/api/v1/admin/users/%s/group-memberships", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r AdminDeleteHandlerResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AdminDeleteHandlerResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	return req, nil
 }
 
-type AdminGetHandlerResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TGHandler
-	JSON401      *struct {
-		Error string `json:"error"`
-	}
-	JSON404 *struct {
-		Error string `json:"error"`
+// NewUserListEntitlementsRequest generates requests for UserListEntitlements
+func NewUserListEntitlementsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	JSON500 *struct {
-		Error string `json:"error"`
+
+	operationPath := fmt.Sprintf("/api/v1/entitlements")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r AdminGetHandlerResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AdminGetHandlerResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	return req, nil
 }
 
-type AdminHealthcheckHandlersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *struct {
-		Error string `json:"error"`
+// NewUserListEntitlementTargetsRequest generates requests for UserListEntitlementTargets
+func NewUserListEntitlementTargetsRequest(server string, params *UserListEntitlementTargetsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	JSON500 *struct {
-		Error string `json:"error"`
+
+	operationPath := fmt.Sprintf("/api/v1/entitlements/targets")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r AdminHealthcheckHandlersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AdminHealthcheckHandlersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryValues := queryURL.Query()
+
+	if params.NextToken != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "nextToken", runtime.ParamLocationQuery, *params.NextToken); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
 	}
-	return 0
-}
 
-type AdminGetIdentityConfigurationResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		AdministratorGroupId string `json:"administratorGroupId"`
-		IdentityProvider     string `json:"identityProvider"`
+	if params.Kind != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "kind", runtime.ParamLocationQuery, *params.Kind); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
 	}
-	JSON401 *struct {
-		Error string `json:"error"`
+
+	if params.Search != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "search", runtime.ParamLocationQuery, *params.Search); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
 	}
-	JSON500 *struct {
-		Error string `json:"error"`
+
+	if params.Filter != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r AdminGetIdentityConfigurationResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	if params.SortBy != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortBy", runtime.ParamLocationQuery, *params.SortBy); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AdminGetIdentityConfigurationResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	if params.SortOrder != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortOrder", runtime.ParamLocationQuery, *params.SortOrder); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
 	}
-	return 0
-}
 
-type AdminSyncIdentityResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *struct {
-		Error string `json:"error"`
+	if params.PageSize != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
 	}
-	JSON500 *struct {
-		Error string `json:"error"`
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r AdminSyncIdentityResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewUserListFavoritesRequest generates requests for UserListFavorites
+func NewUserListFavoritesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AdminSyncIdentityResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/api/v1/favorites")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type AdminListRequestsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Next     *string   `json:"next"`
-		Requests []Request `json:"requests"`
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r AdminListRequestsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AdminListRequestsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewUserCreateFavoriteRequest calls the generic UserCreateFavorite builder with application/json body
+func NewUserCreateFavoriteRequest(server string, body UserCreateFavoriteJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewUserCreateFavoriteRequestWithBody(server, "application/json", bodyReader)
 }
 
-type AdminListTargetGroupsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		TargetGroups []TargetGroup `json:"targetGroups"`
+// NewUserCreateFavoriteRequestWithBody generates requests for UserCreateFavorite with any type of body
+func NewUserCreateFavoriteRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	JSON401 *struct {
-		Error string `json:"error"`
+
+	operationPath := fmt.Sprintf("/api/v1/favorites")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	JSON500 *struct {
-		Error string `json:"error"`
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r AdminListTargetGroupsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AdminListTargetGroupsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewUserRequestFavoritesRequest calls the generic UserRequestFavorites builder with application/json body
+func NewUserRequestFavoritesRequest(server string, body UserRequestFavoritesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewUserRequestFavoritesRequestWithBody(server, "application/json", bodyReader)
 }
 
-type AdminCreateTargetGroupResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *TargetGroup
-	JSON400      *struct {
-		Error string `json:"error"`
-	}
-	JSON401 *struct {
-		Error string `json:"error"`
+// NewUserRequestFavoritesRequestWithBody generates requests for UserRequestFavorites with any type of body
+func NewUserRequestFavoritesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	JSON409 *struct {
-		Error string `json:"error"`
+
+	operationPath := fmt.Sprintf("/api/v1/favorites/request")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	JSON500 *struct {
-		Error string `json:"error"`
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r AdminCreateTargetGroupResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AdminCreateTargetGroupResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewUserDeleteFavoriteRequest generates requests for UserDeleteFavorite
+func NewUserDeleteFavoriteRequest(server string, targetId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "targetId", runtime.ParamLocationPath, targetId)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type AdminDeleteTargetGroupResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *struct {
-		Error string `json:"error"`
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	JSON404 *struct {
-		Error string `json:"error"`
+
+	operationPath := fmt.Sprintf("/api/v1/favorites/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	JSON500 *struct {
-		Error string `json:"error"`
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r AdminDeleteTargetGroupResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AdminDeleteTargetGroupResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewUserRequestPreflightRequest calls the generic UserRequestPreflight builder with application/json body
+func NewUserRequestPreflightRequest(server string, params *UserRequestPreflightParams, body UserRequestPreflightJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewUserRequestPreflightRequestWithBody(server, params, "application/json", bodyReader)
 }
 
-type AdminGetTargetGroupResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TargetGroup
-	JSON401      *struct {
-		Error string `json:"error"`
+// NewUserRequestPreflightRequestWithBody generates requests for UserRequestPreflight with any type of body
+func NewUserRequestPreflightRequestWithBody(server string, params *UserRequestPreflightParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	JSON404 *struct {
-		Error string `json:"error"`
+
+	operationPath := fmt.Sprintf("/api/v1/preflight")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	JSON500 *struct {
-		Error string `json:"error"`
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r AdminGetTargetGroupResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AdminGetTargetGroupResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req.Header.Add("Content-Type", contentType)
+
+	if params.IdempotencyKey != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Idempotency-Key", headerParam0)
 	}
-	return 0
+
+	return req, nil
 }
 
-type AdminCreateTargetGroupLinkResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TargetRoute
-	JSON400      *struct {
-		Error string `json:"error"`
-	}
-	JSON401 *struct {
-		Error string `json:"error"`
+// NewUserGetPreflightRequest generates requests for UserGetPreflight
+func NewUserGetPreflightRequest(server string, preflightId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "preflightId", runtime.ParamLocationPath, preflightId)
+	if err != nil {
+		return nil, err
 	}
-	JSON404 *struct {
-		Error string `json:"error"`
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	JSON500 *struct {
-		Error string `json:"error"`
+
+	operationPath := fmt.Sprintf("/api/v1/preflight/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r AdminCreateTargetGroupLinkResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AdminCreateTargetGroupLinkResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	return req, nil
 }
 
-type AdminGetTargetGroupResourcesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]TargetGroupResource
-	JSON401      *struct {
-		Error string `json:"error"`
-	}
-	JSON404 *struct {
-		Error string `json:"error"`
-	}
-	JSON500 *struct {
-		Error string `json:"error"`
+// NewUserListRequestsRequest generates requests for UserListRequests
+func NewUserListRequestsRequest(server string, params *UserListRequestsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r AdminGetTargetGroupResourcesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/api/v1/requests")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AdminGetTargetGroupResourcesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type AdminFilterTargetGroupResourcesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]TargetGroupResource
-}
+	queryValues := queryURL.Query()
 
-// Status returns HTTPResponse.Status
-func (r AdminFilterTargetGroupResourcesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	if params.NextToken != nil {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AdminFilterTargetGroupResourcesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "nextToken", runtime.ParamLocationQuery, *params.NextToken); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-type AdminListTargetRoutesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Next   *string       `json:"next,omitempty"`
-		Routes []TargetRoute `json:"routes"`
-	}
-	JSON404 *struct {
-		Error string `json:"error"`
-	}
-	JSON500 *struct {
-		Error string `json:"error"`
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r AdminListTargetRoutesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	if params.Filter != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AdminListTargetRoutesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	return req, nil
 }
 
-type AdminRemoveTargetGroupLinkResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *struct {
-		Error string `json:"error"`
-	}
-	JSON401 *struct {
-		Error string `json:"error"`
-	}
-	JSON404 *struct {
-		Error string `json:"error"`
-	}
-	JSON500 *struct {
-		Error string `json:"error"`
+// NewUserPostRequestsRequest calls the generic UserPostRequests builder with application/json body
+func NewUserPostRequestsRequest(server string, params *UserPostRequestsParams, body UserPostRequestsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUserPostRequestsRequestWithBody(server, params, "application/json", bodyReader)
 }
 
-// Status returns HTTPResponse.Status
-func (r AdminRemoveTargetGroupLinkResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewUserPostRequestsRequestWithBody generates requests for UserPostRequests with any type of body
+func NewUserPostRequestsRequestWithBody(server string, params *UserPostRequestsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AdminRemoveTargetGroupLinkResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/api/v1/requests")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type AdminListUsersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Next  *string `json:"next"`
-		Users []User  `json:"users"`
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r AdminListUsersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AdminListUsersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req.Header.Add("Content-Type", contentType)
+
+	if params.IdempotencyKey != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Idempotency-Key", headerParam0)
 	}
-	return 0
+
+	return req, nil
 }
 
-type AdminCreateUserResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *User
-	JSON400      *struct {
-		Error string `json:"error"`
+// NewUserGetRequestRequest generates requests for UserGetRequest
+func NewUserGetRequestRequest(server string, requestId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "requestId", runtime.ParamLocationPath, requestId)
+	if err != nil {
+		return nil, err
 	}
-	JSON401 *struct {
-		Error string `json:"error"`
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	JSON500 *struct {
-		Error string `json:"error"`
+
+	operationPath := fmt.Sprintf("/api/v1/requests/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r AdminCreateUserResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AdminCreateUserResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	return req, nil
 }
 
-type AdminUpdateUserResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *User
-	JSON400      *struct {
-		Error string `json:"error"`
+// NewUserListRequestEventsRequest generates requests for UserListRequestEvents
+func NewUserListRequestEventsRequest(server string, requestId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "requestId", runtime.ParamLocationPath, requestId)
+	if err != nil {
+		return nil, err
 	}
-	JSON401 *struct {
-		Error string `json:"error"`
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	JSON500 *struct {
-		Error string `json:"error"`
+
+	operationPath := fmt.Sprintf("/api/v1/requests/%s/events", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r AdminUpdateUserResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AdminUpdateUserResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	return req, nil
 }
 
-type UserListEntitlementsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Entitlements []TargetKind `json:"entitlements"`
-	}
-	JSON500 *struct {
-		Error string `json:"error"`
+// NewUserReviewRequestRequest calls the generic UserReviewRequest builder with application/json body
+func NewUserReviewRequestRequest(server string, requestId string, groupId string, body UserReviewRequestJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUserReviewRequestRequestWithBody(server, requestId, groupId, "application/json", bodyReader)
 }
 
-// Status returns HTTPResponse.Status
-func (r UserListEntitlementsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// NewUserReviewRequestRequestWithBody generates requests for UserReviewRequest with any type of body
+func NewUserReviewRequestRequestWithBody(server string, requestId string, groupId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserListEntitlementsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	var pathParam0 string
 
-type UserListEntitlementTargetsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Next    *string  `json:"next,omitempty"`
-		Targets []Target `json:"targets"`
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "requestId", runtime.ParamLocationPath, requestId)
+	if err != nil {
+		return nil, err
 	}
-	JSON400 *struct {
-		Error string `json:"error"`
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+	if err != nil {
+		return nil, err
 	}
-	JSON404 *struct {
-		Error string `json:"error"`
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	JSON500 *struct {
-		Error string `json:"error"`
+
+	operationPath := fmt.Sprintf("/api/v1/requests/%s/review/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r UserListEntitlementTargetsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserListEntitlementTargetsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-type UserRequestPreflightResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Preflight
-	JSON400      *struct {
-		Error string `json:"error"`
-	}
-	JSON401 *struct {
-		Error string `json:"error"`
-	}
-	JSON404 *struct {
-		Error string `json:"error"`
-	}
-	JSON500 *struct {
-		Error string `json:"error"`
+// NewUserCancelRequestRequest generates requests for UserCancelRequest
+func NewUserCancelRequestRequest(server string, requestid string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "requestid", runtime.ParamLocationPath, requestid)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r UserRequestPreflightResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserRequestPreflightResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/api/v1/requests/%s/cancel", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type UserGetPreflightResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Preflight
-	JSON404      *struct {
-		Error string `json:"error"`
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	JSON500 *struct {
-		Error string `json:"error"`
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r UserGetPreflightResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewUserActivateRequestGroupRequest generates requests for UserActivateRequestGroup
+func NewUserActivateRequestGroupRequest(server string, requestid string, groupid string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "requestid", runtime.ParamLocationPath, requestid)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserGetPreflightResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "groupid", runtime.ParamLocationPath, groupid)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UserListRequestsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Next     *string   `json:"next"`
-		Requests []Request `json:"requests"`
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	JSON500 *struct {
-		Error string `json:"error"`
+
+	operationPath := fmt.Sprintf("/api/v1/requests/%s/groups/%s/activate", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r UserListRequestsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserListRequestsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	return req, nil
 }
 
-type UserPostRequestsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Request
-	JSON404      *struct {
-		Error string `json:"error"`
-	}
-	JSON500 *struct {
-		Error string `json:"error"`
+// NewUserRevokeRequestTargetRequest generates requests for UserRevokeRequestTarget
+func NewUserRevokeRequestTargetRequest(server string, requestid string, groupid string, targetid string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "requestid", runtime.ParamLocationPath, requestid)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r UserPostRequestsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "groupid", runtime.ParamLocationPath, groupid)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserPostRequestsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "targetid", runtime.ParamLocationPath, targetid)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UserGetRequestResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Request
-	JSON404      *struct {
-		Error string `json:"error"`
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	JSON500 *struct {
-		Error string `json:"error"`
+
+	operationPath := fmt.Sprintf("/api/v1/requests/%s/groups/%s/targets/%s/revoke", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r UserGetRequestResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserGetRequestResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	return req, nil
 }
 
-type UserListRequestEventsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Events []RequestEvent `json:"events"`
-		Next   *string        `json:"next"`
-	}
-	JSON401 *struct {
-		Error string `json:"error"`
+// NewUserRevokeRequestRequest generates requests for UserRevokeRequest
+func NewUserRevokeRequestRequest(server string, requestid string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "requestid", runtime.ParamLocationPath, requestid)
+	if err != nil {
+		return nil, err
 	}
-	JSON500 *struct {
-		Error string `json:"error"`
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r UserListRequestEventsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/api/v1/requests/%s/revoke", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserListRequestEventsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UserReviewRequestResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		// A request to access something made by an end user in Common Fate.
-		Request *Request `json:"request,omitempty"`
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r UserReviewRequestResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewUserListReviewsRequest generates requests for UserListReviews
+func NewUserListReviewsRequest(server string, params *UserListReviewsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserReviewRequestResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/api/v1/reviews")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type UserCancelRequestResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *struct {
-		Error string `json:"error"`
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	JSON404 *struct {
-		Error string `json:"error"`
+
+	queryValues := queryURL.Query()
+
+	if params.Status != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
 	}
-	JSON500 *struct {
-		Error string `json:"error"`
+
+	if params.NextToken != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "nextToken", runtime.ParamLocationQuery, *params.NextToken); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r UserCancelRequestResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserCancelRequestResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewGetGroupTargetInstructionsRequest generates requests for GetGroupTargetInstructions
+func NewGetGroupTargetInstructionsRequest(server string, targetId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "targetId", runtime.ParamLocationPath, targetId)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UserRevokeRequestResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *struct {
-		Error string `json:"error"`
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	JSON404 *struct {
-		Error string `json:"error"`
+
+	operationPath := fmt.Sprintf("/api/v1/targets/%s/access-instructions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	JSON500 *struct {
-		Error string `json:"error"`
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r UserRevokeRequestResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserRevokeRequestResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewUserGetMeRequest generates requests for UserGetMe
+func NewUserGetMeRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UserListReviewsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Next     *string   `json:"next"`
-		Requests []Request `json:"requests"`
+	operationPath := fmt.Sprintf("/api/v1/users/me")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	JSON500 *struct {
-		Error string `json:"error"`
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r UserListReviewsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserListReviewsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewUserGetUserRequest generates requests for UserGetUser
+func NewUserGetUserRequest(server string, userId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetGroupTargetInstructionsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		// Instructions on how to access the requested resource.
-		//
-		// The `instructions` field will be null if no instructions are available.
-		Instructions RequestAccessGroupTargetAccessInstructions `json:"instructions"`
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	JSON404 *struct {
-		Error string `json:"error"`
+
+	operationPath := fmt.Sprintf("/api/v1/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	JSON500 *struct {
-		Error string `json:"error"`
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r GetGroupTargetInstructionsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetGroupTargetInstructionsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
 	}
-	return 0
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-type UserGetMeResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		// Whether the user is an administrator of Common Fate.
-		IsAdmin bool `json:"isAdmin"`
-		User    User `json:"user"`
-	}
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
 }
 
-// Status returns HTTPResponse.Status
-func (r UserGetMeResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return &ClientWithResponses{client}, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserGetMeResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
 	}
-	return 0
 }
 
-type UserGetUserResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *User
-}
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// UserListAccessTemplates request
+	UserListAccessTemplatesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserListAccessTemplatesResponse, error)
 
-// Status returns HTTPResponse.Status
-func (r UserGetUserResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	// AdminListAccessRules request
+	AdminListAccessRulesWithResponse(ctx context.Context, params *AdminListAccessRulesParams, reqEditors ...RequestEditorFn) (*AdminListAccessRulesResponse, error)
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserGetUserResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	// AdminCreateAccessRule request with any body
+	AdminCreateAccessRuleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminCreateAccessRuleResponse, error)
 
-// UserListAccessTemplatesWithResponse request returning *UserListAccessTemplatesResponse
-func (c *ClientWithResponses) UserListAccessTemplatesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserListAccessTemplatesResponse, error) {
-	rsp, err := c.UserListAccessTemplates(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUserListAccessTemplatesResponse(rsp)
-}
+	AdminCreateAccessRuleWithResponse(ctx context.Context, body AdminCreateAccessRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminCreateAccessRuleResponse, error)
 
-// AdminListAccessRulesWithResponse request returning *AdminListAccessRulesResponse
-func (c *ClientWithResponses) AdminListAccessRulesWithResponse(ctx context.Context, params *AdminListAccessRulesParams, reqEditors ...RequestEditorFn) (*AdminListAccessRulesResponse, error) {
-	rsp, err := c.AdminListAccessRules(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseAdminListAccessRulesResponse(rsp)
-}
+	// AdminDeleteAccessRule request
+	AdminDeleteAccessRuleWithResponse(ctx context.Context, ruleId string, reqEditors ...RequestEditorFn) (*AdminDeleteAccessRuleResponse, error)
 
-// AdminCreateAccessRuleWithBodyWithResponse request with arbitrary body returning *AdminCreateAccessRuleResponse
-func (c *ClientWithResponses) AdminCreateAccessRuleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminCreateAccessRuleResponse, error) {
-	rsp, err := c.AdminCreateAccessRuleWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseAdminCreateAccessRuleResponse(rsp)
-}
+	// AdminGetAccessRule request
+	AdminGetAccessRuleWithResponse(ctx context.Context, ruleId string, reqEditors ...RequestEditorFn) (*AdminGetAccessRuleResponse, error)
 
-func (c *ClientWithResponses) AdminCreateAccessRuleWithResponse(ctx context.Context, body AdminCreateAccessRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminCreateAccessRuleResponse, error) {
-	rsp, err := c.AdminCreateAccessRule(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseAdminCreateAccessRuleResponse(rsp)
-}
+	// AdminUpdateAccessRule request with any body
+	AdminUpdateAccessRuleWithBodyWithResponse(ctx context.Context, ruleId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminUpdateAccessRuleResponse, error)
 
-// AdminDeleteAccessRuleWithResponse request returning *AdminDeleteAccessRuleResponse
-func (c *ClientWithResponses) AdminDeleteAccessRuleWithResponse(ctx context.Context, ruleId string, reqEditors ...RequestEditorFn) (*AdminDeleteAccessRuleResponse, error) {
-	rsp, err := c.AdminDeleteAccessRule(ctx, ruleId, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseAdminDeleteAccessRuleResponse(rsp)
-}
+	AdminUpdateAccessRuleWithResponse(ctx context.Context, ruleId string, body AdminUpdateAccessRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminUpdateAccessRuleResponse, error)
 
-// AdminGetAccessRuleWithResponse request returning *AdminGetAccessRuleResponse
-func (c *ClientWithResponses) AdminGetAccessRuleWithResponse(ctx context.Context, ruleId string, reqEditors ...RequestEditorFn) (*AdminGetAccessRuleResponse, error) {
-	rsp, err := c.AdminGetAccessRule(ctx, ruleId, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseAdminGetAccessRuleResponse(rsp)
-}
+	// AdminListBulkRevokeJobs request
+	AdminListBulkRevokeJobsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListBulkRevokeJobsResponse, error)
 
-// AdminUpdateAccessRuleWithBodyWithResponse request with arbitrary body returning *AdminUpdateAccessRuleResponse
-func (c *ClientWithResponses) AdminUpdateAccessRuleWithBodyWithResponse(ctx context.Context, ruleId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminUpdateAccessRuleResponse, error) {
-	rsp, err := c.AdminUpdateAccessRuleWithBody(ctx, ruleId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseAdminUpdateAccessRuleResponse(rsp)
-}
+	// AdminCreateBulkRevokeJob request with any body
+	AdminCreateBulkRevokeJobWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminCreateBulkRevokeJobResponse, error)
 
-func (c *ClientWithResponses) AdminUpdateAccessRuleWithResponse(ctx context.Context, ruleId string, body AdminUpdateAccessRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminUpdateAccessRuleResponse, error) {
-	rsp, err := c.AdminUpdateAccessRule(ctx, ruleId, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseAdminUpdateAccessRuleResponse(rsp)
-}
+	AdminCreateBulkRevokeJobWithResponse(ctx context.Context, body AdminCreateBulkRevokeJobJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminCreateBulkRevokeJobResponse, error)
 
-// AdminGetDeploymentVersionWithResponse request returning *AdminGetDeploymentVersionResponse
-func (c *ClientWithResponses) AdminGetDeploymentVersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminGetDeploymentVersionResponse, error) {
-	rsp, err := c.AdminGetDeploymentVersion(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseAdminGetDeploymentVersionResponse(rsp)
-}
+	// AdminGetBulkRevokeJob request
+	AdminGetBulkRevokeJobWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*AdminGetBulkRevokeJobResponse, error)
 
-// AdminListGroupsWithResponse request returning *AdminListGroupsResponse
-func (c *ClientWithResponses) AdminListGroupsWithResponse(ctx context.Context, params *AdminListGroupsParams, reqEditors ...RequestEditorFn) (*AdminListGroupsResponse, error) {
-	rsp, err := c.AdminListGroups(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseAdminListGroupsResponse(rsp)
-}
+	// AdminListDeadLetterEvents request
+	AdminListDeadLetterEventsWithResponse(ctx context.Context, params *AdminListDeadLetterEventsParams, reqEditors ...RequestEditorFn) (*AdminListDeadLetterEventsResponse, error)
 
-// AdminCreateGroupWithBodyWithResponse request with arbitrary body returning *AdminCreateGroupResponse
-func (c *ClientWithResponses) AdminCreateGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminCreateGroupResponse, error) {
-	rsp, err := c.AdminCreateGroupWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseAdminCreateGroupResponse(rsp)
-}
+	// AdminDiscardDeadLetterEvent request
+	AdminDiscardDeadLetterEventWithResponse(ctx context.Context, eventId string, reqEditors ...RequestEditorFn) (*AdminDiscardDeadLetterEventResponse, error)
 
-func (c *ClientWithResponses) AdminCreateGroupWithResponse(ctx context.Context, body AdminCreateGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminCreateGroupResponse, error) {
-	rsp, err := c.AdminCreateGroup(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseAdminCreateGroupResponse(rsp)
-}
+	// AdminGetDeadLetterEvent request
+	AdminGetDeadLetterEventWithResponse(ctx context.Context, eventId string, reqEditors ...RequestEditorFn) (*AdminGetDeadLetterEventResponse, error)
 
-// AdminDeleteGroupWithResponse request returning *AdminDeleteGroupResponse
-func (c *ClientWithResponses) AdminDeleteGroupWithResponse(ctx context.Context, groupId string, reqEditors ...RequestEditorFn) (*AdminDeleteGroupResponse, error) {
-	rsp, err := c.AdminDeleteGroup(ctx, groupId, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseAdminDeleteGroupResponse(rsp)
-}
+	// AdminReplayDeadLetterEvent request
+	AdminReplayDeadLetterEventWithResponse(ctx context.Context, eventId string, reqEditors ...RequestEditorFn) (*AdminReplayDeadLetterEventResponse, error)
 
-// AdminGetGroupWithResponse request returning *AdminGetGroupResponse
-func (c *ClientWithResponses) AdminGetGroupWithResponse(ctx context.Context, groupId string, reqEditors ...RequestEditorFn) (*AdminGetGroupResponse, error) {
-	rsp, err := c.AdminGetGroup(ctx, groupId, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseAdminGetGroupResponse(rsp)
-}
+	// AdminGetDeploymentVersion request
+	AdminGetDeploymentVersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminGetDeploymentVersionResponse, error)
 
-// AdminUpdateGroupWithBodyWithResponse request with arbitrary body returning *AdminUpdateGroupResponse
-func (c *ClientWithResponses) AdminUpdateGroupWithBodyWithResponse(ctx context.Context, groupId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminUpdateGroupResponse, error) {
-	rsp, err := c.AdminUpdateGroupWithBody(ctx, groupId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseAdminUpdateGroupResponse(rsp)
-}
+	// AdminListGrantDrift request
+	AdminListGrantDriftWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListGrantDriftResponse, error)
 
-func (c *ClientWithResponses) AdminUpdateGroupWithResponse(ctx context.Context, groupId string, body AdminUpdateGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminUpdateGroupResponse, error) {
-	rsp, err := c.AdminUpdateGroup(ctx, groupId, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseAdminUpdateGroupResponse(rsp)
-}
+	// AdminListEventSchemas request
+	AdminListEventSchemasWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListEventSchemasResponse, error)
 
-// AdminListHandlersWithResponse request returning *AdminListHandlersResponse
-func (c *ClientWithResponses) AdminListHandlersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListHandlersResponse, error) {
-	rsp, err := c.AdminListHandlers(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseAdminListHandlersResponse(rsp)
-}
+	// AdminGetEventSchema request
+	AdminGetEventSchemaWithResponse(ctx context.Context, eventType string, params *AdminGetEventSchemaParams, reqEditors ...RequestEditorFn) (*AdminGetEventSchemaResponse, error)
 
-// AdminRegisterHandlerWithBodyWithResponse request with arbitrary body returning *AdminRegisterHandlerResponse
-func (c *ClientWithResponses) AdminRegisterHandlerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminRegisterHandlerResponse, error) {
-	rsp, err := c.AdminRegisterHandlerWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseAdminRegisterHandlerResponse(rsp)
-}
+	// AdminListFailedGrants request
+	AdminListFailedGrantsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListFailedGrantsResponse, error)
 
-func (c *ClientWithResponses) AdminRegisterHandlerWithResponse(ctx context.Context, body AdminRegisterHandlerJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminRegisterHandlerResponse, error) {
-	rsp, err := c.AdminRegisterHandler(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseAdminRegisterHandlerResponse(rsp)
-}
+	// AdminCompleteFailedGrant request
+	AdminCompleteFailedGrantWithResponse(ctx context.Context, grantId string, reqEditors ...RequestEditorFn) (*AdminCompleteFailedGrantResponse, error)
 
-// AdminDeleteHandlerWithResponse request returning *AdminDeleteHandlerResponse
-func (c *ClientWithResponses) AdminDeleteHandlerWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*AdminDeleteHandlerResponse, error) {
-	rsp, err := c.AdminDeleteHandler(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseAdminDeleteHandlerResponse(rsp)
-}
+	// AdminRetryFailedGrant request
+	AdminRetryFailedGrantWithResponse(ctx context.Context, grantId string, reqEditors ...RequestEditorFn) (*AdminRetryFailedGrantResponse, error)
 
-// AdminGetHandlerWithResponse request returning *AdminGetHandlerResponse
-func (c *ClientWithResponses) AdminGetHandlerWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*AdminGetHandlerResponse, error) {
+	// AdminListFreezes request
+	AdminListFreezesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListFreezesResponse, error)
+
+	// AdminCreateFreeze request with any body
+	AdminCreateFreezeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminCreateFreezeResponse, error)
+
+	AdminCreateFreezeWithResponse(ctx context.Context, body AdminCreateFreezeJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminCreateFreezeResponse, error)
+
+	// AdminGetFreeze request
+	AdminGetFreezeWithResponse(ctx context.Context, freezeId string, reqEditors ...RequestEditorFn) (*AdminGetFreezeResponse, error)
+
+	// AdminUpdateFreeze request with any body
+	AdminUpdateFreezeWithBodyWithResponse(ctx context.Context, freezeId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminUpdateFreezeResponse, error)
+
+	AdminUpdateFreezeWithResponse(ctx context.Context, freezeId string, body AdminUpdateFreezeJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminUpdateFreezeResponse, error)
+
+	// AdminListFreezeHistory request
+	AdminListFreezeHistoryWithResponse(ctx context.Context, freezeId string, reqEditors ...RequestEditorFn) (*AdminListFreezeHistoryResponse, error)
+
+	// AdminLiftFreeze request
+	AdminLiftFreezeWithResponse(ctx context.Context, freezeId string, reqEditors ...RequestEditorFn) (*AdminLiftFreezeResponse, error)
+
+	// AdminListGroups request
+	AdminListGroupsWithResponse(ctx context.Context, params *AdminListGroupsParams, reqEditors ...RequestEditorFn) (*AdminListGroupsResponse, error)
+
+	// AdminCreateGroup request with any body
+	AdminCreateGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminCreateGroupResponse, error)
+
+	AdminCreateGroupWithResponse(ctx context.Context, body AdminCreateGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminCreateGroupResponse, error)
+
+	// AdminDeleteGroup request
+	AdminDeleteGroupWithResponse(ctx context.Context, groupId string, reqEditors ...RequestEditorFn) (*AdminDeleteGroupResponse, error)
+
+	// AdminGetGroup request
+	AdminGetGroupWithResponse(ctx context.Context, groupId string, reqEditors ...RequestEditorFn) (*AdminGetGroupResponse, error)
+
+	// AdminUpdateGroup request with any body
+	AdminUpdateGroupWithBodyWithResponse(ctx context.Context, groupId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminUpdateGroupResponse, error)
+
+	AdminUpdateGroupWithResponse(ctx context.Context, groupId string, body AdminUpdateGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminUpdateGroupResponse, error)
+
+	// AdminListHandlers request
+	AdminListHandlersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListHandlersResponse, error)
+
+	// AdminRegisterHandler request with any body
+	AdminRegisterHandlerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminRegisterHandlerResponse, error)
+
+	AdminRegisterHandlerWithResponse(ctx context.Context, body AdminRegisterHandlerJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminRegisterHandlerResponse, error)
+
+	// AdminDeleteHandler request
+	AdminDeleteHandlerWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*AdminDeleteHandlerResponse, error)
+
+	// AdminGetHandler request
+	AdminGetHandlerWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*AdminGetHandlerResponse, error)
+
+	// AdminHealthcheckHandlers request
+	AdminHealthcheckHandlersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminHealthcheckHandlersResponse, error)
+
+	// AdminGetIdentityConfiguration request
+	AdminGetIdentityConfigurationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminGetIdentityConfigurationResponse, error)
+
+	// AdminSyncIdentity request
+	AdminSyncIdentityWithResponse(ctx context.Context, params *AdminSyncIdentityParams, reqEditors ...RequestEditorFn) (*AdminSyncIdentityResponse, error)
+
+	// AdminListIdentitySyncRuns request
+	AdminListIdentitySyncRunsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListIdentitySyncRunsResponse, error)
+
+	// AdminListRequests request
+	AdminListRequestsWithResponse(ctx context.Context, params *AdminListRequestsParams, reqEditors ...RequestEditorFn) (*AdminListRequestsResponse, error)
+
+	// AdminReplayRequestEvents request
+	AdminReplayRequestEventsWithResponse(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*AdminReplayRequestEventsResponse, error)
+
+	// AdminListTargetGroups request
+	AdminListTargetGroupsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListTargetGroupsResponse, error)
+
+	// AdminCreateTargetGroup request with any body
+	AdminCreateTargetGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminCreateTargetGroupResponse, error)
+
+	AdminCreateTargetGroupWithResponse(ctx context.Context, body AdminCreateTargetGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminCreateTargetGroupResponse, error)
+
+	// AdminDeleteTargetGroup request
+	AdminDeleteTargetGroupWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*AdminDeleteTargetGroupResponse, error)
+
+	// AdminGetTargetGroup request
+	AdminGetTargetGroupWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*AdminGetTargetGroupResponse, error)
+
+	// AdminCreateTargetGroupLink request with any body
+	AdminCreateTargetGroupLinkWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminCreateTargetGroupLinkResponse, error)
+
+	AdminCreateTargetGroupLinkWithResponse(ctx context.Context, id string, body AdminCreateTargetGroupLinkJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminCreateTargetGroupLinkResponse, error)
+
+	// AdminGetTargetGroupResources request
+	AdminGetTargetGroupResourcesWithResponse(ctx context.Context, id string, resourceType string, reqEditors ...RequestEditorFn) (*AdminGetTargetGroupResourcesResponse, error)
+
+	// AdminFilterTargetGroupResources request with any body
+	AdminFilterTargetGroupResourcesWithBodyWithResponse(ctx context.Context, id string, resourceType string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminFilterTargetGroupResourcesResponse, error)
+
+	AdminFilterTargetGroupResourcesWithResponse(ctx context.Context, id string, resourceType string, body AdminFilterTargetGroupResourcesJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminFilterTargetGroupResourcesResponse, error)
+
+	// AdminListTargetRoutes request
+	AdminListTargetRoutesWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*AdminListTargetRoutesResponse, error)
+
+	// AdminSyncTargetGroup request
+	AdminSyncTargetGroupWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*AdminSyncTargetGroupResponse, error)
+
+	// AdminRemoveTargetGroupLink request
+	AdminRemoveTargetGroupLinkWithResponse(ctx context.Context, id string, params *AdminRemoveTargetGroupLinkParams, reqEditors ...RequestEditorFn) (*AdminRemoveTargetGroupLinkResponse, error)
+
+	// AdminListTargetMetadata request
+	AdminListTargetMetadataWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListTargetMetadataResponse, error)
+
+	// AdminCreateTargetMetadata request with any body
+	AdminCreateTargetMetadataWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminCreateTargetMetadataResponse, error)
+
+	AdminCreateTargetMetadataWithResponse(ctx context.Context, body AdminCreateTargetMetadataJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminCreateTargetMetadataResponse, error)
+
+	// AdminDeleteTargetMetadata request
+	AdminDeleteTargetMetadataWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*AdminDeleteTargetMetadataResponse, error)
+
+	// AdminUpdateTargetMetadata request with any body
+	AdminUpdateTargetMetadataWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminUpdateTargetMetadataResponse, error)
+
+	AdminUpdateTargetMetadataWithResponse(ctx context.Context, id string, body AdminUpdateTargetMetadataJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminUpdateTargetMetadataResponse, error)
+
+	// AdminListUsers request
+	AdminListUsersWithResponse(ctx context.Context, params *AdminListUsersParams, reqEditors ...RequestEditorFn) (*AdminListUsersResponse, error)
+
+	// AdminCreateUser request with any body
+	AdminCreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminCreateUserResponse, error)
+
+	AdminCreateUserWithResponse(ctx context.Context, body AdminCreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminCreateUserResponse, error)
+
+	// AdminUpdateUser request with any body
+	AdminUpdateUserWithBodyWithResponse(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminUpdateUserResponse, error)
+
+	AdminUpdateUserWithResponse(ctx context.Context, userId string, body AdminUpdateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminUpdateUserResponse, error)
+
+	// AdminListUserGroupMemberships request
+	AdminListUserGroupMembershipsWithResponse(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*AdminListUserGroupMembershipsResponse, error)
+
+	// UserListEntitlements request
+	UserListEntitlementsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserListEntitlementsResponse, error)
+
+	// UserListEntitlementTargets request
+	UserListEntitlementTargetsWithResponse(ctx context.Context, params *UserListEntitlementTargetsParams, reqEditors ...RequestEditorFn) (*UserListEntitlementTargetsResponse, error)
+
+	// UserListFavorites request
+	UserListFavoritesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserListFavoritesResponse, error)
+
+	// UserCreateFavorite request with any body
+	UserCreateFavoriteWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserCreateFavoriteResponse, error)
+
+	UserCreateFavoriteWithResponse(ctx context.Context, body UserCreateFavoriteJSONRequestBody, reqEditors ...RequestEditorFn) (*UserCreateFavoriteResponse, error)
+
+	// UserRequestFavorites request with any body
+	UserRequestFavoritesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserRequestFavoritesResponse, error)
+
+	UserRequestFavoritesWithResponse(ctx context.Context, body UserRequestFavoritesJSONRequestBody, reqEditors ...RequestEditorFn) (*UserRequestFavoritesResponse, error)
+
+	// UserDeleteFavorite request
+	UserDeleteFavoriteWithResponse(ctx context.Context, targetId string, reqEditors ...RequestEditorFn) (*UserDeleteFavoriteResponse, error)
+
+	// UserRequestPreflight request with any body
+	UserRequestPreflightWithBodyWithResponse(ctx context.Context, params *UserRequestPreflightParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserRequestPreflightResponse, error)
+
+	UserRequestPreflightWithResponse(ctx context.Context, params *UserRequestPreflightParams, body UserRequestPreflightJSONRequestBody, reqEditors ...RequestEditorFn) (*UserRequestPreflightResponse, error)
+
+	// UserGetPreflight request
+	UserGetPreflightWithResponse(ctx context.Context, preflightId string, reqEditors ...RequestEditorFn) (*UserGetPreflightResponse, error)
+
+	// UserListRequests request
+	UserListRequestsWithResponse(ctx context.Context, params *UserListRequestsParams, reqEditors ...RequestEditorFn) (*UserListRequestsResponse, error)
+
+	// UserPostRequests request with any body
+	UserPostRequestsWithBodyWithResponse(ctx context.Context, params *UserPostRequestsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserPostRequestsResponse, error)
+
+	UserPostRequestsWithResponse(ctx context.Context, params *UserPostRequestsParams, body UserPostRequestsJSONRequestBody, reqEditors ...RequestEditorFn) (*UserPostRequestsResponse, error)
+
+	// UserGetRequest request
+	UserGetRequestWithResponse(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*UserGetRequestResponse, error)
+
+	// UserListRequestEvents request
+	UserListRequestEventsWithResponse(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*UserListRequestEventsResponse, error)
+
+	// UserReviewRequest request with any body
+	UserReviewRequestWithBodyWithResponse(ctx context.Context, requestId string, groupId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserReviewRequestResponse, error)
+
+	UserReviewRequestWithResponse(ctx context.Context, requestId string, groupId string, body UserReviewRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*UserReviewRequestResponse, error)
+
+	// UserCancelRequest request
+	UserCancelRequestWithResponse(ctx context.Context, requestid string, reqEditors ...RequestEditorFn) (*UserCancelRequestResponse, error)
+
+	// UserActivateRequestGroup request
+	UserActivateRequestGroupWithResponse(ctx context.Context, requestid string, groupid string, reqEditors ...RequestEditorFn) (*UserActivateRequestGroupResponse, error)
+
+	// UserRevokeRequestTarget request
+	UserRevokeRequestTargetWithResponse(ctx context.Context, requestid string, groupid string, targetid string, reqEditors ...RequestEditorFn) (*UserRevokeRequestTargetResponse, error)
+
+	// UserRevokeRequest request
+	UserRevokeRequestWithResponse(ctx context.Context, requestid string, reqEditors ...RequestEditorFn) (*UserRevokeRequestResponse, error)
+
+	// UserListReviews request
+	UserListReviewsWithResponse(ctx context.Context, params *UserListReviewsParams, reqEditors ...RequestEditorFn) (*UserListReviewsResponse, error)
+
+	// GetGroupTargetInstructions request
+	GetGroupTargetInstructionsWithResponse(ctx context.Context, targetId string, reqEditors ...RequestEditorFn) (*GetGroupTargetInstructionsResponse, error)
+
+	// UserGetMe request
+	UserGetMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserGetMeResponse, error)
+
+	// UserGetUser request
+	UserGetUserWithResponse(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*UserGetUserResponse, error)
+}
+
+type UserListAccessTemplatesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		AccessTemplates []AccessTemplate `json:"accessTemplates"`
+		Next            *string          `json:"next,omitempty"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r UserListAccessTemplatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserListAccessTemplatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminListAccessRulesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		AccessRules []AccessRule `json:"accessRules"`
+		Next        *string      `json:"next"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminListAccessRulesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminListAccessRulesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminCreateAccessRuleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *AccessRule
+	JSON400      *struct {
+		Error string `json:"error"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminCreateAccessRuleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminCreateAccessRuleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminDeleteAccessRuleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminDeleteAccessRuleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminDeleteAccessRuleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminGetAccessRuleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AccessRule
+	JSON401      *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminGetAccessRuleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminGetAccessRuleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminUpdateAccessRuleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AccessRule
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminUpdateAccessRuleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminUpdateAccessRuleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminListBulkRevokeJobsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Jobs []BulkRevokeJob `json:"jobs"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminListBulkRevokeJobsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminListBulkRevokeJobsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminCreateBulkRevokeJobResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *BulkRevokeJob
+	JSON400      *struct {
+		Error string `json:"error"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminCreateBulkRevokeJobResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminCreateBulkRevokeJobResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminGetBulkRevokeJobResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BulkRevokeJob
+	JSON401      *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminGetBulkRevokeJobResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminGetBulkRevokeJobResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminListDeadLetterEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Events []DeadLetterEvent `json:"events"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminListDeadLetterEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminListDeadLetterEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminDiscardDeadLetterEventResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminDiscardDeadLetterEventResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminDiscardDeadLetterEventResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminGetDeadLetterEventResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeadLetterEvent
+	JSON401      *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminGetDeadLetterEventResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminGetDeadLetterEventResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminReplayDeadLetterEventResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *struct {
+		Error string `json:"error"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminReplayDeadLetterEventResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminReplayDeadLetterEventResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminGetDeploymentVersionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// The deployment version. Will be a semver, such as "v0.9.0" for official releases, or "dev+GIT_HASH" for pre-release builds.
+		Version string `json:"version"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminGetDeploymentVersionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminGetDeploymentVersionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminListGrantDriftResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Drift []GrantDrift `json:"drift"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminListGrantDriftResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminListGrantDriftResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminListEventSchemasResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Schemas []EventSchema `json:"schemas"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminListEventSchemasResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminListEventSchemasResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminGetEventSchemaResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EventSchema
+	JSON401      *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminGetEventSchemaResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminGetEventSchemaResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminListFailedGrantsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		FailedGrants []FailedGrant `json:"failedGrants"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminListFailedGrantsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminListFailedGrantsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminCompleteFailedGrantResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminCompleteFailedGrantResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminCompleteFailedGrantResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminRetryFailedGrantResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminRetryFailedGrantResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminRetryFailedGrantResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminListFreezesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Freezes []Freeze `json:"freezes"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminListFreezesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminListFreezesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminCreateFreezeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Freeze
+	JSON400      *struct {
+		Error string `json:"error"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminCreateFreezeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminCreateFreezeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminGetFreezeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Freeze
+	JSON401      *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminGetFreezeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminGetFreezeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminUpdateFreezeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Freeze
+	JSON400      *struct {
+		Error string `json:"error"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminUpdateFreezeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminUpdateFreezeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminListFreezeHistoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Events []FreezeHistoryEvent `json:"events"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminListFreezeHistoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminListFreezeHistoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminLiftFreezeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Freeze
+	JSON400      *struct {
+		Error string `json:"error"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminLiftFreezeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminLiftFreezeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminListGroupsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Groups []Group `json:"groups"`
+		Next   *string `json:"next"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminListGroupsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminListGroupsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminCreateGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Group
+	JSON400      *struct {
+		Error string `json:"error"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminCreateGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminCreateGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminDeleteGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminDeleteGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminDeleteGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminGetGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Group
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminGetGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminGetGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminUpdateGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Group
+	JSON400      *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminUpdateGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminUpdateGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminListHandlersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Next string      `json:"next"`
+		Res  []TGHandler `json:"res"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminListHandlersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminListHandlersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminRegisterHandlerResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TGHandler
+	JSON400      *struct {
+		Error string `json:"error"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminRegisterHandlerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminRegisterHandlerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminDeleteHandlerResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON204      *map[string]interface{}
+	JSON401      *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminDeleteHandlerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminDeleteHandlerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminGetHandlerResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TGHandler
+	JSON401      *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminGetHandlerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminGetHandlerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminHealthcheckHandlersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminHealthcheckHandlersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminHealthcheckHandlersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminGetIdentityConfigurationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		AdministratorGroupId string `json:"administratorGroupId"`
+		IdentityProvider     string `json:"identityProvider"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminGetIdentityConfigurationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminGetIdentityConfigurationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminSyncIdentityResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IdentitySyncReport
+	JSON401      *struct {
+		Error string `json:"error"`
+	}
+	JSON409 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminSyncIdentityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminSyncIdentityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminListIdentitySyncRunsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Runs []IdentitySyncRun `json:"runs"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminListIdentitySyncRunsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminListIdentitySyncRunsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminListRequestsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Next     *string   `json:"next"`
+		Requests []Request `json:"requests"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminListRequestsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminListRequestsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminReplayRequestEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *struct {
+		Events []DeadLetterEvent `json:"events"`
+	}
+	JSON400 *struct {
+		Error string `json:"error"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminReplayRequestEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminReplayRequestEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminListTargetGroupsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		TargetGroups []TargetGroup `json:"targetGroups"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminListTargetGroupsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminListTargetGroupsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminCreateTargetGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TargetGroup
+	JSON400      *struct {
+		Error string `json:"error"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON409 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminCreateTargetGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminCreateTargetGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminDeleteTargetGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminDeleteTargetGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminDeleteTargetGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminGetTargetGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TargetGroup
+	JSON401      *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminGetTargetGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminGetTargetGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminCreateTargetGroupLinkResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TargetRoute
+	JSON400      *struct {
+		Error string `json:"error"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminCreateTargetGroupLinkResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminCreateTargetGroupLinkResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminGetTargetGroupResourcesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TargetGroupResource
+	JSON401      *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminGetTargetGroupResourcesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminGetTargetGroupResourcesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminFilterTargetGroupResourcesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TargetGroupResource
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminFilterTargetGroupResourcesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminFilterTargetGroupResourcesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminListTargetRoutesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Next   *string       `json:"next,omitempty"`
+		Routes []TargetRoute `json:"routes"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminListTargetRoutesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminListTargetRoutesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminSyncTargetGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TargetGroupSyncStatus
+	JSON401      *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminSyncTargetGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminSyncTargetGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminRemoveTargetGroupLinkResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *struct {
+		Error string `json:"error"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminRemoveTargetGroupLinkResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminRemoveTargetGroupLinkResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminListTargetMetadataResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Metadata []TargetMetadata `json:"metadata"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminListTargetMetadataResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminListTargetMetadataResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminCreateTargetMetadataResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TargetMetadata
+	JSON400      *struct {
+		Error string `json:"error"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminCreateTargetMetadataResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminCreateTargetMetadataResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminDeleteTargetMetadataResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminDeleteTargetMetadataResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminDeleteTargetMetadataResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminUpdateTargetMetadataResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TargetMetadata
+	JSON400      *struct {
+		Error string `json:"error"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminUpdateTargetMetadataResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminUpdateTargetMetadataResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminListUsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Next  *string `json:"next"`
+		Users []User  `json:"users"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminListUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminListUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminCreateUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *User
+	JSON400      *struct {
+		Error string `json:"error"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminCreateUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminCreateUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminUpdateUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+	JSON400      *struct {
+		Error string `json:"error"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminUpdateUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminUpdateUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminListUserGroupMembershipsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Memberships []UserGroupMembership `json:"memberships"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminListUserGroupMembershipsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminListUserGroupMembershipsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserListEntitlementsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Entitlements []TargetKind `json:"entitlements"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r UserListEntitlementsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserListEntitlementsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserListEntitlementTargetsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Next    *string  `json:"next,omitempty"`
+		Targets []Target `json:"targets"`
+	}
+	JSON400 *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r UserListEntitlementTargetsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserListEntitlementTargetsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserListFavoritesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Favorites []Favorite `json:"favorites"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r UserListFavoritesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserListFavoritesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserCreateFavoriteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Favorite
+	JSON400      *struct {
+		Error string `json:"error"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r UserCreateFavoriteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserCreateFavoriteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserRequestFavoritesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Request
+	JSON400      *struct {
+		Error string `json:"error"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r UserRequestFavoritesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserRequestFavoritesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserDeleteFavoriteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r UserDeleteFavoriteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserDeleteFavoriteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserRequestPreflightResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Preflight
+	JSON400      *struct {
+		Error string `json:"error"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+	JSON409 *struct {
+		Error string `json:"error"`
+	}
+	JSON422 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r UserRequestPreflightResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserRequestPreflightResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserGetPreflightResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Preflight
+	JSON404      *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r UserGetPreflightResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserGetPreflightResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserListRequestsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Next     *string   `json:"next"`
+		Requests []Request `json:"requests"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r UserListRequestsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserListRequestsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserPostRequestsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Request
+	JSON404      *struct {
+		Error string `json:"error"`
+	}
+	JSON409 *struct {
+		Error string `json:"error"`
+	}
+	JSON422 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r UserPostRequestsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserPostRequestsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserGetRequestResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Request
+	JSON404      *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r UserGetRequestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserGetRequestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserListRequestEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Events []RequestEvent `json:"events"`
+		Next   *string        `json:"next"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r UserListRequestEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserListRequestEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserReviewRequestResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// A request to access something made by an end user in Common Fate.
+		Request *Request `json:"request,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r UserReviewRequestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserReviewRequestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserCancelRequestResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r UserCancelRequestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserCancelRequestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserActivateRequestGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RequestAccessGroup
+	JSON400      *struct {
+		Error string `json:"error"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r UserActivateRequestGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserActivateRequestGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserRevokeRequestTargetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *struct {
+		Error string `json:"error"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r UserRevokeRequestTargetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserRevokeRequestTargetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserRevokeRequestResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r UserRevokeRequestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserRevokeRequestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserListReviewsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Next     *string   `json:"next"`
+		Requests []Request `json:"requests"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r UserListReviewsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserListReviewsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetGroupTargetInstructionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// Instructions on how to access the requested resource.
+		//
+		// The `instructions` field will be null if no instructions are available.
+		Instructions RequestAccessGroupTargetAccessInstructions `json:"instructions"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+	JSON500 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetGroupTargetInstructionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetGroupTargetInstructionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserGetMeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// Whether the user is an administrator of Common Fate.
+		IsAdmin bool `json:"isAdmin"`
+		User    User `json:"user"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r UserGetMeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserGetMeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserGetUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+}
+
+// Status returns HTTPResponse.Status
+func (r UserGetUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserGetUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// UserListAccessTemplatesWithResponse request returning *UserListAccessTemplatesResponse
+func (c *ClientWithResponses) UserListAccessTemplatesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserListAccessTemplatesResponse, error) {
+	rsp, err := c.UserListAccessTemplates(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserListAccessTemplatesResponse(rsp)
+}
+
+// AdminListAccessRulesWithResponse request returning *AdminListAccessRulesResponse
+func (c *ClientWithResponses) AdminListAccessRulesWithResponse(ctx context.Context, params *AdminListAccessRulesParams, reqEditors ...RequestEditorFn) (*AdminListAccessRulesResponse, error) {
+	rsp, err := c.AdminListAccessRules(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminListAccessRulesResponse(rsp)
+}
+
+// AdminCreateAccessRuleWithBodyWithResponse request with arbitrary body returning *AdminCreateAccessRuleResponse
+func (c *ClientWithResponses) AdminCreateAccessRuleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminCreateAccessRuleResponse, error) {
+	rsp, err := c.AdminCreateAccessRuleWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminCreateAccessRuleResponse(rsp)
+}
+
+func (c *ClientWithResponses) AdminCreateAccessRuleWithResponse(ctx context.Context, body AdminCreateAccessRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminCreateAccessRuleResponse, error) {
+	rsp, err := c.AdminCreateAccessRule(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminCreateAccessRuleResponse(rsp)
+}
+
+// AdminDeleteAccessRuleWithResponse request returning *AdminDeleteAccessRuleResponse
+func (c *ClientWithResponses) AdminDeleteAccessRuleWithResponse(ctx context.Context, ruleId string, reqEditors ...RequestEditorFn) (*AdminDeleteAccessRuleResponse, error) {
+	rsp, err := c.AdminDeleteAccessRule(ctx, ruleId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminDeleteAccessRuleResponse(rsp)
+}
+
+// AdminGetAccessRuleWithResponse request returning *AdminGetAccessRuleResponse
+func (c *ClientWithResponses) AdminGetAccessRuleWithResponse(ctx context.Context, ruleId string, reqEditors ...RequestEditorFn) (*AdminGetAccessRuleResponse, error) {
+	rsp, err := c.AdminGetAccessRule(ctx, ruleId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminGetAccessRuleResponse(rsp)
+}
+
+// AdminUpdateAccessRuleWithBodyWithResponse request with arbitrary body returning *AdminUpdateAccessRuleResponse
+func (c *ClientWithResponses) AdminUpdateAccessRuleWithBodyWithResponse(ctx context.Context, ruleId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminUpdateAccessRuleResponse, error) {
+	rsp, err := c.AdminUpdateAccessRuleWithBody(ctx, ruleId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminUpdateAccessRuleResponse(rsp)
+}
+
+func (c *ClientWithResponses) AdminUpdateAccessRuleWithResponse(ctx context.Context, ruleId string, body AdminUpdateAccessRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminUpdateAccessRuleResponse, error) {
+	rsp, err := c.AdminUpdateAccessRule(ctx, ruleId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminUpdateAccessRuleResponse(rsp)
+}
+
+// AdminListBulkRevokeJobsWithResponse request returning *AdminListBulkRevokeJobsResponse
+func (c *ClientWithResponses) AdminListBulkRevokeJobsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListBulkRevokeJobsResponse, error) {
+	rsp, err := c.AdminListBulkRevokeJobs(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminListBulkRevokeJobsResponse(rsp)
+}
+
+// AdminCreateBulkRevokeJobWithBodyWithResponse request with arbitrary body returning *AdminCreateBulkRevokeJobResponse
+func (c *ClientWithResponses) AdminCreateBulkRevokeJobWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminCreateBulkRevokeJobResponse, error) {
+	rsp, err := c.AdminCreateBulkRevokeJobWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminCreateBulkRevokeJobResponse(rsp)
+}
+
+func (c *ClientWithResponses) AdminCreateBulkRevokeJobWithResponse(ctx context.Context, body AdminCreateBulkRevokeJobJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminCreateBulkRevokeJobResponse, error) {
+	rsp, err := c.AdminCreateBulkRevokeJob(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminCreateBulkRevokeJobResponse(rsp)
+}
+
+// AdminGetBulkRevokeJobWithResponse request returning *AdminGetBulkRevokeJobResponse
+func (c *ClientWithResponses) AdminGetBulkRevokeJobWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*AdminGetBulkRevokeJobResponse, error) {
+	rsp, err := c.AdminGetBulkRevokeJob(ctx, jobId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminGetBulkRevokeJobResponse(rsp)
+}
+
+// AdminListDeadLetterEventsWithResponse request returning *AdminListDeadLetterEventsResponse
+func (c *ClientWithResponses) AdminListDeadLetterEventsWithResponse(ctx context.Context, params *AdminListDeadLetterEventsParams, reqEditors ...RequestEditorFn) (*AdminListDeadLetterEventsResponse, error) {
+	rsp, err := c.AdminListDeadLetterEvents(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminListDeadLetterEventsResponse(rsp)
+}
+
+// AdminDiscardDeadLetterEventWithResponse request returning *AdminDiscardDeadLetterEventResponse
+func (c *ClientWithResponses) AdminDiscardDeadLetterEventWithResponse(ctx context.Context, eventId string, reqEditors ...RequestEditorFn) (*AdminDiscardDeadLetterEventResponse, error) {
+	rsp, err := c.AdminDiscardDeadLetterEvent(ctx, eventId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminDiscardDeadLetterEventResponse(rsp)
+}
+
+// AdminGetDeadLetterEventWithResponse request returning *AdminGetDeadLetterEventResponse
+func (c *ClientWithResponses) AdminGetDeadLetterEventWithResponse(ctx context.Context, eventId string, reqEditors ...RequestEditorFn) (*AdminGetDeadLetterEventResponse, error) {
+	rsp, err := c.AdminGetDeadLetterEvent(ctx, eventId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminGetDeadLetterEventResponse(rsp)
+}
+
+// AdminReplayDeadLetterEventWithResponse request returning *AdminReplayDeadLetterEventResponse
+func (c *ClientWithResponses) AdminReplayDeadLetterEventWithResponse(ctx context.Context, eventId string, reqEditors ...RequestEditorFn) (*AdminReplayDeadLetterEventResponse, error) {
+	rsp, err := c.AdminReplayDeadLetterEvent(ctx, eventId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminReplayDeadLetterEventResponse(rsp)
+}
+
+// AdminGetDeploymentVersionWithResponse request returning *AdminGetDeploymentVersionResponse
+func (c *ClientWithResponses) AdminGetDeploymentVersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminGetDeploymentVersionResponse, error) {
+	rsp, err := c.AdminGetDeploymentVersion(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminGetDeploymentVersionResponse(rsp)
+}
+
+// AdminListGrantDriftWithResponse request returning *AdminListGrantDriftResponse
+func (c *ClientWithResponses) AdminListGrantDriftWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListGrantDriftResponse, error) {
+	rsp, err := c.AdminListGrantDrift(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminListGrantDriftResponse(rsp)
+}
+
+// AdminListEventSchemasWithResponse request returning *AdminListEventSchemasResponse
+func (c *ClientWithResponses) AdminListEventSchemasWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListEventSchemasResponse, error) {
+	rsp, err := c.AdminListEventSchemas(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminListEventSchemasResponse(rsp)
+}
+
+// AdminGetEventSchemaWithResponse request returning *AdminGetEventSchemaResponse
+func (c *ClientWithResponses) AdminGetEventSchemaWithResponse(ctx context.Context, eventType string, params *AdminGetEventSchemaParams, reqEditors ...RequestEditorFn) (*AdminGetEventSchemaResponse, error) {
+	rsp, err := c.AdminGetEventSchema(ctx, eventType, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminGetEventSchemaResponse(rsp)
+}
+
+// AdminListFailedGrantsWithResponse request returning *AdminListFailedGrantsResponse
+func (c *ClientWithResponses) AdminListFailedGrantsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListFailedGrantsResponse, error) {
+	rsp, err := c.AdminListFailedGrants(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminListFailedGrantsResponse(rsp)
+}
+
+// AdminCompleteFailedGrantWithResponse request returning *AdminCompleteFailedGrantResponse
+func (c *ClientWithResponses) AdminCompleteFailedGrantWithResponse(ctx context.Context, grantId string, reqEditors ...RequestEditorFn) (*AdminCompleteFailedGrantResponse, error) {
+	rsp, err := c.AdminCompleteFailedGrant(ctx, grantId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminCompleteFailedGrantResponse(rsp)
+}
+
+// AdminRetryFailedGrantWithResponse request returning *AdminRetryFailedGrantResponse
+func (c *ClientWithResponses) AdminRetryFailedGrantWithResponse(ctx context.Context, grantId string, reqEditors ...RequestEditorFn) (*AdminRetryFailedGrantResponse, error) {
+	rsp, err := c.AdminRetryFailedGrant(ctx, grantId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminRetryFailedGrantResponse(rsp)
+}
+
+// AdminListFreezesWithResponse request returning *AdminListFreezesResponse
+func (c *ClientWithResponses) AdminListFreezesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListFreezesResponse, error) {
+	rsp, err := c.AdminListFreezes(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminListFreezesResponse(rsp)
+}
+
+// AdminCreateFreezeWithBodyWithResponse request with arbitrary body returning *AdminCreateFreezeResponse
+func (c *ClientWithResponses) AdminCreateFreezeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminCreateFreezeResponse, error) {
+	rsp, err := c.AdminCreateFreezeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminCreateFreezeResponse(rsp)
+}
+
+func (c *ClientWithResponses) AdminCreateFreezeWithResponse(ctx context.Context, body AdminCreateFreezeJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminCreateFreezeResponse, error) {
+	rsp, err := c.AdminCreateFreeze(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminCreateFreezeResponse(rsp)
+}
+
+// AdminGetFreezeWithResponse request returning *AdminGetFreezeResponse
+func (c *ClientWithResponses) AdminGetFreezeWithResponse(ctx context.Context, freezeId string, reqEditors ...RequestEditorFn) (*AdminGetFreezeResponse, error) {
+	rsp, err := c.AdminGetFreeze(ctx, freezeId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminGetFreezeResponse(rsp)
+}
+
+// AdminUpdateFreezeWithBodyWithResponse request with arbitrary body returning *AdminUpdateFreezeResponse
+func (c *ClientWithResponses) AdminUpdateFreezeWithBodyWithResponse(ctx context.Context, freezeId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminUpdateFreezeResponse, error) {
+	rsp, err := c.AdminUpdateFreezeWithBody(ctx, freezeId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminUpdateFreezeResponse(rsp)
+}
+
+func (c *ClientWithResponses) AdminUpdateFreezeWithResponse(ctx context.Context, freezeId string, body AdminUpdateFreezeJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminUpdateFreezeResponse, error) {
+	rsp, err := c.AdminUpdateFreeze(ctx, freezeId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminUpdateFreezeResponse(rsp)
+}
+
+// AdminListFreezeHistoryWithResponse request returning *AdminListFreezeHistoryResponse
+func (c *ClientWithResponses) AdminListFreezeHistoryWithResponse(ctx context.Context, freezeId string, reqEditors ...RequestEditorFn) (*AdminListFreezeHistoryResponse, error) {
+	rsp, err := c.AdminListFreezeHistory(ctx, freezeId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminListFreezeHistoryResponse(rsp)
+}
+
+// AdminLiftFreezeWithResponse request returning *AdminLiftFreezeResponse
+func (c *ClientWithResponses) AdminLiftFreezeWithResponse(ctx context.Context, freezeId string, reqEditors ...RequestEditorFn) (*AdminLiftFreezeResponse, error) {
+	rsp, err := c.AdminLiftFreeze(ctx, freezeId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminLiftFreezeResponse(rsp)
+}
+
+// AdminListGroupsWithResponse request returning *AdminListGroupsResponse
+func (c *ClientWithResponses) AdminListGroupsWithResponse(ctx context.Context, params *AdminListGroupsParams, reqEditors ...RequestEditorFn) (*AdminListGroupsResponse, error) {
+	rsp, err := c.AdminListGroups(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminListGroupsResponse(rsp)
+}
+
+// AdminCreateGroupWithBodyWithResponse request with arbitrary body returning *AdminCreateGroupResponse
+func (c *ClientWithResponses) AdminCreateGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminCreateGroupResponse, error) {
+	rsp, err := c.AdminCreateGroupWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminCreateGroupResponse(rsp)
+}
+
+func (c *ClientWithResponses) AdminCreateGroupWithResponse(ctx context.Context, body AdminCreateGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminCreateGroupResponse, error) {
+	rsp, err := c.AdminCreateGroup(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminCreateGroupResponse(rsp)
+}
+
+// AdminDeleteGroupWithResponse request returning *AdminDeleteGroupResponse
+func (c *ClientWithResponses) AdminDeleteGroupWithResponse(ctx context.Context, groupId string, reqEditors ...RequestEditorFn) (*AdminDeleteGroupResponse, error) {
+	rsp, err := c.AdminDeleteGroup(ctx, groupId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminDeleteGroupResponse(rsp)
+}
+
+// AdminGetGroupWithResponse request returning *AdminGetGroupResponse
+func (c *ClientWithResponses) AdminGetGroupWithResponse(ctx context.Context, groupId string, reqEditors ...RequestEditorFn) (*AdminGetGroupResponse, error) {
+	rsp, err := c.AdminGetGroup(ctx, groupId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminGetGroupResponse(rsp)
+}
+
+// AdminUpdateGroupWithBodyWithResponse request with arbitrary body returning *AdminUpdateGroupResponse
+func (c *ClientWithResponses) AdminUpdateGroupWithBodyWithResponse(ctx context.Context, groupId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminUpdateGroupResponse, error) {
+	rsp, err := c.AdminUpdateGroupWithBody(ctx, groupId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminUpdateGroupResponse(rsp)
+}
+
+func (c *ClientWithResponses) AdminUpdateGroupWithResponse(ctx context.Context, groupId string, body AdminUpdateGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminUpdateGroupResponse, error) {
+	rsp, err := c.AdminUpdateGroup(ctx, groupId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminUpdateGroupResponse(rsp)
+}
+
+// AdminListHandlersWithResponse request returning *AdminListHandlersResponse
+func (c *ClientWithResponses) AdminListHandlersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListHandlersResponse, error) {
+	rsp, err := c.AdminListHandlers(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminListHandlersResponse(rsp)
+}
+
+// AdminRegisterHandlerWithBodyWithResponse request with arbitrary body returning *AdminRegisterHandlerResponse
+func (c *ClientWithResponses) AdminRegisterHandlerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminRegisterHandlerResponse, error) {
+	rsp, err := c.AdminRegisterHandlerWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminRegisterHandlerResponse(rsp)
+}
+
+func (c *ClientWithResponses) AdminRegisterHandlerWithResponse(ctx context.Context, body AdminRegisterHandlerJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminRegisterHandlerResponse, error) {
+	rsp, err := c.AdminRegisterHandler(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminRegisterHandlerResponse(rsp)
+}
+
+// AdminDeleteHandlerWithResponse request returning *AdminDeleteHandlerResponse
+func (c *ClientWithResponses) AdminDeleteHandlerWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*AdminDeleteHandlerResponse, error) {
+	rsp, err := c.AdminDeleteHandler(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminDeleteHandlerResponse(rsp)
+}
+
+// AdminGetHandlerWithResponse request returning *AdminGetHandlerResponse
+func (c *ClientWithResponses) AdminGetHandlerWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*AdminGetHandlerResponse, error) {
 	rsp, err := c.AdminGetHandler(ctx, id, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseAdminGetHandlerResponse(rsp)
+	return ParseAdminGetHandlerResponse(rsp)
+}
+
+// AdminHealthcheckHandlersWithResponse request returning *AdminHealthcheckHandlersResponse
+func (c *ClientWithResponses) AdminHealthcheckHandlersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminHealthcheckHandlersResponse, error) {
+	rsp, err := c.AdminHealthcheckHandlers(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminHealthcheckHandlersResponse(rsp)
+}
+
+// AdminGetIdentityConfigurationWithResponse request returning *AdminGetIdentityConfigurationResponse
+func (c *ClientWithResponses) AdminGetIdentityConfigurationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminGetIdentityConfigurationResponse, error) {
+	rsp, err := c.AdminGetIdentityConfiguration(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminGetIdentityConfigurationResponse(rsp)
+}
+
+// AdminSyncIdentityWithResponse request returning *AdminSyncIdentityResponse
+func (c *ClientWithResponses) AdminSyncIdentityWithResponse(ctx context.Context, params *AdminSyncIdentityParams, reqEditors ...RequestEditorFn) (*AdminSyncIdentityResponse, error) {
+	rsp, err := c.AdminSyncIdentity(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminSyncIdentityResponse(rsp)
+}
+
+// AdminListIdentitySyncRunsWithResponse request returning *AdminListIdentitySyncRunsResponse
+func (c *ClientWithResponses) AdminListIdentitySyncRunsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListIdentitySyncRunsResponse, error) {
+	rsp, err := c.AdminListIdentitySyncRuns(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminListIdentitySyncRunsResponse(rsp)
+}
+
+// AdminListRequestsWithResponse request returning *AdminListRequestsResponse
+func (c *ClientWithResponses) AdminListRequestsWithResponse(ctx context.Context, params *AdminListRequestsParams, reqEditors ...RequestEditorFn) (*AdminListRequestsResponse, error) {
+	rsp, err := c.AdminListRequests(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminListRequestsResponse(rsp)
+}
+
+// AdminReplayRequestEventsWithResponse request returning *AdminReplayRequestEventsResponse
+func (c *ClientWithResponses) AdminReplayRequestEventsWithResponse(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*AdminReplayRequestEventsResponse, error) {
+	rsp, err := c.AdminReplayRequestEvents(ctx, requestId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminReplayRequestEventsResponse(rsp)
+}
+
+// AdminListTargetGroupsWithResponse request returning *AdminListTargetGroupsResponse
+func (c *ClientWithResponses) AdminListTargetGroupsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListTargetGroupsResponse, error) {
+	rsp, err := c.AdminListTargetGroups(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminListTargetGroupsResponse(rsp)
+}
+
+// AdminCreateTargetGroupWithBodyWithResponse request with arbitrary body returning *AdminCreateTargetGroupResponse
+func (c *ClientWithResponses) AdminCreateTargetGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminCreateTargetGroupResponse, error) {
+	rsp, err := c.AdminCreateTargetGroupWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminCreateTargetGroupResponse(rsp)
+}
+
+func (c *ClientWithResponses) AdminCreateTargetGroupWithResponse(ctx context.Context, body AdminCreateTargetGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminCreateTargetGroupResponse, error) {
+	rsp, err := c.AdminCreateTargetGroup(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminCreateTargetGroupResponse(rsp)
+}
+
+// AdminDeleteTargetGroupWithResponse request returning *AdminDeleteTargetGroupResponse
+func (c *ClientWithResponses) AdminDeleteTargetGroupWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*AdminDeleteTargetGroupResponse, error) {
+	rsp, err := c.AdminDeleteTargetGroup(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminDeleteTargetGroupResponse(rsp)
+}
+
+// AdminGetTargetGroupWithResponse request returning *AdminGetTargetGroupResponse
+func (c *ClientWithResponses) AdminGetTargetGroupWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*AdminGetTargetGroupResponse, error) {
+	rsp, err := c.AdminGetTargetGroup(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminGetTargetGroupResponse(rsp)
+}
+
+// AdminCreateTargetGroupLinkWithBodyWithResponse request with arbitrary body returning *AdminCreateTargetGroupLinkResponse
+func (c *ClientWithResponses) AdminCreateTargetGroupLinkWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminCreateTargetGroupLinkResponse, error) {
+	rsp, err := c.AdminCreateTargetGroupLinkWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminCreateTargetGroupLinkResponse(rsp)
+}
+
+func (c *ClientWithResponses) AdminCreateTargetGroupLinkWithResponse(ctx context.Context, id string, body AdminCreateTargetGroupLinkJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminCreateTargetGroupLinkResponse, error) {
+	rsp, err := c.AdminCreateTargetGroupLink(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminCreateTargetGroupLinkResponse(rsp)
+}
+
+// AdminGetTargetGroupResourcesWithResponse request returning *AdminGetTargetGroupResourcesResponse
+func (c *ClientWithResponses) AdminGetTargetGroupResourcesWithResponse(ctx context.Context, id string, resourceType string, reqEditors ...RequestEditorFn) (*AdminGetTargetGroupResourcesResponse, error) {
+	rsp, err := c.AdminGetTargetGroupResources(ctx, id, resourceType, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminGetTargetGroupResourcesResponse(rsp)
+}
+
+// AdminFilterTargetGroupResourcesWithBodyWithResponse request with arbitrary body returning *AdminFilterTargetGroupResourcesResponse
+func (c *ClientWithResponses) AdminFilterTargetGroupResourcesWithBodyWithResponse(ctx context.Context, id string, resourceType string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminFilterTargetGroupResourcesResponse, error) {
+	rsp, err := c.AdminFilterTargetGroupResourcesWithBody(ctx, id, resourceType, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminFilterTargetGroupResourcesResponse(rsp)
+}
+
+func (c *ClientWithResponses) AdminFilterTargetGroupResourcesWithResponse(ctx context.Context, id string, resourceType string, body AdminFilterTargetGroupResourcesJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminFilterTargetGroupResourcesResponse, error) {
+	rsp, err := c.AdminFilterTargetGroupResources(ctx, id, resourceType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminFilterTargetGroupResourcesResponse(rsp)
+}
+
+// AdminListTargetRoutesWithResponse request returning *AdminListTargetRoutesResponse
+func (c *ClientWithResponses) AdminListTargetRoutesWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*AdminListTargetRoutesResponse, error) {
+	rsp, err := c.AdminListTargetRoutes(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminListTargetRoutesResponse(rsp)
+}
+
+// AdminSyncTargetGroupWithResponse request returning *AdminSyncTargetGroupResponse
+func (c *ClientWithResponses) AdminSyncTargetGroupWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*AdminSyncTargetGroupResponse, error) {
+	rsp, err := c.AdminSyncTargetGroup(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminSyncTargetGroupResponse(rsp)
+}
+
+// AdminRemoveTargetGroupLinkWithResponse request returning *AdminRemoveTargetGroupLinkResponse
+func (c *ClientWithResponses) AdminRemoveTargetGroupLinkWithResponse(ctx context.Context, id string, params *AdminRemoveTargetGroupLinkParams, reqEditors ...RequestEditorFn) (*AdminRemoveTargetGroupLinkResponse, error) {
+	rsp, err := c.AdminRemoveTargetGroupLink(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminRemoveTargetGroupLinkResponse(rsp)
+}
+
+// AdminListTargetMetadataWithResponse request returning *AdminListTargetMetadataResponse
+func (c *ClientWithResponses) AdminListTargetMetadataWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListTargetMetadataResponse, error) {
+	rsp, err := c.AdminListTargetMetadata(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminListTargetMetadataResponse(rsp)
+}
+
+// AdminCreateTargetMetadataWithBodyWithResponse request with arbitrary body returning *AdminCreateTargetMetadataResponse
+func (c *ClientWithResponses) AdminCreateTargetMetadataWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminCreateTargetMetadataResponse, error) {
+	rsp, err := c.AdminCreateTargetMetadataWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminCreateTargetMetadataResponse(rsp)
+}
+
+func (c *ClientWithResponses) AdminCreateTargetMetadataWithResponse(ctx context.Context, body AdminCreateTargetMetadataJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminCreateTargetMetadataResponse, error) {
+	rsp, err := c.AdminCreateTargetMetadata(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminCreateTargetMetadataResponse(rsp)
+}
+
+// AdminDeleteTargetMetadataWithResponse request returning *AdminDeleteTargetMetadataResponse
+func (c *ClientWithResponses) AdminDeleteTargetMetadataWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*AdminDeleteTargetMetadataResponse, error) {
+	rsp, err := c.AdminDeleteTargetMetadata(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminDeleteTargetMetadataResponse(rsp)
+}
+
+// AdminUpdateTargetMetadataWithBodyWithResponse request with arbitrary body returning *AdminUpdateTargetMetadataResponse
+func (c *ClientWithResponses) AdminUpdateTargetMetadataWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminUpdateTargetMetadataResponse, error) {
+	rsp, err := c.AdminUpdateTargetMetadataWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminUpdateTargetMetadataResponse(rsp)
+}
+
+func (c *ClientWithResponses) AdminUpdateTargetMetadataWithResponse(ctx context.Context, id string, body AdminUpdateTargetMetadataJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminUpdateTargetMetadataResponse, error) {
+	rsp, err := c.AdminUpdateTargetMetadata(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminUpdateTargetMetadataResponse(rsp)
+}
+
+// AdminListUsersWithResponse request returning *AdminListUsersResponse
+func (c *ClientWithResponses) AdminListUsersWithResponse(ctx context.Context, params *AdminListUsersParams, reqEditors ...RequestEditorFn) (*AdminListUsersResponse, error) {
+	rsp, err := c.AdminListUsers(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminListUsersResponse(rsp)
+}
+
+// AdminCreateUserWithBodyWithResponse request with arbitrary body returning *AdminCreateUserResponse
+func (c *ClientWithResponses) AdminCreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminCreateUserResponse, error) {
+	rsp, err := c.AdminCreateUserWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminCreateUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) AdminCreateUserWithResponse(ctx context.Context, body AdminCreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminCreateUserResponse, error) {
+	rsp, err := c.AdminCreateUser(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminCreateUserResponse(rsp)
+}
+
+// AdminUpdateUserWithBodyWithResponse request with arbitrary body returning *AdminUpdateUserResponse
+func (c *ClientWithResponses) AdminUpdateUserWithBodyWithResponse(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminUpdateUserResponse, error) {
+	rsp, err := c.AdminUpdateUserWithBody(ctx, userId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminUpdateUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) AdminUpdateUserWithResponse(ctx context.Context, userId string, body AdminUpdateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminUpdateUserResponse, error) {
+	rsp, err := c.AdminUpdateUser(ctx, userId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminUpdateUserResponse(rsp)
+}
+
+// AdminListUserGroupMembershipsWithResponse request returning *AdminListUserGroupMembershipsResponse
+func (c *ClientWithResponses) AdminListUserGroupMembershipsWithResponse(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*AdminListUserGroupMembershipsResponse, error) {
+	rsp, err := c.AdminListUserGroupMemberships(ctx, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminListUserGroupMembershipsResponse(rsp)
+}
+
+// UserListEntitlementsWithResponse request returning *UserListEntitlementsResponse
+func (c *ClientWithResponses) UserListEntitlementsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserListEntitlementsResponse, error) {
+	rsp, err := c.UserListEntitlements(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserListEntitlementsResponse(rsp)
+}
+
+// UserListEntitlementTargetsWithResponse request returning *UserListEntitlementTargetsResponse
+func (c *ClientWithResponses) UserListEntitlementTargetsWithResponse(ctx context.Context, params *UserListEntitlementTargetsParams, reqEditors ...RequestEditorFn) (*UserListEntitlementTargetsResponse, error) {
+	rsp, err := c.UserListEntitlementTargets(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserListEntitlementTargetsResponse(rsp)
+}
+
+// UserListFavoritesWithResponse request returning *UserListFavoritesResponse
+func (c *ClientWithResponses) UserListFavoritesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserListFavoritesResponse, error) {
+	rsp, err := c.UserListFavorites(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserListFavoritesResponse(rsp)
+}
+
+// UserCreateFavoriteWithBodyWithResponse request with arbitrary body returning *UserCreateFavoriteResponse
+func (c *ClientWithResponses) UserCreateFavoriteWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserCreateFavoriteResponse, error) {
+	rsp, err := c.UserCreateFavoriteWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserCreateFavoriteResponse(rsp)
+}
+
+func (c *ClientWithResponses) UserCreateFavoriteWithResponse(ctx context.Context, body UserCreateFavoriteJSONRequestBody, reqEditors ...RequestEditorFn) (*UserCreateFavoriteResponse, error) {
+	rsp, err := c.UserCreateFavorite(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserCreateFavoriteResponse(rsp)
+}
+
+// UserRequestFavoritesWithBodyWithResponse request with arbitrary body returning *UserRequestFavoritesResponse
+func (c *ClientWithResponses) UserRequestFavoritesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserRequestFavoritesResponse, error) {
+	rsp, err := c.UserRequestFavoritesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserRequestFavoritesResponse(rsp)
+}
+
+func (c *ClientWithResponses) UserRequestFavoritesWithResponse(ctx context.Context, body UserRequestFavoritesJSONRequestBody, reqEditors ...RequestEditorFn) (*UserRequestFavoritesResponse, error) {
+	rsp, err := c.UserRequestFavorites(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserRequestFavoritesResponse(rsp)
+}
+
+// UserDeleteFavoriteWithResponse request returning *UserDeleteFavoriteResponse
+func (c *ClientWithResponses) UserDeleteFavoriteWithResponse(ctx context.Context, targetId string, reqEditors ...RequestEditorFn) (*UserDeleteFavoriteResponse, error) {
+	rsp, err := c.UserDeleteFavorite(ctx, targetId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserDeleteFavoriteResponse(rsp)
+}
+
+// UserRequestPreflightWithBodyWithResponse request with arbitrary body returning *UserRequestPreflightResponse
+func (c *ClientWithResponses) UserRequestPreflightWithBodyWithResponse(ctx context.Context, params *UserRequestPreflightParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserRequestPreflightResponse, error) {
+	rsp, err := c.UserRequestPreflightWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserRequestPreflightResponse(rsp)
+}
+
+func (c *ClientWithResponses) UserRequestPreflightWithResponse(ctx context.Context, params *UserRequestPreflightParams, body UserRequestPreflightJSONRequestBody, reqEditors ...RequestEditorFn) (*UserRequestPreflightResponse, error) {
+	rsp, err := c.UserRequestPreflight(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserRequestPreflightResponse(rsp)
+}
+
+// UserGetPreflightWithResponse request returning *UserGetPreflightResponse
+func (c *ClientWithResponses) UserGetPreflightWithResponse(ctx context.Context, preflightId string, reqEditors ...RequestEditorFn) (*UserGetPreflightResponse, error) {
+	rsp, err := c.UserGetPreflight(ctx, preflightId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserGetPreflightResponse(rsp)
+}
+
+// UserListRequestsWithResponse request returning *UserListRequestsResponse
+func (c *ClientWithResponses) UserListRequestsWithResponse(ctx context.Context, params *UserListRequestsParams, reqEditors ...RequestEditorFn) (*UserListRequestsResponse, error) {
+	rsp, err := c.UserListRequests(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserListRequestsResponse(rsp)
+}
+
+// UserPostRequestsWithBodyWithResponse request with arbitrary body returning *UserPostRequestsResponse
+func (c *ClientWithResponses) UserPostRequestsWithBodyWithResponse(ctx context.Context, params *UserPostRequestsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserPostRequestsResponse, error) {
+	rsp, err := c.UserPostRequestsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserPostRequestsResponse(rsp)
+}
+
+func (c *ClientWithResponses) UserPostRequestsWithResponse(ctx context.Context, params *UserPostRequestsParams, body UserPostRequestsJSONRequestBody, reqEditors ...RequestEditorFn) (*UserPostRequestsResponse, error) {
+	rsp, err := c.UserPostRequests(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserPostRequestsResponse(rsp)
+}
+
+// UserGetRequestWithResponse request returning *UserGetRequestResponse
+func (c *ClientWithResponses) UserGetRequestWithResponse(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*UserGetRequestResponse, error) {
+	rsp, err := c.UserGetRequest(ctx, requestId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserGetRequestResponse(rsp)
+}
+
+// UserListRequestEventsWithResponse request returning *UserListRequestEventsResponse
+func (c *ClientWithResponses) UserListRequestEventsWithResponse(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*UserListRequestEventsResponse, error) {
+	rsp, err := c.UserListRequestEvents(ctx, requestId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserListRequestEventsResponse(rsp)
+}
+
+// UserReviewRequestWithBodyWithResponse request with arbitrary body returning *UserReviewRequestResponse
+func (c *ClientWithResponses) UserReviewRequestWithBodyWithResponse(ctx context.Context, requestId string, groupId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserReviewRequestResponse, error) {
+	rsp, err := c.UserReviewRequestWithBody(ctx, requestId, groupId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserReviewRequestResponse(rsp)
+}
+
+func (c *ClientWithResponses) UserReviewRequestWithResponse(ctx context.Context, requestId string, groupId string, body UserReviewRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*UserReviewRequestResponse, error) {
+	rsp, err := c.UserReviewRequest(ctx, requestId, groupId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserReviewRequestResponse(rsp)
+}
+
+// UserCancelRequestWithResponse request returning *UserCancelRequestResponse
+func (c *ClientWithResponses) UserCancelRequestWithResponse(ctx context.Context, requestid string, reqEditors ...RequestEditorFn) (*UserCancelRequestResponse, error) {
+	rsp, err := c.UserCancelRequest(ctx, requestid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserCancelRequestResponse(rsp)
+}
+
+// UserActivateRequestGroupWithResponse request returning *UserActivateRequestGroupResponse
+func (c *ClientWithResponses) UserActivateRequestGroupWithResponse(ctx context.Context, requestid string, groupid string, reqEditors ...RequestEditorFn) (*UserActivateRequestGroupResponse, error) {
+	rsp, err := c.UserActivateRequestGroup(ctx, requestid, groupid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserActivateRequestGroupResponse(rsp)
+}
+
+// UserRevokeRequestTargetWithResponse request returning *UserRevokeRequestTargetResponse
+func (c *ClientWithResponses) UserRevokeRequestTargetWithResponse(ctx context.Context, requestid string, groupid string, targetid string, reqEditors ...RequestEditorFn) (*UserRevokeRequestTargetResponse, error) {
+	rsp, err := c.UserRevokeRequestTarget(ctx, requestid, groupid, targetid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserRevokeRequestTargetResponse(rsp)
+}
+
+// UserRevokeRequestWithResponse request returning *UserRevokeRequestResponse
+func (c *ClientWithResponses) UserRevokeRequestWithResponse(ctx context.Context, requestid string, reqEditors ...RequestEditorFn) (*UserRevokeRequestResponse, error) {
+	rsp, err := c.UserRevokeRequest(ctx, requestid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserRevokeRequestResponse(rsp)
+}
+
+// UserListReviewsWithResponse request returning *UserListReviewsResponse
+func (c *ClientWithResponses) UserListReviewsWithResponse(ctx context.Context, params *UserListReviewsParams, reqEditors ...RequestEditorFn) (*UserListReviewsResponse, error) {
+	rsp, err := c.UserListReviews(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserListReviewsResponse(rsp)
+}
+
+// GetGroupTargetInstructionsWithResponse request returning *GetGroupTargetInstructionsResponse
+func (c *ClientWithResponses) GetGroupTargetInstructionsWithResponse(ctx context.Context, targetId string, reqEditors ...RequestEditorFn) (*GetGroupTargetInstructionsResponse, error) {
+	rsp, err := c.GetGroupTargetInstructions(ctx, targetId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetGroupTargetInstructionsResponse(rsp)
+}
+
+// UserGetMeWithResponse request returning *UserGetMeResponse
+func (c *ClientWithResponses) UserGetMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserGetMeResponse, error) {
+	rsp, err := c.UserGetMe(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserGetMeResponse(rsp)
+}
+
+// UserGetUserWithResponse request returning *UserGetUserResponse
+func (c *ClientWithResponses) UserGetUserWithResponse(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*UserGetUserResponse, error) {
+	rsp, err := c.UserGetUser(ctx, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserGetUserResponse(rsp)
+}
+
+// ParseUserListAccessTemplatesResponse parses an HTTP response from a UserListAccessTemplatesWithResponse call
+func ParseUserListAccessTemplatesResponse(rsp *http.Response) (*UserListAccessTemplatesResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserListAccessTemplatesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			AccessTemplates []AccessTemplate `json:"accessTemplates"`
+			Next            *string          `json:"next,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminListAccessRulesResponse parses an HTTP response from a AdminListAccessRulesWithResponse call
+func ParseAdminListAccessRulesResponse(rsp *http.Response) (*AdminListAccessRulesResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminListAccessRulesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			AccessRules []AccessRule `json:"accessRules"`
+			Next        *string      `json:"next"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminCreateAccessRuleResponse parses an HTTP response from a AdminCreateAccessRuleWithResponse call
+func ParseAdminCreateAccessRuleResponse(rsp *http.Response) (*AdminCreateAccessRuleResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminCreateAccessRuleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest AccessRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminDeleteAccessRuleResponse parses an HTTP response from a AdminDeleteAccessRuleWithResponse call
+func ParseAdminDeleteAccessRuleResponse(rsp *http.Response) (*AdminDeleteAccessRuleResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminDeleteAccessRuleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminGetAccessRuleResponse parses an HTTP response from a AdminGetAccessRuleWithResponse call
+func ParseAdminGetAccessRuleResponse(rsp *http.Response) (*AdminGetAccessRuleResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminGetAccessRuleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AccessRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminUpdateAccessRuleResponse parses an HTTP response from a AdminUpdateAccessRuleWithResponse call
+func ParseAdminUpdateAccessRuleResponse(rsp *http.Response) (*AdminUpdateAccessRuleResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminUpdateAccessRuleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AccessRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminListBulkRevokeJobsResponse parses an HTTP response from a AdminListBulkRevokeJobsWithResponse call
+func ParseAdminListBulkRevokeJobsResponse(rsp *http.Response) (*AdminListBulkRevokeJobsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminListBulkRevokeJobsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Jobs []BulkRevokeJob `json:"jobs"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminCreateBulkRevokeJobResponse parses an HTTP response from a AdminCreateBulkRevokeJobWithResponse call
+func ParseAdminCreateBulkRevokeJobResponse(rsp *http.Response) (*AdminCreateBulkRevokeJobResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminCreateBulkRevokeJobResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest BulkRevokeJob
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminGetBulkRevokeJobResponse parses an HTTP response from a AdminGetBulkRevokeJobWithResponse call
+func ParseAdminGetBulkRevokeJobResponse(rsp *http.Response) (*AdminGetBulkRevokeJobResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminGetBulkRevokeJobResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BulkRevokeJob
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminListDeadLetterEventsResponse parses an HTTP response from a AdminListDeadLetterEventsWithResponse call
+func ParseAdminListDeadLetterEventsResponse(rsp *http.Response) (*AdminListDeadLetterEventsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminListDeadLetterEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Events []DeadLetterEvent `json:"events"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminDiscardDeadLetterEventResponse parses an HTTP response from a AdminDiscardDeadLetterEventWithResponse call
+func ParseAdminDiscardDeadLetterEventResponse(rsp *http.Response) (*AdminDiscardDeadLetterEventResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminDiscardDeadLetterEventResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminGetDeadLetterEventResponse parses an HTTP response from a AdminGetDeadLetterEventWithResponse call
+func ParseAdminGetDeadLetterEventResponse(rsp *http.Response) (*AdminGetDeadLetterEventResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminGetDeadLetterEventResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeadLetterEvent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminReplayDeadLetterEventResponse parses an HTTP response from a AdminReplayDeadLetterEventWithResponse call
+func ParseAdminReplayDeadLetterEventResponse(rsp *http.Response) (*AdminReplayDeadLetterEventResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminReplayDeadLetterEventResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminGetDeploymentVersionResponse parses an HTTP response from a AdminGetDeploymentVersionWithResponse call
+func ParseAdminGetDeploymentVersionResponse(rsp *http.Response) (*AdminGetDeploymentVersionResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminGetDeploymentVersionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// The deployment version. Will be a semver, such as "v0.9.0" for official releases, or "dev+GIT_HASH" for pre-release builds.
+			Version string `json:"version"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminListGrantDriftResponse parses an HTTP response from a AdminListGrantDriftWithResponse call
+func ParseAdminListGrantDriftResponse(rsp *http.Response) (*AdminListGrantDriftResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminListGrantDriftResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Drift []GrantDrift `json:"drift"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminListEventSchemasResponse parses an HTTP response from a AdminListEventSchemasWithResponse call
+func ParseAdminListEventSchemasResponse(rsp *http.Response) (*AdminListEventSchemasResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminListEventSchemasResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Schemas []EventSchema `json:"schemas"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminGetEventSchemaResponse parses an HTTP response from a AdminGetEventSchemaWithResponse call
+func ParseAdminGetEventSchemaResponse(rsp *http.Response) (*AdminGetEventSchemaResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminGetEventSchemaResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EventSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminListFailedGrantsResponse parses an HTTP response from a AdminListFailedGrantsWithResponse call
+func ParseAdminListFailedGrantsResponse(rsp *http.Response) (*AdminListFailedGrantsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminListFailedGrantsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			FailedGrants []FailedGrant `json:"failedGrants"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminCompleteFailedGrantResponse parses an HTTP response from a AdminCompleteFailedGrantWithResponse call
+func ParseAdminCompleteFailedGrantResponse(rsp *http.Response) (*AdminCompleteFailedGrantResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminCompleteFailedGrantResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminRetryFailedGrantResponse parses an HTTP response from a AdminRetryFailedGrantWithResponse call
+func ParseAdminRetryFailedGrantResponse(rsp *http.Response) (*AdminRetryFailedGrantResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminRetryFailedGrantResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminListFreezesResponse parses an HTTP response from a AdminListFreezesWithResponse call
+func ParseAdminListFreezesResponse(rsp *http.Response) (*AdminListFreezesResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminListFreezesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Freezes []Freeze `json:"freezes"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminCreateFreezeResponse parses an HTTP response from a AdminCreateFreezeWithResponse call
+func ParseAdminCreateFreezeResponse(rsp *http.Response) (*AdminCreateFreezeResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminCreateFreezeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Freeze
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminGetFreezeResponse parses an HTTP response from a AdminGetFreezeWithResponse call
+func ParseAdminGetFreezeResponse(rsp *http.Response) (*AdminGetFreezeResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminGetFreezeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Freeze
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminUpdateFreezeResponse parses an HTTP response from a AdminUpdateFreezeWithResponse call
+func ParseAdminUpdateFreezeResponse(rsp *http.Response) (*AdminUpdateFreezeResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminUpdateFreezeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Freeze
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminListFreezeHistoryResponse parses an HTTP response from a AdminListFreezeHistoryWithResponse call
+func ParseAdminListFreezeHistoryResponse(rsp *http.Response) (*AdminListFreezeHistoryResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminListFreezeHistoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Events []FreezeHistoryEvent `json:"events"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminLiftFreezeResponse parses an HTTP response from a AdminLiftFreezeWithResponse call
+func ParseAdminLiftFreezeResponse(rsp *http.Response) (*AdminLiftFreezeResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminLiftFreezeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Freeze
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminListGroupsResponse parses an HTTP response from a AdminListGroupsWithResponse call
+func ParseAdminListGroupsResponse(rsp *http.Response) (*AdminListGroupsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminListGroupsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Groups []Group `json:"groups"`
+			Next   *string `json:"next"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminCreateGroupResponse parses an HTTP response from a AdminCreateGroupWithResponse call
+func ParseAdminCreateGroupResponse(rsp *http.Response) (*AdminCreateGroupResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminCreateGroupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Group
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminDeleteGroupResponse parses an HTTP response from a AdminDeleteGroupWithResponse call
+func ParseAdminDeleteGroupResponse(rsp *http.Response) (*AdminDeleteGroupResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminDeleteGroupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminGetGroupResponse parses an HTTP response from a AdminGetGroupWithResponse call
+func ParseAdminGetGroupResponse(rsp *http.Response) (*AdminGetGroupResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminGetGroupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Group
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminUpdateGroupResponse parses an HTTP response from a AdminUpdateGroupWithResponse call
+func ParseAdminUpdateGroupResponse(rsp *http.Response) (*AdminUpdateGroupResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminUpdateGroupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Group
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminListHandlersResponse parses an HTTP response from a AdminListHandlersWithResponse call
+func ParseAdminListHandlersResponse(rsp *http.Response) (*AdminListHandlersResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminListHandlersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Next string      `json:"next"`
+			Res  []TGHandler `json:"res"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminRegisterHandlerResponse parses an HTTP response from a AdminRegisterHandlerWithResponse call
+func ParseAdminRegisterHandlerResponse(rsp *http.Response) (*AdminRegisterHandlerResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminRegisterHandlerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TGHandler
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminDeleteHandlerResponse parses an HTTP response from a AdminDeleteHandlerWithResponse call
+func ParseAdminDeleteHandlerResponse(rsp *http.Response) (*AdminDeleteHandlerResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminDeleteHandlerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 204:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON204 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminGetHandlerResponse parses an HTTP response from a AdminGetHandlerWithResponse call
+func ParseAdminGetHandlerResponse(rsp *http.Response) (*AdminGetHandlerResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminGetHandlerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TGHandler
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminHealthcheckHandlersResponse parses an HTTP response from a AdminHealthcheckHandlersWithResponse call
+func ParseAdminHealthcheckHandlersResponse(rsp *http.Response) (*AdminHealthcheckHandlersResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminHealthcheckHandlersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminGetIdentityConfigurationResponse parses an HTTP response from a AdminGetIdentityConfigurationWithResponse call
+func ParseAdminGetIdentityConfigurationResponse(rsp *http.Response) (*AdminGetIdentityConfigurationResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminGetIdentityConfigurationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			AdministratorGroupId string `json:"administratorGroupId"`
+			IdentityProvider     string `json:"identityProvider"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminSyncIdentityResponse parses an HTTP response from a AdminSyncIdentityWithResponse call
+func ParseAdminSyncIdentityResponse(rsp *http.Response) (*AdminSyncIdentityResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminSyncIdentityResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IdentitySyncReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminListIdentitySyncRunsResponse parses an HTTP response from a AdminListIdentitySyncRunsWithResponse call
+func ParseAdminListIdentitySyncRunsResponse(rsp *http.Response) (*AdminListIdentitySyncRunsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminListIdentitySyncRunsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Runs []IdentitySyncRun `json:"runs"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-// AdminHealthcheckHandlersWithResponse request returning *AdminHealthcheckHandlersResponse
-func (c *ClientWithResponses) AdminHealthcheckHandlersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminHealthcheckHandlersResponse, error) {
-	rsp, err := c.AdminHealthcheckHandlers(ctx, reqEditors...)
+// ParseAdminListRequestsResponse parses an HTTP response from a AdminListRequestsWithResponse call
+func ParseAdminListRequestsResponse(rsp *http.Response) (*AdminListRequestsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseAdminHealthcheckHandlersResponse(rsp)
+
+	response := &AdminListRequestsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Next     *string   `json:"next"`
+			Requests []Request `json:"requests"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminReplayRequestEventsResponse parses an HTTP response from a AdminReplayRequestEventsWithResponse call
+func ParseAdminReplayRequestEventsResponse(rsp *http.Response) (*AdminReplayRequestEventsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminReplayRequestEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest struct {
+			Events []DeadLetterEvent `json:"events"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminListTargetGroupsResponse parses an HTTP response from a AdminListTargetGroupsWithResponse call
+func ParseAdminListTargetGroupsResponse(rsp *http.Response) (*AdminListTargetGroupsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminListTargetGroupsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			TargetGroups []TargetGroup `json:"targetGroups"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-// AdminGetIdentityConfigurationWithResponse request returning *AdminGetIdentityConfigurationResponse
-func (c *ClientWithResponses) AdminGetIdentityConfigurationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminGetIdentityConfigurationResponse, error) {
-	rsp, err := c.AdminGetIdentityConfiguration(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+// ParseAdminCreateTargetGroupResponse parses an HTTP response from a AdminCreateTargetGroupWithResponse call
+func ParseAdminCreateTargetGroupResponse(rsp *http.Response) (*AdminCreateTargetGroupResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminCreateTargetGroupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TargetGroup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseAdminGetIdentityConfigurationResponse(rsp)
-}
 
-// AdminSyncIdentityWithResponse request returning *AdminSyncIdentityResponse
-func (c *ClientWithResponses) AdminSyncIdentityWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminSyncIdentityResponse, error) {
-	rsp, err := c.AdminSyncIdentity(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseAdminSyncIdentityResponse(rsp)
+	return response, nil
 }
 
-// AdminListRequestsWithResponse request returning *AdminListRequestsResponse
-func (c *ClientWithResponses) AdminListRequestsWithResponse(ctx context.Context, params *AdminListRequestsParams, reqEditors ...RequestEditorFn) (*AdminListRequestsResponse, error) {
-	rsp, err := c.AdminListRequests(ctx, params, reqEditors...)
+// ParseAdminDeleteTargetGroupResponse parses an HTTP response from a AdminDeleteTargetGroupWithResponse call
+func ParseAdminDeleteTargetGroupResponse(rsp *http.Response) (*AdminDeleteTargetGroupResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseAdminListRequestsResponse(rsp)
-}
 
-// AdminListTargetGroupsWithResponse request returning *AdminListTargetGroupsResponse
-func (c *ClientWithResponses) AdminListTargetGroupsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminListTargetGroupsResponse, error) {
-	rsp, err := c.AdminListTargetGroups(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &AdminDeleteTargetGroupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseAdminListTargetGroupsResponse(rsp)
-}
 
-// AdminCreateTargetGroupWithBodyWithResponse request with arbitrary body returning *AdminCreateTargetGroupResponse
-func (c *ClientWithResponses) AdminCreateTargetGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminCreateTargetGroupResponse, error) {
-	rsp, err := c.AdminCreateTargetGroupWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseAdminCreateTargetGroupResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-func (c *ClientWithResponses) AdminCreateTargetGroupWithResponse(ctx context.Context, body AdminCreateTargetGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminCreateTargetGroupResponse, error) {
-	rsp, err := c.AdminCreateTargetGroup(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseAdminCreateTargetGroupResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// AdminDeleteTargetGroupWithResponse request returning *AdminDeleteTargetGroupResponse
-func (c *ClientWithResponses) AdminDeleteTargetGroupWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*AdminDeleteTargetGroupResponse, error) {
-	rsp, err := c.AdminDeleteTargetGroup(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseAdminDeleteTargetGroupResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// AdminGetTargetGroupWithResponse request returning *AdminGetTargetGroupResponse
-func (c *ClientWithResponses) AdminGetTargetGroupWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*AdminGetTargetGroupResponse, error) {
-	rsp, err := c.AdminGetTargetGroup(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseAdminGetTargetGroupResponse(rsp)
-}
 
-// AdminCreateTargetGroupLinkWithBodyWithResponse request with arbitrary body returning *AdminCreateTargetGroupLinkResponse
-func (c *ClientWithResponses) AdminCreateTargetGroupLinkWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminCreateTargetGroupLinkResponse, error) {
-	rsp, err := c.AdminCreateTargetGroupLinkWithBody(ctx, id, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseAdminCreateTargetGroupLinkResponse(rsp)
+	return response, nil
 }
 
-func (c *ClientWithResponses) AdminCreateTargetGroupLinkWithResponse(ctx context.Context, id string, body AdminCreateTargetGroupLinkJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminCreateTargetGroupLinkResponse, error) {
-	rsp, err := c.AdminCreateTargetGroupLink(ctx, id, body, reqEditors...)
+// ParseAdminGetTargetGroupResponse parses an HTTP response from a AdminGetTargetGroupWithResponse call
+func ParseAdminGetTargetGroupResponse(rsp *http.Response) (*AdminGetTargetGroupResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseAdminCreateTargetGroupLinkResponse(rsp)
-}
 
-// AdminGetTargetGroupResourcesWithResponse request returning *AdminGetTargetGroupResourcesResponse
-func (c *ClientWithResponses) AdminGetTargetGroupResourcesWithResponse(ctx context.Context, id string, resourceType string, reqEditors ...RequestEditorFn) (*AdminGetTargetGroupResourcesResponse, error) {
-	rsp, err := c.AdminGetTargetGroupResources(ctx, id, resourceType, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &AdminGetTargetGroupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseAdminGetTargetGroupResourcesResponse(rsp)
-}
 
-// AdminFilterTargetGroupResourcesWithBodyWithResponse request with arbitrary body returning *AdminFilterTargetGroupResourcesResponse
-func (c *ClientWithResponses) AdminFilterTargetGroupResourcesWithBodyWithResponse(ctx context.Context, id string, resourceType string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminFilterTargetGroupResourcesResponse, error) {
-	rsp, err := c.AdminFilterTargetGroupResourcesWithBody(ctx, id, resourceType, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseAdminFilterTargetGroupResourcesResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TargetGroup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-func (c *ClientWithResponses) AdminFilterTargetGroupResourcesWithResponse(ctx context.Context, id string, resourceType string, body AdminFilterTargetGroupResourcesJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminFilterTargetGroupResourcesResponse, error) {
-	rsp, err := c.AdminFilterTargetGroupResources(ctx, id, resourceType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseAdminFilterTargetGroupResourcesResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-// AdminListTargetRoutesWithResponse request returning *AdminListTargetRoutesResponse
-func (c *ClientWithResponses) AdminListTargetRoutesWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*AdminListTargetRoutesResponse, error) {
-	rsp, err := c.AdminListTargetRoutes(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseAdminListTargetRoutesResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// AdminRemoveTargetGroupLinkWithResponse request returning *AdminRemoveTargetGroupLinkResponse
-func (c *ClientWithResponses) AdminRemoveTargetGroupLinkWithResponse(ctx context.Context, id string, params *AdminRemoveTargetGroupLinkParams, reqEditors ...RequestEditorFn) (*AdminRemoveTargetGroupLinkResponse, error) {
-	rsp, err := c.AdminRemoveTargetGroupLink(ctx, id, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseAdminRemoveTargetGroupLinkResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// AdminListUsersWithResponse request returning *AdminListUsersResponse
-func (c *ClientWithResponses) AdminListUsersWithResponse(ctx context.Context, params *AdminListUsersParams, reqEditors ...RequestEditorFn) (*AdminListUsersResponse, error) {
-	rsp, err := c.AdminListUsers(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseAdminListUsersResponse(rsp)
-}
 
-// AdminCreateUserWithBodyWithResponse request with arbitrary body returning *AdminCreateUserResponse
-func (c *ClientWithResponses) AdminCreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminCreateUserResponse, error) {
-	rsp, err := c.AdminCreateUserWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseAdminCreateUserResponse(rsp)
+	return response, nil
 }
 
-func (c *ClientWithResponses) AdminCreateUserWithResponse(ctx context.Context, body AdminCreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminCreateUserResponse, error) {
-	rsp, err := c.AdminCreateUser(ctx, body, reqEditors...)
+// ParseAdminCreateTargetGroupLinkResponse parses an HTTP response from a AdminCreateTargetGroupLinkWithResponse call
+func ParseAdminCreateTargetGroupLinkResponse(rsp *http.Response) (*AdminCreateTargetGroupLinkResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseAdminCreateUserResponse(rsp)
-}
 
-// AdminUpdateUserWithBodyWithResponse request with arbitrary body returning *AdminUpdateUserResponse
-func (c *ClientWithResponses) AdminUpdateUserWithBodyWithResponse(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminUpdateUserResponse, error) {
-	rsp, err := c.AdminUpdateUserWithBody(ctx, userId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &AdminCreateTargetGroupLinkResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseAdminUpdateUserResponse(rsp)
-}
 
-func (c *ClientWithResponses) AdminUpdateUserWithResponse(ctx context.Context, userId string, body AdminUpdateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminUpdateUserResponse, error) {
-	rsp, err := c.AdminUpdateUser(ctx, userId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TargetRoute
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseAdminUpdateUserResponse(rsp)
-}
 
-// UserListEntitlementsWithResponse request returning *UserListEntitlementsResponse
-func (c *ClientWithResponses) UserListEntitlementsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserListEntitlementsResponse, error) {
-	rsp, err := c.UserListEntitlements(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUserListEntitlementsResponse(rsp)
+	return response, nil
 }
 
-// UserListEntitlementTargetsWithResponse request returning *UserListEntitlementTargetsResponse
-func (c *ClientWithResponses) UserListEntitlementTargetsWithResponse(ctx context.Context, params *UserListEntitlementTargetsParams, reqEditors ...RequestEditorFn) (*UserListEntitlementTargetsResponse, error) {
-	rsp, err := c.UserListEntitlementTargets(ctx, params, reqEditors...)
+// ParseAdminGetTargetGroupResourcesResponse parses an HTTP response from a AdminGetTargetGroupResourcesWithResponse call
+func ParseAdminGetTargetGroupResourcesResponse(rsp *http.Response) (*AdminGetTargetGroupResourcesResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUserListEntitlementTargetsResponse(rsp)
-}
 
-// UserRequestPreflightWithBodyWithResponse request with arbitrary body returning *UserRequestPreflightResponse
-func (c *ClientWithResponses) UserRequestPreflightWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserRequestPreflightResponse, error) {
-	rsp, err := c.UserRequestPreflightWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &AdminGetTargetGroupResourcesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUserRequestPreflightResponse(rsp)
-}
 
-func (c *ClientWithResponses) UserRequestPreflightWithResponse(ctx context.Context, body UserRequestPreflightJSONRequestBody, reqEditors ...RequestEditorFn) (*UserRequestPreflightResponse, error) {
-	rsp, err := c.UserRequestPreflight(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUserRequestPreflightResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TargetGroupResource
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// UserGetPreflightWithResponse request returning *UserGetPreflightResponse
-func (c *ClientWithResponses) UserGetPreflightWithResponse(ctx context.Context, preflightId string, reqEditors ...RequestEditorFn) (*UserGetPreflightResponse, error) {
-	rsp, err := c.UserGetPreflight(ctx, preflightId, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUserGetPreflightResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-// UserListRequestsWithResponse request returning *UserListRequestsResponse
-func (c *ClientWithResponses) UserListRequestsWithResponse(ctx context.Context, params *UserListRequestsParams, reqEditors ...RequestEditorFn) (*UserListRequestsResponse, error) {
-	rsp, err := c.UserListRequests(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUserListRequestsResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// UserPostRequestsWithBodyWithResponse request with arbitrary body returning *UserPostRequestsResponse
-func (c *ClientWithResponses) UserPostRequestsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserPostRequestsResponse, error) {
-	rsp, err := c.UserPostRequestsWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUserPostRequestsResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-func (c *ClientWithResponses) UserPostRequestsWithResponse(ctx context.Context, body UserPostRequestsJSONRequestBody, reqEditors ...RequestEditorFn) (*UserPostRequestsResponse, error) {
-	rsp, err := c.UserPostRequests(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseUserPostRequestsResponse(rsp)
-}
 
-// UserGetRequestWithResponse request returning *UserGetRequestResponse
-func (c *ClientWithResponses) UserGetRequestWithResponse(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*UserGetRequestResponse, error) {
-	rsp, err := c.UserGetRequest(ctx, requestId, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUserGetRequestResponse(rsp)
+	return response, nil
 }
 
-// UserListRequestEventsWithResponse request returning *UserListRequestEventsResponse
-func (c *ClientWithResponses) UserListRequestEventsWithResponse(ctx context.Context, requestId string, reqEditors ...RequestEditorFn) (*UserListRequestEventsResponse, error) {
-	rsp, err := c.UserListRequestEvents(ctx, requestId, reqEditors...)
+// ParseAdminFilterTargetGroupResourcesResponse parses an HTTP response from a AdminFilterTargetGroupResourcesWithResponse call
+func ParseAdminFilterTargetGroupResourcesResponse(rsp *http.Response) (*AdminFilterTargetGroupResourcesResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUserListRequestEventsResponse(rsp)
-}
 
-// UserReviewRequestWithBodyWithResponse request with arbitrary body returning *UserReviewRequestResponse
-func (c *ClientWithResponses) UserReviewRequestWithBodyWithResponse(ctx context.Context, requestId string, groupId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserReviewRequestResponse, error) {
-	rsp, err := c.UserReviewRequestWithBody(ctx, requestId, groupId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &AdminFilterTargetGroupResourcesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUserReviewRequestResponse(rsp)
-}
 
-func (c *ClientWithResponses) UserReviewRequestWithResponse(ctx context.Context, requestId string, groupId string, body UserReviewRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*UserReviewRequestResponse, error) {
-	rsp, err := c.UserReviewRequest(ctx, requestId, groupId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TargetGroupResource
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseUserReviewRequestResponse(rsp)
+
+	return response, nil
 }
 
-// UserCancelRequestWithResponse request returning *UserCancelRequestResponse
-func (c *ClientWithResponses) UserCancelRequestWithResponse(ctx context.Context, requestid string, reqEditors ...RequestEditorFn) (*UserCancelRequestResponse, error) {
-	rsp, err := c.UserCancelRequest(ctx, requestid, reqEditors...)
+// ParseAdminListTargetRoutesResponse parses an HTTP response from a AdminListTargetRoutesWithResponse call
+func ParseAdminListTargetRoutesResponse(rsp *http.Response) (*AdminListTargetRoutesResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUserCancelRequestResponse(rsp)
-}
 
-// UserRevokeRequestWithResponse request returning *UserRevokeRequestResponse
-func (c *ClientWithResponses) UserRevokeRequestWithResponse(ctx context.Context, requestid string, reqEditors ...RequestEditorFn) (*UserRevokeRequestResponse, error) {
-	rsp, err := c.UserRevokeRequest(ctx, requestid, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &AdminListTargetRoutesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUserRevokeRequestResponse(rsp)
-}
 
-// UserListReviewsWithResponse request returning *UserListReviewsResponse
-func (c *ClientWithResponses) UserListReviewsWithResponse(ctx context.Context, params *UserListReviewsParams, reqEditors ...RequestEditorFn) (*UserListReviewsResponse, error) {
-	rsp, err := c.UserListReviews(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Next   *string       `json:"next,omitempty"`
+			Routes []TargetRoute `json:"routes"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseUserListReviewsResponse(rsp)
+
+	return response, nil
 }
 
-// GetGroupTargetInstructionsWithResponse request returning *GetGroupTargetInstructionsResponse
-func (c *ClientWithResponses) GetGroupTargetInstructionsWithResponse(ctx context.Context, targetId string, reqEditors ...RequestEditorFn) (*GetGroupTargetInstructionsResponse, error) {
-	rsp, err := c.GetGroupTargetInstructions(ctx, targetId, reqEditors...)
+// ParseAdminSyncTargetGroupResponse parses an HTTP response from a AdminSyncTargetGroupWithResponse call
+func ParseAdminSyncTargetGroupResponse(rsp *http.Response) (*AdminSyncTargetGroupResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetGroupTargetInstructionsResponse(rsp)
-}
 
-// UserGetMeWithResponse request returning *UserGetMeResponse
-func (c *ClientWithResponses) UserGetMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserGetMeResponse, error) {
-	rsp, err := c.UserGetMe(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &AdminSyncTargetGroupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUserGetMeResponse(rsp)
-}
 
-// UserGetUserWithResponse request returning *UserGetUserResponse
-func (c *ClientWithResponses) UserGetUserWithResponse(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*UserGetUserResponse, error) {
-	rsp, err := c.UserGetUser(ctx, userId, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TargetGroupSyncStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseUserGetUserResponse(rsp)
+
+	return response, nil
 }
 
-// ParseUserListAccessTemplatesResponse parses an HTTP response from a UserListAccessTemplatesWithResponse call
-func ParseUserListAccessTemplatesResponse(rsp *http.Response) (*UserListAccessTemplatesResponse, error) {
+// ParseAdminRemoveTargetGroupLinkResponse parses an HTTP response from a AdminRemoveTargetGroupLinkWithResponse call
+func ParseAdminRemoveTargetGroupLinkResponse(rsp *http.Response) (*AdminRemoveTargetGroupLinkResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UserListAccessTemplatesResponse{
+	response := &AdminRemoveTargetGroupLinkResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
-			AccessTemplates []AccessTemplate `json:"accessTemplates"`
-			Next            *string          `json:"next,omitempty"`
+			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest struct {
@@ -5942,15 +12817,15 @@ func ParseUserListAccessTemplatesResponse(rsp *http.Response) (*UserListAccessTe
 	return response, nil
 }
 
-// ParseAdminListAccessRulesResponse parses an HTTP response from a AdminListAccessRulesWithResponse call
-func ParseAdminListAccessRulesResponse(rsp *http.Response) (*AdminListAccessRulesResponse, error) {
+// ParseAdminListTargetMetadataResponse parses an HTTP response from a AdminListTargetMetadataWithResponse call
+func ParseAdminListTargetMetadataResponse(rsp *http.Response) (*AdminListTargetMetadataResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminListAccessRulesResponse{
+	response := &AdminListTargetMetadataResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -5958,8 +12833,7 @@ func ParseAdminListAccessRulesResponse(rsp *http.Response) (*AdminListAccessRule
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			AccessRules []AccessRule `json:"accessRules"`
-			Next        *string      `json:"next"`
+			Metadata []TargetMetadata `json:"metadata"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
@@ -5989,22 +12863,22 @@ func ParseAdminListAccessRulesResponse(rsp *http.Response) (*AdminListAccessRule
 	return response, nil
 }
 
-// ParseAdminCreateAccessRuleResponse parses an HTTP response from a AdminCreateAccessRuleWithResponse call
-func ParseAdminCreateAccessRuleResponse(rsp *http.Response) (*AdminCreateAccessRuleResponse, error) {
+// ParseAdminCreateTargetMetadataResponse parses an HTTP response from a AdminCreateTargetMetadataWithResponse call
+func ParseAdminCreateTargetMetadataResponse(rsp *http.Response) (*AdminCreateTargetMetadataResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminCreateAccessRuleResponse{
+	response := &AdminCreateTargetMetadataResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest AccessRule
+		var dest TargetMetadata
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -6042,15 +12916,15 @@ func ParseAdminCreateAccessRuleResponse(rsp *http.Response) (*AdminCreateAccessR
 	return response, nil
 }
 
-// ParseAdminDeleteAccessRuleResponse parses an HTTP response from a AdminDeleteAccessRuleWithResponse call
-func ParseAdminDeleteAccessRuleResponse(rsp *http.Response) (*AdminDeleteAccessRuleResponse, error) {
+// ParseAdminDeleteTargetMetadataResponse parses an HTTP response from a AdminDeleteTargetMetadataWithResponse call
+func ParseAdminDeleteTargetMetadataResponse(rsp *http.Response) (*AdminDeleteTargetMetadataResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminDeleteAccessRuleResponse{
+	response := &AdminDeleteTargetMetadataResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -6088,27 +12962,36 @@ func ParseAdminDeleteAccessRuleResponse(rsp *http.Response) (*AdminDeleteAccessR
 	return response, nil
 }
 
-// ParseAdminGetAccessRuleResponse parses an HTTP response from a AdminGetAccessRuleWithResponse call
-func ParseAdminGetAccessRuleResponse(rsp *http.Response) (*AdminGetAccessRuleResponse, error) {
+// ParseAdminUpdateTargetMetadataResponse parses an HTTP response from a AdminUpdateTargetMetadataWithResponse call
+func ParseAdminUpdateTargetMetadataResponse(rsp *http.Response) (*AdminUpdateTargetMetadataResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminGetAccessRuleResponse{
+	response := &AdminUpdateTargetMetadataResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AccessRule
+		var dest TargetMetadata
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			Error string `json:"error"`
@@ -6141,22 +13024,25 @@ func ParseAdminGetAccessRuleResponse(rsp *http.Response) (*AdminGetAccessRuleRes
 	return response, nil
 }
 
-// ParseAdminUpdateAccessRuleResponse parses an HTTP response from a AdminUpdateAccessRuleWithResponse call
-func ParseAdminUpdateAccessRuleResponse(rsp *http.Response) (*AdminUpdateAccessRuleResponse, error) {
+// ParseAdminListUsersResponse parses an HTTP response from a AdminListUsersWithResponse call
+func ParseAdminListUsersResponse(rsp *http.Response) (*AdminListUsersResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminUpdateAccessRuleResponse{
+	response := &AdminListUsersResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AccessRule
+		var dest struct {
+			Next  *string `json:"next"`
+			Users []User  `json:"users"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -6167,84 +13053,79 @@ func ParseAdminUpdateAccessRuleResponse(rsp *http.Response) (*AdminUpdateAccessR
 	return response, nil
 }
 
-// ParseAdminGetDeploymentVersionResponse parses an HTTP response from a AdminGetDeploymentVersionWithResponse call
-func ParseAdminGetDeploymentVersionResponse(rsp *http.Response) (*AdminGetDeploymentVersionResponse, error) {
+// ParseAdminCreateUserResponse parses an HTTP response from a AdminCreateUserWithResponse call
+func ParseAdminCreateUserResponse(rsp *http.Response) (*AdminCreateUserResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminGetDeploymentVersionResponse{
+	response := &AdminCreateUserResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
-			// The deployment version. Will be a semver, such as "v0.9.0" for official releases, or "dev+GIT_HASH" for pre-release builds.
-			Version string `json:"version"`
+			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseAdminListGroupsResponse parses an HTTP response from a AdminListGroupsWithResponse call
-func ParseAdminListGroupsResponse(rsp *http.Response) (*AdminListGroupsResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+		response.JSON400 = &dest
 
-	response := &AdminListGroupsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest struct {
-			Groups []Group `json:"groups"`
-			Next   *string `json:"next"`
+			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON500 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseAdminCreateGroupResponse parses an HTTP response from a AdminCreateGroupWithResponse call
-func ParseAdminCreateGroupResponse(rsp *http.Response) (*AdminCreateGroupResponse, error) {
+// ParseAdminUpdateUserResponse parses an HTTP response from a AdminUpdateUserWithResponse call
+func ParseAdminUpdateUserResponse(rsp *http.Response) (*AdminUpdateUserResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminCreateGroupResponse{
+	response := &AdminUpdateUserResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Group
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
@@ -6278,20 +13159,38 @@ func ParseAdminCreateGroupResponse(rsp *http.Response) (*AdminCreateGroupRespons
 	return response, nil
 }
 
-// ParseAdminDeleteGroupResponse parses an HTTP response from a AdminDeleteGroupWithResponse call
-func ParseAdminDeleteGroupResponse(rsp *http.Response) (*AdminDeleteGroupResponse, error) {
+// ParseAdminListUserGroupMembershipsResponse parses an HTTP response from a AdminListUserGroupMembershipsWithResponse call
+func ParseAdminListUserGroupMembershipsResponse(rsp *http.Response) (*AdminListUserGroupMembershipsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminDeleteGroupResponse{
+	response := &AdminListUserGroupMembershipsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Memberships []UserGroupMembership `json:"memberships"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
 			Error string `json:"error"`
@@ -6315,48 +13214,62 @@ func ParseAdminDeleteGroupResponse(rsp *http.Response) (*AdminDeleteGroupRespons
 	return response, nil
 }
 
-// ParseAdminGetGroupResponse parses an HTTP response from a AdminGetGroupWithResponse call
-func ParseAdminGetGroupResponse(rsp *http.Response) (*AdminGetGroupResponse, error) {
+// ParseUserListEntitlementsResponse parses an HTTP response from a UserListEntitlementsWithResponse call
+func ParseUserListEntitlementsResponse(rsp *http.Response) (*UserListEntitlementsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminGetGroupResponse{
+	response := &UserListEntitlementsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Group
+		var dest struct {
+			Entitlements []TargetKind `json:"entitlements"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseAdminUpdateGroupResponse parses an HTTP response from a AdminUpdateGroupWithResponse call
-func ParseAdminUpdateGroupResponse(rsp *http.Response) (*AdminUpdateGroupResponse, error) {
+// ParseUserListEntitlementTargetsResponse parses an HTTP response from a UserListEntitlementTargetsWithResponse call
+func ParseUserListEntitlementTargetsResponse(rsp *http.Response) (*UserListEntitlementTargetsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminUpdateGroupResponse{
+	response := &UserListEntitlementTargetsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Group
+		var dest struct {
+			Next    *string  `json:"next,omitempty"`
+			Targets []Target `json:"targets"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -6371,6 +13284,15 @@ func ParseAdminUpdateGroupResponse(rsp *http.Response) (*AdminUpdateGroupRespons
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest struct {
 			Error string `json:"error"`
@@ -6385,15 +13307,15 @@ func ParseAdminUpdateGroupResponse(rsp *http.Response) (*AdminUpdateGroupRespons
 	return response, nil
 }
 
-// ParseAdminListHandlersResponse parses an HTTP response from a AdminListHandlersWithResponse call
-func ParseAdminListHandlersResponse(rsp *http.Response) (*AdminListHandlersResponse, error) {
+// ParseUserListFavoritesResponse parses an HTTP response from a UserListFavoritesWithResponse call
+func ParseUserListFavoritesResponse(rsp *http.Response) (*UserListFavoritesResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminListHandlersResponse{
+	response := &UserListFavoritesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -6401,14 +13323,57 @@ func ParseAdminListHandlersResponse(rsp *http.Response) (*AdminListHandlersRespo
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Next string      `json:"next"`
-			Res  []TGHandler `json:"res"`
+			Favorites []Favorite `json:"favorites"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUserCreateFavoriteResponse parses an HTTP response from a UserCreateFavoriteWithResponse call
+func ParseUserCreateFavoriteResponse(rsp *http.Response) (*UserCreateFavoriteResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserCreateFavoriteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Favorite
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			Error string `json:"error"`
@@ -6418,6 +13383,15 @@ func ParseAdminListHandlersResponse(rsp *http.Response) (*AdminListHandlersRespo
 		}
 		response.JSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest struct {
 			Error string `json:"error"`
@@ -6432,26 +13406,26 @@ func ParseAdminListHandlersResponse(rsp *http.Response) (*AdminListHandlersRespo
 	return response, nil
 }
 
-// ParseAdminRegisterHandlerResponse parses an HTTP response from a AdminRegisterHandlerWithResponse call
-func ParseAdminRegisterHandlerResponse(rsp *http.Response) (*AdminRegisterHandlerResponse, error) {
+// ParseUserRequestFavoritesResponse parses an HTTP response from a UserRequestFavoritesWithResponse call
+func ParseUserRequestFavoritesResponse(rsp *http.Response) (*UserRequestFavoritesResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminRegisterHandlerResponse{
+	response := &UserRequestFavoritesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest TGHandler
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Request
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
@@ -6462,14 +13436,23 @@ func ParseAdminRegisterHandlerResponse(rsp *http.Response) (*AdminRegisterHandle
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
 			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest struct {
@@ -6485,36 +13468,20 @@ func ParseAdminRegisterHandlerResponse(rsp *http.Response) (*AdminRegisterHandle
 	return response, nil
 }
 
-// ParseAdminDeleteHandlerResponse parses an HTTP response from a AdminDeleteHandlerWithResponse call
-func ParseAdminDeleteHandlerResponse(rsp *http.Response) (*AdminDeleteHandlerResponse, error) {
+// ParseUserDeleteFavoriteResponse parses an HTTP response from a UserDeleteFavoriteWithResponse call
+func ParseUserDeleteFavoriteResponse(rsp *http.Response) (*UserDeleteFavoriteResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminDeleteHandlerResponse{
+	response := &UserDeleteFavoriteResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 204:
-		var dest map[string]interface{}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON204 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
 			Error string `json:"error"`
@@ -6538,27 +13505,36 @@ func ParseAdminDeleteHandlerResponse(rsp *http.Response) (*AdminDeleteHandlerRes
 	return response, nil
 }
 
-// ParseAdminGetHandlerResponse parses an HTTP response from a AdminGetHandlerWithResponse call
-func ParseAdminGetHandlerResponse(rsp *http.Response) (*AdminGetHandlerResponse, error) {
+// ParseUserRequestPreflightResponse parses an HTTP response from a UserRequestPreflightWithResponse call
+func ParseUserRequestPreflightResponse(rsp *http.Response) (*UserRequestPreflightResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminGetHandlerResponse{
+	response := &UserRequestPreflightResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TGHandler
+		var dest Preflight
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			Error string `json:"error"`
@@ -6577,42 +13553,23 @@ func ParseAdminGetHandlerResponse(rsp *http.Response) (*AdminGetHandlerResponse,
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
 		var dest struct {
 			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseAdminHealthcheckHandlersResponse parses an HTTP response from a AdminHealthcheckHandlersWithResponse call
-func ParseAdminHealthcheckHandlersResponse(rsp *http.Response) (*AdminHealthcheckHandlersResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &AdminHealthcheckHandlersResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+		response.JSON409 = &dest
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
 		var dest struct {
 			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON422 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest struct {
@@ -6628,38 +13585,35 @@ func ParseAdminHealthcheckHandlersResponse(rsp *http.Response) (*AdminHealthchec
 	return response, nil
 }
 
-// ParseAdminGetIdentityConfigurationResponse parses an HTTP response from a AdminGetIdentityConfigurationWithResponse call
-func ParseAdminGetIdentityConfigurationResponse(rsp *http.Response) (*AdminGetIdentityConfigurationResponse, error) {
+// ParseUserGetPreflightResponse parses an HTTP response from a UserGetPreflightWithResponse call
+func ParseUserGetPreflightResponse(rsp *http.Response) (*UserGetPreflightResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminGetIdentityConfigurationResponse{
+	response := &UserGetPreflightResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			AdministratorGroupId string `json:"administratorGroupId"`
-			IdentityProvider     string `json:"identityProvider"`
-		}
+		var dest Preflight
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
 			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest struct {
@@ -6675,28 +13629,29 @@ func ParseAdminGetIdentityConfigurationResponse(rsp *http.Response) (*AdminGetId
 	return response, nil
 }
 
-// ParseAdminSyncIdentityResponse parses an HTTP response from a AdminSyncIdentityWithResponse call
-func ParseAdminSyncIdentityResponse(rsp *http.Response) (*AdminSyncIdentityResponse, error) {
+// ParseUserListRequestsResponse parses an HTTP response from a UserListRequestsWithResponse call
+func ParseUserListRequestsResponse(rsp *http.Response) (*UserListRequestsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminSyncIdentityResponse{
+	response := &UserListRequestsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Error string `json:"error"`
+			Next     *string   `json:"next"`
+			Requests []Request `json:"requests"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest struct {
@@ -6712,66 +13667,97 @@ func ParseAdminSyncIdentityResponse(rsp *http.Response) (*AdminSyncIdentityRespo
 	return response, nil
 }
 
-// ParseAdminListRequestsResponse parses an HTTP response from a AdminListRequestsWithResponse call
-func ParseAdminListRequestsResponse(rsp *http.Response) (*AdminListRequestsResponse, error) {
+// ParseUserPostRequestsResponse parses an HTTP response from a UserPostRequestsWithResponse call
+func ParseUserPostRequestsResponse(rsp *http.Response) (*UserPostRequestsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminListRequestsResponse{
+	response := &UserPostRequestsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Request
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
-			Next     *string   `json:"next"`
-			Requests []Request `json:"requests"`
+			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseAdminListTargetGroupsResponse parses an HTTP response from a AdminListTargetGroupsWithResponse call
-func ParseAdminListTargetGroupsResponse(rsp *http.Response) (*AdminListTargetGroupsResponse, error) {
+// ParseUserGetRequestResponse parses an HTTP response from a UserGetRequestWithResponse call
+func ParseUserGetRequestResponse(rsp *http.Response) (*UserGetRequestResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminListTargetGroupsResponse{
+	response := &UserGetRequestResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			TargetGroups []TargetGroup `json:"targetGroups"`
-		}
+		var dest Request
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
 			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest struct {
@@ -6787,35 +13773,29 @@ func ParseAdminListTargetGroupsResponse(rsp *http.Response) (*AdminListTargetGro
 	return response, nil
 }
 
-// ParseAdminCreateTargetGroupResponse parses an HTTP response from a AdminCreateTargetGroupWithResponse call
-func ParseAdminCreateTargetGroupResponse(rsp *http.Response) (*AdminCreateTargetGroupResponse, error) {
+// ParseUserListRequestEventsResponse parses an HTTP response from a UserListRequestEventsWithResponse call
+func ParseUserListRequestEventsResponse(rsp *http.Response) (*UserListRequestEventsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminCreateTargetGroupResponse{
+	response := &UserListRequestEventsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest TargetGroup
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Error string `json:"error"`
+			Events []RequestEvent `json:"events"`
+			Next   *string        `json:"next"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
@@ -6826,51 +13806,71 @@ func ParseAdminCreateTargetGroupResponse(rsp *http.Response) (*AdminCreateTarget
 		}
 		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest struct {
 			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON500 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+	}
+
+	return response, nil
+}
+
+// ParseUserReviewRequestResponse parses an HTTP response from a UserReviewRequestWithResponse call
+func ParseUserReviewRequestResponse(rsp *http.Response) (*UserReviewRequestResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserReviewRequestResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Error string `json:"error"`
+			// A request to access something made by an end user in Common Fate.
+			Request *Request `json:"request,omitempty"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
+		response.JSON200 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseAdminDeleteTargetGroupResponse parses an HTTP response from a AdminDeleteTargetGroupWithResponse call
-func ParseAdminDeleteTargetGroupResponse(rsp *http.Response) (*AdminDeleteTargetGroupResponse, error) {
+// ParseUserCancelRequestResponse parses an HTTP response from a UserCancelRequestWithResponse call
+func ParseUserCancelRequestResponse(rsp *http.Response) (*UserCancelRequestResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminDeleteTargetGroupResponse{
+	response := &UserCancelRequestResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
 			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
@@ -6895,27 +13895,36 @@ func ParseAdminDeleteTargetGroupResponse(rsp *http.Response) (*AdminDeleteTarget
 	return response, nil
 }
 
-// ParseAdminGetTargetGroupResponse parses an HTTP response from a AdminGetTargetGroupWithResponse call
-func ParseAdminGetTargetGroupResponse(rsp *http.Response) (*AdminGetTargetGroupResponse, error) {
+// ParseUserActivateRequestGroupResponse parses an HTTP response from a UserActivateRequestGroupWithResponse call
+func ParseUserActivateRequestGroupResponse(rsp *http.Response) (*UserActivateRequestGroupResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminGetTargetGroupResponse{
+	response := &UserActivateRequestGroupResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TargetGroup
+		var dest RequestAccessGroup
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			Error string `json:"error"`
@@ -6948,27 +13957,20 @@ func ParseAdminGetTargetGroupResponse(rsp *http.Response) (*AdminGetTargetGroupR
 	return response, nil
 }
 
-// ParseAdminCreateTargetGroupLinkResponse parses an HTTP response from a AdminCreateTargetGroupLinkWithResponse call
-func ParseAdminCreateTargetGroupLinkResponse(rsp *http.Response) (*AdminCreateTargetGroupLinkResponse, error) {
+// ParseUserRevokeRequestTargetResponse parses an HTTP response from a UserRevokeRequestTargetWithResponse call
+func ParseUserRevokeRequestTargetResponse(rsp *http.Response) (*UserRevokeRequestTargetResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminCreateTargetGroupLinkResponse{
+	response := &UserRevokeRequestTargetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TargetRoute
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
 			Error string `json:"error"`
@@ -7010,35 +14012,28 @@ func ParseAdminCreateTargetGroupLinkResponse(rsp *http.Response) (*AdminCreateTa
 	return response, nil
 }
 
-// ParseAdminGetTargetGroupResourcesResponse parses an HTTP response from a AdminGetTargetGroupResourcesWithResponse call
-func ParseAdminGetTargetGroupResourcesResponse(rsp *http.Response) (*AdminGetTargetGroupResourcesResponse, error) {
+// ParseUserRevokeRequestResponse parses an HTTP response from a UserRevokeRequestWithResponse call
+func ParseUserRevokeRequestResponse(rsp *http.Response) (*UserRevokeRequestResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminGetTargetGroupResourcesResponse{
+	response := &UserRevokeRequestResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []TargetGroupResource
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
 			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
@@ -7063,41 +14058,53 @@ func ParseAdminGetTargetGroupResourcesResponse(rsp *http.Response) (*AdminGetTar
 	return response, nil
 }
 
-// ParseAdminFilterTargetGroupResourcesResponse parses an HTTP response from a AdminFilterTargetGroupResourcesWithResponse call
-func ParseAdminFilterTargetGroupResourcesResponse(rsp *http.Response) (*AdminFilterTargetGroupResourcesResponse, error) {
+// ParseUserListReviewsResponse parses an HTTP response from a UserListReviewsWithResponse call
+func ParseUserListReviewsResponse(rsp *http.Response) (*UserListReviewsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminFilterTargetGroupResourcesResponse{
+	response := &UserListReviewsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []TargetGroupResource
+		var dest struct {
+			Next     *string   `json:"next"`
+			Requests []Request `json:"requests"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseAdminListTargetRoutesResponse parses an HTTP response from a AdminListTargetRoutesWithResponse call
-func ParseAdminListTargetRoutesResponse(rsp *http.Response) (*AdminListTargetRoutesResponse, error) {
+// ParseGetGroupTargetInstructionsResponse parses an HTTP response from a GetGroupTargetInstructionsWithResponse call
+func ParseGetGroupTargetInstructionsResponse(rsp *http.Response) (*GetGroupTargetInstructionsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminListTargetRoutesResponse{
+	response := &GetGroupTargetInstructionsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -7105,8 +14112,10 @@ func ParseAdminListTargetRoutesResponse(rsp *http.Response) (*AdminListTargetRou
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Next   *string       `json:"next,omitempty"`
-			Routes []TargetRoute `json:"routes"`
+			// Instructions on how to access the requested resource.
+			//
+			// The `instructions` field will be null if no instructions are available.
+			Instructions RequestAccessGroupTargetAccessInstructions `json:"instructions"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
@@ -7136,992 +14145,964 @@ func ParseAdminListTargetRoutesResponse(rsp *http.Response) (*AdminListTargetRou
 	return response, nil
 }
 
-// ParseAdminRemoveTargetGroupLinkResponse parses an HTTP response from a AdminRemoveTargetGroupLinkWithResponse call
-func ParseAdminRemoveTargetGroupLinkResponse(rsp *http.Response) (*AdminRemoveTargetGroupLinkResponse, error) {
+// ParseUserGetMeResponse parses an HTTP response from a UserGetMeWithResponse call
+func ParseUserGetMeResponse(rsp *http.Response) (*UserGetMeResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminRemoveTargetGroupLinkResponse{
+	response := &UserGetMeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Error string `json:"error"`
+			// Whether the user is an administrator of Common Fate.
+			IsAdmin bool `json:"isAdmin"`
+			User    User `json:"user"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	return response, nil
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest struct {
-			Error string `json:"error"`
-		}
+// ParseUserGetUserResponse parses an HTTP response from a UserGetUserWithResponse call
+func ParseUserGetUserResponse(rsp *http.Response) (*UserGetUserResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserGetUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Your GET endpoint
+	// (GET /api/v1/access-templates)
+	UserListAccessTemplates(w http.ResponseWriter, r *http.Request)
+	// List Access Rules
+	// (GET /api/v1/admin/access-rules)
+	AdminListAccessRules(w http.ResponseWriter, r *http.Request, params AdminListAccessRulesParams)
+	// Create Access Rule
+	// (POST /api/v1/admin/access-rules)
+	AdminCreateAccessRule(w http.ResponseWriter, r *http.Request)
+	// Delete Access Rule
+	// (DELETE /api/v1/admin/access-rules/{ruleId})
+	AdminDeleteAccessRule(w http.ResponseWriter, r *http.Request, ruleId string)
+	// Get Access Rule
+	// (GET /api/v1/admin/access-rules/{ruleId})
+	AdminGetAccessRule(w http.ResponseWriter, r *http.Request, ruleId string)
+	// Update Access Rule
+	// (PUT /api/v1/admin/access-rules/{ruleId})
+	AdminUpdateAccessRule(w http.ResponseWriter, r *http.Request, ruleId string)
+	// List bulk revoke jobs
+	// (GET /api/v1/admin/bulk-revoke-jobs)
+	AdminListBulkRevokeJobs(w http.ResponseWriter, r *http.Request)
+	// Create a bulk revoke job
+	// (POST /api/v1/admin/bulk-revoke-jobs)
+	AdminCreateBulkRevokeJob(w http.ResponseWriter, r *http.Request)
+	// Get a bulk revoke job
+	// (GET /api/v1/admin/bulk-revoke-jobs/{jobId})
+	AdminGetBulkRevokeJob(w http.ResponseWriter, r *http.Request, jobId string)
+	// List dead letter events
+	// (GET /api/v1/admin/dead-letter-events)
+	AdminListDeadLetterEvents(w http.ResponseWriter, r *http.Request, params AdminListDeadLetterEventsParams)
+	// Discard a dead letter event
+	// (DELETE /api/v1/admin/dead-letter-events/{eventId})
+	AdminDiscardDeadLetterEvent(w http.ResponseWriter, r *http.Request, eventId string)
+	// Get a dead letter event
+	// (GET /api/v1/admin/dead-letter-events/{eventId})
+	AdminGetDeadLetterEvent(w http.ResponseWriter, r *http.Request, eventId string)
+	// Replay a dead letter event
+	// (POST /api/v1/admin/dead-letter-events/{eventId}/replay)
+	AdminReplayDeadLetterEvent(w http.ResponseWriter, r *http.Request, eventId string)
+	// Get deployment version details
+	// (GET /api/v1/admin/deployment/version)
+	AdminGetDeploymentVersion(w http.ResponseWriter, r *http.Request)
+	// List grant drift
+	// (GET /api/v1/admin/drift)
+	AdminListGrantDrift(w http.ResponseWriter, r *http.Request)
+	// List event schemas
+	// (GET /api/v1/admin/event-schemas)
+	AdminListEventSchemas(w http.ResponseWriter, r *http.Request)
+	// Get an event schema
+	// (GET /api/v1/admin/event-schemas/{eventType})
+	AdminGetEventSchema(w http.ResponseWriter, r *http.Request, eventType string, params AdminGetEventSchemaParams)
+	// List failed grants
+	// (GET /api/v1/admin/failed-grants)
+	AdminListFailedGrants(w http.ResponseWriter, r *http.Request)
+	// Force-complete a failed grant
+	// (POST /api/v1/admin/failed-grants/{grantId}/complete)
+	AdminCompleteFailedGrant(w http.ResponseWriter, r *http.Request, grantId string)
+	// Retry a failed grant
+	// (POST /api/v1/admin/failed-grants/{grantId}/retry)
+	AdminRetryFailedGrant(w http.ResponseWriter, r *http.Request, grantId string)
+	// List access freezes
+	// (GET /api/v1/admin/freezes)
+	AdminListFreezes(w http.ResponseWriter, r *http.Request)
+	// Create an access freeze
+	// (POST /api/v1/admin/freezes)
+	AdminCreateFreeze(w http.ResponseWriter, r *http.Request)
+	// Get an access freeze
+	// (GET /api/v1/admin/freezes/{freezeId})
+	AdminGetFreeze(w http.ResponseWriter, r *http.Request, freezeId string)
+	// Update an access freeze
+	// (PUT /api/v1/admin/freezes/{freezeId})
+	AdminUpdateFreeze(w http.ResponseWriter, r *http.Request, freezeId string)
+	// List the history of an access freeze
+	// (GET /api/v1/admin/freezes/{freezeId}/history)
+	AdminListFreezeHistory(w http.ResponseWriter, r *http.Request, freezeId string)
+	// Lift an access freeze
+	// (POST /api/v1/admin/freezes/{freezeId}/lift)
+	AdminLiftFreeze(w http.ResponseWriter, r *http.Request, freezeId string)
+	// List groups
+	// (GET /api/v1/admin/groups)
+	AdminListGroups(w http.ResponseWriter, r *http.Request, params AdminListGroupsParams)
+	// Create Group
+	// (POST /api/v1/admin/groups)
+	AdminCreateGroup(w http.ResponseWriter, r *http.Request)
+	// Delete Group
+	// (DELETE /api/v1/admin/groups/{groupId})
+	AdminDeleteGroup(w http.ResponseWriter, r *http.Request, groupId string)
+	// Get Group Details
+	// (GET /api/v1/admin/groups/{groupId})
+	AdminGetGroup(w http.ResponseWriter, r *http.Request, groupId string)
+	// Update Group
+	// (PUT /api/v1/admin/groups/{groupId})
+	AdminUpdateGroup(w http.ResponseWriter, r *http.Request, groupId string)
+	// Get handlers
+	// (GET /api/v1/admin/handlers)
+	AdminListHandlers(w http.ResponseWriter, r *http.Request)
+	// Register a handler
+	// (POST /api/v1/admin/handlers)
+	AdminRegisterHandler(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /api/v1/admin/handlers/{id})
+	AdminDeleteHandler(w http.ResponseWriter, r *http.Request, id string)
+	// Get handler
+	// (GET /api/v1/admin/handlers/{id})
+	AdminGetHandler(w http.ResponseWriter, r *http.Request, id string)
+	// Healthcheck Handlers
+	// (POST /api/v1/admin/healthcheck-handlers)
+	AdminHealthcheckHandlers(w http.ResponseWriter, r *http.Request)
+	// Get identity configuration
+	// (GET /api/v1/admin/identity)
+	AdminGetIdentityConfiguration(w http.ResponseWriter, r *http.Request)
+	// Sync Identity
+	// (POST /api/v1/admin/identity/sync)
+	AdminSyncIdentity(w http.ResponseWriter, r *http.Request, params AdminSyncIdentityParams)
+	// List identity sync runs
+	// (GET /api/v1/admin/identity/sync-runs)
+	AdminListIdentitySyncRuns(w http.ResponseWriter, r *http.Request)
+	// Your GET endpoint
+	// (GET /api/v1/admin/requests)
+	AdminListRequests(w http.ResponseWriter, r *http.Request, params AdminListRequestsParams)
+	// Replay the dead letter events for a request
+	// (POST /api/v1/admin/requests/{requestId}/replay-events)
+	AdminReplayRequestEvents(w http.ResponseWriter, r *http.Request, requestId string)
+	// Get target groups
+	// (GET /api/v1/admin/target-groups)
+	AdminListTargetGroups(w http.ResponseWriter, r *http.Request)
+	// Create target group
+	// (POST /api/v1/admin/target-groups)
+	AdminCreateTargetGroup(w http.ResponseWriter, r *http.Request)
+	// delete target group
+	// (DELETE /api/v1/admin/target-groups/{id})
+	AdminDeleteTargetGroup(w http.ResponseWriter, r *http.Request, id string)
+	// Get target group (detailed)
+	// (GET /api/v1/admin/target-groups/{id})
+	AdminGetTargetGroup(w http.ResponseWriter, r *http.Request, id string)
+	// Link a target group deployment to its target group
+	// (POST /api/v1/admin/target-groups/{id}/link)
+	AdminCreateTargetGroupLink(w http.ResponseWriter, r *http.Request, id string)
+	// List Target Group Resources
+	// (GET /api/v1/admin/target-groups/{id}/resources/{resourceType})
+	AdminGetTargetGroupResources(w http.ResponseWriter, r *http.Request, id string, resourceType string)
+	// Filter TargetGroup Resources
+	// (POST /api/v1/admin/target-groups/{id}/resources/{resourceType}/filters)
+	AdminFilterTargetGroupResources(w http.ResponseWriter, r *http.Request, id string, resourceType string)
+
+	// (GET /api/v1/admin/target-groups/{id}/routes)
+	AdminListTargetRoutes(w http.ResponseWriter, r *http.Request, id string)
+	// Sync target group resources
+	// (POST /api/v1/admin/target-groups/{id}/sync)
+	AdminSyncTargetGroup(w http.ResponseWriter, r *http.Request, id string)
+	// Unlink a target group deployment from its target group
+	// (POST /api/v1/admin/target-groups/{id}/unlink)
+	AdminRemoveTargetGroupLink(w http.ResponseWriter, r *http.Request, id string, params AdminRemoveTargetGroupLinkParams)
+	// List target metadata
+	// (GET /api/v1/admin/target-metadata)
+	AdminListTargetMetadata(w http.ResponseWriter, r *http.Request)
+	// Create target metadata
+	// (POST /api/v1/admin/target-metadata)
+	AdminCreateTargetMetadata(w http.ResponseWriter, r *http.Request)
+	// Delete target metadata
+	// (DELETE /api/v1/admin/target-metadata/{id})
+	AdminDeleteTargetMetadata(w http.ResponseWriter, r *http.Request, id string)
+	// Update target metadata
+	// (PUT /api/v1/admin/target-metadata/{id})
+	AdminUpdateTargetMetadata(w http.ResponseWriter, r *http.Request, id string)
+	// Returns a list of users
+	// (GET /api/v1/admin/users)
+	AdminListUsers(w http.ResponseWriter, r *http.Request, params AdminListUsersParams)
+	// Create User
+	// (POST /api/v1/admin/users)
+	AdminCreateUser(w http.ResponseWriter, r *http.Request)
+	// Update User
+	// (POST /api/v1/admin/users/{userId})
+	AdminUpdateUser(w http.ResponseWriter, r *http.Request, userId string)
+	// List user group memberships
+	// (GET /api/v1/admin/users/{userId}/group-memberships)
+	AdminListUserGroupMemberships(w http.ResponseWriter, r *http.Request, userId string)
+	// List Entitlements
+	// (GET /api/v1/entitlements)
+	UserListEntitlements(w http.ResponseWriter, r *http.Request)
+	// List Entitlement Resources
+	// (GET /api/v1/entitlements/targets)
+	UserListEntitlementTargets(w http.ResponseWriter, r *http.Request, params UserListEntitlementTargetsParams)
+	// List Favorites
+	// (GET /api/v1/favorites)
+	UserListFavorites(w http.ResponseWriter, r *http.Request)
+	// Create Favorite
+	// (POST /api/v1/favorites)
+	UserCreateFavorite(w http.ResponseWriter, r *http.Request)
+	// Request Favorites
+	// (POST /api/v1/favorites/request)
+	UserRequestFavorites(w http.ResponseWriter, r *http.Request)
+	// Delete Favorite
+	// (DELETE /api/v1/favorites/{targetId})
+	UserDeleteFavorite(w http.ResponseWriter, r *http.Request, targetId string)
+	// Submit Preflight
+	// (POST /api/v1/preflight)
+	UserRequestPreflight(w http.ResponseWriter, r *http.Request, params UserRequestPreflightParams)
+	// Get Preflight
+	// (GET /api/v1/preflight/{preflightId})
+	UserGetPreflight(w http.ResponseWriter, r *http.Request, preflightId string)
+	// List Requests
+	// (GET /api/v1/requests)
+	UserListRequests(w http.ResponseWriter, r *http.Request, params UserListRequestsParams)
+
+	// (POST /api/v1/requests)
+	UserPostRequests(w http.ResponseWriter, r *http.Request, params UserPostRequestsParams)
+	// Get Request
+	// (GET /api/v1/requests/{requestId})
+	UserGetRequest(w http.ResponseWriter, r *http.Request, requestId string)
+	// List request events
+	// (GET /api/v1/requests/{requestId}/events)
+	UserListRequestEvents(w http.ResponseWriter, r *http.Request, requestId string)
+	// Review a request
+	// (POST /api/v1/requests/{requestId}/review/{groupId})
+	UserReviewRequest(w http.ResponseWriter, r *http.Request, requestId string, groupId string)
+	// Revoke an active request
+	// (POST /api/v1/requests/{requestid}/cancel)
+	UserCancelRequest(w http.ResponseWriter, r *http.Request, requestid string)
+	// Activate on demand access
+	// (POST /api/v1/requests/{requestid}/groups/{groupid}/activate)
+	UserActivateRequestGroup(w http.ResponseWriter, r *http.Request, requestid string, groupid string)
+	// Revoke a target in an active request
+	// (POST /api/v1/requests/{requestid}/groups/{groupid}/targets/{targetid}/revoke)
+	UserRevokeRequestTarget(w http.ResponseWriter, r *http.Request, requestid string, groupid string, targetid string)
+	// Revoke an active request
+	// (POST /api/v1/requests/{requestid}/revoke)
+	UserRevokeRequest(w http.ResponseWriter, r *http.Request, requestid string)
+	// List Reviews
+	// (GET /api/v1/reviews)
+	UserListReviews(w http.ResponseWriter, r *http.Request, params UserListReviewsParams)
+	// Your GET endpoint
+	// (GET /api/v1/targets/{targetId}/access-instructions)
+	GetGroupTargetInstructions(w http.ResponseWriter, r *http.Request, targetId string)
+	// Get details for the current user
+	// (GET /api/v1/users/me)
+	UserGetMe(w http.ResponseWriter, r *http.Request)
+	// Get a user
+	// (GET /api/v1/users/{userId})
+	UserGetUser(w http.ResponseWriter, r *http.Request, userId string)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.HandlerFunc) http.HandlerFunc
+
+// UserListAccessTemplates operation middleware
+func (siw *ServerInterfaceWrapper) UserListAccessTemplates(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UserListAccessTemplates(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// AdminListAccessRules operation middleware
+func (siw *ServerInterfaceWrapper) AdminListAccessRules(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	}
+	var err error
 
-	return response, nil
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AdminListAccessRulesParams
+
+	// ------------- Optional query parameter "nextToken" -------------
+	if paramValue := r.URL.Query().Get("nextToken"); paramValue != "" {
 
-// ParseAdminListUsersResponse parses an HTTP response from a AdminListUsersWithResponse call
-func ParseAdminListUsersResponse(rsp *http.Response) (*AdminListUsersResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
 	}
 
-	response := &AdminListUsersResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	err = runtime.BindQueryParameter("form", true, false, "nextToken", r.URL.Query(), &params.NextToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nextToken", Err: err})
+		return
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Next  *string `json:"next"`
-			Users []User  `json:"users"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminListAccessRules(w, r, params)
+	}
 
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return response, nil
+	handler(w, r.WithContext(ctx))
 }
 
-// ParseAdminCreateUserResponse parses an HTTP response from a AdminCreateUserWithResponse call
-func ParseAdminCreateUserResponse(rsp *http.Response) (*AdminCreateUserResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// AdminCreateAccessRule operation middleware
+func (siw *ServerInterfaceWrapper) AdminCreateAccessRule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminCreateAccessRule(w, r)
 	}
 
-	response := &AdminCreateUserResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest User
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	handler(w, r.WithContext(ctx))
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+// AdminDeleteAccessRule operation middleware
+func (siw *ServerInterfaceWrapper) AdminDeleteAccessRule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	var err error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	// ------------- Path parameter "ruleId" -------------
+	var ruleId string
 
+	err = runtime.BindStyledParameter("simple", false, "ruleId", chi.URLParam(r, "ruleId"), &ruleId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ruleId", Err: err})
+		return
 	}
 
-	return response, nil
-}
-
-// ParseAdminUpdateUserResponse parses an HTTP response from a AdminUpdateUserWithResponse call
-func ParseAdminUpdateUserResponse(rsp *http.Response) (*AdminUpdateUserResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminDeleteAccessRule(w, r, ruleId)
 	}
 
-	response := &AdminUpdateUserResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest User
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	handler(w, r.WithContext(ctx))
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+// AdminGetAccessRule operation middleware
+func (siw *ServerInterfaceWrapper) AdminGetAccessRule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	var err error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	// ------------- Path parameter "ruleId" -------------
+	var ruleId string
 
+	err = runtime.BindStyledParameter("simple", false, "ruleId", chi.URLParam(r, "ruleId"), &ruleId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ruleId", Err: err})
+		return
 	}
 
-	return response, nil
-}
-
-// ParseUserListEntitlementsResponse parses an HTTP response from a UserListEntitlementsWithResponse call
-func ParseUserListEntitlementsResponse(rsp *http.Response) (*UserListEntitlementsResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminGetAccessRule(w, r, ruleId)
 	}
 
-	response := &UserListEntitlementsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Entitlements []TargetKind `json:"entitlements"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	handler(w, r.WithContext(ctx))
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// AdminUpdateAccessRule operation middleware
+func (siw *ServerInterfaceWrapper) AdminUpdateAccessRule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	}
+	var err error
 
-	return response, nil
-}
+	// ------------- Path parameter "ruleId" -------------
+	var ruleId string
 
-// ParseUserListEntitlementTargetsResponse parses an HTTP response from a UserListEntitlementTargetsWithResponse call
-func ParseUserListEntitlementTargetsResponse(rsp *http.Response) (*UserListEntitlementTargetsResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameter("simple", false, "ruleId", chi.URLParam(r, "ruleId"), &ruleId)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ruleId", Err: err})
+		return
 	}
 
-	response := &UserListEntitlementTargetsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminUpdateAccessRule(w, r, ruleId)
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Next    *string  `json:"next,omitempty"`
-			Targets []Target `json:"targets"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	handler(w, r.WithContext(ctx))
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+// AdminListBulkRevokeJobs operation middleware
+func (siw *ServerInterfaceWrapper) AdminListBulkRevokeJobs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminListBulkRevokeJobs(w, r)
+	}
 
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return response, nil
+	handler(w, r.WithContext(ctx))
 }
 
-// ParseUserRequestPreflightResponse parses an HTTP response from a UserRequestPreflightWithResponse call
-func ParseUserRequestPreflightResponse(rsp *http.Response) (*UserRequestPreflightResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// AdminCreateBulkRevokeJob operation middleware
+func (siw *ServerInterfaceWrapper) AdminCreateBulkRevokeJob(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminCreateBulkRevokeJob(w, r)
 	}
 
-	response := &UserRequestPreflightResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Preflight
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	handler(w, r.WithContext(ctx))
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+// AdminGetBulkRevokeJob operation middleware
+func (siw *ServerInterfaceWrapper) AdminGetBulkRevokeJob(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	var err error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	// ------------- Path parameter "jobId" -------------
+	var jobId string
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	err = runtime.BindStyledParameter("simple", false, "jobId", chi.URLParam(r, "jobId"), &jobId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "jobId", Err: err})
+		return
+	}
 
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminGetBulkRevokeJob(w, r, jobId)
 	}
 
-	return response, nil
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
 }
 
-// ParseUserGetPreflightResponse parses an HTTP response from a UserGetPreflightWithResponse call
-func ParseUserGetPreflightResponse(rsp *http.Response) (*UserGetPreflightResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// AdminListDeadLetterEvents operation middleware
+func (siw *ServerInterfaceWrapper) AdminListDeadLetterEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AdminListDeadLetterEventsParams
+
+	// ------------- Optional query parameter "requestId" -------------
+	if paramValue := r.URL.Query().Get("requestId"); paramValue != "" {
+
 	}
 
-	response := &UserGetPreflightResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	err = runtime.BindQueryParameter("form", true, false, "requestId", r.URL.Query(), &params.RequestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "requestId", Err: err})
+		return
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Preflight
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Optional query parameter "subscriber" -------------
+	if paramValue := r.URL.Query().Get("subscriber"); paramValue != "" {
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	err = runtime.BindQueryParameter("form", true, false, "subscriber", r.URL.Query(), &params.Subscriber)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subscriber", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminListDeadLetterEvents(w, r, params)
+	}
 
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return response, nil
+	handler(w, r.WithContext(ctx))
 }
 
-// ParseUserListRequestsResponse parses an HTTP response from a UserListRequestsWithResponse call
-func ParseUserListRequestsResponse(rsp *http.Response) (*UserListRequestsResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// AdminDiscardDeadLetterEvent operation middleware
+func (siw *ServerInterfaceWrapper) AdminDiscardDeadLetterEvent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "eventId" -------------
+	var eventId string
+
+	err = runtime.BindStyledParameter("simple", false, "eventId", chi.URLParam(r, "eventId"), &eventId)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "eventId", Err: err})
+		return
 	}
 
-	response := &UserListRequestsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminDiscardDeadLetterEvent(w, r, eventId)
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Next     *string   `json:"next"`
-			Requests []Request `json:"requests"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return response, nil
+	handler(w, r.WithContext(ctx))
 }
 
-// ParseUserPostRequestsResponse parses an HTTP response from a UserPostRequestsWithResponse call
-func ParseUserPostRequestsResponse(rsp *http.Response) (*UserPostRequestsResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// AdminGetDeadLetterEvent operation middleware
+func (siw *ServerInterfaceWrapper) AdminGetDeadLetterEvent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "eventId" -------------
+	var eventId string
+
+	err = runtime.BindStyledParameter("simple", false, "eventId", chi.URLParam(r, "eventId"), &eventId)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "eventId", Err: err})
+		return
 	}
 
-	response := &UserPostRequestsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminGetDeadLetterEvent(w, r, eventId)
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Request
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	handler(w, r.WithContext(ctx))
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// AdminReplayDeadLetterEvent operation middleware
+func (siw *ServerInterfaceWrapper) AdminReplayDeadLetterEvent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	}
+	var err error
 
-	return response, nil
-}
+	// ------------- Path parameter "eventId" -------------
+	var eventId string
 
-// ParseUserGetRequestResponse parses an HTTP response from a UserGetRequestWithResponse call
-func ParseUserGetRequestResponse(rsp *http.Response) (*UserGetRequestResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameter("simple", false, "eventId", chi.URLParam(r, "eventId"), &eventId)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "eventId", Err: err})
+		return
 	}
 
-	response := &UserGetRequestResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminReplayDeadLetterEvent(w, r, eventId)
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Request
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	handler(w, r.WithContext(ctx))
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// AdminGetDeploymentVersion operation middleware
+func (siw *ServerInterfaceWrapper) AdminGetDeploymentVersion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminGetDeploymentVersion(w, r)
+	}
 
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return response, nil
+	handler(w, r.WithContext(ctx))
 }
 
-// ParseUserListRequestEventsResponse parses an HTTP response from a UserListRequestEventsWithResponse call
-func ParseUserListRequestEventsResponse(rsp *http.Response) (*UserListRequestEventsResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// AdminListGrantDrift operation middleware
+func (siw *ServerInterfaceWrapper) AdminListGrantDrift(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminListGrantDrift(w, r)
 	}
 
-	response := &UserListRequestEventsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Events []RequestEvent `json:"events"`
-			Next   *string        `json:"next"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	handler(w, r.WithContext(ctx))
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+// AdminListEventSchemas operation middleware
+func (siw *ServerInterfaceWrapper) AdminListEventSchemas(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminListEventSchemas(w, r)
+	}
 
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return response, nil
+	handler(w, r.WithContext(ctx))
 }
 
-// ParseUserReviewRequestResponse parses an HTTP response from a UserReviewRequestWithResponse call
-func ParseUserReviewRequestResponse(rsp *http.Response) (*UserReviewRequestResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// AdminGetEventSchema operation middleware
+func (siw *ServerInterfaceWrapper) AdminGetEventSchema(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "eventType" -------------
+	var eventType string
+
+	err = runtime.BindStyledParameter("simple", false, "eventType", chi.URLParam(r, "eventType"), &eventType)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "eventType", Err: err})
+		return
 	}
 
-	response := &UserReviewRequestResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AdminGetEventSchemaParams
+
+	// ------------- Optional query parameter "version" -------------
+	if paramValue := r.URL.Query().Get("version"); paramValue != "" {
+
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			// A request to access something made by an end user in Common Fate.
-			Request *Request `json:"request,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	err = runtime.BindQueryParameter("form", true, false, "version", r.URL.Query(), &params.Version)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
 
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminGetEventSchema(w, r, eventType, params)
 	}
 
-	return response, nil
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
 }
 
-// ParseUserCancelRequestResponse parses an HTTP response from a UserCancelRequestWithResponse call
-func ParseUserCancelRequestResponse(rsp *http.Response) (*UserCancelRequestResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// AdminListFailedGrants operation middleware
+func (siw *ServerInterfaceWrapper) AdminListFailedGrants(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminListFailedGrants(w, r)
 	}
 
-	response := &UserCancelRequestResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	handler(w, r.WithContext(ctx))
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+// AdminCompleteFailedGrant operation middleware
+func (siw *ServerInterfaceWrapper) AdminCompleteFailedGrant(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	var err error
+
+	// ------------- Path parameter "grantId" -------------
+	var grantId string
 
+	err = runtime.BindStyledParameter("simple", false, "grantId", chi.URLParam(r, "grantId"), &grantId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "grantId", Err: err})
+		return
 	}
 
-	return response, nil
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminCompleteFailedGrant(w, r, grantId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
 }
 
-// ParseUserRevokeRequestResponse parses an HTTP response from a UserRevokeRequestWithResponse call
-func ParseUserRevokeRequestResponse(rsp *http.Response) (*UserRevokeRequestResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// AdminRetryFailedGrant operation middleware
+func (siw *ServerInterfaceWrapper) AdminRetryFailedGrant(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "grantId" -------------
+	var grantId string
+
+	err = runtime.BindStyledParameter("simple", false, "grantId", chi.URLParam(r, "grantId"), &grantId)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "grantId", Err: err})
+		return
 	}
 
-	response := &UserRevokeRequestResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminRetryFailedGrant(w, r, grantId)
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	handler(w, r.WithContext(ctx))
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// AdminListFreezes operation middleware
+func (siw *ServerInterfaceWrapper) AdminListFreezes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminListFreezes(w, r)
 	}
 
-	return response, nil
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
 }
 
-// ParseUserListReviewsResponse parses an HTTP response from a UserListReviewsWithResponse call
-func ParseUserListReviewsResponse(rsp *http.Response) (*UserListReviewsResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// AdminCreateFreeze operation middleware
+func (siw *ServerInterfaceWrapper) AdminCreateFreeze(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminCreateFreeze(w, r)
 	}
 
-	response := &UserListReviewsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Next     *string   `json:"next"`
-			Requests []Request `json:"requests"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	handler(w, r.WithContext(ctx))
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// AdminGetFreeze operation middleware
+func (siw *ServerInterfaceWrapper) AdminGetFreeze(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "freezeId" -------------
+	var freezeId string
+
+	err = runtime.BindStyledParameter("simple", false, "freezeId", chi.URLParam(r, "freezeId"), &freezeId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "freezeId", Err: err})
+		return
+	}
 
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminGetFreeze(w, r, freezeId)
 	}
 
-	return response, nil
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
 }
 
-// ParseGetGroupTargetInstructionsResponse parses an HTTP response from a GetGroupTargetInstructionsWithResponse call
-func ParseGetGroupTargetInstructionsResponse(rsp *http.Response) (*GetGroupTargetInstructionsResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// AdminUpdateFreeze operation middleware
+func (siw *ServerInterfaceWrapper) AdminUpdateFreeze(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "freezeId" -------------
+	var freezeId string
+
+	err = runtime.BindStyledParameter("simple", false, "freezeId", chi.URLParam(r, "freezeId"), &freezeId)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "freezeId", Err: err})
+		return
 	}
 
-	response := &GetGroupTargetInstructionsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminUpdateFreeze(w, r, freezeId)
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			// Instructions on how to access the requested resource.
-			//
-			// The `instructions` field will be null if no instructions are available.
-			Instructions RequestAccessGroupTargetAccessInstructions `json:"instructions"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	handler(w, r.WithContext(ctx))
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest struct {
-			Error string `json:"error"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// AdminListFreezeHistory operation middleware
+func (siw *ServerInterfaceWrapper) AdminListFreezeHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	}
+	var err error
 
-	return response, nil
-}
+	// ------------- Path parameter "freezeId" -------------
+	var freezeId string
 
-// ParseUserGetMeResponse parses an HTTP response from a UserGetMeWithResponse call
-func ParseUserGetMeResponse(rsp *http.Response) (*UserGetMeResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameter("simple", false, "freezeId", chi.URLParam(r, "freezeId"), &freezeId)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "freezeId", Err: err})
+		return
 	}
 
-	response := &UserGetMeResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminListFreezeHistory(w, r, freezeId)
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			// Whether the user is an administrator of Common Fate.
-			IsAdmin bool `json:"isAdmin"`
-			User    User `json:"user"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return response, nil
+	handler(w, r.WithContext(ctx))
 }
 
-// ParseUserGetUserResponse parses an HTTP response from a UserGetUserWithResponse call
-func ParseUserGetUserResponse(rsp *http.Response) (*UserGetUserResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// AdminLiftFreeze operation middleware
+func (siw *ServerInterfaceWrapper) AdminLiftFreeze(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "freezeId" -------------
+	var freezeId string
+
+	err = runtime.BindStyledParameter("simple", false, "freezeId", chi.URLParam(r, "freezeId"), &freezeId)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "freezeId", Err: err})
+		return
 	}
 
-	response := &UserGetUserResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminLiftFreeze(w, r, freezeId)
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest User
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return response, nil
+	handler(w, r.WithContext(ctx))
 }
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Your GET endpoint
-	// (GET /api/v1/access-templates)
-	UserListAccessTemplates(w http.ResponseWriter, r *http.Request)
-	// List Access Rules
-	// (GET /api/v1/admin/access-rules)
-	AdminListAccessRules(w http.ResponseWriter, r *http.Request, params AdminListAccessRulesParams)
-	// Create Access Rule
-	// (POST /api/v1/admin/access-rules)
-	AdminCreateAccessRule(w http.ResponseWriter, r *http.Request)
-	// Delete Access Rule
-	// (DELETE /api/v1/admin/access-rules/{ruleId})
-	AdminDeleteAccessRule(w http.ResponseWriter, r *http.Request, ruleId string)
-	// Get Access Rule
-	// (GET /api/v1/admin/access-rules/{ruleId})
-	AdminGetAccessRule(w http.ResponseWriter, r *http.Request, ruleId string)
-	// Update Access Rule
-	// (PUT /api/v1/admin/access-rules/{ruleId})
-	AdminUpdateAccessRule(w http.ResponseWriter, r *http.Request, ruleId string)
-	// Get deployment version details
-	// (GET /api/v1/admin/deployment/version)
-	AdminGetDeploymentVersion(w http.ResponseWriter, r *http.Request)
-	// List groups
-	// (GET /api/v1/admin/groups)
-	AdminListGroups(w http.ResponseWriter, r *http.Request, params AdminListGroupsParams)
-	// Create Group
-	// (POST /api/v1/admin/groups)
-	AdminCreateGroup(w http.ResponseWriter, r *http.Request)
-	// Delete Group
-	// (DELETE /api/v1/admin/groups/{groupId})
-	AdminDeleteGroup(w http.ResponseWriter, r *http.Request, groupId string)
-	// Get Group Details
-	// (GET /api/v1/admin/groups/{groupId})
-	AdminGetGroup(w http.ResponseWriter, r *http.Request, groupId string)
-	// Update Group
-	// (PUT /api/v1/admin/groups/{groupId})
-	AdminUpdateGroup(w http.ResponseWriter, r *http.Request, groupId string)
-	// Get handlers
-	// (GET /api/v1/admin/handlers)
-	AdminListHandlers(w http.ResponseWriter, r *http.Request)
-	// Register a handler
-	// (POST /api/v1/admin/handlers)
-	AdminRegisterHandler(w http.ResponseWriter, r *http.Request)
+// AdminListGroups operation middleware
+func (siw *ServerInterfaceWrapper) AdminListGroups(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	// (DELETE /api/v1/admin/handlers/{id})
-	AdminDeleteHandler(w http.ResponseWriter, r *http.Request, id string)
-	// Get handler
-	// (GET /api/v1/admin/handlers/{id})
-	AdminGetHandler(w http.ResponseWriter, r *http.Request, id string)
-	// Healthcheck Handlers
-	// (POST /api/v1/admin/healthcheck-handlers)
-	AdminHealthcheckHandlers(w http.ResponseWriter, r *http.Request)
-	// Get identity configuration
-	// (GET /api/v1/admin/identity)
-	AdminGetIdentityConfiguration(w http.ResponseWriter, r *http.Request)
-	// Sync Identity
-	// (POST /api/v1/admin/identity/sync)
-	AdminSyncIdentity(w http.ResponseWriter, r *http.Request)
-	// Your GET endpoint
-	// (GET /api/v1/admin/requests)
-	AdminListRequests(w http.ResponseWriter, r *http.Request, params AdminListRequestsParams)
-	// Get target groups
-	// (GET /api/v1/admin/target-groups)
-	AdminListTargetGroups(w http.ResponseWriter, r *http.Request)
-	// Create target group
-	// (POST /api/v1/admin/target-groups)
-	AdminCreateTargetGroup(w http.ResponseWriter, r *http.Request)
-	// delete target group
-	// (DELETE /api/v1/admin/target-groups/{id})
-	AdminDeleteTargetGroup(w http.ResponseWriter, r *http.Request, id string)
-	// Get target group (detailed)
-	// (GET /api/v1/admin/target-groups/{id})
-	AdminGetTargetGroup(w http.ResponseWriter, r *http.Request, id string)
-	// Link a target group deployment to its target group
-	// (POST /api/v1/admin/target-groups/{id}/link)
-	AdminCreateTargetGroupLink(w http.ResponseWriter, r *http.Request, id string)
-	// List Target Group Resources
-	// (GET /api/v1/admin/target-groups/{id}/resources/{resourceType})
-	AdminGetTargetGroupResources(w http.ResponseWriter, r *http.Request, id string, resourceType string)
-	// Filter TargetGroup Resources
-	// (POST /api/v1/admin/target-groups/{id}/resources/{resourceType}/filters)
-	AdminFilterTargetGroupResources(w http.ResponseWriter, r *http.Request, id string, resourceType string)
+	var err error
 
-	// (GET /api/v1/admin/target-groups/{id}/routes)
-	AdminListTargetRoutes(w http.ResponseWriter, r *http.Request, id string)
-	// Unlink a target group deployment from its target group
-	// (POST /api/v1/admin/target-groups/{id}/unlink)
-	AdminRemoveTargetGroupLink(w http.ResponseWriter, r *http.Request, id string, params AdminRemoveTargetGroupLinkParams)
-	// Returns a list of users
-	// (GET /api/v1/admin/users)
-	AdminListUsers(w http.ResponseWriter, r *http.Request, params AdminListUsersParams)
-	// Create User
-	// (POST /api/v1/admin/users)
-	AdminCreateUser(w http.ResponseWriter, r *http.Request)
-	// Update User
-	// (POST /api/v1/admin/users/{userId})
-	AdminUpdateUser(w http.ResponseWriter, r *http.Request, userId string)
-	// List Entitlements
-	// (GET /api/v1/entitlements)
-	UserListEntitlements(w http.ResponseWriter, r *http.Request)
-	// List Entitlement Resources
-	// (GET /api/v1/entitlements/targets)
-	UserListEntitlementTargets(w http.ResponseWriter, r *http.Request, params UserListEntitlementTargetsParams)
-	// Submit Preflight
-	// (POST /api/v1/preflight)
-	UserRequestPreflight(w http.ResponseWriter, r *http.Request)
-	// Get Preflight
-	// (GET /api/v1/preflight/{preflightId})
-	UserGetPreflight(w http.ResponseWriter, r *http.Request, preflightId string)
-	// List Requests
-	// (GET /api/v1/requests)
-	UserListRequests(w http.ResponseWriter, r *http.Request, params UserListRequestsParams)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AdminListGroupsParams
 
-	// (POST /api/v1/requests)
-	UserPostRequests(w http.ResponseWriter, r *http.Request)
-	// Get Request
-	// (GET /api/v1/requests/{requestId})
-	UserGetRequest(w http.ResponseWriter, r *http.Request, requestId string)
-	// List request events
-	// (GET /api/v1/requests/{requestId}/events)
-	UserListRequestEvents(w http.ResponseWriter, r *http.Request, requestId string)
-	// Review a request
-	// (POST /api/v1/requests/{requestId}/review/{groupId})
-	UserReviewRequest(w http.ResponseWriter, r *http.Request, requestId string, groupId string)
-	// Revoke an active request
-	// (POST /api/v1/requests/{requestid}/cancel)
-	UserCancelRequest(w http.ResponseWriter, r *http.Request, requestid string)
-	// Revoke an active request
-	// (POST /api/v1/requests/{requestid}/revoke)
-	UserRevokeRequest(w http.ResponseWriter, r *http.Request, requestid string)
-	// List Reviews
-	// (GET /api/v1/reviews)
-	UserListReviews(w http.ResponseWriter, r *http.Request, params UserListReviewsParams)
-	// Your GET endpoint
-	// (GET /api/v1/targets/{targetId}/access-instructions)
-	GetGroupTargetInstructions(w http.ResponseWriter, r *http.Request, targetId string)
-	// Get details for the current user
-	// (GET /api/v1/users/me)
-	UserGetMe(w http.ResponseWriter, r *http.Request)
-	// Get a user
-	// (GET /api/v1/users/{userId})
-	UserGetUser(w http.ResponseWriter, r *http.Request, userId string)
-}
+	// ------------- Optional query parameter "nextToken" -------------
+	if paramValue := r.URL.Query().Get("nextToken"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "nextToken", r.URL.Query(), &params.NextToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nextToken", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "source" -------------
+	if paramValue := r.URL.Query().Get("source"); paramValue != "" {
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
-}
+	}
 
-type MiddlewareFunc func(http.HandlerFunc) http.HandlerFunc
+	err = runtime.BindQueryParameter("form", true, false, "source", r.URL.Query(), &params.Source)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "source", Err: err})
+		return
+	}
 
-// UserListAccessTemplates operation middleware
-func (siw *ServerInterfaceWrapper) UserListAccessTemplates(w http.ResponseWriter, r *http.Request) {
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminListGroups(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// AdminCreateGroup operation middleware
+func (siw *ServerInterfaceWrapper) AdminCreateGroup(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UserListAccessTemplates(w, r)
+		siw.Handler.AdminCreateGroup(w, r)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -8131,28 +15112,23 @@ func (siw *ServerInterfaceWrapper) UserListAccessTemplates(w http.ResponseWriter
 	handler(w, r.WithContext(ctx))
 }
 
-// AdminListAccessRules operation middleware
-func (siw *ServerInterfaceWrapper) AdminListAccessRules(w http.ResponseWriter, r *http.Request) {
+// AdminDeleteGroup operation middleware
+func (siw *ServerInterfaceWrapper) AdminDeleteGroup(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params AdminListAccessRulesParams
-
-	// ------------- Optional query parameter "nextToken" -------------
-	if paramValue := r.URL.Query().Get("nextToken"); paramValue != "" {
-
-	}
+	// ------------- Path parameter "groupId" -------------
+	var groupId string
 
-	err = runtime.BindQueryParameter("form", true, false, "nextToken", r.URL.Query(), &params.NextToken)
+	err = runtime.BindStyledParameter("simple", false, "groupId", chi.URLParam(r, "groupId"), &groupId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nextToken", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupId", Err: err})
 		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminListAccessRules(w, r, params)
+		siw.Handler.AdminDeleteGroup(w, r, groupId)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -8162,12 +15138,23 @@ func (siw *ServerInterfaceWrapper) AdminListAccessRules(w http.ResponseWriter, r
 	handler(w, r.WithContext(ctx))
 }
 
-// AdminCreateAccessRule operation middleware
-func (siw *ServerInterfaceWrapper) AdminCreateAccessRule(w http.ResponseWriter, r *http.Request) {
+// AdminGetGroup operation middleware
+func (siw *ServerInterfaceWrapper) AdminGetGroup(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "groupId" -------------
+	var groupId string
+
+	err = runtime.BindStyledParameter("simple", false, "groupId", chi.URLParam(r, "groupId"), &groupId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupId", Err: err})
+		return
+	}
+
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminCreateAccessRule(w, r)
+		siw.Handler.AdminGetGroup(w, r, groupId)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -8177,23 +15164,23 @@ func (siw *ServerInterfaceWrapper) AdminCreateAccessRule(w http.ResponseWriter,
 	handler(w, r.WithContext(ctx))
 }
 
-// AdminDeleteAccessRule operation middleware
-func (siw *ServerInterfaceWrapper) AdminDeleteAccessRule(w http.ResponseWriter, r *http.Request) {
+// AdminUpdateGroup operation middleware
+func (siw *ServerInterfaceWrapper) AdminUpdateGroup(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "ruleId" -------------
-	var ruleId string
+	// ------------- Path parameter "groupId" -------------
+	var groupId string
 
-	err = runtime.BindStyledParameter("simple", false, "ruleId", chi.URLParam(r, "ruleId"), &ruleId)
+	err = runtime.BindStyledParameter("simple", false, "groupId", chi.URLParam(r, "groupId"), &groupId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ruleId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupId", Err: err})
 		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminDeleteAccessRule(w, r, ruleId)
+		siw.Handler.AdminUpdateGroup(w, r, groupId)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -8203,23 +15190,53 @@ func (siw *ServerInterfaceWrapper) AdminDeleteAccessRule(w http.ResponseWriter,
 	handler(w, r.WithContext(ctx))
 }
 
-// AdminGetAccessRule operation middleware
-func (siw *ServerInterfaceWrapper) AdminGetAccessRule(w http.ResponseWriter, r *http.Request) {
+// AdminListHandlers operation middleware
+func (siw *ServerInterfaceWrapper) AdminListHandlers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminListHandlers(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// AdminRegisterHandler operation middleware
+func (siw *ServerInterfaceWrapper) AdminRegisterHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminRegisterHandler(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// AdminDeleteHandler operation middleware
+func (siw *ServerInterfaceWrapper) AdminDeleteHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "ruleId" -------------
-	var ruleId string
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	err = runtime.BindStyledParameter("simple", false, "ruleId", chi.URLParam(r, "ruleId"), &ruleId)
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ruleId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminGetAccessRule(w, r, ruleId)
+		siw.Handler.AdminDeleteHandler(w, r, id)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -8229,23 +15246,23 @@ func (siw *ServerInterfaceWrapper) AdminGetAccessRule(w http.ResponseWriter, r *
 	handler(w, r.WithContext(ctx))
 }
 
-// AdminUpdateAccessRule operation middleware
-func (siw *ServerInterfaceWrapper) AdminUpdateAccessRule(w http.ResponseWriter, r *http.Request) {
+// AdminGetHandler operation middleware
+func (siw *ServerInterfaceWrapper) AdminGetHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "ruleId" -------------
-	var ruleId string
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	err = runtime.BindStyledParameter("simple", false, "ruleId", chi.URLParam(r, "ruleId"), &ruleId)
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ruleId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminUpdateAccessRule(w, r, ruleId)
+		siw.Handler.AdminGetHandler(w, r, id)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -8255,12 +15272,12 @@ func (siw *ServerInterfaceWrapper) AdminUpdateAccessRule(w http.ResponseWriter,
 	handler(w, r.WithContext(ctx))
 }
 
-// AdminGetDeploymentVersion operation middleware
-func (siw *ServerInterfaceWrapper) AdminGetDeploymentVersion(w http.ResponseWriter, r *http.Request) {
+// AdminHealthcheckHandlers operation middleware
+func (siw *ServerInterfaceWrapper) AdminHealthcheckHandlers(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminGetDeploymentVersion(w, r)
+		siw.Handler.AdminHealthcheckHandlers(w, r)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -8270,39 +15287,54 @@ func (siw *ServerInterfaceWrapper) AdminGetDeploymentVersion(w http.ResponseWrit
 	handler(w, r.WithContext(ctx))
 }
 
-// AdminListGroups operation middleware
-func (siw *ServerInterfaceWrapper) AdminListGroups(w http.ResponseWriter, r *http.Request) {
+// AdminGetIdentityConfiguration operation middleware
+func (siw *ServerInterfaceWrapper) AdminGetIdentityConfiguration(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminGetIdentityConfiguration(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// AdminSyncIdentity operation middleware
+func (siw *ServerInterfaceWrapper) AdminSyncIdentity(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params AdminListGroupsParams
+	var params AdminSyncIdentityParams
 
-	// ------------- Optional query parameter "nextToken" -------------
-	if paramValue := r.URL.Query().Get("nextToken"); paramValue != "" {
+	// ------------- Optional query parameter "dryRun" -------------
+	if paramValue := r.URL.Query().Get("dryRun"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "nextToken", r.URL.Query(), &params.NextToken)
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nextToken", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "source" -------------
-	if paramValue := r.URL.Query().Get("source"); paramValue != "" {
+	// ------------- Optional query parameter "force" -------------
+	if paramValue := r.URL.Query().Get("force"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "source", r.URL.Query(), &params.Source)
+	err = runtime.BindQueryParameter("form", true, false, "force", r.URL.Query(), &params.Force)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "source", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "force", Err: err})
 		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminListGroups(w, r, params)
+		siw.Handler.AdminSyncIdentity(w, r, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -8312,12 +15344,12 @@ func (siw *ServerInterfaceWrapper) AdminListGroups(w http.ResponseWriter, r *htt
 	handler(w, r.WithContext(ctx))
 }
 
-// AdminCreateGroup operation middleware
-func (siw *ServerInterfaceWrapper) AdminCreateGroup(w http.ResponseWriter, r *http.Request) {
+// AdminListIdentitySyncRuns operation middleware
+func (siw *ServerInterfaceWrapper) AdminListIdentitySyncRuns(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminCreateGroup(w, r)
+		siw.Handler.AdminListIdentitySyncRuns(w, r)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -8327,23 +15359,39 @@ func (siw *ServerInterfaceWrapper) AdminCreateGroup(w http.ResponseWriter, r *ht
 	handler(w, r.WithContext(ctx))
 }
 
-// AdminDeleteGroup operation middleware
-func (siw *ServerInterfaceWrapper) AdminDeleteGroup(w http.ResponseWriter, r *http.Request) {
+// AdminListRequests operation middleware
+func (siw *ServerInterfaceWrapper) AdminListRequests(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "groupId" -------------
-	var groupId string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AdminListRequestsParams
 
-	err = runtime.BindStyledParameter("simple", false, "groupId", chi.URLParam(r, "groupId"), &groupId)
+	// ------------- Optional query parameter "status" -------------
+	if paramValue := r.URL.Query().Get("status"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "nextToken" -------------
+	if paramValue := r.URL.Query().Get("nextToken"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "nextToken", r.URL.Query(), &params.NextToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nextToken", Err: err})
 		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminDeleteGroup(w, r, groupId)
+		siw.Handler.AdminListRequests(w, r, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -8353,23 +15401,23 @@ func (siw *ServerInterfaceWrapper) AdminDeleteGroup(w http.ResponseWriter, r *ht
 	handler(w, r.WithContext(ctx))
 }
 
-// AdminGetGroup operation middleware
-func (siw *ServerInterfaceWrapper) AdminGetGroup(w http.ResponseWriter, r *http.Request) {
+// AdminReplayRequestEvents operation middleware
+func (siw *ServerInterfaceWrapper) AdminReplayRequestEvents(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "groupId" -------------
-	var groupId string
+	// ------------- Path parameter "requestId" -------------
+	var requestId string
 
-	err = runtime.BindStyledParameter("simple", false, "groupId", chi.URLParam(r, "groupId"), &groupId)
+	err = runtime.BindStyledParameter("simple", false, "requestId", chi.URLParam(r, "requestId"), &requestId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "requestId", Err: err})
 		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminGetGroup(w, r, groupId)
+		siw.Handler.AdminReplayRequestEvents(w, r, requestId)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -8379,23 +15427,27 @@ func (siw *ServerInterfaceWrapper) AdminGetGroup(w http.ResponseWriter, r *http.
 	handler(w, r.WithContext(ctx))
 }
 
-// AdminUpdateGroup operation middleware
-func (siw *ServerInterfaceWrapper) AdminUpdateGroup(w http.ResponseWriter, r *http.Request) {
+// AdminListTargetGroups operation middleware
+func (siw *ServerInterfaceWrapper) AdminListTargetGroups(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var err error
-
-	// ------------- Path parameter "groupId" -------------
-	var groupId string
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminListTargetGroups(w, r)
+	}
 
-	err = runtime.BindStyledParameter("simple", false, "groupId", chi.URLParam(r, "groupId"), &groupId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupId", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
+	handler(w, r.WithContext(ctx))
+}
+
+// AdminCreateTargetGroup operation middleware
+func (siw *ServerInterfaceWrapper) AdminCreateTargetGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminUpdateGroup(w, r, groupId)
+		siw.Handler.AdminCreateTargetGroup(w, r)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -8405,12 +15457,23 @@ func (siw *ServerInterfaceWrapper) AdminUpdateGroup(w http.ResponseWriter, r *ht
 	handler(w, r.WithContext(ctx))
 }
 
-// AdminListHandlers operation middleware
-func (siw *ServerInterfaceWrapper) AdminListHandlers(w http.ResponseWriter, r *http.Request) {
+// AdminDeleteTargetGroup operation middleware
+func (siw *ServerInterfaceWrapper) AdminDeleteTargetGroup(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminListHandlers(w, r)
+		siw.Handler.AdminDeleteTargetGroup(w, r, id)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -8420,12 +15483,23 @@ func (siw *ServerInterfaceWrapper) AdminListHandlers(w http.ResponseWriter, r *h
 	handler(w, r.WithContext(ctx))
 }
 
-// AdminRegisterHandler operation middleware
-func (siw *ServerInterfaceWrapper) AdminRegisterHandler(w http.ResponseWriter, r *http.Request) {
+// AdminGetTargetGroup operation middleware
+func (siw *ServerInterfaceWrapper) AdminGetTargetGroup(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminRegisterHandler(w, r)
+		siw.Handler.AdminGetTargetGroup(w, r, id)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -8435,8 +15509,8 @@ func (siw *ServerInterfaceWrapper) AdminRegisterHandler(w http.ResponseWriter, r
 	handler(w, r.WithContext(ctx))
 }
 
-// AdminDeleteHandler operation middleware
-func (siw *ServerInterfaceWrapper) AdminDeleteHandler(w http.ResponseWriter, r *http.Request) {
+// AdminCreateTargetGroupLink operation middleware
+func (siw *ServerInterfaceWrapper) AdminCreateTargetGroupLink(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -8451,7 +15525,7 @@ func (siw *ServerInterfaceWrapper) AdminDeleteHandler(w http.ResponseWriter, r *
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminDeleteHandler(w, r, id)
+		siw.Handler.AdminCreateTargetGroupLink(w, r, id)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -8461,8 +15535,8 @@ func (siw *ServerInterfaceWrapper) AdminDeleteHandler(w http.ResponseWriter, r *
 	handler(w, r.WithContext(ctx))
 }
 
-// AdminGetHandler operation middleware
-func (siw *ServerInterfaceWrapper) AdminGetHandler(w http.ResponseWriter, r *http.Request) {
+// AdminGetTargetGroupResources operation middleware
+func (siw *ServerInterfaceWrapper) AdminGetTargetGroupResources(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -8476,8 +15550,17 @@ func (siw *ServerInterfaceWrapper) AdminGetHandler(w http.ResponseWriter, r *htt
 		return
 	}
 
+	// ------------- Path parameter "resourceType" -------------
+	var resourceType string
+
+	err = runtime.BindStyledParameter("simple", false, "resourceType", chi.URLParam(r, "resourceType"), &resourceType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "resourceType", Err: err})
+		return
+	}
+
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminGetHandler(w, r, id)
+		siw.Handler.AdminGetTargetGroupResources(w, r, id, resourceType)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -8487,12 +15570,32 @@ func (siw *ServerInterfaceWrapper) AdminGetHandler(w http.ResponseWriter, r *htt
 	handler(w, r.WithContext(ctx))
 }
 
-// AdminHealthcheckHandlers operation middleware
-func (siw *ServerInterfaceWrapper) AdminHealthcheckHandlers(w http.ResponseWriter, r *http.Request) {
+// AdminFilterTargetGroupResources operation middleware
+func (siw *ServerInterfaceWrapper) AdminFilterTargetGroupResources(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "resourceType" -------------
+	var resourceType string
+
+	err = runtime.BindStyledParameter("simple", false, "resourceType", chi.URLParam(r, "resourceType"), &resourceType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "resourceType", Err: err})
+		return
+	}
+
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminHealthcheckHandlers(w, r)
+		siw.Handler.AdminFilterTargetGroupResources(w, r, id, resourceType)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -8502,12 +15605,23 @@ func (siw *ServerInterfaceWrapper) AdminHealthcheckHandlers(w http.ResponseWrite
 	handler(w, r.WithContext(ctx))
 }
 
-// AdminGetIdentityConfiguration operation middleware
-func (siw *ServerInterfaceWrapper) AdminGetIdentityConfiguration(w http.ResponseWriter, r *http.Request) {
+// AdminListTargetRoutes operation middleware
+func (siw *ServerInterfaceWrapper) AdminListTargetRoutes(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminGetIdentityConfiguration(w, r)
+		siw.Handler.AdminListTargetRoutes(w, r, id)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -8517,12 +15631,23 @@ func (siw *ServerInterfaceWrapper) AdminGetIdentityConfiguration(w http.Response
 	handler(w, r.WithContext(ctx))
 }
 
-// AdminSyncIdentity operation middleware
-func (siw *ServerInterfaceWrapper) AdminSyncIdentity(w http.ResponseWriter, r *http.Request) {
+// AdminSyncTargetGroup operation middleware
+func (siw *ServerInterfaceWrapper) AdminSyncTargetGroup(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminSyncIdentity(w, r)
+		siw.Handler.AdminSyncTargetGroup(w, r, id)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -8532,39 +15657,54 @@ func (siw *ServerInterfaceWrapper) AdminSyncIdentity(w http.ResponseWriter, r *h
 	handler(w, r.WithContext(ctx))
 }
 
-// AdminListRequests operation middleware
-func (siw *ServerInterfaceWrapper) AdminListRequests(w http.ResponseWriter, r *http.Request) {
+// AdminRemoveTargetGroupLink operation middleware
+func (siw *ServerInterfaceWrapper) AdminRemoveTargetGroupLink(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params AdminListRequestsParams
+	var params AdminRemoveTargetGroupLinkParams
 
-	// ------------- Optional query parameter "status" -------------
-	if paramValue := r.URL.Query().Get("status"); paramValue != "" {
+	// ------------- Required query parameter "deploymentId" -------------
+	if paramValue := r.URL.Query().Get("deploymentId"); paramValue != "" {
 
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "deploymentId"})
+		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	err = runtime.BindQueryParameter("form", true, true, "deploymentId", r.URL.Query(), &params.DeploymentId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deploymentId", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "nextToken" -------------
-	if paramValue := r.URL.Query().Get("nextToken"); paramValue != "" {
+	// ------------- Required query parameter "kind" -------------
+	if paramValue := r.URL.Query().Get("kind"); paramValue != "" {
 
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "kind"})
+		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "nextToken", r.URL.Query(), &params.NextToken)
+	err = runtime.BindQueryParameter("form", true, true, "kind", r.URL.Query(), &params.Kind)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nextToken", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "kind", Err: err})
 		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminListRequests(w, r, params)
+		siw.Handler.AdminRemoveTargetGroupLink(w, r, id, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -8574,12 +15714,12 @@ func (siw *ServerInterfaceWrapper) AdminListRequests(w http.ResponseWriter, r *h
 	handler(w, r.WithContext(ctx))
 }
 
-// AdminListTargetGroups operation middleware
-func (siw *ServerInterfaceWrapper) AdminListTargetGroups(w http.ResponseWriter, r *http.Request) {
+// AdminListTargetMetadata operation middleware
+func (siw *ServerInterfaceWrapper) AdminListTargetMetadata(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminListTargetGroups(w, r)
+		siw.Handler.AdminListTargetMetadata(w, r)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -8589,12 +15729,12 @@ func (siw *ServerInterfaceWrapper) AdminListTargetGroups(w http.ResponseWriter,
 	handler(w, r.WithContext(ctx))
 }
 
-// AdminCreateTargetGroup operation middleware
-func (siw *ServerInterfaceWrapper) AdminCreateTargetGroup(w http.ResponseWriter, r *http.Request) {
+// AdminCreateTargetMetadata operation middleware
+func (siw *ServerInterfaceWrapper) AdminCreateTargetMetadata(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminCreateTargetGroup(w, r)
+		siw.Handler.AdminCreateTargetMetadata(w, r)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -8604,8 +15744,8 @@ func (siw *ServerInterfaceWrapper) AdminCreateTargetGroup(w http.ResponseWriter,
 	handler(w, r.WithContext(ctx))
 }
 
-// AdminDeleteTargetGroup operation middleware
-func (siw *ServerInterfaceWrapper) AdminDeleteTargetGroup(w http.ResponseWriter, r *http.Request) {
+// AdminDeleteTargetMetadata operation middleware
+func (siw *ServerInterfaceWrapper) AdminDeleteTargetMetadata(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -8620,7 +15760,7 @@ func (siw *ServerInterfaceWrapper) AdminDeleteTargetGroup(w http.ResponseWriter,
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminDeleteTargetGroup(w, r, id)
+		siw.Handler.AdminDeleteTargetMetadata(w, r, id)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -8630,8 +15770,8 @@ func (siw *ServerInterfaceWrapper) AdminDeleteTargetGroup(w http.ResponseWriter,
 	handler(w, r.WithContext(ctx))
 }
 
-// AdminGetTargetGroup operation middleware
-func (siw *ServerInterfaceWrapper) AdminGetTargetGroup(w http.ResponseWriter, r *http.Request) {
+// AdminUpdateTargetMetadata operation middleware
+func (siw *ServerInterfaceWrapper) AdminUpdateTargetMetadata(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -8646,7 +15786,7 @@ func (siw *ServerInterfaceWrapper) AdminGetTargetGroup(w http.ResponseWriter, r
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminGetTargetGroup(w, r, id)
+		siw.Handler.AdminUpdateTargetMetadata(w, r, id)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -8656,23 +15796,28 @@ func (siw *ServerInterfaceWrapper) AdminGetTargetGroup(w http.ResponseWriter, r
 	handler(w, r.WithContext(ctx))
 }
 
-// AdminCreateTargetGroupLink operation middleware
-func (siw *ServerInterfaceWrapper) AdminCreateTargetGroupLink(w http.ResponseWriter, r *http.Request) {
+// AdminListUsers operation middleware
+func (siw *ServerInterfaceWrapper) AdminListUsers(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AdminListUsersParams
 
-	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	// ------------- Optional query parameter "nextToken" -------------
+	if paramValue := r.URL.Query().Get("nextToken"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "nextToken", r.URL.Query(), &params.NextToken)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nextToken", Err: err})
 		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminCreateTargetGroupLink(w, r, id)
+		siw.Handler.AdminListUsers(w, r, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -8682,32 +15827,38 @@ func (siw *ServerInterfaceWrapper) AdminCreateTargetGroupLink(w http.ResponseWri
 	handler(w, r.WithContext(ctx))
 }
 
-// AdminGetTargetGroupResources operation middleware
-func (siw *ServerInterfaceWrapper) AdminGetTargetGroupResources(w http.ResponseWriter, r *http.Request) {
+// AdminCreateUser operation middleware
+func (siw *ServerInterfaceWrapper) AdminCreateUser(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var err error
-
-	// ------------- Path parameter "id" -------------
-	var id string
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminCreateUser(w, r)
+	}
 
-	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Path parameter "resourceType" -------------
-	var resourceType string
+	handler(w, r.WithContext(ctx))
+}
 
-	err = runtime.BindStyledParameter("simple", false, "resourceType", chi.URLParam(r, "resourceType"), &resourceType)
+// AdminUpdateUser operation middleware
+func (siw *ServerInterfaceWrapper) AdminUpdateUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameter("simple", false, "userId", chi.URLParam(r, "userId"), &userId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "resourceType", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
 		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminGetTargetGroupResources(w, r, id, resourceType)
+		siw.Handler.AdminUpdateUser(w, r, userId)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -8717,32 +15868,23 @@ func (siw *ServerInterfaceWrapper) AdminGetTargetGroupResources(w http.ResponseW
 	handler(w, r.WithContext(ctx))
 }
 
-// AdminFilterTargetGroupResources operation middleware
-func (siw *ServerInterfaceWrapper) AdminFilterTargetGroupResources(w http.ResponseWriter, r *http.Request) {
+// AdminListUserGroupMemberships operation middleware
+func (siw *ServerInterfaceWrapper) AdminListUserGroupMemberships(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id string
-
-	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
-
-	// ------------- Path parameter "resourceType" -------------
-	var resourceType string
+	// ------------- Path parameter "userId" -------------
+	var userId string
 
-	err = runtime.BindStyledParameter("simple", false, "resourceType", chi.URLParam(r, "resourceType"), &resourceType)
+	err = runtime.BindStyledParameter("simple", false, "userId", chi.URLParam(r, "userId"), &userId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "resourceType", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
 		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminFilterTargetGroupResources(w, r, id, resourceType)
+		siw.Handler.AdminListUserGroupMemberships(w, r, userId)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -8752,23 +15894,12 @@ func (siw *ServerInterfaceWrapper) AdminFilterTargetGroupResources(w http.Respon
 	handler(w, r.WithContext(ctx))
 }
 
-// AdminListTargetRoutes operation middleware
-func (siw *ServerInterfaceWrapper) AdminListTargetRoutes(w http.ResponseWriter, r *http.Request) {
+// UserListEntitlements operation middleware
+func (siw *ServerInterfaceWrapper) UserListEntitlements(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var err error
-
-	// ------------- Path parameter "id" -------------
-	var id string
-
-	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
-
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminListTargetRoutes(w, r, id)
+		siw.Handler.UserListEntitlements(w, r)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -8778,85 +15909,94 @@ func (siw *ServerInterfaceWrapper) AdminListTargetRoutes(w http.ResponseWriter,
 	handler(w, r.WithContext(ctx))
 }
 
-// AdminRemoveTargetGroupLink operation middleware
-func (siw *ServerInterfaceWrapper) AdminRemoveTargetGroupLink(w http.ResponseWriter, r *http.Request) {
+// UserListEntitlementTargets operation middleware
+func (siw *ServerInterfaceWrapper) UserListEntitlementTargets(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UserListEntitlementTargetsParams
 
-	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	// ------------- Optional query parameter "nextToken" -------------
+	if paramValue := r.URL.Query().Get("nextToken"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "nextToken", r.URL.Query(), &params.NextToken)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nextToken", Err: err})
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params AdminRemoveTargetGroupLinkParams
+	// ------------- Optional query parameter "kind" -------------
+	if paramValue := r.URL.Query().Get("kind"); paramValue != "" {
 
-	// ------------- Required query parameter "deploymentId" -------------
-	if paramValue := r.URL.Query().Get("deploymentId"); paramValue != "" {
+	}
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "deploymentId"})
+	err = runtime.BindQueryParameter("form", true, false, "kind", r.URL.Query(), &params.Kind)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "kind", Err: err})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "deploymentId", r.URL.Query(), &params.DeploymentId)
+	// ------------- Optional query parameter "search" -------------
+	if paramValue := r.URL.Query().Get("search"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deploymentId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
 		return
 	}
 
-	// ------------- Required query parameter "kind" -------------
-	if paramValue := r.URL.Query().Get("kind"); paramValue != "" {
+	// ------------- Optional query parameter "filter" -------------
+	if paramValue := r.URL.Query().Get("filter"); paramValue != "" {
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "kind"})
-		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "kind", r.URL.Query(), &params.Kind)
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "kind", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
 		return
 	}
 
-	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminRemoveTargetGroupLink(w, r, id, params)
-	}
+	// ------------- Optional query parameter "sortBy" -------------
+	if paramValue := r.URL.Query().Get("sortBy"); paramValue != "" {
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
 	}
 
-	handler(w, r.WithContext(ctx))
-}
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortBy", Err: err})
+		return
+	}
 
-// AdminListUsers operation middleware
-func (siw *ServerInterfaceWrapper) AdminListUsers(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	// ------------- Optional query parameter "sortOrder" -------------
+	if paramValue := r.URL.Query().Get("sortOrder"); paramValue != "" {
 
-	var err error
+	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params AdminListUsersParams
+	err = runtime.BindQueryParameter("form", true, false, "sortOrder", r.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortOrder", Err: err})
+		return
+	}
 
-	// ------------- Optional query parameter "nextToken" -------------
-	if paramValue := r.URL.Query().Get("nextToken"); paramValue != "" {
+	// ------------- Optional query parameter "pageSize" -------------
+	if paramValue := r.URL.Query().Get("pageSize"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "nextToken", r.URL.Query(), &params.NextToken)
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nextToken", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
 		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminListUsers(w, r, params)
+		siw.Handler.UserListEntitlementTargets(w, r, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -8866,12 +16006,12 @@ func (siw *ServerInterfaceWrapper) AdminListUsers(w http.ResponseWriter, r *http
 	handler(w, r.WithContext(ctx))
 }
 
-// AdminCreateUser operation middleware
-func (siw *ServerInterfaceWrapper) AdminCreateUser(w http.ResponseWriter, r *http.Request) {
+// UserListFavorites operation middleware
+func (siw *ServerInterfaceWrapper) UserListFavorites(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminCreateUser(w, r)
+		siw.Handler.UserListFavorites(w, r)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -8881,23 +16021,12 @@ func (siw *ServerInterfaceWrapper) AdminCreateUser(w http.ResponseWriter, r *htt
 	handler(w, r.WithContext(ctx))
 }
 
-// AdminUpdateUser operation middleware
-func (siw *ServerInterfaceWrapper) AdminUpdateUser(w http.ResponseWriter, r *http.Request) {
+// UserCreateFavorite operation middleware
+func (siw *ServerInterfaceWrapper) UserCreateFavorite(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var err error
-
-	// ------------- Path parameter "userId" -------------
-	var userId string
-
-	err = runtime.BindStyledParameter("simple", false, "userId", chi.URLParam(r, "userId"), &userId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
-		return
-	}
-
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminUpdateUser(w, r, userId)
+		siw.Handler.UserCreateFavorite(w, r)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -8907,12 +16036,12 @@ func (siw *ServerInterfaceWrapper) AdminUpdateUser(w http.ResponseWriter, r *htt
 	handler(w, r.WithContext(ctx))
 }
 
-// UserListEntitlements operation middleware
-func (siw *ServerInterfaceWrapper) UserListEntitlements(w http.ResponseWriter, r *http.Request) {
+// UserRequestFavorites operation middleware
+func (siw *ServerInterfaceWrapper) UserRequestFavorites(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UserListEntitlements(w, r)
+		siw.Handler.UserRequestFavorites(w, r)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -8922,39 +16051,23 @@ func (siw *ServerInterfaceWrapper) UserListEntitlements(w http.ResponseWriter, r
 	handler(w, r.WithContext(ctx))
 }
 
-// UserListEntitlementTargets operation middleware
-func (siw *ServerInterfaceWrapper) UserListEntitlementTargets(w http.ResponseWriter, r *http.Request) {
+// UserDeleteFavorite operation middleware
+func (siw *ServerInterfaceWrapper) UserDeleteFavorite(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params UserListEntitlementTargetsParams
-
-	// ------------- Optional query parameter "nextToken" -------------
-	if paramValue := r.URL.Query().Get("nextToken"); paramValue != "" {
-
-	}
-
-	err = runtime.BindQueryParameter("form", true, false, "nextToken", r.URL.Query(), &params.NextToken)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nextToken", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "kind" -------------
-	if paramValue := r.URL.Query().Get("kind"); paramValue != "" {
-
-	}
+	// ------------- Path parameter "targetId" -------------
+	var targetId string
 
-	err = runtime.BindQueryParameter("form", true, false, "kind", r.URL.Query(), &params.Kind)
+	err = runtime.BindStyledParameter("simple", false, "targetId", chi.URLParam(r, "targetId"), &targetId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "kind", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "targetId", Err: err})
 		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UserListEntitlementTargets(w, r, params)
+		siw.Handler.UserDeleteFavorite(w, r, targetId)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -8968,8 +16081,34 @@ func (siw *ServerInterfaceWrapper) UserListEntitlementTargets(w http.ResponseWri
 func (siw *ServerInterfaceWrapper) UserRequestPreflight(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UserRequestPreflightParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, valueList[0], &IdempotencyKey)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UserRequestPreflight(w, r)
+		siw.Handler.UserRequestPreflight(w, r, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -9051,8 +16190,34 @@ func (siw *ServerInterfaceWrapper) UserListRequests(w http.ResponseWriter, r *ht
 func (siw *ServerInterfaceWrapper) UserPostRequests(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UserPostRequestsParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, valueList[0], &IdempotencyKey)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UserPostRequests(w, r)
+		siw.Handler.UserPostRequests(w, r, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -9175,6 +16340,85 @@ func (siw *ServerInterfaceWrapper) UserCancelRequest(w http.ResponseWriter, r *h
 	handler(w, r.WithContext(ctx))
 }
 
+// UserActivateRequestGroup operation middleware
+func (siw *ServerInterfaceWrapper) UserActivateRequestGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "requestid" -------------
+	var requestid string
+
+	err = runtime.BindStyledParameter("simple", false, "requestid", chi.URLParam(r, "requestid"), &requestid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "requestid", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "groupid" -------------
+	var groupid string
+
+	err = runtime.BindStyledParameter("simple", false, "groupid", chi.URLParam(r, "groupid"), &groupid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupid", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UserActivateRequestGroup(w, r, requestid, groupid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// UserRevokeRequestTarget operation middleware
+func (siw *ServerInterfaceWrapper) UserRevokeRequestTarget(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "requestid" -------------
+	var requestid string
+
+	err = runtime.BindStyledParameter("simple", false, "requestid", chi.URLParam(r, "requestid"), &requestid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "requestid", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "groupid" -------------
+	var groupid string
+
+	err = runtime.BindStyledParameter("simple", false, "groupid", chi.URLParam(r, "groupid"), &groupid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupid", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "targetid" -------------
+	var targetid string
+
+	err = runtime.BindStyledParameter("simple", false, "targetid", chi.URLParam(r, "targetid"), &targetid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "targetid", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UserRevokeRequestTarget(w, r, requestid, groupid, targetid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
 // UserRevokeRequest operation middleware
 func (siw *ServerInterfaceWrapper) UserRevokeRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -9441,9 +16685,66 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/api/v1/admin/access-rules/{ruleId}", wrapper.AdminUpdateAccessRule)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/admin/bulk-revoke-jobs", wrapper.AdminListBulkRevokeJobs)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/admin/bulk-revoke-jobs", wrapper.AdminCreateBulkRevokeJob)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/admin/bulk-revoke-jobs/{jobId}", wrapper.AdminGetBulkRevokeJob)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/admin/dead-letter-events", wrapper.AdminListDeadLetterEvents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/admin/dead-letter-events/{eventId}", wrapper.AdminDiscardDeadLetterEvent)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/admin/dead-letter-events/{eventId}", wrapper.AdminGetDeadLetterEvent)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/admin/dead-letter-events/{eventId}/replay", wrapper.AdminReplayDeadLetterEvent)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/admin/deployment/version", wrapper.AdminGetDeploymentVersion)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/admin/drift", wrapper.AdminListGrantDrift)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/admin/event-schemas", wrapper.AdminListEventSchemas)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/admin/event-schemas/{eventType}", wrapper.AdminGetEventSchema)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/admin/failed-grants", wrapper.AdminListFailedGrants)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/admin/failed-grants/{grantId}/complete", wrapper.AdminCompleteFailedGrant)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/admin/failed-grants/{grantId}/retry", wrapper.AdminRetryFailedGrant)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/admin/freezes", wrapper.AdminListFreezes)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/admin/freezes", wrapper.AdminCreateFreeze)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/admin/freezes/{freezeId}", wrapper.AdminGetFreeze)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/admin/freezes/{freezeId}", wrapper.AdminUpdateFreeze)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/admin/freezes/{freezeId}/history", wrapper.AdminListFreezeHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/admin/freezes/{freezeId}/lift", wrapper.AdminLiftFreeze)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/admin/groups", wrapper.AdminListGroups)
 	})
@@ -9480,9 +16781,15 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/admin/identity/sync", wrapper.AdminSyncIdentity)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/admin/identity/sync-runs", wrapper.AdminListIdentitySyncRuns)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/admin/requests", wrapper.AdminListRequests)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/admin/requests/{requestId}/replay-events", wrapper.AdminReplayRequestEvents)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/admin/target-groups", wrapper.AdminListTargetGroups)
 	})
@@ -9507,9 +16814,24 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/admin/target-groups/{id}/routes", wrapper.AdminListTargetRoutes)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/admin/target-groups/{id}/sync", wrapper.AdminSyncTargetGroup)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/admin/target-groups/{id}/unlink", wrapper.AdminRemoveTargetGroupLink)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/admin/target-metadata", wrapper.AdminListTargetMetadata)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/admin/target-metadata", wrapper.AdminCreateTargetMetadata)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/admin/target-metadata/{id}", wrapper.AdminDeleteTargetMetadata)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/admin/target-metadata/{id}", wrapper.AdminUpdateTargetMetadata)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/admin/users", wrapper.AdminListUsers)
 	})
@@ -9519,12 +16841,27 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/admin/users/{userId}", wrapper.AdminUpdateUser)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/admin/users/{userId}/group-memberships", wrapper.AdminListUserGroupMemberships)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/entitlements", wrapper.UserListEntitlements)
 	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/entitlements/targets", wrapper.UserListEntitlementTargets)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/favorites", wrapper.UserListFavorites)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/favorites", wrapper.UserCreateFavorite)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/favorites/request", wrapper.UserRequestFavorites)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/favorites/{targetId}", wrapper.UserDeleteFavorite)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/preflight", wrapper.UserRequestPreflight)
 	})
@@ -9549,6 +16886,12 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/requests/{requestid}/cancel", wrapper.UserCancelRequest)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/requests/{requestid}/groups/{groupid}/activate", wrapper.UserActivateRequestGroup)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/requests/{requestid}/groups/{groupid}/targets/{targetid}/revoke", wrapper.UserRevokeRequestTarget)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/requests/{requestid}/revoke", wrapper.UserRevokeRequest)
 	})
@@ -9571,159 +16914,275 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9+XfbONLgv4LlznvbvStapC5L2revP7ftpDWTw5+tdL7tONMDkqCFmCIVErStJN6/",
-	"fR8uEiRBiTp8JNO/dDsiCdSNQqFQ9dVwo/kiClFIEmP81YjR5xQl5NfIw4j9cBwjSNCR66IkOecPxf/o",
-	"UzcKCQrZn3CxCLALCY7C9qckCulviTtDc0j/WsTRAsVEDOqyQadovgggQfQXslwgY2w4URQgGBr3LeMq",
-	"jtLF2wUdj32DCZqzP/4WI98YG/+9nUPe5hMlbQ20L9Vx7ltyJhjHcEn/vYiRH+CrGZl4CiAJiXF4RZ/H",
-	"CApsKo+IQOANnCPNC+zjzymOkWeMPxQmKqHXKlPkYwZn5HxCLjHu7+lwBfTSAO3OCbhYxNENDNYRNp/z",
-	"iH2B4uMo9DEjg4cSN8YMFToMuoPzRUBhP/LmOASQfQpIBN5eE2i0jDm8e4XCKzIzxh2rN2wZC0gIikNj",
-	"bHyA5pcj8w/LHLUO/vf4p58/XF5+/OW/XV6af/7r/12mltUZtC8vw8vL5OO3f/7NaFVZwsjKMCtAZUxn",
-	"CLBnYHKSADKDBJAZkrDFaYAAIxuigB4YrVzcqlwvCVAouJ/jTfEEkCJfxLZnWS1jjkP5b3s71HV4L2Ic",
-	"xZgsFYhxSNAVihnIML5CZEs1SgM0Zd/rkCd4jo6jMCExxMKENBOjaenDsrYIRrZyCRWULspbFYIcW4Uq",
-	"K/WJWYjdVamkBhUpr/BsjuYOiotcaSxtleHr5OqfuWD9eWBqhKdEeEFkCdxKyp1Jk7Y79XQiuoYYJcDl",
-	"CBqIWwVQ7uZBEZJ6BLngMwF5hcPrnaRjEUTLOQrr1plrHOofqKo9h3d4ns6N8Wg0Yizn/7JaFaUvEacw",
-	"vTKmmHcllxUi7M5nP47m66yEMuEL+vp9y8BeSegHvaKQm5mUf/yff1sr5AwKNupKzN8lKN4dZTSHmEmc",
-	"H8VzSIyx+KW1TokrouDjOCFvNrUAu60sOGHLuN5JC+Ajw1PioyRkThgFphz2GiafoyucEBT/BkMv2Aen",
-	"4W1y5LpRyr9UxZPK5Ve7c6+jMLxNKCRl7ylNTAQTYtpGgZSjgtz/lCY/mVfRzc+/fIOLby785obfUPot",
-	"gT+bP7koJDEMvv0URjGZfUuilMx+/uUnOui3W5SQn3/52by89LR+FNe3qg81OQGRz1wnbm6FU0UiwC0M",
-	"9Z4AVZsWoMs29pBXlPMN9bZlxGlIl3gOjg/TgCoQvE3MAM4dD64VEUwBkIO0VBaplK+VkCRKXfQCB2Q7",
-	"+Vhl5tjgsRw9m/EGo9s9bLGi+Vx8tt4T8ZCLEyGAqyGmwJ3It+9bBt0JxNij7hwda+33DC3uBTL7Lr6r",
-	"LldiCh1fSjsO4ygEUGxK/kcCYgYjlVIYAj4TEPMeXIZTxennPwLCQAAuDIGDgEQoBM4S4NANUo8+lT/L",
-	"t3HIlECO4UTe8uAynPgAE4ATEM0xIchrsZeiGF/hEAblGW9xENAp0wR5B4IEySIKE85BDvuEerapy/aJ",
-	"5+LxDmKBleG24BbT+SpgVaVTHzbh4Sk3e0BSgMrWUUpmfAXeHe18ESvO+36GyAzFjE9pgmLKPBjyzRum",
-	"ewoSxVSWjqP5PArBC0jQQW5ylJWQfryOoBSZCqnYh6uXqjKxThCBOEgAdKJU7GNTMkMhoeRAHkOEwnSS",
-	"OX2/o5iq0x4oecNH0q8OuZcJxHsH4L2QcggSNL+hC0OSujMAE3Bp3FgHowPr0gA+o7KPXczUJEAwQUkL",
-	"RDG4NDx0879eTqZ//nZ08Zt4dREjU7wFnBQHXnKwdh2QgDcjcBkPgEPuvVGcKG1P4zjah2QiOs764BF/",
-	"raE1ZC+DGJE0DpEHqLPLpCRB8Q12EYN/4lF5IUsexkljBu0e8CloDjMaNfsdLAA4425CAxpUvmjpZ2tC",
-	"pXNGnERlq6JOcibgqtThng9OFDFnpHyFC0ZyH2Y6D2M1itdULbU2dIDuyHoqi6m3Ndo5Mc7TAO2DFjAf",
-	"rTFBcghWESJMgwA61NkmcYrWGRAVDjFGI30EAU4IlR3pjNARSoIjA7/7o1c24oY0y0LQWwtQef5dJOmU",
-	"qmGAqKptQhqxiUqUv/9UAkw3MEjZMBwhiYgSJ/3w1fAxCjzlz5PKapeG+HPK3Em6nQAicMZenlKoKfPF",
-	"M7kf9Yyx4bo9t+f1PLOH+r7Zc7ue6fTdvtn3+7Dv9VHf6btGSwLJg+jy302BYC+/gg4KciCM+1ZjVNK5",
-	"w+yrHhn5dBt07E631x8cDkeW3WmOlZxxU7yO5vBLFAK502J8AD8dnb/5WW5j4yhAdPsKkySt8u+cPj06",
-	"fyOR7bscKbPn9RBD0aT4mZIIlAYKsjAOx/A2GWM4H49VzMd02vbrJR2/ngpbQF8gUAb9/UcBvw27cIAO",
-	"+6bvdjtmz+8OzKF36JojH3X8Q9eCHWhnepAH4MZfRXgyVxUeh6Y7aKNlLFInwMmMLuJs4xmFpk9tSCt3",
-	"F40b+8A6sPLTiMw34CuOaed8fAZadwFDz4nunrHeUVY5ttMxbWg7ZsfpQJP+YkLb6Tg2e9pREBoNDwf9",
-	"XrdjW6Ph96d3EiGOJ8OY/mBSAkiE6/ROxfyp9M4fOj3U85HZc2HP7Hld1xx6XWj23b7fR32363fRX3rH",
-	"dl43KIgWLHT1fHXP7yPKQ6p7Hcfsuj3P7KOBbx7CoTNyLc9GHXUZyMx+t9f//nSPo9N1zZ7Th+bAO0Tm",
-	"0B9BZmjc7solT0X8qVTP66Ke3/cGZt8dOGYPdqE5coeeOUK2r8D/nFWPTizRZ1+WWcYGLqidZI6J+v7A",
-	"vDqcDU08+mSZ13bQmXfDXtRfDMpOZlLPFh0EBborEDwc5SOeP/LMSS8wK1PdlGT/fBhXDR6K9019qZym",
-	"P7g6NGdDPDI/Wde2mfP/8w9IfEp4Dd1NQfhhMiKq2KceJtHeSc/5X6G6mfF/mHxPpI/RIkoonZaVpUJ9",
-	"sgHqkv7zpbmIo0/IJSadpBkbCuAUjX/+JONFA20cbsSMK0xmqfOE7IjiKxjiBIr8pwJD3hafcWeFKUSF",
-	"G2amEFZaZElpggYs0X0hmVIAKWPLej19/kw5en8BYnZ8LOlwcfEW4DAhMHQrbhV9Jg6bN7LQkjFqOkCd",
-	"87QOoAJjFID26E3Kk9G1pCh5mZutmo8cWKlBqkrOivfZ0A9rLuluEKXeLSTu7DuT9s1WZpSat+jHlfb1",
-	"Nvl7FPZ9+z0PIesf2elD7Wmsct7Q+OCEZ0X8g+KwLke1MH6TgxF5EPIyhpsdgdSfLMJNcKvLAdnlfBE2",
-	"Rb7uVOipjlnXnqxudqCYpbg3PkuUp9YwO1PkpJCEEYmM+yBNDTNZjlJzzXgpIFqrGDHakBAcQRA5n1hG",
-	"CMVeSRvOEziOzibnJfERMn16g/ajUugGbaFSbPr9CZMAYgMansErHLJ8ISlMZcgUYu1TpNYg1pKX0DYm",
-	"aQMpEwPvg0yZ1hXy4/kiuxGVNlhkipNU0a1AT+EDyre5G1DWiuIEu/JaWX+TbXBseNvj5e7JKireUUpk",
-	"9uyejWY28gaEYOCsl2k+9O4k2DbBQo5q7ym1Ivuk4EVnv+KCpyh95OIPNQMWXFjJnI/lIeVFHOwWv65x",
-	"UrN/qw5qZqRXu5u18rLphb06p6z5FamKsEhrJ/L8Vc+VrbgwSzOlIrSnPN3GSwSduzl53iUNXBA+5E4r",
-	"Ax9CyeLfmSBxfhGg0QJ4v4nz5LMsUQopDq8AlPnpPBddDJ1norNsukrab/4MUBQhDhPgsfxk5GmyK6G4",
-	"axt6ACcs851uN5U0TnyDAFxgltD79FeVn8P9Yn4VJwc1ToM/O8PbzilySOc/h+GL//x7x/sHtF9MT0f/",
-	"Zf29AnXLuDOvIlOYq8kJv3pKoAcJbE7L1/KL9TeeV99gXHFncXO796RXlNnNIv2d5OwGcvV2su5ic8aO",
-	"0pXlPAlB6l9FvVtGrbxXdVU8F2nN3IznEppUVE6zP14rrpllrioIu2BB9UPEneStHb6gKPdpNlGQe4VO",
-	"RfzLtKKKkJBowW4sM6Toet7zh4f+YbfrOoeWz4bTCH1NBQvvSLN8C30TP1IBOKCSRCEXX/261OKVLjxI",
-	"0GuUJPAKrXhDzJrdJhU32xpDIUbRQlES8RxNFXgVEHU4rci+zmV7hegK9a2IDSxcseISG6qZ1GJzUxFd",
-	"5jzym3WndwuUJPLGE/Q8TAeHwVnhg01u6mlQUfYjG21z6rc10gOuIKElc0aGJjLvdUder4u8Q9vtdksy",
-	"P62ay5Im4zkqXYyosqW6fIvLmyfimwvkRqGX1N0jYu8CT06AQ5DwD9RlFScABkF0izwKwQG/aMpXF7t/",
-	"2OkNRb0N/tNAu+LM4V0jkMTIDw9SSSA08LXqaKkIxrRaFaOBXNg+GgwPLbsz6o46ilyolXp0lw023OcX",
-	"B11za2U7S1ty9Gr8uPeYzPj0m61xWH+lKWxUB4i5DQW6tQp2VngUFQgrap9xpRlzu6Ph8HDo2fDQ8iwN",
-	"c1U+VC9Q1hVHYnglR4pvXr0bua/d7EP7chVsVlWXqWWHSsdmnOl41qgDLTSCA882KoWeNHWsmvKH7Pda",
-	"NqOSGFPBfx20zagw8Adux4c9Dx72hoau3NUGTgJTKL6rfd7OgvZ2ZL1TwKq3rHULagingeQEw6swSgh2",
-	"dSUEPL0bGqAbtHYb/iq6esXeY9vNOp+2hCkfucWnzr9TUFMAbiZWvjPouI4zctxer8cmrDFx65aMGhXj",
-	"hZKOZdEPjYexS5mnyov5+UKjMk7FfSnTXxXgHLpsZIXWehvGLg8vLggkqTgzp67MB+Po/Pi3ye+nJ0bL",
-	"ODqeTn4/VYfKv9BtVKpcg13fi+3DK3dm9SBDLpMnZcrJmxdvjZbx/uj8zeTNS6NlnJ6fvz1X582+ajbt",
-	"wl1eu8PAvvF6EXeA3i4Qd7I0vg8hMXZSomdUJD+csieb2Iy36qenFFd1vLUGKAf5vpUH5CsAsie7FNwq",
-	"YthS6KEwIAemmbpC6EHXc52BPfL51iQrMrYn7zMb70EcT+zt6gEqxMtRb0a8aDh0P49QcBgns89F4v3l",
-	"3W3r3WlJ2IwfI78LbQv2hsNel689SmGf8jXxrDpMJDeTSTRHZEa9mDn0EHCWdJONQk9UKgnLVUn2oBzN",
-	"Kgno5EUXZl6k8SJKUMNJz8TbeQbAzqGubBwe7GoAxLnyBV1us2Wuwbdihct0otYp0ImnpFXFMqg4FCmT",
-	"gVecUJFdKW46ca09wy0KzodCsLPuHLZaB8orR1UsBm5MGG/Ght0b2/1xp/OHwqdJYUxJe+Po7Oz8Lfcs",
-	"1GNkBc7ih8/7fHk1rmenb064L9M8T3LVEbSaHlk+X66Q7v4jt4mialglMDawikHoeiQVzZcFlKsJPmKQ",
-	"XBJKCpuvn7nlXLmOwcJB5WZ2rlgyRB7OvEZkFnlbjFb8fmt3wschDLYtrvZC+VjGvPjJ9Mb7kRoPYV/l",
-	"3wpa8bU2I2xL4OOCed7Ulu+8hmQD7E6ljZYjZYTyyvQwCcCF46mGIl7nqXFZUFa4MhWLh1BqALW4YOZn",
-	"sVA9Ri3KRCWYt4mX1+35nUHHcy3fG/UNva0qplCUcuUeyu0tD1x1DPQQNnRu3ZFtIX/U71uHbh3aFTNa",
-	"LrRG/+WgBNyKQnx5LG8GE+AglBV39ABMSTSHBLswCJYginllMyhLPhqtPBbxbvr29dF0cmy0jPPT3yen",
-	"709PCkwuwtUsOODYw67TgdCBdq9Tg/CLor2uniMxgy4rQDK3Xp59s8T4FvBQSL3dACfIA1FIIjAjMrek",
-	"4uOj0JtmxVHVqXASDQeWTSdCCYHzBd0uvJsesx++RCG7pbKtV624cY84b0m4cyBaGRlWCrjKmmYSPrSc",
-	"rjXwBrDrOIc1DM8XlSqvue2qK0WqiOvJ6fGryRseOst9XeEK/sl/OnqlQW+jmBqyLavvWZ0RtIZ1ClsX",
-	"Yj8CBM0XUQzjJYBJgq9CVh2RosZ3o3TXChYxDl28gMHK3WjNGi+99o3CCC/oRxs4LI3cjEfaLlbIXl6l",
-	"V1GL5JeONrietG61LXKpVTl5UGbNGKZbo3XJIPyuCliRqKDuSFkCHrdwxtjoWJ2OaQ1Muzu17XF3NO5a",
-	"B6OO/UdW7sKBluc60LTg0DV73VHXhN6oYw5GfdvqdgZOZ8STq2StSXmnnlmR4gRWtziBZmuWpBzoMct2",
-	"+g8B94HLisrfYjLLkqfy2hpsE6N1H7yh1RkOXavbX6OWmsK7FUVVn4IoBLPoVokoKTlWyAOxiDofXIa8",
-	"MvK/1Lq9/wKMwVmZ4jANAoB9EEZAfQ3AGAF4AzFL2K0qPt4V3ChAgCWqCmC1lV6LolYgUUNvZgCh68Du",
-	"4SHsOCu50NDi8/W8aOelRaf2fHIxeStOLY7eH02m9PeL6dH5ND8/kecZzId5+w+2KJz+19nkvOjMrAG0",
-	"2eIw6iDHsnoja9A/rFvrcr+mdHJWTaCpHoM9C6fBq8+YqUW3mfz07f4AIssfOU6/ID/8NpUmVEG0dX9L",
-	"gYKtfTTqGrNLoxd7WpL4gAWPZ9s9KB1qy804/XT3bTRTTX5I7tV1H4MheQFxkMbovL4DWK2X4Uaxh7yM",
-	"99USzfQJuJ1hdwZuYQLkFyBGAU9cIFG+KzjQCmHO+TlcfOCzf6w4MyvRXO0OkcwZ3DpCEO1VBulwe5FA",
-	"Em0pfySaPkBKjeqCaQ8CC7akmUG6s/tf+p/dACXe55FqkM7y0Gz5rkmNnN9XADmOQoLumoJiD0ao43QR",
-	"cg/9oQrKedHfrskYv51FitPCXYPqTlg22VndNKeRBuuOstRON9ULDtgladw096+mWQ3HoMp0kFEJ8IDW",
-	"Xyc5f53kfLcnOTWhNa/Tg+6o17WgZasWYi/hleOjN8enr14xscs9afYXZ1DOquO3r89enU5PtUlMq4Mu",
-	"sl2QSM+qyRVKqg3nGqzluycci3wwBY4CYgLuZvYcLzz7lrjOEn66lYHQQs5j0xhOIVOpDIwYqxTa0UP0",
-	"mXyxPt1Ft4OOdQU1EFUzqpQssl9PX07eXPz5fjL9zWgZkzc6ytQN0zCtzAmt2/5d2k0HbmrkbZ5OlL5L",
-	"5XibbIHEg8RRqBFyNeCdG9YsmFhAozCdRnTzUh0VWMQDEKNFjBJ2ARiqrV7YbleGVg7AZSjLkMieSgEO",
-	"r5HHPFqlaVgCbjAE4vp6a2UntdUt06rtrLIc0ebhRCWvVBNN9NOQRROOYv2MMwQDMlvqney6TULe2Gyj",
-	"DmYqLLX9zHKQiuRQr4hkHG94acj3DoddH7kDa8Cugd6ZBF7RxdngfrosRvORilO2ddBkWj90kPd6D1HR",
-	"60KMUyVbs/hlwQ973m7MU7gnNX7A6PZzf/YpSfAg7g3YW6oE6KXpZE3etkrV5lv4NXmz6yZVKd9wU5BB",
-	"WfhaQqJIICdGw62XYx36Pbdr2R7qKwStSaDZMklly56u7mZRlWZdFZWJLvgHe7o4q+OaAKmVNZR1RYex",
-	"grHYJJng1l1+8UP7ejG6u74rM0yqZ3F9vlggF/sY0WV5AWOC3TSAsXQWZLcsuvxy9gIIVIMNOBLsML0a",
-	"vq9tS1x7Q0GxDTrFyPvHrWmXnw2TOa/yW6VpcZXOLzgnmikHQs7I83tu/9Ar07rel4+VJ02S+kXVNPa3",
-	"vAxQE2vb+kpQYfz8nzU02tDf96zrwdxfOJ9gvFyU6XSRaeU2l6UqAx3FV+lcVkYrQC6k9UKqXBPIDx3Y",
-	"7SGn1+96g74e8mxCTXKKj0PEorCyQEML0P8COjWrYfJuApBaFIfdBJYDtvZzwUgVt9qHF3XNzDMqNlqF",
-	"+LsfV5IeHOUINrrXd9iDI8dDds/tdhQeyLPr0nFh3aKwX0O03tgIB1Bv0UGtlfnLB9zZB/RR1x/6vUHX",
-	"FrEgtRxa9fBx75u9Gd8W7dKnv66yzaqMjhsYYO0xzRrTn4ObCa3S3p+PWr8JVEjb8IJWt9sdQadr2x2b",
-	"s+edaH27n4D8NjVnaiznljH7pmk8+Z3GB/QwdR3uJehK5o1yjqCmxSrNPAXD3yU1G37VcB3PYqwy0XDp",
-	"D//Byy77kKADHFWskrAP7FvwhlIgVGAdGzNCFsm43YY3kMA4OeA1+9MExaI22oEbzdtp2+517F7Hsn65",
-	"+T89Stm/R8lMhaXGKFbM0+YTH/Y6Vncw4hPzduw49CNZvg26vDadwDO/e0WJHgfKTEVCVQqwKZ+Co7OJ",
-	"oVzcLgyaG1ObV7WOFiiEC2yMjS4rdM1a6c8Yp9pwgds3dpsfpZhEbf8pIjHZfU1mhakgaBqQGqUu5B3L",
-	"qtOD7L32qkam9y2j32SMYkNlVoEunc9hvDTGxv+N0hi8PJ0CFHqLCIe80F2GsjfHoUQ8ln1itWmMrBwq",
-	"DIJy2asiaVjGVqmRLaN1DOeIsOsHH8ojv0F3BCzgFQIkukbssjX9+XOKWJ8SITQhuiNT8Twpu2x50cjd",
-	"eFBsvHvfMnqWvTH998A1Rmy16S3zM3jEUDQ7p3Y40l2HPBY71VDllJ5R5WIH+dnTr5G3rEdBvoJR0i6P",
-	"oRQULnHC3qiYY9MexdUijTJtg7HP2oJ9T8R0wTiF7Rqur1Te9lf6v4l3z6UiQNzr03D+hD0scb7ArV5V",
-	"st5E4Fiwb2sq9fjAj01bjm+BttRh0hm6l4hoKnJpaPgSkVUEtB5J3N/+47vjBiXxajGvLBlsSaBLdr4i",
-	"cFk3VM+Pl7xduTwsUg3P3zHHLynzHbDfRYVXVqeVqWgCIAjRLRBORo148DEfy7g+trRZVSL+Cj2gACgk",
-	"skToEKZkFsX4izTQWjtDwIso5U1B+rqpJiFBcQgDcIHiGxQDJmwlIeP039Sc5oezbSXeqjUUsqUEmSEp",
-	"CmrZ3lqjkXdY+D2Lym7utFRGWaFryomzBJQXGk6akCTfVtY6honwDFkN4qxMa41rmF3PX+kVotCNlwvC",
-	"Dr+vUShLJFNlXPDq0ZLeOzmMra/aj7Mgb/5lXjlnenr+5ugVyygXf35s7c8TLbVo0TiGGYE3dAmpzeK3",
-	"4USfouPoKsQk4rmCiyhilxQwATgBKIROgLyDVY6jPJzZ0qyJePrDu4uyXuiP5ilmh2PNNLj99YpHvkru",
-	"YTl0zzwlSHVLGFnZvbPWj8wFoSrw+uXjKZ3AOrK1Vpt5tSI7r+LP6FLvHK6iysPKNaVyZRHgBxEntXa/",
-	"ict1pZya7cfnkmRc5UM9rJ15JH5sa2J2lnpB58bGQgTF68Nf2UIuk9W2DnxVmm49nUmlGjLL8alfWTW0",
-	"OEdXOCEozpPCNpbU0hCPsSoqHcZ+nJVR0hFAyc1NRL79Fa9eHM/RPLph+7989NpVURWHamilMQ8bt7YL",
-	"I+B+V+GZ2gVYv57W0tN6HJ34TgMt9XrQZMXHGy72Vd1iybTuDLnXprq06Hcq56nYUCufMW9Lsc0a6fgt",
-	"f7t+UXqgiObOTFKAB7/VL0EVymKPdZZa1u7MKferfYQodeWnxWYEtZ7sRLx+XHp781VfO9IzWf5ridKY",
-	"E+1kGborhbtIffo6yEgOWFhmDlkegoYRF8vQlfTbaLP1JBSl0AIF3LVEVFtXrtiIKR1cYRAApS9ljZd6",
-	"nr+xMuAUzTFVDpwA9hogEWC1kfgsSRqwIbTBIplKUA0WqfenVl2Vym9XaUJJrSeKjW0dwqp0Pl13Jt1A",
-	"PHjujlkJRtZwfao2udwWD11jz6e1UYVbQJvuU7hTX0ys3nJPXaDMI+xVCn1/nstupWeNnjD6p4rCxgq0",
-	"dqfDfy9PUrvXKQvVD3yUrKfMhnuZlfSyHktvvtMdjUp68JNsmfnzU+1wqorVDnB4zfzAVQ7HVEVjciKX",
-	"6t2g22wBeEXh3MciwAa6f3BJFn2d9xth/a7knxIawKIKKMe7JAKYJHtYG9ryekTS/qrekLlfnyDIK6WJ",
-	"rwFMksjFrNfvLSYz9lTceM7LqNGR2Tb/Ct+gEKjgmNirP2LRXMzZl7eX3T/6zsQja1oPil3rk8cxjy19",
-	"qk75jtVerW2dqLZ9Vnog0Vji54do3dk94yhh5W8pMorSPKRu8aINtepVWC72Yt/Lrb/u7+/3qscFLeGT",
-	"AOVFRU2aWccoJahJTgx/UR4YMw6o6lkftlAWvP2YND7S45qm+9YTuEBN+JeGz9RF4ydMVRdNZ79KQZ3c",
-	"DZhsZ8pK44kbURth1jwo+cN7au+YiK3w1VgJ9m28taxPuNb2vEDEnSlRUv52rZ15Jx4/h2S8rQOOFIna",
-	"E2F+OlolyBbZc7J71B6S58Rtsi23Xhzhhw+8MSh/vMw5eZWvkaa1v9L/ibS59X4kf3k/zl+WIyUEzw1S",
-	"jyodtyWiAeUMr8ye0gtaY+koXk3d/Ipp6Wamcq2ynNfwkOGDOjl+/NVoX/lc60WYHb2JEgvrd+3K24Cu",
-	"/El2DIq8csM8VvI8Qax+uYdifIM8vppRu1ioaFU1gfLy5KkK3bZ2Xx1k//voAoh1lG0rbYHWxEX4i4DM",
-	"IN/RMaWewSSr2R41odY068qzcsmWC/BOqfHFIfkONMPDWQJZ6aHee1SORPktaWovZfmDNn23LW/eQ0JQ",
-	"TEf65wdofjky/7DMkfnxq926v7xsN/jpb8Yes/AFlYv6bn1ngSBFamo2uItCh1jtGvQ7irG/ZDVbWEUE",
-	"viC5URAgl6ct+FnjIb4o6IRYVlBW2rJu6fpkQ+yW0qte2kd3fzJTnlUuq1T/ZRfmeczEVivV8isvpZq8",
-	"q+uVTGcITE4o1agNOHp/QdVfVCEslDARxQnFvpMVkOC/mGzHKUuZWHan2+sPDocju6MvaHIWIJggauFR",
-	"DJZRGrNZC8MXSp2UnlJDUIMH1d8NMBFlFlRcxE8SGb6MbYGFOo4OD/a8ISYLFM8xaw0PEsRi6lK+haH2",
-	"o7iM4ln+zQWrccirl2a/mgn7VeIJ43AMb5MxhvPxWOXgGIcJgaGLzEUc+ThA7eIYZqggqiVQgqhi6hBZ",
-	"RikIUVYInK83BYoVsRBEkxVvRBjFXlnzRuY2mVnDmGxFgLeJmSRRqf4NL/hg+uW6DTc2q9OgKYIjx8m4",
-	"2VDV3l4TuKOubSSebL56LSs/LtO5sy2dRX+e3YjMBllXCrs7sCwePsrNY2ev5vEvnj0Mzz4WmqUYHatj",
-	"m9bItOyp3Rlb1tiylAZRrt3p8s1Ts81Wvsj/O5/UXqTOHBOgEkPjd7W/Zn+KCENtyZmXqOQ+PdBeuRH7",
-	"niz5Q4GuyfGlQt2tTxHWZqaK4x4eWQTRbViflio3dE2zUpXw6u7Vcep2dH4UgwVMCIhikC7ciHXYVFDQ",
-	"zejLGvPVpNd3Z8dvX4v2AEcX073ejq6mlu5rs5RxpDYQPAkxwaz+Q9ZeiEXA40h6hfI2o7In0ovAWVQQ",
-	"gd0KQxQ6wjzKdqjc47vQNfapO6UoLUH/XTqllNviPXHblI2YuaqViowZ7bmXikLAPEF/AwcnU7VnsD7e",
-	"axaq9tdMau5X3Q7SXWoXX2qt1kuUWZnHMDKFxt+aVt813XnuW8/SPpU65FZqMiq1PevAyqty6oo1Votw",
-	"Vvsg/7gm8SnJ+0TW1y0S7zEN8357XD0Su757W1/eCykuX4NyaUqnxN32Qery0mbtOJO1RbIg+G06PetZ",
-	"NpDoAeznp2A4AWFE1CbLUQzEenSD0S2KeV+ylZup05udDhMLozyXIqAy8owkbvLA9zT0+Bnwx6fkPudO",
-	"sbLQQwHT2mtJGv0NXcSvfOa1UwX5WScxZyn2+AdgygSVvkx3gEyE52lCgMMLrzL3Q2ysM5fqgPGLtfai",
-	"ss4/pyKP40LMoMWYLdRjyZuEl8Zk5y2rClTyIzc6geqwbVyURB1gq3xcOURNahantuJzaqR7lQhi777t",
-	"wtDlvYIaCx7ek7jw/vTsaFSyh3du4zBJIXKQH8UiM5su6Mg7AKe+j3i5PDyfIw9DgoIl0PLxmA222vHe",
-	"Z4y19zSFW26ia1G1mJFlJ5mI2WjPSyZigSGXiQVV/yhNgqV8bTOh4PT6SyjqhIJalnUBWyk64HaGYlRw",
-	"RnK/Y4XTwef4ccoK7DXW/CwjvJxjqqSI7XD7K/+D+jSiojYOExKnLMWlvt6ALPPH04Um6ifb4M/XdHWY",
-	"Z6CFmhYCTTxOSdCtHU6efMs3ro0rM+Y1btw0jlFIgiUIoqsrnsTInLe60NZrtB3PUjIr5p83qn2sKdLL",
-	"KjNm/p2An8G81uBVE5XX7MbURhorqZKlDz9RZu4eikhXSA1XELX1QCneDApWrZoPm7c9GbfbQeTCYBYl",
-	"ZDy0hhYP2XDQsqYpGYjUbovfeGqD8kOxm+z9x/v/HwAA///MsRfJpfAAAA==",
+	"H4sIAAAAAAAC/+x9a3fjNrLgX8Fy55xJ7lK2Xn7u2TNXsd0dTbptj+1O7k7ckwuRkMSYIhQAtFvpeH/7",
+	"HjwJkqBESvKje/IlaYskUKgqFKoK9fjsBXg2xwlKGPWOP3sE/ZYiyr7DYYTEDycEQYYGQYAovZIP1f/4",
+	"0wAnDCXin3A+j6MAsggnu79SnPDfaDBFM8j/NSd4jghTgwZi0Bs0m8eQIf4LW8yRd+yNMI4RTLxH35sQ",
+	"nM4v5nw88U3E0Ez84y8Ejb1j73/uZpDvyonorgPat/Y4j76eCRICF/zvOUHjOJpM2TC0AKGMRMmEPycI",
+	"qtWUHjG1gHM4Q44XxMe/pRFBoXf8c26iwvL8IkY+Gjjx6FcUMO/xkQ+XW14ao80pAedzgu9hvAqx2ZwD",
+	"8QUiJzgZRwINIaIBicRS+DDoE5zNYw77IJxFCYDiU8AwuLhj0PO9Gfz0DiUTNvWOu+3+oe/NIWOIJN6x",
+	"9zNs/T5o/bPdOvJ3/vfxN9/+fHv78W//4/a29ct//7/btN3u7u/e3ia3t/TjH//6i+eXSSLQKlaWg8q7",
+	"mSIgnoHhKQVsChlgU6RhI2mMgEAb4oDueH7GbmWqFxgoUdTP1s3XCSBffH61/Xbb92ZRov/urLd017rn",
+	"JMIkYgsL4ihhaIKIABmSCWJrbqM0Rjfie9fiWTRDJzihjMAoYSsHtoYsfFjcLYqQfsahCtN5fitDkK3W",
+	"wsrS/fRdGt9doXt8h/6OR5tvqZAsrtLELdTGUcwQWYWlDKA38v1H37uLkrD+d3/Hox/4B0WsilEMGEux",
+	"8gbec9xtQcZIejjFawE88+ZyyAhCv28BLpSEdCC+GmMyg8w79kLIUItzlGuPoU9oNpfnSX4rrRQRM0Qp",
+	"nCDnuzTAc7SKsnLJ1+JV/U0dhMrBMwCW4lWsbAv8nz8OStK+hIEZmo0QaYhSLXVLw1fJ139lAvaXnZZD",
+	"iBZQp4SNBm4p5i710b6tzdIIGc5N5ITYz4HyaRbnIaleoDwABIO8i5K7jbhjHuPFDCVV+pYWdEuPuBn8",
+	"FM3SmXd8dHQkSC7/avulw6+AnNz01phq3o9+PSRsTucxwbNVu96a8A1//dH3orDA9Pv9PJO3DJd//I+/",
+	"rGRyAYUYtcbK3yMGQ8jgFhYfoTi8lFDTelh4k/vE4pNM7ZqnoziiU0R2+d7dVWedUwK0Pn7u+I+3t7s1",
+	"fnLqmQxOagJ+w980Oli9U3CyQt58oIhsTgU0g1GcO/7kL/4qoVrCxjgilJ03lcibabwRFeaFW8+K4TPD",
+	"UyChRmSGGAumDPYKIl+hSUQZIt/DJIy3QWn4QAdBgFP5pb0juJz43Ok+ujAMHyiHpGjVpbSFIGWtjpdD",
+	"5VFuq32T0m9aE3z/7d/+gPM/AvhHkPyB0j8o/Lb1TYASRmD8xzcJJmz6B8Upm377t2/4oH88IMq+/du3",
+	"rdvb0LnvorCkYgjbbngK8FiYdHKfKWOPYSAlPrfqAN82PuDmRBSi0PM3kKO+R9JEKIoCnDFMY+Ydc5S1",
+	"YjgbhXAli0Shlw3i2ySyMV/JIYIltI5ON2eRZW4OJbmaKiVuwClOA2XXrAP1MoErBieBsZrUjPcRetgc",
+	"QQGezdRnq1XaEAURVTtnOcQcuFP99qPvcdcKiUJuH/OxVn4vliXNaqEoqO/Keo+awsVQBReON0gAVF6e",
+	"v1JABIx8e8EEyJmAmnfnNrmxvCjyR8AECCCACRghoBeUgNECREkQpyF/qn/Wb0eJ2L16jBEOFzu3yXAM",
+	"IgYiCvAsYgyFvngJk2gSJTAuzvgQxTGfMqUo3OHI/DAPvzJ7sUDWpXadeJfOcUIl/JJ2w4QykgZ8xfRK",
+	"Pd4AKZE13BrcKkRLGbCytLQf1uHhM3leAY0BjtZByqZSddp82Zn2kZ/3pyliU0QEn6YUEc68MJHewIgy",
+	"AhkmfC+d4NkMJ+ANZGjH8x0qDP94FUL5YkqoEh8u1zGKyDpFDEYxBXCEU+UYTdkUJYyjA4ViIRymU2M9",
+	"/YgIFydbwOS9HMl9rGfmGlDv7YCf1C6HgKLZPT/RaRpMAaTg1rtv7xzttG89MBZYHkdBJMREjCBF1AeY",
+	"gFsvRPf/6+3w5pfvB9ffq1fnBLXUW2CURnFId1Ye4BrweggurgNEiZQifE0ct2eE4G1wJuLjrJYc8rWa",
+	"p4F4GRDEUpKgEHCrUXAJReQ+CpCAfxhyfmELeS+QEgHtFtaT2zlCaFQ4DiIFwKXU72rgoPSF756tDpau",
+	"BHKoTVZrO+mZQGBjR6qsEbXYXKDyXZQTktsQ05PyKdRMUjt9cOgTW41lNfW6QjtDBr822AYuYDZabYRk",
+	"ECxDRJLGMRzFyDtmJEWrBIgNhxqj1n4EcUQZ5x2tjPERCoyjbxK3hy8zYkOc6e/WZ6Di/JtwUu6KZBvI",
+	"+RWP6mMkN/tKR64Yus5iNT+M0viOq+v4DgHxsVr0KYLhO8QYImf3HJ4tLBvd64iBWgsvQLBy6Wr4JosP",
+	"EQxBLOYA6nO1/DMuemM0a7h05fGg1r9/sbzz9zBOxTCSiTXzWpetPytHp/XP05KGkybRb6kwobjtD9St",
+	"g3j5hkPNN7x6pp1HoXfsBUE/6If9sNVHe+NWP+iFrdFesNfaG+/BvXAP7Y32As/XQMqbeP13XSDEy+/g",
+	"CMUZEN6jX3spKb85qVyMfrrOcjrdXn9v/+DwqN3p1l+VnrHpugYz+DtOgPYuCDqAbwZX599qnxPBMeK+",
+	"JkhpWqbfFX86uDrXi90L5KJa/bCPxBJbfH0tjQSOA2uxkCTH8IEeR3B2fGyv/JhPu/t+wcevxsIa0OcQ",
+	"ZKB//Kjg78Ae3EcHe61x0Ou2+uPefuswPAhaR2PUHR8EbdiFHbMPstuL48/KZ59tFXmJx91dnp+57zk/",
+	"COuoNYZMwKNNBO++s9PeaXuPudG5Pii1jFYno+Mr2HXXMAlH+NMr3necVKPOqNvqwM6o1R11YYv/0oKd",
+	"UXfUEU+71oKODg/29/q9bqd9dPjl7Tu9ILlOsWL+Q4sjQC+4at/ZK3+pfTc+HPVRf4xa/QD2W/2wF7QO",
+	"wx5s7QV74z20F/TGPfTnvhPW9j2K8Vy4a1/v3hvvIU5Dvve6o1Yv6IetPbQ/bh3Aw9FR0A47qGsfA0bs",
+	"9/p7X97ek8vpBa3+aA+29sMD1DocH0EhaILe0iPPXvhLbb2wh/rjvXC/tRfsj1p92IOto+AwbB2hztiC",
+	"/zVvPT6xXr74skgyMXBu22nitNDeeL81OZgetqKjX9utu07cnfWSPt6b7xeVTFpNFhcEObxbEDwd5rEM",
+	"Qn3lqFcrK2K9pdH+2wEpCzxEto19vTlb4/3JQWt6GB21fm3fdVoZ/X/7CpHPEe/Ae0sh/pAeMZvt0zBi",
+	"eOuol/QvYb1l6H9IvyTUEzTHlONpUToq7CcNlq7xP1u05gRz90CLT1KPDDlw8sI/e2JoUWM3HjYixiRi",
+	"03T0guTAZAKTiAqPR5EgF/lnUlkRG6JEjZbZEO00T5LCBDVI4vpCEyUHkiHL6n36+oky+OkaEBHrofFw",
+	"fX0BooQymAQltYo/U5EhjSS0Jowdu1OlPK0CKEcYC6AtapM6GmAlKgpaZrNT85kdKxWLKqOzpH3W1MPq",
+	"c3oQ4zR8gCyYfmHc3uxkRmnrAX293L5aJn+JzL5tvecpeP2juIeojhPK7htq35DISBiZtLPqcsQev84V",
+	"ibkI4dci13LCLdwBKdBrL9GafuUa9dhNboDErQ/QX6o1v4FRjMK3BG7n3mtsDVd74RYMKxeem6DJ6uWH",
+	"YCK/NKs3AaJbWLoaq8G65Rc1Fq2HbrZi+ZUK+aUikgeaYCWxfhH6931EuT7//Neeuem3fvPJ5etUjq2i",
+	"M1Ug5lhMm8fBVjhAjtRw+aupr4ZtQvvcSg27i21zSqIx28JqQz5O7bVmU69crxy4yWrFpgahHt0sdTuR",
+	"QY1kWVUM5ybxQbVlXVVUx0uFSa2MjGoWEGRynmvHAumoM2higiQqNGJUBsk2UFNBTL6CBlrOWwXRyk1C",
+	"UENEyAUCPPpVnAN89Vb+XBaAObgcXhXYR4cuXi+S4CrdSlg0SRtUrSjMvxo3adJMWprwQ7pIAiA+V0tX",
+	"2/ml4oLs6be3jxQQDdjnEvKEAoZCs4+KkFnI2uZuWrEwXxdkaYzSGhtMDbwNNBl+yuXISluxEZYa2Er5",
+	"ScrLLUHP4QPWt5k1WxQI+Qk2pbVlRtJ11lgz4/vt5nG29rpxyhDdYNXV54UZuQEiBDireVoOvTkKslTn",
+	"jak/U0M1XLCGYOWazfhNDgSVJDmzJskWv26QpEZpZ0vhkeaTnCfM/BrlvD1qvsIPFQPm3FCaMz8Wh9QZ",
+	"5lGAk/LvJUeT+dt2MpkTarnLqHKzNK3cU6WM168RsYpnbO+Tw+LmKUlCgLyXhTOm0VYU81k2Wm1cOECp",
+	"sZuyeRohR1/tUvAwjYKpworI/gJyUIDHNpKeU4ngoDTD20pEySE30h3kEFaK7uZad5bjWUtFemxiWYxF",
+	"ChSHlOeaQp18uuNlG9tKsxSpIqWctuwZzwRiMEooCEXyHQodqUNQVSZLQs5IKRUv2bmE0T0CcB6JbLWX",
+	"L+z2GqqxRYWiICSNf+kePnTP0Ih1/3GYvPnH37vhD7Dz5ubs6L/afy9B7XufWhPcUjJ9eCrTcbMjvB4u",
+	"7dN7RX245XVullS2aX44vGhBN1HvwF3BzdRrK07uO8vAGXIUCrxl0ZZ6/5W2t+9V8nt5r6rnKmdPnnUZ",
+	"h9LSlpusk+kNEzhB5B26R3EZhOEYUMR8OzNepOSrr2Ty4AwmCxDzAUQC7z3Kvy7y8CX2ck98gHYmO6Aj",
+	"1sWmKCJmWC5vuuXfs4l3ZO0MyZqdtr+CT83xU5YC/JEQAuoMVYASqqfPxKzfqPaEZoY8kYsMwXc7ZXgu",
+	"incJyoXesdcfHx6MD3q9YHTQHnuPOa55b8kCV1HTcOBQ5JRQUT9yLt/h24VDrr76buFcVyoKGLxfUj5O",
+	"vhEW6hI4SxIsgUKN4oSisI+zZdrA24DYwzn35ftsAy/Zn0pGldgG5opEyG2Z2LmQysYv7U9hRsjaIGef",
+	"5ohSXbMAhmHEB4fxZe6DJrVGHEvRcuqNKfpYNZGjfoi94kiJAZzEC3PZ9RCxKYBxrHYORcaaAwxOKIAE",
+	"qXIbUmsQeymNkS8wpg4j8PnWQ8n9rXcMbjm2wlvv0UUVy8PQyHFR7ajQZl2JHk6OMRSts33D3lHY76Hw",
+	"oBP0eoXte1M+3gpCKZqhQpZ2mcMc6lbAonvx+k9REuKHaxTgJHSM/z1+ADGWBU+ofCmT1RRM4b2MopDj",
+	"IYATEKIZl8jq7IFjhoiqiqKkZbgDTmUJIqGNdftgilNC81J676DbP2zbsnrfKaxVMaNTtfrKhcjyDOJd",
+	"EGpUWYuyFDoOaRzjB146AJP1wJrBT7VAUiM/PUhFw7EMXyUuLRa/KVevrcHhnTHaPzxod7pHvaOuxeF2",
+	"RW1XDndDH2R+0BXFANY7/gomRoUF8VPEpnL6ZtpV5K4UkdSq1y0U1hze/Nzhp3TZEoQlAWaoUo+4vaPD",
+	"w4PDsAMP2mHbQVybDiU6V6xYrYsOLKuwXHJmW86mp7YiSqtZVgW6khw2HutRphu2j7qwjY7gftgRoJUq",
+	"NZdk0jWKUcCorcpSoWCbc1y5iwrJ+7YBDLhgk75S/TpBQKMFhGiOkpCfFHwS7p3kOsGveOQ6pjSih45C",
+	"eld6RH7iDU5Ozq6vf7n68O6Mj+UqiKOR/Ea5cddWcHIT3wyu3p7d/PJm+O7m7EpOrTQ6bn+wYAoiEetF",
+	"FhInvPaf0m9kXWswhRLfk+geJUA4e0taj8qQG4ZS97EjIm+9x50VStBK7KlFvL26+HCp1iCL/cM4XoAE",
+	"EoIfqGOp4uwHNEomcb6UoRP9KUVkJSgfrjUanSWN9OYosbIDA/n6FWWLGYxgcMfBTULBw5JZJVNTc/Qm",
+	"gtfLzJk7QeqVWFtuPS2txC58bu5y+etXaa8Qv0aaNq8SMmRo5pKwa9aDFzjnZKhaO72L5vPKhwyy1KF4",
+	"8VoqQib948PZh7NTkCYsisUWlDGZUxn0ASiDhFGgQOBuVDZFsx1wShY8KgH8qsd5MzwfXn9/dgogBRRz",
+	"f6jY0QvxUBGdMxBKuHr2syfn9Xzv6sP5+fD8red7egjLOZ0RhKZBgFBYtU6GGYzdj9z29hImdR1g+TL8",
+	"hlENgjUENrVsoDMyGUbOG+O2npJB/NG12/lOXrXVBRM6trs2xDHRskru+IiqTR/yRwFMAhRzD/doUT7o",
+	"3LaUqyDc1dmPFz+c/XJ19o8PZ9c3RqzIo0CGyHGvdCKNJ8vRdTI4Pzl7Zz6U8PCLGX5uci40b6oppFzO",
+	"BJcWyGISm+/yIHm+l5/K8/UbckQnL1YVbFOqZWW1s0pFD1E2XPpUC8yy9STqFgMYhgRR45MTPrqHKQYz",
+	"GKKiT668tSpkxOW7wfn52SkQIlDauQlmYIRQAgyXgwViO2AoXuFb/fqH4eUl/2hsz8uZStI7mMJkgkIg",
+	"J1W2MX9TnD6QumSFAsTzvesPJydnZ6fy33ImLjgGw3cVYqN+DW+jp0pi5FHvaxY32KramWLjrdqdP6ij",
+	"II9vcexnR6+9I6QCqrdBYRNQSWaxVTnld3K6jF/QWfhAtqao9jUTljgLpnxgNbmgmD11/q0iGDbJ+Fo8",
+	"37MByf6UkHi+Z8FRidAfpOQtUXZV46i6hhbbbtlewUZqTGtJq6CtZ87sj/eD7hj2Q3jQP/Rc/aUauGDF",
+	"RpMXo3+6Yp/JFVu/pY5+vYbTtYIHHJAUi7qVFYRE6X/axqXpiD8fIaLTVRhWyqFDDWAMzeaMuvUweWte",
+	"npPj9u/XF+dq5jlcxBg6t7wc4WYxL9zO6sNNHR3esiO7PHehVOpoIagdQ8qAWpBzwPvqhiiih4BMIWpi",
+	"GlUIqBiuMxgmIeL//gE5FAcV92LUfR0WRNS+zAr0Ol4RuPacKrrmFTeis+eKvYoclc3m+RZ5xS86Cr2W",
+	"tm5BklEqxz/6D0+zhp9xb5GABRJYO6+4n1xbLoKTBFMWBa4C9aH7ijDWl8vLhOg7PJGX0E3Kj8uRfTl1",
+	"vsuUXlMGcL1DaTza7waj0dEo6Pf7YkI7bdBhg+hou9BUVlZqq5ACcnn6CkcQryxpxM9rCQJaAVZx/hAH",
+	"6ayCplYBbDN3Z+V9QwZzNoKBxyKAjT3H5HZqogO5UsuW+yvAaRwqnd3cTwkDL0TZn1IF54chQYxEiIIH",
+	"RBBAn6YwpUoTrzL3VuZXDeSrj/6Kw2ENT5JYaYUEXmaH2dE6hZu+4dvvBeMpwWSwxE9cHzxMOV6MfTWD",
+	"C0AZL20uTCPpK7N1YD6c53vnF1fvB++c1smSFh7LzUJm1Lz1Mr9cCV3DogE0MSqIMX6s7lsK9pzcZFrz",
+	"yMhpMbbNuU7GVrmnDq7O+SpUoCb3GVN4zzmYG/w6t3QbTkoUR5No5AoHHMOYIm3cKqgSLO6HEVcMI8oo",
+	"wFYzgewhhzcLwIuYs4dAPcJa1zjNOzVaZDLrrKSYwWmZXDJV1EEsmeIJ+ElBQYIestRPPAMjZJmMKPTN",
+	"jTgQwVpKKFVc11c0Ht66G3rTviEFu54iQqWja5EZHEoHg0R6VeB4jAKW6Z8SiXl7Y0QQvGtNYkip+nyd",
+	"SMvSa3E0bog9+UUF8p6wdWbZ/ZXrHZUP9wPD0x1wjhmgiAk0vn138d3gncKs+3ZsK77iYgvPAn/4mpcb",
+	"OoDVhqvcirmkdZdlB3nZM0BQgIm4b4TKGae8hPwaS+Jm2YmvD7eTq7PBjfC+fbg8Vf96N3xzU+GHgwGr",
+	"cJmusXnHRvTUy2WX7zdxy7rIakaxzkO5rDzxFHQlyuXoU0nFa71DNKIl15Z9aRW+M3uU5eGEGQpsTc25",
+	"y1QDNimg+a01vEMqyqrsYR+c3Ax/HNyceb53emb+sEC0p3NQVz5WlrfreJHKmVI78qFXtmZrw7Y9/dWt",
+	"vlbfCIgnJ1xkOx/zBlhXiJGF62QrdVXQXGfcEtp2tWaxxywhvejPyLjPqobgwHkYjceIoCRAYITYA0KJ",
+	"xq6SJ/LgslokyYgJu9XYHBN1vkWM6gvGMnFCxFDQUCCsawrcOf3wFx9u3g1/lHcYCnqp5yv1LruzkBhA",
+	"ScgVmffD6+vh+VvrK36dQdBMaDcjNMYEFb+y942e1vM9NVSFzbD8vojiOOULOavt8XqYokReA0jfv4Rd",
+	"qNo4ZXHEwTdwe37FpPfIgUpGUpRdBQU4CaIYEXPVWHuuxhry1kwfdfFrcaW1XgNNcaPJjeTcZs4gsFVB",
+	"dRWam0xTO9FtQh0xmJs06i69mGWH12rEnc8ZEWeoDXAGnRk5h0ZXlJfGYJYfeAnZ9EwmN9a+8tELLO+M",
+	"Ut1X0YCMy6uhudIUvwtrztyG0kUSuNxNlQk1xYW61+NYvV144kRobwKZFaG1spi4ydwTMrmQ88hB13eu",
+	"PlCKp3gRkmAa3UsZ5UqbGajnbs6T78iriaWvyI6PFa8IsJdPJF5ZOo94Y8k0rjzJE+NBzH1dhKi4zuKi",
+	"/CKuLMJXknIF2a/ESeomOE5ZgCULw6RQREQfyBWsELH12KC866BFr82K8gQZWTcbKJ2H2xjInViTGWye",
+	"70Gb0kUykjRZo7KMScbaEM3uLOHmWK4apymSa2UtN8Rxue6O3rNmL1btQbWvVu2+NHHHVRmrurDvyvtG",
+	"Wt2Nagzl5AMnGeYOoYZachZcWa2kKdjkFUCms6dMOqlEhHPo1MuqTaAxJsEq5VCRFFA4Rox7wAiiUxyH",
+	"QodWnYdDlDhnjup3l8yuinBFArQIeGyG16r4qcF3F1c3WSiUEMJ8OZTh+ZxjFQUwpQjMMNFSWYRJSaEs",
+	"7m7EzYJQMTTP89xrGY9QQpVIj6GWRWFHS6kYKV9D5bAtXNqKo+GmCX1UdLViIDVn22jMM2vV3ksT98ab",
+	"XxvkGv/C1cn3yk4S3oWz/KD6C5fzo3x5CXvjkHQOJsG03YcCBeZa1ZpyeP7mwvO9nwZXKlT17Orq4sqe",
+	"13xVb9p5sLgLDuPOfdjHMh3oYo5kypFD0DNGolHK3Eo51h/q69D6gTcX9qdnfK32eCsFVAbyo59VjykB",
+	"KJ407URvM2J+hb6FD4sAGTD1bq0hDGEQBqP9ztFYphxeEjQ272whF8uM9yRpWLXdlpX5UBbysqXXQx4+",
+	"PAx+O0LxAaHT3/LI+zPXad1cJycK69HjaNyDnTbsHx72ezIEw+qdXxUCLhyn0ruFZ4iJkE4TSJpw/5Qq",
+	"iJMUW59vYXPUa1fs4hdXuY95SuaYopqTXqq37fDqDbPxC2HaNYC4sr7I6RA1vlUnnNkTlQ4gF3tqXJUk",
+	"Qz7e2caMneVgTWjx7lUxKsxi18qCY3nG+Tl3U19VNEypgujGRO2GxRzjttI+BG2OvU7/uLN33O3+s+A8",
+	"zcbUuPcGl5dXF1KzsGueWXDmP3zdxdCWr/Xy7PxU6jL1C/Mvq5dm1+MvxqmUUPf4UcpEPpcrTXy/nb+L",
+	"rV6ktfN1MI0re2LAcpxQ2LDZ+ZlJzqXnGMwVjGom5/J9ybMAIx5LGEeJwzH5BpNSUQFZYYbjkItr6cHJ",
+	"F5GZpZRlN2PyK/sWQpfnAejTPCLyPryevaO/fI/YFIdrYCD//doq0DhKYHyzZvj+G+tj7YuUVc0a+8sr",
+	"tJqyqFovwaBmitCawJPckdL0/Nn43DMDbI6lRkeoNULxNH2ayuq5yJKaLL4qQ8mcykUs2tPlgxPyh3xW",
+	"xwvaJbjyPFFKx2+imfb64+5+Nwza4/Boz3PL13z5vbykfTJVvThwWZlxQ1hTIQ+OOm00Ptrbax8EVcsu",
+	"idG82JeH/4g746aITZEVfS9un6RbSEfPwZThGWRRINLVMZHRdlDVPwR2fMaHm4v3g5vhicx1HJ79dHZq",
+	"L78AVz2Hxqhz2Bt1IRzBTr9bseA3eXldvr0QAh3I5ClpiuiSYuKW1gchSvgJFUcU8eoJPHGA6Qu5cox4",
+	"Et5Erou+iOLD/XaHT4Qog7M5N3E+3JyIH37HCbJPwqaWgKV6PuO8BebOgPANGpYyuE2aehx+2B712vvh",
+	"PuyNRgcVBL+ucIxyWqv8T+kw1/lnKnz/NrHY9fTs5N1QZn9a+rlSX3+RP4n4qMFPg+GN+E3GHA0vzs03",
+	"g3e/nP3X5fAqz+n546weo6NOu70XtrtHsH1YtbOrkvAGgKHZHBNIFgBSGk2SGUpkcxRpaosYvDmJkiCa",
+	"w3ipqV2ZeqQLezTwkYhqIA00G7u65ppZeaKWJgi44o9CmXOn97oK6VQVRoWKGzEVS6MOL5evuFEe9RMb",
+	"6CVeKOoYy0jIsr5iDTqQrdIV8qzjl7IMrVkNF7k0DFeFQNnCBCwp+Wb7AETpWSmfvWOv2+52W+39Vqd3",
+	"0+kc946Oe+2do27nn6aj9Qi2w2AEW214GLT6vaNeC4ZH3db+0V6n3evuj7pHsqyovuTRNztCBuYnaPfy",
+	"EziMYZpKoI/FpeF/Krh3AjwTgXVsai6+s/bZwmx0Kj/hYbt7eBi0e3srZIX8YZhQRtLAON8LpUWtp9we",
+	"nOIHy4dnG3+hSeTbuU1uEy5u/zuyvv5vVYaHBwry1CBejZrfUiUY2K+J2HR4DyNRqrosjaJNwcWxCNk0",
+	"wC6tduNAUU1dbB/CYAR7BwewO1pKhZrnlQorzZ1S+jzih83wenih7onMmXR9M7i6yW6s9A2SrjbBj7Tq",
+	"A6oK0Hon1lEXjdrt/lF7f++g6qTOtLJCXFq5gF85vob7LrhrwlkNV9Q3z8IoRTUZEXCY91ZoRwXlsl5l",
+	"pGkHBYFC+xUXn+LTYinFG4c7wwrDjGjyV2blv/FtrHK6rXSBv1KQOWPAg6gJueO8b34VKl5YXaGwkrz1",
+	"9steZ28fovb4aDTay+0Xk1lQiqZuFNrfVKPmhowIqrze0hEsB8zpp+t6DPhQa7pO+KebOz0mhWD51QHt",
+	"6l39bTlyzuJ08QZP20sJuqrOVay81pOR4fVqDUgFT34BCIplbQqGM/tvx8nAGdfM4PxnOfvHkuK3dJlP",
+	"m2vpewxvlX8ZtrlwE38XXpN3Gb55gqoptrrqvKbOyaF6wuxTZ+/3vd+CGNHwtyNbmF1mFwfFjhQVfP5Y",
+	"AuQEJwx9qgtKZ/8IdUc9hIKD8aENytWqGk+mnFOm4MmDq+zzmKkSG+6aFOdVkd11L1pjuGSQeRSwlNSt",
+	"05oBZA3rqxWUiQ4MloB0Xf55z/jnPeMXe89Y4UQNu30YHPV7bdju2BJiK440WehOBgFmVof4lyRQRqqT",
+	"i/eX785uzjKDpbbXTACdJYpURLLZlkT9s3zz4tCJFDIWHLmFKbjryfNoHnYeWDBawF8ftMs7V9aqrhMu",
+	"F0dXBKZY2lX65twQ/cZ+b//6CT/sd9sT6ICoHO9nxTh+d/Z2eH79y0/DG160YnjuwkzVMDWDHkdJ+2Hv",
+	"U9pL94NUgccvJ05REFFn7ukAhOqZvA7AiYPJ7auNTLAat3FuGbnpHKybdbstFweRDwBBc4IoEoXxQGia",
+	"1ErPgHZD7YDbRH1ARTuXEQJxlNzJakd20joF9xEEqg1iyd/7QAey6rGT8eEDvUKTqpyx0BTyqe8Ptor/",
+	"ONzB4zQRnpcBcc84RTBm04Vbya4yEtJES+ga+1e/nYfFtxFloyUDKY8Ou5z/21JlqeWtKsbhwWFvjIL9",
+	"9r5oFvWpxb3XHEKpp+t+zh8fffWLoy37c3jp7xp6kJ/Hr58vLYYfEmHlITjjnkCU3EcEJ+7kt6VleQU+",
+	"bbLW80Xn9MTXrWa9hPpUoaccPfy2N/2V0mif9PfFWzaHurn9dEWGq43V+i6GFVHnqya1MV/TaDFQ5r5W",
+	"f+QKMfBX65qGo/bBuB/02p0Q7RURegkZQyTZpFo/BDM45yeUKb8vbhonMR6BuRw+qwMt6rtyE3MCo4Qy",
+	"VaaffyfWyPdpzBddVaCfG3JZTczWf6iqmLmNmV+Y4zbvJt++aBt9u8Zq19RshSQ22aOvesnWZ8mswFzN",
+	"iVSpN/7pIgnqeWTsz7OPttRczF3TRtWjE1hUWCnJ2yaRQQ/B4vdx0rmbH326+2TxfE7CFTpzzFEQjSNR",
+	"33sOCYuCNIZE64M6RYnztuQQAIF9JpvSggTPyrdZ+risa17kxKtLtmRFApcLlmwYY5/ob9UB58bzG0mJ",
+	"evIFodFROO4HewdhEdfV5hqxntTJKtK1IPi/dTZShTt17Wq4ufGzPytw1NCkC9t3+7PxfPQrJIt5EU9Z",
+	"Qct1Sh6XBhqQiSww+ViEXHFrRQlIN+QHI9jro1F/rxfu77khNxM6Is3GUaJKgCsV0AehKIa8mMtKLh+G",
+	"ANktpGXOphrQ3041C5vdKh9mVCidGaxCe3BX6Gaxg2lyqAeDbIG1qnMf9OHRKESdftDrlmiQk+3L3DiC",
+	"DJiKyjpIFNiRK5cJpLLokm1BltG/dcOP+2fVxVGjMnGQspVlaEScYnHNYqmqzJHPr3IjBkzbDOc816k4",
+	"BZqApxGbVdBYoV7lilkXV5PV2gig0J/08JRH6Os/xI7yATSU5rADKoEfp7FYuUshogy66lHmC+zoCfO9",
+	"GrLR44UqVWLfgYuRExmUoVKJ3YV3MIOxFqi0RrpRAb+lEfSiqm1z5/ap1BZ1yFQhSqVKf9vugb/6UFe2",
+	"qltzApWn+Z/m6sbm6hj1xofj/n6vo9zqEud2Y+CC37Hsxci6uKkiCJkjj/oARSJEZaQ7DHATC4u/TbcM",
+	"9UBSzRhXtglGt1G6dlw0GWs6lswnqx1K7v4KYmKzFIEp0XKhZE5KnEn5F1GgtkVWmcGwwS5nkN27iv4f",
+	"0utWZ303/M1CrVz3GqSbakJLdM7CUkXnCAH48PTJKoiKtdUoCVrg40rJeIVTV4vR7SsKqqrfinJ7S0ty",
+	"VzXNXxYyew/jKKxRQbFoTGTgGvE8zypcy1FXnE4StTVrDvR6vSM46nU63Y4tiG7gpI76sdK7w+AE3KGF",
+	"lEyTijaKDMGZcs9AcXNBXQ6aG8mBJX4SNXtW3OsVZKl5pnUPo/GZOjn62iRzDoeIG/jyYoUANDPXLByi",
+	"tQKB1g2NmJQT29cutLwsekI16q8qdDw8lRJTRoPkG/s3iMSoG8ie1VF5QqeSJEk+FESDbsWeW9Ehdlpb",
+	"uZqTYM5VOtTJlER2rIwX8B/+MxAlDsa8wkGESwqSUlXEt+CcYyCxYD32pozN6fHuLryHDBK6M4nYNB1x",
+	"SgU4YbyFRYBnu+lup9/t9Lvt9t/u/0+fY/bvmE5tWCr0s5Km1Hzig3633ds/khM/qp1cqD3o7ifBDeJc",
+	"4f2I+99k7UaAx44KrhHhiF9qq2QDybez8ezai3bkrzUlgCCRoUCTvMMxF7pYfVyIZ9VhTJBNy8DfTFG+",
+	"Xt5E9H3XS5kZJBaWIEKEuP4nW07l8LkCEdzvolqsKa1DjQoiRlE8zhV/X1nNzl1iclXtnawQaoY0X1NY",
+	"4aqwAwuzOS7yuIRMxli2wEkYlMyi91hWa4RveBJbXJ7fpKWD0PoUDC6H1qGWHzSzKTo7bVXzKIHziKds",
+	"7LR32mphAq+7cB7t3nd2ZXBWi6lW1OKZuts19YmGoULBu4iyfOtqmUND5zih8ttuu11FNvPermOcK/WQ",
+	"g71XZwzhgMm+En2aZjNIFt6x939xSgDvm4mScI6jRN6ymiVzK0gvnKRxbtF5zHNARQsZK6CeLzmPGmFW",
+	"ZWu6Ui/NIYEzxBCRxmx+5HP0iYE5nCDA8B0ShWT5z7+liCwyyZigT+xGPadFD6HRtD9uRgMBr43/frvT",
+	"GP9boJpAttWyj2qL4fhniWIRezDHrvI/J+piJLEp5SZUsa1cFs32HQ4X1UvQr0SI7hbH0HViHkuU6Ghp",
+	"oDzUwvoKBEi7v6qA3HpXaxbEQtS4EBBK8rXXIN8LEV0RziK7g+pLN+/uZyK6yD9KroiRNAkdlD8VDwuU",
+	"z1GrX+ascwxOFPnWxlK/3V/jq41xK9ebw+2j7xZ0bxGzQ9BSmRfnwOFbxJYhsP1M7H7xwxdHDY7i5Wxe",
+	"OjLEkSB0EXMiSF73bG1GpKItPx7mqYPmspgzLdIdiN9lA1U+m7p0pkI7fdC97SrYQ475XML1ubmtXUbi",
+	"dzAEFoCKIwuITmDKpphEv6PQYsCinGHgDU6T0GK2YuYpQ4RXkrhG5B4RIJitwGQS/03FKW+E3pLNC1q8",
+	"8/1SfYgW+6ZTP3fHJaxeu3U2v7DRTWd9bhhIjR8xo55xzw5F8vc5Ii2u/QOCaBozlZqq2rNX6F25xsrr",
+	"q6T5YV6LRlTEdgOt6Mo03ZYJo4kYLO86E5mneDweYUhCueuF7SYTmOdYGmoMiwLQSSB8WzI1lXc2J2lC",
+	"dSPhEQzuuDWVyALraBYxGQ1J4cx0W4U0a8lh+nxHSRjdR2HKa7zsgOEYyFq83H4UxnWuPXeudbj2unM+",
+	"yxp/RGyKU5ZNBJOFqAO5s0wjzFF/fbmVG+Y59MI83F+fagiLO2Adibb7+Vc8UjqiU7JdCeah5dl8Jbs4",
+	"H3GGnhM8IYgq9pPX02ksO7EhKBoOoFm18lTmsic60VayxReqQtVhhzqKlOCHZnpUictCBMNWjBhDpCXl",
+	"24qTU75kucnED7pplGj0x49LHgxYbkxeOGZl+rI6bqvPxkIv55WOCXG5GUeUZfDp0KiIWm2yXe6KXBG3",
+	"Sjz6tabUOIrosmbt2c2K3U2bI5LGMLg7V7isgDfXT/sJ/CtF3L8WlYLzLZB8q9BdR6aWuX33s2pCXrC9",
+	"i2F4/HeZVlSY1xzVgppSxMob9QqOPo1oAElYblD+dRvyctUuDDqln9OBq0+glbjb3hlUnOprOoXqUWL1",
+	"OZS18d/uSZTtzV2C5jEUSuwTgVNlf1yjJKTWOSd2tg48saS6lPVF2Q4iZWhI+KWtAfll+5TgBKc0Xtgx",
+	"lFQPb6YT55WyCdSkZcknDp4KaXMlJl65YbqOC8YgQPMXULpfaEtIRNXcFQ7m1WEQu1Z2wVI1ndNSvQui",
+	"RN7dRzhxk1EIPT3FjyYHofmZXhpliYTIFmUADRHjfFoLJbpj6RJlUvCzaWDKlTRueqs+22IAuzel7m9q",
+	"ys7QdVqcKk2VLlE4c40i19ObsiFei8YkMCaRWod8gvVb6hisQca/X1+cmzQeLLYOWQAdMRgaDsJj9UgK",
+	"OMr/g22RN0qpD6ghl/zRZDto362QqnI6xcgqzFE0c5df/ZUqfgVRfrupOAAFbcRAgMUGpIDhJWwhZOe1",
+	"wsi6jGEP8lpYQ5HCrKwZc6hjmqf9PNaSekVW0fSSRBV36ZnEsU/ANImF28J+OInuUVLtrLDQvcpstFhC",
+	"j8+wmrzC+sqywUqqRRZ6//EJ1VN7eV+RaprkWHITtdQko62vmEqlriVPnBWSUL6ktMFANIqTyR5WkcNc",
+	"K/RQl1SMY85qJNId/tCnKUwp40UU30it0nxkKoByvQ+F0n/i68R9GfQEF6oztkg4kcfgEtEmp3grl7iu",
+	"aLMHeS2iTWnkE72ylaItR+3dz6oJ9eOubpVXzwbJ967e0Aa5Eo3KqenrbqJ0c4uT7iftjeDF5sWtxxSZ",
+	"GN4d8EHcXEUU4CQwypGpWK8bos9gou4xTEt/m/dkJjoRXcKpKuoZ+gDqd/NvxmjM9PWKTCujTDWrct1j",
+	"KCxbrPS1e0beYBKglmYvAHNU3YRjCWJk8SLsKsWYxaEWS9jST+liumyvspQZaWQoyz3hMJTLu6PSRmZk",
+	"sZTjVprHX5ShyxG8BpsRhH5Hqw5AJVPUy85r9XfRWHewVsJDvy4OtfI9u+AWXemCExfFMX8wA3AOybKb",
+	"gzcK5rVPNPn9aznM8shtcIv+RuNXDsBT27iA9x05y6JBiVTKrTjEHfDTNIolOeT0IjJaEobH1GRX2uIa",
+	"m4cTi0NB1X7Wv49TqkjPBUuY5oWDfGuK4lAcVYRKa26hDTb0Cc3mpjqXDsyA47GYbenVuMTA+nfi8vvn",
+	"uAxXkH6Ft+BJnoEbCJ3dz/If+etvt8VnUfqJjK5qCn259tYqytQxuDSNthraJ0tfUAonyBedT0XbEXl4",
+	"2NJA6BESAu0cwnNk/wwCyKvNj3Qr9XBpAOD6AsP+/jkC/1az41fvvJco35KE2Z1GlGGysCRNRaBNAmAa",
+	"Rkx5oS23p2QwWRlT1POSg/sAxyGibGXEgyTp9wqOzbQXNcoXS9t3OqpCkUXV0305gVWHhWJ19/GEQtOp",
+	"6HHdmlqCcJAscvqVKuiPlJqlr1rk60pHixGklcKRT/AaTtivXqRxRK8l0LKs6GVmWhxr7V29v+QyTD1f",
+	"6jxHSUAWc1GTgyd7AZE9GIkSonM4iRLlD0rGeKM8Mf+z82NTSiz7UhceHp7fnF2dizZ0Z/+l/vnR316A",
+	"lETP8os3hcCGmWDcrFJJpdLTcYInScSw9PDOMY6VRySiACW8EdRyE0iXAFzTAlJV257eAJJwfn32j8Z/",
+	"zR28+1ll+NaITJOR7Sq3QmdeV6aPZYxQZni3tH3J3K8qtPnLlTMrpEJ5OQReqm8Kl2Hlafn64ofCyt+a",
+	"cnenlfEWfi23blabcTv2mEbjMsvpaeXMM9FjXRGzLVOmtrDQ4SzL/SL8INJV79e2J/QAr0Ck8h0yzdZT",
+	"fbI63f2TiDJEsuryjTm1MMRznIpZNfyv6GTUeARQU7MJy+9+jpYfjtnFaTZ65alos0P5urE2DR0VNdwH",
+	"VIJB8EVdWTYM0q7EZ/t59sQX6out3gd1TvxoU1+G7MoRTFFw17KPloqr3lSFdFmfCW3Lks0O7vg+e7v6",
+	"UHqiW/6NiWQBD76vPoJKmNUl1Sotc059W2uFI5yyfDU2HqcYTVSvrEpNdqhePym83fzUd470So7/SqTU",
+	"psSuqOi7jLnz2OevA4NyIMKheXfdHZClXnJg4ihgKtZFh+PMoMjilX5h0QF3homM1aIAE1O/SkSM8Wgx",
+	"EkwjHosjCmzJDOQxYousEDB34eCHqpguXpRXE2+V6ybSrYD5NBpC6QrUUdMpkzetsu5mhQdHZjy73DdW",
+	"0cfak6u5RCDgFvFVAfsYF/1HJdCfMoxTk4qT7Upgffsn2tFLbFS+IGAxYrO92SJpUif23LoZyG3WLMPZ",
+	"KgGueIxN0UJc0LhiVKq9oTlKcejWNaaKA72WGJO8tCNyiSvJpsM/VtyZASijFPFYBbyqryrRfZW9sTzl",
+	"dxYxGdkoXgMMA95QTs0iqnBU7HxTy7LsOrbbMi7rwJg1bXQ4lv0X8pSv7dDWKK9dmK4Be+x+NvnVOqfP",
+	"yjpfrd7a2dlbTe5zJNVJp6GaUcYb+toTj4noZsNFiBAYKpjOlwkDcxgRXb+fIR28RLKmiJV5enYbZVoR",
+	"hrhpzvYX5R7gSKlFoDpcKMPcWqULsgrZY/U4WF/M5zvevAa9OdfisqnvTDqa8i2l1vTz5jDzDP4zC+bX",
+	"40F7IbVMrrbYq6bZBlrpfZO/Fyep9L8VmeorDvl3Y6ahf20pvtrPtW++UC+bjXrwjcwSReG3L+V1K2+s",
+	"Xd6T2KEUFUpf28sQXS+2AF2zA+Adh3Mbh4AY6PHJOVl2hfj3jixK7opB/1aqP8OiSNjmZ8Ou6Xq1+1n/",
+	"c3mSsKlVne+ZBSnFQSSSJU21dZXTFgJ7ZKESioRgYIPTisLqa39HS8JtaXum8+KXF2+Z67ln4+UZxKNf",
+	"YfoVuktuVdpWseruWPTVr2mevvBCq4NCTa9gvhgUPsveeiMmq9xeueNiK/Jdjy8n5iL+cav7OJ+1KSYB",
+	"1ovWNqknHXHKUJ04TfmiDmISFLC3Z7XzzDrwtiPS5EjPK5oe/RdQgerQz1webV09rE6sHRNEpyopptTL",
+	"UjIIjZJJnLcthBecWAU4pEeUexz5IlSDU1G1lj8Vv4ksW9/K2o6sYjs4yV5UH1dfQz2/nWL33f4qLBa+",
+	"oDxFyZIjuQ7zpskrtS9kyE7ZvnDtsuL9o9Fhh+udw4XxVNu5RiurH1L79edkJfFyQ0OUsdjA1JhZTUJX",
+	"XBDCXNdQ/aHKhomoo3HoqlPV6uy4ybmqh3ktt3+KFLNsdc2SFuhy5BpmGC3A8NQHmIhf41j9TqUSqjqx",
+	"mnrpUDcvXZbe4KDLBi6JjDDP5Zo2kH99mQ+ruWrVHnc4m1c4kqs36FfZIafWxn0KTTWtOsllIPtz78r2",
+	"M+7Kf7f06jW2sQjYqjyg3yAWTK2YEPl25cn7QT1+DYmIa4dX8EVUV+ZRUYRFhKyROSi7V24lcVA1b11z",
+	"58oFP/0pKqD8+s5Ohfx6O233M/+fShlcLe7ly9txTpj8MMV4uuGIVPtndtPRysPCzWi1uSPf67Z5d2hX",
+	"g1VqxXRlOR1Pee5U8fHFD18cCyueWI+FZRpsK+OcOpGguT7AzqbIvokJ5XuBC1m7YbG7hbBoirMsfVQL",
+	"9kKD3fW9rq7BvuwKHgKRRWGw7o3SOoLLYjcR4SrbLNdonGu9DbhPiJqMAyTKTVt1x3duk5sporKSRoiI",
+	"CEk35Tptr73DY6rbE5/Z0K1dZtoaZPvEzIH4WIHZXe1KWYlh9SIP3mfZruN1SVX1C4brYOvGuG6Waoha",
+	"39uoCkV+SHmxZtYxWgDlPFziV8yGV33sOJerculkl7+7q97Uzo9j718/w9bvg9Y/262j1sfPHf/x9na3",
+	"xk9/8WrEJVPEkygAvjcLURUX72GcIuqDGI5QrHvWTagvxSJDZAZmKZWFtaSyLfcFm6JZBQrkXJvi2ALP",
+	"ROby9Cn5YBgei0c74AQmHDhzrTnjlx9zfkkSzRDdqYBRzpeD0SgSGcVgEOCUe5yPO91ef2//4PCo3ekW",
+	"iPavY0GG450KYpQVEVdtcsgYiUYpEyWlKCbMwoUPQjSGotkkw5JSVajHhH23cMa7688E29UJZedQCUBk",
+	"1/kIJ3k4IA2WQHFBQkScgMjv+Fy1wZjBT9EsnVmJHkao6Bruedj22hWgzeEEXUe/57OB1PDecafd9r1Z",
+	"lKi//Nrl3ut6hLcSrf1yx7wljisuxMfwHpNo9dW3Pgf+SoH+xNCUy6CHKWJTHYXPz1tZ7xzF0SQaxUjS",
+	"XQbvK0nkPkDeGHjWr3uuRtg+OjPgKm3+QRjSzLHOsAtx1Ll6VdlUvbNBbVQ1wrNUR9XQvqYY7v4L+gcs",
+	"hDj2mKbXkixXU7hXq1pLd16UZDEGvMY8r9Ks68wr8QrClJja3nYTnjRGKspADDYMKbfN8CxislawvXvN",
+	"ClQmqgDS5aey3Ev5ndy4pEd+jOdwMZs5/o19ywoHtqRzMvJnzTQ1K35oFVYbX7UkorxMyUnEpldHL3kJ",
+	"lMmCOka0RujaZvScoHEcTaZLpMuPiETjhezcBONIuQgDHMco0CJCn9JSu16yvy/NfCssvAFIk+i3FIE7",
+	"nqCnG79ycbUDdHcCE+0oWnvzF6WWKH7DJOJXBTp3FEQJZQiGHNqAIMjkpXSYyo2PjAkxRVDqtArFwxDN",
+	"5pihJFi0fkCL1TcKa52/Bi+bySxl1CgD5xchQ4QFJT4QMlwlwwmGCnWBsVaHr0vGWx17sqqk5yv2ki/L",
+	"C/3sn6f5KKMpAsNTfVoMfroGyqryfPn6DVcpZc8F/rOKRAoz86slLi4VsF7bmGKdrv45P+OlqHEKUMIQ",
+	"AQucEjFrbnjx1TthER17hafcBKlYByd8g5Wccz7Jr0X9pBcj3WJrrMIex7UO8bzmSuaIzCIqWmJRqWSa",
+	"vFhVnhST4hIvs2+uEdOLzEZqUcQ0fHz1JDmGD/Q4grPjY5uCx3zzwSRArTnB4yhGu/kxWom1UCeCKOLS",
+	"xrWQBU5BglCol8GwHkhjLL8KhbSPajEqSKFjuF3sjzcEz/iWEWY0v1OXCfUt3YYnEw/wgbYo5XMax493",
+	"7AXCn9gaQ3ECmXaS3n1np73T9h5zkw1Da5xHv9lWu7hjcMO91og9xXzVu6z4uIjn7rp4xncMboxkMQgH",
+	"iUUzpHwCp0rTvUYBTjjWe/vttgwozMRjd6vi8U+aPQ3NPvpeIO3HAfOOebJ9p9U+arU7N53ucbt93G7/",
+	"0yB1FHS6Pam111P0M83lC1b118tV7ne7LxIynY5mEQM24h2K6+5n889lrUXEbRzK6Z9PZhPWYpUXy5u1",
+	"oKtjYljYXdvKWFlaRmXK6OJQD0l1XRnt86tbVsaKGGLqVmj7t0bcQJlDygAmIJ0HeMatC2sJNS8ntPP8",
+	"w+XJxXtZquZycH2z1WLn5dow23J0GopU+jmHScQi0xJGtC3jiJoTrDVQXZzYMirdLHCJ67PAv5ktORCK",
+	"sELOc9qTulPYe8SmWARWfLi5eD+4GZ54+ZNZLVKdxeYvfm9JohDdRHz/CPYpHvRtoYcRdiOUAa/TP+7s",
+	"HXe7//QefauykDWm0dpOz07eDc9FIShbb7MWkf9wuUKXf00rbxXrUhgr/FAxYE4109zwsTjk8rWqSle/",
+	"XF5d/Di8Hl6cS1lSrcSZIUxFLfW3rbtlIBq1zbr9LKhtFuRacWtEzHQeFtnFYEFxpQCPzDEVoxMEaQEm",
+	"43IesNzw1pPvFk4EZmXDGmiINVzBX6XO9ug46e0qYcuqpbqK/GfloJwq3JV5/PQCTUrSNBaPOR+f4IQy",
+	"AiMVdeSyRfa59fgqZWGO6z97aAajOC/LCJW+q0qwYlh+Yx4FLCX5YIiSKBpccmEkerh8reL3JdH7QpI+",
+	"yCPvOQ8BC/AtnAHPRK7XdK5sxZi01MsnK/244njZzapPLm/1B76/ubnstztAL4+nUJhQxYiK+shWmUdM",
+	"gDqPeB1SRHZuk1XWaFXVx2a2mavk48vlk+prAqTXpqN9z5JQxoV/fEnqS+rkOy09FTD+Vlv0VMRyyKK3",
+	"WetmhX7RiHK0UE4S3Widv8ytT/6bCeHkHwv1Q3kmjEqlWzIHMOG8Lj/nLB+RnNPFNyW7TWRWcUxxOSYh",
+	"5PpRVVAHn8BW2BpHdNgDrFULRg9REbkgse0oQWpx9zIW5JUYApgEKG7EeNuqJyLuAWQwnSaPIC+QMGkm",
+	"GqExJip8lh/oKNwBZ6LndnSPQDSboTCCDMUL4I4yE4MtV7y36RB/oSiWe3ynGtAKtGzEE7kGcPwH1cIT",
+	"PSWbLJFP22K4awYJo5Y4MBevYhrdnRTSLODL7rKgREZEpfcPhUaYZK+b2DOhF3LpJiKAEvzgljIDhVnF",
+	"n09epkbNM8hMl3/r6C+N/ozMiiO2tHGUuaijxyJ55uO7L2Qn+UsCt578FCBKppm40GJxqSixVA2ZHic0",
+	"CyzjsrOIUWuLAsJtJS0mK09+fKd3pIyK9/4sreM4bYqE2PzkefrNsSkfzrniiVMaL8wh0kgdyTHXn+pI",
+	"iSm4TrvqrlWzDs/BIChnBmcW7xJzV87x9bT02Oo18au8nJUUszmlcLIOhZbKd2grSigjqQjvre6yoBvu",
+	"SvE+tD9ZZ/1Sn7KHeQW7sNwq5XnismUe/QxZyF/dIznrNhekhKCExQsQ48lE5nIKt0HVpcp7tB7NUjbN",
+	"V0Mxx3ChtEQCUzbFJPodhQ43oqzeTo0xoOAXMK8UeOWyGSv8gFa293KsmGIWL1QnYiUizb4oJjQw8Aan",
+	"iQvVcAlSnyxvn0OByL0eNiXc0z5lbH68uxvjAMZTTNnxYfuwLS8LJGif9ZwGxEff/CYjIK0fcqn53uPH",
+	"x/8/AB9edmXMkQEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,10 @@
+package types
+
+// FreezeScope is generated as a plain string type (x-go-type in openapi.yml),
+// because its values overlap with BulkRevokeJobKind and generating both would
+// prefix the BulkRevokeJobKind constants with their type name.
+const (
+	FreezeScopeACCESSRULE  FreezeScope = "ACCESS_RULE"
+	FreezeScopeGLOBAL      FreezeScope = "GLOBAL"
+	FreezeScopeTARGETGROUP FreezeScope = "TARGET_GROUP"
+)
@@ -66,3 +66,27 @@ func NewAccessTemplateID() string {
 func NewHistoryID() string {
 	return newResourceID("his")
 }
+
+func NewTargetMetadataID() string {
+	return newResourceID("tmd")
+}
+
+func NewBulkRevokeJobID() string {
+	return newResourceID("brj")
+}
+
+func NewFreezeID() string {
+	return newResourceID("frz")
+}
+
+func NewFreezeHistoryEventID() string {
+	return newResourceID("frh")
+}
+
+func NewEventID() string {
+	return newResourceID("evt")
+}
+
+func NewIdentitySyncRunID() string {
+	return newResourceID("isr")
+}
@@ -0,0 +1,52 @@
+package workflow
+
+import (
+	"time"
+
+	"github.com/common-fate/common-fate/pkg/access"
+	"github.com/common-fate/common-fate/pkg/storage/keys"
+	"github.com/common-fate/ddb"
+)
+
+// Action is the next step to be taken for a grant.
+type Action string
+
+const (
+	ActionActivate   Action = "ACTIVATE"
+	ActionDeactivate Action = "DEACTIVATE"
+)
+
+// Workflow is a grant which is being provisioned by the local workflow runtime.
+// Workflows are persisted so that pending activations and deactivations
+// are resumed if the server restarts. A workflow is deleted once the grant has been deactivated.
+type Workflow struct {
+	GrantID    string             `json:"grantId" dynamodbav:"grantId"`
+	Grant      access.GroupTarget `json:"grant" dynamodbav:"grant"`
+	NextAction Action             `json:"nextAction" dynamodbav:"nextAction"`
+	// RunAt is when the next action is due
+	RunAt time.Time `json:"runAt" dynamodbav:"runAt"`
+	// State is returned by the provider when the grant is activated, and is passed back to the provider when the grant is deactivated
+	State map[string]any `json:"state,omitempty" dynamodbav:"state,omitempty"`
+	// Attempt is the attempt number for the next action, it is increased when the granter asks for a failed attempt to be retried
+	Attempt int `json:"attempt,omitempty" dynamodbav:"attempt,omitempty"`
+	// LeaseOwner is the runtime instance which is currently acting on the workflow.
+	// Leases prevent multiple replicas of the server from acting on the same workflow.
+	LeaseOwner string `json:"leaseOwner,omitempty" dynamodbav:"leaseOwner,omitempty"`
+	// LeaseExpiresAt is a unix timestamp in seconds, after which the lease may be claimed by another instance
+	LeaseExpiresAt int64     `json:"leaseExpiresAt,omitempty" dynamodbav:"leaseExpiresAt,omitempty"`
+	CreatedAt      time.Time `json:"createdAt" dynamodbav:"createdAt"`
+	UpdatedAt      time.Time `json:"updatedAt" dynamodbav:"updatedAt"`
+}
+
+// IsDue returns true if the next action should be run.
+func (w *Workflow) IsDue(now time.Time) bool {
+	return !w.RunAt.After(now)
+}
+
+func (w *Workflow) DDBKeys() (ddb.Keys, error) {
+	k := ddb.Keys{
+		PK: keys.GrantWorkflow.PK1,
+		SK: keys.GrantWorkflow.SK1(w.GrantID),
+	}
+	return k, nil
+}